@@ -9,8 +9,11 @@ import (
 
 // Genesis stores genesis state
 type Genesis struct {
-	Epoch      idx.Epoch
+	Epoch idx.Epoch
+	// Validators of the first epoch, their quorum rule becomes the quorum rule of all the epochs, see EpochState
 	Validators *pos.Validators
+	// AllowUnsafeQuorum allows quorum rules with a fraction lower than 2/3, for research networks only
+	AllowUnsafeQuorum bool
 }
 
 // ApplyGenesis writes initial state.
@@ -21,6 +24,9 @@ func (s *Store) ApplyGenesis(g *Genesis) error {
 	if g.Validators.Len() == 0 {
 		return fmt.Errorf("genesis validators shouldn't be empty")
 	}
+	if err := g.Validators.QuorumRule().Validate(g.AllowUnsafeQuorum); err != nil {
+		return err
+	}
 	if ok, _ := s.table.LastDecidedState.Has([]byte(dsKey)); ok {
 		return fmt.Errorf("genesis already applied")
 	}
@@ -36,6 +42,9 @@ func (s *Store) applyGenesis(epoch idx.Epoch, validators *pos.Validators) {
 
 	es.Validators = validators
 	es.Epoch = epoch
+	if rule := validators.QuorumRule(); rule != pos.DefaultQuorumRule {
+		es.QuorumRule = rule
+	}
 	ds.LastDecidedFrame = FirstFrame - 1
 
 	s.SetEpochState(es)
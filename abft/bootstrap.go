@@ -25,6 +25,9 @@ type EpochState struct {
 	// these values change only after a change of epoch
 	Epoch      idx.Epoch
 	Validators *pos.Validators
+	// QuorumRule is a consensus parameter which is applied to the validators of every epoch,
+	// the zero value is pos.DefaultQuorumRule
+	QuorumRule pos.QuorumRule `rlp:"optional"`
 }
 
 func (es EpochState) String() string {
@@ -48,6 +51,7 @@ func (p *Orderer) Bootstrap(callback OrdererCallbacks) error {
 		p.callback.EpochDBLoaded(p.store.GetEpoch())
 	}
 	p.election = election.New(p.store.GetValidators(), p.store.GetLastDecidedFrame()+1, p.dagIndex.ForklessCause, p.store.GetFrameRoots)
+	p.election.SetForklessCauseMany(p.forklessCauseMany)
 
 	// events reprocessing
 	_, err = p.bootstrapElection()
@@ -72,12 +76,14 @@ func (p *Orderer) StartFrom(callback OrdererCallbacks, epoch idx.Epoch, validato
 		p.callback.EpochDBLoaded(p.store.GetEpoch())
 	}
 	p.election = election.New(validators, FirstFrame, p.dagIndex.ForklessCause, p.store.GetFrameRoots)
+	p.election.SetForklessCauseMany(p.forklessCauseMany)
 	return err
 }
 
 // Reset switches epoch state to a new empty epoch.
+// The quorum rule of the network is kept, see EpochState.
 func (p *Orderer) Reset(epoch idx.Epoch, validators *pos.Validators) error {
-	p.store.applyGenesis(epoch, validators)
+	p.store.applyGenesis(epoch, validators.WithQuorumRule(p.store.GetEpochState().QuorumRule))
 	// reset internal epoch DB
 	err := p.resetEpochStore(epoch)
 	if err != nil {
@@ -86,10 +92,10 @@ func (p *Orderer) Reset(epoch idx.Epoch, validators *pos.Validators) error {
 	if p.callback.EpochDBLoaded != nil {
 		p.callback.EpochDBLoaded(p.store.GetEpoch())
 	}
-	p.election.Reset(validators, FirstFrame)
+	p.election.Reset(p.store.GetValidators(), FirstFrame)
 	return nil
 }
 
 func (p *Orderer) loadEpochDB() error {
-	return p.store.openEpochDB(p.store.GetEpoch())
+	return p.store.loadEpochDB()
 }
@@ -1,8 +1,10 @@
 package abft
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
@@ -53,3 +55,102 @@ func processTestEvent(t *testing.T, lch *CoreLachesis, store *EventStore, valida
 	}
 	return event
 }
+
+func TestCalcFrameIdx_MaxFrameJump(t *testing.T) {
+	const gap = 100
+	const maxFrameJump = 2
+	nodes := tdag.GenNodes(2)
+	// Give one validator quorum power to advance the frames on it's own
+	lch, _, store, _ := NewCoreLachesis(nodes, []pos.Weight{1, 3})
+	lch.config.MaxFrameJump = maxFrameJump
+
+	laggyGenesis := processTestEvent(t, lch, store, nodes[0], 1, hash.Events{})
+	parentEvent := processTestEvent(t, lch, store, nodes[1], 1, hash.Events{})
+	for i := 0; i < gap; i++ {
+		parentEvent = processTestEvent(t, lch, store, nodes[1], idx.Event(parentEvent.Seq()+1), hash.Events{parentEvent.ID()})
+	}
+
+	// Validator which was offline for more than MaxFrameJump frames comes back by referring to the recent events
+	laggy := processTestEvent(t, lch, store, nodes[0], laggyGenesis.Seq()+1, hash.Events{laggyGenesis.ID(), parentEvent.ID()})
+	if want, got := laggyGenesis.Frame()+gap+1, laggy.Frame(); want != got {
+		t.Fatalf("expected frame of the returned validator to be: %d, got: %d", want, got)
+	}
+	// and keeps participating
+	for i := 0; i < 5; i++ {
+		parentEvent = processTestEvent(t, lch, store, nodes[1], parentEvent.Seq()+1, hash.Events{parentEvent.ID(), laggy.ID()})
+		laggy = processTestEvent(t, lch, store, nodes[0], laggy.Seq()+1, hash.Events{laggy.ID(), parentEvent.ID()})
+		if laggy.Frame() < parentEvent.Frame() {
+			t.Fatalf("returned validator lags behind: frame %d, other validator frame %d", laggy.Frame(), parentEvent.Frame())
+		}
+	}
+
+	// Event with a claimed frame above the limit isn't processed and its roots aren't stored
+	lch.config.SuppressFramePanic = true
+	event := &tdag.TestEvent{}
+	event.SetSeq(laggy.Seq() + 1)
+	event.SetCreator(nodes[0])
+	event.SetParents(hash.Events{laggy.ID(), parentEvent.ID()})
+	maxLamport = maxLamport + 1
+	event.SetLamport(maxLamport)
+	event.SetEpoch(lch.store.GetEpoch())
+	parentsFrame := laggy.Frame()
+	if parentsFrame < parentEvent.Frame() {
+		parentsFrame = parentEvent.Frame()
+	}
+	event.SetFrame(parentsFrame + maxFrameJump + 1)
+	store.SetEvent(event)
+	var jumpErr *FrameJumpError
+	if err := lch.Process(event); !errors.As(err, &jumpErr) {
+		t.Fatalf("expected FrameJumpError, got: %v", err)
+	}
+	if jumpErr.ParentsFrame != parentsFrame || jumpErr.Frame != event.Frame() {
+		t.Errorf("unexpected frame jump: %v", jumpErr)
+	}
+	for f := laggy.Frame() + 1; f <= event.Frame(); f++ {
+		for _, root := range lch.store.GetFrameRoots(f) {
+			if root.ID == event.ID() {
+				t.Fatalf("root of rejected event is stored on frame %d", f)
+			}
+		}
+	}
+}
+
+// countingForklessCause hides ForklessCauseMany of the DAG index and counts ForklessCause calls
+type countingForklessCause struct {
+	index dagidx.ForklessCause
+	calls int
+}
+
+func (c *countingForklessCause) ForklessCause(a, b hash.Event) bool {
+	c.calls++
+	return c.index.ForklessCause(a, b)
+}
+
+func TestForklessCausedByQuorumOn_EarlyExit(t *testing.T) {
+	nodes := tdag.GenNodes(10)
+	lch, _, store, dagIndexer := NewCoreLachesis(nodes, nil)
+
+	heads := hash.Events{}
+	for _, node := range nodes {
+		heads = append(heads, processTestEvent(t, lch, store, node, 1, hash.Events{}).ID())
+	}
+	for seq := idx.Event(2); seq <= 3; seq++ {
+		next := hash.Events{}
+		for i, node := range nodes {
+			parents := append(hash.Events{heads[i]}, heads[:i]...)
+			parents = append(parents, heads[i+1:]...)
+			next = append(next, processTestEvent(t, lch, store, node, seq, parents).ID())
+		}
+		heads = next
+	}
+	e := store.GetEvent(heads[0])
+
+	counter := &countingForklessCause{index: dagIndexer}
+	lch.Orderer.dagIndex = counter
+	if !lch.forklessCausedByQuorumOn(e, 1) {
+		t.Fatal("event isn't forkless caused by quorum of frame roots")
+	}
+	if want := len(nodes)*2/3 + 1; counter.calls != want {
+		t.Errorf("expected %d ForklessCause calls, got %d", want, counter.calls)
+	}
+}
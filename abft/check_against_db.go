@@ -79,6 +79,39 @@ func GetEpochRange(conn *sql.DB) (idx.Epoch, idx.Epoch, error) {
 	return epochMin, epochMax, nil
 }
 
+// LoadEpochEvents reads validators and events of the epoch from the event DB.
+// Returned IDs are ordered by Lamport timestamps.
+func LoadEpochEvents(conn *sql.DB, epoch idx.Epoch) (*pos.Validators, *EventStore, hash.Events, error) {
+	validatorIDs, weights, err := getValidator(conn, epoch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	builder := pos.NewBuilder()
+	for i, id := range validatorIDs {
+		builder.Set(id, weights[i])
+	}
+
+	eventsOrdered, _, err := getEvents(conn, epoch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	eventStore := NewEventStore()
+	ids := make(hash.Events, 0, len(eventsOrdered))
+	for _, event := range eventsOrdered {
+		testEvent := &tdag.TestEvent{}
+		testEvent.SetSeq(event.seq)
+		testEvent.SetCreator(event.validatorId)
+		testEvent.SetParents(event.parents)
+		testEvent.SetLamport(event.lamportTs)
+		testEvent.SetFrame(event.frame)
+		testEvent.SetEpoch(epoch)
+		testEvent.SetID([24]byte(event.hash[8:]))
+		eventStore.SetEvent(testEvent)
+		ids = append(ids, testEvent.ID())
+	}
+	return builder.Build(), eventStore, ids, nil
+}
+
 func ingestEvent(testLachesis *CoreLachesis, eventStore *EventStore, event *dbEvent) error {
 	testEvent := &tdag.TestEvent{}
 	testEvent.SetSeq(event.seq)
@@ -1,16 +1,25 @@
 package abft
 
-import "github.com/Fantom-foundation/lachesis-base/utils/cachescale"
+import (
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/utils/cachescale"
+)
 
 type Config struct {
 	// Suppresses the frame missmatch panic - used only for importing older historical event files, disabled by default
 	SuppressFramePanic bool
+	// MaxFrameJump limits the difference between event's frame and the highest frame of its parents, 0 means no limit.
+	// Events exceeding the limit are rejected, so the value must be the same for all the nodes of a network.
+	// Frame of an honest event doesn't exceed the highest parent frame by more than 1 unless the parents observe forks,
+	// and a validator which was offline jumps over the missed frames by referring to the recent events.
+	MaxFrameJump idx.Frame
 }
 
 // DefaultConfig for livenet.
 func DefaultConfig() Config {
 	return Config{
 		SuppressFramePanic: false,
+		MaxFrameJump:       0,
 	}
 }
 
@@ -18,6 +27,7 @@ func DefaultConfig() Config {
 func LiteConfig() Config {
 	return Config{
 		SuppressFramePanic: false,
+		MaxFrameJump:       0,
 	}
 }
 
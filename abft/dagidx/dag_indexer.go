@@ -33,6 +33,18 @@ type ForklessCause interface {
 	ForklessCause(aID, bID hash.Event) bool
 }
 
+// ForklessCauseMany is an optional extension of ForklessCause, which evaluates multiple candidates at once.
+type ForklessCauseMany interface {
+	// ForklessCauseMany returns ForklessCause(aID, bID) for each of bIDs
+	ForklessCauseMany(aID hash.Event, bIDs hash.Events) []bool
+}
+
+// Pruner is an optional extension of a DAG index, which deletes the data of the events far below the last decided frame.
+type Pruner interface {
+	// Prune is called after a frame is decided, the changes are written by the next Flush
+	Prune(lastDecidedFrame idx.Frame)
+}
+
 type VectorClock interface {
 	GetMergedHighestBefore(id hash.Event) HighestBeforeSeq
 }
@@ -0,0 +1,98 @@
+package election
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// Candidate is the leading Atropos candidate of the current election.
+// Unlike Res, it isn't final unless Decided is true.
+type Candidate struct {
+	Frame     idx.Frame
+	Atropos   hash.Event
+	Validator idx.ValidatorID
+
+	// Round is the latest round of votes for the candidate, i.e. voters' frame minus Frame
+	Round idx.Frame
+	// YesWeight is the weight of the latest round roots which vote "yes" for the candidate
+	YesWeight pos.Weight
+	// NoWeight is the weight of the latest round roots which vote "no" for the candidate
+	NoWeight pos.Weight
+	// Decided is true if the candidate is decided as Atropos
+	Decided bool
+}
+
+// LeadingCandidate returns the most likely Atropos for the frame being decided, according to the already processed votes.
+// Subjects are checked in the same order as in chooseAtropos, subjects which are decided or likely to be decided as "no" are skipped.
+// Returns nil if there are no "yes" votes for any of the remaining subjects yet.
+func (el *Election) LeadingCandidate() *Candidate {
+	for _, validator := range el.validators.SortedIDs() {
+		round, yesVotes, noVotes, observedRoot := el.latestVotes(validator)
+
+		decided, ok := el.decidedRoots[validator]
+		if ok && !decided.yes {
+			continue
+		}
+		if ok {
+			observedRoot = decided.observedRoot
+		} else if yesVotes.Sum() == 0 || yesVotes.Sum() < noVotes.Sum() {
+			// subject is likely to be decided as "no" in a round in which all the voters vote
+			if round == 0 {
+				return nil
+			}
+			continue
+		}
+
+		return &Candidate{
+			Frame:     el.frameToDecide,
+			Atropos:   observedRoot,
+			Validator: validator,
+			Round:     round,
+			YesWeight: yesVotes.Sum(),
+			NoWeight:  noVotes.Sum(),
+			Decided:   ok,
+		}
+	}
+	return nil
+}
+
+// subjectVotes are the votes for a subject from the roots of the highest voting frame
+type subjectVotes struct {
+	frame        idx.Frame
+	yesVotes     *pos.WeightCounter
+	noVotes      *pos.WeightCounter
+	observedRoot hash.Event
+}
+
+// saveVote stores the vote and counts it if it's from the highest voting frame for the subject
+func (el *Election) saveVote(vid voteID, vote voteValue) {
+	el.votes[vid] = vote
+
+	latest := el.latest[vid.forValidator]
+	if latest == nil || vid.fromRoot.Slot.Frame > latest.frame {
+		latest = &subjectVotes{
+			frame:    vid.fromRoot.Slot.Frame,
+			yesVotes: el.validators.NewCounter(),
+			noVotes:  el.validators.NewCounter(),
+		}
+		el.latest[vid.forValidator] = latest
+	} else if vid.fromRoot.Slot.Frame < latest.frame {
+		return
+	}
+	if vote.yes {
+		latest.observedRoot = vote.observedRoot
+		latest.yesVotes.Count(vid.fromRoot.Slot.Validator)
+	} else {
+		latest.noVotes.Count(vid.fromRoot.Slot.Validator)
+	}
+}
+
+// latestVotes returns votes for the subject from the roots of the highest voting frame
+func (el *Election) latestVotes(subject idx.ValidatorID) (round idx.Frame, yesVotes, noVotes *pos.WeightCounter, observedRoot hash.Event) {
+	latest := el.latest[subject]
+	if latest == nil {
+		return 0, el.validators.NewCounter(), el.validators.NewCounter(), hash.ZeroEvent
+	}
+	return latest.frame - el.frameToDecide, latest.yesVotes, latest.noVotes, latest.observedRoot
+}
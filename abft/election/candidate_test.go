@@ -0,0 +1,136 @@
+package election
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// recountLatestVotes counts votes for the subject from the roots of the highest voting frame by scanning all the votes
+func recountLatestVotes(el *Election, subject idx.ValidatorID) (round idx.Frame, yes, no pos.Weight) {
+	var latest idx.Frame
+	for vid := range el.votes {
+		if vid.forValidator == subject && vid.fromRoot.Slot.Frame > latest {
+			latest = vid.fromRoot.Slot.Frame
+		}
+	}
+	if latest == 0 {
+		return 0, 0, 0
+	}
+	yesVotes := el.validators.NewCounter()
+	noVotes := el.validators.NewCounter()
+	for vid, vote := range el.votes {
+		if vid.forValidator != subject || vid.fromRoot.Slot.Frame != latest {
+			continue
+		}
+		if vote.yes {
+			yesVotes.Count(vid.fromRoot.Slot.Validator)
+		} else {
+			noVotes.Count(vid.fromRoot.Slot.Validator)
+		}
+	}
+	return latest - el.frameToDecide, yesVotes.Sum(), noVotes.Sum()
+}
+
+func TestLeadingCandidate(t *testing.T) {
+	// roots of frame 1 observe the roots of frame 0 created by the observed validators
+	testLeadingCandidate(t, "all observed", []idx.ValidatorID{1, 2, 3, 4}, 1)
+	testLeadingCandidate(t, "first not observed", []idx.ValidatorID{2, 3, 4}, 2)
+}
+
+func testLeadingCandidate(t *testing.T, name string, observed []idx.ValidatorID, atropos idx.ValidatorID) {
+	t.Run(name, func(t *testing.T) {
+		require := require.New(t)
+
+		validators := pos.EqualWeightValidators([]idx.ValidatorID{1, 2, 3, 4}, 1)
+		frameRoots := make(map[idx.Frame][]RootAndSlot)
+		edges := make(map[fakeEdge]bool)
+		addRoot := func(frame idx.Frame, validator idx.ValidatorID) RootAndSlot {
+			root := RootAndSlot{
+				ID: hash.Event{byte(frame), byte(validator)},
+				Slot: Slot{
+					Frame:     frame,
+					Validator: validator,
+				},
+			}
+			frameRoots[frame] = append(frameRoots[frame], root)
+			return root
+		}
+		for _, v := range validators.SortedIDs() {
+			addRoot(0, v)
+		}
+		for _, v := range validators.SortedIDs() {
+			root := addRoot(1, v)
+			for _, prev := range frameRoots[0] {
+				if containsValidator(observed, prev.Slot.Validator) {
+					edges[fakeEdge{root.ID, prev.ID}] = true
+				}
+			}
+		}
+		decisive := addRoot(2, 1)
+		for _, prev := range frameRoots[1] {
+			edges[fakeEdge{decisive.ID, prev.ID}] = true
+		}
+
+		el := New(validators, 0, func(a hash.Event, b hash.Event) bool {
+			return edges[fakeEdge{a, b}]
+		}, func(f idx.Frame) []RootAndSlot {
+			return frameRoots[f]
+		})
+		require.Nil(el.LeadingCandidate())
+
+		checkLatestVotes := func() {
+			for _, v := range validators.IDs() {
+				round, yes, no := recountLatestVotes(el, v)
+				gotRound, gotYes, gotNo, _ := el.latestVotes(v)
+				require.Equal(round, gotRound)
+				require.Equal(yes, gotYes.Sum())
+				require.Equal(no, gotNo.Sum())
+			}
+		}
+		for i, root := range frameRoots[1] {
+			res, err := el.ProcessRoot(root)
+			require.NoError(err)
+			require.Nil(res)
+			checkLatestVotes()
+
+			candidate := el.LeadingCandidate()
+			require.NotNil(candidate)
+			require.Equal(&Candidate{
+				Frame:     0,
+				Atropos:   hash.Event{0, byte(atropos)},
+				Validator: atropos,
+				Round:     1,
+				YesWeight: pos.Weight(i + 1),
+			}, candidate)
+		}
+
+		res, err := el.ProcessRoot(decisive)
+		require.NoError(err)
+		require.NotNil(res)
+		checkLatestVotes()
+		candidate := el.LeadingCandidate()
+		require.NotNil(candidate)
+		require.True(candidate.Decided)
+		require.Equal(res.Frame, candidate.Frame)
+		require.Equal(res.Atropos, candidate.Atropos)
+
+		// the latest votes are restored with the election state
+		restored, err := Load(el.State(), el.observe, el.getFrameRoots)
+		require.NoError(err)
+		require.Equal(candidate, restored.LeadingCandidate())
+	})
+}
+
+func containsValidator(ids []idx.ValidatorID, id idx.ValidatorID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -7,7 +7,8 @@ import (
 	"github.com/Fantom-foundation/lachesis-base/hash"
 )
 
-// DebugStateHash may be used in tests to match election state
+// DebugStateHash may be used in tests to match election state.
+// Votes are hashed in the order of State, so the hash doesn't depend on the map iteration order.
 func (el *Election) DebugStateHash() hash.Hash {
 	hasher := sha256.New()
 	write := func(bb []byte) {
@@ -16,15 +17,16 @@ func (el *Election) DebugStateHash() hash.Hash {
 		}
 	}
 
-	for vid, vote := range el.votes {
-		write(vid.fromRoot.ID.Bytes())
-		write(vid.fromRoot.Slot.Frame.Bytes())
-		write(vid.fromRoot.Slot.Validator.Bytes())
-		write(vote.observedRoot.Bytes())
+	s := el.State()
+	for _, vote := range s.Votes {
+		write(vote.From.ID.Bytes())
+		write(vote.From.Slot.Frame.Bytes())
+		write(vote.From.Slot.Validator.Bytes())
+		write(vote.ObservedRoot.Bytes())
 	}
-	for validator, vote := range el.decidedRoots {
-		write(validator.Bytes())
-		write(vote.observedRoot.Bytes())
+	for _, vote := range s.DecidedRoots {
+		write(vote.Validator.Bytes())
+		write(vote.ObservedRoot.Bytes())
 	}
 	return hash.FromBytes(hasher.Sum(nil))
 }
@@ -34,11 +36,11 @@ func (el *Election) DebugStateHash() hash.Hash {
 func (el *Election) String(voters []RootAndSlot) string {
 	if voters == nil {
 		votersM := make(map[RootAndSlot]bool)
-		for vid := range el.votes {
-			votersM[vid.fromRoot] = true
-		}
-		for voter := range votersM {
-			voters = append(voters, voter)
+		for _, vote := range el.State().Votes {
+			if !votersM[vote.From] {
+				votersM[vote.From] = true
+				voters = append(voters, vote.From)
+			}
 		}
 	}
 
@@ -17,14 +17,18 @@ type (
 		// election state
 		decidedRoots map[idx.ValidatorID]voteValue // decided roots at "frameToDecide"
 		votes        map[voteID]voteValue
+		latest       map[idx.ValidatorID]*subjectVotes // votes of the highest voting frame per subject
 
 		// external world
 		observe       ForklessCauseFn
+		observeMany   ForklessCauseManyFn
 		getFrameRoots GetFrameRootsFn
 	}
 
 	// ForklessCauseFn returns true if event A is forkless caused by event B
 	ForklessCauseFn func(a hash.Event, b hash.Event) bool
+	// ForklessCauseManyFn returns ForklessCauseFn(a, b) for each of bs
+	ForklessCauseManyFn func(a hash.Event, bs hash.Events) []bool
 	// GetFrameRootsFn returns all the roots in the specified frame
 	GetFrameRootsFn func(f idx.Frame) []RootAndSlot
 
@@ -75,12 +79,18 @@ func New(
 	return el
 }
 
+// SetForklessCauseMany sets a batched version of ForklessCauseFn, which evaluates all the roots of a frame at once
+func (el *Election) SetForklessCauseMany(fn ForklessCauseManyFn) {
+	el.observeMany = fn
+}
+
 // Reset erases the current election state, prepare for new election frame
 func (el *Election) Reset(validators *pos.Validators, frameToDecide idx.Frame) {
 	el.validators = validators
 	el.frameToDecide = frameToDecide
 	el.votes = make(map[voteID]voteValue)
 	el.decidedRoots = make(map[idx.ValidatorID]voteValue)
+	el.latest = make(map[idx.ValidatorID]*subjectVotes)
 }
 
 // return root slots which are not within el.decidedRoots
@@ -103,9 +113,9 @@ func (el *Election) observedRoots(root hash.Event, frame idx.Frame) []RootAndSlo
 	observedRoots := make([]RootAndSlot, 0, el.validators.Len())
 
 	frameRoots := el.getFrameRoots(frame)
-	for _, frameRoot := range frameRoots {
-		if el.observe(root, frameRoot.ID) {
-			observedRoots = append(observedRoots, frameRoot)
+	for i, observed := range el.observeRoots(root, frameRoots) {
+		if observed {
+			observedRoots = append(observedRoots, frameRoots[i])
 		}
 	}
 	return observedRoots
@@ -115,10 +125,26 @@ func (el *Election) observedRootsMap(root hash.Event, frame idx.Frame) map[idx.V
 	observedRootsMap := make(map[idx.ValidatorID]RootAndSlot, el.validators.Len())
 
 	frameRoots := el.getFrameRoots(frame)
-	for _, frameRoot := range frameRoots {
-		if el.observe(root, frameRoot.ID) {
-			observedRootsMap[frameRoot.Slot.Validator] = frameRoot
+	for i, observed := range el.observeRoots(root, frameRoots) {
+		if observed {
+			observedRootsMap[frameRoots[i].Slot.Validator] = frameRoots[i]
 		}
 	}
 	return observedRootsMap
 }
+
+// observeRoots returns true for each of the frame roots which forkless causes the specified root
+func (el *Election) observeRoots(root hash.Event, frameRoots []RootAndSlot) []bool {
+	if el.observeMany != nil {
+		ids := make(hash.Events, len(frameRoots))
+		for i, frameRoot := range frameRoots {
+			ids[i] = frameRoot.ID
+		}
+		return el.observeMany(root, ids)
+	}
+	observed := make([]bool, len(frameRoots))
+	for i, frameRoot := range frameRoots {
+		observed[i] = el.observe(root, frameRoot.ID)
+	}
+	return observed
+}
@@ -106,7 +106,7 @@ func (el *Election) ProcessRoot(newRoot RootAndSlot) (*Res, error) {
 			fromRoot:     newRoot,
 			forValidator: validatorSubject,
 		}
-		el.votes[vid] = vote
+		el.saveVote(vid, vote)
 	}
 
 	// check if election is decided
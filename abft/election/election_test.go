@@ -255,6 +255,17 @@ func testProcessRoot(
 	ordered = unordered.ByParents()
 
 	election := New(validators, 0, forklessCauseFn, getFrameRootsFn)
+	// the same election with batched evaluation of forkless cause
+	batched := New(validators, 0, forklessCauseFn, getFrameRootsFn)
+	batched.SetForklessCauseMany(func(a hash.Event, bs hash.Events) []bool {
+		res := make([]bool, len(bs))
+		for i, b := range bs {
+			res[i] = forklessCauseFn(a, b)
+		}
+		return res
+	})
+	// the same election which is saved and loaded before every root
+	restored := New(validators, 0, forklessCauseFn, getFrameRootsFn)
 
 	// processing:
 	var alreadyDecided bool
@@ -271,9 +282,32 @@ func testProcessRoot(
 		if err != nil {
 			t.Fatal(err)
 		}
+		gotBatched, err := batched.ProcessRoot(RootAndSlot{
+			ID:   rootHash,
+			Slot: rootSlot,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertar.Equal(got, gotBatched)
+		restored = reloadElection(t, restored, forklessCauseFn, getFrameRootsFn)
+		gotRestored, err := restored.ProcessRoot(RootAndSlot{
+			ID:   rootHash,
+			Slot: rootSlot,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertar.Equal(got, gotRestored)
+		assertar.Equal(election.DebugStateHash(), restored.DebugStateHash())
 
 		// checking:
 		decisive := expected != nil && expected.DecisiveRoots[root.ID().String()]
+		explanation, err := election.Explain()
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkExplanation(t, election, got, explanation)
 		if decisive || alreadyDecided {
 			assertar.NotNil(got)
 			assertar.Equal(expected.DecidedFrame, got.Frame)
@@ -0,0 +1,76 @@
+package electionsim
+
+import (
+	"math/rand"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// Scheduler decides when events are delivered to validators.
+// Delays are finite, i.e. every event is eventually delivered to everyone.
+type Scheduler interface {
+	// Delay returns the number of steps after which the event, emitted at the step, is delivered to the validator.
+	// Values lower than 1 are treated as 1.
+	Delay(step int, e dag.Event, to idx.ValidatorID, r *rand.Rand) int
+}
+
+// RandomDelay delivers events after a uniformly random number of steps within [Min, Max]
+type RandomDelay struct {
+	Min int
+	Max int
+}
+
+// Delay implements Scheduler.
+func (s RandomDelay) Delay(step int, e dag.Event, to idx.ValidatorID, r *rand.Rand) int {
+	if s.Max <= s.Min {
+		return s.Min
+	}
+	return s.Min + r.Intn(s.Max-s.Min+1)
+}
+
+// DelayedValidators delivers events of the specified validators Extra steps later than the Base scheduler
+type DelayedValidators struct {
+	Base       Scheduler
+	Validators []idx.ValidatorID
+	Extra      int
+}
+
+// Delay implements Scheduler.
+func (s DelayedValidators) Delay(step int, e dag.Event, to idx.ValidatorID, r *rand.Rand) int {
+	delay := s.Base.Delay(step, e, to, r)
+	if contains(s.Validators, e.Creator()) && e.Creator() != to {
+		delay += s.Extra
+	}
+	return delay
+}
+
+// Partition isolates the Group of validators from the rest within the [From, To) steps.
+// Events emitted within the period are delivered across the partition not earlier than at step To.
+type Partition struct {
+	Base  Scheduler
+	Group []idx.ValidatorID
+	From  int
+	To    int
+}
+
+// Delay implements Scheduler.
+func (s Partition) Delay(step int, e dag.Event, to idx.ValidatorID, r *rand.Rand) int {
+	delay := s.Base.Delay(step, e, to, r)
+	if step < s.From || step >= s.To {
+		return delay
+	}
+	if contains(s.Group, e.Creator()) != contains(s.Group, to) && step+delay < s.To {
+		delay = s.To - step
+	}
+	return delay
+}
+
+func contains(ids []idx.ValidatorID, id idx.ValidatorID) bool {
+	for _, it := range ids {
+		if it == id {
+			return true
+		}
+	}
+	return false
+}
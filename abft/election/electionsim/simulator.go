@@ -0,0 +1,262 @@
+// Package electionsim simulates Atropos elections of validators whose views of DAG are shaped by adversarial message delivery.
+// Every validator emits events on top of its own view, and runs its own abft.IndexedLachesis over the view.
+package electionsim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// Config of simulation
+type Config struct {
+	Validators *pos.Validators
+	// Cheaters emit a pair of fork events on every step.
+	// The first fork is delivered first to the first half of validators, and the second fork to the second half.
+	Cheaters []idx.ValidatorID
+	// ForkDelay is the number of steps by which a fork is delivered later to the half of validators which gets the other fork first
+	ForkDelay int
+
+	// Steps is the number of steps, every validator emits an event on each step
+	Steps int
+	// Parents is the max number of parents of an event, including the self-parent
+	Parents int
+
+	Scheduler Scheduler
+	Seed      int64
+}
+
+// Result of simulation
+type Result struct {
+	// Decided are the Atropos decisions of every honest validator, in order of frames
+	Decided map[idx.ValidatorID][]Decision
+	// RoundsToDecide is the number of decisions by number of rounds it took, over all the honest validators
+	RoundsToDecide map[idx.Frame]int
+	// Events is the number of emitted events
+	Events int
+}
+
+type delivery struct {
+	to idx.ValidatorID
+	e  *tdag.TestEvent
+}
+
+type simulator struct {
+	cfg   Config
+	r     *rand.Rand
+	views map[idx.ValidatorID]*view
+	// last are the last own events, the next events are emitted on top of them
+	last     map[idx.ValidatorID]*tdag.TestEvent
+	schedule map[int][]delivery
+	events   int
+}
+
+// Run simulates the elections.
+// Returns an error if honest validators decided different Atroposes, or if any validator has failed to process events.
+func Run(cfg Config) (*Result, error) {
+	cheatersWeight := cfg.Validators.NewCounter()
+	for _, cheater := range cfg.Cheaters {
+		if !cfg.Validators.Exists(cheater) {
+			return nil, fmt.Errorf("cheater %d isn't a validator", cheater)
+		}
+		cheatersWeight.Count(cheater)
+	}
+	if cheatersWeight.Sum()*3 >= cfg.Validators.TotalWeight() {
+		return nil, fmt.Errorf("cheaters weight %d isn't less than 1/3W of %d", cheatersWeight.Sum(), cfg.Validators.TotalWeight())
+	}
+	if cfg.Parents < 1 {
+		return nil, fmt.Errorf("at least a self-parent is required")
+	}
+
+	s := &simulator{
+		cfg:      cfg,
+		r:        rand.New(rand.NewSource(cfg.Seed)), // nolint:gosec
+		views:    make(map[idx.ValidatorID]*view),
+		last:     make(map[idx.ValidatorID]*tdag.TestEvent),
+		schedule: make(map[int][]delivery),
+	}
+	for _, validator := range cfg.Validators.IDs() {
+		v, err := newView(cfg.Validators)
+		if err != nil {
+			return nil, err
+		}
+		s.views[validator] = v
+	}
+
+	for step := 0; step < cfg.Steps; step++ {
+		if err := s.step(step); err != nil {
+			return nil, err
+		}
+	}
+	return s.result()
+}
+
+func (s *simulator) step(step int) error {
+	for _, d := range s.schedule[step] {
+		if err := s.views[d.to].deliver(d.e); err != nil {
+			return fmt.Errorf("validator %d failed to process event %s: %w", d.to, d.e.ID().String(), err)
+		}
+	}
+	delete(s.schedule, step)
+
+	for _, creator := range s.cfg.Validators.IDs() {
+		selfParent := s.last[creator]
+		e, err := s.emit(step, creator, selfParent)
+		if err != nil {
+			return err
+		}
+		// next events are emitted on top of the first fork
+		s.last[creator] = e
+		if !s.isCheater(creator) || selfParent == nil {
+			s.send(step, e, nil, 0)
+			continue
+		}
+		fork, err := s.emit(step, creator, selfParent)
+		if err != nil {
+			return err
+		}
+		// equivocation: each half of validators gets one of the forks first
+		ids := s.cfg.Validators.SortedIDs()
+		first, second := ids[:len(ids)/2], ids[len(ids)/2:]
+		s.send(step, e, second, s.cfg.ForkDelay)
+		s.send(step, fork, first, s.cfg.ForkDelay)
+	}
+	return nil
+}
+
+// emit creates a new event of the creator on top of the creator's view, and processes it by the view
+func (s *simulator) emit(step int, creator idx.ValidatorID, selfParent *tdag.TestEvent) (*tdag.TestEvent, error) {
+	v := s.views[creator]
+
+	e := &tdag.TestEvent{}
+	e.SetCreator(creator)
+	e.SetParents(hash.Events{})
+	e.SetSeq(1)
+	e.SetLamport(1)
+	if selfParent != nil {
+		e.SetSeq(selfParent.Seq() + 1)
+		e.SetLamport(selfParent.Lamport() + 1)
+		e.AddParent(selfParent.ID())
+	}
+	others := make([]idx.ValidatorID, 0, len(v.heads))
+	for _, validator := range s.cfg.Validators.IDs() {
+		if validator != creator && v.heads[validator] != nil {
+			others = append(others, validator)
+		}
+	}
+	s.r.Shuffle(len(others), func(i, j int) {
+		others[i], others[j] = others[j], others[i]
+	})
+	for i := 0; i < len(others) && len(e.Parents()) < s.cfg.Parents; i++ {
+		parent := v.heads[others[i]]
+		e.AddParent(parent.ID())
+		if e.Lamport() <= parent.Lamport() {
+			e.SetLamport(parent.Lamport() + 1)
+		}
+	}
+	e.Name = fmt.Sprintf("%s%03d", hash.GetNodeName(creator), step)
+
+	if err := v.build(e); err != nil {
+		return nil, fmt.Errorf("validator %d failed to build event: %w", creator, err)
+	}
+	s.events++
+	var id [24]byte
+	binary.BigEndian.PutUint64(id[16:], uint64(s.events))
+	e.SetID(id)
+	if err := v.deliver(e); err != nil {
+		return nil, fmt.Errorf("validator %d failed to process own event %s: %w", creator, e.ID().String(), err)
+	}
+	return e, nil
+}
+
+// send schedules delivery of the event to other validators, the late validators get it extra steps later
+func (s *simulator) send(step int, e *tdag.TestEvent, late []idx.ValidatorID, extra int) {
+	for _, to := range s.cfg.Validators.IDs() {
+		if to == e.Creator() {
+			continue
+		}
+		delay := s.cfg.Scheduler.Delay(step, e, to, s.r)
+		if delay < 1 {
+			delay = 1
+		}
+		if contains(late, to) {
+			delay += extra
+		}
+		s.schedule[step+delay] = append(s.schedule[step+delay], delivery{to, e})
+	}
+}
+
+func (s *simulator) isCheater(validator idx.ValidatorID) bool {
+	return contains(s.cfg.Cheaters, validator)
+}
+
+// result checks that the honest validators agree on the decided Atroposes
+func (s *simulator) result() (*Result, error) {
+	res := &Result{
+		Decided:        make(map[idx.ValidatorID][]Decision),
+		RoundsToDecide: make(map[idx.Frame]int),
+		Events:         s.events,
+	}
+	var (
+		longest     []Decision
+		longestView idx.ValidatorID
+	)
+	for _, validator := range s.cfg.Validators.SortedIDs() {
+		if s.isCheater(validator) {
+			continue
+		}
+		decided := s.views[validator].decided
+		res.Decided[validator] = decided
+		for _, d := range decided {
+			res.RoundsToDecide[d.Round]++
+		}
+		for i := 0; i < len(decided) && i < len(longest); i++ {
+			if decided[i].Res != longest[i].Res {
+				return res, fmt.Errorf("validator %d decided Atropos %s at frame %d, but validator %d decided %s",
+					validator, decided[i].Atropos.String(), decided[i].Frame, longestView, longest[i].Atropos.String())
+			}
+		}
+		if len(decided) > len(longest) {
+			longest, longestView = decided, validator
+		}
+	}
+	return res, nil
+}
+
+// MinDecided returns the lowest number of frames decided by an honest validator
+func (r *Result) MinDecided() int {
+	min := -1
+	for _, decided := range r.Decided {
+		if min < 0 || len(decided) < min {
+			min = len(decided)
+		}
+	}
+	return min
+}
+
+// String returns the distribution of rounds-to-decide in a human readable format
+func (r *Result) String() string {
+	rounds := make([]idx.Frame, 0, len(r.RoundsToDecide))
+	total := 0
+	for round, n := range r.RoundsToDecide {
+		rounds = append(rounds, round)
+		total += n
+	}
+	sort.Slice(rounds, func(i, j int) bool {
+		return rounds[i] < rounds[j]
+	})
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("events=%d, decisions=%d, min decided frames=%d, rounds to decide:", r.Events, total, r.MinDecided()))
+	for _, round := range rounds {
+		sb.WriteString(fmt.Sprintf(" %d:%.1f%%", round, 100*float64(r.RoundsToDecide[round])/float64(total)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,165 @@
+package electionsim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+func TestSimulation(t *testing.T) {
+	nodes := tdag.GenNodes(7)
+	equal := pos.EqualWeightValidators(nodes, 1)
+	different := pos.ArrayToValidators(nodes, []pos.Weight{10, 8, 6, 4, 3, 2, 1})
+	base := RandomDelay{Min: 1, Max: 3}
+	rule, err := pos.NewQuorumRule(3, 4)
+	require.NoError(t, err)
+	threeQuarters := equal.WithQuorumRule(rule)
+
+	for _, tc := range []struct {
+		name string
+		cfg  Config
+	}{
+		{
+			name: "random delays",
+			cfg: Config{
+				Validators: equal,
+				Scheduler:  base,
+			},
+		},
+		{
+			name: "random delays different weights",
+			cfg: Config{
+				Validators: different,
+				Scheduler:  base,
+			},
+		},
+		{
+			name: "delayed validators",
+			cfg: Config{
+				Validators: equal,
+				Scheduler: DelayedValidators{
+					Base:       base,
+					Validators: nodes[:2],
+					Extra:      8,
+				},
+			},
+		},
+		{
+			name: "delayed heaviest validator",
+			cfg: Config{
+				Validators: different,
+				Scheduler: DelayedValidators{
+					Base:       base,
+					Validators: nodes[:1],
+					Extra:      8,
+				},
+			},
+		},
+		{
+			name: "partition without quorum",
+			cfg: Config{
+				Validators: equal,
+				Scheduler: Partition{
+					Base:  base,
+					Group: nodes[:3],
+					From:  10,
+					To:    40,
+				},
+			},
+		},
+		{
+			name: "partition of minority",
+			cfg: Config{
+				Validators: equal,
+				Scheduler: Partition{
+					Base:  base,
+					Group: nodes[:2],
+					From:  5,
+					To:    50,
+				},
+			},
+		},
+		{
+			name: "quorum rule 3/4",
+			cfg: Config{
+				Validators: threeQuarters,
+				Scheduler:  base,
+			},
+		},
+		{
+			name: "quorum rule 3/4 delayed validator",
+			cfg: Config{
+				Validators: threeQuarters,
+				Scheduler: DelayedValidators{
+					Base:       base,
+					Validators: nodes[:1],
+					Extra:      8,
+				},
+			},
+		},
+		{
+			name: "equivocating validators",
+			cfg: Config{
+				Validators: equal,
+				Cheaters:   nodes[:2],
+				ForkDelay:  4,
+				Scheduler:  base,
+			},
+		},
+		{
+			name: "equivocating validators within partition",
+			cfg: Config{
+				Validators: equal,
+				Cheaters:   nodes[3:5],
+				ForkDelay:  6,
+				Scheduler: Partition{
+					Base:  base,
+					Group: nodes[:3],
+					From:  10,
+					To:    30,
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for seed := int64(0); seed < 3; seed++ {
+				cfg := tc.cfg
+				cfg.Steps = 80
+				cfg.Parents = 4
+				cfg.Seed = seed
+				res, err := Run(cfg)
+				require.NoError(t, err)
+				// liveness
+				require.Greater(t, res.MinDecided(), 3)
+				for _, round := range decidedRounds(res) {
+					require.GreaterOrEqual(t, round, idx.Frame(2))
+				}
+				t.Log(res.String())
+			}
+		})
+	}
+}
+
+func TestSimulation_TooManyCheaters(t *testing.T) {
+	nodes := tdag.GenNodes(6)
+	_, err := Run(Config{
+		Validators: pos.EqualWeightValidators(nodes, 1),
+		Cheaters:   nodes[:2],
+		Steps:      10,
+		Parents:    3,
+		Scheduler:  RandomDelay{Min: 1, Max: 1},
+	})
+	require.Error(t, err)
+}
+
+func decidedRounds(res *Result) []idx.Frame {
+	rounds := make([]idx.Frame, 0, len(res.RoundsToDecide))
+	for round := range res.RoundsToDecide {
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
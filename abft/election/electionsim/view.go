@@ -0,0 +1,151 @@
+package electionsim
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/abft"
+	"github.com/Fantom-foundation/lachesis-base/abft/election"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
+	"github.com/Fantom-foundation/lachesis-base/utils/adapters"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+// Decision is an Atropos decided by a validator's view
+type Decision struct {
+	election.Res
+	// Round is the frame of the root which has decided the election, minus the decided frame
+	Round idx.Frame
+}
+
+// view is a DAG of events known by a validator, and the validator's consensus over it
+type view struct {
+	store *abft.Store
+	input *abft.EventStore
+	lch   *abft.IndexedLachesis
+
+	heads map[idx.ValidatorID]*tdag.TestEvent
+	// pending are the delivered events with not yet known parents
+	pending []*tdag.TestEvent
+
+	decided []Decision
+
+	err error
+}
+
+func newView(validators *pos.Validators) (*view, error) {
+	v := &view{
+		heads: make(map[idx.ValidatorID]*tdag.TestEvent),
+	}
+	openEDB := func(epoch idx.Epoch) kvdb.Store {
+		return memorydb.New()
+	}
+	v.store = abft.NewStore(memorydb.New(), openEDB, v.crit, abft.LiteStoreConfig())
+	err := v.store.ApplyGenesis(&abft.Genesis{
+		Validators: validators,
+		Epoch:      abft.FirstEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	v.input = abft.NewEventStore()
+	dagIndexer := &adapters.VectorToDagIndexer{Index: vecfc.NewMemIndex(v.crit, vecfc.LiteConfig())}
+	v.lch = abft.NewIndexedLachesis(v.store, v.input, dagIndexer, v.crit, abft.LiteConfig())
+
+	// epochs are never sealed
+	err = v.lch.Bootstrap(lachesis.ConsensusCallbacks{
+		BeginBlock: func(block *lachesis.Block) lachesis.BlockCallbacks {
+			v.onFrameDecided(block)
+			return lachesis.BlockCallbacks{}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (v *view) crit(err error) {
+	if v.err == nil {
+		v.err = err
+	}
+}
+
+// build calculates the frame of a new event of the view's validator
+func (v *view) build(e *tdag.TestEvent) error {
+	e.SetEpoch(abft.FirstEpoch)
+	if err := v.lch.Build(e); err != nil {
+		return err
+	}
+	return v.err
+}
+
+// deliver processes the event and the pending events whose parents became known
+func (v *view) deliver(e *tdag.TestEvent) error {
+	if v.input.HasEvent(e.ID()) {
+		return nil
+	}
+	v.pending = append(v.pending, e)
+	for processed := true; processed; {
+		processed = false
+		rest := v.pending[:0]
+		for _, p := range v.pending {
+			if !v.parentsKnown(p) {
+				rest = append(rest, p)
+				continue
+			}
+			if err := v.process(p); err != nil {
+				return err
+			}
+			processed = true
+		}
+		v.pending = rest
+	}
+	return nil
+}
+
+func (v *view) parentsKnown(e dag.Event) bool {
+	for _, p := range e.Parents() {
+		if !v.input.HasEvent(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// process adds the event into the view, parents must be already known
+func (v *view) process(e *tdag.TestEvent) error {
+	v.input.SetEvent(e)
+	if err := v.lch.Process(e); err != nil {
+		return err
+	}
+	if v.err != nil {
+		return v.err
+	}
+	if head := v.heads[e.Creator()]; head == nil || head.Seq() < e.Seq() {
+		v.heads[e.Creator()] = e
+	}
+	return nil
+}
+
+// onFrameDecided is called by the Orderer before the decided frame is stored
+func (v *view) onFrameDecided(block *lachesis.Block) {
+	frame := v.store.GetLastDecidedFrame() + 1
+	electing := v.input.GetEvent(block.Electing)
+	if electing == nil {
+		v.crit(fmt.Errorf("electing root %s isn't found", block.Electing.String()))
+		return
+	}
+	v.decided = append(v.decided, Decision{
+		Res: election.Res{
+			Frame:   frame,
+			Atropos: block.Atropos,
+		},
+		Round: electing.Frame() - frame,
+	})
+}
@@ -0,0 +1,168 @@
+package election
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+type (
+	// Explanation describes how the Atropos of the election frame is chosen.
+	// Intended for display and auditing, it isn't used by the election itself.
+	Explanation struct {
+		Frame idx.Frame
+		// Decided is true if the Atropos is chosen
+		Decided          bool
+		Atropos          hash.Event
+		AtroposValidator idx.ValidatorID
+		// Subjects are listed in the order in which chooseAtropos checks them
+		Subjects []SubjectExplanation
+		// Reason is a human readable summary of why the Atropos is (not yet) chosen
+		Reason string
+	}
+
+	// SubjectExplanation describes the votes for a root slot of a validator at the election frame
+	SubjectExplanation struct {
+		Validator idx.ValidatorID
+		Weight    pos.Weight
+
+		Decided bool
+		Yes     bool
+		// Root is the observed root of the subject, zero if the votes are "no"
+		Root hash.Event
+		// Round is the round of the decisive vote if decided, or the latest round of votes otherwise
+		Round idx.Frame
+		// DecidedBy is the root which has made the decision, nil if not decided
+		DecidedBy *RootAndSlot
+		// Voters are the previous round roots whose votes are counted by DecidedBy, nil if not decided.
+		// For not decided subjects, only YesWeight and NoWeight of the latest round are reported.
+		Voters    []VoterExplanation
+		YesWeight pos.Weight
+		NoWeight  pos.Weight
+
+		// Chosen is true if the subject's root is the Atropos
+		Chosen bool
+	}
+
+	// VoterExplanation is a vote of a root for the subject
+	VoterExplanation struct {
+		Root   RootAndSlot
+		Weight pos.Weight
+		Yes    bool
+	}
+)
+
+// Explain describes the current election state: for each subject validator, in the order of chooseAtropos,
+// how its root is voted and decided, and why the Atropos is chosen.
+// Voters of decided subjects are found with ForklessCauseFn and GetFrameRootsFn, so the DAG of the election must be available.
+func (el *Election) Explain() (*Explanation, error) {
+	res, err := el.chooseAtropos()
+	if err != nil {
+		return nil, err
+	}
+
+	ex := &Explanation{
+		Frame:    el.frameToDecide,
+		Subjects: make([]SubjectExplanation, 0, el.validators.Len()),
+	}
+	var (
+		rejected []idx.ValidatorID
+		pending  *idx.ValidatorID
+	)
+	for i, validator := range el.validators.SortedIDs() {
+		sub := el.explainSubject(validator)
+		sub.Weight = el.validators.GetWeightByIdx(idx.Validator(i))
+
+		if !ex.Decided && pending == nil {
+			switch {
+			case !sub.Decided:
+				pending = &sub.Validator
+			case sub.Yes:
+				sub.Chosen = true
+				ex.Decided = true
+				ex.Atropos = sub.Root
+				ex.AtroposValidator = sub.Validator
+			default:
+				rejected = append(rejected, sub.Validator)
+			}
+		}
+		ex.Subjects = append(ex.Subjects, sub)
+	}
+	if res != nil && (!ex.Decided || res.Atropos != ex.Atropos) {
+		// sanity check
+		return nil, fmt.Errorf("explained Atropos %s mismatches the chosen one %s", ex.Atropos.String(), res.Atropos.String())
+	}
+
+	switch {
+	case ex.Decided && len(rejected) == 0:
+		ex.Reason = fmt.Sprintf("validator %d is the first in order of weight, and its root is decided as 'yes'", ex.AtroposValidator)
+	case ex.Decided:
+		ex.Reason = fmt.Sprintf("validator %d is the first in order of weight whose root is decided as 'yes', the roots of preceding validators %v are decided as 'no'", ex.AtroposValidator, rejected)
+	default:
+		ex.Reason = fmt.Sprintf("not decided: the root of validator %d must be decided first, the roots of preceding validators %v are decided as 'no'", *pending, rejected)
+	}
+	return ex, nil
+}
+
+// explainSubject collects the votes for the subject
+func (el *Election) explainSubject(validator idx.ValidatorID) SubjectExplanation {
+	sub := SubjectExplanation{
+		Validator: validator,
+	}
+	decided, ok := el.decidedRoots[validator]
+	if !ok {
+		round, yesVotes, noVotes, observedRoot := el.latestVotes(validator)
+		sub.Round = round
+		sub.YesWeight = yesVotes.Sum()
+		sub.NoWeight = noVotes.Sum()
+		if sub.YesWeight != 0 {
+			sub.Root = observedRoot
+		}
+		return sub
+	}
+	sub.Decided = true
+	sub.Yes = decided.yes
+	if decided.yes {
+		sub.Root = decided.observedRoot
+	}
+
+	// only a single root makes the decision, as decided subjects aren't voted for anymore
+	for vid, vote := range el.votes {
+		if vid.forValidator == validator && vote.decided {
+			decidedBy := vid.fromRoot
+			sub.DecidedBy = &decidedBy
+			break
+		}
+	}
+	if sub.DecidedBy == nil {
+		return sub
+	}
+	sub.Round = sub.DecidedBy.Slot.Frame - el.frameToDecide
+
+	yesVotes := el.validators.NewCounter()
+	noVotes := el.validators.NewCounter()
+	for _, observedRoot := range el.observedRoots(sub.DecidedBy.ID, sub.DecidedBy.Slot.Frame-1) {
+		vote, ok := el.votes[voteID{
+			fromRoot:     observedRoot,
+			forValidator: validator,
+		}]
+		if !ok {
+			continue
+		}
+		sub.Voters = append(sub.Voters, VoterExplanation{
+			Root:   observedRoot,
+			Weight: el.validators.Get(observedRoot.Slot.Validator),
+			Yes:    vote.yes,
+		})
+		if vote.yes {
+			yesVotes.Count(observedRoot.Slot.Validator)
+		} else {
+			noVotes.Count(observedRoot.Slot.Validator)
+		}
+	}
+	sub.YesWeight = yesVotes.Sum()
+	sub.NoWeight = noVotes.Sum()
+	return sub
+}
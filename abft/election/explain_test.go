@@ -0,0 +1,82 @@
+package election
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// checkExplanation checks that the explanation is consistent with the election result
+func checkExplanation(t *testing.T, el *Election, res *Res, ex *Explanation) {
+	t.Helper()
+	assertar := assert.New(t)
+
+	assertar.Equal(el.frameToDecide, ex.Frame)
+	assertar.Equal(res != nil, ex.Decided)
+	assertar.NotEmpty(ex.Reason)
+	if res != nil {
+		assertar.Equal(res.Atropos, ex.Atropos)
+	}
+	if !assertar.Equal(el.validators.SortedIDs(), subjectIDs(ex)) {
+		return
+	}
+
+	chosen := 0
+	beforeAtropos := true
+	for _, sub := range ex.Subjects {
+		assertar.Equal(el.validators.Get(sub.Validator), sub.Weight)
+		if sub.Chosen {
+			chosen++
+			assertar.True(sub.Decided)
+			assertar.True(sub.Yes)
+			assertar.Equal(ex.Atropos, sub.Root)
+			assertar.Equal(ex.AtroposValidator, sub.Validator)
+			beforeAtropos = false
+		} else if beforeAtropos && ex.Decided {
+			// all the preceding subjects are decided as 'no'
+			assertar.True(sub.Decided)
+			assertar.False(sub.Yes)
+		}
+		if !sub.Decided {
+			assertar.Nil(sub.DecidedBy)
+			assertar.Empty(sub.Voters)
+			continue
+		}
+		// decision is made by a supermajority of the previous round voters
+		if assertar.NotNil(sub.DecidedBy) {
+			assertar.Equal(el.frameToDecide+sub.Round, sub.DecidedBy.Slot.Frame)
+		}
+		assertar.GreaterOrEqual(sub.Round, idx.Frame(2))
+		yes, no := el.validators.NewCounter(), el.validators.NewCounter()
+		for _, voter := range sub.Voters {
+			assertar.Equal(sub.DecidedBy.Slot.Frame-1, voter.Root.Slot.Frame)
+			if voter.Yes {
+				yes.Count(voter.Root.Slot.Validator)
+			} else {
+				no.Count(voter.Root.Slot.Validator)
+			}
+		}
+		assertar.Equal(yes.Sum(), sub.YesWeight)
+		assertar.Equal(no.Sum(), sub.NoWeight)
+		if sub.Yes {
+			assertar.True(yes.HasQuorum())
+		} else {
+			assertar.True(no.HasQuorum())
+		}
+	}
+	if ex.Decided {
+		assertar.Equal(1, chosen)
+	} else {
+		assertar.Zero(chosen)
+	}
+}
+
+func subjectIDs(ex *Explanation) []idx.ValidatorID {
+	ids := make([]idx.ValidatorID, len(ex.Subjects))
+	for i, sub := range ex.Subjects {
+		ids[i] = sub.Validator
+	}
+	return ids
+}
@@ -0,0 +1,272 @@
+package election
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+type (
+	// State is a serializable snapshot of the election state.
+	// It's encoded into RLP as a plain struct, and into JSON with human-readable hashes.
+	State struct {
+		FrameToDecide idx.Frame
+		Validators    *pos.Validators
+		Votes         []Vote
+		DecidedRoots  []DecidedRoot
+		// QuorumRule is the quorum rule of validators, which isn't a part of their encoding.
+		// The zero value is pos.DefaultQuorumRule.
+		QuorumRule pos.QuorumRule `rlp:"optional"`
+	}
+
+	// Vote is a vote of a root for a subject validator
+	Vote struct {
+		From         RootAndSlot
+		ForValidator idx.ValidatorID
+		Decided      bool
+		Yes          bool
+		ObservedRoot hash.Event
+	}
+
+	// DecidedRoot is a decided root slot of the subject validator at the election frame
+	DecidedRoot struct {
+		Validator    idx.ValidatorID
+		Decided      bool
+		Yes          bool
+		ObservedRoot hash.Event
+	}
+)
+
+// State returns a snapshot of the election state.
+// Votes and decided roots are sorted, so equal states have equal encodings.
+func (el *Election) State() *State {
+	s := &State{
+		FrameToDecide: el.frameToDecide,
+		Validators:    el.validators,
+		Votes:         make([]Vote, 0, len(el.votes)),
+		DecidedRoots:  make([]DecidedRoot, 0, len(el.decidedRoots)),
+	}
+	if rule := el.validators.QuorumRule(); rule != pos.DefaultQuorumRule {
+		s.QuorumRule = rule
+	}
+	for vid, vote := range el.votes {
+		s.Votes = append(s.Votes, Vote{
+			From:         vid.fromRoot,
+			ForValidator: vid.forValidator,
+			Decided:      vote.decided,
+			Yes:          vote.yes,
+			ObservedRoot: vote.observedRoot,
+		})
+	}
+	for validator, vote := range el.decidedRoots {
+		s.DecidedRoots = append(s.DecidedRoots, DecidedRoot{
+			Validator:    validator,
+			Decided:      vote.decided,
+			Yes:          vote.yes,
+			ObservedRoot: vote.observedRoot,
+		})
+	}
+
+	sort.Slice(s.Votes, func(i, j int) bool {
+		a, b := s.Votes[i], s.Votes[j]
+		if a.From.Slot.Frame != b.From.Slot.Frame {
+			return a.From.Slot.Frame < b.From.Slot.Frame
+		}
+		if a.From.Slot.Validator != b.From.Slot.Validator {
+			return a.From.Slot.Validator < b.From.Slot.Validator
+		}
+		if a.From.ID != b.From.ID {
+			return bytes.Compare(a.From.ID.Bytes(), b.From.ID.Bytes()) < 0
+		}
+		return a.ForValidator < b.ForValidator
+	})
+	sort.Slice(s.DecidedRoots, func(i, j int) bool {
+		return s.DecidedRoots[i].Validator < s.DecidedRoots[j].Validator
+	})
+	return s
+}
+
+// Load rebuilds the election from the state, bound to the specified external world
+func Load(
+	s *State,
+	forklessCauseFn ForklessCauseFn,
+	getFrameRoots GetFrameRootsFn,
+) (*Election, error) {
+	if s.Validators == nil || s.Validators.Len() == 0 {
+		return nil, fmt.Errorf("no validators in election state")
+	}
+	if err := s.QuorumRule.Validate(true); err != nil {
+		return nil, err
+	}
+	validators := s.Validators
+	if s.QuorumRule != (pos.QuorumRule{}) {
+		validators = validators.WithQuorumRule(s.QuorumRule)
+	}
+	el := New(validators, s.FrameToDecide, forklessCauseFn, getFrameRoots)
+
+	for _, v := range s.Votes {
+		if !s.Validators.Exists(v.ForValidator) {
+			return nil, fmt.Errorf("vote for unknown validator %d", v.ForValidator)
+		}
+		if v.From.Slot.Frame <= s.FrameToDecide {
+			return nil, fmt.Errorf("vote from root %s of frame %d isn't above election frame %d", v.From.ID.String(), v.From.Slot.Frame, s.FrameToDecide)
+		}
+		vid := voteID{
+			fromRoot:     v.From,
+			forValidator: v.ForValidator,
+		}
+		if _, ok := el.votes[vid]; ok {
+			return nil, fmt.Errorf("duplicate vote from root %s for validator %d", v.From.ID.String(), v.ForValidator)
+		}
+		el.saveVote(vid, voteValue{
+			decided:      v.Decided,
+			yes:          v.Yes,
+			observedRoot: v.ObservedRoot,
+		})
+	}
+	for _, d := range s.DecidedRoots {
+		if !s.Validators.Exists(d.Validator) {
+			return nil, fmt.Errorf("decided root of unknown validator %d", d.Validator)
+		}
+		if _, ok := el.decidedRoots[d.Validator]; ok {
+			return nil, fmt.Errorf("duplicate decided root of validator %d", d.Validator)
+		}
+		el.decidedRoots[d.Validator] = voteValue{
+			decided:      d.Decided,
+			yes:          d.Yes,
+			observedRoot: d.ObservedRoot,
+		}
+	}
+	return el, nil
+}
+
+type (
+	jsonState struct {
+		FrameToDecide idx.Frame       `json:"frameToDecide"`
+		Validators    []jsonValidator `json:"validators"`
+		Votes         []jsonVote      `json:"votes"`
+		DecidedRoots  []jsonDecided   `json:"decidedRoots"`
+		QuorumRule    *pos.QuorumRule `json:"quorumRule,omitempty"`
+	}
+
+	jsonValidator struct {
+		ID     idx.ValidatorID `json:"id"`
+		Weight pos.Weight      `json:"weight"`
+	}
+
+	jsonRoot struct {
+		ID        hash.Hash       `json:"id"`
+		Frame     idx.Frame       `json:"frame"`
+		Validator idx.ValidatorID `json:"validator"`
+	}
+
+	jsonVote struct {
+		From         jsonRoot        `json:"from"`
+		ForValidator idx.ValidatorID `json:"forValidator"`
+		Decided      bool            `json:"decided"`
+		Yes          bool            `json:"yes"`
+		ObservedRoot hash.Hash       `json:"observedRoot"`
+	}
+
+	jsonDecided struct {
+		Validator    idx.ValidatorID `json:"validator"`
+		Decided      bool            `json:"decided"`
+		Yes          bool            `json:"yes"`
+		ObservedRoot hash.Hash       `json:"observedRoot"`
+	}
+)
+
+// MarshalJSON is for JSON serialization.
+func (s *State) MarshalJSON() ([]byte, error) {
+	js := jsonState{
+		FrameToDecide: s.FrameToDecide,
+		Validators:    []jsonValidator{},
+		Votes:         make([]jsonVote, len(s.Votes)),
+		DecidedRoots:  make([]jsonDecided, len(s.DecidedRoots)),
+	}
+	if s.QuorumRule != (pos.QuorumRule{}) {
+		js.QuorumRule = &s.QuorumRule
+	}
+	if s.Validators != nil {
+		for i, id := range s.Validators.SortedIDs() {
+			js.Validators = append(js.Validators, jsonValidator{
+				ID:     id,
+				Weight: s.Validators.GetWeightByIdx(idx.Validator(i)),
+			})
+		}
+	}
+	for i, v := range s.Votes {
+		js.Votes[i] = jsonVote{
+			From: jsonRoot{
+				ID:        hash.Hash(v.From.ID),
+				Frame:     v.From.Slot.Frame,
+				Validator: v.From.Slot.Validator,
+			},
+			ForValidator: v.ForValidator,
+			Decided:      v.Decided,
+			Yes:          v.Yes,
+			ObservedRoot: hash.Hash(v.ObservedRoot),
+		}
+	}
+	for i, d := range s.DecidedRoots {
+		js.DecidedRoots[i] = jsonDecided{
+			Validator:    d.Validator,
+			Decided:      d.Decided,
+			Yes:          d.Yes,
+			ObservedRoot: hash.Hash(d.ObservedRoot),
+		}
+	}
+	return json.Marshal(js)
+}
+
+// UnmarshalJSON is for JSON deserialization.
+func (s *State) UnmarshalJSON(input []byte) error {
+	var js jsonState
+	if err := json.Unmarshal(input, &js); err != nil {
+		return err
+	}
+
+	builder := pos.NewBuilder()
+	for _, v := range js.Validators {
+		builder.Set(v.ID, v.Weight)
+	}
+	*s = State{
+		FrameToDecide: js.FrameToDecide,
+		Validators:    builder.Build(),
+		Votes:         make([]Vote, len(js.Votes)),
+		DecidedRoots:  make([]DecidedRoot, len(js.DecidedRoots)),
+	}
+	if js.QuorumRule != nil {
+		s.QuorumRule = *js.QuorumRule
+		s.Validators = s.Validators.WithQuorumRule(s.QuorumRule)
+	}
+	for i, v := range js.Votes {
+		s.Votes[i] = Vote{
+			From: RootAndSlot{
+				ID: hash.Event(v.From.ID),
+				Slot: Slot{
+					Frame:     v.From.Frame,
+					Validator: v.From.Validator,
+				},
+			},
+			ForValidator: v.ForValidator,
+			Decided:      v.Decided,
+			Yes:          v.Yes,
+			ObservedRoot: hash.Event(v.ObservedRoot),
+		}
+	}
+	for i, d := range js.DecidedRoots {
+		s.DecidedRoots[i] = DecidedRoot{
+			Validator:    d.Validator,
+			Decided:      d.Decided,
+			Yes:          d.Yes,
+			ObservedRoot: hash.Event(d.ObservedRoot),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package election
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// reloadElection saves the election state into RLP and JSON, and loads it back
+func reloadElection(t *testing.T, el *Election, forklessCauseFn ForklessCauseFn, getFrameRootsFn GetFrameRootsFn) *Election {
+	t.Helper()
+	require := require.New(t)
+
+	b, err := rlp.EncodeToBytes(el.State())
+	require.NoError(err)
+	fromRlp := &State{}
+	require.NoError(rlp.DecodeBytes(b, fromRlp))
+
+	j, err := json.Marshal(fromRlp)
+	require.NoError(err)
+	fromJson := &State{}
+	require.NoError(json.Unmarshal(j, fromJson))
+	require.Equal(el.State(), fromJson)
+
+	loaded, err := Load(fromJson, forklessCauseFn, getFrameRootsFn)
+	require.NoError(err)
+	return loaded
+}
+
+func TestState(t *testing.T) {
+	require := require.New(t)
+
+	validators := pos.ArrayToValidators([]idx.ValidatorID{1, 2, 3}, []pos.Weight{3, 2, 1})
+	root := func(n byte, frame idx.Frame, validator idx.ValidatorID) RootAndSlot {
+		return RootAndSlot{
+			ID:   hash.Event{n},
+			Slot: Slot{Frame: frame, Validator: validator},
+		}
+	}
+	el := New(validators, 1, nil, nil)
+	el.votes[voteID{root(1, 2, 1), 2}] = voteValue{yes: true, observedRoot: hash.Event{4}}
+	el.votes[voteID{root(1, 2, 1), 1}] = voteValue{yes: false}
+	el.votes[voteID{root(2, 3, 2), 1}] = voteValue{decided: true, yes: true, observedRoot: hash.Event{5}}
+	el.decidedRoots[3] = voteValue{decided: true, yes: false}
+
+	s := el.State()
+	require.Equal(idx.Frame(1), s.FrameToDecide)
+	require.Equal([]Vote{
+		{From: root(1, 2, 1), ForValidator: 1},
+		{From: root(1, 2, 1), ForValidator: 2, Yes: true, ObservedRoot: hash.Event{4}},
+		{From: root(2, 3, 2), ForValidator: 1, Decided: true, Yes: true, ObservedRoot: hash.Event{5}},
+	}, s.Votes)
+	require.Equal([]DecidedRoot{{Validator: 3, Decided: true}}, s.DecidedRoots)
+
+	loaded := reloadElection(t, el, nil, nil)
+	require.Equal(el.DebugStateHash(), loaded.DebugStateHash())
+	require.Equal(el.String(nil), loaded.String(nil))
+	require.Equal(validators.SortedIDs(), loaded.validators.SortedIDs())
+	require.Equal(validators.SortedWeights(), loaded.validators.SortedWeights())
+
+	// inconsistent states are rejected
+	broken := el.State()
+	broken.Votes[0].ForValidator = 4
+	_, err := Load(broken, nil, nil)
+	require.Error(err)
+
+	broken = el.State()
+	broken.Votes[0].From.Slot.Frame = 1
+	_, err = Load(broken, nil, nil)
+	require.Error(err)
+
+	broken = el.State()
+	broken.DecidedRoots = append(broken.DecidedRoots, broken.DecidedRoots[0])
+	_, err = Load(broken, nil, nil)
+	require.Error(err)
+
+	broken = el.State()
+	broken.Validators = pos.NewBuilder().Build()
+	_, err = Load(broken, nil, nil)
+	require.Error(err)
+
+	broken = el.State()
+	broken.QuorumRule = pos.QuorumRule{Numerator: 1}
+	_, err = Load(broken, nil, nil)
+	require.Error(err)
+
+	// the quorum rule of validators is kept
+	rule, err := pos.NewQuorumRule(3, 4)
+	require.NoError(err)
+	el = New(validators.WithQuorumRule(rule), 1, nil, nil)
+	el.decidedRoots[3] = voteValue{decided: true, yes: false}
+	require.Equal(rule, el.State().QuorumRule)
+	loaded = reloadElection(t, el, nil, nil)
+	require.Equal(rule, loaded.validators.QuorumRule())
+	require.Equal(el.DebugStateHash(), loaded.DebugStateHash())
+}
@@ -0,0 +1,402 @@
+package emissionsim
+
+// geographicLatencyData returns the mean and the standard deviation of latencies (in milliseconds) between pairs of cities
+func geographicLatencyData() (meanLatency [][]float64, stdLatency [][]float64) {
+	meanLatency = [][]float64{
+		{0.027, 155.2935, 256.06949999999995, 248.59, 243.616, 176.28449999999998, 289.29999999999995, 213.9065, 216.341, 104.142, 128.443, 85.85050000000001, 79.622, 142.1565, 243.573, 96.328, 208.2955, 247.687, 283.4625, 163.88049999999998, 192.556, 218.612, 205.12900000000002, 230.15699999999998, 277.58950000000004, 142.8265, 221.43900000000002, 252.4445, 316.12300000000005, 135.49349999999998, 145.383, 100.8785, 238.1205, 204.897, 247.77499999999998, 364.2605, 243.0695, 236.64, 171.0635, 90.453, 114.258, 142.07549999999998, 195.16649999999998, 191.635, 169.993, 341.981, 392.98699999999997, 264.346, 333.532, 203.7335, 220.0195, 265.4275, 266.794, 326.68899999999996, 385.121, 232.4925, 166.6635, 354.299, 132.74, 242.67950000000002, 246.903, 147.8175, 142.73000000000002, 109.97399999999999, 165.4885, 184.8005, 363.8455, 141.85750000000002, 276.1165, 224.563, 89.537, 111.92250000000001, 148.03199999999998, 149.96050000000002, 236.4805, 319.655, 152.348, 81.319, 141.76850000000002, 193.909, 318.394, 151.825, 143.88049999999998, 89.2205, 341.623, 332.652, 366.2545, 154.253, 299.7715, 297.55449999999996, 361.40549999999996, 282.517, 118.23349999999999, 116.515, 93.431, 239.735, 127.96199999999999, 377.84749999999997, 304.2095, 272.05949999999996, 104.69300000000001, 109.16550000000001, 325.9605, 160.5515, 175.365, 186.361, 173.65949999999998, 116.039, 124.17, 267.3465, 379.8675, 227.837, 181.2995, 173.25150000000002, 308.64, 201.5435, 159.5115, 307.629, 199.3235, 197.36599999999999, 97.93299999999999, 151.2965, 215.1835, 305.1915, 173.28449999999998, 323.9625, 97.3045, 334.57950000000005, 266.3775, 123.125, 115.61, 113.21549999999999, 176.61849999999998, 422.6995, 216.1805, 243.964, 202.4405, 221.19, 250.47899999999998, 262.218, 198.55, 174.9665, 250.149, 229.8545, 216.59199999999998, 224.5285, 243.02, 196.6825, 223.9265, 243.61149999999998, 220.55450000000002, 345.7015, 227.7165, 260.961, 215.216, 263.786, 208.5455, 198.66500000000002, 154.9805, 147.7, 360.79949999999997, 181.62400000000002, 156.289, 267.75699999999995, 178.27499999999998, 168.53949999999998, 145.83550000000002, 153.8715, 311.171, 145.804, 251.674, 145.047, 243.577, 72.29050000000001, 199.3775, 242.661, 127.87700000000001, 309.631, 240.19400000000002, 229.128, 218.353, 136.9175, 269.76099999999997, 130.774, 110.4055, 175.151, 148.06349999999998, 209.40699999999998, 161.80700000000002, 307.291, 222.188, 216.1115, 334.91499999999996, 254.2135, 113.6235},
+		{156.0205, 0.0415, 111.559, 92.54750000000001, 178.45999999999998, 94.917, 228.47699999999998, 114.411, 89.4975, 46.067, 12.794, 20.7785, 41.997, 21.011, 108.55850000000001, 26.418, 96.868, 108.9, 224.56900000000002, 54.7765, 109.709, 107.072, 126.7185, 106.013, 94.7895, 66.72149999999999, 128.64800000000002, 127.041, 247.488, 69.6495, 37.852, 20.8555, 95.911, 123.1635, 111.3335, 222.9315, 123.9325, 126.0155, 99.6315, 176.5025, 47.974, 8.2735, 51.241, 99.04599999999999, 37.0005, 222.9805, 254.4615, 115.331, 219.2595, 114.2315, 107.106, 142.084, 153.1975, 280.688, 260.59299999999996, 137.2095, 17.687, 240.373, 69.1935, 132.7125, 97.922, 81.06, 25.868000000000002, 23.109, 56.034499999999994, 27.806, 227.35, 51.626000000000005, 144.39100000000002, 96.028, 41.3095, 19.469, 36.269999999999996, 64.94, 113.762, 198.9345, 19.8365, 40.8895, 96.17699999999999, 16.054000000000002, 219.219, 18.4075, 125.658, 26.9875, 223.267, 252.35500000000002, 186.2425, 13.9515, 184.348, 147.30700000000002, 244.177, 166.5255, 39.234, 34.005, 37.571, 116.9545, 13.764, 256.8375, 188.1585, 80.012, 22.9645, 30.134, 205.908, 46.081500000000005, 22.352, 60.4985, 45.933, 31.249000000000002, 25.880499999999998, 111.665, 242.98000000000002, 104.9555, 115.96350000000001, 93.1435, 126.988, 87.3065, 98.23599999999999, 178.815, 121.15350000000001, 98.786, 21.162, 60.58, 119.0, 230.5745, 51.929500000000004, 263.2425, 15.492, 185.5235, 251.293, 74.89500000000001, 22.548499999999997, 17.2575, 100.071, 263.46349999999995, 97.51050000000001, 109.3785, 117.735, 90.3395, 142.04399999999998, 135.05450000000002, 90.3005, 97.3715, 99.445, 112.822, 148.99450000000002, 115.99799999999999, 125.50450000000001, 78.9855, 146.089, 115.3485, 121.07149999999999, 250.091, 105.8815, 112.83449999999999, 95.9295, 140.966, 93.744, 96.598, 95.6575, 14.765, 213.792, 92.28450000000001, 76.59549999999999, 152.17149999999998, 89.4675, 96.7475, 18.2125, 41.956, 287.475, 89.97149999999999, 102.6075, 17.333, 108.9365, 141.84449999999998, 111.719, 131.53449999999998, 217.692, 174.914, 133.05200000000002, 97.8965, 151.697, 22.723, 145.75, 11.8315, 22.111, 107.0155, 105.03999999999999, 89.3195, 100.0035, 181.5455, 103.54650000000001, 122.84700000000001, 216.822, 168.797, 27.5675},
+		{256.16499999999996, 111.7315, 0.019, 23.4195, 271.405, 24.2075, 280.2845, 35.801, 27.121499999999997, 142.13400000000001, 104.676, 95.9855, 130.8075, 103.368, 43.6575, 115.13900000000001, 39.826, 1.9264999999999999, 295.5105, 174.84300000000002, 45.001000000000005, 19.9685, 32.893, 22.772, 13.6045, 170.8955, 14.1195, 26.0955, 264.13599999999997, 164.622, 136.893, 106.483, 32.1715, 62.765, 36.41, 229.46, 7.634, 39.474000000000004, 185.601, 260.88599999999997, 132.73950000000002, 102.10849999999999, 170.8545, 45.2535, 133.44799999999998, 138.803, 258.7745, 44.559, 322.2445, 24.014499999999998, 18.477, 65.7065, 83.2965, 190.94549999999998, 350.5565, 34.817499999999995, 98.9205, 260.943, 162.3535, 68.0945, 34.8735, 172.1105, 119.95150000000001, 120.47149999999999, 176.4915, 113.11449999999999, 156.07549999999998, 144.0785, 78.366, 39.6325, 125.60900000000001, 126.8945, 137.836, 179.2055, 39.8985, 293.61350000000004, 112.2195, 130.769, 167.824, 121.959, 330.90200000000004, 126.8495, 197.934, 122.2555, 144.73700000000002, 315.4315, 293.5675, 94.85, 96.5205, 33.1025, 206.494, 248.589, 138.74599999999998, 145.495, 124.152, 121.3805, 97.479, 205.692, 136.267, 204.3885, 127.1335, 135.43900000000002, 306.256, 149.5865, 93.7875, 171.3985, 148.29899999999998, 126.6825, 98.9185, 0.6445000000000001, 186.68, 13.256499999999999, 209.628, 18.200000000000003, 14.446, 32.028999999999996, 18.1025, 294.9, 15.0425, 18.694499999999998, 106.6645, 188.14749999999998, 40.57, 323.413, 143.39249999999998, 209.974, 99.644, 87.3125, 186.6355, 152.3425, 96.3185, 119.66300000000001, 33.480999999999995, 138.4965, 37.973, 18.25, 17.433500000000002, 35.55200000000001, 69.9905, 18.881500000000003, 25.877499999999998, 25.6845, 24.351, 10.356000000000002, 54.0145, 24.143, 55.966, 30.459, 58.235, 38.7545, 25.5215, 211.2335, 15.0745, 9.708, 191.058, 26.274, 24.761499999999998, 24.929000000000002, 23.551499999999997, 101.68100000000001, 137.81650000000002, 27.9105, 175.66, 30.352, 33.9465, 23.992, 124.078, 129.311, 270.66999999999996, 100.247, 25.7035, 99.531, 15.059, 233.0235, 36.9185, 44.9035, 122.667, 61.647, 46.4945, 27.682000000000002, 54.2835, 105.96000000000001, 76.315, 108.9915, 143.314, 42.6405, 198.4115, 38.1775, 19.552500000000002, 129.048, 40.8405, 11.245999999999999, 244.977, 68.0255, 126.276},
+		{248.60399999999998, 92.546, 23.569, 0.045, 235.17700000000002, 10.257, 280.179, 31.03, 8.9865, 114.20599999999999, 74.525, 77.409, 108.6805, 79.1785, 22.639, 91.575, 17.6655, 22.058, 299.07, 148.2765, 39.2315, 23.750999999999998, 17.2155, 43.6675, 10.6795, 148.5625, 30.932499999999997, 48.3955, 243.8955, 144.7005, 107.9625, 84.49549999999999, 19.17, 44.185, 33.1455, 206.5935, 27.64, 36.113, 163.0925, 238.01350000000002, 113.633, 83.25, 143.504, 16.864, 105.1105, 142.26, 267.2335, 26.5885, 293.2695, 30.6615, 8.3835, 66.661, 62.287, 174.777, 262.0085, 51.559, 74.0325, 259.745, 142.337, 46.188, 13.797, 152.075, 95.3695, 89.583, 149.9915, 90.279, 137.7165, 124.13499999999999, 69.0745, 17.639499999999998, 103.89150000000001, 99.97999999999999, 102.801, 144.102, 18.0635, 272.195, 80.502, 108.943, 164.531, 90.7585, 303.01149999999996, 100.5145, 170.34449999999998, 102.2345, 130.65, 299.10699999999997, 273.5975, 79.221, 89.542, 60.5025, 165.9175, 231.36149999999998, 111.62049999999999, 117.9435, 99.5195, 106.719, 78.219, 184.49599999999998, 127.703, 176.45850000000002, 91.39, 102.6275, 279.351, 122.8055, 71.8665, 146.40300000000002, 122.5985, 99.6565, 82.8775, 23.448999999999998, 177.606, 33.832499999999996, 186.926, 13.405000000000001, 41.4795, 10.1055, 13.209, 258.5835, 37.447, 11.8915, 84.936, 160.66649999999998, 44.119, 295.277, 116.66900000000001, 270.2285, 73.631, 91.3665, 169.43349999999998, 129.12150000000003, 74.4, 88.4745, 7.515, 124.433, 17.8925, 12.887, 20.374499999999998, 13.5425, 62.837500000000006, 44.779, 11.677, 14.9025, 15.0805, 31.539, 49.765, 26.741500000000002, 45.4685, 8.5655, 52.221999999999994, 35.477999999999994, 44.3605, 196.227, 17.2865, 25.9105, 167.7045, 54.0115, 15.291, 15.6085, 9.74, 80.3495, 138.853, 10.905999999999999, 151.4935, 57.6295, 12.065, 15.4875, 98.603, 101.19999999999999, 171.4515, 76.826, 19.31, 77.3725, 22.942500000000003, 229.601, 15.2025, 30.863, 100.703, 81.9045, 43.295, 11.567499999999999, 47.710499999999996, 84.9875, 54.8725, 86.008, 116.5135, 20.4355, 178.66449999999998, 16.865000000000002, 15.250499999999999, 107.174, 19.538, 37.982, 182.33350000000002, 88.332, 99.658},
+		{243.62849999999997, 178.453, 271.565, 235.168, 0.038, 240.2475, 198.647, 247.6845, 217.076, 146.8535, 176.13150000000002, 182.16649999999998, 178.57850000000002, 170.3195, 237.8825, 165.33550000000002, 255.0645, 233.38400000000001, 130.416, 143.602, 240.94150000000002, 233.9025, 270.5895, 225.17450000000002, 221.77, 108.1585, 248.7615, 255.46699999999998, 78.9065, 114.9185, 149.75, 172.0085, 245.45299999999997, 273.3945, 242.389, 259.0865, 240.40800000000002, 248.6805, 227.685, 308.984, 154.412, 138.0545, 120.465, 255.3945, 138.851, 246.003, 78.59100000000001, 254.738, 266.7555, 264.601, 240.3635, 283.7815, 280.8055, 101.2885, 110.051, 288.054, 183.71550000000002, 50.1, 117.424, 261.5435, 229.417, 123.3835, 187.7625, 153.674, 85.51650000000001, 155.09550000000002, 102.96950000000001, 138.851, 292.1355, 235.265, 178.239, 150.34550000000002, 170.5475, 119.203, 229.9475, 32.9815, 183.827, 165.433, 216.019, 172.2695, 288.657, 152.36849999999998, 243.32999999999998, 172.555, 133.156, 70.362, 33.3095, 184.57049999999998, 296.3295, 321.23199999999997, 379.49850000000004, 8.2375, 158.61, 113.905, 171.3285, 255.3125, 179.87349999999998, 420.87850000000003, 341.33950000000004, 173.9795, 145.545, 131.0625, 254.42849999999999, 170.0015, 185.6045, 143.11599999999999, 136.95850000000002, 130.69400000000002, 178.34300000000002, 282.7735, 114.6935, 225.12650000000002, 78.676, 262.78, 255.2585, 213.9395, 224.7305, 18.3025, 248.166, 267.972, 197.025, 90.491, 207.6845, 68.178, 146.905, 128.48000000000002, 139.6275, 299.70950000000005, 411.50149999999996, 159.93349999999998, 170.35649999999998, 177.3785, 256.403, 166.082, 233.594, 230.497, 277.472, 233.8725, 262.6015, 257.605, 241.91, 246.641, 257.19500000000005, 231.3515, 325.545, 249.363, 257.207, 207.03, 316.601, 270.9425, 255.6645, 86.39699999999999, 228.9355, 259.635, 243.589, 312.6945, 220.6775, 230.7975, 250.284, 178.338, 245.084, 218.8465, 125.39, 292.899, 238.588, 226.4085, 138.554, 148.49349999999998, 76.12700000000001, 177.137, 234.292, 188.052, 230.11450000000002, 318.53150000000005, 240.645, 253.62349999999998, 323.1955, 284.56, 274.3495, 222.343, 291.2955, 178.77800000000002, 292.1645, 193.3865, 123.747, 236.2545, 251.28199999999998, 227.66500000000002, 226.466, 328.12149999999997, 227.251, 250.7725, 129.9725, 279.65999999999997, 200.20299999999997},
+		{176.7285, 94.7695, 24.246, 10.2975, 240.21949999999998, 0.048, 273.605, 24.39, 6.8155, 118.0635, 76.514, 81.5995, 118.642, 84.134, 31.722, 96.89349999999999, 20.249499999999998, 19.034, 290.6385, 147.04, 29.897, 17.49, 22.258499999999998, 24.4595, 13.859, 135.2215, 27.383000000000003, 46.131, 167.56099999999998, 139.07850000000002, 107.363, 82.072, 10.8155, 41.4925, 27.1745, 156.9255, 29.2365, 34.412499999999994, 160.4685, 237.8425, 118.8255, 141.659, 149.77800000000002, 23.4015, 106.95949999999999, 145.966, 264.221, 38.568, 298.5605, 40.0505, 10.713999999999999, 62.054500000000004, 70.1405, 203.3955, 213.659, 41.856, 86.553, 239.394, 147.11450000000002, 50.033, 11.9055, 141.6575, 100.2115, 98.332, 134.626, 92.2985, 148.2165, 126.672, 69.7775, 17.099, 100.0435, 106.952, 106.485, 146.416, 26.5365, 258.673, 87.969, 116.4075, 153.574, 101.4645, 313.705, 100.804, 171.78699999999998, 99.4525, 132.325, 221.00400000000002, 272.644, 78.6065, 104.13650000000001, 58.092, 183.8555, 247.2895, 121.4625, 113.0945, 108.05600000000001, 21.1005, 81.5935, 174.961, 108.1325, 167.9325, 97.209, 97.7185, 283.408, 121.3015, 72.3095, 136.73700000000002, 111.6785, 110.3165, 79.4685, 24.1485, 259.84000000000003, 45.424, 177.3605, 5.3725000000000005, 32.6005, 9.004999999999999, 12.262, 267.149, 34.650999999999996, 16.788, 92.03200000000001, 154.8875, 58.126000000000005, 251.0855, 116.0535, 288.3255, 77.875, 115.2825, 182.4915, 137.166, 87.7915, 100.45349999999999, 10.499, 204.793, 16.9485, 53.616, 17.439, 12.8525, 61.563500000000005, 46.75749999999999, 3.2255000000000003, 5.323499999999999, 3.248, 29.2575, 55.555, 22.602, 43.826, 6.8614999999999995, 57.5145, 29.866500000000002, 34.897999999999996, 263.54999999999995, 16.174999999999997, 59.710499999999996, 162.98950000000002, 50.816, 6.808, 3.125, 2.425, 87.0745, 142.744, 5.843500000000001, 158.97, 54.8305, 12.501, 4.4455, 105.17699999999999, 102.6245, 181.024, 85.02850000000001, 18.618499999999997, 84.482, 70.4925, 219.4075, 24.816000000000003, 45.0045, 240.27100000000002, 81.831, 40.909000000000006, 11.899000000000001, 48.287499999999994, 88.19800000000001, 66.27000000000001, 91.728, 100.0975, 21.038, 176.72750000000002, 11.814, 12.208, 104.55199999999999, 26.515, 27.142000000000003, 127.0295, 95.274, 103.24000000000001},
+		{289.097, 228.17950000000002, 280.057, 280.003, 198.37849999999997, 273.3975, 0.039, 290.1335, 263.342, 190.189, 220.768, 227.44150000000002, 305.779, 216.5675, 260.40250000000003, 204.22250000000003, 293.51149999999996, 297.692, 40.668, 190.4495, 316.15200000000004, 289.356, 291.141, 309.212, 282.72900000000004, 245.04500000000002, 304.653, 301.9295, 160.7835, 158.011, 192.58749999999998, 214.93, 276.876, 316.7905, 294.446, 326.27200000000005, 297.249, 294.201, 345.257, 353.54499999999996, 256.994, 290.2745, 185.4615, 298.824, 268.21900000000005, 168.12900000000002, 127.945, 273.48900000000003, 15.631499999999999, 286.154, 293.8935, 324.74350000000004, 310.8075, 273.2455, 176.988, 309.413, 363.022, 151.6605, 235.31099999999998, 304.35350000000005, 272.71450000000004, 165.65, 365.265, 283.7895, 239.73000000000002, 304.1885, 149.3155, 184.2305, 327.44550000000004, 277.4115, 298.32399999999996, 204.33999999999997, 270.801, 172.5605, 273.533, 171.35399999999998, 228.778, 294.992, 258.7545, 215.6935, 46.396, 335.683, 291.5645, 215.1105, 184.89499999999998, 248.26749999999998, 319.9665, 219.469, 273.822, 327.24699999999996, 443.816, 143.38400000000001, 281.794, 258.437, 290.988, 286.43100000000004, 218.762, 440.941, 283.8125, 218.9375, 341.666, 281.2365, 1.424, 288.544, 294.6055, 271.7105, 280.98900000000003, 274.9175, 223.61599999999999, 292.35699999999997, 169.824, 307.49800000000005, 247.9715, 280.42650000000003, 303.741, 270.6995, 276.0905, 204.9265, 299.3425, 299.30949999999996, 221.4085, 248.394, 343.774, 263.052, 220.28949999999998, 70.2055, 285.89099999999996, 362.5635, 438.6675, 305.6995, 293.48699999999997, 222.3755, 295.779, 215.166, 287.1725, 263.305, 279.9355, 276.63800000000003, 320.7265, 302.18449999999996, 274.8345, 278.27049999999997, 302.89, 289.5615, 312.94, 277.9175, 304.5575, 290.9575, 325.3885, 315.196, 307.413, 146.8385, 273.65049999999997, 301.38750000000005, 351.4635, 310.3385, 287.291, 276.85249999999996, 284.87649999999996, 221.3795, 154.0855, 270.3025, 312.46500000000003, 320.6555, 280.892, 287.846, 285.9945, 313.615, 169.6515, 229.94299999999998, 262.4925, 300.147, 262.8845, 363.6515, 277.313, 284.293, 416.4155, 332.703, 304.307, 268.7215, 325.159, 221.90449999999998, 318.77750000000003, 297.79499999999996, 268.1095, 276.74699999999996, 0.0, 269.202, 283.3715, 395.2255, 277.7335, 294.012, 297.467, 331.3185, 309.7815},
+		{214.838, 114.318, 35.9005, 31.044, 247.75, 24.3095, 290.22450000000003, 0.039, 29.7075, 139.3365, 93.52950000000001, 101.476, 142.92849999999999, 106.37049999999999, 55.0135, 120.7015, 41.2515, 43.047, 311.90250000000003, 153.45549999999997, 6.5545, 10.374500000000001, 37.341, 47.086, 27.610500000000002, 158.065, 45.299, 59.8535, 191.313, 165.087, 145.1575, 102.7255, 36.933, 62.673500000000004, 7.4115, 178.013, 41.3515, 15.581, 180.44549999999998, 276.647, 143.1825, 104.119, 160.7065, 39.5005, 126.19200000000001, 173.3095, 284.7845, 65.727, 316.5865, 59.048, 33.438, 83.6395, 92.2085, 212.8515, 304.79999999999995, 62.0245, 102.872, 208.522, 165.03199999999998, 68.78550000000001, 33.5915, 165.233, 124.88, 117.6365, 155.25900000000001, 113.24, 171.87599999999998, 144.0315, 87.96549999999999, 40.548500000000004, 139.139, 121.0515, 124.0085, 165.6145, 53.8915, 285.637, 108.3105, 136.6855, 193.53699999999998, 121.293, 327.4085, 120.94149999999999, 227.0805, 119.744, 161.72449999999998, 320.227, 256.51599999999996, 98.943, 104.44800000000001, 69.8655, 201.442, 251.2265, 144.4375, 132.563, 128.92149999999998, 10.512, 99.02600000000001, 202.916, 144.1845, 182.74, 112.344, 119.7235, 303.82349999999997, 141.845, 100.50049999999999, 163.6075, 133.07, 131.0085, 103.24600000000001, 35.716499999999996, 199.127, 46.298500000000004, 198.011, 24.884999999999998, 66.001, 33.239000000000004, 36.692, 236.4915, 53.4135, 37.540000000000006, 115.0735, 159.29149999999998, 7.67, 271.529, 132.22250000000003, 292.315, 94.65350000000001, 78.2075, 191.30349999999999, 160.265, 101.7875, 116.4795, 38.448, 222.019, 42.134, 35.809, 41.159, 32.41, 84.6195, 68.9845, 22.551, 26.4745, 24.154, 38.0295, 75.4885, 52.125, 77.833, 26.5925, 79.9855, 44.048500000000004, 63.546499999999995, 222.5745, 33.5125, 37.19, 187.575, 62.449, 24.2225, 22.539499999999997, 24.7895, 103.83500000000001, 167.8615, 25.545, 188.196, 74.39250000000001, 35.212, 26.389499999999998, 175.14749999999998, 205.00349999999997, 276.44849999999997, 99.94149999999999, 40.5045, 102.9975, 35.25, 254.935, 46.328500000000005, 71.332, 122.1045, 80.7015, 62.736999999999995, 26.3335, 67.42699999999999, 111.11, 87.761, 108.696, 120.6325, 39.792500000000004, 211.414, 34.1175, 34.423500000000004, 126.1325, 48.879999999999995, 54.8625, 161.524, 60.086, 126.024},
+		{216.35899999999998, 89.4405, 27.127000000000002, 8.8875, 217.0215, 6.9625, 263.625, 29.711, 0.037, 111.0885, 71.36699999999999, 75.96600000000001, 98.942, 78.1335, 30.062, 88.21600000000001, 11.9785, 28.333, 295.1395, 131.904, 34.408, 17.363999999999997, 26.936999999999998, 33.146, 13.4715, 134.5595, 30.1385, 49.233000000000004, 191.07, 130.17950000000002, 108.5145, 77.47, 11.657, 50.831500000000005, 34.968500000000006, 141.95, 28.4125, 29.0155, 153.2165, 231.738, 110.508, 84.4735, 138.52300000000002, 11.957, 98.45, 189.237, 191.33749999999998, 40.329, 287.7075, 49.512, 15.739, 64.8455, 59.284499999999994, 247.99099999999999, 215.177, 45.790499999999994, 70.7235, 212.749, 136.762, 38.14, 6.904, 150.7055, 85.513, 92.18549999999999, 139.90699999999998, 88.64150000000001, 149.204, 119.09299999999999, 63.7255, 12.104, 98.0655, 92.7415, 98.327, 137.531, 23.8675, 270.00350000000003, 78.9505, 103.084, 157.1635, 84.4125, 307.946, 92.931, 172.341, 90.7875, 141.402, 260.404, 269.839, 69.429, 91.4315, 62.349500000000006, 162.42950000000002, 236.0215, 112.10050000000001, 108.6705, 98.7065, 27.3795, 69.10499999999999, 172.85899999999998, 176.544, 162.1445, 96.69, 90.947, 273.727, 125.9965, 70.314, 168.2475, 117.5215, 101.5825, 78.60249999999999, 26.9745, 227.70600000000002, 33.941, 190.321, 12.283000000000001, 40.429, 1.45, 13.9525, 251.4735, 43.812, 17.2715, 81.2135, 155.075, 55.739999999999995, 245.31799999999998, 108.1445, 268.201, 72.03049999999999, 94.3695, 156.573, 136.12349999999998, 77.767, 87.8055, 14.295, 146.51, 10.456, 26.618000000000002, 26.701999999999998, 5.702500000000001, 66.8925, 43.946, 7.334, 8.993500000000001, 6.7405, 36.0245, 57.845, 31.351, 42.535, 10.3235, 64.0355, 39.4835, 56.1275, 200.7375, 20.2455, 25.5955, 152.4, 55.9875, 9.625499999999999, 8.733, 5.868, 73.192, 148.8095, 12.197, 157.8605, 64.03450000000001, 4.479, 8.5445, 89.72149999999999, 104.8635, 321.2615, 70.4345, 18.796, 76.084, 24.810499999999998, 227.059, 21.307000000000002, 39.9705, 96.81049999999999, 82.0555, 45.4815, 10.997, 58.2645, 80.3775, 62.176, 83.525, 95.17699999999999, 13.0615, 172.7725, 4.1215, 16.113, 97.411, 12.2005, 33.93, 188.735, 83.57900000000001, 97.797},
+		{104.1725, 45.9815, 141.9545, 114.303, 146.82150000000001, 118.232, 190.42849999999999, 139.33749999999998, 111.14150000000001, 0.048, 44.527, 49.4745, 35.7395, 38.06, 136.8155, 24.036, 125.397, 136.0045, 186.7785, 61.506, 136.5475, 131.336, 143.8555, 134.544, 121.208, 38.157, 141.10750000000002, 153.5385, 206.466, 33.4685, 7.164, 38.001999999999995, 121.7115, 147.9235, 139.96949999999998, 268.63199999999995, 140.35399999999998, 147.195, 84.6865, 162.0455, 11.629, 41.364000000000004, 61.802, 123.94200000000001, 12.173, 247.6225, 251.6165, 148.109, 184.6735, 152.82150000000001, 124.81, 171.1905, 182.796, 281.43899999999996, 210.53699999999998, 154.3995, 42.197, 190.566, 34.515, 158.83100000000002, 115.72, 43.838, 36.839, 37.548500000000004, 58.700500000000005, 56.5255, 250.451, 35.492000000000004, 173.84199999999998, 123.02250000000001, 38.199, 18.5795, 25.717, 45.811499999999995, 139.1085, 170.543, 38.2545, 42.549, 73.3755, 28.070500000000003, 214.9445, 23.0105, 106.446, 30.227, 267.531, 196.4335, 166.99, 44.1805, 200.8, 178.1175, 284.94399999999996, 138.78, 10.9895, 42.748999999999995, 30.4285, 135.1725, 40.429500000000004, 266.0615, 215.60899999999998, 98.9005, 33.987, 33.843, 172.574, 16.2095, 49.619, 39.0275, 25.1595, 20.587, 40.8905, 141.2615, 258.6475, 135.135, 78.85300000000001, 124.1965, 151.16899999999998, 107.968, 121.701, 153.9895, 150.596, 130.123, 36.7365, 64.741, 141.10750000000002, 203.3825, 54.5105, 224.9975, 44.512, 206.80700000000002, 269.2785, 29.753, 49.836, 40.0505, 121.2065, 246.09550000000002, 117.636, 133.05849999999998, 135.499, 114.7745, 177.704, 159.45, 114.41650000000001, 128.52249999999998, 116.2345, 140.367, 162.0455, 135.5575, 162.8395, 106.848, 175.13850000000002, 142.945, 159.2125, 232.151, 132.4665, 145.959, 90.8425, 171.97899999999998, 121.991, 126.2945, 117.822, 42.944, 238.75, 118.076, 49.637, 172.9755, 110.2915, 114.59899999999999, 45.1825, 46.5165, 218.92000000000002, 48.563, 137.088, 45.5235, 131.92849999999999, 163.1615, 127.57849999999999, 153.63299999999998, 209.75900000000001, 188.5075, 155.322, 123.27250000000001, 158.7145, 40.36, 170.6495, 53.2055, 34.591499999999996, 122.9855, 104.7065, 114.0855, 123.1405, 204.4915, 123.0615, 140.7695, 258.201, 183.17149999999998, 51.907},
+		{128.38, 12.646, 104.69399999999999, 74.467, 176.05450000000002, 76.4515, 220.889, 93.499, 71.322, 44.4555, 0.037, 9.050999999999998, 32.807, 9.126, 104.037, 21.228, 78.70949999999999, 95.523, 213.8545, 75.7045, 104.368, 90.7635, 94.596, 114.327, 82.52199999999999, 69.223, 97.874, 130.08550000000002, 235.667, 68.326, 39.320499999999996, 9.124, 78.54249999999999, 110.386, 106.95349999999999, 218.17149999999998, 107.8065, 108.97649999999999, 86.293, 164.708, 44.3765, 8.743500000000001, 62.8925, 86.6505, 32.315, 248.849, 268.7395, 110.6705, 219.023, 138.5285, 81.206, 128.5195, 129.65550000000002, 259.25300000000004, 294.64149999999995, 129.23250000000002, 4.2435, 253.687, 65.7015, 120.2445, 80.624, 75.9015, 20.02, 19.106, 77.842, 18.3455, 214.7455, 51.309, 128.1125, 82.919, 29.664, 26.7735, 30.332, 72.8965, 95.0865, 199.7125, 10.060500000000001, 34.427499999999995, 89.354, 19.2345, 239.48149999999998, 25.448, 102.62100000000001, 20.717, 249.9075, 227.8105, 186.8965, 1.5354999999999999, 164.91750000000002, 131.6045, 226.3345, 173.393, 40.5025, 46.026, 30.569499999999998, 104.8535, 2.2744999999999997, 243.91699999999997, 183.9075, 105.084, 23.335, 31.5505, 207.18400000000003, 48.072500000000005, 8.432, 65.18700000000001, 43.3805, 28.254, 5.169499999999999, 99.816, 258.89099999999996, 102.304, 106.288, 80.31, 117.82499999999999, 70.1555, 89.235, 183.5575, 111.911, 86.0975, 13.461, 105.857, 116.896, 225.421, 43.719, 257.81399999999996, 1.9895, 156.48149999999998, 229.54399999999998, 54.814499999999995, 11.1205, 16.747, 82.70349999999999, 209.4645, 79.2635, 83.93299999999999, 92.811, 73.673, 137.2025, 115.2685, 79.8315, 86.99799999999999, 73.993, 106.007, 126.8475, 98.2075, 117.2, 67.5005, 133.4685, 103.472, 126.044, 261.42, 97.93299999999999, 101.92250000000001, 89.0745, 124.374, 75.7935, 75.7225, 75.447, 4.244, 212.965, 83.5935, 87.46000000000001, 131.9755, 71.465, 81.08449999999999, 88.525, 101.6245, 233.9725, 8.188500000000001, 90.4615, 8.375, 95.428, 156.4985, 93.2565, 102.1765, 164.5405, 159.2525, 114.975, 84.172, 119.5035, 12.2625, 129.2645, 12.8105, 33.345, 81.883, 110.476, 74.167, 91.04400000000001, 163.43400000000003, 89.8485, 99.9385, 193.686, 150.279, 29.422},
+		{86.0235, 20.573999999999998, 96.0025, 77.27350000000001, 182.02949999999998, 81.5445, 227.7545, 101.455, 75.9405, 49.191, 9.0685, 0.23249999999999998, 34.509, 11.9765, 105.3455, 23.454, 85.363, 112.1235, 221.0775, 66.794, 104.4855, 93.1945, 105.5605, 98.858, 97.89850000000001, 70.0975, 110.3015, 142.3725, 246.55349999999999, 69.422, 36.782, 14.5035, 80.5595, 117.1095, 106.7425, 276.9295, 113.47550000000001, 112.44200000000001, 90.2585, 161.644, 42.9125, 18.131, 75.365, 88.7815, 32.742000000000004, 219.8195, 268.52099999999996, 112.7425, 221.65800000000002, 123.58850000000001, 97.4065, 142.615, 140.1255, 273.688, 247.44799999999998, 141.258, 9.84, 220.533, 75.888, 126.155, 89.6095, 79.774, 26.5645, 25.306, 64.445, 15.494, 256.054, 57.283, 139.4355, 94.2755, 32.062, 29.1685, 33.5755, 77.2045, 105.75450000000001, 202.406, 18.4695, 37.676, 75.32300000000001, 23.7725, 228.87650000000002, 27.623, 103.9865, 25.4255, 221.07999999999998, 221.659, 197.965, 6.7509999999999994, 168.92700000000002, 131.91, 245.079, 170.56099999999998, 48.696, 41.1425, 37.058, 110.2175, 6.714, 245.3385, 181.8105, 103.34, 25.759500000000003, 29.7215, 209.2765, 45.679500000000004, 2.1304999999999996, 65.47399999999999, 46.695499999999996, 40.436499999999995, 9.785, 95.63, 278.176, 97.642, 112.1115, 86.4795, 109.755, 75.61449999999999, 85.341, 202.0145, 121.5565, 92.13499999999999, 18.383, 70.086, 111.49549999999999, 214.3395, 51.588, 262.25199999999995, 6.051, 167.749, 246.0745, 60.506, 4.477, 21.427999999999997, 86.61850000000001, 256.79049999999995, 83.0555, 108.5475, 100.131, 74.84049999999999, 146.0265, 130.7185, 80.9795, 93.63749999999999, 81.8815, 104.2285, 130.0935, 105.44800000000001, 115.38499999999999, 72.96000000000001, 133.15, 105.366, 136.5735, 281.8825, 105.213, 103.555, 91.78450000000001, 124.965, 79.6255, 86.9365, 92.6945, 11.566, 241.602, 83.75800000000001, 89.90549999999999, 128.6565, 81.3365, 82.6565, 27.5265, 41.2485, 239.6775, 11.528500000000001, 95.7175, 7.8025, 108.3475, 136.2845, 102.8665, 123.2225, 246.8535, 173.305, 119.36449999999999, 85.748, 121.977, 18.054499999999997, 134.7025, 18.5305, 31.1005, 91.8835, 107.2005, 81.872, 87.63900000000001, 164.31799999999998, 95.274, 117.21350000000001, 293.358, 162.945, 33.082},
+		{79.6925, 42.006, 130.8435, 108.666, 178.59050000000002, 118.753, 306.12649999999996, 142.9265, 98.993, 35.754000000000005, 32.8815, 34.590999999999994, 0.042, 27.133499999999998, 115.364, 16.4375, 121.275, 131.0025, 280.5045, 86.14150000000001, 128.8445, 131.507, 124.836, 126.52850000000001, 107.4605, 70.8485, 137.719, 153.364, 243.1685, 64.8555, 33.563, 32.1505, 115.5215, 139.12349999999998, 132.45850000000002, 281.4255, 125.4495, 145.312, 55.7985, 132.6335, 36.879999999999995, 44.366, 76.5795, 130.862, 41.6685, 250.0865, 247.8535, 116.541, 213.9135, 125.4745, 113.589, 160.7885, 166.25850000000003, 250.203, 290.933, 155.92000000000002, 29.813000000000002, 250.909, 61.2595, 137.326, 110.577, 71.47399999999999, 43.4515, 38.2875, 74.09299999999999, 49.966, 246.79199999999997, 85.608, 158.087, 110.3005, 9.8245, 42.997, 42.093500000000006, 76.9965, 150.954, 199.096, 40.86, 6.288, 71.1595, 34.754000000000005, 240.393, 37.352000000000004, 62.7775, 20.439, 262.975, 226.05349999999999, 193.299, 27.3455, 195.127, 168.256, 265.676, 170.3415, 41.123000000000005, 50.058499999999995, 41.105000000000004, 133.3875, 31.8875, 268.9995, 211.19299999999998, 114.9105, 42.8305, 40.198499999999996, 206.173, 36.2135, 38.762, 71.9545, 46.9045, 44.3675, 35.3205, 129.4415, 274.8745, 128.0595, 107.0385, 109.6545, 132.97500000000002, 105.42349999999999, 111.969, 188.624, 132.156, 119.106, 30.823, 78.439, 153.8905, 213.44299999999998, 67.12, 264.1515, 33.162, 201.85500000000002, 271.223, 62.811499999999995, 43.006, 40.7775, 110.6645, 282.40250000000003, 107.8985, 117.775, 125.608, 108.245, 170.51299999999998, 146.56150000000002, 115.96350000000001, 108.39150000000001, 114.06649999999999, 137.1335, 154.881, 131.4, 141.43099999999998, 100.8985, 168.542, 134.178, 135.3405, 260.528, 123.33, 135.582, 61.678, 158.732, 111.71799999999999, 111.1865, 110.2995, 34.170500000000004, 236.1805, 109.485, 86.165, 169.9795, 107.796, 110.4065, 44.289, 59.519999999999996, 236.175, 38.7255, 116.636, 37.513, 130.9075, 121.7525, 119.52000000000001, 135.9555, 187.926, 197.4975, 144.7165, 109.73, 141.35899999999998, 30.000500000000002, 159.486, 42.9845, 40.027, 116.739, 85.273, 103.164, 114.7065, 199.7425, 113.672, 136.9595, 271.9715, 190.28500000000003, 38.117999999999995},
+		{133.266, 20.951, 102.509, 86.5175, 171.272, 84.1845, 234.618, 116.5145, 76.3955, 37.476, 9.152, 11.885, 27.21, 0.019, 95.2775, 12.515, 95.465, 103.298, 231.406, 68.386, 111.232, 97.2305, 101.4605, 107.2615, 97.28999999999999, 74.2855, 117.3075, 128.273, 263.192, 63.277, 28.1035, 7.3025, 88.964, 113.28450000000001, 107.5395, 211.3775, 114.51599999999999, 122.2425, 86.094, 164.516, 36.135000000000005, 23.031, 68.3155, 104.12950000000001, 43.2655, 224.25799999999998, 260.5555, 115.648, 208.3835, 115.951, 88.3475, 151.286, 133.45, 271.923, 283.447, 124.22149999999999, 8.1925, 216.5505, 72.662, 131.334, 89.2975, 83.2715, 11.7895, 24.2295, 70.291, 38.211, 227.7215, 57.2325, 142.885, 89.0085, 20.238, 35.7115, 30.502499999999998, 71.453, 93.9055, 190.358, 14.156, 34.179, 62.4615, 23.431, 231.405, 27.2275, 85.415, 14.47, 220.38299999999998, 249.674, 196.347, 7.146, 170.3405, 143.0355, 260.75199999999995, 184.026, 39.3865, 49.275999999999996, 33.57, 108.23249999999999, 8.215499999999999, 257.538, 184.6865, 99.358, 18.9825, 31.241500000000002, 197.029, 44.0715, 15.117, 66.5, 48.2825, 39.2775, 10.142, 102.2235, 243.43349999999998, 106.237, 106.30350000000001, 104.401, 123.3725, 75.3415, 92.2415, 174.28, 124.313, 88.18299999999999, 7.304, 74.6565, 126.449, 221.23000000000002, 50.162499999999994, 267.3625, 6.8585, 177.3795, 255.3515, 69.2775, 16.224, 10.1725, 94.712, 267.422, 87.232, 98.21549999999999, 95.3555, 83.436, 146.2975, 123.3115, 82.3595, 92.29400000000001, 93.24, 103.254, 138.2255, 104.0515, 133.2615, 90.463, 132.5685, 114.3935, 121.41300000000001, 270.18550000000005, 97.7795, 106.271, 81.70500000000001, 136.34449999999998, 99.81899999999999, 113.065, 95.32249999999999, 14.288499999999999, 225.60199999999998, 87.284, 91.276, 136.4505, 77.2825, 85.789, 23.2275, 23.2225, 241.4075, 81.2385, 100.636, 15.1075, 96.28450000000001, 221.131, 99.28, 117.2795, 209.41250000000002, 161.506, 119.52600000000001, 98.2135, 114.3, 9.134, 132.575, 17.275, 39.249, 109.5375, 91.7675, 79.17, 94.221, 171.473, 90.944, 113.0245, 204.485, 168.80450000000002, 28.240000000000002},
+		{243.571, 108.5215, 43.6905, 22.628, 237.92849999999999, 31.714, 260.6225, 55.022999999999996, 30.122999999999998, 136.83499999999998, 104.10249999999999, 105.4685, 115.364, 95.24549999999999, 0.029, 111.699, 34.977, 25.447, 262.70550000000003, 161.243, 50.986000000000004, 39.8555, 43.332, 36.525999999999996, 24.819499999999998, 155.9685, 40.7535, 58.936499999999995, 172.55, 156.7575, 121.06549999999999, 100.503, 43.391000000000005, 16.003, 52.1965, 171.136, 42.746, 68.696, 177.2215, 232.892, 125.16900000000001, 102.7535, 167.185, 29.647, 121.646, 161.159, 293.07000000000005, 14.0525, 302.788, 49.6115, 27.423499999999997, 73.4195, 45.528499999999994, 211.531, 199.972, 72.3075, 101.2075, 314.8815, 155.55149999999998, 71.079, 38.7, 158.14, 114.1615, 106.631, 162.10000000000002, 116.8425, 174.5505, 152.9245, 82.8315, 39.4195, 116.134, 145.6825, 124.86250000000001, 165.416, 8.855, 259.24850000000004, 105.14500000000001, 125.271, 196.488, 115.382, 315.70050000000003, 121.0255, 196.529, 107.9075, 149.036, 266.2655, 275.245, 100.89, 113.19550000000001, 80.35050000000001, 160.57150000000001, 224.766, 129.01, 140.606, 121.871, 49.480999999999995, 101.38, 169.1535, 142.097, 201.2485, 119.693, 125.1215, 288.359, 140.7785, 101.771, 165.6395, 135.88150000000002, 129.49849999999998, 104.3, 43.47, 187.71699999999998, 35.44, 205.469, 31.677999999999997, 50.445499999999996, 26.1535, 28.0, 227.782, 52.42, 27.5475, 107.0505, 180.178, 60.054, 307.996, 136.5115, 190.56650000000002, 95.929, 118.811, 164.413, 162.09, 104.523, 106.5245, 25.944000000000003, 189.6255, 35.235, 33.716499999999996, 24.554499999999997, 34.451, 71.02250000000001, 63.419, 33.260999999999996, 42.0805, 30.707, 35.9705, 83.811, 44.738, 66.7465, 34.920500000000004, 71.332, 46.9005, 51.668, 273.237, 31.503, 62.985, 186.413, 73.8895, 44.2515, 34.6305, 33.065, 106.8205, 183.6415, 32.891999999999996, 179.628, 82.0445, 28.1435, 35.66, 117.16550000000001, 119.116, 173.80450000000002, 106.41550000000001, 21.2655, 101.631, 32.308, 222.7645, 31.898, 53.0195, 228.42000000000002, 101.9745, 48.786500000000004, 31.094, 32.921, 103.1545, 71.5055, 109.5005, 122.8065, 38.659499999999994, 184.6465, 28.506, 29.313000000000002, 127.928, 36.0755, 45.695499999999996, 145.9265, 115.1265, 117.2285},
+		{96.3195, 26.360500000000002, 115.187, 91.5755, 165.2715, 96.7955, 204.4975, 120.671, 88.2895, 23.941499999999998, 21.2405, 23.628999999999998, 16.407, 12.943000000000001, 111.70849999999999, 0.028, 99.707, 112.628, 201.022, 73.6215, 119.897, 115.005, 114.785, 115.0255, 99.8485, 59.698, 113.875, 140.176, 218.2625, 51.013, 18.6965, 16.813000000000002, 100.574, 126.941, 118.112, 230.14999999999998, 113.9225, 129.1055, 76.345, 141.163, 27.854, 29.695, 59.953, 105.179, 26.257, 249.091, 259.8935, 106.86850000000001, 208.48649999999998, 110.8425, 101.18950000000001, 143.4025, 148.19650000000001, 238.18099999999998, 246.46550000000002, 134.05, 16.3285, 219.755, 48.6035, 122.857, 93.30600000000001, 56.3695, 31.5295, 20.7225, 67.477, 33.778, 230.671, 52.523, 147.39499999999998, 95.75049999999999, 11.8795, 22.884999999999998, 26.41, 60.323, 97.31, 179.2955, 26.9595, 17.878999999999998, 54.622, 21.2345, 225.6515, 21.654, 89.5985, 8.7265, 208.64, 210.696, 176.5445, 17.959, 172.97199999999998, 151.349, 249.238, 152.2585, 28.6435, 35.742000000000004, 22.172, 113.908, 19.363500000000002, 255.506, 193.446, 94.763, 12.105, 23.9135, 194.424, 33.291, 24.363500000000002, 64.843, 29.9685, 31.314500000000002, 24.149, 116.17850000000001, 245.913, 111.088, 95.7205, 96.09649999999999, 120.48349999999999, 86.055, 98.526, 165.458, 119.29249999999999, 102.7005, 19.2835, 76.4295, 138.423, 220.461, 70.0505, 239.3515, 18.6385, 197.39, 252.99099999999999, 37.792500000000004, 27.083, 25.689, 104.21549999999999, 266.875, 94.64699999999999, 112.631, 112.757, 94.4885, 141.3495, 131.59550000000002, 104.01599999999999, 95.91650000000001, 90.72999999999999, 110.75800000000001, 139.781, 117.316, 127.75200000000001, 86.99350000000001, 142.3535, 119.75399999999999, 132.64, 243.58100000000002, 108.5295, 109.1825, 78.12, 147.2355, 95.5815, 99.124, 96.472, 19.122999999999998, 242.83100000000002, 97.4845, 89.109, 151.372, 94.2435, 93.94300000000001, 29.383, 28.8775, 208.21550000000002, 23.111, 116.817, 24.059, 113.5115, 138.26749999999998, 108.4225, 121.062, 181.3685, 170.6815, 130.517, 95.032, 147.455, 19.7455, 143.68200000000002, 31.0155, 30.997500000000002, 102.7765, 85.995, 88.566, 100.2485, 186.5585, 97.6435, 123.5965, 208.0235, 168.9515, 31.1995},
+		{208.36, 96.9135, 39.97, 17.7035, 255.0845, 20.2605, 293.6245, 41.193, 12.123000000000001, 125.49350000000001, 78.7355, 85.5385, 121.3335, 95.7925, 35.0185, 99.6935, 0.051, 35.8655, 293.818, 152.17649999999998, 37.929500000000004, 31.817500000000003, 36.2695, 36.619, 25.504, 123.3955, 39.2335, 63.33, 210.261, 155.28050000000002, 120.4185, 96.582, 21.433, 56.814499999999995, 44.9525, 164.7305, 42.2885, 58.298, 157.887, 232.9115, 122.961, 74.5745, 167.20499999999998, 37.5975, 89.49000000000001, 148.60899999999998, 281.0665, 42.147999999999996, 299.25750000000005, 78.4465, 24.476, 70.47200000000001, 77.15549999999999, 250.673, 274.56550000000004, 88.8395, 80.268, 269.682, 154.243, 65.58500000000001, 8.9985, 140.5865, 108.991, 106.284, 155.75099999999998, 80.262, 159.11599999999999, 130.6535, 75.8965, 19.8825, 110.592, 105.1565, 124.479, 158.517, 33.996, 271.145, 87.0205, 103.626, 160.9, 96.513, 308.10799999999995, 120.396, 192.251, 107.2955, 161.15, 250.58800000000002, 283.42650000000003, 78.8945, 101.0835, 73.81, 153.4965, 237.6535, 117.346, 120.07300000000001, 116.4485, 46.685500000000005, 80.8825, 187.388, 131.2455, 181.38049999999998, 98.49799999999999, 86.735, 285.5525, 140.3555, 74.044, 138.41649999999998, 117.833, 101.664, 83.845, 39.807, 197.0615, 35.566500000000005, 165.4375, 21.4155, 61.8925, 13.354, 26.810000000000002, 270.794, 52.056, 27.8885, 95.812, 126.4855, 50.713, 293.053, 111.707, 298.7345, 69.217, 117.7435, 166.94150000000002, 135.6605, 73.0245, 94.22399999999999, 26.064500000000002, 216.426, 23.637, 28.772, 35.4215, 15.763, 69.62700000000001, 59.7825, 19.192, 20.7155, 18.884, 45.569, 67.53800000000001, 42.61, 54.1875, 10.2055, 74.686, 46.687, 60.775000000000006, 278.571, 32.6465, 38.042, 176.5315, 67.539, 16.835, 17.801000000000002, 18.552, 91.108, 161.5195, 17.558500000000002, 151.40300000000002, 78.521, 14.1135, 21.893, 116.588, 118.62049999999999, 265.781, 81.60650000000001, 34.649, 85.20949999999999, 36.292500000000004, 210.7125, 31.799999999999997, 47.657, 116.70750000000001, 90.157, 58.838499999999996, 22.674500000000002, 51.282, 102.613, 70.92349999999999, 89.9805, 101.9205, 3.6870000000000003, 184.397, 16.387999999999998, 28.73, 106.3595, 29.4195, 48.608000000000004, 169.44650000000001, 126.69749999999999, 117.662},
+		{247.768, 108.8245, 1.8935, 22.009500000000003, 233.34050000000002, 18.9845, 297.6975, 42.939, 28.3545, 135.86149999999998, 95.543, 112.151, 130.8775, 104.189, 25.389, 112.578, 35.8215, 0.026, 301.901, 173.6645, 30.1205, 33.3705, 27.497999999999998, 5.8614999999999995, 14.1975, 152.19650000000001, 30.8445, 41.6635, 165.45299999999997, 164.918, 130.345, 102.6265, 28.027500000000003, 55.014, 32.504, 166.175, 6.279999999999999, 35.9925, 170.0485, 259.164, 134.977, 98.531, 169.4615, 28.0355, 123.346, 155.98000000000002, 198.49900000000002, 38.288, 312.933, 17.1005, 16.6905, 66.0735, 72.69749999999999, 186.2005, 275.5985, 47.736, 96.8715, 194.2175, 162.648, 70.249, 30.1815, 156.324, 115.4865, 115.961, 162.114, 107.792, 144.654, 145.649, 71.459, 39.7555, 124.375, 125.40299999999999, 126.203, 173.321, 44.517, 292.6165, 108.822, 124.006, 185.925, 117.384, 329.8935, 118.3355, 188.122, 119.5335, 142.329, 254.69400000000002, 294.0605, 96.77, 118.666, 38.6275, 193.938, 263.9205, 138.22, 127.829, 126.9755, 41.695499999999996, 104.7055, 198.1735, 127.48949999999999, 194.44650000000001, 116.7445, 115.70949999999999, 294.47900000000004, 143.47, 89.2635, 157.2135, 132.51299999999998, 125.8845, 91.61099999999999, 1.7675, 182.78199999999998, 6.2065, 192.4595, 15.8355, 17.18, 24.927500000000002, 14.5725, 275.0885, 14.235, 16.7735, 109.10300000000001, 153.36599999999999, 38.709, 255.34199999999998, 136.503, 294.4965, 89.10149999999999, 107.814, 189.5985, 148.576, 107.429, 115.066, 14.092, 205.449, 38.6275, 15.907, 20.8615, 28.767, 72.0925, 28.235500000000002, 20.5845, 25.6615, 24.1675, 11.8795, 58.1445, 22.4385, 64.62049999999999, 23.559, 49.046499999999995, 26.0485, 15.2935, 276.00149999999996, 6.6905, 14.497499999999999, 180.937, 31.7655, 22.83, 20.5155, 23.869, 107.07, 155.9145, 24.181, 170.96699999999998, 37.9145, 29.478, 24.316000000000003, 122.1175, 125.9855, 270.60900000000004, 95.677, 15.849499999999999, 95.0245, 14.226, 251.703, 33.9405, 53.8265, 254.26, 75.6455, 45.067, 24.768, 60.381, 106.489, 72.11449999999999, 104.517, 115.112, 41.932, 198.8425, 31.4835, 15.895, 126.1805, 37.158500000000004, 30.872500000000002, 138.8185, 66.87, 126.714},
+		{283.47749999999996, 224.50799999999998, 295.4905, 299.00800000000004, 130.3655, 290.52, 40.9165, 311.8355, 295.1995, 186.393, 213.816, 221.11450000000002, 280.5995, 221.84, 262.624, 200.885, 293.7555, 302.0245, 0.053, 186.248, 298.723, 298.5265, 302.7155, 288.85749999999996, 303.1465, 224.25, 322.355, 335.422, 159.64, 154.465, 189.388, 213.986, 306.8905, 321.2905, 296.486, 320.017, 307.0835, 315.8905, 347.55949999999996, 348.03, 276.4115, 269.81, 182.21800000000002, 308.506, 247.7775, 169.0885, 130.151, 275.7075, 55.3445, 321.8935, 283.5615, 343.26700000000005, 341.055, 268.57349999999997, 177.59300000000002, 342.055, 353.4655, 153.953, 215.019, 329.9335, 289.5725, 227.156, 360.0615, 262.5075, 219.2855, 282.83299999999997, 151.54149999999998, 180.7185, 331.84450000000004, 298.1205, 275.7395, 203.121, 286.45849999999996, 211.428, 298.24199999999996, 227.20100000000002, 228.04250000000002, 274.5375, 292.60749999999996, 212.09199999999998, 38.065, 338.0425, 288.91999999999996, 211.695, 187.17950000000002, 242.961, 317.733, 220.008, 295.9425, 331.606, 465.46299999999997, 136.42849999999999, 267.03999999999996, 238.08800000000002, 269.76149999999996, 303.205, 217.268, 461.286, 285.5285, 215.63549999999998, 341.29200000000003, 260.7875, 43.329499999999996, 268.11400000000003, 277.80949999999996, 252.92700000000002, 260.5245, 253.56650000000002, 220.6345, 279.8035, 171.9195, 287.32050000000004, 227.6835, 282.6545, 336.4815, 294.7425, 298.36199999999997, 149.80599999999998, 313.86749999999995, 295.5485, 217.2415, 227.7665, 324.7685, 233.2435, 228.466, 72.32849999999999, 265.477, 372.529, 461.07, 208.8885, 274.349, 218.687, 297.433, 217.4215, 293.223, 265.31, 298.91049999999996, 291.11, 365.1735, 314.37, 293.9575, 308.823, 299.255, 308.2555, 321.7985, 280.0635, 323.897, 310.65099999999995, 330.738, 329.871, 323.6515, 143.813, 288.4825, 320.407, 365.6405, 322.4005, 276.95849999999996, 275.34000000000003, 289.8135, 216.418, 158.426, 269.8865, 313.471, 337.541, 300.76300000000003, 305.14, 260.187, 327.8935, 169.61149999999998, 261.88800000000003, 264.66999999999996, 282.0055, 263.6755, 369.32, 293.31899999999996, 299.0165, 410.82050000000004, 352.043, 323.1725, 303.8935, 330.898, 217.99, 353.293, 301.8425, 247.65050000000002, 298.087, 0.0, 288.7355, 307.5355, 401.19, 292.1245, 308.9905, 292.589, 345.75300000000004, 288.81550000000004},
+		{163.86450000000002, 54.591, 174.896, 148.248, 143.585, 147.02949999999998, 190.6755, 151.078, 131.96300000000002, 61.4975, 75.7195, 67.178, 86.059, 68.442, 161.2495, 73.5505, 152.0675, 173.67, 186.165, 0.013, 154.289, 167.20350000000002, 169.29000000000002, 149.6605, 147.9775, 19.287, 167.072, 198.3395, 172.7035, 32.879999999999995, 54.572, 68.534, 149.8285, 182.83350000000002, 157.88150000000002, 278.0875, 175.5115, 161.3275, 128.647, 203.49849999999998, 69.00399999999999, 58.0785, 4.6065000000000005, 150.1735, 41.789, 258.37850000000003, 190.363, 173.6905, 174.7005, 194.166, 154.361, 188.77100000000002, 203.0545, 207.637, 219.44600000000003, 217.3655, 76.676, 153.358, 35.871, 174.58249999999998, 141.8185, 38.617, 72.604, 51.9075, 4.9975000000000005, 73.638, 250.792, 39.9105, 194.92700000000002, 146.3655, 84.382, 50.3625, 61.459, 30.724, 148.30349999999999, 144.335, 62.215500000000006, 73.7745, 130.6035, 58.527, 158.892, 56.3825, 147.55599999999998, 73.464, 277.924, 166.2505, 154.923, 68.2115, 221.5975, 211.83100000000002, 314.13, 113.587, 50.667, 32.798, 68.36850000000001, 160.2905, 61.0765, 311.805, 263.821, 31.5895, 70.045, 50.7315, 156.7365, 72.9485, 78.63300000000001, 56.416, 55.715500000000006, 49.7455, 76.853, 174.791, 243.5375, 149.721, 70.8365, 159.73250000000002, 191.558, 140.57, 156.3055, 139.4235, 172.60399999999998, 157.312, 76.99350000000001, 9.379999999999999, 187.448, 152.81, 45.19, 224.6035, 58.302, 225.027, 305.05600000000004, 75.3765, 72.77799999999999, 66.3935, 154.982, 258.52, 144.531, 159.213, 166.7975, 144.1135, 201.3185, 197.4755, 158.9325, 138.123, 153.437, 169.392, 191.1935, 173.2175, 172.92200000000003, 124.7435, 203.427, 165.15550000000002, 183.9035, 226.29149999999998, 154.9445, 173.355, 138.22500000000002, 205.14749999999998, 146.969, 153.2305, 156.832, 71.2585, 274.364, 136.858, 41.1295, 209.423, 143.7055, 142.13600000000002, 66.973, 87.3775, 182.0915, 79.711, 164.12349999999998, 71.38650000000001, 175.12650000000002, 199.22899999999998, 153.76, 177.5385, 258.17100000000005, 231.3555, 188.4025, 138.981, 212.3235, 73.22749999999999, 215.3515, 72.476, 42.7205, 156.27800000000002, 155.834, 135.24099999999999, 158.4855, 235.441, 156.6245, 181.81150000000002, 246.453, 215.577, 61.8895},
+		{211.67950000000002, 109.7635, 45.192499999999995, 39.254, 240.939, 29.926000000000002, 316.369, 6.578, 34.4925, 136.613, 104.495, 104.6115, 128.9085, 114.51599999999999, 51.013, 119.9295, 37.954, 30.236, 298.7685, 154.38049999999998, 0.047, 27.4745, 39.8025, 37.096, 28.5395, 162.3495, 18.302, 39.1045, 180.078, 162.864, 143.478, 107.50200000000001, 42.251, 73.917, 7.0024999999999995, 224.987, 38.9335, 21.383499999999998, 196.1995, 269.322, 146.224, 109.4015, 171.331, 58.8435, 127.554, 163.5625, 191.8555, 66.577, 308.3445, 41.424499999999995, 34.707, 80.64099999999999, 90.374, 225.7775, 297.80899999999997, 64.8125, 109.13, 266.2755, 163.001, 75.239, 38.173, 154.4495, 128.1205, 117.28450000000001, 161.675, 122.1045, 199.4665, 159.0025, 83.4405, 40.3245, 138.6375, 122.814, 127.9725, 166.341, 56.216499999999996, 262.74649999999997, 111.974, 128.8825, 181.72, 127.283, 336.2895, 121.5375, 207.2575, 126.8995, 193.1825, 268.2235, 287.1035, 99.224, 125.892, 63.073, 197.086, 244.1405, 152.7385, 140.157, 134.38150000000002, 16.419, 98.897, 202.4065, 132.25799999999998, 185.95499999999998, 117.09049999999999, 127.24950000000001, 342.61, 141.8555, 107.6845, 173.1495, 137.772, 136.0455, 101.703, 44.963, 232.5625, 36.0255, 203.2585, 29.7135, 48.2805, 34.1215, 28.4245, 254.986, 52.583, 34.161, 109.4225, 165.6335, 26.182000000000002, 273.7385, 145.416, 280.92949999999996, 99.9765, 66.0685, 192.1385, 196.7025, 107.2685, 119.458, 52.352000000000004, 215.85399999999998, 40.556, 32.968, 33.259, 37.948, 93.65350000000001, 59.216, 26.9535, 33.537, 41.361000000000004, 35.802, 68.318, 39.6065, 56.7565, 31.133499999999998, 72.94149999999999, 24.299500000000002, 60.011, 229.4705, 34.985, 40.465999999999994, 204.6235, 71.9405, 28.0745, 36.694, 30.597, 102.582, 176.228, 29.2185, 189.7265, 73.672, 40.3285, 31.494999999999997, 120.241, 138.655, 205.303, 104.402, 32.301500000000004, 112.13300000000001, 32.7695, 233.571, 46.329, 66.134, 228.041, 89.21600000000001, 61.6805, 49.2705, 73.64150000000001, 107.4805, 87.729, 114.825, 128.3305, 48.6555, 212.14350000000002, 34.8335, 29.855, 127.82300000000001, 51.864999999999995, 43.1185, 230.363, 85.6485, 124.6465},
+		{218.5705, 107.06049999999999, 19.962, 23.7185, 233.85500000000002, 17.4435, 289.259, 10.3575, 16.927, 131.3345, 90.794, 93.217, 131.446, 101.1735, 39.814499999999995, 114.97399999999999, 31.7455, 33.3815, 298.455, 167.214, 27.3765, 0.035, 32.645, 44.799499999999995, 12.9905, 145.878, 37.965500000000006, 44.641, 173.554, 163.931, 125.4995, 104.96600000000001, 24.2085, 56.364, 27.781, 168.068, 31.909, 13.315000000000001, 169.81650000000002, 251.386, 139.347, 96.2835, 161.878, 33.6325, 115.7, 159.35, 355.64599999999996, 54.1845, 298.0525, 54.9815, 19.837, 73.664, 88.0435, 195.739, 280.134, 56.622, 90.937, 349.43899999999996, 154.919, 60.591, 27.372500000000002, 151.877, 106.3475, 104.267, 168.20499999999998, 105.349, 153.4555, 140.17149999999998, 73.039, 31.3435, 122.281, 111.22, 118.9555, 158.6335, 41.287, 278.88599999999997, 97.1545, 119.1695, 154.41500000000002, 113.8695, 335.13199999999995, 121.455, 189.5645, 105.8845, 158.1155, 251.107, 286.459, 99.88650000000001, 117.509, 51.649, 185.484, 225.742, 130.428, 129.565, 117.757, 9.538, 90.6635, 195.671, 129.335, 194.95499999999998, 113.65, 110.7525, 281.4925, 133.963, 85.5695, 147.6975, 129.3635, 120.63900000000001, 91.459, 19.991, 196.752, 34.897000000000006, 188.215, 13.1075, 50.322, 22.8495, 16.1375, 270.477, 49.412, 22.7845, 103.39150000000001, 150.52800000000002, 16.258499999999998, 259.5825, 128.3435, 281.5045, 92.737, 68.248, 183.11950000000002, 151.99599999999998, 90.07300000000001, 111.45349999999999, 21.7045, 212.0435, 31.232, 30.0805, 36.664500000000004, 27.476, 70.2585, 40.0505, 14.023, 16.4065, 13.035499999999999, 28.095, 53.446, 35.803, 47.382000000000005, 17.240000000000002, 61.2505, 31.0335, 56.3465, 223.8875, 19.9035, 17.9625, 176.78750000000002, 45.427499999999995, 14.2395, 15.306999999999999, 12.723500000000001, 91.37899999999999, 159.53050000000002, 17.4795, 164.372, 56.4965, 25.811999999999998, 16.6175, 113.67150000000001, 123.693, 267.5565, 92.273, 32.829, 91.4685, 27.0575, 218.386, 36.15, 53.418, 117.761, 91.119, 47.183499999999995, 18.572, 67.504, 107.6865, 75.738, 110.013, 111.8985, 31.683, 197.869, 23.997500000000002, 17.561500000000002, 118.3185, 35.653, 41.943, 196.5895, 82.64850000000001, 122.8765},
+		{205.09, 126.719, 32.881, 17.09, 270.674, 22.145, 291.288, 37.321, 26.926, 143.695, 94.56700000000001, 105.7, 124.76050000000001, 101.28049999999999, 43.292, 114.625, 36.1695, 27.5005, 302.01149999999996, 169.45749999999998, 39.7495, 32.684, 0.021, 26.4345, 16.459, 164.163, 32.010000000000005, 60.641000000000005, 155.7495, 163.48399999999998, 138.7105, 100.6995, 34.3185, 74.70400000000001, 40.629000000000005, 162.409, 35.5565, 48.7255, 175.96699999999998, 248.812, 144.2745, 99.0445, 160.55599999999998, 30.003, 134.0545, 220.89499999999998, 182.48700000000002, 59.248000000000005, 306.2595, 38.2795, 20.961, 70.3235, 68.82650000000001, 227.78699999999998, 324.90650000000005, 49.242000000000004, 97.06899999999999, 206.856, 159.36, 78.506, 39.635999999999996, 162.2855, 112.33, 112.2895, 154.9315, 108.9245, 157.261, 153.136, 73.9065, 36.3635, 125.5155, 120.19149999999999, 124.13, 164.8395, 59.8275, 291.3385, 104.795, 114.1345, 182.7045, 117.8365, 324.746, 118.49549999999999, 189.267, 119.084, 149.1375, 203.9205, 288.903, 97.976, 72.539, 66.5505, 157.55, 240.72449999999998, 146.0395, 138.0245, 123.18700000000001, 35.989000000000004, 99.13550000000001, 164.29399999999998, 102.945, 198.63400000000001, 114.04050000000001, 118.717, 298.172, 132.19799999999998, 101.70400000000001, 166.688, 150.66, 124.14150000000001, 103.088, 25.7165, 208.8375, 25.3285, 197.6035, 20.101, 31.314500000000002, 27.2755, 22.588, 297.778, 40.524, 7.8985, 103.352, 177.87599999999998, 50.359, 294.1875, 153.3205, 290.52, 88.07900000000001, 108.086, 174.93900000000002, 160.34699999999998, 101.356, 119.866, 24.037, 201.5685, 30.947000000000003, 21.889, 1.2850000000000001, 36.7725, 76.0695, 33.564, 22.6825, 29.503, 27.112499999999997, 21.216, 54.0775, 14.923, 51.486999999999995, 23.444, 53.6395, 35.233000000000004, 50.011, 308.42650000000003, 20.087, 34.673, 183.865, 49.5405, 23.7485, 22.1465, 26.503, 100.88900000000001, 205.76100000000002, 20.4275, 178.5925, 67.0665, 27.903, 24.505499999999998, 119.135, 122.77, 332.94100000000003, 100.976, 19.0465, 105.22749999999999, 25.113, 214.724, 30.587, 38.813, 137.187, 92.6115, 46.372, 28.5955, 28.691000000000003, 111.5815, 71.24549999999999, 110.5215, 131.7455, 47.8265, 202.55349999999999, 29.208, 20.646, 128.37, 45.9505, 35.49, 166.8535, 80.082, 122.0705},
+		{230.236, 106.0545, 22.905, 43.736999999999995, 225.1935, 24.4225, 309.493, 47.022999999999996, 33.275999999999996, 134.61700000000002, 114.43, 99.01050000000001, 126.5425, 107.22749999999999, 36.58, 115.0835, 36.5965, 5.9495000000000005, 288.9135, 149.73250000000002, 37.1305, 44.882000000000005, 26.5975, 0.037, 28.7045, 156.7995, 48.9375, 36.4815, 168.215, 171.938, 133.7055, 102.827, 55.248, 69.926, 32.963499999999996, 173.52100000000002, 11.167, 48.544, 183.39600000000002, 262.168, 139.3865, 106.58, 155.6905, 39.537, 123.243, 162.38150000000002, 183.1905, 51.7125, 306.185, 35.953500000000005, 31.195, 76.8115, 76.8185, 222.3915, 283.193, 55.933, 126.5365, 267.0595, 169.386, 77.076, 34.9985, 151.5075, 118.136, 111.557, 153.5205, 116.7575, 168.0995, 152.44150000000002, 87.7635, 39.168, 135.10649999999998, 118.206, 121.0365, 162.9995, 41.974999999999994, 245.83550000000002, 117.26050000000001, 126.784, 190.74, 145.12, 329.53599999999994, 117.426, 182.99599999999998, 119.34899999999999, 172.493, 257.77049999999997, 282.93100000000004, 92.1325, 117.903, 39.239999999999995, 201.873, 233.50900000000001, 160.8725, 132.33300000000003, 129.63600000000002, 40.229, 94.62299999999999, 171.736, 137.621, 181.3055, 110.921, 120.51050000000001, 339.27750000000003, 138.1165, 115.4665, 163.04149999999998, 137.349, 129.71550000000002, 97.27, 24.1905, 196.79399999999998, 11.872499999999999, 199.647, 23.464, 20.092, 29.942500000000003, 16.200000000000003, 242.79700000000003, 16.7435, 25.0935, 104.53450000000001, 157.897, 43.9435, 262.27049999999997, 139.209, 273.5835, 93.8145, 83.906, 185.518, 172.941, 100.766, 125.6645, 29.381999999999998, 205.985, 37.336, 21.875, 18.9685, 35.4685, 76.339, 41.711, 28.416, 32.849999999999994, 27.5685, 9.4725, 59.695, 33.761, 54.1405, 30.8385, 74.552, 25.351, 19.941499999999998, 213.74349999999998, 12.060500000000001, 15.4515, 189.06900000000002, 43.992999999999995, 25.146, 35.696, 35.25, 118.84049999999999, 162.4695, 28.039, 183.235, 63.870000000000005, 33.458, 24.9965, 116.063, 130.7525, 194.168, 98.771, 21.7155, 110.265, 24.33, 215.2695, 35.078, 61.9285, 219.20299999999997, 94.47999999999999, 50.1145, 34.75, 63.733999999999995, 105.3145, 81.5185, 107.971, 119.618, 41.7415, 209.4185, 32.663, 17.119999999999997, 128.691, 41.198, 28.551499999999997, 219.125, 89.509, 124.61449999999999},
+		{278.02750000000003, 94.7525, 13.559000000000001, 10.6755, 221.753, 13.814499999999999, 282.947, 27.546, 13.465499999999999, 121.209, 82.55850000000001, 97.9695, 107.4405, 97.36099999999999, 24.787, 99.85900000000001, 25.427999999999997, 14.2085, 303.09299999999996, 147.9925, 28.484, 13.000499999999999, 16.475, 28.657, 0.029, 142.726, 20.865499999999997, 31.584, 164.8665, 147.85649999999998, 118.87100000000001, 85.924, 19.0875, 44.590500000000006, 40.595, 146.76, 17.156, 27.750500000000002, 163.1725, 232.5765, 124.0015, 90.179, 159.075, 21.508499999999998, 117.4325, 134.291, 178.8585, 42.8885, 303.5185, 18.366500000000002, 9.1715, 54.845, 63.7735, 203.2185, 225.358, 35.3495, 83.47149999999999, 204.64, 143.425, 55.351, 18.076999999999998, 161.789, 108.3535, 104.0215, 140.5485, 107.09, 151.557, 129.8875, 68.9545, 28.091, 119.97749999999999, 108.5685, 120.024, 152.5995, 32.84, 283.092, 95.636, 126.4305, 176.89749999999998, 107.2695, 306.1585, 111.8965, 181.515, 105.879, 129.31400000000002, 255.205, 287.754, 83.87049999999999, 97.9825, 50.2745, 172.125, 221.8605, 123.723, 119.4365, 111.995, 29.1085, 88.017, 178.985, 110.41650000000001, 177.3945, 109.43549999999999, 117.309, 287.0385, 125.5865, 85.5055, 148.765, 122.3205, 116.415, 80.9055, 13.451, 174.37, 18.055500000000002, 184.4135, 16.7755, 26.543, 18.105, 3.5454999999999997, 279.124, 33.4065, 13.81, 93.012, 145.6745, 49.930499999999995, 257.3455, 125.474, 288.2215, 79.5275, 93.4145, 172.49849999999998, 141.1825, 94.3065, 102.6695, 3.4290000000000003, 131.277, 22.198999999999998, 9.7615, 16.253, 17.875500000000002, 57.8495, 34.5325, 20.1585, 16.7455, 13.8355, 10.506499999999999, 54.683499999999995, 23.099, 36.926500000000004, 18.093, 47.398, 24.464, 44.316500000000005, 212.065, 7.7669999999999995, 22.0825, 167.7315, 43.186, 15.7035, 8.6145, 13.720500000000001, 91.5265, 158.18200000000002, 12.641, 163.21, 54.285, 19.508000000000003, 15.1035, 104.8955, 115.308, 237.577, 84.248, 14.721, 90.8205, 26.2785, 223.1655, 23.235500000000002, 43.931, 218.7645, 61.733, 33.415, 11.123000000000001, 42.8525, 95.525, 63.4695, 95.69, 113.59649999999999, 40.4945, 182.55849999999998, 20.942, 4.9725, 112.8965, 21.701, 20.2605, 147.32049999999998, 103.40450000000001, 108.73400000000001},
+		{142.881, 66.773, 171.0325, 148.6155, 108.19749999999999, 135.214, 245.2415, 158.09449999999998, 134.7255, 38.1965, 69.2885, 70.167, 70.906, 74.3655, 156.0045, 59.747, 123.41499999999999, 152.297, 224.358, 19.347, 162.34199999999998, 145.9615, 164.296, 156.78449999999998, 142.78300000000002, 0.04, 158.9855, 174.59550000000002, 181.1085, 10.5075, 43.054, 69.44149999999999, 147.7455, 176.5165, 159.388, 254.4845, 161.9835, 169.401, 136.88049999999998, 200.01850000000002, 49.0675, 66.347, 22.698, 155.6335, 35.707, 245.7665, 199.543, 153.6175, 161.3955, 185.256, 148.8065, 198.45600000000002, 203.4955, 203.3305, 191.208, 189.8415, 72.143, 158.2255, 12.304, 179.4505, 132.424, 17.304, 57.555, 51.748999999999995, 15.745000000000001, 81.9885, 287.273, 23.934, 196.43349999999998, 137.723, 71.5265, 41.323, 56.525999999999996, 8.965499999999999, 133.75549999999998, 150.781, 80.58500000000001, 71.493, 122.142, 51.93, 175.8425, 55.157, 161.9315, 70.51400000000001, 252.61450000000002, 169.607, 126.291, 63.4785, 236.244, 183.54700000000003, 284.37, 119.5205, 52.198, 26.686, 70.9055, 173.64350000000002, 64.41550000000001, 291.7045, 238.5645, 49.597, 55.9135, 68.9755, 158.765, 52.5475, 75.87049999999999, 35.804500000000004, 49.628, 43.4315, 67.64750000000001, 170.69650000000001, 256.07899999999995, 155.9735, 54.572500000000005, 141.79250000000002, 166.49650000000003, 131.18, 143.20850000000002, 125.98150000000001, 165.19549999999998, 152.3985, 65.899, 28.1475, 165.03199999999998, 177.6535, 54.131, 207.56099999999998, 63.6975, 226.173, 286.4, 60.193, 73.40350000000001, 65.36850000000001, 152.17950000000002, 282.3265, 135.341, 150.5235, 150.209, 134.0465, 190.978, 177.31150000000002, 138.49450000000002, 149.17000000000002, 146.347, 152.075, 186.6155, 156.584, 171.29899999999998, 130.1995, 199.488, 164.9685, 165.92950000000002, 195.0635, 162.7825, 166.72199999999998, 138.0285, 198.176, 137.61599999999999, 140.60649999999998, 137.124, 70.0325, 237.69549999999998, 141.688, 21.466, 197.94400000000002, 134.111, 138.735, 53.5605, 79.92500000000001, 233.68650000000002, 70.7415, 150.913, 75.8835, 151.964, 186.947, 148.053, 184.27249999999998, 277.0175, 235.5095, 179.447, 147.035, 182.608, 71.5055, 199.7475, 73.6, 36.682, 135.00650000000002, 148.904, 133.282, 147.315, 224.1615, 151.5325, 160.885, 249.618, 197.99599999999998, 69.3},
+		{221.695, 128.67149999999998, 14.182, 31.002000000000002, 248.764, 27.363, 304.7895, 45.368, 30.165, 141.13, 97.966, 110.4075, 137.7115, 112.149, 40.7645, 113.8515, 39.185, 30.878, 322.224, 167.15449999999998, 18.259, 37.9845, 32.1685, 48.936, 20.894, 158.9315, 0.0465, 22.1335, 191.626, 172.348, 137.414, 103.838, 32.920500000000004, 61.253, 56.497, 182.0645, 37.479, 32.3995, 182.16199999999998, 264.929, 146.15699999999998, 106.716, 178.79199999999997, 44.3665, 126.3805, 170.042, 287.6065, 53.632999999999996, 322.71500000000003, 38.23350000000001, 26.615, 76.482, 92.3695, 199.9605, 226.52100000000002, 43.486999999999995, 106.463, 266.75350000000003, 170.0615, 76.64150000000001, 39.719, 164.2385, 131.2215, 118.1415, 155.803, 115.1315, 166.7, 146.75, 81.8115, 40.7115, 132.3505, 126.4005, 138.45100000000002, 172.59300000000002, 50.677499999999995, 297.7715, 105.632, 130.88150000000002, 197.151, 120.98349999999999, 330.789, 123.8295, 189.59199999999998, 120.35300000000001, 193.927, 261.839, 293.847, 96.84649999999999, 114.3735, 46.2555, 195.34550000000002, 273.40549999999996, 138.264, 139.15449999999998, 131.213, 38.1105, 100.2295, 192.182, 142.5035, 200.56, 122.9765, 125.203, 307.86400000000003, 146.6295, 93.03, 165.6885, 144.89, 132.7045, 107.79050000000001, 14.149000000000001, 200.76, 49.949, 205.51049999999998, 20.2955, 68.111, 29.6075, 28.8585, 275.0965, 42.476, 31.496499999999997, 109.566, 166.9785, 69.02199999999999, 263.024, 139.3625, 302.625, 97.774, 140.933, 195.3085, 152.828, 109.26650000000001, 120.763, 23.008000000000003, 222.3045, 43.875, 32.477000000000004, 33.1185, 37.494, 75.207, 59.001000000000005, 30.1405, 36.2385, 23.3665, 28.4635, 83.35900000000001, 36.854, 50.757000000000005, 27.862499999999997, 76.94999999999999, 5.384, 47.01, 292.0715, 21.898, 47.8405, 185.31799999999998, 39.206500000000005, 24.7035, 23.485500000000002, 26.9565, 103.4085, 166.46699999999998, 37.801, 182.4255, 43.2785, 33.285, 42.6055, 128.5915, 135.24200000000002, 204.2385, 100.56899999999999, 29.4935, 98.55449999999999, 31.4055, 254.9495, 47.632000000000005, 62.286, 257.863, 76.005, 52.6235, 32.911, 79.4305, 118.637, 87.747, 109.045, 128.6655, 42.2435, 199.641, 33.1955, 30.656, 125.2775, 42.576499999999996, 42.804500000000004, 160.4255, 99.87700000000001, 127.4265},
+		{252.442, 127.0515, 26.161, 48.405, 255.483, 46.1025, 302.0565, 59.8925, 49.316500000000005, 153.45850000000002, 130.10250000000002, 142.4565, 153.3745, 128.156, 58.93, 140.183, 63.293, 41.691500000000005, 335.457, 198.3315, 39.0375, 44.672, 60.6895, 36.449, 31.6045, 174.573, 22.118499999999997, 0.04, 196.132, 190.583, 160.88150000000002, 123.14500000000001, 55.554500000000004, 81.305, 56.3665, 171.3915, 32.3575, 55.519999999999996, 200.788, 279.23, 159.8845, 126.0475, 189.55849999999998, 55.215999999999994, 147.55450000000002, 163.0355, 282.4755, 69.64850000000001, 345.14549999999997, 87.59700000000001, 46.0295, 85.396, 104.9885, 211.82150000000001, 372.8805, 64.1665, 122.368, 289.0635, 185.76, 100.5445, 50.5175, 182.516, 145.3075, 138.60649999999998, 173.7545, 132.967, 171.168, 161.796, 94.4605, 62.936, 151.65449999999998, 150.739, 142.704, 202.697, 64.8335, 284.83050000000003, 136.04649999999998, 150.806, 205.0845, 146.71949999999998, 356.1885, 140.58249999999998, 213.0675, 145.8295, 163.8795, 273.20450000000005, 315.33050000000003, 122.15350000000001, 122.536, 55.757, 187.081, 261.3875, 162.13799999999998, 154.7865, 145.2455, 51.721000000000004, 122.195, 199.86, 155.41649999999998, 230.32150000000001, 140.86950000000002, 140.39249999999998, 331.573, 173.08, 118.778, 167.286, 157.836, 153.73450000000003, 123.1555, 25.951, 203.47500000000002, 33.911500000000004, 220.2285, 41.3525, 47.230999999999995, 56.825, 34.7085, 295.8605, 63.11, 43.210499999999996, 129.486, 180.49099999999999, 50.8455, 342.9035, 159.6175, 303.986, 115.80600000000001, 61.8555, 200.543, 176.097, 122.786, 143.185, 42.8505, 219.421, 61.4965, 40.787499999999994, 53.706, 60.3485, 85.908, 42.845, 38.7765, 42.896, 40.28, 32.7795, 89.937, 48.7125, 63.954, 47.678, 87.562, 26.994999999999997, 54.543499999999995, 229.1925, 39.0965, 44.4725, 206.471, 48.275000000000006, 45.8585, 44.370999999999995, 38.8745, 130.87900000000002, 160.76850000000002, 47.736999999999995, 197.639, 50.3225, 57.6075, 39.788, 158.3505, 163.35950000000003, 293.3165, 125.0495, 50.7375, 123.136, 40.0805, 262.707, 58.035, 70.714, 142.9115, 74.4175, 73.1825, 42.018, 86.946, 128.7655, 101.808, 130.2585, 140.41649999999998, 59.185, 223.728, 52.718, 36.133, 152.59550000000002, 74.019, 58.076, 188.0605, 71.35900000000001, 151.3755},
+		{301.438, 247.779, 264.2365, 243.934, 79.2595, 167.674, 161.209, 191.24349999999998, 191.17950000000002, 206.6405, 235.8135, 246.6525, 243.15300000000002, 263.4325, 172.582, 218.18349999999998, 210.18, 165.515, 159.659, 172.53199999999998, 180.2115, 173.76749999999998, 156.01100000000002, 168.15449999999998, 164.989, 181.123, 191.7935, 196.17000000000002, 0.024, 180.9665, 215.9175, 228.817, 244.3395, 201.85000000000002, 184.118, 88.41, 190.776, 201.1345, 304.678, 381.4285, 213.209, 212.29899999999998, 243.24849999999998, 191.09449999999998, 196.4575, 95.93700000000001, 54.686499999999995, 185.0395, 327.4145, 194.35500000000002, 176.149, 207.78199999999998, 200.7965, 35.1955, 55.066500000000005, 196.9225, 248.414, 39.4965, 183.02100000000002, 223.1915, 259.252, 201.40449999999998, 250.8765, 202.7135, 158.7015, 228.5895, 42.892, 185.328, 224.352, 187.6205, 240.83550000000002, 216.55700000000002, 238.905, 202.71, 186.3665, 65.935, 249.4555, 241.6635, 282.8865, 238.3775, 294.50250000000005, 214.87400000000002, 322.19, 228.202, 74.17750000000001, 62.65, 103.577, 212.29399999999998, 222.8625, 288.2875, 325.96000000000004, 80.377, 221.0425, 187.7745, 228.4675, 253.153, 223.652, 338.0595, 278.758, 223.54399999999998, 229.6885, 219.89550000000003, 268.9605, 227.007, 254.7285, 204.602, 210.3765, 203.4955, 231.18099999999998, 266.9075, 57.8235, 167.937, 150.8555, 172.086, 184.606, 172.997, 167.851, 97.5505, 189.85649999999998, 177.824, 240.3565, 164.20350000000002, 194.24, 74.2425, 207.3955, 90.7735, 241.49450000000002, 247.652, 276.8645, 235.572, 219.757, 227.5555, 172.6945, 106.0805, 188.202, 168.236, 168.471, 176.107, 223.5045, 191.3755, 168.3145, 176.70100000000002, 170.707, 172.789, 219.19299999999998, 174.8865, 204.697, 177.595, 213.777, 187.7825, 211.269, 36.9705, 183.767, 275.4065, 312.456, 196.5995, 174.9305, 176.40550000000002, 176.905, 243.77949999999998, 45.446, 164.115, 206.918, 218.249, 181.4215, 169.9105, 208.241, 244.811, 196.3465, 245.151, 172.014, 248.75549999999998, 170.19, 355.05, 201.119, 192.5385, 267.262, 235.985, 202.2515, 180.9915, 185.52249999999998, 256.6675, 312.6445, 219.9265, 196.7585, 189.58749999999998, 320.9395, 165.7055, 169.291, 271.76, 196.011, 183.6485, 65.4175, 224.32150000000001, 258.1845},
+		{135.4995, 69.623, 164.614, 144.692, 114.888, 139.065, 158.2335, 165.08249999999998, 130.2575, 33.482, 68.362, 69.60499999999999, 64.815, 63.798500000000004, 156.79250000000002, 51.009, 155.19299999999998, 165.0125, 154.458, 32.8735, 162.786, 163.925, 163.462, 171.848, 147.8485, 10.433499999999999, 172.3635, 190.5385, 180.97, 0.266, 35.842, 64.2315, 150.661, 170.1925, 163.26549999999997, 268.8625, 172.1725, 177.31349999999998, 120.0925, 169.77249999999998, 39.929, 78.557, 29.972, 158.04649999999998, 45.007, 220.61599999999999, 198.295, 164.081, 163.931, 184.6005, 148.775, 194.6595, 190.7385, 186.833, 189.438, 191.47050000000002, 65.668, 151.29149999999998, 3.56, 183.78750000000002, 149.06150000000002, 7.819, 67.432, 65.0245, 28.908, 79.30199999999999, 239.4715, 28.124499999999998, 196.3125, 150.24349999999998, 61.308, 51.2265, 57.549499999999995, 16.18, 136.094, 131.93349999999998, 69.0935, 62.965, 119.6695, 59.8535, 181.847, 55.820499999999996, 134.369, 67.564, 276.82500000000005, 150.929, 135.1845, 65.7365, 228.54, 200.2655, 311.562, 129.50799999999998, 49.3725, 34.863, 59.413, 170.954, 67.00999999999999, 309.7615, 239.506, 62.207, 64.441, 81.8945, 149.67450000000002, 45.207, 73.639, 33.6845, 60.073, 52.654, 67.97749999999999, 164.398, 253.73950000000002, 158.8995, 50.032, 145.40800000000002, 187.2355, 140.62400000000002, 148.57350000000002, 121.476, 172.951, 148.8655, 60.455, 41.79, 176.5095, 161.7465, 82.7565, 192.86849999999998, 68.019, 233.6375, 304.068, 51.4955, 76.207, 66.5825, 158.8975, 279.374, 142.365, 153.598, 156.36450000000002, 143.794, 194.32600000000002, 186.013, 151.5695, 144.946, 147.6805, 167.5315, 190.406, 167.429, 170.413, 132.29500000000002, 188.776, 163.23250000000002, 179.247, 199.6815, 157.35950000000003, 171.411, 125.875, 194.01999999999998, 145.7065, 147.19549999999998, 149.6975, 67.815, 215.63049999999998, 146.64, 20.234, 200.409, 142.3895, 141.21699999999998, 80.929, 62.541, 184.9855, 73.116, 159.1415, 73.2875, 165.97, 176.808, 159.409, 171.5875, 283.1575, 223.4675, 180.7595, 139.752, 187.544, 70.7775, 194.1635, 80.9565, 59.297, 156.93900000000002, 139.5335, 135.198, 153.536, 235.813, 149.63150000000002, 173.689, 265.00800000000004, 235.9495, 63.287},
+		{145.33100000000002, 37.844, 136.872, 107.95400000000001, 149.722, 107.3075, 192.7955, 145.13549999999998, 108.531, 7.1545000000000005, 39.333, 36.793, 33.5175, 28.0795, 121.033, 18.668, 120.3485, 130.37, 189.3275, 54.607, 143.4185, 125.4915, 138.75799999999998, 133.63549999999998, 118.8745, 42.998999999999995, 137.3605, 160.8625, 216.0165, 35.84, 0.014, 28.3915, 115.686, 140.43599999999998, 134.414, 259.6885, 136.0205, 154.344, 86.1005, 156.308, 10.2545, 46.942, 47.558499999999995, 125.112, 28.615499999999997, 245.2745, 238.67649999999998, 128.1355, 187.441, 154.9225, 112.9915, 161.4905, 162.45499999999998, 279.177, 289.318, 151.45600000000002, 32.7845, 216.4685, 34.1355, 150.97899999999998, 112.10249999999999, 48.512, 29.548499999999997, 34.1255, 53.7955, 53.2945, 242.242, 47.518, 170.8615, 116.8675, 22.032, 27.8675, 31.9015, 49.8165, 118.5645, 166.748, 41.5525, 34.468500000000006, 87.4105, 27.067, 207.0805, 28.8145, 97.1395, 30.9035, 244.2575, 193.0045, 169.55599999999998, 31.9125, 184.278, 172.4735, 280.6925, 138.2055, 13.2905, 20.37, 22.5315, 136.992, 33.2695, 276.02599999999995, 217.84449999999998, 100.36099999999999, 26.921, 36.354, 175.20850000000002, 18.496499999999997, 44.1085, 43.328, 34.799, 49.126, 35.727000000000004, 136.80349999999999, 251.4915, 132.45350000000002, 86.886, 115.8715, 145.05700000000002, 101.1985, 114.4005, 157.848, 136.05149999999998, 112.935, 31.0135, 60.1135, 147.1915, 202.669, 48.251, 227.8475, 37.426, 210.3135, 271.119, 33.983999999999995, 45.42, 44.727, 123.062, 242.267, 110.27850000000001, 119.437, 120.624, 110.128, 163.048, 147.3225, 108.09, 119.3485, 107.1615, 129.3635, 159.6745, 130.3775, 149.25799999999998, 114.951, 157.30349999999999, 137.1465, 137.7935, 246.911, 118.83500000000001, 144.5005, 90.59, 166.08749999999998, 109.60849999999999, 107.6945, 106.32249999999999, 42.429, 238.5935, 115.1605, 63.439, 166.4195, 102.6565, 108.3905, 44.965, 25.07, 209.6285, 80.38499999999999, 120.39500000000001, 42.132000000000005, 119.1105, 151.244, 122.609, 151.678, 291.92449999999997, 183.846, 144.56900000000002, 117.57900000000001, 145.0035, 34.829499999999996, 157.0225, 43.9105, 30.789, 124.1905, 101.80799999999999, 102.795, 116.494, 200.7585, 115.564, 131.9645, 246.526, 194.486, 48.494},
+		{100.9255, 20.8445, 106.43700000000001, 84.45599999999999, 171.88400000000001, 82.138, 215.2475, 102.7585, 77.62, 37.903499999999994, 9.136500000000002, 14.7035, 32.112, 7.486000000000001, 100.5615, 16.8455, 96.60900000000001, 102.6765, 214.0445, 68.5125, 107.4905, 105.0605, 100.7265, 102.3905, 85.813, 71.073, 103.8955, 123.083, 228.774, 64.26599999999999, 28.453000000000003, 0.035, 87.938, 116.2585, 110.27600000000001, 215.856, 116.72149999999999, 123.7485, 78.78450000000001, 150.25650000000002, 37.406499999999994, 14.2925, 75.1445, 87.02799999999999, 44.669, 234.4005, 279.26599999999996, 104.82249999999999, 211.274, 120.29849999999999, 89.072, 134.9495, 143.849, 272.668, 276.25300000000004, 124.38050000000001, 9.722999999999999, 236.9065, 64.54650000000001, 130.65, 85.5275, 74.699, 23.701, 20.029, 77.57050000000001, 25.5285, 223.435, 59.7335, 135.16250000000002, 92.07149999999999, 27.168, 34.316500000000005, 35.475, 75.026, 97.66300000000001, 207.73000000000002, 19.439999999999998, 32.6455, 65.583, 24.386499999999998, 230.324, 28.180500000000002, 103.2085, 16.116, 208.985, 221.95, 198.12400000000002, 9.681000000000001, 171.1895, 139.666, 252.1165, 169.3795, 41.0285, 47.778999999999996, 27.1075, 106.02950000000001, 10.257, 250.71949999999998, 174.925, 101.6505, 27.823999999999998, 34.425, 198.329, 49.784, 16.618000000000002, 74.85900000000001, 39.117000000000004, 38.558499999999995, 10.986, 102.798, 247.356, 102.63499999999999, 113.2225, 88.434, 112.1765, 75.8115, 88.98949999999999, 189.666, 117.8725, 93.4625, 12.256, 102.9525, 115.7865, 208.0675, 47.7495, 249.8185, 9.8645, 182.0655, 237.55849999999998, 54.384, 19.8385, 11.75, 89.9135, 211.012, 84.9815, 95.783, 96.64699999999999, 83.738, 137.6435, 126.652, 82.7385, 99.302, 82.7815, 110.85749999999999, 132.804, 104.7195, 127.599, 90.8515, 132.9565, 109.781, 127.4605, 272.3265, 103.91650000000001, 108.49600000000001, 84.263, 131.2665, 84.40700000000001, 82.97149999999999, 81.3075, 13.9575, 217.75650000000002, 89.5505, 82.193, 142.057, 80.4785, 83.602, 25.0825, 36.414, 257.7475, 12.817499999999999, 96.5275, 19.259, 95.173, 137.3735, 99.9905, 126.2255, 225.7985, 168.442, 124.7055, 89.97649999999999, 128.9345, 10.8865, 136.382, 21.0175, 35.1645, 96.2045, 101.1625, 77.8005, 90.434, 171.5785, 90.424, 107.1455, 236.48149999999998, 166.51999999999998, 25.7605},
+		{238.3015, 95.838, 31.9115, 18.863999999999997, 245.50150000000002, 11.3665, 276.686, 36.783500000000004, 11.1645, 120.68799999999999, 78.804, 80.59700000000001, 115.35499999999999, 86.1905, 43.2515, 100.072, 20.916, 27.7185, 306.30949999999996, 149.4425, 41.987, 24.171, 33.907, 55.0035, 18.9555, 147.78, 31.9225, 55.4205, 241.613, 150.2785, 115.30850000000001, 87.493, 0.051000000000000004, 52.8625, 39.828, 216.7235, 34.894999999999996, 40.796, 170.08249999999998, 246.32600000000002, 122.072, 86.59, 145.394, 27.224, 106.3875, 149.99849999999998, 262.358, 44.3625, 296.3445, 41.82, 21.067, 73.89250000000001, 83.8155, 190.06650000000002, 276.3245, 56.936499999999995, 80.568, 259.904, 147.7865, 48.695499999999996, 16.9565, 156.7945, 98.34700000000001, 91.9505, 152.3845, 94.849, 148.38150000000002, 128.0805, 73.3315, 20.0775, 110.892, 102.18950000000001, 106.6065, 148.041, 35.420500000000004, 268.9655, 83.2835, 116.88050000000001, 167.317, 93.62549999999999, 306.6455, 102.8445, 168.6005, 108.24000000000001, 140.2615, 308.08349999999996, 271.6615, 78.5805, 101.1695, 66.36, 180.6855, 235.2225, 113.92949999999999, 121.1685, 108.72, 106.953, 79.3315, 189.1125, 150.1515, 178.4865, 97.837, 105.172, 282.4385, 127.29050000000001, 74.17099999999999, 151.3955, 128.6935, 101.89850000000001, 84.1585, 31.543, 186.828, 43.519, 187.90300000000002, 13.629, 47.1215, 12.7135, 21.820500000000003, 270.8175, 47.954, 29.941499999999998, 90.764, 163.2665, 44.9795, 307.903, 119.313, 289.69849999999997, 80.251, 96.501, 172.5815, 137.1365, 76.8965, 91.043, 24.7785, 141.7185, 23.432000000000002, 30.0045, 30.995, 16.236, 72.1285, 52.067499999999995, 12.27, 16.487000000000002, 8.618500000000001, 40.7325, 67.06299999999999, 38.2345, 48.2095, 11.022, 66.864, 41.5685, 59.068, 225.2495, 23.066499999999998, 30.447000000000003, 178.44299999999998, 58.763, 9.8675, 8.958, 9.9255, 82.432, 153.745, 14.249, 155.09050000000002, 65.503, 14.1815, 12.3825, 100.9675, 114.75, 276.30150000000003, 81.9745, 36.4465, 79.8055, 28.3205, 234.264, 32.9775, 50.795500000000004, 118.406, 91.6465, 49.089, 18.865499999999997, 58.9915, 90.18549999999999, 71.053, 87.9455, 121.277, 24.121000000000002, 183.14, 20.0245, 23.451999999999998, 108.00450000000001, 22.2735, 43.108000000000004, 193.7515, 89.284, 102.4425},
+		{204.8005, 123.14, 62.8065, 44.135999999999996, 273.376, 41.46, 316.9995, 62.6325, 50.887, 147.89350000000002, 110.416, 117.1065, 139.07549999999998, 114.1935, 15.9695, 126.881, 56.747, 55.041, 321.2795, 182.8555, 73.837, 56.358000000000004, 74.719, 69.86099999999999, 44.584500000000006, 176.42849999999999, 61.239000000000004, 81.25, 201.88150000000002, 170.19850000000002, 140.438, 116.304, 53.1175, 0.052, 68.72999999999999, 182.4755, 69.9735, 81.2885, 189.5695, 259.11699999999996, 149.52499999999998, 118.4205, 176.5365, 61.022, 138.29149999999998, 190.333, 304.5785, 16.6205, 340.7835, 85.23949999999999, 52.3625, 108.2465, 82.63050000000001, 218.587, 255.988, 88.73599999999999, 109.1505, 296.209, 167.11, 85.744, 56.778999999999996, 176.992, 125.1745, 131.902, 185.8825, 129.69650000000001, 179.973, 154.154, 117.4285, 55.6605, 129.958, 135.9185, 141.1005, 174.40449999999998, 40.561, 297.96000000000004, 121.478, 136.08100000000002, 180.2525, 126.52199999999999, 338.782, 134.859, 211.214, 127.049, 172.1705, 333.5455, 292.0045, 107.09, 127.126, 97.9165, 223.49349999999998, 265.312, 147.174, 145.73450000000003, 145.522, 65.6505, 114.172, 205.0765, 135.4195, 211.4495, 139.91649999999998, 130.85399999999998, 329.60450000000003, 157.2665, 110.6735, 181.332, 146.0895, 137.281, 110.579, 62.730999999999995, 218.6925, 67.673, 214.813, 47.6875, 94.66050000000001, 47.143, 50.015, 280.427, 70.78649999999999, 52.5865, 138.0165, 189.12099999999998, 72.54400000000001, 318.01099999999997, 146.204, 307.297, 108.378, 127.6925, 212.073, 157.112, 117.9985, 121.38550000000001, 54.0265, 167.629, 58.2175, 56.8835, 57.322, 51.6935, 91.69649999999999, 86.791, 45.239000000000004, 48.209500000000006, 47.0325, 55.925, 89.951, 66.211, 78.9385, 45.6555, 102.2425, 68.2475, 88.207, 298.727, 49.6095, 70.1425, 198.64350000000002, 89.9365, 47.894999999999996, 47.595, 43.4335, 113.964, 188.405, 44.017, 176.3095, 95.5175, 50.9855, 46.917500000000004, 129.9, 147.85, 209.212, 115.791, 48.4845, 112.67750000000001, 47.144000000000005, 260.5865, 61.285, 72.79050000000001, 69.7125, 117.1625, 77.01050000000001, 47.232, 100.2415, 125.32249999999999, 93.80799999999999, 134.23, 140.16750000000002, 66.2065, 209.399, 54.98, 50.646, 152.28, 52.1205, 65.9315, 177.4495, 110.5695, 130.6615},
+		{247.8525, 111.22999999999999, 36.608, 33.096500000000006, 242.382, 27.165, 294.9585, 7.547000000000001, 35.0555, 140.2285, 107.057, 108.705, 132.46699999999998, 108.39699999999999, 52.2765, 118.19550000000001, 44.97, 32.512, 296.3205, 157.8555, 6.8565000000000005, 27.874499999999998, 40.709, 32.815, 40.6255, 159.199, 56.5215, 56.4885, 184.207, 163.334, 134.51600000000002, 110.1625, 40.09, 68.896, 0.0565, 175.571, 39.542500000000004, 19.799500000000002, 197.2405, 276.43, 144.809, 109.39349999999999, 165.38150000000002, 58.0865, 130.7235, 168.51600000000002, 281.207, 67.4255, 310.95550000000003, 37.2255, 35.594, 80.8005, 91.735, 209.078, 223.6595, 63.093, 104.661, 267.8455, 167.2345, 76.125, 38.6515, 154.691, 128.622, 122.7835, 158.231, 126.2045, 166.931, 153.488, 85.46199999999999, 44.5045, 128.65300000000002, 122.99199999999999, 131.03, 167.686, 58.290499999999994, 289.5585, 110.69550000000001, 132.8385, 197.663, 125.7675, 335.118, 125.76650000000001, 191.6815, 121.374, 163.1575, 309.1945, 266.477, 99.606, 100.4125, 70.12899999999999, 190.60250000000002, 244.88049999999998, 144.9845, 139.1585, 137.526, 17.2425, 100.8075, 204.1895, 117.405, 191.8435, 132.9125, 129.9795, 339.78499999999997, 145.4625, 100.7315, 171.922, 137.31799999999998, 134.7465, 103.20349999999999, 37.367999999999995, 198.0985, 39.7, 203.2955, 30.3335, 43.129000000000005, 33.869, 28.072, 209.8485, 46.2705, 39.667, 111.80799999999999, 164.029, 27.868499999999997, 226.6225, 146.3305, 224.284, 103.93100000000001, 71.3925, 189.594, 166.051, 108.6135, 119.828, 33.1525, 165.923, 40.5135, 36.17, 40.305, 40.816, 81.5755, 67.316, 27.063499999999998, 29.986, 42.41, 30.1575, 88.88300000000001, 43.596000000000004, 57.2425, 31.0815, 88.2815, 24.6935, 59.1095, 309.02149999999995, 35.804, 45.4135, 193.79, 66.4285, 31.1425, 30.079, 27.026, 103.3935, 179.45600000000002, 29.935000000000002, 186.594, 87.132, 36.515, 29.512, 122.9015, 131.5155, 260.9435, 186.1465, 33.929, 107.041, 36.3565, 226.08350000000002, 47.8855, 65.5395, 130.173, 95.568, 58.2675, 35.596000000000004, 70.9375, 111.815, 91.5275, 120.57, 127.16300000000001, 51.995000000000005, 211.284, 37.485, 29.685, 136.64, 47.0475, 47.058499999999995, 181.7065, 96.257, 127.359},
+		{364.2525, 222.8725, 229.555, 206.5795, 259.3365, 150.4725, 340.985, 178.0325, 142.018, 268.6995, 218.25, 277.16150000000005, 281.433, 215.755, 171.1605, 230.17950000000002, 164.6935, 161.2985, 332.466, 278.1735, 225.46949999999998, 168.127, 162.6095, 173.35399999999998, 146.785, 254.52249999999998, 182.05700000000002, 171.3885, 88.491, 268.908, 259.709, 215.9225, 216.6005, 182.515, 175.5035, 0.029, 171.9975, 192.0945, 337.121, 358.2835, 284.326, 226.136, 308.35850000000005, 170.52949999999998, 236.778, 31.255499999999998, 122.697, 205.40249999999997, 406.1265, 177.07549999999998, 219.096, 238.236, 198.598, 138.014, 260.5905, 199.041, 208.8395, 111.559, 261.393, 186.976, 171.446, 251.68900000000002, 240.70049999999998, 231.78750000000002, 277.9755, 237.2255, 42.4715, 305.534, 217.86, 155.3365, 246.67450000000002, 316.599, 240.178, 275.3695, 155.2855, 130.54500000000002, 217.961, 279.80949999999996, 331.08000000000004, 223.34300000000002, 344.743, 247.635, 303.40999999999997, 231.763, 28.5435, 119.1875, 390.3615, 210.24599999999998, 248.87900000000002, 267.261, 358.8035, 157.3625, 243.72500000000002, 303.686, 271.6355, 171.7815, 212.958, 315.5765, 238.994, 331.054, 239.7885, 244.61, 393.3895, 273.17449999999997, 208.25150000000002, 284.79949999999997, 272.275, 251.3255, 222.1105, 236.351, 65.8125, 168.08350000000002, 307.6645, 159.9975, 182.726, 146.918, 168.378, 168.285, 169.44150000000002, 157.05599999999998, 287.97749999999996, 303.3165, 177.39600000000002, 272.499, 267.5085, 301.4705, 206.5165, 228.234, 370.626, 306.58799999999997, 273.53700000000003, 226.414, 170.63600000000002, 180.29500000000002, 145.486, 157.5675, 156.2015, 217.0725, 211.355, 198.555, 152.3915, 159.80149999999998, 152.889, 161.506, 192.587, 166.014, 188.08350000000002, 145.2875, 223.32049999999998, 170.586, 209.096, 148.993, 171.99099999999999, 171.76, 346.9565, 261.741, 214.5205, 151.199, 200.7705, 222.69549999999998, 37.1355, 155.144, 283.46500000000003, 238.82, 209.841, 158.2645, 249.003, 240.2745, 132.52749999999997, 220.83100000000002, 163.6885, 211.8155, 162.174, 365.826, 158.7045, 323.48699999999997, 224.22449999999998, 247.6835, 178.05, 144.01549999999997, 181.892, 232.57850000000002, 198.312, 226.12900000000002, 275.077, 220.58499999999998, 0.0, 153.44600000000003, 169.5695, 314.3175, 213.7465, 177.6875, 26.3855, 226.2115, 250.829},
+		{243.00650000000002, 123.886, 7.4415, 27.5635, 240.34550000000002, 29.177, 297.3985, 41.3035, 28.39, 139.86, 107.8015, 113.61099999999999, 125.423, 109.76849999999999, 42.7205, 114.0025, 42.1825, 6.2875, 307.03650000000005, 175.5185, 38.8235, 31.905, 35.522, 11.094000000000001, 17.075000000000003, 161.907, 37.4265, 32.287, 186.757, 172.12, 135.971, 116.61250000000001, 34.786500000000004, 69.9675, 39.5225, 171.8765, 0.019, 41.7545, 191.87150000000003, 251.1875, 142.7495, 111.431, 188.063, 42.5835, 130.4755, 149.575, 273.22249999999997, 50.891999999999996, 312.712, 10.030000000000001, 26.308999999999997, 69.4205, 84.065, 194.128, 242.257, 58.823, 107.6, 268.441, 162.958, 68.741, 36.947500000000005, 172.4095, 127.83, 122.1615, 158.382, 107.552, 157.471, 149.273, 74.706, 42.953, 128.419, 132.06900000000002, 139.204, 180.815, 48.543499999999995, 304.0935, 111.9675, 144.179, 197.1515, 127.57900000000001, 341.6095, 128.09050000000002, 208.8725, 118.646, 164.0965, 325.4675, 313.195, 109.1155, 108.9355, 44.2195, 187.364, 255.09449999999998, 148.86700000000002, 143.503, 130.63799999999998, 39.3035, 183.82299999999998, 180.62400000000002, 115.1925, 213.65449999999998, 132.6585, 126.68299999999999, 311.608, 182.20600000000002, 110.30000000000001, 186.4115, 141.094, 138.964, 113.911, 7.5435, 198.5515, 11.746500000000001, 206.3795, 21.213, 14.386, 32.583, 17.2705, 307.206, 10.3265, 25.642, 115.5645, 165.358, 46.3035, 241.61599999999999, 146.2725, 288.2885, 106.586, 107.98249999999999, 185.578, 162.4555, 112.0035, 123.7415, 27.455, 163.62349999999998, 39.855000000000004, 21.916, 24.883, 38.2045, 73.1565, 27.3095, 29.1475, 29.1435, 27.043, 18.3525, 60.7235, 29.880499999999998, 54.5775, 46.471000000000004, 60.3655, 38.9555, 21.793, 280.221, 20.2055, 23.9375, 200.1855, 37.626000000000005, 33.8525, 26.164, 30.7145, 104.1955, 159.483, 32.7145, 187.28050000000002, 54.721000000000004, 34.5745, 26.369, 128.469, 131.4025, 336.363, 123.21199999999999, 29.206, 110.13300000000001, 19.475, 225.1225, 45.4195, 69.6705, 127.2695, 76.16749999999999, 49.7695, 33.1005, 62.7415, 113.30850000000001, 85.2015, 112.2585, 126.78, 44.730000000000004, 206.179, 36.54, 19.485, 131.8915, 42.1405, 19.844, 147.4815, 73.816, 132.065},
+		{236.649, 126.00550000000001, 39.540499999999994, 36.107, 248.643, 34.439499999999995, 294.51, 15.562999999999999, 29.073999999999998, 147.19650000000001, 109.0455, 112.441, 145.2885, 119.257, 68.67099999999999, 129.105, 58.202, 36.05, 315.8755, 161.381, 21.272, 13.295, 48.783500000000004, 48.493, 27.7575, 169.3595, 32.3615, 55.509, 201.07350000000002, 177.3355, 154.35950000000003, 123.686, 40.873000000000005, 81.321, 19.746, 192.0805, 41.809, 0.0395, 187.6045, 280.5985, 156.131, 119.186, 187.81, 49.9625, 139.732, 161.502, 297.8935, 68.4405, 321.903, 53.2455, 46.1095, 83.258, 96.845, 215.3715, 220.896, 60.58, 110.5265, 251.04149999999998, 174.228, 69.476, 36.7555, 170.487, 148.0605, 126.798, 166.1985, 128.2815, 181.6005, 156.77749999999997, 82.0495, 40.578, 149.20850000000002, 125.4735, 144.584, 182.89, 58.9025, 298.433, 119.914, 151.2015, 189.76, 131.413, 329.9145, 135.1445, 214.8785, 133.89, 178.56099999999998, 285.60249999999996, 298.817, 110.32, 119.1885, 73.749, 183.025, 254.64600000000002, 152.85750000000002, 147.1085, 135.1515, 9.939, 108.968, 201.2065, 141.747, 196.33350000000002, 152.925, 134.8235, 302.279, 159.216, 107.1335, 184.135, 153.119, 142.34199999999998, 117.18199999999999, 39.1405, 215.9205, 49.069500000000005, 210.13299999999998, 39.7205, 62.947, 47.667, 32.045, 280.169, 53.6125, 50.9955, 124.613, 174.5725, 22.247, 232.6725, 145.545, 296.754, 111.08, 95.013, 200.46550000000002, 165.33749999999998, 115.086, 122.3215, 30.3375, 223.27100000000002, 57.5105, 43.888999999999996, 51.4625, 42.104, 82.7465, 69.025, 40.206, 30.332, 41.022000000000006, 47.542, 80.21350000000001, 55.0805, 46.322, 39.883, 81.56450000000001, 39.6695, 63.9375, 222.5085, 34.9955, 37.983999999999995, 190.26, 66.69049999999999, 29.3675, 34.761, 39.9315, 106.107, 173.332, 27.8725, 197.596, 70.539, 42.2345, 42.554, 139.324, 153.245, 348.949, 112.2755, 48.292, 113.09700000000001, 44.949, 253.877, 56.114000000000004, 66.711, 127.65100000000001, 93.97800000000001, 75.7745, 31.680500000000002, 79.975, 124.1095, 97.872, 125.523, 134.7615, 41.712, 215.76850000000002, 32.599000000000004, 36.77, 132.8915, 62.9155, 57.667500000000004, 207.68, 87.357, 150.25150000000002},
+		{171.086, 99.6275, 185.578, 163.09050000000002, 227.6535, 160.38049999999998, 345.4, 180.51049999999998, 153.3135, 83.9705, 86.31700000000001, 90.38, 55.768, 86.1185, 177.2265, 76.32, 157.812, 170.1015, 347.534, 128.53050000000002, 196.145, 169.8755, 175.974, 183.266, 163.1505, 136.88400000000001, 182.185, 200.9855, 305.22950000000003, 120.1045, 86.142, 78.832, 170.32999999999998, 189.64, 197.2645, 337.17999999999995, 191.922, 187.64749999999998, 0.034, 87.555, 86.1755, 92.882, 142.61, 191.4595, 110.97, 304.5125, 350.54449999999997, 181.73149999999998, 268.0475, 197.3855, 168.572, 217.91500000000002, 216.17149999999998, 308.9475, 333.58299999999997, 204.6125, 86.33449999999999, 317.478, 117.75399999999999, 199.546, 164.458, 138.54899999999998, 101.9025, 97.101, 140.0375, 101.73349999999999, 349.116, 117.54650000000001, 218.75900000000001, 160.4595, 56.1175, 100.66749999999999, 105.1885, 134.35500000000002, 162.8345, 302.6705, 93.59100000000001, 55.359, 97.026, 91.21549999999999, 298.1105, 97.056, 133.27949999999998, 75.5, 293.5605, 291.3785, 250.69, 88.9105, 228.647, 220.619, 328.9145, 242.473, 97.605, 110.156, 90.24000000000001, 183.897, 81.9815, 314.7735, 255.1445, 171.4515, 96.868, 89.255, 250.0815, 107.1485, 94.4285, 122.8725, 104.0485, 95.021, 82.77600000000001, 185.1925, 393.1035, 179.31099999999998, 157.163, 165.016, 207.8795, 161.3435, 165.5365, 251.3005, 193.9635, 162.40550000000002, 88.28399999999999, 161.188, 188.71699999999998, 295.082, 139.3135, 351.1945, 90.352, 241.9025, 319.239, 120.1235, 99.91050000000001, 82.256, 160.497, 331.4, 162.3705, 171.9085, 178.101, 165.8585, 216.1565, 200.3375, 168.27249999999998, 170.33499999999998, 155.2405, 179.594, 203.854, 188.4375, 203.80450000000002, 151.2375, 202.632, 190.8615, 204.10649999999998, 333.90999999999997, 177.924, 182.904, 6.2219999999999995, 214.873, 166.6845, 169.35250000000002, 167.4745, 87.6335, 305.1885, 157.245, 143.1795, 209.68200000000002, 164.313, 167.74099999999999, 102.26650000000001, 92.9795, 337.08900000000006, 93.2975, 167.2665, 93.8265, 177.11200000000002, 193.6295, 168.3325, 183.226, 252.32350000000002, 231.985, 198.409, 171.611, 198.5885, 82.5645, 209.0925, 91.832, 95.669, 164.421, 119.8625, 158.238, 164.6845, 256.2355, 162.405, 177.091, 327.0375, 232.10399999999998, 104.3425},
+		{90.707, 176.55, 260.904, 238.1055, 309.0025, 237.8395, 353.7595, 276.59000000000003, 231.82999999999998, 162.0025, 164.7605, 161.9075, 132.697, 164.3775, 232.922, 141.184, 232.893, 259.2065, 347.9845, 203.551, 269.236, 251.4715, 249.21300000000002, 262.1975, 232.6185, 200.171, 264.9475, 279.2235, 377.27750000000003, 169.5805, 156.3585, 150.2255, 246.6575, 259.15999999999997, 276.39549999999997, 358.295, 251.29899999999998, 280.628, 87.53, 0.037, 156.911, 171.87349999999998, 216.685, 249.26600000000002, 188.825, 370.0405, 395.8005, 257.562, 336.5295, 286.77549999999997, 224.583, 297.305, 290.824, 387.4765, 408.3775, 262.52049999999997, 161.063, 378.013, 191.2955, 267.477, 238.065, 205.9685, 156.3585, 159.3725, 210.8515, 179.57150000000001, 348.461, 186.949, 292.486, 248.61950000000002, 131.43849999999998, 179.06, 169.2625, 204.5455, 245.00400000000002, 346.106, 159.4635, 138.94650000000001, 222.142, 170.1135, 374.3515, 164.663, 126.148, 170.24349999999998, 389.27049999999997, 371.148, 322.74800000000005, 164.602, 318.58299999999997, 300.225, 414.31, 269.002, 173.54000000000002, 180.35649999999998, 166.0205, 263.073, 161.221, 407.145, 343.9235, 251.7135, 158.834, 180.7875, 324.44100000000003, 167.683, 170.2185, 196.52, 170.127, 185.861, 152.10649999999998, 261.0425, 382.6465, 268.82349999999997, 231.25, 245.434, 259.718, 222.601, 231.7495, 313.0775, 279.75300000000004, 251.373, 165.50650000000002, 210.817, 272.663, 365.78499999999997, 196.468, 388.3225, 165.31799999999998, 345.8455, 409.16700000000003, 182.85250000000002, 175.9545, 153.0045, 223.2605, 373.156, 241.183, 248.76749999999998, 231.8145, 241.81150000000002, 299.30100000000004, 284.62649999999996, 226.916, 230.5805, 236.536, 272.653, 291.44449999999995, 255.043, 293.9805, 243.9305, 300.881, 266.3385, 283.7065, 393.55600000000004, 251.385, 243.82, 92.4995, 289.267, 244.4595, 242.5325, 240.684, 169.0225, 342.1345, 223.79, 192.986, 292.796, 239.4625, 239.075, 169.8025, 167.2765, 413.89, 210.755, 253.01, 156.8385, 244.49849999999998, 50.456, 250.8615, 278.90599999999995, 193.9545, 333.0005, 257.576, 245.426, 261.25199999999995, 163.5685, 294.597, 177.13600000000002, 181.646, 237.94549999999998, 200.7705, 231.86950000000002, 245.423, 331.85249999999996, 237.524, 258.1535, 340.93100000000004, 316.5195, 180.4475},
+		{114.74799999999999, 47.9835, 132.76, 113.615, 154.4115, 118.77250000000001, 257.1375, 143.2175, 110.547, 11.627, 44.435, 43.062, 36.8655, 33.0275, 125.18, 27.846, 122.89099999999999, 135.026, 276.446, 69.04599999999999, 146.027, 139.379, 144.28050000000002, 139.1975, 124.02600000000001, 48.9855, 146.15449999999998, 159.88049999999998, 213.05849999999998, 39.953, 10.273499999999999, 37.413, 121.98400000000001, 149.527, 144.5005, 284.276, 142.80450000000002, 156.151, 86.158, 156.941, 0.033, 54.5275, 59.9815, 123.3665, 19.55, 270.887, 244.81099999999998, 131.08350000000002, 204.487, 166.9625, 117.387, 170.051, 175.333, 254.12099999999998, 258.111, 174.0115, 40.73, 203.25549999999998, 35.643, 160.767, 115.3205, 47.811499999999995, 44.7325, 42.274, 61.706, 57.281, 250.1695, 41.8755, 170.50599999999997, 122.299, 36.426, 26.358, 39.3825, 55.1105, 129.356, 172.889, 44.3545, 37.68, 96.373, 33.7545, 221.845, 40.3585, 110.34700000000001, 30.785, 272.51750000000004, 226.28300000000002, 179.8825, 40.755, 211.864, 167.227, 276.9305, 151.1055, 18.743000000000002, 39.584, 31.7345, 148.036, 37.179, 268.25649999999996, 214.3725, 112.731, 34.204499999999996, 46.568, 194.236, 22.1905, 50.787499999999994, 39.1215, 13.808499999999999, 35.797, 44.739, 133.776, 258.24649999999997, 140.32999999999998, 85.39500000000001, 120.7025, 146.05, 108.99600000000001, 121.485, 160.379, 146.81650000000002, 122.5735, 36.9225, 74.0555, 141.9835, 208.465, 55.373, 259.66499999999996, 49.057500000000005, 208.94650000000001, 281.8035, 38.616, 56.552499999999995, 43.581500000000005, 134.748, 240.73250000000002, 117.45949999999999, 125.656, 131.09750000000003, 123.89750000000001, 188.267, 156.07049999999998, 123.1755, 128.4205, 116.3635, 130.82350000000002, 177.9255, 137.3995, 151.48000000000002, 124.92949999999999, 169.47899999999998, 141.3975, 147.753, 241.8195, 121.64150000000001, 161.01850000000002, 96.134, 166.81, 112.6545, 112.85749999999999, 133.089, 49.788, 254.8885, 122.2435, 68.273, 170.952, 106.988, 120.6575, 66.0505, 80.22149999999999, 226.26049999999998, 91.4345, 151.221, 47.8495, 121.8195, 157.2135, 132.3155, 158.31900000000002, 255.2905, 199.463, 149.7485, 124.5395, 169.201, 44.236, 164.9475, 49.946, 34.477999999999994, 125.30850000000001, 103.2125, 111.42349999999999, 124.1005, 206.011, 126.87100000000001, 147.313, 244.21300000000002, 205.77550000000002, 47.7245},
+		{142.036, 8.211, 102.1285, 83.203, 138.0, 141.60649999999998, 290.36800000000005, 104.112, 84.53800000000001, 41.3275, 8.7525, 17.892, 44.3035, 22.991999999999997, 102.7115, 29.648, 74.545, 98.5435, 269.26300000000003, 58.111999999999995, 109.3125, 96.2655, 99.028, 106.4885, 90.1565, 66.259, 106.691, 125.858, 212.27, 78.5495, 46.917, 14.2725, 86.971, 118.42099999999999, 109.3885, 226.09550000000002, 111.432, 119.218, 92.7935, 171.82799999999997, 54.542, 0.028, 56.221, 94.5005, 29.9635, 251.0635, 233.814, 107.58699999999999, 225.245, 118.9205, 90.74199999999999, 145.866, 140.15800000000002, 255.914, 265.2165, 133.87, 11.274, 220.659, 76.79050000000001, 121.619, 135.074, 58.103, 18.073999999999998, 23.804, 69.499, 16.0435, 227.636, 56.6275, 145.02949999999998, 89.835, 36.114999999999995, 28.9935, 35.539, 79.6825, 91.477, 188.4385, 15.655999999999999, 42.5215, 97.45949999999999, 20.317999999999998, 236.0935, 26.969, 106.7315, 31.083, 252.9835, 218.042, 194.21, 10.339500000000001, 197.026, 133.83350000000002, 239.8795, 156.2165, 41.209500000000006, 40.313500000000005, 49.212, 108.375, 11.0275, 240.086, 207.517, 85.14750000000001, 27.5155, 37.155, 219.3385, 51.6405, 10.383, 67.386, 48.176500000000004, 33.0915, 16.032, 102.0825, 265.217, 104.068, 112.42150000000001, 90.943, 123.49549999999999, 79.7125, 94.4105, 159.2815, 113.636, 97.334, 23.747, 65.8695, 110.35849999999999, 219.32150000000001, 41.804, 253.01749999999998, 12.744, 170.0005, 234.181, 61.887, 21.253, 18.069, 93.7885, 272.693, 84.99449999999999, 100.19749999999999, 99.3305, 82.07, 147.3865, 122.0515, 87.24950000000001, 91.8995, 83.191, 100.533, 134.17399999999998, 107.012, 121.09899999999999, 78.15950000000001, 147.351, 113.96, 114.10900000000001, 220.93, 104.5315, 99.822, 101.03, 129.303, 84.50999999999999, 90.966, 84.287, 11.6025, 223.7955, 90.11449999999999, 94.229, 133.289, 83.0485, 88.0395, 21.578000000000003, 42.929, 224.1365, 21.374, 99.27000000000001, 8.993500000000001, 99.7625, 135.933, 97.631, 113.0545, 170.5015, 156.49, 120.503, 95.71449999999999, 124.129, 21.18, 138.312, 5.141500000000001, 28.371499999999997, 83.36699999999999, 109.453, 81.834, 95.9995, 176.39499999999998, 102.9885, 158.071, 196.734, 155.5375, 34.7555},
+		{195.2195, 51.284, 170.81799999999998, 143.457, 120.461, 149.789, 185.65949999999998, 160.70850000000002, 138.51600000000002, 61.638000000000005, 62.917, 75.2735, 76.47800000000001, 68.28649999999999, 167.129, 59.923, 167.2695, 169.4315, 182.054, 4.5885, 171.21699999999998, 161.80450000000002, 160.459, 155.627, 159.0475, 22.662, 178.6165, 189.488, 242.378, 29.9805, 47.5235, 75.172, 145.784, 176.55900000000003, 166.7255, 308.221, 188.086, 187.7525, 141.3465, 216.73250000000002, 59.941500000000005, 56.195, 0.037, 147.571, 45.192, 276.757, 213.853, 181.72050000000002, 177.3795, 169.789, 159.5385, 200.985, 198.386, 281.4105, 242.8865, 201.37349999999998, 78.0805, 170.1745, 36.9235, 184.13850000000002, 156.12900000000002, 41.715, 63.278999999999996, 51.6815, 7.9035, 76.5955, 290.19399999999996, 75.949, 199.5005, 143.03300000000002, 70.79650000000001, 50.912499999999994, 61.057500000000005, 29.832, 164.594, 199.459, 69.753, 77.158, 117.4385, 55.555499999999995, 210.15699999999998, 56.0235, 149.012, 75.5255, 270.0695, 185.255, 156.292, 72.176, 227.20850000000002, 207.836, 327.04549999999995, 98.7995, 53.8985, 35.917, 73.0455, 166.7865, 73.393, 314.06600000000003, 257.516, 27.8175, 69.4135, 55.447, 186.2075, 66.511, 73.9645, 57.307, 58.945, 52.728, 78.83449999999999, 170.2015, 246.8895, 153.0435, 74.225, 157.53199999999998, 182.095, 143.9105, 157.629, 123.50550000000001, 175.68349999999998, 159.776, 73.62, 12.4695, 182.5125, 276.6185, 31.132, 220.2485, 64.657, 235.3415, 323.67949999999996, 81.04750000000001, 72.5795, 60.507999999999996, 154.80849999999998, 267.3155, 147.515, 165.55200000000002, 164.5605, 141.5965, 217.56099999999998, 180.5665, 150.868, 147.739, 156.98649999999998, 159.591, 195.55700000000002, 167.1365, 184.8755, 127.95599999999999, 205.2025, 177.79950000000002, 175.8535, 218.6375, 161.94650000000001, 165.7735, 147.863, 201.2295, 150.14499999999998, 156.613, 165.07850000000002, 69.1405, 258.9205, 138.075, 41.854, 202.9725, 145.80149999999998, 151.68650000000002, 76.373, 70.338, 254.3095, 100.3635, 167.76100000000002, 66.142, 165.4805, 192.5475, 172.5785, 175.642, 299.975, 228.9795, 189.25650000000002, 154.1895, 201.8095, 77.937, 203.33100000000002, 63.533500000000004, 45.8345, 176.563, 144.84550000000002, 141.4975, 159.38049999999998, 238.63, 159.5075, 176.0845, 276.7925, 235.709, 71.7915},
+		{190.7305, 93.768, 86.3575, 19.0505, 285.7235, 36.3595, 296.23199999999997, 75.5115, 14.006499999999999, 154.5085, 89.40350000000001, 90.8615, 151.569, 95.44800000000001, 78.0785, 98.26050000000001, 72.934, 65.225, 295.709, 184.483, 86.988, 60.2685, 76.34649999999999, 88.162, 35.3635, 186.6175, 83.46950000000001, 88.49, 189.0125, 187.30349999999999, 154.471, 88.9685, 63.855000000000004, 87.91749999999999, 87.88300000000001, 188.7975, 85.5755, 86.383, 190.302, 284.2865, 165.6625, 90.477, 186.4845, 0.162, 121.77199999999999, 187.493, 288.10749999999996, 84.3385, 292.321, 88.392, 52.730000000000004, 89.005, 90.92250000000001, 289.8485, 195.287, 90.05250000000001, 88.7315, 197.67700000000002, 188.3365, 86.65299999999999, 46.271, 188.16, 97.773, 100.61, 188.20499999999998, 98.285, 186.1755, 185.40949999999998, 88.37, 38.601, 119.51849999999999, 102.745, 117.9465, 185.4245, 66.509, 288.30150000000003, 90.036, 156.18099999999998, 220.3535, 94.8015, 405.5235, 102.86000000000001, 188.50549999999998, 97.269, 181.44299999999998, 293.903, 289.3195, 88.0245, 96.9785, 91.0345, 190.1765, 286.5915, 132.567, 164.5165, 126.2995, 77.0305, 89.09899999999999, 189.618, 175.9595, 190.756, 105.583, 101.71, 289.5395, 181.02550000000002, 88.4, 190.94299999999998, 189.714, 151.9725, 92.105, 88.2875, 189.33350000000002, 88.3925, 191.711, 36.732, 85.833, 21.0855, 34.5025, 286.212, 86.4615, 53.5325, 95.059, 187.398, 86.85300000000001, 286.495, 160.26999999999998, 383.952, 88.7155, 100.488, 191.93200000000002, 187.8645, 90.205, 99.4805, 35.1355, 187.628, 50.513000000000005, 75.8955, 67.85, 31.5395, 92.5495, 88.0065, 38.167500000000004, 38.2685, 20.965, 85.913, 90.70150000000001, 79.36850000000001, 80.7495, 16.0815, 89.7185, 87.52099999999999, 82.947, 382.274, 49.275000000000006, 79.44749999999999, 191.01749999999998, 89.62950000000001, 32.126000000000005, 25.1195, 28.9635, 89.5705, 187.03300000000002, 13.0215, 183.395, 89.50200000000001, 20.984499999999997, 27.198999999999998, 105.6925, 141.0855, 289.8935, 88.734, 76.0415, 88.899, 68.6605, 251.538, 61.476, 90.50649999999999, 103.782, 89.0625, 87.47, 14.219999999999999, 88.53649999999999, 91.869, 89.69399999999999, 89.2295, 113.241, 83.5985, 189.5505, 26.4255, 47.109, 118.55199999999999, 59.040499999999994, 87.029, 189.645, 94.952, 103.67699999999999},
+		{170.0285, 36.942499999999995, 133.429, 105.056, 138.8345, 106.91499999999999, 268.3535, 126.1425, 98.491, 12.150500000000001, 32.329, 32.8375, 41.622, 43.149, 121.618, 26.212, 89.4195, 123.4115, 285.692, 41.7855, 127.4, 115.689, 134.0625, 123.15450000000001, 117.411, 35.6295, 126.3115, 147.50549999999998, 196.4025, 44.9945, 28.5965, 44.604, 106.5375, 138.27749999999997, 130.6995, 236.8145, 130.471, 139.6925, 110.959, 188.8015, 19.535, 29.96, 45.2245, 118.935, 0.028, 242.6345, 218.7485, 126.476, 191.916, 143.7505, 111.8545, 163.0205, 158.69, 225.13299999999998, 213.7775, 159.0425, 34.6265, 188.727, 42.7455, 138.841, 98.857, 61.107, 29.3715, 19.25, 38.7595, 45.61, 259.9765, 21.008499999999998, 160.919, 110.839, 40.2345, 6.288, 30.137500000000003, 47.7845, 116.8565, 178.3535, 25.682, 37.661500000000004, 81.781, 15.383, 210.276, 19.927500000000002, 103.235, 38.474, 229.221, 192.271, 162.5375, 32.7245, 180.90449999999998, 149.663, 271.1335, 133.95299999999997, 7.672, 31.665, 50.548, 134.7285, 30.538, 258.06449999999995, 214.515, 72.114, 28.8165, 37.5275, 216.61, 38.3645, 36.037499999999994, 73.8315, 14.438, 8.98, 33.0875, 131.582, 243.0695, 122.01650000000001, 84.274, 106.988, 145.60399999999998, 97.9435, 113.821, 140.74099999999999, 137.6765, 118.97800000000001, 40.9865, 49.8365, 136.0225, 183.61149999999998, 33.2145, 230.69150000000002, 36.147000000000006, 187.577, 252.413, 38.049, 41.016999999999996, 23.75, 115.14, 274.45500000000004, 103.32, 124.984, 122.538, 100.009, 166.883, 154.80149999999998, 109.33099999999999, 109.6825, 117.994, 126.8235, 153.662, 125.99199999999999, 141.7555, 95.198, 158.2405, 131.9155, 152.97449999999998, 243.0625, 125.4875, 134.048, 116.6815, 162.311, 112.31450000000001, 115.67, 103.7255, 33.5385, 265.1635, 106.881, 53.6405, 174.32999999999998, 100.08699999999999, 108.914, 65.5265, 92.78200000000001, 225.7855, 47.5755, 117.48249999999999, 27.8125, 124.543, 157.844, 114.362, 135.326, 244.3025, 178.096, 147.1245, 115.043, 170.32, 49.108999999999995, 156.0875, 33.399, 6.061, 104.083, 113.21600000000001, 103.998, 115.5355, 190.9785, 114.54599999999999, 130.45, 247.002, 186.78750000000002, 32.6215},
+		{343.007, 224.433, 140.6775, 143.15300000000002, 247.022, 148.08100000000002, 170.78199999999998, 173.84449999999998, 190.291, 249.019, 250.38049999999998, 220.974, 251.3715, 225.27100000000002, 162.6515, 250.562, 149.084, 157.4085, 169.56799999999998, 260.3775, 164.9565, 161.83550000000002, 221.565, 163.6095, 135.80700000000002, 246.63, 171.40050000000002, 163.96800000000002, 97.889, 222.654, 246.812, 236.306, 150.9145, 191.982, 170.95999999999998, 32.959, 151.63400000000001, 163.1465, 305.33050000000003, 371.9675, 271.656, 252.5765, 276.50800000000004, 157.5025, 243.168, 0.069, 62.599000000000004, 168.63150000000002, 381.099, 156.89350000000002, 145.575, 168.663, 193.4965, 82.49100000000001, 231.64, 189.748, 249.1815, 82.2045, 214.9125, 183.69650000000001, 153.0695, 220.5285, 278.8025, 244.2335, 252.091, 236.72500000000002, 16.4105, 253.964, 204.17000000000002, 162.852, 265.54999999999995, 253.6875, 240.49099999999999, 245.7645, 152.1905, 105.6525, 243.86599999999999, 269.23199999999997, 324.762, 261.5525, 417.4695, 226.5265, 403.0875, 270.02750000000003, 19.4635, 98.77199999999999, 243.245, 212.459, 140.4855, 178.2065, 289.602, 118.819, 270.6885, 269.026, 277.93, 163.7255, 233.4395, 305.02700000000004, 218.566, 304.65250000000003, 241.36200000000002, 274.02700000000004, 357.049, 258.7215, 267.5715, 265.1975, 250.31400000000002, 245.06650000000002, 218.0135, 148.184, 79.67949999999999, 168.35, 294.33050000000003, 135.873, 154.132, 149.0005, 135.286, 138.78050000000002, 177.3175, 123.063, 305.247, 279.805, 175.30450000000002, 218.45, 263.752, 97.0215, 209.6045, 201.437, 289.2055, 265.599, 221.8065, 245.042, 147.5405, 152.2155, 254.212, 145.1585, 177.4435, 157.4495, 170.3785, 178.24650000000003, 135.817, 192.16649999999998, 158.214, 163.325, 221.7485, 153.8725, 182.1945, 148.783, 205.51999999999998, 171.23000000000002, 179.23649999999998, 83.9555, 155.7735, 176.995, 305.56600000000003, 188.1105, 152.18099999999998, 138.50150000000002, 133.7415, 238.9185, 14.692499999999999, 162.333, 262.78200000000004, 218.0205, 154.0375, 152.5965, 255.75650000000002, 276.8825, 261.2555, 258.4835, 165.20600000000002, 227.7985, 134.0455, 347.635, 155.0215, 158.7455, 358.36850000000004, 198.55700000000002, 295.92150000000004, 156.16199999999998, 172.296, 274.29949999999997, 176.0635, 270.8175, 243.435, 159.7635, 362.986, 145.423, 136.659, 246.925, 198.3145, 161.445, 21.5065, 270.5335, 274.175},
+		{392.9925, 254.4385, 258.919, 267.33050000000003, 78.5915, 264.4325, 128.2125, 284.76750000000004, 191.474, 253.05700000000002, 268.474, 270.3195, 247.817, 271.7165, 293.2425, 260.0525, 280.9925, 198.607, 130.1705, 190.371, 191.8, 357.57050000000004, 182.537, 183.178, 178.8995, 199.499, 287.662, 282.4365, 54.6395, 198.35700000000003, 238.7175, 279.3755, 263.149, 304.60850000000005, 281.3165, 122.6495, 273.2885, 298.091, 350.5005, 395.8915, 244.7785, 233.83800000000002, 213.966, 261.3555, 218.7975, 62.063500000000005, 0.0515, 366.16200000000003, 285.663, 307.842, 260.22400000000005, 235.85, 267.597, 40.484, 61.286500000000004, 397.4765, 268.15250000000003, 45.2265, 204.977, 390.5375, 185.268, 203.2125, 279.68399999999997, 223.39100000000002, 181.253, 251.027, 47.724500000000006, 211.18200000000002, 229.9, 288.8505, 282.033, 220.942, 252.053, 207.9985, 214.519, 75.9345, 246.7525, 263.987, 311.7835, 249.14499999999998, 361.5975, 224.703, 331.16499999999996, 270.856, 111.49799999999999, 54.3505, 209.5065, 232.938, 274.7525, 298.6825, 353.4145, 83.3065, 236.272, 209.7585, 240.7455, 367.1535, 259.1855, 418.7725, 142.82150000000001, 220.8595, 232.2425, 228.858, 264.9415, 243.2415, 263.557, 217.894, 233.1045, 226.791, 276.70050000000003, 259.74, 65.729, 183.3525, 211.4745, 180.27249999999998, 289.377, 264.777, 267.315, 98.03, 281.887, 357.1035, 264.481, 186.298, 199.569, 62.611, 258.392, 58.031000000000006, 235.383, 263.5535, 280.8265, 253.4885, 245.41649999999998, 258.97, 267.3115, 110.96799999999999, 273.24199999999996, 355.7445, 269.51, 257.369, 314.8075, 311.95349999999996, 260.241, 179.287, 173.035, 398.587, 230.274, 365.3485, 388.63750000000005, 169.99099999999999, 300.7915, 288.4365, 294.8555, 65.9175, 350.1135, 276.67449999999997, 350.4765, 279.4975, 172.6345, 254.20600000000002, 264.6385, 284.5825, 49.6175, 171.60750000000002, 212.546, 243.723, 337.9875, 175.30349999999999, 261.067, 254.6035, 47.4725, 267.1855, 363.8085, 268.53499999999997, 352.4715, 387.58349999999996, 290.3965, 388.054, 290.164, 399.17449999999997, 383.6405, 249.852, 313.6725, 276.42949999999996, 322.6375, 269.60450000000003, 219.9315, 284.6375, 0.0, 337.9325, 273.33450000000005, 354.126, 282.2955, 372.79449999999997, 102.4825, 247.55700000000002, 292.3985},
+		{264.42449999999997, 115.242, 44.607, 26.558, 254.825, 38.6455, 273.486, 65.785, 40.370999999999995, 147.99200000000002, 110.643, 112.889, 116.54650000000001, 115.819, 14.001, 106.91650000000001, 42.212500000000006, 38.352999999999994, 275.6885, 173.714, 66.50399999999999, 54.1445, 59.335, 51.8105, 42.9825, 153.5595, 53.629999999999995, 69.7385, 184.374, 164.063, 128.2505, 104.875, 44.38, 16.7315, 67.5385, 205.4785, 51.0165, 68.455, 181.651, 257.5945, 131.054, 107.64750000000001, 181.8385, 39.272999999999996, 126.4925, 167.752, 366.0905, 0.06, 320.16549999999995, 84.6115, 42.6075, 92.626, 65.4045, 214.445, 206.016, 86.7595, 88.09100000000001, 321.67999999999995, 153.809, 77.5315, 44.9455, 144.382, 122.21549999999999, 107.75800000000001, 161.64499999999998, 115.65, 167.6795, 155.9905, 83.058, 52.796499999999995, 123.41, 136.06799999999998, 132.094, 173.25400000000002, 8.639, 258.7455, 121.5275, 112.995, 187.62150000000003, 101.1215, 313.40250000000003, 114.838, 189.3515, 116.61500000000001, 191.3825, 254.863, 274.4045, 111.017, 118.0985, 81.418, 219.3555, 234.9015, 121.2435, 154.8505, 116.1345, 57.318, 114.497, 218.71699999999998, 133.71800000000002, 205.8625, 109.741, 113.4295, 313.048, 128.0725, 96.076, 150.274, 137.82850000000002, 123.458, 111.15549999999999, 44.5385, 178.1045, 50.5315, 200.47899999999998, 46.727000000000004, 59.136, 52.8835, 36.4945, 242.381, 63.570499999999996, 31.8545, 112.095, 169.857, 73.89, 291.71950000000004, 144.5435, 203.559, 96.189, 128.28949999999998, 204.6435, 131.1805, 104.482, 126.7125, 27.512, 255.381, 42.948499999999996, 34.583, 55.409, 47.602, 115.2765, 72.9855, 38.56, 45.114999999999995, 30.665, 49.289, 80.92699999999999, 41.224999999999994, 82.285, 35.051, 92.5035, 57.968, 69.705, 290.2835, 40.091, 75.94149999999999, 187.566, 73.993, 46.465500000000006, 43.6, 40.365, 113.493, 153.76, 35.217, 183.98000000000002, 75.56700000000001, 46.807500000000005, 40.935500000000005, 124.414, 138.549, 265.74699999999996, 103.2695, 34.2655, 95.4865, 34.4055, 229.66649999999998, 38.236000000000004, 55.458, 111.3135, 106.59649999999999, 66.2795, 38.656, 58.477500000000006, 110.403, 78.59299999999999, 118.9925, 118.468, 53.3605, 186.685, 35.813500000000005, 38.081500000000005, 148.8235, 43.191, 57.5275, 166.15699999999998, 134.178, 123.145},
+		{333.5335, 219.23450000000003, 322.39300000000003, 293.2555, 266.7655, 298.60400000000004, 15.8355, 316.605, 286.95399999999995, 184.67, 219.11599999999999, 221.81650000000002, 213.90249999999997, 207.76049999999998, 302.765, 208.59199999999998, 299.198, 313.0405, 55.378, 174.733, 308.2415, 298.086, 306.0435, 306.09950000000003, 303.52549999999997, 161.3615, 322.72950000000003, 345.1515, 327.082, 163.9735, 187.4595, 211.287, 296.493, 340.769, 311.1925, 406.0605, 312.74199999999996, 321.9325, 268.027, 336.47799999999995, 204.4795, 225.2885, 177.55349999999999, 292.95050000000003, 191.95850000000002, 379.3855, 284.9315, 320.281, 0.032, 312.28499999999997, 300.47950000000003, 340.62149999999997, 336.945, 273.4735, 449.5525, 334.76, 223.3745, 305.7165, 166.781, 335.834, 300.965, 160.289, 219.7175, 211.67149999999998, 174.07350000000002, 223.2375, 355.7255, 178.875, 364.9945, 297.7495, 225.468, 199.1205, 201.32549999999998, 170.091, 320.6175, 233.3895, 216.185, 211.4955, 271.15599999999995, 205.235, 58.3605, 202.9555, 299.163, 210.11200000000002, 388.009, 309.30499999999995, 282.5605, 215.2975, 365.0475, 358.4385, 519.5445, 157.43099999999998, 197.474, 182.7905, 208.3615, 327.125, 212.59300000000002, 476.121, 404.47249999999997, 201.66500000000002, 200.5765, 200.4515, 16.413, 196.87150000000003, 224.724, 190.881, 204.7645, 192.4425, 211.5255, 321.1075, 409.935, 305.0655, 197.59300000000002, 306.7395, 318.31899999999996, 287.74, 320.995, 283.6405, 327.06899999999996, 303.847, 218.8175, 185.8395, 332.4975, 307.61, 214.1685, 92.995, 212.39249999999998, 374.80899999999997, 471.1, 214.71949999999998, 219.661, 206.9635, 295.5885, 429.576, 285.295, 304.772, 304.5575, 291.651, 352.968, 345.0715, 305.31600000000003, 304.5315, 297.761, 319.37350000000004, 340.3985, 319.4285, 319.23249999999996, 279.005, 336.926, 311.8885, 335.846, 338.086, 307.88, 320.5435, 273.969, 351.9765, 291.2805, 299.846, 303.20349999999996, 214.905, 359.045, 292.12300000000005, 187.399, 363.85450000000003, 288.942, 297.7115, 228.005, 230.7735, 330.641, 227.48, 300.6335, 217.33350000000002, 321.5765, 356.57550000000003, 316.7895, 323.109, 386.80449999999996, 379.5265, 326.421, 301.911, 334.7835, 221.70499999999998, 354.6265, 232.058, 192.804, 292.94, 297.29200000000003, 280.711, 329.2955, 382.139, 293.2525, 320.2085, 386.35249999999996, 353.8315, 209.4955},
+		{259.25350000000003, 114.15899999999999, 23.9865, 30.643, 264.6945, 40.017, 286.178, 59.105000000000004, 49.552499999999995, 152.70600000000002, 138.4885, 123.71199999999999, 125.4445, 119.156, 49.525, 110.7505, 78.45849999999999, 17.1005, 321.70950000000005, 194.0355, 41.314499999999995, 54.973, 38.314499999999995, 35.9645, 18.338, 185.204, 38.2205, 87.554, 194.30349999999999, 184.527, 154.9975, 120.199, 41.5405, 85.29750000000001, 37.1485, 177.098, 10.091, 53.051, 197.3, 286.8755, 166.877, 118.92150000000001, 169.7375, 66.4105, 143.6455, 154.48700000000002, 307.651, 84.4935, 312.1485, 0.03, 32.751000000000005, 79.61349999999999, 93.2835, 215.8225, 318.335, 70.35499999999999, 142.01, 284.2155, 169.6445, 91.32499999999999, 66.02250000000001, 166.20999999999998, 148.1895, 146.2535, 167.48250000000002, 114.2225, 152.59550000000002, 178.2105, 109.5075, 60.933, 154.54700000000003, 144.368, 131.8535, 189.8955, 64.17150000000001, 308.22450000000003, 117.1735, 129.41, 179.7225, 141.289, 351.9655, 129.2495, 196.226, 134.938, 199.9765, 264.463, 280.14599999999996, 105.1745, 142.25650000000002, 61.477000000000004, 195.25549999999998, 266.8035, 168.4885, 148.48950000000002, 126.87049999999999, 48.403000000000006, 117.467, 217.4015, 141.92700000000002, 221.66, 140.767, 118.19, 331.092, 151.3255, 104.2955, 161.071, 137.11, 131.9405, 119.877, 10.404499999999999, 201.13150000000002, 36.54, 212.441, 42.063500000000005, 33.941, 42.417500000000004, 29.3885, 260.4775, 31.9175, 33.2825, 124.4815, 172.674, 58.7115, 284.842, 154.2595, 312.2385, 120.6915, 108.10300000000001, 211.9265, 186.6825, 110.47, 131.723, 49.0575, 233.573, 58.034499999999994, 29.602, 58.555, 49.0435, 96.48750000000001, 40.085, 25.2655, 57.8885, 38.9285, 15.782, 81.829, 49.0105, 72.33, 28.427500000000002, 105.47749999999999, 63.334999999999994, 18.048499999999997, 215.07850000000002, 40.326, 27.038, 186.118, 67.87950000000001, 38.3095, 54.9585, 38.06, 121.0685, 164.16750000000002, 39.885000000000005, 207.059, 72.6935, 56.408, 40.763000000000005, 187.957, 230.178, 283.14250000000004, 114.7425, 46.284, 123.57, 55.328, 286.558, 64.964, 53.0895, 136.849, 66.4495, 55.308, 29.246, 75.517, 137.546, 107.05000000000001, 113.411, 147.865, 58.691500000000005, 226.2145, 62.0865, 33.3305, 145.03699999999998, 50.6605, 47.9845, 176.902, 87.979, 146.339},
+		{220.1605, 107.1515, 18.483, 8.440000000000001, 240.39299999999997, 10.6085, 293.96500000000003, 33.4385, 15.8535, 124.9245, 81.3105, 97.3765, 113.6115, 88.1035, 27.429499999999997, 101.24600000000001, 24.44, 16.7895, 283.4085, 154.414, 34.570499999999996, 19.8925, 21.011000000000003, 31.038, 9.225999999999999, 148.811, 26.632, 46.096999999999994, 176.01600000000002, 148.74349999999998, 113.0635, 88.90899999999999, 21.165999999999997, 52.331500000000005, 35.547, 219.064, 26.308, 46.106, 168.4975, 224.4525, 117.3435, 90.83449999999999, 159.4525, 26.369, 111.93450000000001, 144.55700000000002, 260.2625, 42.605000000000004, 300.503, 32.4625, 0.043, 58.989999999999995, 71.25450000000001, 181.08800000000002, 280.7555, 49.894999999999996, 86.2935, 248.733, 145.0895, 58.4055, 20.418, 161.793, 96.0255, 98.414, 156.7545, 104.1625, 149.15800000000002, 134.6535, 64.643, 24.3475, 108.1825, 108.71549999999999, 116.833, 153.4945, 24.839, 283.151, 87.3725, 115.536, 179.469, 102.02449999999999, 310.028, 107.7595, 188.22899999999998, 99.8715, 140.0405, 240.24849999999998, 277.99850000000004, 89.297, 86.125, 55.051500000000004, 158.3715, 236.452, 117.73, 132.46050000000002, 108.8775, 32.6495, 89.241, 166.58800000000002, 134.7655, 186.5355, 112.422, 113.6995, 286.56550000000004, 132.6275, 78.421, 158.25150000000002, 128.71699999999998, 114.628, 96.7645, 18.2705, 210.541, 30.409, 193.1215, 9.6975, 46.394999999999996, 16.5185, 12.626999999999999, 282.59000000000003, 39.706500000000005, 19.563000000000002, 93.095, 168.67000000000002, 40.697500000000005, 304.6195, 128.3605, 226.5895, 79.4445, 97.3075, 177.627, 138.3635, 95.68350000000001, 95.414, 15.1445, 147.255, 24.362000000000002, 23.366, 21.3425, 20.3005, 61.4835, 37.745000000000005, 14.4525, 10.6655, 10.7775, 30.667, 58.208, 27.541, 40.954499999999996, 25.137999999999998, 53.6095, 34.489000000000004, 40.0175, 300.166, 11.7905, 25.3275, 177.228, 48.912, 13.4435, 11.3735, 8.722999999999999, 89.943, 157.1495, 11.340499999999999, 168.14600000000002, 52.6185, 17.249000000000002, 9.999500000000001, 109.362, 111.122, 266.7605, 166.972, 27.441, 83.86, 22.323, 212.62400000000002, 26.108, 37.759, 107.549, 78.8435, 46.505, 11.342500000000001, 43.088, 95.036, 66.27600000000001, 101.481, 114.5925, 27.826500000000003, 183.7015, 18.816000000000003, 14.533999999999999, 114.10849999999999, 27.6235, 33.776, 196.7465, 98.06, 109.093},
+		{262.79999999999995, 142.48250000000002, 64.60050000000001, 66.15700000000001, 283.245, 60.843999999999994, 324.216, 83.6325, 65.1645, 171.7575, 127.2935, 142.0725, 160.143, 152.55599999999998, 72.64500000000001, 142.6885, 69.729, 65.11500000000001, 343.548, 188.268, 80.51150000000001, 73.1925, 69.912, 75.768, 53.535, 197.4075, 77.65199999999999, 85.1635, 201.88549999999998, 194.595, 160.577, 135.059, 72.25899999999999, 106.9665, 81.493, 238.0735, 69.3295, 83.2995, 219.292, 296.27700000000004, 168.832, 145.256, 202.2395, 84.303, 162.4355, 168.02949999999998, 235.337, 91.88, 340.7455, 78.8945, 57.989000000000004, 0.0805, 104.86699999999999, 283.375, 278.6785, 95.1935, 137.16, 258.8485, 199.1285, 95.05199999999999, 69.9845, 207.373, 149.4015, 157.723, 201.481, 138.8705, 196.43900000000002, 179.9655, 110.34, 69.343, 157.477, 157.264, 162.141, 209.3365, 94.9565, 325.0775, 134.2265, 166.3495, 204.01850000000002, 155.4675, 357.08349999999996, 158.27100000000002, 227.654, 149.0625, 161.781, 218.9935, 287.7645, 134.4485, 125.35499999999999, 105.56700000000001, 193.9685, 279.227, 178.36399999999998, 174.0865, 154.7885, 85.81, 133.655, 214.967, 175.08100000000002, 230.64249999999998, 152.0575, 157.881, 344.8285, 188.9185, 137.8105, 203.247, 177.089, 170.14350000000002, 132.954, 64.77, 253.35750000000002, 71.9505, 240.50799999999998, 61.3365, 80.157, 64.428, 56.857, 301.0645, 80.199, 68.5215, 144.738, 199.10750000000002, 79.16499999999999, 313.267, 169.8965, 325.01800000000003, 133.607, 145.06650000000002, 208.4005, 198.697, 143.0155, 154.10500000000002, 56.271, 191.99, 67.398, 64.075, 68.99, 71.3205, 113.191, 86.8655, 58.876999999999995, 60.551500000000004, 61.5165, 72.5995, 100.5855, 57.3005, 86.253, 66.53800000000001, 98.91550000000001, 78.893, 84.2645, 248.7825, 62.45, 71.514, 224.2425, 98.0455, 63.964, 61.3155, 62.028999999999996, 127.80449999999999, 171.84, 66.08, 201.744, 111.048, 60.157, 70.1705, 156.31349999999998, 170.592, 298.9575, 136.353, 69.7425, 143.76, 64.66550000000001, 261.043, 71.78200000000001, 73.09899999999999, 257.3215, 126.112, 90.67349999999999, 63.667, 74.2245, 141.20850000000002, 101.783, 148.33800000000002, 162.864, 81.35849999999999, 235.2615, 61.2695, 58.809, 165.6585, 78.9175, 74.09700000000001, 146.94600000000003, 118.18700000000001, 155.836},
+		{265.13, 153.0985, 78.4125, 60.132000000000005, 278.9315, 65.605, 308.92449999999997, 90.469, 61.0875, 188.64600000000002, 126.717, 149.691, 162.7005, 131.617, 48.7545, 142.45, 87.0215, 70.7315, 348.5265, 197.75549999999998, 87.51050000000001, 95.3495, 65.59700000000001, 75.521, 61.1405, 201.3585, 87.7435, 104.956, 201.0695, 190.409, 157.822, 145.6975, 84.8905, 79.771, 90.3235, 195.9765, 91.299, 103.225, 213.15249999999997, 291.2595, 172.88, 134.865, 195.497, 74.721, 156.105, 185.8245, 266.6625, 63.093500000000006, 342.1705, 87.9645, 67.09899999999999, 112.5235, 0.036, 280.7795, 272.137, 110.7175, 140.2885, 255.474, 194.226, 114.8005, 70.6345, 209.32150000000001, 162.55, 152.865, 203.966, 137.3255, 213.3265, 174.7305, 113.84049999999999, 72.977, 158.046, 149.485, 161.10649999999998, 192.9625, 54.692, 314.196, 148.49, 158.21699999999998, 219.20600000000002, 145.938, 350.2895, 158.217, 243.66, 146.4205, 227.166, 297.547, 308.08900000000006, 126.9095, 140.94, 118.869, 200.428, 280.62300000000005, 166.623, 200.368, 164.40449999999998, 86.60249999999999, 129.429, 230.0165, 156.881, 224.6515, 157.1605, 167.71699999999998, 342.378, 188.63299999999998, 136.0785, 210.2975, 167.6285, 159.7745, 132.1135, 88.4205, 231.49, 80.08850000000001, 246.851, 82.49950000000001, 86.2915, 60.039, 63.5155, 294.6205, 84.7285, 64.92349999999999, 143.253, 233.5705, 99.6435, 357.582, 200.076, 320.51149999999996, 136.3515, 137.778, 225.50650000000002, 185.7455, 146.56650000000002, 150.7895, 57.559, 197.138, 80.227, 66.39949999999999, 67.7415, 75.07849999999999, 110.187, 91.6555, 84.65549999999999, 71.5455, 74.5595, 87.7425, 104.82650000000001, 69.14850000000001, 101.5295, 72.55600000000001, 111.0015, 89.85149999999999, 95.63050000000001, 249.1205, 72.6925, 82.479, 227.803, 109.01599999999999, 69.391, 70.7735, 67.668, 132.724, 188.22899999999998, 73.76849999999999, 205.77949999999998, 123.8515, 76.154, 82.34, 149.8945, 160.402, 316.0365, 154.243, 81.7655, 136.4485, 75.4525, 271.47400000000005, 65.39750000000001, 106.2465, 155.779, 137.7755, 94.743, 63.825, 97.814, 153.9915, 115.896, 136.6685, 184.03300000000002, 85.33000000000001, 220.8005, 77.1785, 67.9175, 167.947, 78.5735, 103.0185, 171.751, 141.9405, 155.47449999999998},
+		{326.4465, 280.7175, 191.089, 174.632, 101.21600000000001, 203.1895, 271.19100000000003, 211.899, 246.966, 281.42949999999996, 259.906, 274.05449999999996, 249.7425, 269.90549999999996, 211.507, 238.0, 250.3855, 186.272, 266.82849999999996, 207.45999999999998, 225.6095, 196.0775, 228.20299999999997, 222.3185, 203.2285, 202.7235, 199.66449999999998, 211.678, 34.968, 186.69299999999998, 278.885, 272.3965, 190.885, 218.5705, 209.38850000000002, 138.199, 194.0455, 215.43200000000002, 308.744, 387.4385, 254.12400000000002, 255.74, 281.5745, 270.5725, 225.0915, 80.219, 40.134, 214.5225, 273.33950000000004, 215.6645, 180.9495, 282.8935, 276.27049999999997, 0.036, 223.6325, 247.23250000000002, 251.60899999999998, 63.806, 189.523, 256.683, 189.54899999999998, 213.64350000000002, 262.11749999999995, 240.724, 203.30450000000002, 274.641, 61.1935, 312.961, 259.2575, 204.82, 247.185, 234.4425, 246.91899999999998, 220.57, 181.022, 82.084, 247.644, 254.1605, 346.3225, 245.8355, 326.3035, 232.29399999999998, 325.1895, 272.031, 131.053, 77.997, 204.2125, 271.529, 298.9315, 220.317, 375.24850000000004, 102.249, 235.5065, 216.3185, 246.571, 232.1295, 258.5605, 278.491, 209.471, 239.63150000000002, 262.27049999999997, 255.03, 292.789, 275.625, 251.053, 254.05349999999999, 250.636, 231.351, 260.601, 190.88049999999998, 113.2975, 184.6685, 217.452, 186.6335, 195.735, 199.4685, 228.86, 114.4265, 198.5965, 202.26, 299.623, 213.6925, 210.3405, 94.8925, 254.977, 247.523, 253.7735, 282.125, 290.7835, 237.638, 261.2215, 266.73, 269.4625, 134.678, 207.439, 176.862, 226.37650000000002, 182.0215, 225.47, 223.967, 182.988, 241.4065, 216.87650000000002, 182.82, 243.62599999999998, 176.17899999999997, 300.86850000000004, 249.793, 275.454, 200.934, 212.64800000000002, 73.883, 208.1505, 208.301, 314.821, 247.41199999999998, 222.4515, 186.474, 186.50900000000001, 273.0055, 66.69200000000001, 181.8125, 216.8145, 230.082, 180.2825, 196.7165, 249.5605, 276.963, 217.0625, 255.3825, 173.942, 255.954, 176.4145, 391.645, 199.67149999999998, 284.9, 284.42449999999997, 251.278, 224.34550000000002, 210.49849999999998, 225.53050000000002, 288.28650000000005, 261.6495, 251.0455, 223.7345, 273.21950000000004, 332.7865, 255.48250000000002, 209.158, 280.89549999999997, 260.004, 223.63400000000001, 119.2595, 246.7955, 257.828},
+		{385.02250000000004, 260.63599999999997, 350.69, 260.43899999999996, 110.098, 215.0555, 177.09300000000002, 304.906, 215.0795, 210.625, 294.604, 247.3705, 290.8425, 284.0855, 199.9965, 246.498, 274.606, 276.26599999999996, 177.5285, 219.607, 297.8265, 280.32, 324.9605, 283.4585, 225.41649999999998, 191.34699999999998, 227.0805, 372.8345, 55.112, 189.423, 289.354, 276.0665, 276.431, 256.0415, 223.74349999999998, 260.62300000000005, 242.329, 220.99200000000002, 333.613, 408.437, 258.15700000000004, 265.318, 242.8095, 202.2355, 214.07350000000002, 230.647, 61.236999999999995, 205.8795, 449.6415, 318.1915, 280.174, 278.51300000000003, 274.54200000000003, 223.84449999999998, 0.041, 375.88599999999997, 247.67450000000002, 49.8905, 191.16649999999998, 242.602, 210.05399999999997, 188.55700000000002, 301.283, 253.29649999999998, 186.47899999999998, 254.8245, 220.421, 229.314, 321.084, 271.872, 246.937, 221.54250000000002, 217.962, 194.934, 289.212, 83.8925, 267.1965, 237.8545, 308.63149999999996, 257.4845, 342.851, 204.6975, 305.523, 251.0625, 239.028, 43.3145, 216.252, 262.46950000000004, 186.23000000000002, 317.2125, 434.188, 96.9025, 224.522, 205.064, 258.5415, 218.3225, 230.829, 376.308, 237.9015, 221.4885, 239.7265, 228.59, 424.18600000000004, 309.2315, 252.96949999999998, 327.55600000000004, 232.4345, 222.385, 297.004, 348.73, 106.993, 284.3245, 199.3125, 270.97950000000003, 222.888, 283.5385, 200.1285, 279.0325, 247.5765, 287.90999999999997, 252.8235, 194.8515, 307.19849999999997, 79.0635, 229.526, 103.9555, 237.103, 418.4615, 297.0375, 238.0115, 244.7295, 257.73850000000004, 195.247, 151.2475, 202.4, 197.393, 210.88400000000001, 324.9475, 342.95000000000005, 219.7105, 343.3555, 319.1735, 321.39750000000004, 198.7395, 334.565, 284.001, 230.3315, 269.182, 365.217, 387.9225, 272.1445, 58.441500000000005, 200.514, 209.418, 342.20500000000004, 377.097, 278.106, 327.1535, 280.4705, 262.151, 219.4645, 275.058, 233.7335, 322.35699999999997, 269.9635, 265.5505, 212.3535, 250.892, 23.149, 253.42000000000002, 192.21949999999998, 248.241, 265.4635, 389.23199999999997, 218.252, 409.3385, 291.5545, 282.07050000000004, 298.839, 293.146, 410.02700000000004, 288.5295, 353.7955, 232.6125, 275.9015, 298.967, 0.0, 292.34950000000003, 202.936, 368.436, 298.54449999999997, 224.53300000000002, 228.5755, 260.84000000000003, 272.51750000000004},
+		{232.484, 137.2045, 34.827, 51.5245, 288.688, 41.7755, 309.40049999999997, 62.060500000000005, 45.8775, 153.8935, 129.284, 141.3, 155.927, 137.24599999999998, 72.285, 134.038, 73.364, 47.7335, 342.02, 217.398, 64.71950000000001, 56.629, 49.295, 56.4525, 35.364000000000004, 189.808, 43.398, 64.32050000000001, 196.733, 191.4785, 151.485, 124.21799999999999, 57.18, 88.733, 63.0815, 198.989, 58.9055, 60.633, 204.87349999999998, 262.557, 174.0095, 133.90050000000002, 201.4375, 62.801, 159.0705, 188.15699999999998, 397.402, 86.729, 334.7425, 71.0645, 49.902, 95.321, 113.1195, 247.3595, 375.8795, 0.042, 123.045, 357.2845, 192.925, 87.11850000000001, 58.557, 208.9315, 160.82999999999998, 145.7255, 200.719, 127.4855, 198.00099999999998, 198.4955, 95.7735, 57.341, 156.5165, 145.2975, 145.85, 201.667, 68.6285, 320.0335, 128.351, 162.437, 212.8705, 153.7865, 352.805, 152.1095, 218.9195, 138.1975, 189.36, 355.5735, 316.1005, 133.4905, 118.6915, 55.5575, 211.37, 281.40999999999997, 163.59550000000002, 175.44650000000001, 150.2, 55.187, 118.947, 218.9, 149.9375, 243.317, 148.8955, 168.5445, 338.029, 169.411, 130.617, 205.96800000000002, 168.6055, 159.85250000000002, 135.257, 41.5715, 224.3025, 52.997, 242.571, 42.7605, 32.439499999999995, 50.321, 38.83, 318.30449999999996, 68.347, 55.233000000000004, 129.1445, 194.22250000000003, 72.6925, 303.75, 184.7065, 326.481, 133.94549999999998, 120.31899999999999, 211.3025, 184.61700000000002, 139.0325, 165.796, 45.346000000000004, 227.3845, 60.1525, 57.0595, 54.4275, 53.254000000000005, 95.75, 65.37, 45.293, 49.954, 58.63, 50.132999999999996, 10.1355, 85.7765, 72.47149999999999, 79.41550000000001, 8.7345, 62.073, 70.7575, 384.52, 41.46, 57.084, 216.60649999999998, 48.8805, 48.95, 49.2805, 49.873999999999995, 124.50999999999999, 163.3605, 55.97, 206.5, 11.964, 55.8685, 46.954499999999996, 138.8985, 158.219, 379.964, 133.7385, 57.715999999999994, 140.50349999999997, 46.186, 244.982, 89.206, 57.451499999999996, 242.349, 35.494, 72.26050000000001, 46.801, 41.567499999999995, 141.332, 97.4525, 129.9925, 158.7765, 67.77600000000001, 226.101, 50.3125, 36.7345, 145.1735, 58.464, 44.923500000000004, 205.95049999999998, 48.0295, 160.6415},
+		{166.6955, 17.671, 98.939, 74.0125, 183.727, 86.509, 363.0485, 102.8615, 70.7705, 41.916, 4.271, 9.953, 29.801, 8.228, 101.2065, 16.295, 80.226, 96.92150000000001, 353.4875, 76.76150000000001, 108.96799999999999, 90.9635, 97.195, 126.45599999999999, 83.4865, 72.1005, 106.44550000000001, 122.378, 248.4475, 65.7015, 32.796499999999995, 9.7055, 80.784, 109.1875, 104.687, 208.873, 107.5985, 110.525, 86.3295, 161.0805, 40.748000000000005, 11.310500000000001, 78.083, 81.2525, 34.652, 247.84750000000003, 268.0105, 87.99799999999999, 223.379, 141.9655, 86.3945, 137.30599999999998, 140.538, 252.017, 248.5915, 123.05850000000001, 0.0245, 228.58249999999998, 63.3455, 115.713, 81.6285, 73.047, 19.1595, 19.174, 79.6795, 19.7825, 231.0905, 68.4965, 166.23700000000002, 79.22, 27.1465, 29.7335, 32.2565, 74.569, 79.65350000000001, 197.762, 12.415, 30.2925, 90.3735, 20.537, 232.8905, 28.3585, 92.6665, 23.205, 216.549, 216.458, 195.5475, 3.2875, 165.08499999999998, 133.928, 251.539, 167.1265, 41.1485, 46.9865, 28.295, 109.5025, 5.2105, 227.36649999999997, 202.725, 106.065, 29.563499999999998, 33.0535, 209.7385, 49.061, 9.932500000000001, 65.1, 47.2855, 29.8525, 6.806, 104.9065, 252.54649999999998, 108.445, 114.56, 80.5075, 113.9145, 71.446, 87.29400000000001, 184.2385, 115.09450000000001, 85.815, 13.325, 90.318, 113.5035, 220.32, 46.570499999999996, 282.1835, 3.748, 160.9635, 231.902, 52.322, 12.168, 18.2325, 84.777, 204.94799999999998, 79.50450000000001, 85.4425, 92.6065, 75.3055, 127.4865, 121.1705, 84.376, 90.733, 76.667, 110.637, 123.4975, 100.60300000000001, 117.58949999999999, 69.632, 121.101, 104.978, 125.85849999999999, 257.635, 91.067, 98.213, 91.937, 126.095, 77.07, 77.36250000000001, 77.803, 5.7085, 262.7635, 81.84899999999999, 79.441, 138.79500000000002, 73.6365, 79.4195, 27.352, 34.6045, 247.6535, 9.184999999999999, 92.723, 9.26, 95.64850000000001, 140.32150000000001, 92.616, 103.798, 208.77499999999998, 163.8585, 116.2315, 81.48349999999999, 125.545, 13.0505, 133.43099999999998, 14.5205, 35.710499999999996, 84.29750000000001, 100.01650000000001, 80.93299999999999, 87.43, 165.6585, 80.9995, 111.47399999999999, 187.9595, 152.9675, 29.2935},
+		{354.3195, 240.406, 261.00800000000004, 259.744, 50.107, 239.36, 151.8825, 208.4275, 212.86599999999999, 189.9995, 253.7605, 220.547, 250.946, 216.4425, 314.865, 219.88400000000001, 269.632, 194.142, 153.9605, 153.39999999999998, 266.1395, 349.498, 206.857, 266.95050000000003, 204.6825, 158.19299999999998, 266.73199999999997, 289.0195, 39.618, 151.3125, 216.4785, 236.9025, 260.2015, 296.2185, 267.72249999999997, 111.5265, 268.495, 251.09300000000002, 317.3835, 377.955, 203.2175, 220.732, 170.0915, 203.1385, 188.7655, 81.5295, 45.212500000000006, 321.59450000000004, 305.7405, 284.051, 248.688, 258.775, 258.57399999999996, 63.9875, 49.849000000000004, 357.3485, 228.5695, 0.0395, 160.55, 356.13, 239.7415, 159.086, 223.0075, 192.82850000000002, 141.3975, 225.6865, 66.6265, 199.0145, 231.1645, 244.6745, 244.114, 187.82549999999998, 206.961, 161.01100000000002, 211.656, 27.5015, 207.719, 215.3605, 314.223, 208.13850000000002, 307.881, 188.831, 297.791, 216.974, 101.2575, 29.932000000000002, 180.608, 199.312, 401.5085, 295.885, 427.054, 51.425, 199.47500000000002, 189.7905, 201.4355, 351.637, 210.935, 468.26800000000003, 273.94550000000004, 183.272, 203.67950000000002, 215.237, 293.82849999999996, 205.1025, 215.035, 200.4035, 204.67399999999998, 190.711, 222.97500000000002, 260.427, 87.2795, 258.7065, 162.321, 191.38049999999998, 270.1975, 252.9595, 249.1615, 69.40700000000001, 272.5535, 312.5985, 216.9665, 146.636, 227.381, 75.7795, 210.0815, 81.84649999999999, 206.42899999999997, 294.408, 324.349, 222.2785, 206.5365, 201.048, 192.35649999999998, 132.6365, 261.89, 316.9325, 258.0135, 256.97450000000003, 271.00350000000003, 297.067, 249.48250000000002, 207.9805, 245.731, 348.095, 233.8665, 321.145, 267.882, 238.928, 296.937, 204.07, 287.1135, 36.096, 341.1435, 263.17449999999997, 323.4735, 267.3395, 189.077, 251.5925, 254.35000000000002, 224.5165, 73.2375, 244.51850000000002, 173.8665, 231.887, 293.4845, 191.9625, 213.146, 208.959, 91.983, 229.0475, 315.38800000000003, 220.567, 336.88300000000004, 403.94, 274.8725, 339.8835, 297.0745, 403.945, 273.0535, 190.027, 305.68600000000004, 231.749, 333.2495, 223.744, 186.74650000000003, 272.7035, 294.6065, 241.574, 250.5405, 348.139, 270.9575, 260.3925, 91.24950000000001, 287.5235, 215.06650000000002},
+		{132.752, 69.19399999999999, 162.4145, 142.329, 117.4235, 147.1275, 235.36700000000002, 164.989, 136.813, 34.5865, 65.7555, 75.9825, 61.2585, 72.977, 155.56, 48.5735, 154.2135, 162.697, 214.86950000000002, 35.911500000000004, 162.94650000000001, 154.9565, 159.372, 169.30349999999999, 143.43099999999998, 12.233, 170.06400000000002, 185.775, 183.055, 3.5865, 34.144000000000005, 64.5145, 148.18, 167.1055, 167.2735, 261.41700000000003, 162.9655, 174.25, 117.7115, 191.2985, 35.653999999999996, 76.8305, 36.956, 160.7695, 42.7695, 212.8985, 204.9685, 153.833, 166.783, 169.7335, 145.142, 199.183, 196.2015, 189.684, 191.144, 192.942, 63.31, 160.5905, 0.022, 175.578, 147.7365, 11.096499999999999, 73.996, 58.754999999999995, 31.917, 79.6, 242.853, 24.914, 203.7305, 146.912, 58.3975, 48.972, 54.415, 20.025, 133.82350000000002, 139.3955, 66.1185, 60.7215, 118.31, 57.528000000000006, 185.1485, 52.352000000000004, 137.99450000000002, 63.921, 251.8555, 154.331, 136.73649999999998, 64.41550000000001, 211.11, 201.042, 313.7745, 168.17950000000002, 47.0845, 35.4715, 67.88550000000001, 180.2015, 73.874, 308.323, 279.66150000000005, 65.203, 61.53, 79.3925, 152.6205, 46.084500000000006, 71.3745, 28.6875, 60.8765, 50.329499999999996, 64.9785, 166.13299999999998, 247.762, 157.214, 53.1335, 143.07350000000002, 184.101, 139.4895, 153.3295, 127.452, 176.54149999999998, 157.31799999999998, 60.5495, 44.721000000000004, 174.47250000000003, 168.8775, 75.6235, 198.15300000000002, 65.4015, 228.016, 298.51, 49.060500000000005, 73.883, 65.4665, 157.64100000000002, 270.70050000000003, 149.01850000000002, 150.107, 158.413, 142.9905, 192.054, 186.89249999999998, 143.9805, 154.199, 137.9035, 159.139, 202.7145, 165.12, 175.2525, 129.647, 188.731, 173.127, 181.074, 216.24, 150.85399999999998, 169.0915, 123.24199999999999, 192.01549999999997, 144.683, 144.962, 145.9495, 67.93549999999999, 213.0365, 144.927, 29.554499999999997, 205.5745, 141.3905, 137.88049999999998, 84.6015, 60.437, 180.977, 80.346, 166.96699999999998, 70.8965, 163.5805, 177.071, 159.7845, 169.994, 280.51300000000003, 220.226, 179.442, 140.7, 191.3005, 70.319, 191.8185, 85.3685, 54.456500000000005, 154.66649999999998, 131.011, 133.27949999999998, 146.90550000000002, 232.19650000000001, 149.9895, 170.8355, 238.52999999999997, 209.808, 73.5135},
+		{242.6975, 132.72500000000002, 68.2775, 46.195499999999996, 261.5585, 50.003, 304.45, 68.815, 38.232, 158.81150000000002, 120.2715, 126.2105, 137.321, 133.038, 71.105, 122.80850000000001, 65.542, 70.3005, 330.0225, 174.6505, 75.203, 60.643, 78.586, 77.1175, 55.372, 179.452, 76.5925, 100.5635, 223.23250000000002, 183.853, 151.024, 130.6775, 48.839, 85.792, 76.1055, 186.983, 68.8255, 69.52199999999999, 199.5505, 267.452, 160.757, 121.6865, 184.26350000000002, 63.574, 138.898, 183.1275, 390.582, 77.5195, 335.858, 91.428, 58.4305, 95.0495, 116.395, 257.4295, 242.587, 87.12950000000001, 115.71000000000001, 356.2445, 175.601, 0.06, 43.342, 177.31650000000002, 144.18349999999998, 131.203, 197.9445, 123.4135, 184.5255, 156.3915, 104.75200000000001, 57.411, 145.316, 133.23649999999998, 159.9645, 198.7515, 73.959, 308.8655, 119.24549999999999, 154.8085, 177.5195, 134.096, 348.93449999999996, 145.93349999999998, 237.547, 128.1175, 186.3715, 308.938, 317.447, 111.207, 136.4785, 102.988, 205.6175, 243.083, 154.832, 150.2385, 148.64350000000002, 67.41149999999999, 113.07900000000001, 204.2105, 151.026, 197.2, 147.632, 132.1905, 323.416, 160.178, 110.40950000000001, 203.4235, 153.8415, 138.7715, 109.481, 67.7755, 207.3605, 79.8455, 213.714, 61.614999999999995, 95.7615, 48.5955, 64.358, 251.7895, 81.368, 55.0765, 137.9885, 182.877, 93.1155, 264.411, 150.1475, 308.8015, 119.6095, 145.4405, 205.083, 164.6405, 123.6045, 137.26, 61.7305, 184.6945, 48.8545, 69.2475, 61.8775, 43.081500000000005, 123.2465, 87.688, 56.5415, 52.7635, 50.181, 70.1225, 97.295, 74.939, 84.2625, 55.426, 102.89750000000001, 89.41749999999999, 91.602, 241.79500000000002, 61.7755, 70.96549999999999, 203.649, 98.096, 51.2635, 50.3795, 47.5265, 110.7385, 178.30849999999998, 52.56, 207.7935, 110.01400000000001, 41.626, 49.066, 202.86849999999998, 216.828, 351.058, 117.969, 68.9145, 107.344, 67.7115, 277.4045, 75.7915, 91.0505, 134.334, 122.0275, 86.5395, 50.7695, 81.924, 120.4455, 106.87950000000001, 121.256, 143.9645, 59.591499999999996, 210.879, 49.4395, 69.958, 133.9405, 55.736000000000004, 77.1885, 160.703, 124.69999999999999, 148.211},
+		{247.0585, 97.94049999999999, 34.947500000000005, 13.8065, 229.42950000000002, 11.8875, 272.89750000000004, 33.635, 6.972, 115.7415, 80.66499999999999, 89.74799999999999, 110.59549999999999, 91.45349999999999, 38.7055, 93.301, 8.9435, 30.2285, 289.606, 141.8835, 38.123, 27.396, 39.603, 34.9705, 18.09, 132.3835, 39.7025, 50.556, 259.53, 149.12400000000002, 112.113, 85.4305, 17.158, 56.8335, 38.81, 173.792, 36.9775, 36.7755, 164.4785, 238.053, 115.3145, 135.1445, 156.13, 20.856, 98.90549999999999, 148.603, 185.289, 44.973, 300.95399999999995, 66.06549999999999, 20.399, 71.336, 73.201, 189.80149999999998, 210.1225, 58.590500000000006, 81.64949999999999, 239.7575, 147.7305, 43.338, 0.0155, 139.793, 87.471, 86.988, 137.332, 89.59299999999999, 154.928, 121.76599999999999, 66.777, 16.0595, 106.203, 100.47749999999999, 115.67750000000001, 147.9885, 28.463, 263.35249999999996, 85.719, 107.16499999999999, 153.7505, 89.499, 301.822, 94.2, 171.4235, 103.262, 138.4085, 224.20100000000002, 267.358, 76.72800000000001, 91.6565, 68.027, 170.67649999999998, 257.4715, 111.8235, 108.77799999999999, 105.703, 32.296, 80.826, 182.65550000000002, 118.6695, 172.6925, 91.951, 95.69550000000001, 286.805, 123.34299999999999, 69.6585, 145.06400000000002, 111.124, 100.094, 79.6845, 34.9275, 184.197, 49.399, 173.166, 18.4745, 44.701, 6.612500000000001, 20.5975, 267.2135, 44.956500000000005, 24.1685, 91.6575, 135.4675, 68.9435, 263.7885, 114.2105, 272.784, 93.83099999999999, 122.2655, 182.16899999999998, 132.3795, 73.09049999999999, 90.8325, 19.198500000000003, 204.835, 16.595, 32.3025, 42.1415, 11.844000000000001, 62.075, 55.180499999999995, 12.6435, 21.849, 17.771, 27.417, 70.0555, 38.8105, 55.3495, 7.375, 75.1795, 41.2175, 44.279, 262.75300000000004, 26.627, 76.538, 169.627, 62.7485, 20.9565, 18.198, 18.469, 80.52000000000001, 153.7095, 14.4895, 155.556, 67.305, 10.7055, 14.887, 96.082, 110.20349999999999, 257.299, 79.3045, 28.397, 79.572, 26.921, 221.85899999999998, 27.258000000000003, 50.399, 239.774, 94.29249999999999, 51.1935, 28.589, 54.128, 87.003, 73.27199999999999, 93.87, 97.95349999999999, 17.945500000000003, 186.92700000000002, 9.341, 21.4845, 101.8735, 23.7905, 38.7535, 145.024, 92.025, 99.128},
+		{147.8485, 81.055, 172.059, 152.0635, 123.3875, 141.6705, 165.8435, 165.2415, 150.777, 43.933, 75.958, 79.87549999999999, 71.464, 84.07, 158.265, 56.37, 140.2455, 156.161, 227.195, 38.659, 154.37, 151.793, 162.31599999999997, 151.464, 161.83749999999998, 17.247, 163.894, 182.3225, 201.4795, 7.844, 48.629999999999995, 74.679, 156.7695, 177.00650000000002, 154.5805, 251.689, 172.4325, 170.503, 138.54250000000002, 205.937, 47.951499999999996, 58.1545, 41.796, 148.775, 61.138, 219.122, 203.196, 144.393, 160.2885, 166.23000000000002, 161.7545, 207.2255, 214.9385, 214.6765, 188.4575, 209.06650000000002, 73.05, 159.111, 11.1085, 177.2925, 139.47500000000002, 0.042, 84.033, 45.422, 33.1155, 80.314, 211.707, 28.897, 187.361, 140.4725, 68.1755, 82.33, 66.12, 24.919, 157.67849999999999, 151.727, 75.98349999999999, 67.05, 142.645, 67.372, 191.2835, 59.213, 131.3245, 63.851, 246.027, 168.9445, 142.479, 71.708, 256.241, 195.538, 323.2955, 117.95349999999999, 56.88, 21.592, 65.5015, 174.04649999999998, 70.75200000000001, 305.35900000000004, 254.6985, 68.263, 65.07050000000001, 68.6345, 180.17849999999999, 55.134, 81.06299999999999, 44.595, 70.757, 49.632000000000005, 77.631, 171.76999999999998, 231.9985, 150.2455, 53.1415, 151.106, 170.5315, 141.29950000000002, 160.513, 134.78050000000002, 171.214, 156.084, 68.45349999999999, 42.796499999999995, 170.3105, 171.2595, 84.7475, 210.38150000000002, 57.900000000000006, 218.26, 287.0875, 58.5455, 62.368, 73.96, 146.4375, 284.684, 146.961, 155.613, 159.574, 138.52300000000002, 198.9375, 196.513, 141.0355, 151.632, 153.80450000000002, 162.426, 206.5475, 174.909, 181.9235, 123.4695, 208.75099999999998, 170.8475, 162.582, 223.0185, 158.46, 172.19549999999998, 141.038, 203.5315, 146.64749999999998, 152.499, 148.0065, 75.44649999999999, 214.2505, 136.292, 26.381999999999998, 206.0275, 136.4565, 147.0645, 57.308499999999995, 94.39150000000001, 234.2235, 80.648, 155.03199999999998, 80.56200000000001, 156.721, 191.6745, 157.139, 185.141, 227.08550000000002, 218.56349999999998, 191.6035, 147.957, 197.078, 82.662, 204.5685, 75.313, 31.4955, 156.708, 155.4915, 146.151, 166.314, 229.3225, 155.22, 169.673, 252.8115, 230.0785, 73.823},
+		{143.8665, 25.756, 119.6035, 95.17099999999999, 187.469, 99.73849999999999, 365.395, 124.391, 85.2815, 36.872, 19.91, 26.4585, 43.772000000000006, 11.431000000000001, 113.7535, 31.6475, 108.678, 114.99350000000001, 359.59749999999997, 72.5995, 127.9675, 106.21000000000001, 112.2595, 117.7235, 108.4345, 57.466, 131.81900000000002, 144.9965, 250.06650000000002, 67.00999999999999, 29.563000000000002, 23.060000000000002, 98.069, 124.832, 128.535, 240.53199999999998, 127.4765, 147.8365, 101.2925, 155.7905, 44.7675, 17.909, 63.215500000000006, 107.8155, 29.252499999999998, 278.297, 279.10450000000003, 121.97749999999999, 219.40449999999998, 148.048, 95.815, 148.887, 154.187, 260.586, 301.0455, 161.089, 18.756999999999998, 222.9785, 74.0155, 143.926, 87.1795, 83.795, 0.022, 7.034000000000001, 75.32900000000001, 35.448, 237.63799999999998, 71.4085, 150.8605, 96.827, 31.4915, 31.941000000000003, 20.514, 64.899, 97.25450000000001, 204.018, 18.535, 33.4805, 104.0145, 12.0785, 229.137, 16.249, 116.285, 24.3615, 274.0335, 213.087, 192.92899999999997, 16.7225, 183.959, 153.8145, 267.1185, 163.108, 36.295500000000004, 35.929, 38.073499999999996, 121.3665, 26.273, 255.793, 213.50650000000002, 89.9125, 4.2005, 29.084000000000003, 205.281, 49.2555, 26.6625, 75.102, 38.929500000000004, 22.9625, 28.659, 119.6375, 297.91499999999996, 116.623, 108.90549999999999, 101.8335, 138.252, 92.04400000000001, 98.9545, 201.93200000000002, 129.95999999999998, 107.80250000000001, 23.682000000000002, 89.019, 137.1205, 227.804, 36.0615, 293.549, 23.468, 203.1825, 252.561, 61.1525, 30.5075, 13.64, 107.1395, 236.26350000000002, 102.22149999999999, 100.191, 117.446, 92.6765, 146.8725, 140.219, 100.756, 98.4145, 99.268, 124.25049999999999, 147.57150000000001, 121.6445, 142.029, 107.5095, 150.204, 130.774, 139.611, 256.5985, 111.707, 117.881, 104.14850000000001, 147.23399999999998, 92.07149999999999, 88.36, 96.232, 21.310000000000002, 259.39549999999997, 100.0745, 97.083, 152.053, 94.08, 102.7085, 23.852, 27.634999999999998, 236.999, 22.575499999999998, 113.2875, 14.756, 116.76, 151.9965, 113.376, 125.235, 290.9385, 166.135, 137.5775, 96.7645, 130.906, 26.9935, 153.7975, 24.054499999999997, 24.4695, 111.92, 112.94749999999999, 95.7945, 100.707, 182.9745, 109.581, 125.93799999999999, 219.4275, 199.88150000000002, 33.188},
+		{110.027, 23.1175, 120.6015, 89.615, 153.7385, 98.3535, 284.40599999999995, 117.67500000000001, 92.3175, 36.813, 19.207, 25.56, 38.189, 24.269, 106.685, 20.7605, 106.26750000000001, 116.0745, 266.473, 52.605999999999995, 117.249, 104.3665, 112.271, 111.594, 104.07, 51.767, 118.1615, 138.56650000000002, 203.01350000000002, 65.0955, 34.1995, 20.0905, 92.0475, 131.979, 122.75550000000001, 231.815, 122.3, 126.9165, 98.34649999999999, 159.4635, 42.318, 23.869, 51.7815, 115.903, 19.3285, 244.51600000000002, 223.346, 107.834, 211.72, 146.4545, 98.42750000000001, 157.668, 149.96949999999998, 240.6765, 253.0915, 147.3585, 19.2205, 192.83100000000002, 58.789, 130.54399999999998, 86.899, 45.451499999999996, 7.108499999999999, 0.043, 58.0865, 39.343, 230.1225, 44.370000000000005, 155.1725, 97.4245, 29.1595, 15.412500000000001, 16.759500000000003, 63.4465, 110.6605, 197.14600000000002, 24.244500000000002, 31.747500000000002, 99.1275, 5.3614999999999995, 221.2, 13.0835, 100.125, 30.941000000000003, 263.062, 203.0725, 189.6175, 20.2945, 167.512, 147.04700000000003, 269.97950000000003, 149.462, 26.887500000000003, 27.7245, 42.6445, 119.19999999999999, 20.2985, 247.518, 199.4135, 77.1615, 12.119, 19.695500000000003, 231.5255, 38.760999999999996, 20.479, 51.5285, 32.045500000000004, 21.838, 19.3625, 120.5575, 254.394, 111.814, 99.863, 99.512, 133.91750000000002, 87.49000000000001, 101.834, 159.202, 126.0095, 103.73150000000001, 23.758499999999998, 56.0065, 122.194, 213.3535, 31.468, 246.832, 20.4135, 193.6165, 242.07, 78.094, 28.772, 15.118500000000001, 96.84649999999999, 271.85450000000003, 91.4455, 103.9615, 114.8515, 88.582, 157.48250000000002, 140.3755, 94.809, 100.4155, 148.5215, 115.178, 152.8065, 113.93799999999999, 130.0585, 85.8515, 150.714, 122.9165, 140.822, 252.0555, 114.94, 123.981, 104.9465, 147.5345, 91.031, 88.6515, 91.5605, 22.5275, 222.808, 98.12299999999999, 65.48599999999999, 159.247, 88.18, 94.1165, 14.5085, 38.882999999999996, 252.899, 23.211, 107.018, 13.4545, 111.47, 143.64999999999998, 106.417, 132.60250000000002, 200.4875, 169.6205, 130.55, 105.54650000000001, 135.3775, 22.8155, 144.4615, 28.9615, 17.5215, 96.22749999999999, 115.658, 92.98349999999999, 103.3385, 181.6645, 100.56, 122.033, 215.473, 171.868, 13.567},
+		{165.5275, 56.0055, 176.64749999999998, 149.99, 85.529, 134.52100000000002, 239.852, 155.20350000000002, 139.961, 58.768, 77.877, 64.59649999999999, 74.0985, 71.0315, 162.185, 67.4465, 155.709, 161.908, 219.3135, 5.026, 161.6165, 168.2525, 155.098, 153.44400000000002, 140.5625, 15.711, 155.7935, 173.753, 158.5455, 28.9525, 53.8565, 77.689, 152.829, 185.91750000000002, 158.3855, 277.949, 158.4445, 166.2475, 140.085, 210.8395, 61.733000000000004, 69.3555, 8.0745, 157.079, 38.803, 250.8, 181.22050000000002, 161.6275, 174.0915, 167.4625, 156.7355, 202.5695, 208.1005, 203.381, 186.343, 200.71300000000002, 79.6875, 141.4005, 31.955, 197.969, 137.047, 33.122, 75.5705, 58.042500000000004, 0.041, 77.003, 254.9735, 40.015, 194.6515, 143.4685, 86.277, 44.817, 63.786, 23.749499999999998, 152.478, 145.90949999999998, 69.442, 76.50399999999999, 123.5355, 60.8395, 171.34550000000002, 58.358000000000004, 144.87400000000002, 82.625, 272.582, 164.2815, 127.19200000000001, 60.8385, 251.567, 197.659, 331.8265, 96.00399999999999, 53.1925, 29.6945, 75.2775, 185.41699999999997, 62.476, 283.2, 286.219, 34.267, 68.80850000000001, 71.1865, 196.82049999999998, 68.8175, 80.2925, 50.7125, 53.135999999999996, 49.954499999999996, 65.0325, 177.5965, 248.948, 152.82850000000002, 67.2055, 138.66250000000002, 177.5285, 128.274, 143.083, 104.763, 162.7585, 155.2615, 65.8885, 12.399, 177.406, 171.70549999999997, 38.1815, 202.305, 69.14, 220.961, 283.377, 78.48849999999999, 75.927, 67.58, 155.426, 282.3165, 138.0675, 169.8845, 158.18349999999998, 147.731, 192.9685, 201.0205, 134.4315, 155.5265, 151.1725, 148.7905, 184.67950000000002, 175.191, 169.1605, 134.851, 186.7455, 161.64499999999998, 182.191, 173.67, 176.9665, 161.8155, 166.661, 208.197, 154.8295, 155.68349999999998, 145.8955, 73.911, 248.46550000000002, 137.7745, 31.002499999999998, 219.2935, 145.4565, 136.109, 48.678, 77.16550000000001, 221.25549999999998, 83.256, 148.375, 73.16650000000001, 163.79000000000002, 184.7755, 144.96800000000002, 178.71249999999998, 275.7255, 250.216, 190.48250000000002, 144.4955, 205.99849999999998, 71.23150000000001, 196.08350000000002, 72.05000000000001, 40.049, 133.4675, 158.66199999999998, 131.707, 145.60399999999998, 229.638, 158.3045, 174.651, 220.193, 238.575, 72.07300000000001},
+		{185.0215, 27.909, 113.306, 90.38900000000001, 155.25650000000002, 92.3295, 304.3065, 113.3665, 88.81200000000001, 56.683499999999995, 18.5165, 15.6365, 50.0755, 38.189499999999995, 116.9995, 33.8565, 80.46, 107.9915, 320.68949999999995, 73.816, 122.188, 105.4765, 109.08099999999999, 116.892, 107.23150000000001, 82.0685, 115.185, 133.12900000000002, 228.63400000000001, 79.4, 53.4705, 25.6035, 94.8735, 129.873, 126.34100000000001, 237.35950000000003, 107.725, 128.4305, 101.8675, 179.42149999999998, 57.4165, 16.194499999999998, 76.08449999999999, 114.72900000000001, 45.732, 235.3825, 251.047, 115.68549999999999, 223.3655, 114.3625, 104.89150000000001, 139.8465, 139.22050000000002, 275.2375, 254.75150000000002, 126.92949999999999, 19.9325, 225.8275, 79.738, 123.5035, 89.741, 80.191, 35.641000000000005, 39.388999999999996, 77.1565, 0.055, 221.0315, 64.671, 140.468, 86.68, 50.938, 41.941, 46.0805, 85.751, 99.559, 197.7425, 25.262500000000003, 50.233000000000004, 87.54050000000001, 35.692, 259.229, 41.997, 110.02199999999999, 35.386, 236.01350000000002, 252.0855, 196.2215, 20.959, 165.332, 140.0335, 263.99199999999996, 188.5725, 61.0595, 56.126999999999995, 49.188500000000005, 108.3725, 18.555500000000002, 247.992, 185.7775, 104.5335, 37.7615, 44.84, 265.96950000000004, 65.1275, 24.1475, 95.00800000000001, 58.760000000000005, 54.1965, 24.0215, 113.4625, 254.2355, 115.70949999999999, 128.313, 96.8045, 110.86099999999999, 88.07, 102.4435, 170.993, 109.13300000000001, 107.6555, 32.1765, 80.72999999999999, 123.525, 229.7, 53.637, 266.84950000000003, 17.8775, 182.0005, 243.747, 95.53800000000001, 27.6085, 40.565, 106.93199999999999, 283.2845, 94.672, 108.257, 107.2295, 91.3395, 152.745, 126.796, 93.924, 99.04, 97.869, 108.168, 139.243, 106.6465, 121.5455, 89.74000000000001, 154.40699999999998, 120.986, 113.7045, 233.348, 114.709, 124.258, 109.7565, 143.80399999999997, 83.2465, 91.465, 93.152, 19.278, 250.144, 100.7945, 96.42949999999999, 150.86700000000002, 90.2165, 95.181, 41.117999999999995, 58.657, 247.0375, 25.533, 107.6755, 25.3485, 108.714, 143.939, 104.768, 125.8135, 168.821, 175.8915, 130.3835, 93.204, 135.8025, 31.184, 146.798, 22.633000000000003, 44.177, 91.3715, 118.273, 80.833, 102.735, 183.4855, 105.765, 117.55, 246.563, 162.9305, 47.215},
+		{363.9585, 227.3465, 156.18200000000002, 137.74450000000002, 103.0355, 148.1585, 149.524, 171.7625, 149.255, 250.9425, 214.846, 255.9435, 246.85250000000002, 215.153, 174.579, 230.786, 159.115, 144.6725, 151.532, 250.7625, 199.4395, 153.5285, 157.0225, 168.04149999999998, 151.65949999999998, 287.286, 166.702, 171.21949999999998, 43.025999999999996, 239.493, 242.19549999999998, 223.4395, 149.007, 179.9985, 167.3795, 42.4915, 157.65249999999997, 181.675, 349.1365, 348.4575, 250.18200000000002, 227.642, 290.12149999999997, 152.983, 260.03499999999997, 15.503, 47.6655, 167.68400000000003, 355.7655, 152.63400000000001, 149.127, 196.89499999999998, 201.4255, 60.846999999999994, 220.3685, 196.906, 231.1375, 66.696, 242.8475, 184.51749999999998, 155.0365, 211.732, 237.9715, 230.0245, 255.0155, 220.809, 0.075, 269.0195, 200.3775, 157.00349999999997, 252.5985, 239.25549999999998, 250.677, 251.9615, 157.299, 89.973, 240.91199999999998, 261.727, 316.6495, 233.304, 397.89549999999997, 232.515, 302.086, 233.714, 42.536, 74.4075, 332.11249999999995, 225.909, 224.671, 189.712, 289.39549999999997, 100.697, 251.07, 277.77750000000003, 255.33350000000002, 164.2485, 226.81900000000002, 295.512, 229.09300000000002, 326.2085, 239.8195, 240.2955, 345.706, 256.204, 238.845, 260.1755, 257.3565, 241.3295, 209.5805, 149.52749999999997, 73.312, 154.01749999999998, 289.4255, 148.29199999999997, 184.51049999999998, 139.299, 152.0185, 124.35749999999999, 161.5225, 134.56650000000002, 257.79650000000004, 282.3245, 171.744, 78.85300000000001, 260.9595, 78.967, 219.35750000000002, 222.666, 326.6075, 265.01649999999995, 269.3115, 236.538, 140.5935, 131.4185, 149.45499999999998, 147.2365, 162.7475, 155.33300000000003, 195.434, 198.00650000000002, 148.5975, 150.155, 149.99349999999998, 159.19299999999998, 192.70350000000002, 140.748, 191.43900000000002, 139.3825, 242.2785, 164.058, 173.5805, 77.7195, 149.0315, 173.0735, 338.63750000000005, 186.051, 152.00799999999998, 152.191, 174.26749999999998, 224.0655, 9.11, 142.13600000000002, 253.71699999999998, 202.659, 151.6635, 141.046, 237.76749999999998, 237.8785, 82.87299999999999, 211.735, 144.099, 233.3835, 160.302, 371.5775, 156.575, 267.548, 227.4195, 219.327, 191.742, 143.9395, 193.1775, 224.401, 195.335, 245.015, 249.947, 203.9205, 316.064, 140.0275, 152.26, 240.716, 182.9855, 175.954, 28.1775, 226.5515, 236.757},
+		{141.888, 51.655, 144.188, 124.15700000000001, 138.876, 126.60499999999999, 184.3505, 144.1275, 119.184, 35.519000000000005, 51.3565, 57.4825, 85.61949999999999, 57.1065, 152.95049999999998, 52.519000000000005, 130.608, 145.73, 180.7835, 39.9685, 158.972, 140.222, 153.1935, 152.42649999999998, 129.92149999999998, 23.9155, 146.69, 161.79, 185.4425, 28.172, 47.554, 59.7585, 128.317, 154.1905, 153.503, 305.52250000000004, 149.34050000000002, 156.808, 117.54, 186.95999999999998, 41.888, 56.697, 76.045, 150.4495, 21.064500000000002, 253.154, 211.183, 156.01749999999998, 178.893, 178.32, 134.6515, 179.711, 176.67700000000002, 313.419, 229.2825, 198.14749999999998, 68.5025, 199.0745, 24.941499999999998, 156.40449999999998, 121.79650000000001, 28.881, 71.424, 44.332499999999996, 40.0395, 64.559, 269.0375, 0.034, 180.07999999999998, 132.053, 81.1765, 26.802, 72.352, 29.0105, 141.2765, 154.94799999999998, 50.897, 69.866, 140.5595, 52.37, 196.42149999999998, 69.19149999999999, 138.3175, 58.941500000000005, 287.16200000000003, 246.815, 197.3205, 49.367999999999995, 209.11849999999998, 177.683, 290.4625, 133.699, 50.1525, 39.587, 68.9985, 149.0995, 50.563500000000005, 283.65700000000004, 220.735, 88.1335, 72.2415, 66.565, 166.5645, 57.1055, 73.08949999999999, 38.7565, 42.322, 29.097, 69.0455, 143.75900000000001, 263.146, 155.63049999999998, 60.6105, 125.6785, 151.615, 116.053, 126.94649999999999, 170.06099999999998, 161.2285, 152.00150000000002, 62.785, 62.599500000000006, 155.96800000000002, 183.3545, 56.8585, 219.101, 48.0925, 225.0725, 274.29049999999995, 69.561, 59.896, 44.2, 149.04000000000002, 266.619, 142.5725, 143.38150000000002, 141.61, 122.5015, 174.215, 164.291, 120.6585, 131.47250000000003, 140.9325, 156.8165, 168.51999999999998, 143.0325, 161.5445, 125.6495, 180.08300000000003, 148.20100000000002, 174.2455, 207.473, 138.549, 149.7375, 119.61699999999999, 193.4435, 123.7105, 123.434, 126.656, 53.231, 291.6755, 131.68, 33.879999999999995, 172.38049999999998, 120.015, 138.601, 56.998000000000005, 82.054, 272.2005, 67.005, 163.66649999999998, 72.98349999999999, 137.889, 190.3305, 139.2, 151.547, 226.5385, 206.189, 179.61599999999999, 130.37150000000003, 164.1615, 64.05, 185.5235, 56.1005, 52.349, 130.473, 148.482, 121.37549999999999, 128.414, 210.374, 150.9255, 148.67899999999997, 242.46949999999998, 209.5135, 38.7445},
+		{276.0925, 143.857, 78.377, 68.45349999999999, 292.16200000000003, 69.8185, 327.4945, 87.9935, 63.756, 174.5525, 128.212, 140.2545, 158.0405, 150.21550000000002, 82.825, 147.385, 75.854, 71.5035, 331.7975, 194.925, 83.39250000000001, 73.10249999999999, 73.748, 87.6495, 68.98400000000001, 196.63299999999998, 81.804, 94.4545, 223.4475, 197.5675, 170.8985, 135.30349999999999, 73.53, 117.43799999999999, 85.4405, 217.869, 74.7525, 82.124, 218.75549999999998, 292.592, 170.48, 145.259, 199.495, 78.7955, 160.965, 202.573, 229.973, 82.9945, 365.07550000000003, 109.713, 64.608, 110.588, 116.99799999999999, 258.51300000000003, 320.85900000000004, 95.652, 166.23149999999998, 231.121, 203.6875, 104.756, 66.793, 187.5925, 150.8425, 155.1315, 194.95600000000002, 140.465, 200.3825, 180.178, 0.0295, 77.8675, 154.911, 156.513, 162.85, 203.235, 78.77350000000001, 314.2115, 132.524, 155.223, 225.834, 156.275, 456.632, 170.13, 227.2335, 155.39249999999998, 208.10399999999998, 306.343, 298.219, 129.4675, 138.779, 111.6865, 233.55349999999999, 282.5845, 177.89, 168.18599999999998, 167.675, 92.42099999999999, 129.5935, 234.0775, 159.72050000000002, 222.375, 161.437, 153.266, 349.7935, 176.63, 136.918, 216.25900000000001, 165.043, 164.0935, 132.02550000000002, 81.74350000000001, 319.164, 81.14150000000001, 238.4015, 74.821, 87.648, 62.782, 72.5275, 305.33000000000004, 84.5215, 78.545, 139.274, 208.279, 90.26750000000001, 314.62649999999996, 173.20749999999998, 256.108, 133.7335, 149.6895, 214.5965, 209.23000000000002, 144.2285, 156.7015, 76.7075, 276.3405, 67.82900000000001, 69.6225, 64.556, 67.914, 117.4605, 113.2805, 62.3245, 65.142, 68.2625, 70.7495, 102.04400000000001, 91.6105, 103.987, 63.191, 122.0265, 89.9675, 81.884, 335.972, 67.71449999999999, 80.594, 219.93099999999998, 107.40950000000001, 64.48599999999999, 65.65700000000001, 63.0305, 138.6995, 201.014, 70.4685, 213.849, 75.32249999999999, 61.814, 70.8495, 152.7165, 173.7545, 290.983, 212.0435, 70.80250000000001, 138.39800000000002, 81.2575, 261.05600000000004, 79.636, 67.8295, 159.46249999999998, 147.1725, 97.431, 72.616, 63.3485, 139.809, 103.881, 147.129, 160.826, 76.542, 236.6925, 61.681, 77.6785, 153.42149999999998, 71.245, 79.819, 194.34, 127.684, 161.4665},
+		{224.5985, 96.056, 39.663, 17.612499999999997, 235.29250000000002, 17.0895, 277.7265, 40.5835, 12.143999999999998, 123.126, 83.011, 94.3355, 110.3015, 87.5205, 39.394000000000005, 95.7375, 19.7795, 39.7665, 298.077, 146.344, 40.296499999999995, 31.417, 36.5005, 39.118, 28.098, 137.65449999999998, 40.7625, 62.896, 187.91899999999998, 150.303, 116.847, 92.083, 20.261, 55.669, 44.4285, 155.36849999999998, 42.974000000000004, 40.4805, 160.489, 248.603, 122.2765, 89.95, 143.10399999999998, 18.634, 110.9175, 160.983, 288.8725, 52.713499999999996, 297.8945, 60.894000000000005, 24.305, 70.0565, 77.1235, 204.9845, 272.1435, 57.336, 79.156, 244.70350000000002, 146.9515, 57.421, 16.054000000000002, 140.507, 97.086, 97.3055, 143.3875, 86.5915, 157.022, 131.9985, 77.8835, 0.021, 110.1935, 109.54599999999999, 105.8895, 153.96550000000002, 40.189499999999995, 263.74800000000005, 92.703, 119.0185, 170.695, 91.968, 304.231, 100.69399999999999, 177.995, 101.9865, 144.3125, 247.01850000000002, 271.1135, 81.5775, 102.4385, 73.59649999999999, 186.98250000000002, 233.7465, 111.945, 114.91900000000001, 112.20400000000001, 37.4075, 83.0325, 184.5255, 125.0565, 177.473, 90.0755, 106.6285, 286.802, 128.358, 72.8195, 145.1095, 125.275, 107.77250000000001, 82.8315, 39.4195, 188.3945, 38.9715, 181.68099999999998, 21.1035, 60.234, 10.9295, 38.921, 239.322, 49.611, 27.95, 92.9025, 142.7135, 47.2025, 243.0745, 121.71950000000001, 280.71349999999995, 74.446, 98.5915, 173.3665, 135.935, 81.131, 94.377, 23.368000000000002, 217.989, 19.092, 28.695, 35.875, 14.3115, 57.848, 59.408500000000004, 19.649, 22.255000000000003, 15.190999999999999, 47.0145, 67.271, 42.4835, 56.304, 9.4085, 77.72149999999999, 39.968, 61.1635, 220.16, 34.179500000000004, 37.733, 169.2105, 66.664, 17.7385, 16.8575, 15.407, 83.8695, 156.398, 21.439, 155.842, 72.845, 14.985, 20.612000000000002, 100.96799999999999, 110.66499999999999, 265.2595, 80.6375, 31.7825, 78.703, 35.997, 203.07150000000001, 31.1355, 48.033, 105.924, 87.2, 57.093999999999994, 20.874000000000002, 61.751999999999995, 92.866, 69.955, 95.1115, 105.3355, 22.451999999999998, 183.2065, 13.028500000000001, 41.202, 105.233, 28.2075, 40.271, 186.005, 92.78200000000001, 99.5445},
+		{89.562, 41.3585, 125.77199999999999, 103.9075, 178.216, 100.0135, 298.73, 139.127, 98.1585, 38.183499999999995, 29.749, 32.2545, 9.8645, 20.136, 116.1885, 11.9265, 110.569, 124.451, 313.821, 84.49000000000001, 138.60399999999998, 122.36699999999999, 125.6005, 135.1385, 120.02449999999999, 71.54150000000001, 132.348, 151.67849999999999, 241.014, 61.326, 22.0715, 27.204, 111.309, 130.0435, 128.6825, 246.6935, 128.4765, 149.254, 56.1655, 131.25150000000002, 36.5105, 36.2035, 70.89, 122.343, 40.314499999999995, 264.5105, 281.8905, 123.3615, 225.4105, 154.64999999999998, 108.19200000000001, 158.27050000000003, 162.4665, 247.32600000000002, 246.247, 156.5365, 27.18, 244.0915, 58.4525, 145.37849999999997, 106.221, 68.21549999999999, 31.3575, 29.183, 86.309, 50.8825, 252.5395, 81.178, 154.9525, 110.2345, 0.0485, 36.4775, 45.062, 76.57249999999999, 97.32900000000001, 195.067, 39.2765, 3.004, 62.659, 31.2835, 239.93200000000002, 41.078, 73.971, 24.596, 235.341, 217.238, 192.27949999999998, 27.6, 177.106, 161.0845, 281.024, 161.66649999999998, 38.802, 43.869, 18.027, 131.042, 28.4085, 276.957, 213.2975, 113.14099999999999, 22.002000000000002, 51.0565, 210.948, 41.709500000000006, 34.21, 67.0125, 50.495, 44.9585, 28.618499999999997, 126.12950000000001, 273.0055, 128.90800000000002, 114.186, 105.36699999999999, 144.5235, 101.9445, 108.065, 182.2995, 132.24, 108.4455, 32.5265, 83.705, 141.039, 220.7815, 55.6415, 259.5535, 27.9195, 192.058, 262.0665, 48.5945, 36.8795, 31.9925, 108.4705, 239.6885, 103.039, 113.99, 118.4245, 105.45249999999999, 154.842, 148.743, 106.4135, 117.19200000000001, 99.97049999999999, 137.635, 151.7625, 128.79250000000002, 143.7725, 92.9065, 154.09449999999998, 127.4805, 150.1585, 261.892, 113.8775, 131.34050000000002, 63.363, 156.2515, 107.795, 108.2975, 108.8825, 36.815, 265.1305, 107.96950000000001, 88.816, 161.154, 106.2985, 101.634, 40.033, 20.1235, 250.1335, 34.736, 116.3475, 34.312, 125.3945, 130.2525, 116.9185, 132.72050000000002, 269.755, 183.8755, 140.265, 111.15549999999999, 145.276, 27.2975, 155.0395, 40.636, 48.034, 113.116, 79.564, 95.844, 110.3755, 195.2055, 109.68100000000001, 131.659, 231.8815, 208.025, 38.480000000000004},
+		{111.967, 19.7455, 127.052, 100.018, 150.3985, 106.9385, 204.7045, 120.965, 92.77250000000001, 18.493000000000002, 26.854, 29.3595, 42.983000000000004, 30.4045, 145.6995, 22.852, 105.0985, 125.39949999999999, 200.6815, 50.504, 122.80850000000001, 111.169, 120.21549999999999, 118.1035, 108.6075, 41.227500000000006, 126.331, 151.168, 216.762, 51.3445, 27.933, 34.2535, 102.042, 135.94, 123.041, 316.49249999999995, 132.086, 125.459, 100.759, 179.061, 26.5255, 29.046, 51.111, 110.4735, 6.3165, 251.792, 220.8865, 136.379, 198.9925, 144.4265, 108.678, 157.2645, 151.026, 234.517, 220.77300000000002, 145.2055, 29.79, 187.87900000000002, 49.156, 133.183, 100.69200000000001, 82.428, 31.832, 15.8155, 44.8715, 41.9225, 239.4245, 26.829, 156.505, 109.429, 36.4045, 0.0525, 19.633000000000003, 68.8175, 120.625, 165.0895, 20.6495, 48.3945, 94.4765, 11.019, 205.4065, 16.7735, 103.5555, 31.5015, 252.673, 210.63150000000002, 175.1515, 25.939999999999998, 174.502, 164.69, 257.8245, 137.6925, 13.837, 18.2855, 55.8065, 125.75450000000001, 24.069000000000003, 263.83299999999997, 219.368, 78.78, 32.111999999999995, 27.6585, 186.94650000000001, 41.291, 30.5285, 60.498, 27.7085, 14.196, 48.0565, 127.8075, 248.9075, 116.991, 87.65950000000001, 110.75, 131.389, 90.838, 108.82249999999999, 160.2625, 137.9275, 110.71449999999999, 32.533500000000004, 55.5105, 143.074, 216.2175, 32.693, 239.54149999999998, 27.266, 191.80349999999999, 260.932, 44.5175, 31.601, 18.8475, 113.382, 261.407, 120.8895, 111.15350000000001, 119.28999999999999, 96.5075, 166.52800000000002, 149.1815, 110.80199999999999, 109.601, 108.345, 128.6775, 146.03050000000002, 125.3185, 138.6905, 101.55799999999999, 146.9865, 123.4055, 148.93900000000002, 250.288, 120.056, 125.039, 92.9375, 158.29000000000002, 106.833, 100.2345, 110.73599999999999, 28.2325, 246.567, 105.753, 54.259, 164.2845, 95.56700000000001, 119.737, 10.152999999999999, 39.022999999999996, 218.959, 30.130499999999998, 132.75150000000002, 24.0, 127.5925, 163.962, 118.271, 129.94549999999998, 216.26, 187.5055, 160.166, 101.7735, 144.38049999999998, 29.5795, 163.4185, 24.007, 11.5405, 105.8185, 113.32650000000001, 96.862, 109.2365, 187.377, 102.32050000000001, 131.1155, 263.4705, 178.8855, 14.8345},
+		{148.14350000000002, 36.385, 138.05599999999998, 102.8755, 170.63, 106.5555, 271.2105, 124.08099999999999, 98.432, 25.836, 30.451999999999998, 33.727500000000006, 42.2145, 30.1495, 124.976, 26.5245, 124.52000000000001, 126.36250000000001, 286.5565, 61.4865, 128.006, 119.097, 124.113, 121.0565, 120.125, 56.5595, 138.472, 142.81650000000002, 238.9355, 57.7045, 32.0255, 35.566500000000005, 106.976, 141.20850000000002, 131.171, 240.1895, 139.359, 144.668, 105.2375, 169.2735, 39.458, 35.755, 61.178, 113.521, 30.238, 239.546, 252.098, 132.1865, 201.385, 132.0585, 117.00800000000001, 163.094, 162.8935, 247.06900000000002, 217.99450000000002, 145.92450000000002, 32.3005, 207.1165, 54.5025, 160.0195, 115.771, 65.9805, 20.761499999999998, 16.801499999999997, 63.8805, 46.010000000000005, 250.5695, 72.4075, 163.3115, 105.917, 45.1475, 19.6285, 0.015, 61.944, 131.341, 179.079, 32.733999999999995, 35.8475, 109.2775, 14.49, 217.123, 25.153, 112.05799999999999, 45.1115, 231.6345, 235.32600000000002, 191.376, 33.051, 196.004, 165.352, 267.76300000000003, 176.93349999999998, 32.6355, 31.956, 42.658500000000004, 128.92, 32.421499999999995, 278.20799999999997, 190.5895, 93.75049999999999, 17.981, 35.826, 186.951, 41.309, 36.3035, 48.894999999999996, 44.120000000000005, 22.177, 34.8165, 147.07999999999998, 272.1445, 121.4025, 94.8925, 108.9345, 149.1055, 100.80600000000001, 109.5705, 175.0245, 141.42399999999998, 117.336, 36.838499999999996, 86.85849999999999, 139.509, 204.64049999999997, 35.473, 268.99850000000004, 32.188500000000005, 206.654, 281.9585, 58.2115, 40.208, 31.214, 119.6255, 287.799, 109.84899999999999, 123.4525, 121.91550000000001, 101.311, 156.478, 147.757, 105.5555, 118.5565, 107.487, 133.42149999999998, 173.097, 129.33749999999998, 143.37, 114.4635, 170.522, 132.8755, 141.997, 241.01799999999997, 123.439, 136.4785, 111.1225, 158.452, 108.471, 106.53999999999999, 105.832, 33.480999999999995, 245.33499999999998, 110.085, 69.267, 167.5615, 102.676, 108.6245, 32.3125, 41.463, 236.417, 97.227, 124.3155, 32.9365, 123.31049999999999, 163.062, 123.6565, 135.75650000000002, 303.0525, 191.296, 149.021, 115.1685, 153.957, 38.043, 163.2015, 35.3585, 26.1805, 128.0465, 113.70349999999999, 102.68, 113.893, 192.04500000000002, 116.12700000000001, 136.894, 264.398, 188.8535, 34.418499999999995},
+		{150.00799999999998, 64.943, 179.28199999999998, 144.0775, 119.20400000000001, 146.4795, 172.9595, 165.59050000000002, 137.558, 45.866, 72.938, 77.78299999999999, 76.9575, 71.88, 165.4235, 60.316, 158.454, 173.1375, 211.549, 30.7115, 166.2745, 158.676, 164.623, 162.957, 152.63049999999998, 8.952, 172.64100000000002, 202.72899999999998, 202.58249999999998, 16.177500000000002, 49.817, 75.0195, 148.0795, 174.41899999999998, 167.6365, 275.286, 180.868, 183.4065, 134.3425, 204.53199999999998, 55.1065, 79.7115, 29.869500000000002, 157.4235, 47.79, 244.69, 207.97899999999998, 173.3675, 170.11, 189.83, 153.132, 209.9775, 196.498, 220.876, 194.9445, 201.7005, 74.62, 160.9265, 20.0915, 198.739, 148.055, 24.903, 65.237, 63.415, 23.700499999999998, 86.00200000000001, 252.06099999999998, 29.018, 203.26749999999998, 153.9385, 76.5935, 68.938, 61.856, 0.054, 162.9495, 132.63049999999998, 83.38050000000001, 72.43799999999999, 110.482, 58.831, 178.216, 59.6145, 143.3505, 71.45400000000001, 256.855, 177.351, 139.2815, 73.8825, 226.50099999999998, 206.332, 323.1165, 120.55799999999999, 52.771, 36.3005, 78.7225, 166.88799999999998, 80.622, 313.528, 247.666, 57.236000000000004, 61.918, 78.265, 158.656, 58.855999999999995, 77.5195, 41.584, 57.93, 62.311, 75.539, 172.648, 269.38649999999996, 160.114, 61.7475, 160.45850000000002, 171.8105, 140.71800000000002, 157.5095, 238.959, 183.237, 157.48250000000002, 73.366, 35.427, 193.448, 177.459, 79.42349999999999, 230.5645, 72.47999999999999, 249.812, 323.486, 71.67500000000001, 78.35749999999999, 64.937, 152.421, 287.347, 149.867, 156.9035, 160.8225, 142.856, 204.025, 179.5605, 145.527, 153.1115, 149.202, 179.3065, 207.08249999999998, 168.098, 182.9085, 149.649, 212.28199999999998, 171.3475, 199.49, 189.20800000000003, 170.8095, 175.7885, 142.077, 201.3195, 155.0575, 144.81599999999997, 153.528, 75.49000000000001, 245.4085, 155.68, 19.701, 214.35750000000002, 142.542, 148.59, 85.819, 99.4345, 183.8965, 73.9825, 185.21949999999998, 73.7055, 168.59199999999998, 194.804, 157.7985, 187.85649999999998, 338.895, 232.784, 187.1605, 150.85199999999998, 199.7155, 73.53800000000001, 203.421, 81.237, 53.7735, 151.458, 146.186, 142.921, 170.298, 235.075, 157.006, 180.777, 246.00650000000002, 217.25549999999998, 78.3295},
+		{236.43650000000002, 113.713, 39.811, 18.0005, 229.899, 26.5225, 273.71799999999996, 53.8815, 23.9145, 139.051, 95.1075, 105.794, 150.896, 93.289, 8.802, 97.298, 33.905, 44.5315, 298.23, 148.2995, 56.1145, 41.2705, 59.7585, 41.887, 32.811, 133.6565, 50.638999999999996, 64.787, 186.9595, 136.083, 118.5335, 97.6275, 35.588, 40.5355, 58.285, 155.22899999999998, 48.533500000000004, 58.884, 162.756, 244.9365, 129.30349999999999, 91.445, 164.6695, 28.5785, 116.84899999999999, 151.983, 214.461, 8.6065, 320.5715, 64.159, 24.75, 95.184, 58.2955, 180.892, 289.104, 68.5755, 79.598, 211.563, 133.766, 73.875, 28.3935, 157.617, 97.69900000000001, 110.568, 152.409, 99.39699999999999, 157.2595, 141.212, 78.7205, 40.121, 97.244, 120.5505, 131.17000000000002, 162.8885, 0.021, 286.2865, 99.1265, 104.49950000000001, 162.343, 92.67, 304.56, 121.2435, 196.7765, 113.5515, 146.171, 240.6575, 268.899, 92.595, 125.702, 76.787, 208.225, 243.296, 114.0055, 110.74199999999999, 125.844, 52.659499999999994, 95.8205, 201.969, 123.02449999999999, 177.613, 101.874, 97.1805, 289.51250000000005, 138.34199999999998, 87.655, 142.642, 112.8015, 104.4455, 100.42150000000001, 39.708, 174.096, 39.391000000000005, 174.184, 29.66, 65.7735, 24.3815, 33.7885, 255.7825, 57.119, 25.387999999999998, 113.773, 138.20600000000002, 57.716499999999996, 289.3835, 129.36849999999998, 284.246, 80.57900000000001, 114.6315, 193.317, 122.95349999999999, 89.34899999999999, 84.5135, 21.825499999999998, 142.8285, 34.013, 25.991500000000002, 21.596, 29.9055, 68.4235, 62.536500000000004, 27.6695, 37.28, 23.0775, 46.815, 98.2775, 35.0535, 57.8155, 31.855, 85.411, 51.576499999999996, 69.422, 205.4495, 41.1665, 46.312, 165.0675, 69.1555, 33.326, 31.937, 33.3905, 99.82249999999999, 156.447, 28.595, 162.924, 83.41050000000001, 28.326500000000003, 33.180499999999995, 110.7495, 123.03450000000001, 184.2175, 91.77199999999999, 33.547, 87.331, 30.4335, 228.4985, 39.414, 36.7065, 109.931, 98.6185, 51.841, 30.433, 67.02000000000001, 117.459, 74.445, 114.078, 102.4005, 38.2805, 181.3895, 30.3155, 35.296, 123.58099999999999, 32.545, 53.1295, 127.8015, 92.6635, 100.81700000000001},
+		{319.6755, 198.952, 293.725, 272.1865, 33.002, 258.617, 171.342, 285.56899999999996, 270.121, 169.50400000000002, 199.726, 202.5335, 199.07049999999998, 192.4005, 259.26750000000004, 179.33, 271.1355, 292.6705, 227.2715, 144.3725, 262.705, 278.943, 291.34799999999996, 245.796, 283.097, 150.7475, 297.807, 284.83849999999995, 65.93299999999999, 131.9805, 166.788, 207.864, 269.2525, 298.10249999999996, 289.54200000000003, 130.59550000000002, 304.1335, 298.48199999999997, 302.7415, 346.1255, 172.8845, 188.499, 199.56900000000002, 278.2695, 178.4075, 105.652, 75.963, 258.7995, 233.434, 308.3245, 283.121, 325.64549999999997, 318.408, 81.87950000000001, 83.9845, 320.031, 197.77249999999998, 27.54, 139.39600000000002, 308.856, 263.35900000000004, 151.735, 204.7815, 197.14, 145.8945, 197.64100000000002, 90.018, 154.9345, 314.24, 263.649, 195.107, 165.091, 179.06650000000002, 132.63150000000002, 286.342, 0.0395, 198.2155, 189.1635, 291.4525, 177.493, 287.47299999999996, 175.4335, 271.1225, 191.9975, 120.49799999999999, 49.146, 64.397, 192.019, 289.719, 333.284, 411.0285, 40.879999999999995, 168.8295, 171.027, 198.551, 290.085, 185.846, 394.589, 163.625, 283.4095, 178.7335, 176.165, 213.582, 178.966, 198.7385, 180.52749999999997, 179.4375, 181.661, 209.88150000000002, 290.619, 108.229, 246.90300000000002, 144.043, 278.929, 309.834, 257.1395, 210.6775, 48.1065, 299.673, 288.5425, 213.62, 154.3485, 323.3275, 185.5095, 210.6485, 210.5125, 200.589, 364.4375, 279.533, 180.837, 210.14600000000002, 179.53199999999998, 277.655, 248.856, 280.892, 214.0005, 295.108, 262.3965, 306.18, 296.02250000000004, 282.8795, 283.5345, 268.6135, 301.379, 339.39599999999996, 298.778, 274.225, 232.4185, 334.4085, 257.9285, 317.83299999999997, 59.308499999999995, 255.716, 292.218, 295.83950000000004, 322.89, 270.9395, 278.97850000000005, 273.3005, 191.45850000000002, 94.852, 239.02249999999998, 141.53, 328.23400000000004, 258.82849999999996, 239.87650000000002, 179.6035, 190.211, 52.0375, 190.609, 258.12350000000004, 189.73950000000002, 253.39350000000002, 317.4915, 296.134, 331.764, 321.156, 358.02750000000003, 306.60400000000004, 245.714, 319.16150000000005, 197.122, 336.88149999999996, 200.459, 174.811, 268.9315, 302.59900000000005, 255.9735, 203.19400000000002, 353.034, 272.6435, 294.413, 114.656, 355.1335, 194.6445},
+		{152.3835, 19.8305, 112.318, 80.506, 183.8275, 87.8635, 228.94400000000002, 108.319, 79.0115, 38.191, 10.1105, 18.613500000000002, 40.8655, 14.1035, 105.133, 26.977, 86.975, 108.8725, 228.101, 62.272999999999996, 111.921, 97.187, 104.84100000000001, 117.161, 95.6645, 80.544, 105.63650000000001, 136.0365, 249.625, 69.1315, 41.560500000000005, 19.4805, 83.1585, 121.5185, 110.6105, 217.9435, 112.0, 119.92, 93.5945, 159.477, 44.341499999999996, 15.715499999999999, 69.786, 91.5845, 25.7245, 243.1365, 246.71800000000002, 121.5655, 216.1775, 117.1945, 87.337, 135.6455, 146.2625, 247.97050000000002, 267.2185, 127.456, 12.4215, 207.7235, 66.145, 119.249, 85.7265, 76.005, 18.741500000000002, 24.194000000000003, 69.4625, 25.1615, 240.9385, 50.8765, 132.51850000000002, 92.696, 39.227, 20.642000000000003, 32.6165, 83.403, 99.1815, 197.955, 0.031, 47.6005, 77.537, 10.9225, 223.29399999999998, 20.0445, 101.1785, 26.529, 212.09750000000003, 220.7725, 190.7415, 10.781500000000001, 159.6505, 146.0365, 239.714, 170.6335, 32.279, 38.3525, 50.4125, 111.149, 10.1495, 249.4455, 185.9355, 96.7505, 23.043999999999997, 35.355000000000004, 221.851, 53.595, 13.721, 62.163, 45.0195, 75.09, 12.236, 109.661, 253.904, 119.8215, 119.5415, 90.2435, 112.15950000000001, 74.22999999999999, 98.202, 176.3665, 117.781, 91.2875, 22.958, 77.682, 121.789, 270.091, 42.616, 264.546, 10.948, 191.1005, 235.51, 61.576, 18.072499999999998, 31.409, 94.21199999999999, 230.038, 86.1585, 91.7825, 100.3415, 79.812, 143.542, 129.344, 93.7685, 96.397, 80.281, 117.1875, 133.22, 107.4725, 117.5035, 78.6685, 130.1525, 122.262, 136.8465, 270.281, 107.08500000000001, 104.193, 96.17699999999999, 139.62, 81.60149999999999, 79.76249999999999, 90.061, 13.284500000000001, 245.4035, 87.7765, 95.173, 145.363, 76.15950000000001, 97.163, 27.3685, 51.0365, 234.53, 15.956, 101.2295, 4.53, 108.817, 150.072, 108.0795, 110.4445, 217.906, 170.9595, 122.5695, 92.2505, 128.7695, 20.887, 137.82850000000002, 23.011, 34.5505, 93.67, 112.53999999999999, 80.37899999999999, 100.137, 168.38400000000001, 87.22999999999999, 114.497, 252.11149999999998, 167.1005, 19.055999999999997},
+		{80.629, 40.9065, 130.853, 108.94999999999999, 165.42649999999998, 116.266, 295.163, 136.7345, 103.152, 42.5245, 34.4615, 37.7295, 6.29, 34.077, 125.2775, 17.889499999999998, 103.367, 123.913, 274.702, 73.77799999999999, 128.846, 119.1095, 114.1585, 126.73849999999999, 126.465, 71.4455, 130.6655, 150.6585, 241.543, 62.9905, 34.4915, 32.656, 117.30699999999999, 136.13, 132.85649999999998, 279.7025, 144.202, 151.20850000000002, 55.3745, 138.97050000000002, 37.685, 42.576, 77.191, 128.6185, 37.548, 268.63800000000003, 263.9995, 112.98349999999999, 211.315, 129.506, 115.506, 166.674, 162.851, 254.1285, 237.836, 162.4665, 30.3005, 215.34300000000002, 60.7375, 154.7785, 106.96549999999999, 66.918, 33.8245, 31.712, 76.7575, 50.1105, 261.71349999999995, 69.842, 155.04149999999998, 119.016, 2.9844999999999997, 48.4195, 35.778999999999996, 72.365, 104.4905, 189.1575, 47.61, 0.034, 43.842, 36.8745, 240.3585, 31.8005, 74.171, 24.375, 253.5485, 215.5995, 200.763, 34.668499999999995, 199.01999999999998, 159.811, 280.745, 161.7135, 35.6455, 46.6185, 21.2735, 130.7715, 37.7795, 261.10450000000003, 230.0975, 109.582, 31.067, 40.7615, 251.7485, 30.1235, 40.108000000000004, 68.858, 63.5185, 38.9105, 37.6235, 131.284, 279.3505, 123.535, 107.696, 111.193, 136.464, 100.393, 119.1395, 184.5355, 134.3195, 118.52850000000001, 39.2275, 88.9285, 134.935, 224.7855, 59.578500000000005, 257.8355, 28.9285, 199.19549999999998, 262.929, 44.9305, 39.602, 36.9255, 116.21549999999999, 285.6675, 111.61850000000001, 121.7175, 124.02000000000001, 103.8755, 164.9315, 153.6225, 107.492, 119.1105, 147.817, 123.4735, 160.262, 130.452, 145.75799999999998, 98.1325, 163.65499999999997, 133.736, 160.786, 251.911, 122.2835, 136.757, 71.357, 163.031, 111.26599999999999, 119.1365, 107.64150000000001, 41.265, 261.49199999999996, 111.2195, 89.88550000000001, 174.6345, 104.82900000000001, 108.0195, 39.3755, 29.127, 274.77549999999997, 88.20599999999999, 120.5645, 39.7275, 125.6675, 129.981, 117.7185, 137.93900000000002, 183.77249999999998, 190.025, 149.852, 117.5325, 147.5265, 37.3665, 162.766, 48.479, 36.213499999999996, 124.598, 76.3015, 103.4295, 124.311, 195.644, 119.8485, 140.21800000000002, 260.9425, 211.1035, 51.388999999999996},
+		{134.236, 83.916, 156.377, 153.595, 204.1925, 142.894, 247.714, 182.6205, 146.527, 63.6995, 77.8545, 63.911, 60.85, 68.9625, 183.6105, 42.815, 152.591, 173.4305, 282.96349999999995, 118.745, 169.50099999999998, 145.57049999999998, 171.6375, 180.78, 166.7825, 112.8255, 185.132, 194.70749999999998, 273.782, 107.64150000000001, 79.6785, 54.5355, 155.7745, 167.86149999999998, 188.832, 320.6585, 186.0235, 180.374, 86.691, 210.60500000000002, 83.6765, 85.993, 105.674, 215.515, 71.66749999999999, 317.222, 299.998, 176.094, 262.3605, 170.0985, 167.957, 194.261, 216.369, 335.437, 295.837, 208.051, 78.4375, 304.88, 105.8555, 166.0875, 141.48399999999998, 131.9835, 91.3715, 88.6055, 111.6615, 79.784, 308.419, 128.95100000000002, 212.411, 158.8475, 49.423500000000004, 84.23599999999999, 99.227, 100.4105, 150.9855, 282.045, 67.74350000000001, 31.442999999999998, 0.0195, 97.998, 264.366, 94.006, 127.91, 70.21600000000001, 261.15049999999997, 310.442, 245.59550000000002, 78.122, 266.50199999999995, 189.57049999999998, 304.062, 225.03, 87.276, 98.3425, 56.644000000000005, 152.39249999999998, 57.153499999999994, 299.2875, 225.4675, 139.164, 82.51650000000001, 85.05250000000001, 265.28700000000003, 70.8485, 63.625, 109.44, 108.6835, 69.91499999999999, 64.67949999999999, 176.98700000000002, 362.1685, 181.6325, 137.50799999999998, 149.522, 211.71949999999998, 145.82999999999998, 164.58249999999998, 223.211, 183.5115, 142.6705, 57.9825, 139.0485, 184.88150000000002, 267.84749999999997, 131.72899999999998, 300.609, 60.365, 246.925, 300.5625, 82.3245, 67.434, 59.876000000000005, 170.6755, 367.7955, 159.899, 175.723, 168.424, 130.993, 189.2505, 177.8485, 150.1045, 164.85399999999998, 136.86649999999997, 178.90449999999998, 203.7135, 178.06, 205.32150000000001, 146.84449999999998, 213.4395, 187.317, 197.67, 333.877, 172.379, 176.38600000000002, 83.11699999999999, 189.1325, 155.433, 162.837, 138.2625, 62.4695, 263.9235, 138.58249999999998, 128.7035, 199.5275, 130.7625, 166.7015, 90.55099999999999, 84.6615, 282.8185, 69.2345, 162.393, 66.1345, 167.39600000000002, 189.71949999999998, 146.6985, 167.0735, 204.772, 224.201, 190.0485, 151.93099999999998, 182.599, 78.39150000000001, 182.8415, 91.92150000000001, 79.931, 160.2105, 92.059, 131.2165, 168.04899999999998, 242.5195, 164.2185, 186.507, 308.1805, 219.3615, 94.85499999999999},
+		{194.10500000000002, 16.011, 121.977, 90.7135, 172.269, 101.40350000000001, 215.95800000000003, 121.2835, 84.48249999999999, 28.2115, 19.2345, 23.957, 34.778, 22.168, 115.3535, 21.2095, 96.3765, 117.3945, 212.187, 58.497, 127.196, 113.89150000000001, 117.83250000000001, 145.049, 107.2265, 51.816500000000005, 120.897, 146.695, 238.37900000000002, 59.834500000000006, 27.069000000000003, 24.393, 93.774, 126.4845, 125.66, 223.3535, 127.65350000000001, 131.357, 91.19200000000001, 169.8675, 33.727000000000004, 20.3335, 55.579499999999996, 101.63050000000001, 15.405999999999999, 260.758, 249.2185, 101.1115, 205.19650000000001, 141.30599999999998, 102.15350000000001, 156.026, 145.9845, 246.271, 256.206, 152.745, 20.570500000000003, 208.141, 57.489999999999995, 134.0435, 89.4555, 67.3045, 12.3295, 5.306, 60.831500000000005, 35.676500000000004, 233.313, 52.33, 156.2585, 91.95750000000001, 31.176499999999997, 11.023, 14.424, 58.8245, 92.691, 177.445, 10.9255, 36.8595, 111.095, 0.016, 214.012, 10.3845, 98.6345, 28.0305, 235.748, 222.312, 183.5095, 19.927999999999997, 184.65949999999998, 155.80900000000003, 258.86400000000003, 147.3965, 22.398, 28.883000000000003, 44.311, 120.4545, 20.741, 243.152, 220.22500000000002, 87.6695, 12.649999999999999, 19.224, 190.6215, 36.340500000000006, 21.8425, 40.1685, 40.6575, 11.007, 25.9205, 121.8355, 251.113, 127.70849999999999, 103.554, 103.34, 136.95499999999998, 84.383, 112.474, 191.6865, 133.5475, 101.00999999999999, 28.979, 72.24549999999999, 137.628, 213.373, 27.651, 250.63400000000001, 18.432, 183.135, 244.856, 52.868, 24.4285, 9.065, 105.619, 221.625, 97.51400000000001, 102.0385, 109.559, 87.89500000000001, 131.144, 141.784, 102.135, 104.233, 89.3105, 122.0835, 145.3935, 115.993, 134.6955, 86.84, 149.707, 125.881, 144.73, 243.383, 112.90350000000001, 119.983, 96.92250000000001, 149.6755, 89.7935, 89.904, 99.768, 21.817999999999998, 256.99850000000004, 104.105, 58.3395, 153.25650000000002, 86.273, 102.53649999999999, 17.719, 36.6515, 223.1165, 24.1355, 108.2625, 14.718, 118.342, 159.4645, 114.5745, 120.779, 213.24599999999998, 178.2945, 138.6565, 101.36500000000001, 154.83100000000002, 30.169, 149.89749999999998, 29.2075, 17.124, 100.0475, 104.5065, 92.68799999999999, 114.2265, 178.792, 105.1345, 133.48250000000002, 236.34300000000002, 172.04950000000002, 10.4145},
+		{318.7315, 219.191, 331.179, 303.212, 288.8175, 313.932, 46.426500000000004, 327.19849999999997, 307.30999999999995, 214.9395, 239.3265, 229.22, 240.1295, 226.12, 315.9315, 225.543, 308.3725, 330.281, 37.956500000000005, 159.1465, 336.1415, 334.98699999999997, 324.94100000000003, 329.322, 306.3345, 175.7765, 330.99249999999995, 356.394, 294.15, 182.06799999999998, 207.38400000000001, 230.44, 306.03499999999997, 339.0685, 335.6585, 344.599, 341.742, 330.081, 298.3505, 374.60699999999997, 221.6175, 236.489, 210.16899999999998, 416.1175, 210.1045, 415.67949999999996, 361.5965, 313.73699999999997, 58.2445, 352.1195, 310.224, 358.0325, 352.7315, 327.262, 342.616, 353.302, 233.11849999999998, 308.09299999999996, 185.26749999999998, 348.8675, 302.2315, 191.1515, 229.579, 221.6505, 171.7285, 259.10450000000003, 397.83299999999997, 196.7855, 456.5195, 304.394, 240.0605, 205.5005, 217.322, 178.41699999999997, 304.7435, 287.43600000000004, 223.39749999999998, 240.8375, 274.53049999999996, 214.296, 0.0985, 222.3425, 301.935, 234.4915, 416.4465, 346.4545, 308.173, 224.841, 388.395, 369.243, 471.499, 139.3265, 206.4535, 200.947, 245.59199999999998, 347.405, 225.622, 467.136, 440.211, 187.12900000000002, 226.32600000000002, 228.005, 46.327, 225.85399999999998, 233.65, 216.66899999999998, 223.9805, 211.9065, 232.95999999999998, 330.25350000000003, 347.3565, 330.33500000000004, 211.249, 316.603, 349.512, 296.7755, 312.621, 250.6005, 337.4275, 313.8035, 231.941, 202.92000000000002, 344.093, 343.0295, 227.563, 56.021, 238.9395, 396.0, 457.3755, 232.005, 242.24650000000003, 220.983, 429.591, 369.7315, 304.40099999999995, 314.492, 322.63750000000005, 300.0145, 360.682, 353.624, 314.6055, 309.232, 307.77200000000005, 321.577, 361.049, 328.3435, 351.589, 304.5985, 363.1435, 331.81899999999996, 347.851, 372.775, 327.2325, 329.8035, 304.1635, 361.959, 314.42949999999996, 304.65049999999997, 312.404, 226.761, 398.67449999999997, 315.6905, 197.44549999999998, 365.00350000000003, 298.6535, 401.938, 222.621, 243.98000000000002, 338.238, 236.91199999999998, 320.823, 226.65449999999998, 330.40200000000004, 360.45550000000003, 315.157, 334.3915, 461.02750000000003, 407.599, 342.8675, 307.3325, 374.3795, 234.9505, 367.669, 240.3185, 211.0795, 311.95349999999996, 0.0, 304.42499999999995, 314.747, 390.60699999999997, 313.3295, 345.456, 342.601, 387.413, 218.248},
+		{151.90550000000002, 18.421, 126.848, 100.5195, 152.357, 100.786, 336.226, 120.8835, 93.0135, 23.042, 25.719, 27.366, 37.271, 27.057000000000002, 120.99199999999999, 21.6825, 120.3545, 118.16550000000001, 338.1035, 56.403999999999996, 121.3335, 121.3425, 118.4865, 117.3575, 112.10499999999999, 55.087500000000006, 123.52600000000001, 140.5525, 214.6285, 55.8535, 28.677, 28.233, 102.9345, 134.8915, 125.8655, 247.6365, 128.0865, 135.216, 97.0295, 164.37349999999998, 40.366, 26.868000000000002, 56.079, 110.887, 19.8835, 225.5785, 224.76999999999998, 114.88650000000001, 202.853, 129.3705, 107.7125, 159.2905, 160.0625, 232.552, 204.5765, 151.6875, 28.3675, 188.8415, 52.549, 145.994, 93.95750000000001, 59.1035, 16.625999999999998, 13.046, 58.23, 42.062, 233.72050000000002, 69.025, 169.72899999999998, 100.679, 41.0385, 16.789, 25.033, 59.6355, 121.2955, 175.389, 20.197499999999998, 31.7735, 104.34649999999999, 10.429, 222.01999999999998, 0.036, 105.513, 41.016999999999996, 227.94150000000002, 219.8635, 187.279, 27.433999999999997, 180.327, 154.6585, 264.2165, 144.2595, 29.415, 30.7695, 43.7425, 122.388, 26.906, 259.60749999999996, 188.221, 87.319, 14.499500000000001, 38.452, 213.801, 38.0965, 32.1285, 46.248999999999995, 37.348, 19.313499999999998, 29.4805, 126.65899999999999, 263.3615, 116.0515, 96.0135, 102.6395, 128.93, 94.243, 106.396, 161.51999999999998, 130.24450000000002, 112.291, 31.6845, 64.7995, 148.7455, 207.1985, 31.61, 254.91500000000002, 26.218, 193.7745, 259.28, 54.0145, 32.0385, 21.2755, 109.446, 246.3745, 103.94749999999999, 114.8925, 117.804, 97.9565, 155.25, 139.8175, 100.521, 113.57050000000001, 100.727, 114.5625, 149.6565, 121.3695, 132.923, 109.44649999999999, 157.3025, 130.5, 130.88049999999998, 233.8505, 118.355, 120.39, 102.6125, 154.0245, 102.9805, 102.2115, 100.191, 27.445, 230.8855, 101.19200000000001, 70.19999999999999, 157.612, 98.003, 104.06200000000001, 19.727, 48.5475, 238.56, 93.169, 114.58949999999999, 29.147, 113.2635, 153.252, 113.2655, 132.17, 236.4525, 185.605, 145.1995, 108.6505, 144.4885, 31.4675, 162.39249999999998, 26.529, 18.688000000000002, 105.083, 108.42150000000001, 96.86449999999999, 108.8895, 192.3255, 112.38, 125.32900000000001, 257.06, 177.28, 44.615},
+		{143.7505, 125.4495, 197.6675, 170.2385, 243.2145, 171.6745, 291.59299999999996, 226.8065, 172.256, 106.324, 102.6, 103.60249999999999, 62.66, 90.209, 196.37, 89.5645, 192.071, 188.0165, 288.95349999999996, 147.41, 207.0365, 189.46949999999998, 188.82150000000001, 182.7365, 181.374, 161.7165, 189.33999999999997, 212.93, 321.71950000000004, 134.221, 97.08099999999999, 102.996, 168.3315, 211.0935, 191.543, 303.236, 208.83550000000002, 214.79500000000002, 133.04500000000002, 126.0195, 110.0565, 106.707, 148.8585, 181.938, 103.1735, 402.37199999999996, 331.0195, 189.1535, 299.149, 195.99900000000002, 188.168, 227.22, 245.2025, 325.3045, 304.7825, 218.84699999999998, 92.4825, 297.6635, 137.927, 237.387, 171.251, 131.164, 116.36699999999999, 99.929, 144.651, 109.747, 301.7795, 138.197, 227.1775, 177.886, 73.688, 103.314, 111.92099999999999, 143.2275, 196.69799999999998, 270.806, 101.08699999999999, 74.02250000000001, 138.839, 98.4165, 301.6805, 105.4315, 0.05, 95.066, 284.155, 352.89549999999997, 254.8605, 91.6505, 250.23649999999998, 230.9315, 338.569, 240.588, 101.27850000000001, 109.6935, 101.33850000000001, 195.21800000000002, 101.119, 339.5005, 269.8245, 175.514, 104.035, 108.401, 274.0235, 104.60849999999999, 100.4315, 150.76600000000002, 116.15350000000001, 120.60300000000001, 94.969, 184.438, 351.09000000000003, 190.95600000000002, 217.44, 180.65699999999998, 272.4015, 161.726, 228.7775, 265.8285, 210.378, 205.2335, 94.5215, 176.0235, 216.7865, 321.344, 132.9835, 342.21900000000005, 91.928, 272.7795, 333.95, 115.539, 111.47149999999999, 105.531, 174.602, 318.5715, 167.0025, 186.0595, 184.15449999999998, 175.137, 250.262, 208.18200000000002, 181.3465, 169.4225, 169.2925, 190.86599999999999, 242.178, 199.7285, 213.9475, 181.631, 220.142, 212.81, 211.06650000000002, 334.615, 185.144, 212.997, 139.04250000000002, 222.416, 170.519, 173.911, 192.2405, 95.89099999999999, 297.573, 175.281, 167.337, 231.859, 182.5275, 179.8735, 103.65700000000001, 95.57, 292.9665, 96.1555, 197.442, 107.5685, 201.048, 148.51049999999998, 188.595, 199.2005, 286.05899999999997, 263.28700000000003, 213.27999999999997, 168.0275, 199.5595, 91.203, 235.8725, 97.143, 109.59100000000001, 187.6845, 133.1395, 174.471, 230.2815, 264.949, 188.9955, 195.083, 307.1605, 263.64750000000004, 112.279},
+		{91.4925, 28.4865, 122.056, 101.936, 174.0365, 100.888, 215.5265, 122.425, 91.1325, 30.311999999999998, 20.811500000000002, 24.232, 21.215, 14.7255, 110.7955, 8.6365, 110.4055, 119.5475, 211.72, 72.949, 125.638, 110.394, 118.31450000000001, 122.21549999999999, 105.906, 70.61500000000001, 124.013, 147.124, 228.4415, 67.5125, 30.85, 15.198, 108.511, 127.983, 119.789, 225.9955, 116.84899999999999, 130.648, 85.40450000000001, 161.2305, 26.938, 31.256, 75.39750000000001, 98.7165, 38.438, 271.7585, 271.054, 117.911, 209.975, 121.438, 101.1985, 151.2705, 150.356, 258.5895, 251.2765, 151.29000000000002, 21.7345, 216.919, 64.116, 128.13049999999998, 100.1765, 63.593, 23.380000000000003, 29.565, 82.6615, 36.592, 219.5365, 58.8395, 151.3365, 102.79400000000001, 24.5995, 31.573, 43.760999999999996, 72.815, 115.0215, 192.027, 26.5785, 21.9175, 62.6545, 28.165, 234.308, 39.6115, 93.2355, 0.053, 216.21800000000002, 224.39049999999997, 202.6515, 17.9645, 167.82350000000002, 160.76299999999998, 260.0145, 167.652, 35.218, 50.0075, 17.1675, 115.0915, 24.212000000000003, 268.28650000000005, 206.5005, 109.082, 29.610500000000002, 47.863, 197.59750000000003, 49.284499999999994, 31.119500000000002, 75.47200000000001, 44.312, 43.1345, 24.6015, 123.2535, 252.97199999999998, 115.276, 115.374, 102.9555, 142.32, 89.94800000000001, 108.96549999999999, 182.5285, 129.597, 102.8305, 18.7245, 89.471, 135.361, 226.7935, 52.9435, 250.11950000000002, 25.618499999999997, 187.8995, 260.5965, 43.168499999999995, 30.076500000000003, 23.349, 101.06649999999999, 224.559, 96.551, 111.85849999999999, 107.557, 103.88550000000001, 160.193, 138.85500000000002, 107.459, 107.9075, 93.4785, 115.6345, 143.2335, 119.95750000000001, 138.9615, 101.6605, 158.7575, 119.16149999999999, 134.639, 258.06100000000004, 112.9515, 122.319, 88.826, 150.3775, 104.4375, 103.24350000000001, 102.986, 22.83, 253.98000000000002, 102.181, 69.9885, 162.297, 90.76, 97.6985, 37.5975, 28.863, 229.30700000000002, 26.8405, 108.867, 29.9895, 123.586, 158.739, 106.814, 129.5495, 253.8835, 177.1865, 132.932, 103.26650000000001, 134.854, 23.912, 146.55849999999998, 28.844, 47.218, 109.93299999999999, 89.8615, 91.9255, 110.417, 190.835, 105.412, 120.18299999999999, 240.8345, 176.2655, 37.554},
+		{344.58349999999996, 224.695, 143.8795, 130.812, 132.993, 131.7945, 184.54000000000002, 161.75799999999998, 140.9225, 267.55499999999995, 250.5615, 221.798, 261.762, 216.5695, 149.0465, 208.00099999999998, 161.1615, 142.347, 188.571, 277.3895, 192.636, 158.16449999999998, 149.5085, 172.363, 129.3435, 252.5585, 193.869, 164.0895, 74.1865, 280.0135, 244.4345, 208.91750000000002, 141.5595, 169.895, 163.905, 28.5825, 164.219, 179.47899999999998, 293.6325, 389.08799999999997, 272.5165, 252.9565, 266.676, 144.145, 229.2045, 17.691000000000003, 112.095, 191.6895, 386.10900000000004, 200.9505, 140.8605, 161.10899999999998, 229.8645, 130.7595, 240.989, 189.5925, 217.519, 101.9135, 255.1905, 186.319, 138.344, 246.01749999999998, 275.174, 263.8655, 272.5535, 235.874, 42.179500000000004, 287.249, 208.582, 144.67849999999999, 235.708, 252.03300000000002, 231.39600000000002, 256.75350000000003, 148.0275, 121.651, 212.04399999999998, 250.38, 272.21299999999997, 235.5505, 418.78, 229.515, 284.23699999999997, 204.9135, 0.019, 111.57300000000001, 245.49599999999998, 201.415, 205.40449999999998, 177.09750000000003, 304.8625, 145.0805, 268.288, 288.8755, 229.725, 145.275, 204.7405, 275.668, 145.627, 327.2505, 255.733, 244.6035, 375.881, 261.121, 196.9015, 271.58299999999997, 257.4015, 230.0605, 208.603, 140.9865, 50.4135, 131.097, 292.01149999999996, 142.68900000000002, 177.777, 148.488, 140.245, 151.7625, 154.45, 138.8655, 256.5585, 288.4785, 168.8745, 273.302, 247.6345, 224.442, 197.11950000000002, 233.99149999999997, 328.714, 281.17150000000004, 214.1005, 231.9185, 126.642, 166.7065, 138.487, 152.17849999999999, 120.6115, 156.6975, 212.8515, 130.91649999999998, 131.6275, 152.62849999999997, 138.212, 146.25650000000002, 185.46699999999998, 166.94549999999998, 182.41649999999998, 173.5385, 234.848, 183.559, 161.229, 132.54250000000002, 167.281, 179.20499999999998, 299.9425, 174.687, 135.394, 143.4085, 180.8965, 196.4915, 24.191000000000003, 178.85, 278.67949999999996, 178.09699999999998, 131.58550000000002, 127.562, 243.0315, 225.4175, 124.5385, 190.592, 140.35449999999997, 205.32850000000002, 163.38299999999998, 364.56399999999996, 152.579, 279.933, 219.5, 197.11149999999998, 181.6165, 134.51, 185.3475, 254.7385, 199.0845, 243.04950000000002, 234.291, 144.078, 299.635, 140.9135, 132.464, 236.0995, 150.805, 165.11399999999998, 27.811, 213.71249999999998, 231.5565},
+		{332.7155, 252.445, 315.6495, 299.145, 70.389, 221.072, 255.4495, 323.1885, 261.06050000000005, 197.5945, 227.92649999999998, 222.361, 225.894, 249.656, 266.27599999999995, 210.6875, 250.85250000000002, 254.9305, 243.15550000000002, 167.01, 268.7825, 251.9075, 203.98250000000002, 257.9185, 256.48, 169.5875, 261.9425, 273.991, 63.793499999999995, 150.952, 193.049, 221.95100000000002, 308.7695, 334.8415, 309.3945, 119.2425, 326.04449999999997, 285.64300000000003, 291.428, 371.2255, 226.27949999999998, 218.33, 185.428, 299.8795, 192.297, 97.4605, 54.43, 255.0845, 309.38, 265.2085, 240.2245, 219.66750000000002, 301.14549999999997, 79.029, 45.180499999999995, 356.407, 216.605, 29.9745, 154.367, 308.962, 224.18349999999998, 169.3765, 213.203, 203.18900000000002, 164.81799999999998, 252.00650000000002, 74.66550000000001, 247.166, 306.1425, 247.2625, 217.208, 211.692, 235.88799999999998, 177.54199999999997, 240.79000000000002, 49.1515, 220.779, 215.769, 322.2215, 237.5795, 346.8265, 220.061, 353.16549999999995, 224.432, 111.55199999999999, 0.033, 176.83350000000002, 227.7785, 191.625, 283.4355, 493.71000000000004, 56.3615, 201.06799999999998, 183.51, 210.765, 223.38549999999998, 229.217, 285.3345, 140.3755, 215.7865, 211.563, 214.81, 337.8695, 202.639, 237.74450000000002, 186.848, 205.0985, 198.80349999999999, 232.83749999999998, 328.621, 92.602, 255.8065, 168.664, 246.3955, 362.2095, 235.656, 257.4385, 76.0795, 268.31, 181.8055, 227.16199999999998, 172.99849999999998, 282.0615, 24.618000000000002, 249.14249999999998, 90.6225, 206.527, 367.7315, 211.9405, 218.42950000000002, 213.764, 218.2525, 301.209, 159.2125, 300.435, 203.84199999999998, 207.226, 239.99349999999998, 274.78999999999996, 277.317, 297.164, 253.813, 278.748, 323.65650000000005, 295.823, 257.772, 270.394, 242.44400000000002, 310.656, 343.603, 342.331, 54.569500000000005, 265.4545, 328.7075, 295.1295, 347.23900000000003, 259.89200000000005, 301.217, 250.90300000000002, 225.7855, 83.40299999999999, 250.4345, 185.287, 351.7435, 301.202, 251.425, 228.32150000000001, 237.42149999999998, 76.006, 247.9745, 233.59949999999998, 228.43200000000002, 259.815, 341.76250000000005, 259.171, 382.361, 321.187, 280.12149999999997, 334.529, 249.42149999999998, 339.462, 277.946, 384.4595, 238.345, 188.822, 262.708, 294.384, 240.394, 258.60249999999996, 352.477, 268.7595, 241.5975, 99.041, 372.2105, 223.54399999999998},
+		{365.9775, 186.16199999999998, 293.579, 273.539, 33.32, 272.493, 319.9715, 256.448, 269.8095, 166.9555, 186.879, 198.00799999999998, 193.215, 198.549, 275.098, 176.418, 283.36199999999997, 294.2065, 317.703, 154.913, 287.015, 286.4655, 288.8405, 282.8095, 287.7205, 126.23400000000001, 293.861, 315.3075, 103.207, 135.179, 169.6125, 197.9515, 271.615, 291.98699999999997, 266.53999999999996, 390.2445, 313.182, 298.699, 250.7905, 322.71450000000004, 179.832, 194.221, 156.38, 285.063, 162.54950000000002, 242.10500000000002, 209.4865, 274.404, 282.5285, 280.8115, 277.913, 289.8115, 312.348, 204.014, 216.167, 316.1615, 195.414, 180.631, 136.6705, 317.365, 267.32050000000004, 142.43349999999998, 192.83999999999997, 189.5345, 127.12950000000001, 196.15699999999998, 332.08000000000004, 197.2165, 298.2015, 271.071, 192.1185, 175.1895, 191.262, 139.2235, 268.9085, 64.3415, 190.7095, 200.7745, 258.25, 183.475, 307.6645, 187.406, 255.023, 198.587, 245.626, 176.74349999999998, 0.029, 199.3005, 267.479, 321.5585, 419.664, 34.5765, 174.3425, 161.118, 198.082, 293.4715, 210.13299999999998, 434.794, 312.91549999999995, 166.27949999999998, 190.127, 196.4265, 270.68499999999995, 182.76350000000002, 202.5025, 159.5345, 174.345, 195.7165, 197.0775, 291.04499999999996, 268.95550000000003, 281.8225, 179.1735, 253.84, 271.3355, 259.5905, 276.8345, 149.38549999999998, 281.61249999999995, 286.389, 198.88600000000002, 151.026, 274.97249999999997, 214.3975, 202.0745, 250.26749999999998, 190.61149999999998, 235.3275, 398.9345, 179.2735, 201.898, 187.28449999999998, 248.26600000000002, 299.584, 263.33799999999997, 290.15, 294.9565, 261.8165, 322.9425, 277.3505, 260.02049999999997, 282.899, 280.11850000000004, 286.28, 263.8645, 294.79200000000003, 310.4605, 257.222, 282.195, 300.4545, 304.671, 157.96249999999998, 280.461, 294.798, 265.8345, 326.986, 274.076, 285.68600000000004, 290.415, 202.632, 223.5135, 271.644, 156.735, 312.9635, 267.562, 277.323, 189.6465, 206.417, 69.987, 203.59949999999998, 262.218, 196.3155, 263.0545, 342.007, 289.255, 312.081, 325.549, 357.273, 308.5105, 272.8365, 316.53049999999996, 192.02999999999997, 326.12350000000004, 192.7885, 162.05599999999998, 270.6495, 256.3055, 258.966, 278.798, 360.905, 281.077, 301.796, 238.529, 275.731, 204.288},
+		{154.262, 13.960999999999999, 94.97999999999999, 79.208, 184.60399999999998, 78.61099999999999, 219.595, 98.973, 69.5245, 44.2025, 1.572, 6.816, 27.366999999999997, 8.1775, 100.89949999999999, 17.979, 78.8535, 96.8325, 220.07150000000001, 68.22149999999999, 99.219, 99.938, 98.1235, 92.1045, 83.9125, 63.451, 96.7245, 122.156, 212.3585, 65.798, 31.9465, 9.787500000000001, 79.02449999999999, 107.1335, 99.60499999999999, 210.2355, 110.646, 110.314, 88.9485, 164.597, 40.766999999999996, 10.42, 72.255, 85.721, 32.7725, 210.23149999999998, 232.96249999999998, 111.04599999999999, 215.3065, 105.263, 89.382, 135.34300000000002, 130.529, 271.457, 262.4445, 132.77949999999998, 3.3114999999999997, 199.329, 64.4325, 111.21600000000001, 76.7315, 71.714, 17.072499999999998, 20.251, 60.8495, 20.868000000000002, 225.952, 49.364999999999995, 129.47449999999998, 81.567, 27.549500000000002, 25.86, 32.953500000000005, 73.854, 92.67, 192.012, 10.764, 34.666, 89.776, 19.967, 224.596, 27.436, 91.751, 18.075000000000003, 201.537, 227.819, 199.1005, 0.038, 166.59, 131.176, 251.83350000000002, 159.25900000000001, 44.191500000000005, 40.1645, 33.4735, 102.691, 1.4115, 233.0375, 174.769, 97.441, 25.1425, 26.057, 203.1445, 41.347, 8.525, 63.3435, 43.5295, 38.105999999999995, 4.257, 94.8335, 248.703, 92.54050000000001, 106.209, 81.23150000000001, 103.88, 70.15100000000001, 78.0715, 184.779, 109.93350000000001, 87.9615, 13.0715, 66.89099999999999, 115.805, 221.652, 53.171, 254.6985, 0.8955, 165.8895, 237.9435, 63.861999999999995, 10.128, 15.406500000000001, 84.24549999999999, 209.96300000000002, 78.144, 95.40450000000001, 97.82300000000001, 74.99600000000001, 129.143, 114.263, 74.075, 91.0545, 75.73599999999999, 98.279, 125.1405, 107.9905, 116.531, 66.6285, 135.638, 109.59899999999999, 128.478, 261.7795, 98.715, 102.2055, 95.138, 125.9005, 82.52449999999999, 83.21549999999999, 83.711, 4.3245000000000005, 207.8885, 82.5675, 88.8885, 127.497, 72.81700000000001, 78.3655, 21.294, 34.6505, 243.0815, 5.9775, 90.227, 13.0785, 101.96799999999999, 129.3175, 94.5195, 109.3615, 209.00900000000001, 149.027, 125.3145, 80.0395, 124.5615, 11.965, 132.53449999999998, 26.8575, 28.2775, 80.8735, 101.1685, 74.373, 82.184, 163.9275, 80.66499999999999, 112.035, 188.0965, 144.22500000000002, 29.304000000000002},
+		{299.81550000000004, 184.5505, 96.4305, 89.5655, 296.3935, 104.112, 274.322, 104.53999999999999, 91.375, 200.612, 164.9725, 169.013, 195.135, 170.222, 113.1805, 173.017, 101.0945, 118.786, 295.7125, 221.755, 125.8555, 117.56700000000001, 72.6365, 117.856, 98.0235, 236.223, 114.398, 122.521, 222.562, 228.606, 184.3245, 171.2125, 101.89699999999999, 127.18450000000001, 100.4865, 248.896, 109.02350000000001, 118.6755, 228.61450000000002, 318.59299999999996, 211.88299999999998, 196.9925, 227.3995, 110.2575, 180.95749999999998, 139.2055, 274.88149999999996, 117.95349999999999, 365.08450000000005, 142.3755, 86.57400000000001, 125.6675, 142.8745, 299.4125, 186.18599999999998, 118.6985, 165.1375, 401.567, 211.124, 136.5655, 91.672, 255.964, 180.6575, 167.4605, 251.433, 165.284, 224.64749999999998, 209.1285, 138.82049999999998, 102.346, 177.05700000000002, 174.267, 195.925, 226.486, 125.772, 289.791, 159.66199999999998, 199.0325, 278.538, 184.7605, 388.3645, 180.3225, 250.3955, 177.7115, 205.34449999999998, 191.2695, 267.523, 166.6005, 0.042, 136.891, 161.2685, 252.156, 204.4255, 227.2885, 188.6055, 101.656, 148.2715, 182.575, 16.747500000000002, 260.44, 193.98700000000002, 199.51299999999998, 363.3885, 198.847, 167.6995, 232.1655, 212.7215, 203.515, 160.747, 95.858, 189.80599999999998, 115.281, 249.45499999999998, 90.3125, 126.68299999999999, 102.571, 98.4785, 311.152, 109.731, 106.47800000000001, 180.03300000000002, 235.14049999999997, 116.7445, 190.98399999999998, 215.49099999999999, 278.912, 189.684, 155.0645, 181.5565, 220.99900000000002, 197.946, 170.8015, 97.2225, 125.327, 99.2145, 97.9365, 107.3045, 100.5815, 132.6505, 114.211, 101.9975, 87.29650000000001, 89.3805, 121.037, 135.47750000000002, 91.307, 111.572, 117.8895, 150.185, 109.64250000000001, 134.2215, 188.35250000000002, 103.7285, 102.09700000000001, 235.32150000000001, 129.7065, 84.943, 103.42150000000001, 89.543, 150.4865, 157.5085, 124.0545, 241.61849999999998, 132.285, 81.868, 107.149, 190.835, 201.86900000000003, 336.02700000000004, 166.14749999999998, 101.90899999999999, 170.195, 113.436, 299.4445, 99.112, 124.9965, 272.447, 157.082, 143.708, 78.943, 116.243, 168.371, 157.7455, 168.5165, 202.748, 94.83600000000001, 265.132, 93.383, 103.964, 195.062, 114.97399999999999, 125.1075, 226.015, 154.70749999999998, 180.6855},
+		{297.509, 147.1685, 33.1275, 60.4075, 321.36, 58.057, 327.77, 69.69149999999999, 62.367000000000004, 177.99849999999998, 131.44, 131.94549999999998, 168.0165, 139.839, 80.34, 151.399, 73.76050000000001, 38.827, 331.869, 211.8025, 63.0105, 51.8025, 66.5325, 39.256, 50.3655, 183.20600000000002, 46.2165, 55.682, 287.55600000000004, 200.1055, 172.506, 139.66899999999998, 67.46000000000001, 97.79400000000001, 70.236, 267.00699999999995, 44.1125, 73.8155, 220.7545, 300.5435, 167.003, 134.0575, 207.699, 75.92349999999999, 149.666, 176.0165, 298.448, 81.47200000000001, 358.7145, 61.2225, 55.2475, 106.672, 121.9615, 219.48399999999998, 317.1545, 55.7, 133.877, 295.906, 200.94, 102.888, 67.9935, 195.6085, 154.067, 146.91899999999998, 197.5215, 139.834, 189.422, 177.7265, 111.3195, 73.431, 160.917, 164.4595, 165.44600000000003, 206.11599999999999, 76.7385, 333.5, 146.135, 159.663, 201.76049999999998, 155.91649999999998, 368.8225, 154.426, 231.202, 160.50799999999998, 177.2015, 282.47249999999997, 321.844, 131.05349999999999, 136.79, 0.025500000000000002, 236.47449999999998, 284.026, 176.6485, 159.4835, 158.764, 157.154, 132.1035, 236.4915, 172.9225, 241.93099999999998, 152.0255, 143.659, 344.997, 173.4015, 127.74449999999999, 206.9925, 163.661, 162.001, 134.1905, 32.665499999999994, 218.4345, 30.6785, 225.496, 54.86, 20.2575, 66.3505, 53.3115, 328.481, 51.6255, 55.3995, 142.2765, 185.2785, 75.911, 346.4815, 170.613, 259.776, 120.134, 106.7885, 225.4115, 186.8385, 129.5445, 153.692, 67.8065, 229.3055, 73.498, 54.8945, 54.2465, 69.32, 106.7405, 26.8455, 59.163, 61.032, 58.349000000000004, 44.527, 94.13550000000001, 61.099500000000006, 79.131, 56.5685, 91.0915, 74.589, 62.6015, 246.00549999999998, 52.00749999999999, 41.6265, 227.98950000000002, 9.16, 55.4865, 58.611999999999995, 57.5205, 138.046, 185.204, 55.2515, 219.095, 36.6195, 68.0385, 59.2785, 159.3285, 165.9345, 309.0415, 134.11700000000002, 62.426500000000004, 133.3965, 51.8, 271.169, 74.0375, 81.77699999999999, 159.6975, 90.168, 83.12049999999999, 64.941, 92.5915, 142.726, 114.04050000000001, 142.738, 147.402, 77.3905, 237.225, 72.411, 54.8535, 164.627, 75.78299999999999, 27.002499999999998, 270.22450000000003, 85.881, 163.89499999999998},
+		{361.8785, 244.354, 206.7315, 165.96300000000002, 379.622, 189.84199999999998, 444.6675, 201.524, 162.6235, 285.0125, 238.9425, 245.2595, 265.737, 261.38, 160.6705, 249.35649999999998, 153.66750000000002, 193.8065, 465.52099999999996, 314.1625, 197.141, 185.677, 157.776, 201.92700000000002, 172.235, 284.375, 195.7875, 187.821, 326.101, 311.674, 280.8705, 252.2835, 168.7565, 223.64100000000002, 190.8305, 358.84749999999997, 187.5405, 183.075, 329.036, 414.2525, 277.0325, 240.14, 327.1965, 192.3475, 271.2155, 289.29200000000003, 353.4465, 219.27949999999998, 519.933, 195.325, 158.476, 194.5595, 202.7595, 375.60450000000003, 434.418, 211.4975, 252.25099999999998, 427.196, 309.403, 205.71249999999998, 170.7895, 323.57349999999997, 267.2665, 269.9865, 331.9015, 263.9345, 289.459, 290.537, 233.6105, 187.04649999999998, 281.0265, 257.982, 267.77250000000004, 323.1435, 208.514, 411.11850000000004, 239.72050000000002, 268.3885, 313.368, 259.5025, 472.6725, 264.4715, 338.90200000000004, 279.0905, 304.6005, 493.587, 419.6535, 251.851, 161.353, 234.22250000000003, 0.022, 376.74850000000004, 286.288, 304.55600000000004, 269.6015, 198.4095, 239.0655, 166.217, 176.5725, 341.95450000000005, 270.1175, 274.108, 523.931, 301.5935, 233.9175, 318.813, 306.66049999999996, 286.675, 251.82600000000002, 194.30450000000002, 346.40999999999997, 187.95, 357.0265, 176.77949999999998, 197.942, 178.551, 175.2725, 429.115, 194.941, 148.19650000000001, 260.5115, 338.553, 192.5615, 439.4315, 314.0625, 418.4705, 249.0815, 264.2405, 68.287, 302.48900000000003, 260.4855, 267.54999999999995, 180.514, 290.04949999999997, 186.44799999999998, 181.2215, 171.9885, 169.2065, 197.72899999999998, 205.341, 166.661, 163.917, 180.52949999999998, 180.922, 203.5665, 169.46949999999998, 206.9995, 176.51049999999998, 186.7105, 195.3755, 211.2465, 506.8645, 189.606, 176.6175, 323.4355, 231.315, 189.9885, 180.1515, 180.28199999999998, 233.784, 307.115, 176.0495, 322.0285, 215.73250000000002, 182.82850000000002, 155.277, 270.2175, 271.70849999999996, 420.858, 236.3905, 186.39100000000002, 239.4035, 175.6595, 360.1675, 164.916, 231.18900000000002, 174.88600000000002, 234.997, 208.21949999999998, 186.1145, 172.035, 248.785, 199.1875, 246.24349999999998, 294.255, 183.216, 318.9105, 173.8415, 177.124, 282.8695, 190.2425, 185.152, 300.06449999999995, 240.3825, 254.555},
+		{282.66650000000004, 166.533, 248.7615, 231.3735, 8.253499999999999, 247.25349999999997, 143.6, 251.113, 236.132, 138.8705, 173.47050000000002, 170.68099999999998, 170.3425, 194.433, 224.8305, 152.2745, 237.61, 264.4065, 136.5335, 113.678, 244.1285, 225.79950000000002, 240.8205, 233.4325, 221.89600000000002, 119.495, 273.442, 261.3605, 80.527, 129.503, 138.2165, 169.45100000000002, 235.8655, 265.3175, 245.011, 157.3545, 255.111, 254.656, 242.462, 269.08000000000004, 151.107, 156.3055, 98.8245, 256.916, 133.9685, 117.041, 83.2735, 234.7765, 157.422, 266.696, 236.4395, 280.30449999999996, 286.4755, 102.4175, 96.905, 281.079, 167.19549999999998, 51.489000000000004, 168.1825, 243.0915, 257.4375, 117.964, 165.7125, 149.41649999999998, 95.982, 188.46550000000002, 100.67750000000001, 133.71800000000002, 282.635, 233.87400000000002, 161.68, 137.793, 176.75650000000002, 120.57499999999999, 243.334, 40.954499999999996, 170.674, 161.716, 233.1165, 147.4235, 139.5405, 144.2255, 240.665, 166.5075, 143.737, 56.269000000000005, 34.656000000000006, 159.2845, 252.12650000000002, 283.9705, 376.7105, 0.048, 143.149, 121.30850000000001, 175.246, 227.04000000000002, 168.917, 390.4625, 349.2085, 164.674, 154.17849999999999, 148.29649999999998, 143.88400000000001, 149.798, 174.9715, 152.828, 149.40449999999998, 143.371, 166.224, 248.588, 146.212, 245.05349999999999, 134.2265, 233.9835, 264.613, 236.7265, 235.262, 23.073, 236.5825, 213.76, 171.4495, 138.248, 245.893, 82.803, 175.4395, 152.0045, 157.1035, 318.706, 300.014, 168.966, 176.6275, 159.645, 245.075, 168.155, 237.3985, 217.1595, 248.61450000000002, 230.059, 299.544, 242.79250000000002, 199.9015, 232.584, 253.441, 204.5215, 283.0385, 230.55450000000002, 250.34300000000002, 231.00150000000002, 279.0575, 204.1825, 241.0265, 82.965, 209.21699999999998, 241.3845, 237.3125, 280.28200000000004, 222.9425, 220.70850000000002, 229.3625, 176.4475, 108.6175, 223.4525, 119.345, 297.41949999999997, 229.43200000000002, 238.2225, 157.73000000000002, 170.84750000000003, 88.7595, 173.63049999999998, 220.601, 168.61599999999999, 219.812, 293.38, 260.3025, 268.5775, 325.242, 295.51599999999996, 245.165, 227.79500000000002, 281.7965, 173.544, 291.015, 169.894, 146.0375, 217.7015, 251.374, 228.24200000000002, 243.7115, 329.284, 246.0985, 264.2765, 135.28699999999998, 320.201, 184.07049999999998},
+		{118.22749999999999, 39.227000000000004, 138.7225, 111.59450000000001, 158.511, 121.354, 282.14099999999996, 144.4235, 112.1505, 11.0095, 40.5135, 48.697500000000005, 41.0745, 40.0075, 129.01749999999998, 28.6245, 117.24799999999999, 138.24650000000003, 267.1245, 50.6055, 152.6535, 130.448, 146.19299999999998, 160.797, 123.7245, 52.0715, 138.21550000000002, 162.1255, 220.9365, 49.3685, 13.312000000000001, 40.942, 114.27449999999999, 147.1975, 145.00349999999997, 243.6575, 148.917, 152.8665, 97.6055, 173.478, 18.729, 41.2425, 53.9285, 120.0035, 7.7059999999999995, 269.51649999999995, 236.1265, 121.1985, 197.36, 168.409, 117.685, 179.1285, 167.927, 235.54899999999998, 224.405, 163.59550000000002, 41.171499999999995, 199.425, 47.084, 154.8365, 111.815, 56.8755, 36.429, 26.8405, 53.1755, 60.7285, 250.949, 50.135999999999996, 177.868, 111.8945, 38.7695, 13.771999999999998, 32.507, 52.736000000000004, 114.035, 168.7945, 32.275, 35.611999999999995, 98.5195, 22.424, 206.154, 29.362499999999997, 101.313, 35.4145, 267.24649999999997, 201.0035, 174.436, 44.1645, 204.414, 176.6565, 285.664, 143.1445, 0.033, 21.171, 36.706, 135.70499999999998, 44.844, 274.9955, 236.978, 79.62049999999999, 28.427999999999997, 39.915, 183.0565, 22.469, 42.5315, 53.3535, 27.8935, 15.253, 43.536, 138.055, 256.5925, 145.489, 95.761, 123.6335, 157.769, 105.059, 127.837, 162.0445, 154.74099999999999, 121.3425, 44.966, 64.5015, 146.487, 205.75650000000002, 43.59, 247.86950000000002, 39.462, 203.531, 268.4035, 36.1355, 45.088, 29.792, 121.1805, 233.244, 116.76750000000001, 122.0325, 129.60950000000003, 108.8295, 163.135, 161.9555, 122.1795, 128.602, 117.836, 142.937, 166.3325, 136.57299999999998, 155.111, 102.93, 171.476, 145.144, 160.69150000000002, 230.2385, 128.5775, 137.3775, 103.4065, 169.71, 116.9685, 111.024, 120.098, 47.724500000000006, 251.793, 126.7835, 55.3915, 173.8895, 107.59299999999999, 120.96950000000001, 52.191, 53.707, 225.257, 45.926, 129.055, 35.288, 139.026, 167.981, 128.39499999999998, 141.529, 219.4215, 198.03449999999998, 153.76600000000002, 126.4225, 150.268, 44.813500000000005, 167.4595, 48.677, 30.691000000000003, 119.709, 110.8695, 112.6785, 129.4845, 199.87599999999998, 124.9885, 153.68849999999998, 221.7055, 190.079, 27.0575},
+		{116.53800000000001, 34.0125, 145.5805, 117.93549999999999, 113.9175, 113.14250000000001, 258.7605, 132.528, 108.7505, 42.7495, 46.0655, 41.244, 50.0535, 49.2895, 140.60950000000003, 35.7555, 120.0455, 127.9555, 238.072, 32.873000000000005, 140.1375, 129.5895, 137.997, 132.3125, 119.4645, 26.6725, 139.19650000000001, 154.775, 187.40949999999998, 34.908500000000004, 20.416, 47.7635, 121.3535, 145.771, 138.968, 303.64300000000003, 143.5545, 147.142, 110.1255, 180.33550000000002, 39.6935, 40.3615, 35.9045, 131.4365, 31.718, 267.414, 209.76, 154.85399999999998, 182.79399999999998, 148.619, 132.5675, 173.5725, 191.64749999999998, 216.175, 204.9665, 175.519, 46.9845, 189.752, 35.5115, 150.20499999999998, 108.73849999999999, 21.604, 35.763999999999996, 27.671, 29.708, 56.0605, 277.73249999999996, 39.573499999999996, 168.01850000000002, 114.8785, 43.854, 18.2315, 31.886, 36.3295, 110.811, 170.9875, 38.3535, 46.6185, 105.76050000000001, 28.993000000000002, 201.11599999999999, 30.7665, 109.866, 49.966499999999996, 289.7215, 183.463, 161.1585, 40.146, 227.249, 159.6825, 304.496, 121.3985, 21.200499999999998, 0.036, 51.486, 163.3435, 45.649, 268.334, 223.48149999999998, 63.134, 31.193, 44.4255, 182.247, 29.6275, 49.091499999999996, 34.765, 33.21, 21.453, 51.458, 145.7855, 250.46800000000002, 132.505, 75.8775, 119.531, 148.44, 107.176, 122.594, 131.774, 147.6935, 131.579, 42.9975, 41.004000000000005, 159.8835, 191.142, 40.704499999999996, 220.9375, 40.1815, 211.1855, 262.975, 61.212999999999994, 44.5905, 41.455, 129.135, 273.8895, 112.5695, 128.728, 137.51749999999998, 110.47399999999999, 166.909, 168.2855, 114.7525, 124.015, 114.70150000000001, 126.5835, 164.21, 144.102, 152.797, 106.9005, 163.5545, 142.401, 156.168, 196.4525, 159.5025, 144.6035, 112.82900000000001, 176.2855, 122.047, 123.219, 114.7355, 46.68, 273.4635, 118.4865, 55.7975, 196.89350000000002, 110.6455, 121.673, 27.5855, 41.6785, 227.6325, 48.302, 127.726, 42.465500000000006, 128.6825, 163.585, 124.8365, 149.256, 254.0195, 195.036, 162.3075, 124.434, 168.6765, 51.2965, 171.1685, 44.6465, 13.7035, 112.1985, 126.20949999999999, 109.36, 125.2415, 202.69549999999998, 132.7435, 137.776, 272.091, 175.1065, 43.148},
+		{95.77199999999999, 37.2945, 122.4375, 98.2675, 170.8745, 108.6245, 289.4855, 129.1655, 100.9735, 30.585, 29.798499999999997, 38.251999999999995, 40.960499999999996, 27.9525, 121.7375, 21.924500000000002, 116.108, 126.0985, 268.0475, 70.60849999999999, 133.875, 116.43299999999999, 121.5375, 129.64600000000002, 111.354, 71.565, 131.50349999999997, 147.4145, 227.13400000000001, 61.024, 23.332500000000003, 26.446, 108.96600000000001, 145.4225, 137.465, 271.0, 130.25799999999998, 133.316, 92.136, 166.061, 32.0735, 48.822, 73.45400000000001, 122.24549999999999, 43.842, 276.9465, 242.027, 113.62950000000001, 209.2175, 127.00800000000001, 111.05, 158.236, 163.635, 244.9345, 258.3915, 151.22899999999998, 28.997, 199.5155, 66.22550000000001, 148.2785, 105.696, 64.6225, 36.894000000000005, 43.658, 73.7555, 48.4975, 253.7615, 62.4885, 171.027, 112.9255, 18.9975, 60.620999999999995, 42.7475, 76.99549999999999, 126.0125, 198.087, 43.313500000000005, 21.241999999999997, 63.541, 50.085499999999996, 246.2805, 42.938500000000005, 101.1585, 16.295, 230.849, 209.89, 198.4675, 34.573499999999996, 188.606, 158.8665, 271.352, 175.23000000000002, 36.108000000000004, 49.635000000000005, 0.0335, 124.1455, 31.4875, 263.8645, 188.96249999999998, 102.2165, 49.2825, 45.537, 196.17399999999998, 31.078, 34.0685, 61.2205, 50.623999999999995, 51.4025, 35.043499999999995, 120.3505, 286.24649999999997, 126.191, 110.4585, 108.7015, 140.338, 95.4305, 113.3065, 177.8175, 139.79899999999998, 115.7615, 33.605000000000004, 81.6545, 135.03050000000002, 216.279, 52.586, 252.3615, 33.9745, 194.6855, 253.8155, 46.825, 42.135, 29.076999999999998, 125.8405, 292.669, 104.0715, 122.923, 116.6045, 102.5205, 163.0765, 138.01749999999998, 101.549, 116.688, 100.4995, 124.2535, 159.72449999999998, 125.42699999999999, 146.772, 102.0475, 164.7125, 137.81799999999998, 146.4675, 258.039, 122.105, 125.0605, 95.72049999999999, 150.494, 113.2485, 117.8535, 113.602, 28.828, 239.792, 114.5295, 93.7575, 167.67149999999998, 100.2085, 103.42, 29.639499999999998, 55.3035, 270.062, 34.1035, 119.93549999999999, 37.2525, 119.945, 163.4565, 117.99100000000001, 134.9005, 211.4115, 181.5355, 141.236, 106.88300000000001, 138.165, 32.256, 159.9895, 42.7945, 42.201, 102.0495, 93.9565, 105.2385, 117.785, 197.024, 112.89949999999999, 132.59449999999998, 249.53449999999998, 167.99349999999998, 42.5035},
+		{239.699, 116.95599999999999, 121.3655, 106.719, 255.243, 21.081, 286.8705, 10.432, 27.4695, 135.1805, 104.9205, 110.2595, 133.3625, 108.131, 49.476, 113.92750000000001, 46.626999999999995, 41.7525, 303.194, 160.287, 16.110500000000002, 9.5745, 36.0765, 40.2535, 29.130000000000003, 173.625, 38.135, 51.739000000000004, 253.365, 170.9155, 137.04399999999998, 106.1465, 107.483, 65.7015, 17.2935, 171.858, 39.3245, 9.9255, 183.8875, 262.9945, 148.03699999999998, 108.405, 166.761, 50.299, 134.7805, 161.6035, 366.9205, 57.233, 327.04949999999997, 48.4185, 32.819500000000005, 86.4855, 88.30199999999999, 232.15800000000002, 220.852, 55.194500000000005, 109.523, 351.61800000000005, 180.215, 67.445, 32.3185, 174.0805, 121.575, 119.0775, 185.38150000000002, 108.2425, 164.1735, 149.1325, 92.4185, 37.439, 131.068, 125.7885, 128.73450000000003, 166.9245, 52.7355, 290.074, 111.1865, 130.796, 163.3165, 120.442, 347.539, 122.166, 195.293, 112.42150000000001, 145.284, 223.364, 293.615, 102.6785, 101.649, 157.1055, 198.2715, 227.01749999999998, 135.7295, 163.314, 126.2425, 0.032, 99.16, 205.89100000000002, 144.928, 187.0325, 125.388, 125.184, 307.89250000000004, 149.41000000000003, 104.404, 198.639, 144.8615, 130.961, 110.6955, 122.05799999999999, 183.4595, 37.78, 210.2125, 25.9535, 50.777, 36.578500000000005, 28.045, 236.39499999999998, 46.265, 36.1365, 107.65450000000001, 191.6245, 34.7395, 272.47, 167.1105, 286.382, 102.32, 86.241, 198.62650000000002, 159.5625, 113.33, 119.989, 29.131999999999998, 163.79000000000002, 44.153000000000006, 121.5615, 29.0775, 102.9145, 73.07900000000001, 56.3315, 28.195, 23.313, 22.8125, 40.385, 69.94, 48.452, 45.539, 36.6145, 72.471, 32.561, 53.3885, 286.60749999999996, 27.3685, 47.826499999999996, 186.7015, 147.288, 22.490000000000002, 21.1495, 35.058499999999995, 105.0955, 173.155, 23.262999999999998, 194.09550000000002, 58.7525, 38.2705, 23.583, 187.13400000000001, 191.17649999999998, 333.5455, 104.985, 30.0735, 109.744, 27.201500000000003, 236.0495, 57.673500000000004, 64.8995, 123.3435, 75.2825, 60.6365, 24.801000000000002, 62.663, 106.759, 83.2825, 115.6385, 131.16649999999998, 39.423, 208.978, 30.682000000000002, 29.914, 197.9185, 51.259, 52.1755, 146.247, 75.9195, 134.53300000000002},
+		{128.43, 13.7695, 97.51650000000001, 78.241, 179.8765, 81.638, 219.0575, 99.03, 69.1755, 40.3835, 2.332, 6.8085, 31.811999999999998, 6.9925, 101.39099999999999, 19.3995, 80.8545, 104.7945, 217.34449999999998, 61.091499999999996, 98.8775, 90.7405, 99.2515, 94.6465, 88.054, 64.394, 100.292, 122.202, 223.7385, 67.09649999999999, 33.299499999999995, 10.3535, 80.02600000000001, 114.2435, 100.8005, 212.8105, 179.862, 109.02250000000001, 81.9925, 161.80599999999998, 37.2045, 11.102, 73.48949999999999, 87.066, 30.622999999999998, 233.022, 259.1055, 114.598, 212.7005, 117.456, 89.2325, 133.7935, 132.53300000000002, 258.7955, 230.868, 118.941, 5.2395, 210.965, 73.927, 113.1035, 80.869, 70.755, 26.511, 20.317999999999998, 62.480999999999995, 18.444, 226.7775, 50.582, 129.6205, 83.102, 28.423000000000002, 23.9725, 32.372, 80.6325, 95.9375, 185.8315, 10.1815, 37.794, 67.61, 20.802, 225.51749999999998, 26.9465, 101.488, 24.1245, 204.712, 229.39, 210.183, 1.4125, 148.284, 132.07299999999998, 238.9755, 168.97050000000002, 44.9315, 45.672, 32.424, 99.0445, 0.046, 232.284, 196.44150000000002, 97.9855, 25.9645, 29.058, 204.5485, 51.322500000000005, 9.5045, 63.406, 44.4925, 38.295500000000004, 5.4055, 98.45949999999999, 260.06050000000005, 93.483, 106.69, 82.7175, 114.64750000000001, 69.5435, 80.85149999999999, 181.906, 113.8985, 82.348, 13.105, 67.6455, 111.741, 230.7045, 47.260000000000005, 254.28949999999998, 1.8735, 168.2145, 238.7305, 54.6075, 11.131, 15.293, 81.14, 253.4755, 79.74549999999999, 94.59, 91.4005, 75.006, 134.9185, 116.581, 76.3045, 93.9925, 87.73150000000001, 103.375, 129.20049999999998, 102.316, 111.667, 69.9015, 131.2045, 101.9805, 127.997, 261.501, 97.18299999999999, 101.30600000000001, 89.65, 125.19749999999999, 78.5585, 76.809, 96.757, 4.1965, 216.01749999999998, 83.945, 85.86099999999999, 135.687, 72.19, 78.9425, 23.6455, 35.0425, 225.691, 6.891, 92.9735, 10.674, 98.1465, 146.8415, 98.668, 119.681, 209.89, 152.4785, 115.694, 80.57249999999999, 111.6785, 12.3035, 134.78, 16.271, 29.1755, 85.028, 102.3835, 74.631, 80.80250000000001, 165.1015, 80.2775, 111.66900000000001, 191.5205, 148.019, 29.7735},
+		{375.13800000000003, 257.5435, 206.824, 184.4755, 411.603, 174.905, 433.051, 188.098, 177.4955, 266.1735, 237.3985, 234.958, 259.3225, 259.5725, 178.589, 255.53199999999998, 186.3235, 195.8375, 461.108, 314.276, 202.4905, 197.981, 164.35, 171.677, 179.5085, 291.59000000000003, 192.34449999999998, 212.3005, 338.299, 315.4315, 261.1, 247.45049999999998, 189.64600000000002, 208.349, 204.2535, 315.4715, 182.74200000000002, 203.65449999999998, 325.96500000000003, 407.93550000000005, 281.2955, 240.0935, 313.8025, 176.8275, 258.1405, 314.7365, 417.0705, 215.894, 478.0675, 218.08100000000002, 166.5245, 217.6155, 215.808, 278.6585, 376.038, 215.469, 242.4515, 458.94100000000003, 306.4235, 207.4365, 182.7485, 305.6905, 264.4035, 247.51850000000002, 282.868, 247.812, 283.08849999999995, 282.97950000000003, 234.07799999999997, 185.8985, 272.476, 254.226, 278.3245, 308.29100000000005, 202.01, 394.4895, 249.315, 260.775, 310.7405, 249.4, 451.8855, 261.5565, 328.304, 267.5855, 268.3855, 285.0675, 435.2125, 242.5625, 184.2055, 237.6935, 166.00650000000002, 384.7765, 274.29, 268.356, 263.267, 191.531, 232.336, 0.055, 197.659, 344.72749999999996, 260.26, 254.39100000000002, 453.13800000000003, 283.803, 240.81099999999998, 280.4705, 272.00350000000003, 267.245, 235.55349999999999, 205.34699999999998, 272.75, 172.0335, 309.9525, 182.9645, 209.01850000000002, 173.793, 182.051, 421.20050000000003, 211.8865, 165.06799999999998, 258.13599999999997, 296.0965, 214.1805, 338.4115, 273.88300000000004, 441.9665, 228.4325, 256.435, 201.227, 285.437, 237.5915, 257.24850000000004, 173.336, 287.606, 185.103, 186.61200000000002, 193.69400000000002, 180.7925, 198.3625, 216.23649999999998, 180.709, 175.5985, 180.24599999999998, 205.804, 224.1775, 173.159, 215.13799999999998, 166.066, 220.74450000000002, 203.926, 215.221, 447.655, 195.9205, 203.908, 330.23, 227.7385, 170.363, 180.19299999999998, 181.6865, 232.4485, 313.235, 165.832, 320.517, 234.3405, 177.3435, 169.5545, 267.1495, 278.2835, 450.67600000000004, 239.3605, 202.352, 244.78300000000002, 194.97899999999998, 378.9405, 185.09300000000002, 220.04399999999998, 227.898, 244.798, 210.0555, 185.3435, 220.538, 241.296, 190.3955, 243.893, 255.9145, 190.275, 0.0, 178.0425, 184.7385, 253.994, 187.6045, 177.584, 265.32500000000005, 257.8295, 266.845},
+		{304.3505, 188.339, 136.362, 127.757, 341.3285, 108.177, 283.4625, 144.3125, 176.7535, 219.4495, 184.0695, 182.1215, 211.36700000000002, 186.757, 142.19799999999998, 193.4355, 131.28750000000002, 127.6925, 285.5635, 263.945, 132.2715, 129.5165, 103.104, 137.6575, 110.483, 238.568, 142.5745, 155.48000000000002, 278.866, 239.614, 217.937, 175.0085, 150.671, 135.5385, 117.34049999999999, 239.0325, 115.313, 141.8175, 255.23950000000002, 343.99699999999996, 214.464, 207.51049999999998, 257.61, 133.5355, 214.632, 217.03, 142.5615, 133.684, 404.603, 141.947, 135.0405, 175.981, 151.65699999999998, 209.82150000000001, 237.89299999999997, 148.45850000000002, 202.86450000000002, 274.001, 279.7535, 151.106, 118.782, 254.46550000000002, 213.5695, 199.5045, 286.34950000000003, 185.6055, 229.20049999999998, 220.8705, 159.7625, 125.08699999999999, 213.3285, 219.605, 190.6395, 247.71550000000002, 123.182, 163.794, 186.0035, 230.276, 233.6485, 220.344, 440.171, 188.2875, 270.317, 204.24450000000002, 145.67399999999998, 139.2815, 313.00800000000004, 174.8395, 16.8075, 172.861, 176.587, 349.3135, 237.036, 223.6125, 192.695, 144.99099999999999, 196.4885, 197.733, 0.067, 299.3385, 213.70850000000002, 208.34550000000002, 376.111, 242.51850000000002, 196.309, 260.017, 220.47500000000002, 194.4195, 178.2755, 135.89100000000002, 192.43349999999998, 126.303, 277.9485, 130.603, 127.984, 116.3495, 118.219, 259.2165, 165.649, 114.7235, 173.13400000000001, 256.9135, 136.406, 252.3255, 243.2615, 213.58800000000002, 184.4325, 182.41, 197.648, 256.0515, 189.118, 199.03, 117.548, 130.428, 103.3445, 116.70349999999999, 127.0305, 139.262, 165.9105, 132.3425, 110.426, 189.553, 108.80199999999999, 125.45599999999999, 150.00549999999998, 118.8475, 145.4315, 128.639, 143.4715, 131.07600000000002, 167.001, 220.19549999999998, 128.79950000000002, 121.2325, 269.4835, 166.14249999999998, 144.9155, 119.479, 120.8315, 178.538, 241.719, 128.948, 262.7285, 173.43, 125.46549999999999, 111.5935, 202.2405, 194.0095, 357.2785, 191.1645, 111.125, 213.9845, 127.26599999999999, 306.44849999999997, 130.2455, 130.8725, 309.4735, 205.637, 155.68099999999998, 117.771, 114.646, 201.184, 164.5435, 199.156, 195.50900000000001, 125.648, 339.277, 97.27199999999999, 119.607, 225.6695, 132.54399999999998, 126.9485, 205.655, 202.074, 216.30849999999998},
+		{272.003, 79.8485, 204.62849999999997, 176.29149999999998, 174.0515, 167.574, 219.2375, 182.6835, 162.1385, 98.94800000000001, 105.116, 103.6565, 114.77250000000001, 99.423, 201.274, 94.8195, 181.2835, 194.402, 215.3505, 31.6055, 185.9055, 195.01600000000002, 198.466, 181.2455, 177.29399999999998, 49.568, 200.626, 230.31099999999998, 223.375, 62.2505, 100.4025, 101.55000000000001, 178.6685, 211.42649999999998, 191.834, 331.1645, 213.7495, 196.38, 171.514, 251.755, 112.687, 85.31649999999999, 27.7905, 181.077, 72.128, 302.9385, 220.838, 205.9385, 201.586, 221.753, 186.7515, 228.73000000000002, 227.416, 239.35449999999997, 221.3555, 243.28699999999998, 106.01650000000001, 183.2765, 65.10050000000001, 197.136, 172.612, 68.0265, 90.0075, 77.155, 34.345, 104.3475, 326.25800000000004, 88.03399999999999, 222.563, 177.4235, 113.10300000000001, 78.72149999999999, 93.6175, 57.215, 177.618, 283.269, 96.81899999999999, 109.447, 151.0275, 87.68350000000001, 186.9445, 87.34, 175.7305, 109.04050000000001, 327.413, 215.69, 166.35000000000002, 97.36449999999999, 260.44050000000004, 241.9205, 341.799, 164.4405, 79.58, 63.114999999999995, 99.9605, 187.079, 97.872, 344.5245, 299.255, 0.0455, 107.61850000000001, 80.81899999999999, 186.9545, 113.1265, 107.463, 85.555, 92.2045, 80.456, 115.273, 203.902, 272.077, 180.5115, 101.1995, 188.2385, 222.043, 169.304, 174.09300000000002, 153.1495, 200.09550000000002, 186.28300000000002, 106.869, 39.609, 208.768, 194.22899999999998, 59.053, 253.73700000000002, 97.41, 258.42949999999996, 326.3415, 104.6905, 102.91, 95.22800000000001, 177.2995, 288.3335, 169.1875, 188.21949999999998, 187.07350000000002, 172.77749999999997, 230.839, 227.245, 187.957, 178.5585, 172.301, 193.13, 228.89600000000002, 201.57, 198.4255, 154.4155, 226.11599999999999, 201.71699999999998, 215.1945, 204.411, 195.839, 202.253, 177.027, 227.79399999999998, 182.4295, 183.36200000000002, 184.6895, 100.18, 284.562, 166.961, 103.1685, 240.98450000000003, 172.5145, 166.877, 98.287, 117.152, 211.8975, 111.26050000000001, 199.313, 100.88, 203.936, 233.6495, 188.566, 206.058, 292.91099999999994, 255.64249999999998, 203.9995, 169.81150000000002, 231.1615, 106.06450000000001, 245.909, 84.249, 72.9385, 185.1285, 184.908, 163.5915, 176.1215, 264.5055, 175.053, 207.3105, 357.1015, 259.771, 96.952},
+		{105.09649999999999, 23.0285, 127.1395, 91.432, 145.594, 97.272, 341.7885, 112.372, 96.75800000000001, 34.057500000000005, 23.3795, 25.8395, 42.739000000000004, 18.9545, 119.737, 12.141, 98.503, 116.6465, 341.331, 70.1345, 117.0245, 113.7145, 114.15350000000001, 110.8985, 109.49199999999999, 55.911, 122.8305, 140.769, 229.346, 64.51750000000001, 26.9835, 27.924500000000002, 98.594, 140.034, 132.833, 239.836, 132.7775, 152.975, 96.831, 158.7765, 34.239000000000004, 27.5885, 69.44149999999999, 113.55000000000001, 28.891, 240.55450000000002, 232.198, 109.8265, 200.5165, 140.8875, 112.406, 152.1265, 152.63600000000002, 262.919, 238.774, 148.971, 29.596, 203.7145, 61.6115, 147.611, 91.8765, 65.084, 4.3525, 12.1275, 68.776, 37.671499999999995, 239.89299999999997, 72.25999999999999, 161.3405, 90.098, 22.033, 32.099500000000006, 17.9445, 61.932, 101.96799999999999, 178.767, 23.11, 31.1025, 89.2865, 12.715, 225.9995, 14.586500000000001, 104.2825, 28.353, 254.53449999999998, 211.57350000000002, 190.2055, 25.1485, 194.024, 152.0325, 270.0905, 154.2055, 28.444000000000003, 31.247, 49.208, 125.4505, 25.988, 260.05, 213.6205, 107.636, 0.6890000000000001, 30.5155, 185.7745, 46.554, 27.066, 70.97749999999999, 36.53, 24.454, 33.416, 127.15950000000001, 262.7555, 113.98400000000001, 95.679, 98.5365, 126.7115, 93.267, 100.983, 193.23450000000003, 126.199, 110.8155, 26.035, 72.967, 129.23250000000002, 234.339, 47.1045, 271.9715, 23.686, 199.38049999999998, 258.2765, 53.9845, 26.9805, 16.694499999999998, 105.9815, 243.9445, 107.92949999999999, 104.453, 113.65299999999999, 88.4105, 159.17849999999999, 141.584, 98.152, 101.509, 91.3255, 117.091, 153.24, 120.53800000000001, 137.253, 106.40950000000001, 156.64749999999998, 130.2235, 137.8, 236.7045, 114.9845, 129.0145, 102.854, 150.7045, 98.81, 106.42750000000001, 99.223, 25.002, 240.32850000000002, 96.64150000000001, 86.065, 158.35950000000003, 94.23949999999999, 100.6495, 21.1815, 24.988500000000002, 238.7355, 94.858, 112.818, 27.055, 112.83500000000001, 145.39100000000002, 107.9885, 132.94549999999998, 228.103, 184.0245, 144.598, 102.8315, 146.386, 32.248999999999995, 158.506, 25.296, 31.473, 122.6225, 103.021, 95.416, 102.3355, 183.76350000000002, 114.553, 123.8635, 216.10750000000002, 202.7645, 14.307},
+		{109.1705, 30.137999999999998, 135.3625, 102.601, 131.07999999999998, 97.68, 281.4395, 119.61250000000001, 91.0065, 33.8675, 31.564999999999998, 29.868000000000002, 40.195499999999996, 35.1625, 125.0805, 23.887999999999998, 86.6505, 115.739, 260.5375, 50.7605, 127.19, 110.762, 118.767, 120.3895, 117.3065, 68.92150000000001, 125.155, 140.363, 220.0095, 81.914, 36.3875, 34.4575, 105.225, 130.86149999999998, 129.822, 244.577, 126.6845, 134.8115, 89.34549999999999, 180.73250000000002, 46.548, 37.182500000000005, 55.546, 107.461, 37.5455, 272.5525, 228.853, 113.35900000000001, 200.42950000000002, 118.15350000000001, 113.65, 157.71800000000002, 160.9295, 254.44099999999997, 228.39499999999998, 165.7015, 33.0115, 215.1755, 79.39150000000001, 132.136, 95.66749999999999, 68.6005, 28.9345, 19.628, 71.1755, 44.7265, 240.23950000000002, 66.5325, 153.0625, 106.693, 50.989999999999995, 27.491, 35.710499999999996, 78.312, 97.199, 176.1455, 35.338, 40.717, 92.4995, 19.244, 228.079, 38.3945, 108.565, 49.483999999999995, 246.48, 214.731, 196.46300000000002, 26.003999999999998, 198.8915, 143.6585, 274.0925, 148.284, 39.9285, 44.402, 47.6435, 125.107, 28.982, 254.31799999999998, 208.24, 80.74549999999999, 30.4625, 0.02, 235.553, 49.545500000000004, 34.340500000000006, 76.5385, 48.7675, 43.5205, 31.326, 134.2815, 260.96349999999995, 120.64750000000001, 112.8655, 104.6635, 127.634, 94.3195, 109.6445, 150.7015, 128.3625, 116.3885, 30.936500000000002, 74.3885, 127.8005, 232.09300000000002, 34.009, 242.6175, 27.906, 190.29149999999998, 247.69, 91.70599999999999, 38.661500000000004, 25.3585, 107.5795, 292.812, 101.33099999999999, 115.3625, 112.73949999999999, 97.17750000000001, 160.82049999999998, 143.636, 101.174, 105.03, 103.0925, 114.15950000000001, 149.14350000000002, 132.9645, 135.39350000000002, 99.193, 145.43900000000002, 129.32150000000001, 151.0405, 214.841, 118.093, 130.286, 95.7915, 161.34199999999998, 108.3005, 105.7895, 99.0805, 32.676500000000004, 245.21550000000002, 107.905, 80.3175, 177.9965, 97.261, 100.644, 14.8455, 28.898, 242.495, 41.9645, 113.4695, 27.1705, 113.635, 154.9965, 110.67500000000001, 133.6395, 186.2545, 184.4435, 149.655, 110.3215, 148.304, 32.4035, 156.594, 41.17, 36.831, 95.859, 129.7215, 97.86500000000001, 108.18799999999999, 189.027, 103.37049999999999, 122.53399999999999, 215.846, 172.3465, 35.588},
+		{325.593, 205.4105, 305.927, 278.9095, 253.96550000000002, 283.2865, 1.1684999999999999, 303.2935, 273.3065, 171.84199999999998, 206.864, 209.03449999999998, 205.66899999999998, 205.1535, 287.83950000000004, 193.985, 285.0525, 294.0765, 42.853, 156.23950000000002, 342.116, 281.0115, 297.81500000000005, 338.762, 286.59900000000005, 158.348, 307.4515, 331.089, 268.1345, 149.186, 174.805, 197.535, 281.9815, 329.214, 339.16949999999997, 392.916, 311.1435, 301.845, 249.6055, 324.017, 193.72250000000003, 218.904, 185.8105, 291.6975, 216.1375, 355.58050000000003, 264.392, 312.56899999999996, 15.9625, 330.7715, 286.0775, 343.162, 343.911, 292.4, 423.9455, 337.5795, 209.268, 293.351, 152.102, 322.9535, 286.279, 179.6905, 205.1485, 231.039, 196.3655, 265.377, 345.22950000000003, 166.027, 350.006, 286.3745, 210.426, 186.358, 186.3535, 158.07150000000001, 289.081, 213.08800000000002, 221.3675, 251.2505, 276.5215, 190.2185, 46.2415, 213.472, 273.6275, 197.3985, 375.6405, 337.2985, 270.194, 202.64499999999998, 362.9275, 344.475, 525.0835, 143.3415, 182.605, 181.7115, 195.6595, 307.404, 204.002, 449.4325, 375.5325, 186.493, 185.321, 235.119, 0.0445, 184.01600000000002, 209.719, 218.3965, 203.9365, 222.9915, 202.719, 306.59749999999997, 402.19100000000003, 337.858, 217.3995, 291.115, 319.5585, 272.8895, 304.25350000000003, 268.22400000000005, 314.83500000000004, 289.2635, 203.567, 184.1905, 318.8145, 302.375, 234.48000000000002, 70.583, 215.0985, 369.66650000000004, 459.4665, 199.822, 255.08749999999998, 194.7945, 283.109, 364.26599999999996, 280.9305, 289.6835, 290.172, 276.0025, 345.6675, 329.93550000000005, 289.4075, 289.6735, 284.9895, 302.08050000000003, 328.0925, 304.033, 319.2925, 311.725, 327.9395, 309.5415, 320.40250000000003, 353.6715, 294.917, 310.27149999999995, 258.807, 338.06399999999996, 319.0945, 284.7875, 288.5815, 220.01999999999998, 337.823, 294.164, 173.215, 342.3355, 275.042, 284.89250000000004, 213.187, 211.55349999999999, 319.911, 213.529, 291.834, 203.4955, 305.985, 345.495, 299.8165, 309.1905, 372.288, 367.5605, 313.491, 291.732, 319.249, 208.856, 337.808, 217.715, 217.04149999999998, 281.4675, 0.0, 277.03700000000003, 314.922, 367.058, 277.2375, 313.20849999999996, 365.85699999999997, 344.1635, 194.0915},
+		{160.776, 46.0505, 149.59949999999998, 122.8115, 170.02, 121.2745, 288.5085, 141.8475, 125.47649999999999, 16.235, 48.1025, 45.733, 36.194500000000005, 44.05, 140.809, 33.281499999999994, 140.339, 143.512, 268.3185, 73.0035, 141.78300000000002, 133.99450000000002, 132.3425, 138.075, 125.5935, 52.509, 146.63, 172.9425, 226.6045, 45.24, 18.5285, 49.7675, 127.53, 157.311, 145.3945, 273.163, 182.2935, 159.189, 107.1465, 167.6685, 22.195, 51.611999999999995, 66.507, 140.882, 38.394000000000005, 257.2955, 243.2585, 128.01549999999997, 196.88150000000002, 151.338, 132.618, 189.6235, 191.16649999999998, 276.145, 309.17100000000005, 169.464, 49.0665, 205.1055, 46.1025, 160.1575, 123.3205, 55.149, 49.199, 38.706, 68.717, 65.001, 256.21500000000003, 57.107, 176.625, 128.32299999999998, 41.7055, 41.293000000000006, 41.277, 58.8965, 138.3985, 178.95, 53.61, 30.115499999999997, 81.4995, 36.391999999999996, 225.435, 38.246, 104.733, 49.231, 260.935, 202.5435, 182.796, 41.332, 198.82150000000001, 173.425, 301.408, 149.775, 22.688000000000002, 29.641, 31.6295, 149.42399999999998, 51.252, 286.8855, 242.364, 112.87100000000001, 46.556, 49.5615, 184.273, 0.038, 56.835, 52.822500000000005, 44.745999999999995, 59.197, 50.2235, 148.7785, 267.038, 135.63549999999998, 95.282, 117.475, 160.0125, 115.632, 121.293, 180.64499999999998, 152.19099999999997, 128.2785, 45.9345, 75.3735, 142.36950000000002, 212.9005, 56.034, 250.856, 42.382, 213.7745, 274.745, 41.38, 52.812, 50.3755, 137.945, 261.3965, 124.7205, 130.2695, 135.639, 122.0035, 189.8325, 162.176, 121.91149999999999, 137.671, 116.695, 131.361, 165.73250000000002, 145.4375, 157.93900000000002, 117.307, 177.137, 142.213, 162.7705, 250.2665, 133.94150000000002, 139.628, 100.41499999999999, 177.874, 116.693, 122.021, 120.922, 50.459500000000006, 242.85649999999998, 128.894, 69.21199999999999, 179.4875, 117.4295, 117.9445, 45.4055, 44.671, 240.2925, 53.323499999999996, 130.04899999999998, 53.119, 130.0885, 161.1415, 127.316, 170.1375, 242.3655, 204.755, 159.9685, 128.07999999999998, 159.075, 54.552, 177.3405, 49.635, 35.9555, 127.82300000000001, 115.2795, 110.405, 121.495, 211.317, 132.54149999999998, 147.382, 249.6855, 227.88, 61.082},
+		{175.3525, 22.302500000000002, 93.8905, 71.8305, 185.599, 72.251, 294.6915, 100.5315, 70.352, 49.582499999999996, 8.4405, 2.334, 38.704, 14.5185, 101.72149999999999, 24.3125, 73.9265, 89.29150000000001, 277.82899999999995, 78.5745, 107.578, 85.561, 101.67150000000001, 115.416, 85.49, 75.7865, 92.92750000000001, 118.7305, 254.7645, 73.631, 44.091, 16.5725, 74.59649999999999, 110.66550000000001, 100.6265, 208.23950000000002, 110.30799999999999, 107.1135, 94.37899999999999, 170.166, 50.742, 10.406500000000001, 73.9025, 94.522, 36.041, 266.4015, 263.4865, 96.041, 224.702, 104.2175, 78.4145, 137.6555, 138.5845, 251.222, 252.7695, 130.5525, 9.886, 214.9845, 71.3385, 110.37049999999999, 69.595, 81.027, 26.8465, 20.387500000000003, 80.243, 23.981, 238.80700000000002, 73.04650000000001, 136.8675, 72.80799999999999, 34.1185, 30.2695, 36.1785, 77.47, 87.67099999999999, 198.693, 13.655999999999999, 40.061, 75.07900000000001, 21.836, 233.46699999999998, 32.089, 100.487, 31.165, 196.92, 237.6995, 202.5115, 8.463, 167.68650000000002, 127.8815, 236.26350000000002, 174.906, 42.5125, 48.9955, 34.6575, 104.3055, 9.4315, 236.0605, 196.178, 107.2745, 26.9785, 34.3, 210.18650000000002, 56.795500000000004, 0.017, 80.0605, 52.135000000000005, 30.582, 11.2365, 93.8315, 247.6515, 106.87049999999999, 119.4115, 75.398, 109.2075, 65.4735, 92.29050000000001, 201.0745, 113.48349999999999, 81.9795, 19.836, 91.747, 105.89150000000001, 227.333, 48.4335, 259.06399999999996, 7.891, 157.9975, 229.394, 60.418, 5.318, 19.552500000000002, 85.98400000000001, 194.6935, 79.173, 83.072, 90.542, 68.939, 129.377, 115.9985, 73.275, 80.119, 70.447, 105.45150000000001, 125.5495, 96.9505, 106.445, 63.842, 122.42500000000001, 105.757, 128.2125, 284.313, 84.743, 91.826, 100.049, 120.257, 70.8205, 70.9905, 71.67150000000001, 10.7995, 278.1885, 76.094, 81.242, 132.535, 67.30950000000001, 79.372, 26.4745, 40.561, 245.847, 11.789, 89.4445, 8.52, 90.16300000000001, 162.21949999999998, 93.516, 101.9785, 216.485, 150.038, 116.173, 81.05250000000001, 123.4125, 19.198500000000003, 133.599, 17.25, 47.7435, 76.9, 108.136, 72.45, 94.0815, 159.6925, 80.05699999999999, 105.51400000000001, 188.5595, 154.89749999999998, 29.9145},
+		{186.90949999999998, 57.214, 169.156, 148.2665, 140.0605, 135.54250000000002, 271.486, 166.3485, 169.8355, 38.841499999999996, 64.8985, 69.426, 73.89500000000001, 63.419, 164.5675, 67.00200000000001, 142.57850000000002, 154.94, 253.6325, 62.004000000000005, 170.377, 147.2545, 167.763, 162.789, 146.94799999999998, 34.707499999999996, 163.233, 167.79250000000002, 207.3755, 35.66, 42.599, 75.7535, 151.42450000000002, 181.2625, 170.00400000000002, 286.435, 185.373, 182.98149999999998, 123.4265, 195.6175, 39.8065, 69.7055, 54.8325, 174.332, 75.144, 261.3265, 218.39499999999998, 148.4755, 192.32, 159.4065, 156.3435, 205.3675, 213.4185, 254.679, 324.07050000000004, 205.69299999999998, 65.1465, 200.84699999999998, 30.070999999999998, 204.94799999999998, 144.3965, 44.4795, 74.41900000000001, 55.112, 53.3775, 93.318, 257.6325, 38.1625, 213.0775, 145.58249999999998, 65.1745, 64.0915, 49.0115, 44.747, 145.6555, 179.64499999999998, 60.9435, 66.146, 119.5985, 46.2735, 217.4565, 46.484, 152.1175, 73.7465, 271.307, 187.214, 166.94299999999998, 62.697, 231.8365, 204.064, 313.5885, 152.1085, 53.2825, 33.4495, 68.601, 200.712, 65.76, 278.855, 262.3565, 83.605, 72.082, 77.081, 216.6485, 54.269, 79.396, 0.0295, 68.92099999999999, 85.10149999999999, 76.253, 171.8825, 272.99699999999996, 160.0695, 74.45949999999999, 142.86849999999998, 171.34750000000003, 132.86200000000002, 136.59, 150.04000000000002, 165.303, 152.464, 66.673, 64.896, 184.291, 186.9095, 63.144000000000005, 235.1275, 78.5865, 244.53, 276.8855, 40.257000000000005, 72.56649999999999, 65.029, 170.4555, 286.106, 148.76749999999998, 153.55200000000002, 149.77949999999998, 148.561, 229.2455, 184.7715, 128.4245, 147.01299999999998, 138.0035, 152.484, 193.917, 159.67000000000002, 169.8345, 140.59, 202.17149999999998, 151.70749999999998, 183.7695, 222.9155, 151.3595, 158.44650000000001, 125.7285, 206.713, 145.3095, 142.465, 134.21949999999998, 66.905, 263.651, 145.865, 47.448, 193.2305, 133.522, 139.6745, 61.04, 69.90299999999999, 242.3025, 69.7575, 137.7515, 75.168, 151.728, 190.209, 166.2195, 205.2705, 269.4275, 220.1755, 182.67849999999999, 154.08100000000002, 191.722, 78.148, 188.63150000000002, 68.39750000000001, 46.823, 156.2555, 151.39249999999998, 132.171, 136.756, 234.2985, 160.625, 163.27, 258.1135, 249.836, 84.1565},
+		{173.639, 45.882000000000005, 148.3675, 122.5605, 136.863, 111.656, 281.18899999999996, 133.07150000000001, 117.5615, 25.1605, 43.41, 46.82, 46.870999999999995, 41.2595, 135.868, 29.9285, 117.8545, 132.529, 260.6255, 55.739000000000004, 137.85199999999998, 129.37349999999998, 146.906, 136.973, 122.3005, 49.555499999999995, 144.875, 157.801, 210.14749999999998, 60.047, 34.7855, 39.0625, 128.88, 146.14100000000002, 137.232, 272.254, 141.0395, 153.1135, 104.00800000000001, 170.0965, 13.783, 48.321, 58.950500000000005, 153.614, 14.4495, 247.70600000000002, 232.938, 137.744, 204.638, 137.07, 128.6845, 176.6585, 169.91500000000002, 250.8245, 232.1615, 168.748, 47.2375, 204.59750000000003, 60.8475, 153.80599999999998, 111.082, 70.592, 39.135000000000005, 31.967, 53.0965, 58.643, 257.26, 42.2845, 164.9105, 125.2415, 50.427499999999995, 27.7165, 44.025499999999994, 57.876, 112.8075, 179.472, 45.0055, 63.4695, 119.931, 40.647999999999996, 224.03, 37.258, 116.19399999999999, 45.210499999999996, 255.8725, 205.045, 174.436, 43.4715, 212.7015, 163.7265, 305.5135, 149.39600000000002, 27.886499999999998, 33.188, 50.9865, 144.841, 44.430499999999995, 271.9855, 220.39749999999998, 92.164, 36.468, 48.7585, 204.447, 44.711, 52.129000000000005, 68.23, 0.032, 22.281, 50.92, 148.3265, 251.0745, 135.85, 97.745, 121.757, 147.60399999999998, 111.46449999999999, 119.6795, 154.547, 146.733, 127.904, 44.939, 64.38749999999999, 166.9255, 218.4025, 46.22, 244.16750000000002, 47.306, 208.46949999999998, 267.826, 51.898, 50.044, 53.694500000000005, 140.65949999999998, 285.954, 122.126, 132.06349999999998, 137.3895, 115.21799999999999, 181.47050000000002, 159.925, 116.917, 121.943, 123.89750000000001, 130.687, 162.39999999999998, 140.9635, 152.6345, 127.336, 170.077, 146.9755, 171.1725, 218.00900000000001, 147.71050000000002, 147.9185, 113.57050000000001, 177.1135, 126.9755, 125.25200000000001, 117.594, 47.3585, 249.262, 125.089, 53.316, 172.7365, 122.7425, 118.662, 39.483000000000004, 56.683499999999995, 237.938, 44.037, 131.1245, 48.2275, 131.6445, 166.9375, 128.2385, 147.746, 257.826, 208.10399999999998, 159.73149999999998, 121.259, 167.42450000000002, 44.0505, 165.611, 45.8715, 19.8945, 114.265, 136.0215, 113.92699999999999, 121.0975, 212.8515, 130.051, 140.08249999999998, 280.70799999999997, 181.364, 42.458},
+		{116.026, 31.1995, 126.737, 99.628, 130.6715, 110.31649999999999, 274.96000000000004, 130.946, 101.62950000000001, 20.587, 28.2725, 40.4975, 44.070499999999996, 40.1755, 129.452, 31.282, 101.36, 125.704, 253.5605, 49.706, 135.9865, 120.6095, 124.239, 129.6105, 116.4075, 43.3505, 132.44650000000001, 153.5605, 203.523, 52.6575, 49.1245, 38.539, 102.138, 137.2615, 134.76049999999998, 251.2815, 138.948, 142.33749999999998, 94.96549999999999, 185.8625, 35.7785, 32.94, 52.766, 130.08350000000002, 8.9885, 243.10000000000002, 226.71, 123.463, 192.24849999999998, 131.947, 114.5675, 170.582, 155.8055, 231.3345, 222.098, 159.35750000000002, 29.829, 190.63400000000001, 50.312, 138.72449999999998, 99.838, 49.463, 22.933999999999997, 21.792, 49.816, 54.063500000000005, 241.3145, 29.0475, 163.94799999999998, 107.7045, 44.8825, 14.158999999999999, 22.0265, 62.292500000000004, 104.2695, 181.6345, 75.122, 38.8855, 78.74549999999999, 11.028500000000001, 211.485, 19.3325, 120.69800000000001, 43.494, 229.48000000000002, 198.63049999999998, 195.729, 38.0685, 203.475, 162.0075, 292.85450000000003, 143.288, 15.227, 21.4215, 51.9375, 130.90300000000002, 38.231, 267.1945, 194.27050000000003, 80.32050000000001, 24.383, 43.5125, 223.4135, 59.175, 30.58, 84.9915, 22.285, 0.028, 42.191500000000005, 127.7005, 258.16700000000003, 128.83800000000002, 92.81649999999999, 113.34100000000001, 141.3915, 104.3725, 120.051, 147.406, 140.844, 125.35499999999999, 38.736000000000004, 58.4625, 144.844, 209.02300000000002, 35.109, 237.086, 32.6535, 198.0965, 259.9525, 45.6725, 38.055, 21.6665, 124.74549999999999, 257.5185, 109.729, 118.10249999999999, 125.851, 103.2305, 153.691, 151.16250000000002, 111.64699999999999, 116.217, 121.3595, 124.4075, 158.45850000000002, 124.655, 150.14600000000002, 96.6515, 166.687, 140.716, 153.45749999999998, 212.2645, 125.6505, 139.782, 101.64500000000001, 158.141, 105.8115, 113.34649999999999, 110.34549999999999, 33.1295, 236.041, 110.2365, 53.1635, 162.991, 101.937, 114.8535, 27.505, 35.655, 233.801, 38.477999999999994, 124.908, 24.052500000000002, 126.2875, 160.0885, 121.99549999999999, 129.6485, 200.2035, 191.96800000000002, 154.974, 122.24199999999999, 156.3585, 42.9975, 163.4325, 38.798, 13.689499999999999, 103.56800000000001, 117.5765, 109.62950000000001, 122.013, 198.6455, 122.804, 139.38799999999998, 280.279, 182.0495, 40.1285},
+		{125.50999999999999, 25.610500000000002, 99.1935, 82.79050000000001, 178.36950000000002, 79.49, 223.69299999999998, 103.1125, 77.7955, 40.7365, 5.227, 9.862, 34.988, 10.127500000000001, 104.2955, 24.169, 83.879, 91.686, 225.7115, 77.1345, 101.55000000000001, 91.513, 103.1455, 97.102, 80.91900000000001, 67.80099999999999, 107.995, 123.2285, 231.1225, 68.0545, 35.8065, 10.628, 83.56450000000001, 110.62299999999999, 103.618, 222.136, 114.043, 117.084, 83.15899999999999, 152.1135, 44.7505, 16.1665, 79.102, 91.101, 33.191, 216.175, 274.909, 111.231, 211.79899999999998, 119.9345, 96.41550000000001, 134.8095, 136.098, 260.8755, 295.86, 135.13850000000002, 6.9355, 222.85399999999998, 65.0445, 109.5355, 79.7535, 77.691, 29.427, 19.359, 65.0385, 24.247999999999998, 209.64600000000002, 69.1935, 132.04, 83.0815, 28.8295, 47.674499999999995, 34.685, 75.5005, 100.463, 209.9615, 12.2475, 37.6795, 74.821, 25.96, 232.36950000000002, 29.4805, 95.143, 23.227, 209.3775, 232.452, 197.17649999999998, 4.2684999999999995, 164.261, 134.63400000000001, 245.966, 166.821, 43.613, 51.495000000000005, 34.566, 110.7965, 5.314, 247.6085, 178.25, 115.63, 33.408500000000004, 31.421, 202.8895, 50.316, 11.183, 76.273, 51.125, 42.231, 0.0445, 98.6335, 257.089, 96.051, 109.47999999999999, 90.88900000000001, 118.9055, 73.456, 85.8825, 189.6085, 110.074, 97.524, 16.804000000000002, 70.209, 114.2535, 254.8535, 52.1085, 259.1325, 5.1655, 185.55849999999998, 253.1415, 61.7885, 13.6025, 27.1815, 84.7355, 225.9235, 82.4745, 102.99549999999999, 93.4845, 76.44149999999999, 138.6695, 122.3195, 80.5215, 94.031, 79.08449999999999, 101.331, 128.6265, 103.51650000000001, 115.852, 81.127, 132.8905, 109.16149999999999, 126.7525, 254.4575, 96.679, 107.0455, 88.771, 127.8715, 79.32849999999999, 79.78, 78.44399999999999, 8.220500000000001, 211.8295, 82.0185, 86.2495, 135.2385, 75.41050000000001, 82.5595, 27.66, 41.1345, 253.136, 15.3645, 100.4245, 12.117, 100.8725, 136.047, 99.6035, 115.6305, 212.997, 152.86, 117.50200000000001, 83.16, 117.7355, 18.013, 138.06, 17.6695, 33.340999999999994, 86.123, 104.39150000000001, 75.23949999999999, 85.657, 168.072, 94.95750000000001, 105.3765, 204.15249999999997, 185.615, 32.192499999999995},
+		{267.354, 111.6545, 0.6839999999999999, 23.4205, 282.7595, 24.111, 292.70550000000003, 35.7255, 27.038, 141.2735, 99.8385, 95.804, 129.42000000000002, 102.089, 43.475, 116.155, 39.7375, 1.789, 279.778, 174.8395, 44.8965, 20.009, 25.823, 24.176499999999997, 13.474, 170.6365, 14.1295, 25.9925, 266.88, 164.4375, 136.79649999999998, 102.7775, 31.9835, 62.753, 37.3885, 237.20499999999998, 7.57, 39.133, 185.1825, 261.01800000000003, 133.764, 102.1185, 170.2345, 49.713, 131.6255, 146.7465, 259.59900000000005, 44.5125, 321.114, 10.3735, 18.203, 65.51750000000001, 82.669, 190.9395, 348.746, 40.426500000000004, 104.8665, 260.405, 166.1325, 67.74449999999999, 34.913, 171.7575, 119.729, 120.49600000000001, 177.59300000000002, 113.3155, 149.4795, 143.74, 81.7465, 39.414500000000004, 126.0695, 127.65350000000001, 147.02100000000002, 172.60750000000002, 39.7375, 290.625, 109.6175, 131.2525, 188.3545, 121.874, 330.044, 126.666, 184.6715, 120.46199999999999, 140.707, 328.5935, 291.09749999999997, 94.815, 95.85749999999999, 32.682, 206.626, 248.538, 138.06099999999998, 145.7665, 122.14, 122.082, 98.467, 202.55599999999998, 135.795, 203.9645, 127.1, 134.293, 307.142, 148.767, 93.83850000000001, 170.849, 148.335, 127.731, 98.5535, 0.0325, 191.1495, 13.211500000000001, 224.238, 18.103, 13.904, 31.541, 18.0455, 285.0975, 15.776, 18.1075, 106.6345, 187.8895, 43.599000000000004, 329.629, 143.2575, 220.288, 102.667, 86.5015, 188.526, 152.3245, 96.292, 119.592, 32.956, 139.111, 37.906499999999994, 18.032, 17.4215, 35.3725, 66.707, 17.412, 25.238500000000002, 25.649, 24.3385, 10.277000000000001, 53.851, 23.833, 44.032, 30.4345, 54.272999999999996, 37.2735, 25.411, 223.9545, 14.926, 9.6265, 191.71749999999997, 26.079, 24.635, 24.93, 23.5215, 100.65, 149.656, 27.881500000000003, 180.8825, 30.2195, 33.9055, 23.9375, 123.82849999999999, 128.772, 270.931, 100.1935, 25.6485, 99.5155, 15.012, 233.38, 36.751999999999995, 45.0445, 122.4135, 61.203500000000005, 46.5535, 27.6385, 47.3935, 106.0835, 76.70599999999999, 106.7495, 142.77249999999998, 42.709, 198.39350000000002, 38.046499999999995, 19.508, 128.4525, 40.182, 10.232, 233.3065, 64.76400000000001, 127.79650000000001},
+		{380.0855, 243.55849999999998, 186.6685, 177.175, 114.81649999999999, 259.344, 169.99349999999998, 198.40300000000002, 227.6035, 258.33050000000003, 259.634, 278.366, 274.974, 244.1055, 187.56650000000002, 245.7635, 196.692, 182.7015, 171.8505, 243.14499999999998, 232.381, 196.4205, 208.96550000000002, 196.3755, 173.9905, 256.33500000000004, 201.0365, 203.0645, 57.545, 253.5705, 252.0015, 247.5555, 187.20499999999998, 218.87599999999998, 197.567, 65.2425, 198.0875, 216.061, 391.355, 382.35400000000004, 258.0115, 265.07, 246.8835, 171.7705, 242.6305, 77.76599999999999, 65.6345, 178.09, 409.689, 200.5335, 210.5625, 253.58499999999998, 224.678, 112.936, 106.8965, 224.5225, 252.0005, 87.42150000000001, 247.813, 206.591, 183.8415, 232.098, 298.20050000000003, 253.845, 248.6045, 253.5785, 73.55000000000001, 263.022, 319.1805, 188.102, 273.004, 248.964, 271.7545, 269.27250000000004, 174.19400000000002, 108.2585, 253.615, 279.35, 368.063, 250.41449999999998, 346.8555, 263.20849999999996, 351.2665, 253.7455, 51.7815, 91.69149999999999, 269.27549999999997, 247.909, 189.4805, 218.4025, 345.704, 146.07150000000001, 256.60400000000004, 249.9325, 287.22450000000003, 182.95999999999998, 260.169, 272.22, 191.648, 271.6775, 262.808, 260.778, 401.8905, 267.0575, 247.612, 271.1295, 250.86450000000002, 258.14549999999997, 255.17649999999998, 190.9865, 0.031, 192.05849999999998, 194.1835, 213.385, 203.6295, 201.9345, 203.6475, 136.101, 193.0915, 178.293, 271.24, 262.31100000000004, 205.8675, 104.1405, 245.7925, 96.2, 237.2245, 260.5, 327.34450000000004, 265.499, 283.6755, 258.0305, 152.6775, 148.25549999999998, 188.034, 283.75149999999996, 210.8015, 288.394, 227.8055, 213.22050000000002, 189.5735, 212.8125, 215.20049999999998, 183.033, 228.9475, 188.5775, 212.047, 177.01049999999998, 257.5355, 197.36450000000002, 319.797, 76.0035, 177.21050000000002, 202.7735, 389.3055, 216.6565, 183.69799999999998, 270.6005, 207.277, 253.179, 72.594, 173.59949999999998, 231.9015, 234.962, 165.587, 194.0435, 250.6705, 266.8265, 263.41650000000004, 287.7635, 178.2545, 251.8365, 181.3515, 385.847, 191.8665, 361.245, 251.345, 254.789, 217.5985, 183.6185, 207.102, 270.851, 241.753, 247.17000000000002, 245.84550000000002, 231.36200000000002, 341.8985, 184.8665, 180.676, 264.0145, 239.212, 292.2745, 72.743, 242.5205, 256.146},
+		{227.88600000000002, 104.94149999999999, 13.357, 33.839, 225.14249999999998, 45.405, 307.78999999999996, 46.332499999999996, 33.9915, 135.164, 102.4, 97.684, 128.0755, 106.185, 35.4285, 111.1195, 35.505, 6.27, 287.399, 149.7575, 35.9735, 34.9265, 25.4235, 11.8625, 18.091, 155.928, 49.9495, 33.933, 167.84050000000002, 158.921, 132.4875, 102.695, 44.255, 67.70400000000001, 39.657, 168.09550000000002, 11.765999999999998, 49.08, 179.30700000000002, 268.8395, 140.4915, 104.119, 153.04, 35.967, 122.0735, 166.5215, 183.313, 50.5105, 305.10299999999995, 36.5875, 30.445999999999998, 71.061, 86.1705, 184.7255, 284.148, 53.033500000000004, 108.441, 258.7655, 157.2245, 79.8475, 49.4065, 150.244, 116.6965, 111.7815, 152.8295, 115.618, 153.974, 155.631, 81.0805, 39.0345, 128.903, 117.076, 121.351, 160.1275, 39.4555, 246.885, 119.827, 123.52000000000001, 191.0215, 127.7525, 330.5165, 116.0655, 191.1275, 114.704, 131.10399999999998, 255.773, 281.84450000000004, 92.531, 115.291, 30.695500000000003, 187.846, 245.055, 145.5355, 132.5285, 126.9985, 37.805499999999995, 93.458, 171.9735, 126.2405, 180.52100000000002, 113.9795, 120.69399999999999, 338.352, 135.64499999999998, 106.9235, 161.9115, 135.9205, 128.8915, 96.0945, 13.239, 192.1755, 0.023, 198.3745, 27.658, 16.781, 31.5385, 21.3345, 246.18099999999998, 32.4705, 25.816, 104.963, 158.3135, 47.0685, 266.663, 138.1225, 270.23, 92.58699999999999, 80.88550000000001, 185.966, 181.71699999999998, 101.112, 111.0985, 19.65, 218.5525, 38.4525, 25.714, 17.85, 34.730999999999995, 84.0075, 53.485, 25.060499999999998, 31.2655, 25.1585, 9.961, 62.4675, 23.941499999999998, 53.496, 29.9055, 67.0295, 24.4655, 32.2975, 210.862, 12.622499999999999, 28.115, 187.1365, 41.3315, 25.444, 33.908, 24.360999999999997, 102.251, 173.75900000000001, 28.201, 177.6875, 63.816, 33.574, 25.849, 115.018, 131.1775, 194.8025, 97.3785, 23.96, 114.8905, 24.8615, 215.983, 35.341499999999996, 56.841499999999996, 219.416, 80.7445, 50.5895, 27.811500000000002, 55.7245, 104.54249999999999, 81.149, 105.6675, 119.755, 41.576499999999996, 207.1975, 33.459500000000006, 22.968, 128.135, 42.025000000000006, 27.7295, 188.36, 65.863, 121.075},
+		{181.3315, 115.936, 209.743, 186.8995, 78.636, 177.4715, 248.11399999999998, 198.0005, 190.262, 78.3745, 106.35, 112.2005, 106.98349999999999, 106.214, 205.4755, 95.682, 165.38150000000002, 192.496, 227.59550000000002, 70.801, 203.086, 188.287, 197.5975, 199.5495, 184.413, 54.47, 205.4675, 220.199, 150.46800000000002, 49.938500000000005, 86.939, 114.039, 188.0775, 214.8365, 203.353, 307.637, 206.4455, 210.16, 157.043, 231.253, 85.529, 112.4365, 74.1855, 189.836, 84.30850000000001, 291.6205, 211.434, 200.372, 197.6345, 212.4405, 193.05849999999998, 241.10649999999998, 249.56900000000002, 218.13400000000001, 199.28300000000002, 242.59, 114.6385, 162.254, 53.1425, 213.67000000000002, 173.1175, 53.1435, 108.693, 99.8325, 67.18350000000001, 128.13150000000002, 289.3675, 60.560500000000005, 238.172, 181.63600000000002, 114.166, 87.429, 94.7405, 61.682, 174.2355, 143.987, 119.551, 107.67949999999999, 146.7695, 103.4085, 211.375, 95.99000000000001, 217.5435, 116.678, 288.0115, 168.513, 179.144, 106.16499999999999, 249.415, 225.421, 356.953, 134.161, 95.741, 75.892, 112.9385, 210.09, 106.5995, 309.847, 277.9635, 101.0995, 95.65, 112.87299999999999, 217.77300000000002, 95.3125, 119.445, 76.2835, 97.7695, 92.8575, 109.56299999999999, 224.225, 194.016, 198.361, 0.043, 183.874, 214.42450000000002, 172.589, 189.04250000000002, 94.6895, 209.695, 195.6885, 106.331, 78.9535, 211.75900000000001, 179.3915, 106.63900000000001, 211.021, 112.51650000000001, 267.7475, 327.463, 102.8425, 115.529, 111.6035, 189.01, 244.889, 179.2525, 194.5075, 193.553, 178.6215, 243.563, 225.2785, 181.349, 188.011, 185.24200000000002, 196.62599999999998, 230.7705, 199.892, 212.83249999999998, 171.108, 234.1055, 208.851, 210.98950000000002, 165.66750000000002, 204.322, 209.8795, 178.714, 237.024, 180.26, 187.965, 179.89, 107.7755, 281.88149999999996, 183.624, 67.733, 250.185, 175.039, 181.427, 99.693, 122.3415, 241.175, 112.54599999999999, 193.53300000000002, 114.773, 194.4035, 229.099, 190.6005, 232.51, 315.918, 266.31, 229.3645, 190.57999999999998, 229.641, 109.8595, 241.3945, 119.709, 85.87100000000001, 176.34, 184.0455, 176.0525, 189.3325, 268.4325, 195.97899999999998, 202.405, 233.6915, 240.7785, 110.7975},
+		{214.61700000000002, 93.16900000000001, 18.3185, 13.417, 262.85249999999996, 5.3845, 280.48400000000004, 24.859499999999997, 12.411999999999999, 124.207, 80.3755, 86.65299999999999, 109.6865, 104.70599999999999, 31.756, 96.0745, 21.384, 15.8415, 282.736, 159.735, 29.694, 13.1795, 20.200499999999998, 23.402, 16.8445, 141.779, 20.302500000000002, 41.4105, 172.0235, 145.4555, 115.95349999999999, 88.46199999999999, 13.946, 47.7655, 30.289, 160.015, 21.2935, 39.796499999999995, 165.038, 245.4645, 120.7825, 90.9915, 157.53750000000002, 30.186999999999998, 107.04650000000001, 135.839, 180.321, 46.7805, 306.783, 42.107, 9.7075, 62.296, 75.655, 186.711, 270.99649999999997, 42.7655, 80.553, 191.379, 143.079, 61.594, 18.4805, 151.1475, 102.0335, 99.604, 138.69150000000002, 96.63650000000001, 148.32350000000002, 125.71549999999999, 74.857, 21.1435, 105.3275, 110.806, 108.90450000000001, 160.477, 29.753500000000003, 278.9575, 90.276, 111.4565, 160.269, 103.4125, 316.539, 102.6555, 180.8275, 101.7145, 143.343, 245.7105, 253.921, 81.2355, 90.3125, 54.9195, 176.6295, 233.846, 123.632, 119.5915, 111.1885, 25.907, 82.67699999999999, 170.45100000000002, 130.599, 188.292, 98.671, 104.7015, 291.73699999999997, 117.5495, 75.479, 140.48950000000002, 121.773, 113.5675, 90.9915, 18.1615, 213.423, 27.682000000000002, 183.9495, 0.0685, 33.4545, 12.567499999999999, 12.411, 279.6675, 35.7515, 17.3925, 93.1695, 142.8825, 32.5245, 246.18099999999998, 122.94749999999999, 210.44549999999998, 79.2425, 100.065, 181.435, 132.2215, 86.77799999999999, 101.08349999999999, 20.444, 195.90449999999998, 21.7945, 27.189, 19.9255, 17.015, 60.947, 48.171, 6.1365, 12.5575, 6.199, 18.8445, 51.581, 26.3545, 41.135, 10.4415, 63.6035, 29.564, 37.935, 200.892, 17.7425, 19.041, 171.728, 48.147999999999996, 7.107, 6.482, 4.771, 92.08500000000001, 143.10899999999998, 9.352, 161.84300000000002, 54.260999999999996, 15.381499999999999, 7.785, 106.953, 115.7005, 257.1405, 82.9295, 30.055, 80.8775, 19.906, 220.2455, 30.575, 42.693, 117.924, 75.1125, 37.3685, 12.004000000000001, 51.772999999999996, 92.51050000000001, 70.16650000000001, 86.30850000000001, 106.856, 39.456, 179.5115, 18.8475, 12.333, 113.73349999999999, 25.2895, 28.598999999999997, 193.589, 71.91900000000001, 112.715},
+		{308.433, 127.02850000000001, 14.503, 41.337, 255.214, 33.848, 304.026, 65.87, 40.2765, 151.25, 117.765, 109.7255, 132.90949999999998, 123.324, 50.444500000000005, 120.4255, 61.812, 17.082500000000003, 336.547, 191.6205, 48.304, 50.2155, 31.0325, 20.028, 26.5005, 166.562, 66.684, 47.232, 184.6815, 187.17200000000003, 145.047, 112.18950000000001, 47.3305, 94.66300000000001, 43.302, 182.5905, 14.376000000000001, 62.9225, 207.69549999999998, 259.58299999999997, 146.1435, 123.5995, 182.137, 52.804, 145.7545, 152.7785, 289.3985, 59.134, 320.16499999999996, 33.884, 46.11, 80.381, 88.3175, 196.11950000000002, 222.572, 32.333, 113.85849999999999, 270.206, 184.04399999999998, 95.7285, 44.632, 170.534, 138.476, 134.054, 177.7355, 110.9265, 184.349, 151.497, 87.67, 60.1495, 144.49200000000002, 131.15, 148.887, 171.765, 65.813, 309.771, 112.08949999999999, 136.526, 223.935, 136.9805, 349.36699999999996, 129.154, 272.5455, 139.17200000000003, 177.6855, 334.8505, 271.1875, 103.9045, 126.342, 20.3385, 197.832, 264.547, 157.7665, 148.45749999999998, 142.412, 50.437, 114.524, 208.998, 127.8945, 222.036, 126.82050000000001, 128.128, 319.98, 160.04149999999998, 109.138, 165.1535, 147.7955, 141.5565, 118.7475, 13.8265, 203.898, 16.703, 214.664, 33.2635, 0.0615, 42.819, 29.1045, 233.168, 9.136, 51.68, 116.127, 183.88150000000002, 52.057500000000005, 264.654, 152.0805, 305.0725, 101.777, 106.261, 198.124, 167.9955, 111.281, 127.6745, 39.278, 211.1015, 48.019000000000005, 35.525999999999996, 48.5055, 46.329, 85.0055, 12.3505, 49.146, 49.6445, 33.474, 23.37, 18.251, 49.799, 58.5625, 39.61, 19.014, 41.596000000000004, 12.6845, 230.4155, 30.883, 23.564, 216.6865, 14.167, 36.358999999999995, 37.417, 33.5965, 124.6445, 175.878, 39.3535, 178.1085, 19.6285, 45.104, 42.366, 145.221, 151.9815, 257.2825, 109.3335, 52.4105, 114.44, 32.942, 247.619, 59.099000000000004, 57.346000000000004, 229.9375, 71.0655, 66.5095, 36.798500000000004, 36.8375, 127.2225, 97.5565, 115.4875, 130.6285, 54.003, 214.14249999999998, 50.397, 30.6345, 137.107, 58.977500000000006, 27.262999999999998, 186.3065, 74.32499999999999, 143.196},
+		{201.53449999999998, 87.27350000000001, 32.0775, 10.056000000000001, 213.915, 8.934000000000001, 270.8325, 33.236, 1.472, 107.92949999999999, 70.1955, 75.6335, 105.353, 75.206, 26.1315, 86.031, 13.271, 24.951, 294.671, 140.5315, 34.158500000000004, 22.86, 27.258000000000003, 29.78, 18.067500000000003, 130.9325, 29.595, 56.784, 172.9515, 140.619, 101.185, 75.749, 12.7055, 47.1365, 33.8125, 146.88299999999998, 32.5585, 47.683499999999995, 161.2905, 222.6145, 108.9785, 79.63900000000001, 143.994, 21.39, 97.809, 148.275, 264.76, 52.765, 287.7045, 42.4225, 16.526, 65.85900000000001, 61.670500000000004, 199.399, 283.4035, 50.316500000000005, 71.42850000000001, 252.9345, 139.43849999999998, 48.55, 6.5329999999999995, 141.246, 92.006, 87.416, 127.99000000000001, 87.777, 139.226, 115.964, 62.7415, 10.847000000000001, 101.8905, 90.788, 100.629, 140.838, 24.406, 257.0695, 74.1865, 100.3355, 156.8315, 84.328, 296.6145, 94.213, 161.85000000000002, 88.6635, 151.675, 234.6505, 259.583, 70.086, 102.518, 66.0635, 178.88549999999998, 236.658, 105.047, 106.9315, 96.023, 36.480999999999995, 69.46549999999999, 177.2385, 116.2755, 169.3645, 93.2585, 94.346, 273.25649999999996, 115.595, 65.4685, 130.3025, 111.3505, 104.2165, 73.26400000000001, 31.494, 201.727, 31.334, 172.40699999999998, 12.494, 42.8645, 0.0175, 22.2955, 252.1295, 37.2695, 19.252000000000002, 81.3435, 133.32, 36.869, 234.9545, 113.57, 278.01, 68.049, 90.6425, 163.192, 127.207, 77.7405, 91.676, 15.713000000000001, 200.8205, 11.526499999999999, 22.8945, 24.0335, 7.0600000000000005, 64.0385, 50.6135, 10.376999999999999, 9.5725, 7.6105, 24.781, 64.3555, 35.1505, 47.235, 1.996, 61.8865, 32.61, 39.439499999999995, 217.51999999999998, 24.3925, 34.945499999999996, 168.088, 59.1575, 10.283999999999999, 9.8215, 7.669499999999999, 72.45849999999999, 151.7825, 12.2695, 141.7135, 63.1035, 5.0895, 10.0025, 94.245, 102.0195, 248.165, 72.677, 23.509, 71.081, 22.0455, 197.71949999999998, 23.104, 40.196, 98.187, 79.328, 48.34, 11.357, 43.9255, 82.5135, 62.8435, 83.521, 96.423, 15.0595, 174.8875, 5.2895, 24.5885, 97.68299999999999, 20.414, 34.748, 181.31150000000002, 77.049, 95.559},
+		{238.452, 95.9885, 18.319000000000003, 14.2805, 240.5125, 12.209, 274.829, 33.408500000000004, 17.4095, 125.911, 84.90299999999999, 85.729, 114.4705, 87.4965, 34.207, 100.6965, 28.435000000000002, 9.886, 292.4545, 145.974, 27.991, 25.216, 23.506, 15.308499999999999, 4.7905, 145.365, 21.603, 35.620999999999995, 169.885, 147.4135, 113.3535, 88.713, 25.41, 50.0325, 30.247500000000002, 158.1935, 22.125, 32.055, 166.6895, 245.9085, 124.495, 90.477, 149.225, 27.238500000000002, 114.447, 164.635, 261.249, 36.275999999999996, 308.23350000000005, 29.777, 14.035, 61.7685, 87.5275, 226.8185, 202.245, 43.439499999999995, 100.09049999999999, 188.86599999999999, 143.1865, 58.3635, 20.610999999999997, 148.1155, 106.6125, 100.4685, 143.0745, 92.46549999999999, 157.084, 130.749, 66.877, 30.384, 108.302, 108.2795, 116.19300000000001, 159.4015, 33.34, 260.498, 91.56700000000001, 115.9615, 163.173, 105.0555, 312.37199999999996, 107.26400000000001, 185.006, 106.5235, 123.5715, 206.379, 253.0565, 81.11099999999999, 94.8415, 54.708, 181.278, 230.225, 124.668, 126.931, 110.1695, 29.2555, 79.8375, 189.7715, 118.9125, 174.219, 106.8245, 112.4425, 293.619, 121.22149999999999, 84.647, 141.599, 135.662, 121.3375, 85.63550000000001, 17.657, 179.111, 19.067999999999998, 187.924, 8.6275, 32.16, 15.593499999999999, 1.3685, 249.8105, 40.318, 14.633500000000002, 97.414, 146.8635, 51.177, 200.21249999999998, 125.0545, 275.6805, 79.5475, 98.733, 183.6135, 148.98000000000002, 90.357, 107.033, 7.776999999999999, 132.1925, 24.68, 31.467, 13.5895, 22.622500000000002, 61.807, 42.689, 12.275500000000001, 16.063000000000002, 11.620000000000001, 8.937, 48.620999999999995, 31.186, 38.6595, 15.204, 44.956, 28.7025, 42.7375, 195.9115, 10.043, 13.212499999999999, 175.39600000000002, 48.0945, 14.207999999999998, 13.83, 17.304499999999997, 88.7515, 164.8955, 16.761, 175.381, 62.829, 20.7565, 13.1585, 109.37049999999999, 123.81700000000001, 278.01300000000003, 165.52800000000002, 13.886, 91.3125, 14.165, 227.88400000000001, 27.334, 51.244, 247.9835, 65.42599999999999, 37.771, 14.443999999999999, 43.567499999999995, 96.8385, 69.827, 96.58949999999999, 108.57849999999999, 32.899, 186.5025, 18.6535, 4.154, 120.196, 28.6855, 25.5355, 164.5435, 79.2755, 111.1155},
+		{307.64, 178.805, 294.7845, 258.573, 18.3095, 267.19, 205.223, 236.543, 250.9345, 153.9955, 183.642, 202.06, 188.63549999999998, 174.16, 227.78050000000002, 165.52550000000002, 270.75, 275.08500000000004, 149.848, 139.477, 254.92450000000002, 270.51649999999995, 297.78049999999996, 242.774, 279.16, 125.90950000000001, 275.11749999999995, 295.855, 97.59899999999999, 121.5085, 157.86849999999998, 189.74099999999999, 271.0565, 280.4515, 209.624, 168.273, 307.23350000000005, 280.2025, 251.3095, 313.07500000000005, 160.393, 159.3385, 123.4865, 264.3715, 140.7765, 136.9905, 98.0135, 242.27550000000002, 283.654, 260.226, 282.561, 301.797, 296.2975, 114.63300000000001, 278.9905, 318.334, 184.236, 69.44749999999999, 127.467, 251.7645, 267.20849999999996, 134.79899999999998, 201.7295, 159.1605, 104.763, 170.8595, 124.30199999999999, 170.04649999999998, 305.369, 239.23450000000003, 182.2885, 160.3425, 174.999, 238.9245, 255.83749999999998, 48.0945, 176.368, 184.5485, 234.07100000000003, 191.736, 250.73000000000002, 161.3555, 266.18, 183.2285, 151.084, 75.2825, 149.4125, 184.7615, 311.1405, 328.375, 428.937, 23.033, 162.13, 131.75650000000002, 177.3185, 236.3985, 181.86849999999998, 417.807, 259.1515, 153.096, 193.155, 150.7175, 268.64149999999995, 180.654, 201.136, 151.3345, 154.64299999999997, 147.4325, 189.6165, 285.096, 136.1165, 246.17, 94.7185, 279.6065, 233.19400000000002, 252.1855, 267.776, 0.034, 273.3215, 281.2955, 189.466, 108.34649999999999, 224.297, 70.5855, 150.7135, 129.4005, 170.94, 175.845, 417.466, 177.332, 179.58749999999998, 168.128, 263.45349999999996, 185.45499999999998, 260.9625, 230.273, 299.88750000000005, 266.476, 292.8915, 219.9535, 188.589, 269.389, 264.856, 181.522, 316.332, 263.927, 271.1595, 222.3625, 322.4445, 179.412, 253.55599999999998, 100.209, 238.9325, 296.0815, 247.16899999999998, 327.3355, 254.19299999999998, 275.0635, 272.427, 183.0695, 127.6815, 236.22050000000002, 134.13549999999998, 320.899, 253.99099999999999, 261.73699999999997, 153.452, 182.271, 82.333, 175.654, 231.36599999999999, 192.4665, 231.3385, 309.99350000000004, 284.17650000000003, 249.291, 336.171, 343.0395, 301.6345, 237.057, 315.8205, 184.67849999999999, 317.4205, 166.2295, 141.572, 198.0595, 259.124, 231.265, 256.2675, 357.3355, 260.105, 275.70050000000003, 146.394, 224.498, 182.861},
+		{199.26049999999998, 121.15350000000001, 15.12, 37.411500000000004, 248.0335, 34.587, 299.6815, 53.4715, 43.8685, 150.56150000000002, 111.93350000000001, 121.56649999999999, 132.0985, 122.37299999999999, 52.4005, 119.326, 52.03, 14.285499999999999, 313.9945, 172.49099999999999, 52.522999999999996, 49.26, 40.668, 16.6505, 33.396, 165.24349999999998, 42.409000000000006, 63.099000000000004, 189.89600000000002, 172.91899999999998, 136.1395, 117.887, 48.2915, 70.832, 46.188500000000005, 169.44, 10.0145, 53.601, 194.05149999999998, 279.7045, 146.7245, 113.714, 173.90800000000002, 49.204, 137.66, 176.2165, 281.69550000000004, 63.495000000000005, 326.929, 31.942500000000003, 39.8815, 81.8095, 87.73599999999999, 198.2715, 247.516, 67.5975, 115.101, 272.4595, 176.49849999999998, 81.37700000000001, 44.9555, 171.1495, 130.5255, 125.98150000000001, 162.75900000000001, 108.9915, 161.473, 161.2825, 84.507, 49.694500000000005, 132.1295, 137.656, 141.34550000000002, 183.213, 57.1515, 299.78150000000005, 117.77600000000001, 134.2805, 194.47500000000002, 133.5485, 337.3315, 130.196, 210.4145, 128.25549999999998, 154.1615, 268.2, 281.74649999999997, 109.916, 109.6195, 51.621, 194.74849999999998, 236.45499999999998, 154.745, 147.608, 137.45850000000002, 46.178, 113.96600000000001, 200.71800000000002, 165.51999999999998, 200.0335, 126.099, 128.3135, 315.196, 152.2335, 113.47200000000001, 166.16649999999998, 146.693, 140.8135, 110.0115, 15.801, 193.22050000000002, 32.469, 209.70600000000002, 35.7705, 9.372499999999999, 37.3245, 31.36, 273.178, 0.042499999999999996, 31.759999999999998, 119.148, 169.017, 58.212, 270.308, 147.099, 296.204, 103.251, 118.16499999999999, 202.0355, 178.2495, 111.186, 129.4075, 35.8885, 208.1945, 43.542500000000004, 44.376000000000005, 34.2795, 40.611999999999995, 94.5735, 37.701, 35.2675, 37.165, 33.3665, 24.464, 60.431, 43.9825, 63.147000000000006, 38.08, 58.370999999999995, 51.289, 11.8945, 225.3385, 27.134500000000003, 31.253, 194.0065, 45.590500000000006, 34.353, 37.138000000000005, 37.992000000000004, 118.5205, 181.9645, 36.7285, 198.305, 47.5075, 38.421499999999995, 34.259, 132.88600000000002, 136.1395, 280.237, 110.448, 44.091499999999996, 113.52600000000001, 37.385999999999996, 236.4075, 49.9865, 64.5445, 140.31799999999998, 93.4045, 58.6295, 37.036500000000004, 64.46, 117.959, 88.4125, 123.0555, 130.65949999999998, 57.5595, 211.2235, 44.0275, 31.6555, 130.5435, 49.594, 7.574, 153.273, 76.957, 134.125},
+		{197.79700000000003, 99.013, 18.613500000000002, 12.0625, 268.087, 16.7145, 299.64549999999997, 37.471500000000006, 17.418, 130.1915, 86.069, 93.1335, 118.904, 88.32849999999999, 27.633499999999998, 102.698, 27.853, 16.759999999999998, 295.625, 157.613, 34.1995, 23.0265, 7.9515, 25.365000000000002, 13.5825, 152.601, 31.838, 43.34, 177.72750000000002, 149.0675, 113.4245, 93.4165, 29.8625, 52.5295, 39.7535, 157.09, 25.567, 51.0835, 162.351, 251.526, 122.5515, 97.601, 159.926, 33.031499999999994, 118.99000000000001, 122.235, 357.18050000000005, 31.95, 304.062, 33.497, 19.990000000000002, 69.2255, 65.5225, 202.458, 288.11850000000004, 55.358000000000004, 85.7835, 313.053, 157.02499999999998, 55.275999999999996, 24.1485, 156.40449999999998, 107.5575, 103.868, 155.389, 107.65350000000001, 134.7185, 151.9225, 78.5175, 28.131, 108.571, 110.755, 117.7415, 157.3915, 25.592, 288.83349999999996, 91.02250000000001, 118.6725, 153.2755, 101.2025, 313.638, 112.348, 205.90750000000003, 100.17750000000001, 140.11599999999999, 181.7115, 286.4895, 87.898, 106.45150000000001, 55.6555, 148.17849999999999, 213.6145, 121.52850000000001, 131.575, 115.2395, 36.287, 82.31899999999999, 177.252, 114.7775, 186.5025, 110.838, 116.642, 290.0125, 128.25650000000002, 82.00999999999999, 151.7605, 128.0485, 125.35, 97.019, 18.2185, 178.985, 26.0985, 195.8145, 17.302500000000002, 52.16, 19.223, 14.1175, 281.2365, 31.8375, 0.6625000000000001, 93.3735, 170.993, 39.963499999999996, 199.31900000000002, 132.041, 334.20050000000003, 86.44, 100.863, 169.1605, 139.723, 98.201, 98.87299999999999, 18.98, 200.926, 28.130000000000003, 9.553999999999998, 27.5955, 24.386, 54.716, 44.292, 18.238500000000002, 18.6115, 14.573, 31.768, 58.308, 16.2825, 44.253, 23.045, 69.813, 32.471500000000006, 40.967, 286.296, 18.127499999999998, 27.3505, 171.6095, 48.809, 24.7235, 21.664, 23.54, 89.056, 123.13300000000001, 21.589, 172.6285, 62.039500000000004, 20.7485, 18.893500000000003, 110.14150000000001, 111.494, 257.36850000000004, 92.645, 9.526, 94.452, 8.422, 214.6415, 24.2825, 29.362000000000002, 101.2715, 81.849, 51.334, 19.076999999999998, 43.6155, 95.15299999999999, 68.06, 93.444, 116.9025, 30.5905, 185.425, 20.875, 16.2055, 117.671, 28.367, 30.47, 165.4565, 131.36849999999998, 108.8655},
+		{98.9925, 21.1045, 106.69550000000001, 84.9265, 197.02100000000002, 91.9805, 221.522, 115.019, 81.2355, 36.6935, 13.493500000000001, 18.423000000000002, 30.781, 7.206, 107.024, 19.2665, 95.7365, 109.12299999999999, 217.208, 77.0985, 109.3275, 103.4125, 103.4225, 104.458, 93.02199999999999, 65.872, 109.497, 129.537, 240.321, 60.471000000000004, 31.057499999999997, 12.1985, 90.9755, 138.024, 111.69999999999999, 287.971, 115.61, 124.6185, 88.2305, 165.4865, 36.916, 23.735, 73.6135, 102.37100000000001, 40.989, 304.217, 264.452, 112.0745, 218.779, 124.553, 93.0625, 145.666, 145.2885, 299.87699999999995, 252.731, 129.16649999999998, 13.328, 216.9765, 60.533, 137.91750000000002, 91.6345, 68.441, 23.862000000000002, 23.652, 65.8255, 32.0015, 257.6465, 62.7335, 139.228, 92.864, 32.435, 32.5325, 36.7225, 73.36099999999999, 113.8035, 213.4215, 22.936500000000002, 39.253, 70.782, 28.999000000000002, 231.62099999999998, 31.6405, 94.70349999999999, 19.91, 256.3425, 227.127, 198.94650000000001, 13.058, 180.026, 142.29149999999998, 260.372, 171.369, 44.953, 42.964, 35.756, 107.59549999999999, 13.0215, 252.8805, 173.36, 106.837, 26.017, 30.929499999999997, 204.0485, 45.9045, 19.9065, 66.9125, 44.944, 38.7445, 16.689, 106.60849999999999, 271.10699999999997, 104.936, 106.321, 93.09, 116.34299999999999, 81.369, 94.946, 189.4035, 119.238, 93.69, 0.022, 70.7255, 137.8315, 213.9075, 47.113, 253.787, 12.689499999999999, 181.904, 250.1895, 57.6195, 21.7175, 15.7775, 90.35149999999999, 265.9585, 89.8125, 108.9325, 99.5615, 86.244, 146.0, 128.504, 95.49549999999999, 90.6555, 85.21350000000001, 107.911, 134.06650000000002, 112.188, 131.04, 78.89150000000001, 140.2485, 118.5575, 133.1355, 262.24350000000004, 102.905, 111.71199999999999, 93.918, 138.78449999999998, 96.3555, 94.914, 91.3945, 16.4225, 269.7405, 88.80600000000001, 95.118, 151.45, 85.91550000000001, 94.09200000000001, 28.0785, 29.8365, 240.014, 18.0245, 99.9085, 22.723, 111.5275, 140.052, 114.701, 129.4265, 204.2355, 166.1555, 129.2335, 93.971, 135.126, 12.133, 151.1285, 25.3765, 34.629000000000005, 99.72550000000001, 100.3775, 84.5545, 96.384, 177.277, 96.32849999999999, 118.6685, 287.8595, 165.122, 29.043},
+		{151.558, 60.6265, 187.9995, 160.5795, 90.4815, 155.3635, 248.541, 159.255, 155.059, 64.6305, 106.144, 70.481, 78.58850000000001, 74.31, 180.223, 76.4055, 126.4965, 153.449, 228.096, 9.403500000000001, 165.53, 150.65449999999998, 178.018, 157.706, 145.6055, 28.070500000000003, 166.93900000000002, 180.4375, 164.7785, 41.764, 60.174499999999995, 102.9735, 164.3145, 189.1865, 164.40249999999997, 303.6435, 165.3415, 174.6175, 161.21699999999998, 210.732, 74.1645, 65.89099999999999, 12.5055, 162.8105, 49.9375, 279.048, 186.6585, 170.13400000000001, 185.85500000000002, 172.5585, 168.811, 199.5325, 233.65300000000002, 213.7045, 194.777, 193.68200000000002, 90.385, 146.6465, 44.795500000000004, 182.858, 135.4, 42.827, 88.87100000000001, 56.0865, 12.404, 80.5325, 282.3845, 62.583, 208.298, 142.64600000000002, 83.523, 55.595, 86.71000000000001, 35.254999999999995, 138.3065, 154.29500000000002, 77.65, 89.009, 148.00549999999998, 72.238, 203.637, 64.972, 176.4035, 89.6255, 288.2555, 173.013, 151.4745, 66.8195, 235.0135, 185.161, 338.481, 138.1595, 64.445, 40.97, 81.908, 191.6605, 67.67699999999999, 294.621, 257.2505, 39.702, 72.9875, 74.3555, 184.765, 75.6465, 91.70949999999999, 66.5035, 64.563, 58.5275, 71.3455, 187.86950000000002, 262.74199999999996, 158.3155, 78.9685, 142.9035, 183.52949999999998, 133.65449999999998, 162.651, 108.39850000000001, 169.0035, 171.742, 71.101, 0.052, 173.304, 177.73149999999998, 44.032, 210.807, 86.314, 232.71249999999998, 290.561, 89.748, 73.2175, 75.8945, 164.12599999999998, 274.655, 140.2675, 154.45100000000002, 153.347, 136.778, 200.606, 192.4525, 141.059, 157.7995, 159.619, 153.24849999999998, 203.084, 173.23250000000002, 181.514, 133.32600000000002, 207.106, 168.257, 194.6035, 177.46800000000002, 174.702, 169.12849999999997, 153.3, 218.849, 142.7855, 164.655, 137.95049999999998, 69.7685, 267.828, 143.8755, 55.722, 218.872, 139.078, 141.523, 53.6315, 82.826, 227.35500000000002, 103.4305, 153.61849999999998, 82.126, 152.299, 190.168, 150.4495, 194.5605, 280.6725, 236.163, 197.716, 150.051, 226.56400000000002, 93.9165, 211.452, 101.904, 50.844, 137.148, 174.8135, 136.657, 165.309, 228.411, 145.57, 181.745, 229.333, 199.9195, 88.6105},
+		{242.007, 118.81549999999999, 40.6965, 43.999, 207.7255, 57.576499999999996, 343.6635, 7.6645, 55.724, 141.21949999999998, 116.8565, 111.711, 153.7635, 126.571, 60.0825, 138.3595, 50.6295, 38.716499999999996, 324.6525, 187.2785, 26.029, 16.314500000000002, 50.311, 43.882000000000005, 50.038, 164.99349999999998, 68.9855, 50.8915, 193.8845, 176.4835, 147.192, 115.7425, 44.7445, 72.5485, 27.5945, 177.368, 46.317499999999995, 22.22, 188.69549999999998, 272.53, 141.76999999999998, 110.2935, 182.34550000000002, 47.423500000000004, 135.973, 172.588, 199.4075, 73.79, 332.413, 58.707499999999996, 40.635999999999996, 80.219, 101.53649999999999, 210.602, 307.658, 72.7295, 113.524, 227.434, 174.3375, 93.074, 69.27099999999999, 170.271, 136.788, 122.03450000000001, 177.33249999999998, 123.3195, 171.7175, 155.939, 90.1845, 47.409, 141.0095, 142.94799999999998, 139.3915, 193.536, 57.868, 323.123, 122.0205, 134.796, 194.3075, 137.4765, 343.7885, 148.5855, 216.8755, 133.8785, 168.744, 281.714, 274.938, 115.727, 116.6705, 76.32849999999999, 192.428, 245.875, 146.41649999999998, 159.6535, 136.35649999999998, 34.7705, 111.57, 214.307, 136.31900000000002, 208.81, 129.0785, 127.707, 319.156, 142.5025, 105.78200000000001, 184.389, 166.9565, 144.95100000000002, 113.897, 43.4845, 205.86, 47.042, 211.618, 32.473, 52.147499999999994, 36.825, 29.2915, 224.2995, 58.156, 39.7515, 137.78750000000002, 172.869, 0.043, 278.6245, 147.365, 273.5265, 116.199, 72.4135, 208.683, 174.3485, 114.7875, 131.40550000000002, 49.7905, 218.011, 48.076499999999996, 47.8445, 67.20949999999999, 45.700500000000005, 100.358, 94.4135, 38.5015, 32.664, 47.075500000000005, 40.2105, 71.13050000000001, 58.905, 64.4195, 32.343, 88.982, 53.611, 61.087, 238.124, 53.0645, 62.4945, 199.075, 64.22, 49.455, 51.2375, 34.768, 105.2625, 184.048, 31.4025, 187.925, 72.1225, 44.41, 31.3825, 130.649, 152.889, 291.9345, 109.906, 52.2445, 108.186, 41.605999999999995, 233.7955, 55.688, 74.3775, 138.8745, 96.763, 62.849, 57.664500000000004, 74.896, 118.9795, 92.7245, 140.7955, 134.6805, 55.149, 222.73250000000002, 41.1525, 35.387, 143.962, 61.498000000000005, 75.35249999999999, 197.018, 66.54750000000001, 143.695},
+		{303.28499999999997, 228.4555, 321.61850000000004, 293.4825, 63.433, 250.104, 261.731, 268.8565, 243.81400000000002, 201.5775, 222.7955, 212.81650000000002, 211.6595, 226.1765, 306.8945, 218.764, 290.534, 253.687, 231.6635, 151.90800000000002, 271.901, 258.023, 293.11350000000004, 260.8105, 255.062, 175.365, 260.45799999999997, 340.43949999999995, 75.289, 160.187, 200.948, 206.48200000000003, 306.2415, 312.28049999999996, 224.799, 270.61850000000004, 239.2395, 231.30900000000003, 282.83500000000004, 365.595, 206.906, 218.0285, 275.81600000000003, 275.7885, 182.08, 215.917, 61.3025, 290.47299999999996, 305.76, 283.7225, 302.97799999999995, 313.3605, 359.493, 93.789, 76.9165, 302.528, 218.763, 74.47999999999999, 167.5365, 262.334, 259.79200000000003, 169.53199999999998, 225.44549999999998, 211.635, 170.7145, 228.76999999999998, 76.185, 181.8585, 308.53049999999996, 241.6415, 218.7785, 214.511, 204.25349999999997, 177.15249999999997, 288.0135, 184.03500000000003, 267.7605, 223.4725, 274.63750000000005, 211.462, 341.011, 205.57799999999997, 320.711, 209.65550000000002, 272.635, 24.185499999999998, 213.642, 220.377, 188.73200000000003, 345.7715, 436.0925, 81.28999999999999, 204.779, 189.551, 213.957, 269.6975, 234.144, 336.567, 251.0205, 192.227, 236.664, 230.36200000000002, 301.0435, 211.887, 225.8355, 185.301, 215.4715, 208.04500000000002, 244.56799999999998, 328.2235, 102.958, 264.5045, 177.89600000000002, 245.18349999999998, 263.419, 233.6895, 244.6735, 68.85, 269.12350000000004, 195.44, 211.4535, 176.5355, 277.039, 0.057, 209.02100000000002, 212.534, 222.888, 339.1685, 303.74, 220.2025, 220.7475, 213.4105, 256.18399999999997, 151.77, 240.0145, 214.176, 247.2365, 238.123, 243.3115, 275.129, 245.53050000000002, 292.71500000000003, 253.72899999999998, 271.058, 298.8505, 320.83050000000003, 279.85900000000004, 244.8185, 350.6385, 277.11249999999995, 246.0645, 68.13, 297.8915, 275.894, 284.93600000000004, 356.28, 261.464, 303.102, 240.9875, 212.9535, 88.99000000000001, 227.4385, 178.301, 347.078, 236.46949999999998, 247.023, 213.4975, 229.54149999999998, 74.1725, 216.8305, 192.80700000000002, 224.6925, 196.4555, 368.839, 254.4875, 357.21500000000003, 382.677, 359.461, 344.9455, 263.52, 177.44099999999997, 234.864, 293.13750000000005, 223.0275, 195.804, 257.15049999999997, 294.4045, 282.235, 260.004, 328.25800000000004, 300.3415, 270.3775, 98.98349999999999, 263.8775, 236.4955},
+		{173.336, 51.976, 143.49349999999998, 116.6755, 146.95499999999998, 116.032, 220.56650000000002, 132.2145, 108.167, 54.5495, 43.776, 51.758, 67.14150000000001, 50.055499999999995, 136.5555, 70.0555, 111.678, 136.582, 228.53199999999998, 45.292, 145.40249999999997, 128.3875, 153.342, 139.2045, 125.5355, 54.102999999999994, 139.409, 159.665, 207.358, 82.822, 48.3185, 47.7875, 119.2995, 146.263, 146.38299999999998, 267.582, 146.3655, 145.613, 139.324, 196.457, 55.418499999999995, 41.882000000000005, 31.1955, 122.9795, 33.2855, 262.7255, 258.41049999999996, 144.5095, 214.21550000000002, 154.2955, 128.40449999999998, 170.548, 203.272, 255.167, 229.1225, 184.79899999999998, 46.6015, 210.0885, 75.7, 150.1625, 114.24199999999999, 84.7875, 36.2135, 31.456, 38.22, 53.5385, 260.9305, 56.867, 173.26549999999997, 121.762, 55.625, 32.72, 35.433499999999995, 79.41499999999999, 129.45850000000002, 210.67649999999998, 42.6515, 59.5965, 139.22, 27.725, 227.558, 31.634999999999998, 133.164, 51.361999999999995, 247.632, 248.906, 202.147, 53.1725, 215.511, 170.582, 314.049, 175.44850000000002, 43.6405, 40.7555, 53.92, 167.1165, 47.2715, 273.9235, 243.2095, 59.1535, 47.090500000000006, 34.060500000000005, 234.961, 56.052499999999995, 48.5115, 64.8295, 46.2825, 35.165, 52.07, 143.2925, 245.757, 138.155, 106.6685, 122.9425, 152.11950000000002, 113.7865, 126.4425, 150.7665, 147.1335, 132.0655, 47.1435, 44.033, 147.45, 209.7525, 0.049, 255.24, 53.2235, 218.049, 269.004, 82.0205, 49.602999999999994, 44.63, 125.7995, 318.172, 119.1335, 127.63, 130.6515, 113.582, 165.9035, 182.9425, 122.226, 144.70999999999998, 123.30199999999999, 139.682, 165.65249999999997, 137.36950000000002, 149.4695, 112.19749999999999, 183.2375, 147.11950000000002, 167.8225, 217.14499999999998, 180.63299999999998, 150.2525, 144.9435, 174.423, 122.368, 117.1825, 116.616, 44.2945, 273.3075, 117.826, 79.01, 195.655, 115.95349999999999, 118.3445, 38.457499999999996, 52.268, 206.9365, 48.6465, 131.72, 40.82, 135.058, 170.107, 128.61450000000002, 206.7475, 277.4815, 213.5925, 160.647, 120.737, 170.132, 56.1905, 168.38299999999998, 49.1355, 30.8415, 121.8375, 165.38400000000001, 113.99549999999999, 127.993, 209.099, 130.30849999999998, 146.086, 277.51800000000003, 216.957, 51.4825},
+		{323.946, 263.2565, 210.18349999999998, 270.235, 128.488, 288.40099999999995, 70.4785, 292.2845, 268.2375, 224.9265, 257.89700000000005, 262.352, 264.148, 251.10000000000002, 190.574, 239.4715, 298.659, 294.655, 72.34450000000001, 224.5595, 280.858, 281.5555, 290.4145, 273.52750000000003, 288.2735, 207.5005, 302.622, 303.9955, 90.798, 192.8915, 227.8235, 249.76, 289.931, 307.2235, 224.334, 299.4685, 288.355, 296.82849999999996, 351.209, 388.291, 259.68399999999997, 253.0365, 220.3795, 341.3805, 230.7215, 95.74000000000001, 58.052499999999995, 203.501, 92.98949999999999, 312.389, 226.565, 323.59000000000003, 323.1805, 246.92649999999998, 103.9495, 326.54200000000003, 282.158, 81.863, 198.13549999999998, 308.7765, 272.73699999999997, 210.38400000000001, 293.74699999999996, 245.57350000000002, 202.3095, 266.8085, 78.905, 219.099, 256.0965, 280.7265, 259.5285, 239.394, 268.8845, 230.535, 284.29600000000005, 210.5005, 264.496, 257.8075, 312.0115, 250.6465, 56.0835, 254.91649999999998, 342.2875, 250.4085, 224.897, 90.596, 250.34300000000002, 254.648, 279.015, 259.552, 418.3005, 151.981, 247.916, 220.942, 253.35449999999997, 286.3725, 254.322, 431.959, 213.64550000000003, 253.7735, 271.94550000000004, 242.6105, 70.98, 250.855, 259.1245, 234.3825, 244.17950000000002, 237.08749999999998, 259.11249999999995, 220.3065, 97.2305, 270.209, 211.142, 210.425, 305.0425, 278.003, 287.999, 129.4025, 296.3625, 334.2105, 253.84300000000002, 210.78699999999998, 273.7645, 214.228, 255.137, 0.035500000000000004, 248.7285, 288.558, 440.3365, 242.28, 256.1365, 255.297, 335.8265, 144.856, 289.28650000000005, 193.21, 278.37149999999997, 216.7705, 332.782, 230.3155, 280.881, 281.20799999999997, 337.806, 286.91700000000003, 253.1105, 207.757, 305.0865, 255.9915, 263.86400000000003, 315.413, 307.28549999999996, 75.958, 203.65550000000002, 303.581, 340.18899999999996, 251.21949999999998, 217.17649999999998, 208.985, 217.8185, 259.6325, 84.4925, 198.531, 229.5045, 332.251, 282.824, 279.2365, 250.1515, 322.795, 95.3875, 262.71349999999995, 192.445, 266.803, 191.3675, 396.374, 208.6015, 285.9475, 451.404, 338.23850000000004, 304.06899999999996, 285.205, 321.704, 258.8505, 335.4975, 297.09749999999997, 231.207, 278.491, 0.0, 271.40549999999996, 278.008, 328.6935, 284.185, 306.606, 274.131, 330.17949999999996, 266.1965},
+		{97.804, 15.5, 99.636, 73.644, 139.6415, 77.9395, 285.93449999999996, 94.596, 71.299, 44.415, 2.034, 6.24, 33.1365, 6.982, 95.924, 18.656, 69.082, 89.1745, 265.5385, 58.3145, 99.919, 92.7895, 88.1885, 93.788, 79.5495, 63.673, 97.64, 115.76599999999999, 241.74, 68.061, 37.467, 10.002500000000001, 80.22550000000001, 108.39699999999999, 104.2045, 206.48250000000002, 106.667, 111.19749999999999, 90.3435, 165.3495, 49.09, 12.7955, 64.7485, 75.3825, 36.208, 208.88049999999998, 235.3755, 96.22649999999999, 212.4435, 120.8235, 79.4325, 134.2775, 139.3885, 253.8095, 236.82, 133.987, 3.7755, 206.439, 65.4185, 119.58, 93.8175, 57.905, 23.779, 20.3905, 69.333, 17.883000000000003, 219.38299999999998, 48.09, 133.70299999999997, 74.4975, 27.89, 27.2045, 32.117000000000004, 72.4925, 80.56649999999999, 200.5965, 10.959, 28.939500000000002, 71.521, 18.455, 239.09949999999998, 26.178, 92.07849999999999, 23.7455, 192.534, 206.5145, 190.642, 0.9005000000000001, 189.71550000000002, 120.048, 248.916, 157.096, 39.4955, 40.207499999999996, 36.088499999999996, 102.3375, 1.8715000000000002, 228.4615, 184.388, 97.3885, 23.744999999999997, 27.9455, 215.5625, 42.3965, 7.9239999999999995, 73.451, 47.444, 32.794, 5.188, 102.689, 237.44099999999997, 92.589, 112.7145, 79.10249999999999, 101.7755, 68.1335, 80.32, 171.124, 103.319, 86.2355, 12.716000000000001, 86.267, 116.05449999999999, 224.19400000000002, 53.314499999999995, 248.746, 0.0415, 168.574, 236.3315, 54.759, 10.5545, 13.3565, 87.602, 221.9065, 78.584, 88.592, 87.58500000000001, 72.5735, 122.66, 117.13, 75.6515, 82.688, 81.9545, 88.856, 125.572, 97.6685, 111.53399999999999, 69.13050000000001, 134.9595, 104.52600000000001, 121.9785, 216.1365, 87.46199999999999, 97.46, 95.8665, 124.46950000000001, 74.1885, 76.842, 72.74549999999999, 3.9400000000000004, 212.357, 80.7415, 89.11, 130.499, 70.2445, 81.6225, 22.838, 33.543499999999995, 218.45999999999998, 7.327999999999999, 89.09299999999999, 7.8505, 88.5815, 126.602, 84.7615, 101.248, 209.393, 148.344, 115.92750000000001, 83.09700000000001, 126.8265, 13.767, 129.139, 13.780000000000001, 28.3975, 80.6235, 102.1505, 71.40700000000001, 82.55850000000001, 163.5165, 79.4725, 95.19, 252.454, 168.67649999999998, 28.491999999999997},
+		{334.55600000000004, 185.5345, 87.3035, 90.8455, 299.73400000000004, 110.0255, 362.983, 78.12950000000001, 94.48949999999999, 206.839, 156.497, 167.88549999999998, 201.868, 178.102, 118.887, 198.066, 117.727, 107.877, 372.69100000000003, 225.71249999999998, 66.006, 68.2915, 108.16300000000001, 83.88300000000001, 93.438, 226.542, 141.1495, 61.8695, 248.0455, 234.238, 210.37400000000002, 182.08999999999997, 96.72200000000001, 127.732, 71.426, 228.24849999999998, 108.011, 95.03450000000001, 241.9275, 346.47400000000005, 208.9575, 170.06099999999998, 235.41449999999998, 110.7525, 187.60649999999998, 200.38049999999998, 263.6125, 128.2785, 374.8425, 108.213, 97.857, 145.4255, 143.93900000000002, 282.2335, 418.195, 120.3655, 161.5745, 294.40999999999997, 228.0075, 145.44, 120.0695, 218.558, 203.3435, 193.6225, 221.05700000000002, 182.2615, 222.6325, 225.084, 149.73700000000002, 98.604, 192.5765, 191.981, 206.633, 249.9035, 114.69800000000001, 364.4415, 191.1165, 199.209, 258.6735, 183.702, 395.685, 193.7175, 272.8755, 189.138, 233.96, 367.241, 235.3175, 165.899, 155.15, 107.11449999999999, 263.5865, 318.6675, 203.05599999999998, 211.32850000000002, 196.87349999999998, 86.2475, 167.767, 269.49350000000004, 182.45499999999998, 258.16200000000003, 199.36950000000002, 189.6495, 370.1545, 213.76299999999998, 158.6615, 240.428, 208.4875, 197.61200000000002, 182.0915, 85.8095, 260.5745, 80.9855, 267.7045, 99.45599999999999, 105.9125, 90.6825, 81.9325, 175.895, 118.215, 100.509, 179.898, 231.66899999999998, 72.2885, 340.89599999999996, 218.0405, 288.4915, 168.5, 0.0275, 250.879, 216.238, 178.8885, 190.32150000000001, 97.0815, 285.57, 106.3455, 92.1065, 105.9315, 99.6645, 149.417, 136.7335, 97.922, 100.9565, 85.767, 85.3805, 156.281, 101.436, 115.81299999999999, 91.881, 159.8895, 73.32300000000001, 116.832, 367.778, 95.5935, 99.554, 251.632, 136.1625, 97.512, 86.9695, 102.214, 166.45100000000002, 225.40949999999998, 100.59, 250.6465, 152.91899999999998, 92.91900000000001, 97.67099999999999, 195.196, 205.69, 314.935, 174.838, 92.5335, 164.27249999999998, 96.675, 305.5315, 111.44200000000001, 138.909, 191.76049999999998, 117.85050000000001, 130.871, 105.281, 135.8275, 186.38049999999998, 161.8235, 179.9255, 190.8385, 102.4065, 264.672, 100.5625, 93.129, 202.697, 112.794, 123.3215, 237.0265, 73.8685, 203.53050000000002},
+		{266.39750000000004, 251.34300000000002, 186.7265, 169.456, 411.55449999999996, 182.49, 438.28, 191.3075, 156.69650000000001, 269.216, 229.615, 246.2295, 271.237, 257.2415, 164.445, 253.0195, 166.934, 189.669, 460.9165, 305.068, 192.125, 183.187, 175.01299999999998, 185.5165, 172.552, 286.40049999999997, 195.262, 200.57, 276.8755, 304.14750000000004, 271.176, 237.66500000000002, 172.543, 212.2415, 189.627, 370.64149999999995, 185.64999999999998, 200.5125, 319.4295, 409.1545, 281.8395, 234.253, 323.7585, 193.9815, 252.49099999999999, 287.9015, 280.874, 204.70850000000002, 471.36400000000003, 212.1605, 177.67149999999998, 209.906, 226.9355, 291.108, 296.98299999999995, 210.4445, 231.93349999999998, 324.3835, 298.539, 205.1055, 182.1845, 287.118, 252.6045, 242.033, 283.399, 243.63549999999998, 326.611, 274.323, 214.6325, 173.407, 262.062, 260.953, 281.823, 323.5315, 193.399, 279.51599999999996, 235.536, 263.1465, 308.8655, 244.913, 457.10900000000004, 259.3125, 334.1185, 254.472, 330.522, 211.9545, 398.986, 237.951, 181.568, 225.284, 68.234, 300.0005, 268.4415, 263.0175, 254.47899999999998, 198.6825, 238.735, 201.192, 197.61450000000002, 326.399, 258.2575, 247.7045, 458.17049999999995, 274.764, 229.43, 275.3775, 267.88199999999995, 260.0085, 252.7095, 188.575, 327.248, 185.969, 327.491, 181.5, 198.223, 163.2485, 183.449, 417.48199999999997, 202.0485, 169.179, 250.2565, 290.6125, 208.65800000000002, 305.0555, 269.001, 440.45050000000003, 236.329, 250.8695, 0.04, 288.801, 231.54, 236.838, 196.494, 305.06899999999996, 175.91899999999998, 179.07299999999998, 185.50549999999998, 169.2645, 208.3745, 204.036, 183.171, 171.75549999999998, 182.387, 186.3275, 213.492, 179.572, 201.7975, 156.6325, 208.2245, 195.954, 207.744, 356.4135, 189.9765, 193.149, 325.9315, 222.207, 169.1695, 173.071, 181.47250000000003, 239.5005, 311.39549999999997, 165.791, 308.307, 239.0465, 167.9835, 184.1565, 258.316, 276.707, 363.2205, 246.269, 195.57150000000001, 232.4505, 189.995, 356.73699999999997, 179.23250000000002, 216.11, 153.99849999999998, 247.25549999999998, 198.8965, 183.542, 201.5205, 253.25549999999998, 211.3325, 247.772, 251.366, 179.9765, 0.0, 164.8965, 185.7905, 258.9295, 183.792, 199.1335, 324.728, 239.9805, 253.8105},
+		{123.1825, 74.80850000000001, 152.3485, 129.0805, 159.90800000000002, 137.1115, 305.666, 160.24349999999998, 136.1045, 29.727, 54.823, 60.4335, 62.6835, 69.195, 162.0215, 37.762, 135.6605, 148.5565, 208.7215, 75.432, 196.597, 152.0045, 160.386, 172.864, 141.1695, 59.994, 152.769, 176.04950000000002, 235.3, 51.472, 33.9445, 54.354, 137.1215, 157.10950000000003, 165.9875, 306.41700000000003, 162.495, 165.343, 120.045, 182.7555, 38.59, 61.8795, 81.1005, 169.08350000000002, 38.03, 263.61199999999997, 253.339, 131.1045, 214.583, 186.8515, 138.298, 199.891, 187.5325, 237.676, 238.0125, 184.618, 52.304, 222.24, 49.0075, 164.551, 132.362, 58.4525, 60.9405, 77.937, 78.434, 95.369, 264.95799999999997, 69.51650000000001, 209.202, 135.8875, 48.5145, 44.301500000000004, 58.054, 71.61699999999999, 122.9185, 180.843, 61.555, 44.885000000000005, 89.2355, 52.847, 231.58350000000002, 53.9425, 115.6645, 43.075500000000005, 276.917, 216.6655, 179.2145, 63.797, 220.8435, 186.829, 302.3425, 168.87650000000002, 36.1175, 61.0035, 46.1055, 159.521, 54.538, 303.2595, 255.85250000000002, 104.7885, 53.8675, 91.6465, 200.2695, 41.3565, 60.4185, 41.2555, 51.894, 45.6625, 61.769999999999996, 152.2865, 265.6485, 181.6415, 102.759, 132.219, 168.0115, 127.143, 146.769, 177.3755, 178.2045, 139.316, 57.644, 89.813, 174.3915, 222.149, 81.944, 242.185, 54.683499999999995, 216.1725, 288.7335, 0.0495, 62.952, 60.9195, 160.607, 259.9785, 139.9735, 142.4575, 147.773, 130.6275, 188.498, 173.9275, 138.4185, 142.02800000000002, 143.226, 156.10950000000003, 180.61599999999999, 154.0905, 180.625, 148.6335, 183.065, 172.1695, 175.623, 251.85750000000002, 144.8995, 150.441, 125.9535, 181.76, 133.802, 133.8605, 132.2745, 56.695, 261.163, 148.8945, 75.8015, 187.34550000000002, 130.4385, 134.7695, 62.2295, 70.512, 240.6585, 59.815, 146.7265, 59.989999999999995, 149.297, 175.19299999999998, 150.39249999999998, 158.478, 254.321, 216.089, 171.9375, 140.0665, 181.2295, 55.7145, 216.43400000000003, 64.0835, 63.9905, 139.697, 126.154, 137.6865, 148.601, 221.529, 149.1005, 164.622, 285.98400000000004, 214.0045, 56.881},
+		{116.4135, 22.6005, 96.44550000000001, 74.4735, 170.39749999999998, 87.866, 293.7895, 101.878, 77.93700000000001, 49.927499999999995, 11.2275, 4.699999999999999, 43.046, 16.243499999999997, 104.615, 27.1655, 72.923, 107.531, 274.6135, 72.87049999999999, 107.2525, 90.196, 101.398, 100.7915, 94.374, 73.469, 109.3725, 122.733, 218.3425, 76.3235, 45.498000000000005, 19.9375, 77.108, 118.104, 108.607, 273.1965, 112.09649999999999, 115.1445, 99.9855, 176.0525, 56.7415, 21.328, 72.709, 93.029, 41.1035, 220.1645, 245.442, 104.5575, 219.66649999999998, 110.607, 95.756, 143.30700000000002, 141.4195, 260.58050000000003, 244.56799999999998, 138.0845, 12.258, 206.642, 73.95750000000001, 123.632, 72.97999999999999, 62.4465, 30.939, 28.8125, 76.1865, 27.585, 269.3355, 59.917500000000004, 144.25150000000002, 81.174, 36.916, 31.8045, 40.1555, 78.3195, 89.339, 210.118, 18.152, 39.7005, 78.034, 24.524, 241.9495, 32.0705, 111.632, 30.1035, 214.087, 213.8, 202.00549999999998, 10.175, 197.99, 129.745, 260.47, 176.6945, 45.168, 44.656000000000006, 44.251999999999995, 113.376, 11.137, 237.61950000000002, 189.135, 102.9945, 27.0105, 38.733000000000004, 255.6605, 52.8665, 5.409000000000001, 72.9755, 50.174, 38.277, 13.7105, 96.3885, 283.8465, 101.1705, 115.5935, 86.79849999999999, 111.24449999999999, 77.9985, 90.3475, 179.673, 111.281, 98.37299999999999, 21.804499999999997, 73.3295, 114.8785, 222.4905, 49.6535, 256.21950000000004, 10.594999999999999, 177.9955, 231.5735, 63.0975, 0.016, 22.2045, 94.4055, 273.127, 82.2235, 97.322, 101.154, 76.9245, 152.5615, 119.25200000000001, 85.101, 97.11449999999999, 83.8955, 101.5265, 129.9685, 99.721, 115.4295, 76.5255, 132.91250000000002, 116.9575, 139.4315, 228.74099999999999, 104.9165, 113.4495, 105.108, 123.52850000000001, 79.15, 73.7205, 77.93299999999999, 14.0515, 221.858, 87.106, 92.2755, 135.287, 74.4825, 84.143, 32.2675, 48.9785, 226.10399999999998, 12.921, 98.446, 15.552, 95.39500000000001, 137.162, 93.5685, 103.7765, 191.89, 159.552, 120.07499999999999, 93.9315, 135.603, 21.426000000000002, 136.62349999999998, 23.002000000000002, 34.56, 82.16550000000001, 109.201, 78.93950000000001, 91.21000000000001, 172.3075, 88.6605, 111.11699999999999, 273.877, 180.0595, 38.25},
+		{113.225, 17.228, 119.6055, 88.444, 177.357, 100.386, 222.65, 116.38550000000001, 87.842, 39.8315, 16.78, 21.286, 40.744, 24.1665, 106.512, 25.676000000000002, 94.14750000000001, 115.1015, 218.71, 66.37899999999999, 119.4025, 111.4665, 119.7045, 125.6165, 102.67150000000001, 65.32849999999999, 120.72749999999999, 143.15949999999998, 227.618, 66.604, 44.724999999999994, 11.7315, 91.39949999999999, 121.388, 119.75200000000001, 226.3915, 123.74000000000001, 122.3115, 82.2225, 152.946, 43.572500000000005, 18.087, 60.5585, 105.8715, 23.769, 245.029, 258.85249999999996, 126.689, 206.939, 131.7615, 95.362, 153.0165, 153.84550000000002, 266.7985, 257.0725, 165.7975, 18.2265, 201.005, 65.4635, 137.2185, 90.8105, 73.935, 14.058, 15.042, 67.5625, 40.418, 236.476, 44.1665, 156.69549999999998, 94.3695, 31.9285, 18.694000000000003, 31.0815, 64.9265, 84.545, 179.497, 31.4075, 36.900999999999996, 71.709, 9.0565, 220.8625, 21.2095, 105.631, 23.268, 231.93, 218.21550000000002, 187.3105, 15.3795, 170.762, 153.76749999999998, 267.3835, 159.6055, 29.7965, 41.4315, 29.457, 119.962, 15.2245, 254.079, 198.99450000000002, 95.2595, 16.6325, 25.356499999999997, 195.2855, 50.353, 19.577, 61.832499999999996, 53.708, 21.681, 27.310499999999998, 119.5695, 257.71299999999997, 110.9255, 111.6105, 101.0155, 127.6105, 91.688, 109.95949999999999, 168.1035, 129.4375, 98.7375, 15.7935, 75.92, 131.51549999999997, 215.0825, 44.5, 255.26749999999998, 13.308499999999999, 190.30700000000002, 236.7905, 60.939499999999995, 22.131500000000003, 0.024, 113.8115, 273.8595, 92.2355, 99.6275, 107.1165, 85.6265, 151.15449999999998, 141.8435, 99.83099999999999, 105.903, 86.962, 112.35249999999999, 146.0325, 123.1965, 131.45499999999998, 78.026, 146.841, 125.201, 136.3605, 263.16049999999996, 109.75800000000001, 117.654, 91.8125, 146.588, 97.307, 87.59700000000001, 97.493, 17.8585, 268.5935, 98.892, 69.0295, 152.8005, 84.026, 104.712, 10.586, 24.637999999999998, 226.877, 21.810499999999998, 132.36599999999999, 12.432, 116.04650000000001, 150.6825, 115.6135, 117.825, 203.8535, 172.197, 140.10649999999998, 99.543, 166.57, 13.9005, 142.1225, 24.7305, 37.309, 98.3905, 104.7595, 89.0745, 107.1015, 176.3065, 102.9935, 129.20299999999997, 234.3065, 193.03050000000002, 23.880000000000003},
+		{176.8625, 100.0395, 33.314499999999995, 7.4815000000000005, 256.44500000000005, 11.220500000000001, 296.4015, 38.412499999999994, 14.427, 121.11, 82.91499999999999, 86.6675, 110.6695, 94.154, 26.042499999999997, 104.2445, 26.0065, 14.0695, 297.297, 154.9435, 52.585499999999996, 21.799500000000002, 24.058, 29.436500000000002, 3.452, 152.08350000000002, 22.947000000000003, 42.8795, 172.591, 158.9545, 123.10849999999999, 89.935, 25.1605, 54.078500000000005, 33.217, 170.55599999999998, 27.4715, 30.4135, 160.5135, 223.2605, 134.737, 93.845, 154.7865, 18.054000000000002, 115.18299999999999, 145.4145, 267.275, 27.514, 295.6685, 49.1185, 15.150500000000001, 57.3365, 60.3005, 268.7285, 195.18349999999998, 45.1545, 84.7875, 192.372, 157.6365, 61.69, 19.21, 146.461, 107.20599999999999, 96.799, 155.41, 106.7735, 140.59550000000002, 148.9975, 76.741, 23.3285, 108.4685, 113.24000000000001, 119.592, 152.414, 21.862000000000002, 277.6015, 94.271, 116.185, 183.56650000000002, 105.64, 430.601, 109.4795, 174.8065, 100.101, 126.622, 301.1495, 248.503, 84.252, 97.197, 67.801, 180.4245, 245.1175, 121.188, 129.12349999999998, 126.6325, 29.16, 81.12100000000001, 173.378, 117.544, 176.954, 105.8615, 107.56299999999999, 283.5605, 138.01350000000002, 86.00800000000001, 169.925, 141.142, 124.75800000000001, 85.8715, 32.988, 152.983, 19.616, 189.0825, 20.4575, 39.283, 15.769, 6.4175, 263.42999999999995, 35.9875, 18.9815, 90.4935, 163.84699999999998, 49.956, 262.3065, 125.727, 335.8525, 87.6755, 97.0745, 196.553, 160.527, 94.40100000000001, 113.896, 0.033, 130.113, 23.822499999999998, 18.136499999999998, 9.074, 19.049999999999997, 82.707, 42.038, 18.247500000000002, 17.119, 13.962, 23.6, 54.277, 22.6675, 40.1425, 14.331, 66.6955, 30.744, 29.978, 253.45350000000002, 10.213000000000001, 23.6815, 166.1025, 64.299, 20.2295, 17.473, 13.7415, 88.268, 136.065, 13.814, 163.79149999999998, 65.81299999999999, 17.759500000000003, 18.951999999999998, 114.67850000000001, 128.6005, 256.5835, 94.17150000000001, 13.091999999999999, 89.69550000000001, 29.5125, 223.832, 20.076999999999998, 38.952, 101.6575, 63.9735, 36.166, 14.7255, 35.986999999999995, 98.6325, 61.9465, 96.53450000000001, 115.74449999999999, 28.997, 176.31400000000002, 18.076999999999998, 7.886, 113.267, 27.0245, 23.259, 173.0765, 104.1585, 109.62049999999999},
+		{422.41949999999997, 263.44399999999996, 138.5755, 124.37450000000001, 166.0885, 204.7525, 215.313, 221.9815, 146.5805, 246.18349999999998, 209.462, 256.813, 282.1035, 267.35450000000003, 189.54399999999998, 266.7175, 216.27100000000002, 205.5695, 217.3725, 258.517, 215.81799999999998, 212.0765, 201.6245, 205.9445, 131.36, 282.29499999999996, 222.42899999999997, 219.394, 105.79750000000001, 279.4345, 242.323, 210.8805, 141.774, 167.66649999999998, 165.86849999999998, 180.24450000000002, 163.66750000000002, 223.298, 331.4665, 373.02750000000003, 240.9545, 272.7285, 267.4185, 147.5375, 274.485, 150.1975, 110.96950000000001, 255.465, 429.587, 233.66899999999998, 147.861, 191.857, 199.68, 134.52800000000002, 151.12900000000002, 227.3755, 204.9315, 132.62400000000002, 270.682, 184.684, 204.582, 284.4565, 236.519, 271.5, 282.309, 283.222, 131.38049999999998, 266.608, 276.27, 217.81400000000002, 239.5215, 261.312, 287.712, 287.298, 142.87900000000002, 248.823, 230.003, 285.678, 378.40200000000004, 221.6245, 369.2905, 246.43349999999998, 318.847, 225.6775, 167.378, 159.0745, 299.601, 209.8835, 125.2775, 229.3235, 289.6875, 168.048, 233.1315, 273.8605, 292.07849999999996, 163.6735, 253.42000000000002, 287.62350000000004, 130.3785, 288.2535, 243.926, 292.7315, 364.77, 261.4, 194.752, 285.0935, 285.8195, 257.538, 225.8075, 139.066, 148.274, 218.5285, 244.868, 195.8835, 211.0015, 200.878, 131.1735, 185.434, 208.0845, 200.67849999999999, 265.97400000000005, 274.637, 218.11700000000002, 152.7665, 318.138, 144.85000000000002, 221.856, 285.53499999999997, 305.05899999999997, 259.726, 273.0575, 272.654, 130.1215, 0.03, 217.3765, 190.522, 198.7785, 198.18650000000002, 246.7975, 171.904, 194.9735, 212.86200000000002, 146.3665, 209.3645, 239.5325, 145.068, 176.862, 214.325, 232.2105, 212.46050000000002, 162.9025, 129.664, 142.666, 218.3125, 336.7395, 168.7375, 137.9545, 136.7815, 202.6265, 211.235, 138.481, 213.7475, 306.1385, 173.188, 211.3145, 198.2285, 273.36, 282.282, 279.2205, 221.00349999999997, 196.7495, 208.739, 188.7825, 386.486, 206.18599999999998, 307.046, 331.602, 204.25150000000002, 169.4555, 199.8885, 151.071, 214.02949999999998, 192.5205, 259.65549999999996, 272.791, 167.36849999999998, 409.738, 201.246, 132.9565, 298.5665, 157.29899999999998, 240.48000000000002, 155.59199999999998, 316.089, 282.15},
+		{216.254, 97.56200000000001, 38.2085, 17.973, 233.6405, 16.9845, 287.3365, 42.161, 10.539, 117.73949999999999, 79.36449999999999, 83.122, 107.936, 87.1255, 35.286, 94.701, 23.6725, 38.7295, 293.2835, 144.628, 40.556, 31.319499999999998, 31.024, 37.3635, 22.2855, 135.35899999999998, 43.924, 61.564, 188.33, 142.468, 110.36, 84.9885, 23.5545, 58.322500000000005, 40.6275, 145.5515, 39.9385, 57.585, 162.41199999999998, 241.23149999999998, 117.50800000000001, 85.104, 147.60250000000002, 28.5095, 103.40899999999999, 253.0575, 273.30449999999996, 42.9895, 285.342, 58.132999999999996, 24.592, 67.48949999999999, 73.338, 207.40699999999998, 201.96550000000002, 60.223, 79.60849999999999, 261.90999999999997, 149.053, 48.866, 16.651, 147.0075, 102.31700000000001, 91.453, 138.0985, 94.58, 149.5165, 142.5985, 67.884, 19.1555, 103.03, 120.9665, 109.76249999999999, 149.60399999999998, 34.1295, 280.92949999999996, 86.224, 111.6665, 168.124, 97.61349999999999, 304.1595, 104.007, 167.1655, 97.80850000000001, 141.50150000000002, 300.41549999999995, 263.3895, 78.191, 99.2255, 73.774, 186.349, 237.4325, 116.8495, 112.6105, 104.67099999999999, 44.2365, 79.7775, 185.0885, 103.3595, 169.197, 107.939, 101.39349999999999, 281.44899999999996, 124.7765, 79.3215, 150.555, 122.21350000000001, 109.816, 82.487, 37.998999999999995, 188.163, 38.513999999999996, 179.35399999999998, 21.801499999999997, 48.135000000000005, 11.6095, 23.603, 261.01149999999996, 43.616, 27.93, 89.899, 140.288, 48.0945, 241.062, 119.14500000000001, 289.32899999999995, 78.63749999999999, 106.39099999999999, 175.949, 140.064, 82.21950000000001, 92.30600000000001, 23.8445, 217.858, 0.0405, 30.2865, 30.9465, 15.125, 69.3565, 55.025000000000006, 16.7205, 19.52, 15.811, 33.2585, 66.76650000000001, 43.6775, 56.188, 10.2235, 61.977000000000004, 41.9405, 60.894999999999996, 292.381, 33.9225, 43.275999999999996, 164.66500000000002, 66.735, 18.057499999999997, 18.111, 16.018500000000003, 83.6795, 247.361, 19.298, 154.894, 66.26, 13.624, 20.881500000000003, 100.1375, 106.06700000000001, 259.95500000000004, 82.04249999999999, 31.368499999999997, 81.81299999999999, 34.3745, 205.773, 31.789, 53.424, 106.35849999999999, 87.5695, 57.534499999999994, 21.024, 53.156000000000006, 89.0815, 71.146, 91.80850000000001, 103.6455, 23.127499999999998, 182.891, 13.864, 25.435000000000002, 105.7645, 21.052, 45.4965, 171.674, 93.2625, 105.778},
+		{243.993, 109.3475, 18.137999999999998, 12.875, 230.5045, 53.5555, 267.7285, 36.9435, 26.663, 133.07549999999998, 84.0025, 108.7985, 117.7585, 98.025, 33.841499999999996, 112.65299999999999, 28.697499999999998, 15.9715, 265.3465, 159.3345, 32.956, 30.115499999999997, 21.912, 21.835, 9.7915, 150.4855, 32.482, 40.798, 168.6395, 153.621, 119.44550000000001, 95.71799999999999, 30.346, 56.9465, 36.3965, 157.576, 22.005499999999998, 43.9315, 171.8345, 248.80349999999999, 125.60900000000001, 100.25200000000001, 165.5065, 33.052, 125.036, 143.94, 355.6985, 34.598, 304.798, 29.584, 23.353, 63.6785, 69.2375, 177.7405, 197.4905, 57.269, 85.535, 317.009, 150.119, 69.242, 32.276, 156.0245, 100.5435, 104.24199999999999, 169.9595, 108.118, 147.2345, 143.37849999999997, 69.6435, 28.630000000000003, 113.94, 111.2385, 123.395, 157.18849999999998, 26.066499999999998, 213.94549999999998, 91.795, 121.89099999999999, 188.3125, 102.2565, 314.1515, 114.886, 186.1605, 108.233, 150.442, 203.628, 290.2065, 95.422, 97.96000000000001, 55.3655, 180.97, 217.0655, 122.10149999999999, 128.70749999999998, 125.8655, 121.382, 94.863, 186.54149999999998, 116.5865, 188.31799999999998, 104.525, 115.39750000000001, 290.175, 130.3185, 83.1695, 152.8415, 134.112, 119.10249999999999, 102.52199999999999, 18.023, 284.914, 25.718, 194.5205, 27.174500000000002, 45.447, 22.948, 22.001, 230.61700000000002, 44.411, 9.491499999999998, 108.9705, 154.48700000000002, 47.8645, 220.303, 127.604, 193.182, 88.7305, 92.06450000000001, 179.07850000000002, 142.5655, 97.2835, 99.6765, 18.2885, 190.51, 30.2565, 0.075, 23.3525, 25.546, 63.613, 44.6225, 26.024, 28.005, 26.118000000000002, 25.2165, 51.7455, 15.948, 42.078, 23.935499999999998, 60.445, 35.769999999999996, 57.1065, 211.9575, 26.231, 26.712, 177.5125, 48.6155, 21.4495, 21.1785, 22.503500000000003, 96.6165, 171.863, 23.1035, 179.762, 59.931, 24.13, 18.545, 111.78, 123.156, 263.02, 88.2045, 7.924, 88.60900000000001, 8.144, 221.3675, 29.0625, 29.7425, 245.3005, 74.75550000000001, 42.0145, 21.0715, 41.996, 101.5915, 73.1225, 101.522, 115.96799999999999, 37.1595, 187.58100000000002, 25.4005, 23.575000000000003, 121.2585, 35.260999999999996, 46.841499999999996, 174.464, 75.412, 111.13900000000001},
+		{202.4135, 117.688, 17.475, 20.320500000000003, 277.453, 17.347, 280.14549999999997, 41.208, 26.7025, 135.4715, 92.795, 100.09450000000001, 125.57, 95.46, 24.503999999999998, 112.7205, 35.3505, 20.8675, 298.8485, 166.768, 33.157, 36.672, 1.3495, 18.859, 16.2315, 150.1605, 33.079499999999996, 53.667, 168.30849999999998, 156.364, 120.5925, 96.62049999999999, 31.188, 57.31, 40.21, 156.077, 24.8885, 51.433, 178.062, 231.6865, 131.0595, 99.32, 164.5805, 30.5535, 122.55250000000001, 176.05, 269.525, 55.397999999999996, 304.51599999999996, 58.571, 21.4, 70.04750000000001, 70.4025, 226.711, 210.95850000000002, 54.431, 92.5685, 258.01, 158.38299999999998, 61.825, 42.087500000000006, 159.5475, 117.74549999999999, 114.75450000000001, 157.84, 107.031, 162.71499999999997, 141.546, 64.527, 35.8855, 118.33949999999999, 119.2635, 121.7535, 160.7715, 21.601, 298.517, 100.303, 124.2945, 179.57549999999998, 109.5425, 322.2835, 117.7565, 184.2295, 108.685, 120.53450000000001, 207.168, 294.9335, 97.763, 107.255, 54.269, 171.88049999999998, 248.5205, 129.5775, 137.4795, 116.507, 29.045, 91.309, 192.9815, 126.915, 187.02800000000002, 113.5895, 112.729, 290.6595, 135.6015, 90.5315, 150.9515, 137.383, 125.834, 93.40299999999999, 17.371000000000002, 210.745, 17.799500000000002, 193.52249999999998, 19.8355, 48.565, 24.049, 15.995, 299.84950000000003, 34.2475, 27.11, 99.534, 153.35899999999998, 67.255, 248.881, 130.5795, 278.346, 87.514, 105.87299999999999, 185.43099999999998, 147.78300000000002, 101.0365, 107.0975, 9.049, 198.76350000000002, 30.872999999999998, 23.333, 0.02, 32.185500000000005, 54.352000000000004, 31.085, 20.5945, 29.224, 20.075, 21.098, 56.6545, 7.7625, 45.562, 21.968, 35.579499999999996, 40.0835, 42.427, 222.5865, 13.439, 30.098, 180.4515, 47.556, 21.262, 17.1565, 21.205, 100.3075, 158.8265, 20.188000000000002, 166.47899999999998, 55.438500000000005, 28.305999999999997, 18.819, 119.31649999999999, 118.67349999999999, 327.299, 93.3365, 25.673499999999997, 95.996, 12.442499999999999, 208.8995, 26.8195, 39.013, 108.625, 78.598, 25.1235, 24.017, 29.977, 107.287, 64.094, 102.779, 118.2355, 41.409, 193.8375, 25.996499999999997, 17.8955, 125.83850000000001, 41.078, 29.220999999999997, 167.34199999999998, 83.178, 117.3125},
+		{222.4805, 90.31899999999999, 35.703500000000005, 13.6205, 234.01999999999998, 17.813000000000002, 276.773, 32.314499999999995, 5.8545, 114.6735, 75.7035, 75.10849999999999, 108.279, 80.45150000000001, 34.663, 94.472, 15.751, 28.939999999999998, 291.1015, 144.1445, 37.521, 27.5175, 36.977500000000006, 35.494, 17.8755, 134.2535, 37.3715, 60.3395, 174.5275, 143.94, 110.172, 83.6515, 16.4865, 51.826, 41.1295, 216.9485, 38.274, 42.2015, 165.8725, 242.0645, 123.95949999999999, 82.25, 141.841, 27.4345, 100.054, 156.1705, 257.01300000000003, 47.547, 291.6585, 49.13, 20.4805, 71.92599999999999, 71.80600000000001, 182.1015, 325.62800000000004, 53.2685, 75.15899999999999, 256.97799999999995, 142.9195, 43.028, 12.0225, 138.8305, 93.07249999999999, 88.672, 147.7095, 91.42500000000001, 155.388, 122.473, 67.84049999999999, 14.368, 105.449, 96.5375, 101.35, 142.9075, 29.993000000000002, 262.288, 79.902, 104.0095, 142.32850000000002, 87.90299999999999, 304.9625, 98.078, 175.392, 101.776, 154.43349999999998, 240.28949999999998, 262.124, 75.12700000000001, 100.5815, 69.2895, 169.1515, 228.481, 108.71600000000001, 110.44749999999999, 101.964, 102.895, 74.937, 177.8095, 139.25400000000002, 172.9055, 88.62, 97.22, 276.54150000000004, 122.057, 69.0485, 149.761, 115.295, 103.314, 83.057, 35.579, 288.6685, 34.7175, 178.6175, 16.9575, 46.5145, 7.079000000000001, 25.058999999999997, 266.52700000000004, 40.696, 24.264, 86.40100000000001, 137.007, 47.0245, 239.22699999999998, 113.6255, 216.7785, 72.604, 99.5685, 169.356, 130.73899999999998, 76.8755, 85.5025, 19.204, 198.4015, 15.0815, 26.017000000000003, 32.370000000000005, 0.033, 65.515, 56.542500000000004, 15.854, 15.4175, 11.013, 35.16, 85.9375, 38.708, 51.6635, 5.2505, 71.64250000000001, 36.2185, 42.379, 219.6905, 24.913, 33.635000000000005, 171.4495, 61.952, 13.9095, 13.622, 11.036999999999999, 77.003, 155.32150000000001, 15.5715, 144.319, 66.85249999999999, 8.965, 15.8925, 94.047, 105.5545, 271.331, 75.53649999999999, 29.747999999999998, 74.69149999999999, 32.113, 201.1705, 27.2115, 48.4535, 101.65549999999999, 83.4, 52.1135, 20.442, 57.132999999999996, 85.81549999999999, 65.742, 88.5065, 98.71950000000001, 18.3995, 179.279, 8.910499999999999, 28.988, 106.56450000000001, 16.6845, 38.4695, 218.198, 81.9605, 96.64500000000001},
+		{251.98000000000002, 145.3655, 70.125, 62.8765, 262.673, 61.445, 333.9055, 84.6465, 67.017, 167.3995, 137.3175, 148.9545, 173.359, 146.382, 70.1615, 141.353, 69.59100000000001, 72.15299999999999, 362.35, 201.4105, 93.67250000000001, 70.36, 70.47749999999999, 76.332, 61.2335, 202.9445, 75.1935, 88.483, 224.45350000000002, 194.43849999999998, 176.153, 146.8095, 72.332, 91.75550000000001, 79.42599999999999, 211.0685, 73.24350000000001, 82.7745, 216.2055, 305.318, 188.353, 135.2635, 217.15449999999998, 92.348, 165.72899999999998, 170.175, 314.9015, 115.327, 350.29499999999996, 96.6005, 65.89750000000001, 113.525, 112.3625, 225.7745, 342.8755, 99.9, 127.6005, 270.951, 192.1255, 122.3205, 62.137, 199.189, 147.28699999999998, 157.4505, 203.03050000000002, 155.36599999999999, 195.552, 174.2945, 117.477, 57.885, 154.8045, 165.04000000000002, 156.445, 204.13299999999998, 68.52199999999999, 293.169, 155.57, 165.11, 214.712, 131.35500000000002, 360.7255, 155.383, 249.606, 160.30849999999998, 211.02100000000002, 274.9735, 323.1395, 129.19549999999998, 132.17849999999999, 106.962, 197.6015, 298.3235, 163.142, 169.7365, 162.0575, 73.30600000000001, 136.0435, 198.54649999999998, 167.222, 230.854, 159.1925, 162.0475, 346.10450000000003, 190.159, 129.539, 224.2855, 184.2895, 153.8295, 139.769, 66.7425, 228.493, 83.993, 244.76600000000002, 60.9745, 83.5625, 64.1755, 68.5445, 283.65, 94.5895, 54.6395, 146.115, 200.619, 100.458, 251.389, 169.678, 335.42100000000005, 122.70750000000001, 149.44799999999998, 208.497, 188.54199999999997, 155.3605, 152.9225, 79.814, 246.882, 69.35249999999999, 63.797, 54.4705, 65.507, 0.031, 95.29849999999999, 65.977, 80.1435, 61.646, 73.743, 102.3025, 65.5735, 93.652, 69.57499999999999, 101.0025, 79.58349999999999, 82.2145, 234.61950000000002, 65.395, 78.2295, 220.82600000000002, 104.331, 60.833, 66.66499999999999, 54.3605, 146.22500000000002, 169.4005, 80.23249999999999, 204.9995, 116.237, 64.25399999999999, 72.255, 165.27749999999997, 163.848, 298.355, 137.971, 76.726, 128.04500000000002, 68.065, 287.4485, 80.699, 115.0275, 260.29949999999997, 119.96350000000001, 94.488, 67.107, 99.84649999999999, 155.1205, 111.7095, 151.359, 162.5695, 78.85900000000001, 236.37650000000002, 73.136, 76.381, 157.648, 80.224, 97.792, 241.1535, 122.2625, 138.73149999999998},
+		{262.2645, 135.0565, 19.0235, 44.782, 257.629, 45.9055, 302.5375, 69.025, 44.0295, 159.5095, 115.3185, 130.7505, 146.57049999999998, 123.48349999999999, 63.4285, 131.6055, 59.7485, 28.2895, 314.4825, 197.4675, 59.742000000000004, 40.1105, 33.599999999999994, 41.667500000000004, 34.577, 177.238, 58.7495, 42.8565, 191.002, 186.067, 147.357, 126.662, 52.204, 86.813, 67.405, 198.5625, 27.32, 69.05000000000001, 200.3105, 284.63750000000005, 156.08350000000002, 122.10499999999999, 180.5045, 57.2395, 154.863, 176.6855, 311.948, 72.9925, 345.0795, 40.0925, 37.715500000000006, 87.18299999999999, 93.583, 224.1245, 219.65949999999998, 65.351, 121.1755, 297.594, 186.949, 87.704, 55.192, 196.533, 140.416, 140.3375, 201.007, 126.687, 197.9865, 164.309, 113.2815, 59.3925, 148.73950000000002, 149.0935, 147.6695, 179.5505, 62.601, 296.03049999999996, 129.371, 153.614, 190.649, 141.834, 353.41150000000005, 139.783, 208.2525, 140.289, 130.9905, 276.399, 277.4235, 114.27600000000001, 114.226, 26.7885, 205.262, 242.84449999999998, 162.002, 168.3345, 138.038, 56.3475, 116.573, 216.598, 132.3155, 227.327, 141.5165, 143.668, 330.318, 162.1865, 116.0455, 187.336, 160.01999999999998, 151.20049999999998, 122.761, 17.438499999999998, 213.1835, 53.4915, 225.40550000000002, 48.141999999999996, 12.4295, 50.686499999999995, 37.902, 221.4245, 37.809, 44.588, 128.5325, 192.5235, 94.4665, 277.9445, 182.904, 230.3275, 117.13900000000001, 136.74900000000002, 204.01749999999998, 173.9835, 119.165, 141.8795, 41.9795, 171.963, 54.98, 44.598, 31.142, 56.441500000000005, 95.2795, 0.031, 48.959500000000006, 57.692, 48.183, 32.542, 51.597, 47.254, 74.1695, 49.1275, 55.536500000000004, 59.0255, 69.482, 233.77800000000002, 38.094, 30.459, 200.3605, 14.835999999999999, 46.352999999999994, 47.828500000000005, 56.5445, 122.75200000000001, 193.7765, 52.994, 207.836, 29.77, 52.628, 47.559, 136.181, 147.039, 288.828, 123.755, 54.069500000000005, 121.8125, 37.892, 259.178, 61.466, 54.507, 280.8465, 76.88300000000001, 78.1555, 45.204, 49.343, 134.87650000000002, 83.489, 125.19749999999999, 147.286, 61.6845, 223.779, 47.957499999999996, 39.2915, 149.195, 66.506, 45.3305, 199.9545, 104.5, 150.558},
+		{245.773, 90.29650000000001, 25.2745, 11.7205, 241.839, 3.4435000000000002, 275.2285, 22.6255, 7.617, 114.316, 80.0335, 81.22900000000001, 115.838, 82.256, 33.2685, 103.959, 19.1515, 20.558500000000002, 294.00199999999995, 158.92950000000002, 26.886000000000003, 14.227, 22.6795, 28.438000000000002, 20.2075, 138.56599999999997, 30.021, 38.735, 168.3255, 151.6025, 108.1865, 82.56899999999999, 12.541, 45.45, 26.912, 152.3265, 29.2325, 40.143, 168.192, 227.074, 123.0745, 87.22200000000001, 150.8995, 27.416, 109.3715, 134.6595, 260.23249999999996, 38.8515, 305.329, 25.381500000000003, 14.3825, 60.051500000000004, 73.6315, 183.399, 343.3805, 45.424, 84.413, 249.4395, 143.8245, 56.533, 12.597000000000001, 141.441, 100.57849999999999, 94.62049999999999, 134.4885, 93.9045, 148.68200000000002, 120.655, 62.4195, 19.639, 106.338, 110.5895, 105.98400000000001, 145.5795, 27.671999999999997, 282.8315, 93.7485, 107.72, 157.4975, 102.149, 314.774, 100.63499999999999, 181.4425, 102.824, 131.46249999999998, 297.2795, 260.1155, 74.63550000000001, 102.011, 59.5235, 166.554, 199.8265, 122.232, 114.7935, 102.2185, 28.219, 76.3565, 181.42649999999998, 110.2345, 187.95600000000002, 98.2675, 101.2285, 289.879, 122.1905, 73.33349999999999, 127.757, 116.955, 111.6745, 81.0355, 25.1995, 189.738, 25.0215, 181.391, 6.0265, 49.173, 10.3335, 11.463000000000001, 188.6045, 35.186499999999995, 18.0045, 95.565, 141.0045, 38.581, 247.9915, 122.152, 280.832, 75.83500000000001, 98.374, 183.1505, 138.429, 84.9645, 99.888, 18.200499999999998, 195.0485, 16.6385, 26.049500000000002, 20.6385, 15.643, 65.94200000000001, 48.9145, 0.041, 6.155, 3.8535000000000004, 17.868000000000002, 68.54599999999999, 30.877499999999998, 41.5475, 7.529, 72.6945, 27.0155, 34.132000000000005, 284.683, 19.4625, 28.6145, 173.6055, 52.673, 5.433, 4.66, 2.147, 84.14750000000001, 139.62099999999998, 6.5115, 155.9025, 56.700500000000005, 13.4585, 5.3385, 108.5725, 117.7885, 251.2015, 81.4295, 23.4165, 79.506, 17.7145, 203.644, 29.2815, 42.208, 238.1015, 79.049, 41.328500000000005, 13.419, 50.272999999999996, 88.98400000000001, 69.217, 103.2285, 105.0595, 37.0945, 184.6705, 18.677, 12.9435, 121.8565, 27.584, 29.747, 149.8025, 83.0505, 105.14500000000001},
+		{226.4745, 97.374, 25.816499999999998, 14.9175, 246.673, 5.338, 278.33, 26.4845, 9.0725, 128.571, 87.05799999999999, 93.6265, 108.357, 96.16900000000001, 42.094, 95.9265, 20.732, 25.7225, 308.6355, 138.1015, 33.482, 16.454, 29.595, 32.8445, 16.764499999999998, 149.135, 36.223, 42.919, 176.6955, 144.933, 119.368, 99.3235, 16.9185, 48.2615, 29.993000000000002, 159.36, 29.241, 30.338, 170.308, 230.61599999999999, 128.424, 91.922, 147.8025, 28.737000000000002, 109.686, 191.346, 179.2225, 45.0795, 304.4075, 57.916, 10.631, 61.658500000000004, 72.4985, 241.5385, 319.3345, 50.0165, 90.79400000000001, 208.1135, 154.20600000000002, 52.709500000000006, 21.869, 151.652, 98.77850000000001, 100.41550000000001, 155.55700000000002, 98.9145, 150.19, 131.415, 65.1645, 22.285, 117.1615, 109.5005, 118.44, 153.152, 37.3215, 283.53700000000003, 96.40950000000001, 119.1235, 174.9135, 104.251, 308.8685, 113.5215, 169.574, 110.22649999999999, 149.9695, 253.6585, 282.816, 91.05199999999999, 87.232, 61.212999999999994, 163.84199999999998, 232.56150000000002, 128.63, 123.963, 117.7895, 23.332, 93.9015, 175.644, 189.485, 178.5435, 101.41550000000001, 105.02950000000001, 290.03650000000005, 137.6945, 80.167, 147.382, 121.963, 116.232, 93.954, 25.6655, 212.881, 31.2745, 188.05349999999999, 12.5185, 49.7265, 9.593499999999999, 19.8985, 269.54650000000004, 37.2055, 18.439, 90.6945, 158.04149999999998, 32.727999999999994, 294.07349999999997, 144.7045, 281.062, 82.70599999999999, 100.939, 171.729, 142.0525, 97.0325, 105.941, 17.180500000000002, 212.78199999999998, 19.466, 28.0245, 29.269, 15.3735, 80.1825, 57.687, 6.1855, 0.029, 6.296, 27.28, 67.9855, 30.865499999999997, 42.674, 11.2485, 65.75200000000001, 37.281499999999994, 41.4645, 205.07549999999998, 22.658, 32.4925, 168.148, 54.3215, 7.9375, 5.984999999999999, 4.525, 85.84649999999999, 146.556, 8.4725, 165.678, 70.37450000000001, 13.6385, 8.038499999999999, 102.524, 119.68700000000001, 337.37699999999995, 98.283, 38.648, 85.8065, 27.471, 244.10700000000003, 26.1465, 49.43, 108.3095, 84.2345, 43.8915, 9.367, 59.492000000000004, 96.1515, 68.6855, 103.84100000000001, 107.734, 32.351, 182.41899999999998, 15.838, 22.811, 106.0565, 24.499000000000002, 32.9355, 180.48149999999998, 77.62899999999999, 107.2415},
+		{250.113, 99.4075, 24.2875, 15.0365, 257.155, 3.192, 303.097, 24.1355, 6.761, 116.24549999999999, 74.017, 82.084, 114.0205, 93.1905, 30.6665, 90.693, 18.814, 24.2235, 299.27250000000004, 153.442, 41.274, 13.0275, 27.139, 27.4985, 13.8025, 146.255, 23.317999999999998, 40.246, 170.76749999999998, 147.678, 107.1445, 82.7045, 8.868500000000001, 47.033500000000004, 42.346000000000004, 152.833, 27.0565, 40.9925, 155.1845, 236.4645, 116.33, 83.19399999999999, 156.99200000000002, 23.828, 117.9985, 157.048, 173.0035, 30.594, 297.7185, 38.947500000000005, 10.795, 61.595, 75.57900000000001, 216.749, 321.3125, 58.591, 76.624, 245.6905, 137.8595, 50.1045, 17.7095, 153.76749999999998, 99.51650000000001, 148.442, 151.10399999999998, 97.667, 149.9655, 140.86149999999998, 68.16550000000001, 15.239, 99.8735, 108.3155, 107.4295, 149.1375, 23.0915, 268.56600000000003, 80.243, 147.86149999999998, 148.525, 89.2865, 307.51599999999996, 100.8475, 169.409, 95.1735, 138.2225, 278.685, 280.1205, 75.67699999999999, 89.3955, 58.152, 180.3605, 253.386, 117.8045, 114.664, 102.2005, 22.737000000000002, 87.652, 181.494, 108.8245, 172.2905, 91.24799999999999, 103.0655, 285.427, 116.638, 70.443, 137.277, 123.9385, 121.3675, 79.181, 24.319, 215.06900000000002, 25.1, 185.20850000000002, 6.1465, 33.5275, 7.6625, 10.819, 264.8075, 33.368, 14.317499999999999, 85.198, 159.64249999999998, 47.120999999999995, 255.401, 123.2305, 337.7745, 81.9, 85.702, 182.311, 143.23649999999998, 83.8015, 86.942, 13.9265, 146.3225, 15.713999999999999, 26.057000000000002, 20.074, 11.0585, 61.5515, 48.118, 3.8994999999999997, 6.238, 0.033, 38.117000000000004, 65.9355, 26.4345, 42.086, 6.011, 60.179500000000004, 29.055, 34.6335, 273.778, 17.698500000000003, 45.427, 163.70499999999998, 51.316, 4.234, 3.5095, 2.5645, 82.926, 135.684, 6.665, 167.79, 65.128, 9.6495, 4.140499999999999, 103.57, 120.3965, 252.5165, 78.2085, 23.502, 82.703, 15.174, 233.207, 21.953, 44.623000000000005, 110.7185, 78.4935, 41.128, 11.743, 42.1905, 84.9545, 68.9945, 91.7925, 103.923, 27.3935, 171.5365, 9.6655, 12.244, 101.828, 16.92, 28.4, 141.72899999999998, 86.52350000000001, 102.6155},
+		{229.8965, 112.838, 10.4805, 31.54, 231.368, 29.203000000000003, 289.6095, 38.0995, 35.811, 140.3725, 106.143, 104.4145, 137.1565, 105.05799999999999, 35.9895, 110.804, 45.5515, 11.968499999999999, 308.37149999999997, 169.313, 35.789500000000004, 28.180500000000002, 21.3335, 9.503, 10.548, 152.0845, 28.451, 32.806, 172.54199999999997, 167.614, 129.413, 110.8615, 41.073, 56.0065, 30.1315, 161.529, 18.4325, 47.608999999999995, 179.597, 272.648, 130.86700000000002, 100.6225, 159.5615, 42.601, 126.9055, 161.91699999999997, 394.9505, 49.23, 319.42650000000003, 15.858, 30.744500000000002, 72.45349999999999, 84.569, 182.9755, 198.9155, 50.1905, 110.67699999999999, 346.72, 159.1725, 70.138, 27.430999999999997, 162.4735, 124.3205, 115.14349999999999, 148.8255, 108.026, 159.1035, 156.841, 70.792, 47.1665, 137.648, 128.67450000000002, 133.361, 179.343, 46.9105, 301.4105, 117.21600000000001, 123.5315, 190.2235, 122.15899999999999, 321.427, 114.566, 191.11900000000003, 115.6245, 143.7415, 323.3705, 286.37800000000004, 98.328, 121.009, 44.564, 180.86599999999999, 204.516, 142.993, 126.64099999999999, 125.564, 40.439499999999995, 103.3995, 202.4955, 125.3785, 193.1045, 117.0895, 114.223, 302.581, 131.39999999999998, 105.568, 152.9885, 130.7475, 124.47749999999999, 101.38, 10.339, 183.50650000000002, 9.993, 196.716, 18.8245, 23.4825, 24.86, 15.137, 181.57600000000002, 24.522, 31.551000000000002, 107.94149999999999, 153.0765, 40.328, 272.92449999999997, 139.698, 286.9, 88.8895, 85.3935, 186.3485, 156.15949999999998, 101.467, 112.419, 23.551000000000002, 209.3995, 33.2475, 25.259500000000003, 21.161, 35.2325, 73.626, 32.5565, 17.764499999999998, 27.278, 38.2035, 0.048, 79.848, 45.868, 50.598, 21.566000000000003, 77.464, 16.499499999999998, 35.1075, 221.837, 12.621500000000001, 9.419, 187.6275, 38.2, 21.467, 27.926000000000002, 22.0595, 110.5795, 163.137, 28.886499999999998, 187.32150000000001, 66.6365, 42.599000000000004, 29.014000000000003, 120.198, 127.025, 264.40599999999995, 98.2825, 22.496000000000002, 110.2305, 19.0965, 253.6025, 40.5205, 49.254999999999995, 127.1625, 75.4765, 50.3615, 23.877, 58.613, 112.6825, 79.0265, 110.686, 116.2065, 47.923500000000004, 196.781, 39.2275, 15.577, 125.69149999999999, 42.377, 28.204, 197.614, 70.405, 127.76},
+		{215.84300000000002, 148.9375, 53.9735, 49.832, 325.51250000000005, 55.572500000000005, 312.4685, 75.56649999999999, 57.7215, 161.702, 126.924, 130.23000000000002, 154.781, 138.2015, 83.8265, 139.9015, 67.5605, 58.206, 321.957, 191.19549999999998, 68.358, 53.5385, 54.21, 59.6855, 54.752, 186.673, 83.3985, 89.99199999999999, 219.1985, 190.466, 159.797, 132.803, 66.6055, 89.98949999999999, 88.85900000000001, 191.976, 60.7975, 80.217, 203.94, 291.4245, 177.9505, 134.219, 195.6255, 62.584999999999994, 153.71249999999998, 220.279, 229.6385, 81.0765, 340.38750000000005, 81.89500000000001, 58.232, 101.95349999999999, 107.5135, 243.2455, 334.3515, 10.1785, 123.583, 233.962, 202.7625, 97.351, 70.0745, 206.541, 147.87349999999998, 152.8535, 184.779, 139.177, 192.6945, 168.563, 102.122, 67.3445, 151.704, 145.9525, 173.0885, 207.1245, 98.3495, 339.549, 133.2395, 160.2975, 217.077, 145.467, 360.81050000000005, 149.642, 242.30200000000002, 143.061, 184.115, 295.7695, 264.041, 123.87450000000001, 135.5255, 94.129, 198.7595, 282.996, 166.51350000000002, 164.245, 160.95600000000002, 69.99600000000001, 129.1625, 214.83100000000002, 149.9595, 228.924, 153.205, 149.23149999999998, 328.2855, 165.8075, 125.5655, 194.142, 162.4345, 158.4785, 128.78199999999998, 53.896, 228.8435, 62.4845, 230.8095, 51.6035, 18.177, 64.463, 48.827, 316.293, 60.5055, 58.299, 134.088, 202.661, 71.13900000000001, 300.6595, 165.71949999999998, 253.1815, 125.628, 156.252, 213.4865, 180.75650000000002, 129.95, 146.025, 53.738, 239.6755, 66.7915, 51.811, 56.732, 81.20949999999999, 102.315, 51.6335, 68.35849999999999, 68.0325, 65.9625, 79.932, 0.04, 66.60249999999999, 81.37950000000001, 53.8095, 8.6615, 84.747, 56.745999999999995, 363.62300000000005, 69.463, 59.224000000000004, 212.823, 77.45349999999999, 69.268, 55.709, 54.574, 135.0705, 196.274, 52.107, 199.0, 17.945, 69.6075, 53.662, 147.9405, 163.0655, 313.004, 138.5495, 71.9245, 131.31099999999998, 54.979, 263.27549999999997, 85.4845, 84.54750000000001, 351.995, 108.025, 79.3825, 58.0595, 67.1305, 130.51299999999998, 101.673, 151.0565, 150.464, 85.469, 241.5015, 67.5455, 56.4975, 176.2605, 69.35249999999999, 64.57249999999999, 215.164, 108.6825, 150.399},
+		{224.25150000000002, 116.2645, 23.8355, 26.6305, 248.9115, 22.426000000000002, 277.66200000000003, 51.769000000000005, 31.471, 135.4335, 98.0505, 105.1345, 131.17149999999998, 104.14099999999999, 45.063, 117.3955, 42.5155, 22.3005, 279.474, 173.172, 39.098, 35.7255, 14.663499999999999, 33.699, 22.854999999999997, 156.265, 36.8625, 48.3965, 174.2595, 167.308, 129.962, 104.662, 38.5995, 65.9745, 43.068, 165.95, 29.7175, 55.006, 188.067, 254.757, 137.274, 106.322, 166.825, 39.587500000000006, 125.5015, 152.9555, 365.3115, 41.094, 319.108, 48.9945, 27.201999999999998, 58.391999999999996, 70.947, 176.5035, 283.5675, 85.606, 100.524, 320.907, 165.2115, 75.03649999999999, 38.7365, 174.6655, 121.7845, 113.43299999999999, 174.7815, 106.2105, 140.73450000000003, 142.755, 91.5185, 42.635000000000005, 128.55700000000002, 125.0275, 128.774, 168.00549999999998, 35.3165, 298.689, 107.017, 130.261, 188.886, 116.1305, 327.731, 121.05799999999999, 199.924, 118.361, 166.79149999999998, 256.34950000000003, 294.592, 107.57300000000001, 91.195, 60.9185, 169.1325, 230.2945, 136.353, 143.5305, 126.136, 48.277, 101.9515, 172.7955, 119.18299999999999, 201.677, 120.40700000000001, 132.838, 304.1195, 145.5015, 96.872, 163.415, 140.46699999999998, 124.45949999999999, 103.9775, 23.515, 188.6695, 23.734, 199.75150000000002, 26.2855, 49.7455, 35.2255, 24.8555, 263.86749999999995, 43.911, 16.266, 112.2065, 172.6495, 58.3245, 322.3905, 136.878, 207.4495, 97.4485, 101.1005, 179.32, 153.706, 99.07, 123.19749999999999, 22.378500000000003, 144.82799999999997, 43.372, 15.7265, 7.410500000000001, 38.322, 65.443, 46.9495, 30.404, 31.0355, 26.4, 45.399, 66.008, 0.0545, 55.531, 30.810499999999998, 64.955, 43.268, 57.7245, 293.355, 19.7165, 36.132000000000005, 193.49349999999998, 54.593, 30.0205, 30.134, 28.849, 102.643, 153.832, 30.244500000000002, 172.5735, 58.064, 36.82, 27.6635, 123.60149999999999, 134.6195, 292.2495, 101.93299999999999, 17.634, 102.2745, 12.387, 233.2885, 35.1135, 35.0145, 115.1305, 80.1565, 42.748999999999995, 32.536500000000004, 40.950500000000005, 107.6555, 72.0945, 108.9525, 120.964, 45.477999999999994, 200.273, 32.1065, 26.247999999999998, 132.4095, 41.793, 36.919, 155.67149999999998, 90.85249999999999, 124.0515},
+		{236.667, 127.8215, 55.388000000000005, 48.018, 250.106, 40.86, 308.279, 84.7125, 41.314, 163.99099999999999, 118.9215, 121.8155, 144.802, 135.372, 65.6015, 129.4785, 57.331, 63.4915, 326.796, 178.172, 52.7525, 48.075500000000005, 56.939, 56.665, 34.9095, 176.4725, 48.549499999999995, 67.7445, 207.60399999999998, 178.724, 151.20749999999998, 130.39, 49.2695, 87.0495, 63.134, 188.962, 56.2415, 49.819, 203.171, 289.938, 155.2475, 124.83500000000001, 189.85000000000002, 64.6365, 145.632, 185.0285, 395.24699999999996, 80.517, 322.081, 77.4135, 44.5075, 92.807, 109.7525, 303.8245, 223.51600000000002, 76.7675, 122.59299999999999, 265.6825, 176.175, 83.3005, 54.1075, 191.2335, 145.3345, 131.442, 174.1055, 120.9195, 191.5325, 163.405, 107.424, 56.734, 146.3655, 142.9405, 145.7135, 185.048, 64.4615, 271.038, 120.7895, 147.3465, 211.385, 137.79, 359.6095, 137.4225, 215.5175, 141.70299999999997, 185.92950000000002, 264.402, 316.26750000000004, 118.15299999999999, 116.0975, 83.62100000000001, 209.3065, 241.911, 159.8735, 153.8985, 145.528, 44.9475, 116.824, 214.0575, 144.582, 202.374, 138.51, 138.2105, 321.3565, 161.171, 115.429, 184.0995, 154.46249999999998, 149.9565, 123.78399999999999, 40.74, 214.478, 55.8, 216.834, 37.6195, 63.729, 53.439, 40.233999999999995, 267.382, 64.70949999999999, 47.709, 133.43849999999998, 181.72750000000002, 64.39699999999999, 281.8695, 155.49099999999999, 309.61199999999997, 116.8965, 116.258, 206.8505, 185.815, 119.297, 134.68, 36.0095, 178.3995, 60.28, 39.088, 45.9795, 53.2295, 97.845, 75.673, 37.8505, 37.7485, 48.5875, 49.721000000000004, 90.86000000000001, 55.417, 1.019, 42.8635, 86.4635, 62.823, 72.86699999999999, 222.575, 43.647, 51.358999999999995, 211.753, 73.797, 43.6195, 48.6505, 39.4785, 121.73349999999999, 174.6615, 32.8095, 201.85000000000002, 83.731, 56.4075, 38.730000000000004, 138.5025, 148.297, 258.2525, 117.93700000000001, 52.1425, 118.1495, 45.0945, 233.1345, 63.461, 82.27799999999999, 231.274, 101.59899999999999, 73.2165, 45.449, 88.8875, 128.5035, 101.8455, 124.60849999999999, 140.7265, 62.044, 203.48950000000002, 45.6115, 43.4765, 151.7455, 63.7875, 58.424, 215.62, 109.1425, 146.8955},
+		{196.6735, 78.9435, 30.484, 8.5795, 207.03050000000002, 6.8285, 291.149, 26.589, 10.3545, 106.80250000000001, 67.635, 73.0675, 100.87950000000001, 90.55850000000001, 34.8865, 86.9745, 10.148499999999999, 23.5995, 272.7485, 124.765, 31.067500000000003, 17.249499999999998, 23.5495, 30.7305, 18.08, 130.1285, 27.862499999999997, 47.650999999999996, 177.56650000000002, 132.33100000000002, 114.9525, 90.8785, 11.2935, 45.688500000000005, 31.0965, 145.30399999999997, 46.563, 39.887, 151.1875, 243.889, 125.0, 78.184, 128.0405, 14.626999999999999, 95.208, 147.54649999999998, 169.9115, 35.021, 279.0065, 28.494999999999997, 25.247, 66.551, 67.801, 249.8295, 268.914, 79.4355, 69.659, 238.892, 129.646, 55.3955, 7.343, 123.4545, 107.89349999999999, 85.815, 135.049, 89.62, 139.3795, 125.614, 63.1695, 9.407499999999999, 92.8845, 101.48849999999999, 114.415, 149.33300000000003, 31.877499999999998, 232.35750000000002, 78.666, 98.124, 156.4575, 86.872, 304.882, 109.392, 181.74849999999998, 102.8685, 174.0165, 241.5765, 257.292, 66.5955, 117.83449999999999, 56.567, 176.374, 230.95749999999998, 102.937, 106.9025, 104.9495, 36.602500000000006, 69.844, 166.0435, 128.4985, 154.4255, 106.39150000000001, 99.191, 312.233, 117.2755, 63.902, 139.941, 127.346, 96.851, 81.23, 30.423000000000002, 177.927, 29.875999999999998, 171.095, 10.399000000000001, 39.507, 2.0854999999999997, 18.486, 222.3435, 38.069500000000005, 23.2185, 78.9005, 133.212, 32.462, 246.5695, 112.144, 255.9405, 69.097, 91.87950000000001, 156.59300000000002, 148.8055, 76.38499999999999, 78.10300000000001, 14.3105, 214.54399999999998, 10.155000000000001, 23.9345, 22.008499999999998, 5.1775, 70.58500000000001, 49.170500000000004, 7.5985, 11.2065, 6.0195, 21.509500000000003, 53.751999999999995, 31.299, 44.6055, 0.018, 68.5505, 34.054, 37.5255, 206.1205, 30.7095, 25.21, 157.67700000000002, 58.162, 8.653500000000001, 7.333, 6.3045, 73.879, 152.489, 9.8605, 162.739, 74.2835, 4.1265, 8.397, 89.948, 116.368, 194.87900000000002, 72.506, 21.028, 72.4295, 20.2305, 189.92149999999998, 21.923499999999997, 43.6395, 96.775, 92.9315, 57.424499999999995, 16.0965, 51.328, 94.74, 70.101, 81.002, 93.6585, 13.3505, 165.9885, 3.9085, 20.3485, 96.46199999999999, 18.58, 31.7485, 161.5625, 78.70849999999999, 92.921},
+		{223.755, 146.144, 58.1045, 52.158500000000004, 316.531, 57.3715, 325.454, 79.8115, 64.001, 174.869, 133.4205, 133.19, 168.503, 137.40949999999998, 71.297, 142.22899999999998, 74.541, 49.157, 330.70500000000004, 203.36, 72.9125, 61.2385, 53.522999999999996, 74.4865, 47.245, 199.262, 76.931, 87.5255, 210.7025, 188.676, 157.24, 132.95350000000002, 67.02799999999999, 102.1885, 88.2295, 223.31400000000002, 60.34, 81.497, 202.767, 300.841, 169.46, 147.38549999999998, 205.05349999999999, 64.14750000000001, 158.1735, 203.9435, 300.61, 92.4545, 336.903, 105.533, 53.4225, 100.13749999999999, 105.13149999999999, 274.395, 365.196, 8.5215, 121.09649999999999, 296.965, 188.6395, 102.7815, 75.15549999999999, 208.68599999999998, 150.57850000000002, 150.547, 186.5995, 154.2465, 241.64, 180.0085, 121.929, 77.721, 153.988, 146.9495, 170.39299999999997, 212.0775, 85.345, 334.264, 130.0775, 163.498, 224.612, 149.663, 362.856, 157.3835, 220.274, 139.25349999999997, 230.51749999999998, 310.48249999999996, 282.14549999999997, 135.656, 150.088, 91.3145, 186.5765, 279.1345, 171.38549999999998, 163.5635, 167.0725, 72.367, 131.51850000000002, 220.04149999999998, 143.44299999999998, 225.988, 156.551, 145.4985, 328.33050000000003, 177.115, 122.45150000000001, 202.787, 170.086, 166.5655, 132.6315, 54.2315, 257.5195, 66.9365, 234.07999999999998, 63.489000000000004, 18.811999999999998, 61.792, 42.354, 322.352, 58.383, 69.893, 140.24200000000002, 207.031, 88.826, 351.8345, 183.179, 263.81050000000005, 134.74450000000002, 159.465, 208.152, 182.965, 132.76999999999998, 146.73149999999998, 66.6305, 232.2095, 61.848, 60.4595, 35.647000000000006, 71.612, 101.129, 55.329, 72.5005, 65.64949999999999, 60.209, 77.45949999999999, 8.562999999999999, 65.0635, 84.0645, 68.5275, 0.0405, 94.97800000000001, 63.7975, 360.6945, 62.8215, 64.7085, 208.028, 74.559, 74.732, 57.275999999999996, 57.149, 134.69799999999998, 205.589, 50.513000000000005, 207.224, 15.853, 61.2195, 60.292500000000004, 163.058, 164.8795, 370.7895, 138.109, 72.223, 130.3345, 60.1375, 263.874, 75.52350000000001, 79.3535, 349.5785, 104.672, 89.45, 59.534499999999994, 68.67500000000001, 137.94850000000002, 114.233, 142.856, 148.4845, 85.606, 248.9345, 77.11949999999999, 49.635000000000005, 166.1315, 70.94800000000001, 65.887, 242.151, 90.4075, 150.04149999999998},
+		{243.5565, 115.346, 38.7035, 35.4435, 270.7895, 29.737000000000002, 315.3775, 44.058499999999995, 39.575500000000005, 143.728, 103.50550000000001, 105.35499999999999, 134.153, 114.8555, 46.8635, 119.7195, 46.608999999999995, 26.0875, 329.81600000000003, 165.22199999999998, 24.235, 31.070500000000003, 35.176, 25.308, 24.467, 164.9245, 5.3715, 26.9765, 187.8195, 163.26850000000002, 137.15499999999997, 109.832, 41.454499999999996, 68.21449999999999, 24.78, 170.5085, 39.158500000000004, 39.621, 190.849, 265.7635, 141.50650000000002, 113.9795, 177.798, 48.7645, 131.95850000000002, 170.841, 288.2885, 57.769000000000005, 311.8645, 63.414500000000004, 34.575, 78.2825, 89.5285, 201.09449999999998, 387.894, 62.0965, 104.8755, 204.031, 173.16750000000002, 89.40549999999999, 41.210499999999996, 170.82999999999998, 130.76049999999998, 122.862, 161.6, 120.8905, 163.988, 148.1495, 89.961, 40.0385, 127.498, 123.6505, 132.8265, 171.313, 51.6135, 257.90250000000003, 122.229, 133.712, 195.98649999999998, 125.9335, 331.4815, 130.63549999999998, 213.05450000000002, 121.9085, 184.50900000000001, 343.56100000000004, 300.225, 109.846, 109.57, 74.65700000000001, 195.2635, 204.1845, 145.4395, 142.392, 136.411, 32.557, 102.01599999999999, 204.053, 131.0, 201.805, 130.1605, 129.3265, 310.01750000000004, 142.1795, 105.69200000000001, 152.9215, 147.015, 140.756, 109.247, 37.1895, 197.8895, 24.435000000000002, 208.9085, 29.467, 41.7295, 32.8195, 27.4465, 179.426, 51.294, 32.3835, 118.652, 167.996, 53.744, 277.7085, 147.0685, 315.4365, 104.494, 73.2925, 195.91, 172.2765, 116.85249999999999, 125.2105, 30.805, 212.474, 41.8915, 35.7655, 40.1395, 35.9065, 80.583, 59.018, 27.27, 37.3005, 29.217, 16.4785, 84.57300000000001, 43.090500000000006, 57.06699999999999, 34.075, 95.07749999999999, 0.042499999999999996, 49.082, 318.28499999999997, 37.9615, 27.5285, 196.553, 66.92099999999999, 33.875, 34.2825, 29.1785, 109.58, 155.09550000000002, 32.966, 197.2665, 90.2015, 34.546, 31.0845, 127.9195, 130.079, 259.6965, 185.29950000000002, 35.9065, 110.332, 39.2395, 226.574, 59.5785, 65.72, 228.01549999999997, 84.826, 56.4375, 34.5015, 63.738, 117.9885, 87.5545, 113.3415, 130.8675, 51.911, 209.5915, 39.192, 28.924, 126.75450000000001, 46.7855, 44.161500000000004, 182.084, 81.257, 132.09050000000002},
+		{220.621, 121.126, 25.598, 44.4135, 255.708, 34.8555, 307.68899999999996, 63.533500000000004, 56.2245, 159.2625, 126.175, 136.74, 135.3665, 121.5455, 51.678, 132.728, 60.788, 15.3835, 323.734, 183.998, 59.943, 56.411, 50.0935, 19.987000000000002, 44.3345, 165.913, 47.027, 54.555499999999995, 211.35250000000002, 179.30349999999999, 137.8515, 127.45349999999999, 59.1905, 88.2995, 59.1605, 209.171, 21.826, 63.958, 204.09300000000002, 283.727, 147.8025, 114.2125, 175.97199999999998, 46.2125, 153.04500000000002, 177.0605, 294.9065, 69.641, 335.89549999999997, 18.131999999999998, 40.1435, 85.1155, 95.773, 212.7665, 272.14300000000003, 70.2995, 125.948, 287.151, 181.151, 91.596, 44.313, 162.66500000000002, 140.1635, 140.807, 182.2415, 113.5595, 173.66649999999998, 174.264, 81.91, 61.2365, 150.16649999999998, 148.9545, 141.9635, 199.58999999999997, 69.509, 317.84900000000005, 136.8555, 160.8185, 208.3425, 144.81099999999998, 347.7315, 130.929, 211.2295, 135.72000000000003, 161.2525, 342.343, 304.629, 128.534, 134.23399999999998, 62.5035, 211.225, 241.0095, 160.7825, 156.1905, 146.8755, 53.416, 128.0335, 215.2395, 166.9555, 215.196, 137.7505, 151.02499999999998, 320.8945, 162.818, 128.2425, 184.863, 171.20499999999998, 153.498, 126.77850000000001, 25.491500000000002, 323.1425, 32.326, 211.0475, 37.908, 12.6965, 39.531000000000006, 36.798500000000004, 253.59199999999998, 11.963000000000001, 41.208, 133.177, 194.619, 61.114, 247.8225, 167.81799999999998, 307.3435, 122.021, 116.849, 207.7285, 175.59750000000003, 139.43349999999998, 136.4035, 30.0505, 162.89499999999998, 60.8875, 57.119, 42.486999999999995, 42.285, 82.227, 69.493, 34.179, 41.4735, 34.7, 35.176, 56.7295, 57.792, 71.995, 37.571, 63.9495, 49.101, 0.027, 229.825, 29.957, 33.726, 203.8845, 54.552, 33.803, 43.416, 39.2405, 132.6725, 172.576, 46.146, 195.0815, 59.242999999999995, 42.025999999999996, 36.411, 144.94549999999998, 149.6275, 365.282, 129.51100000000002, 38.575500000000005, 131.43849999999998, 39.430499999999995, 259.424, 55.9945, 77.36449999999999, 143.3505, 64.83500000000001, 60.0385, 37.6385, 87.31450000000001, 135.605, 93.838, 128.453, 146.8155, 58.8805, 223.15, 56.405, 37.049, 133.671, 61.4555, 10.817, 183.445, 63.2775, 146.2495},
+		{345.646, 250.2255, 211.373, 196.248, 86.406, 263.699, 147.023, 222.4865, 200.8195, 231.9895, 261.5475, 281.976, 260.5505, 255.3145, 273.2495, 243.70350000000002, 278.624, 275.9255, 143.975, 226.3205, 229.2995, 223.935, 308.534, 213.763, 212.1275, 195.097, 292.103, 229.2105, 36.521, 199.7295, 247.0145, 272.7405, 225.48149999999998, 298.7885, 309.02049999999997, 149.0165, 280.301, 222.527, 333.91549999999995, 393.483, 241.73250000000002, 221.014, 218.68, 341.7315, 243.122, 82.56700000000001, 65.9105, 290.11749999999995, 338.1375, 215.0655, 300.1255, 250.204, 251.685, 73.7905, 58.447, 384.5455, 257.6535, 36.0945, 216.2615, 241.801, 262.76750000000004, 223.03750000000002, 256.66949999999997, 251.86599999999999, 173.6535, 233.188, 77.707, 207.48700000000002, 336.0375, 220.1655, 261.9875, 250.25549999999998, 240.848, 189.2385, 205.5, 59.327, 270.3085, 251.9105, 345.466, 243.392, 372.7145, 233.8025, 334.90049999999997, 243.69, 132.6485, 54.318, 157.782, 261.77599999999995, 188.5785, 246.0525, 506.8735, 83.001, 230.35649999999998, 196.475, 258.0505, 286.6625, 261.501, 456.6065, 220.089, 204.412, 236.6925, 214.748, 354.138, 250.26, 284.4065, 224.392, 218.4775, 212.2885, 254.5355, 223.989, 76.368, 210.8705, 165.743, 200.8875, 230.4795, 217.56099999999998, 204.715, 100.2195, 225.48899999999998, 286.416, 262.29, 177.5155, 237.962, 70.45949999999999, 217.094, 76.00649999999999, 216.12900000000002, 367.7645, 356.447, 251.9715, 228.671, 263.183, 253.331, 129.728, 292.32349999999997, 212.082, 222.549, 219.673, 234.6695, 233.0195, 284.5565, 205.038, 273.837, 221.779, 363.90250000000003, 293.2605, 229.1515, 206.19650000000001, 360.75350000000003, 318.1065, 229.8, 0.033, 205.1005, 213.309, 333.7715, 315.1715, 220.8485, 208.45, 216.3295, 278.9375, 66.727, 222.1395, 198.55599999999998, 245.495, 218.2385, 270.658, 250.21949999999998, 265.8385, 68.59450000000001, 268.94399999999996, 293.078, 267.169, 217.598, 404.868, 215.0655, 389.9075, 289.73, 279.74649999999997, 363.218, 211.7985, 386.473, 249.8285, 319.59000000000003, 260.932, 205.1315, 224.88400000000001, 356.4795, 204.4345, 206.5025, 299.908, 300.5735, 284.2815, 125.011, 255.69150000000002, 269.5285},
+		{227.79500000000002, 105.8995, 15.1115, 17.308, 228.9425, 16.1725, 274.0535, 33.5315, 20.3325, 132.49099999999999, 97.973, 105.358, 123.32849999999999, 98.1175, 31.537, 108.5495, 32.602000000000004, 6.7475000000000005, 288.523, 154.9075, 34.963499999999996, 19.991500000000002, 20.119999999999997, 11.982, 7.804, 162.793, 21.8865, 39.123000000000005, 183.8675, 157.406, 118.8815, 103.9075, 23.1545, 49.6415, 35.875, 171.98000000000002, 20.2895, 35.04, 177.9215, 251.3825, 121.674, 104.584, 162.024, 31.067, 125.5425, 154.817, 350.159, 40.028999999999996, 307.8945, 40.387, 11.785, 62.518, 76.374, 208.369, 200.4925, 41.4755, 91.082, 341.174, 150.915, 61.7545, 26.622500000000002, 158.4715, 111.89, 114.905, 176.9655, 114.59049999999999, 149.32850000000002, 138.54399999999998, 67.75049999999999, 34.216, 113.84700000000001, 120.082, 123.393, 170.8485, 41.235, 255.715, 107.095, 122.296, 184.245, 112.9545, 327.2095, 118.393, 185.3095, 112.6885, 167.38600000000002, 265.09749999999997, 280.524, 98.69149999999999, 103.702, 52.001000000000005, 189.524, 209.21550000000002, 128.603, 159.514, 122.23, 27.366999999999997, 97.15350000000001, 194.221, 128.6855, 195.81, 114.94049999999999, 118.09700000000001, 295.326, 133.9835, 84.81, 152.7855, 147.748, 125.691, 96.66550000000001, 14.9555, 177.657, 12.649000000000001, 204.4175, 17.722, 30.9825, 24.4195, 7.032500000000001, 238.923, 27.0935, 18.1495, 102.94, 174.84, 53.137, 303.09749999999997, 180.59300000000002, 203.62400000000002, 87.493, 95.5825, 189.83350000000002, 144.96699999999998, 104.866, 109.792, 10.1715, 142.696, 33.879, 26.2315, 13.492, 24.8975, 65.35849999999999, 38.087500000000006, 19.41, 22.67, 17.735, 12.617, 69.45949999999999, 20.03, 44.7945, 30.746499999999997, 62.9525, 37.987, 29.924, 205.106, 0.035, 41.182, 180.8295, 45.179, 16.2855, 15.616, 17.4065, 99.991, 172.579, 20.116999999999997, 174.6635, 72.487, 25.761, 20.261499999999998, 109.836, 132.0005, 238.5615, 91.5425, 16.991500000000002, 90.125, 25.5355, 250.6325, 29.512500000000003, 51.329499999999996, 115.029, 68.239, 40.203, 18.9545, 41.617, 103.4665, 69.358, 110.5295, 118.86500000000001, 42.311, 189.6255, 26.327, 8.591, 120.90549999999999, 32.5105, 40.3945, 180.221, 69.741, 114.8555},
+		{260.9955, 112.8245, 9.787500000000001, 25.9145, 259.6225, 59.708, 301.6065, 37.219, 25.653, 145.93200000000002, 101.953, 103.69, 135.58499999999998, 104.10849999999999, 63.0, 109.20150000000001, 37.994, 14.5555, 320.4215, 173.404, 40.413, 18.001, 34.673, 15.391, 22.109, 166.68349999999998, 47.866, 44.4705, 275.595, 171.445, 144.5285, 108.3745, 31.36, 70.1725, 45.4895, 171.786, 23.9805, 38.001000000000005, 182.89049999999997, 243.81150000000002, 161.03050000000002, 99.774, 165.993, 35.205, 134.0975, 175.372, 276.6365, 75.9445, 320.552, 27.066499999999998, 25.305999999999997, 71.82050000000001, 86.75, 208.61599999999999, 209.3025, 57.1105, 98.23349999999999, 263.19050000000004, 169.106, 70.9435, 76.5315, 172.2, 118.50450000000001, 123.949, 161.557, 124.168, 173.0985, 149.715, 80.63900000000001, 37.760000000000005, 131.317, 124.716, 136.43900000000002, 175.8185, 46.3715, 292.2165, 104.1965, 136.7455, 187.4885, 120.0735, 329.47900000000004, 120.595, 213.1955, 122.3215, 180.62849999999997, 328.2695, 294.83000000000004, 102.19649999999999, 102.0755, 41.606, 176.19600000000003, 241.3275, 137.4065, 144.6145, 125.3545, 47.782, 101.293, 200.6815, 121.138, 202.2705, 129.2045, 130.314, 310.674, 139.732, 91.8745, 154.272, 147.96300000000002, 139.828, 106.202, 9.641, 203.61599999999999, 28.132, 209.875, 18.986, 23.686500000000002, 35.0185, 24.8615, 296.1, 31.264499999999998, 27.1255, 111.7595, 169.28300000000002, 62.5995, 277.358, 150.23649999999998, 303.522, 97.43299999999999, 99.57249999999999, 193.1255, 150.5655, 113.3895, 117.675, 23.691000000000003, 218.517, 43.210499999999996, 26.6865, 30.1475, 33.76049999999999, 78.1675, 30.4435, 28.584, 32.485, 45.47, 9.399999999999999, 59.2385, 36.3035, 48.3905, 25.177, 64.8905, 27.549, 33.671499999999995, 213.34699999999998, 41.1775, 0.036, 189.217, 45.6195, 22.9115, 23.1245, 21.845, 105.725, 183.68, 35.444, 192.4375, 54.893, 32.1695, 30.735, 120.942, 129.577, 267.45349999999996, 98.35849999999999, 55.0245, 97.5615, 23.674500000000002, 253.62650000000002, 42.5045, 54.196, 128.6885, 76.80850000000001, 56.058, 24.1725, 62.846000000000004, 108.56, 85.74600000000001, 117.0265, 130.5285, 40.914500000000004, 197.69650000000001, 37.307, 26.5785, 127.346, 45.447, 75.345, 147.74, 66.576, 125.26},
+		{214.81, 95.345, 190.993, 166.476, 242.618, 162.07150000000001, 351.134, 186.464, 151.2785, 90.35050000000001, 87.91300000000001, 92.298, 60.533, 91.8445, 184.9835, 77.7165, 175.103, 180.6815, 364.2735, 137.606, 204.24450000000002, 176.0655, 183.7865, 188.50150000000002, 169.02249999999998, 138.0565, 185.0075, 205.172, 313.705, 125.025, 90.0455, 82.9505, 177.18349999999998, 197.9275, 192.9095, 345.38, 199.5965, 189.7995, 7.0845, 91.194, 95.07050000000001, 100.4315, 147.54250000000002, 182.3415, 116.7235, 304.0235, 352.5395, 186.661, 275.05449999999996, 185.111, 176.149, 222.92700000000002, 223.99349999999998, 313.9185, 340.75800000000004, 217.8355, 91.7645, 322.171, 122.552, 202.311, 169.9555, 139.95749999999998, 104.0685, 104.1445, 167.2965, 108.489, 338.72749999999996, 118.4665, 219.389, 168.07, 62.0835, 91.531, 110.20949999999999, 141.0065, 164.3195, 294.722, 94.938, 70.888, 93.491, 95.5985, 303.1105, 102.92949999999999, 138.44549999999998, 77.1185, 298.6385, 294.0665, 264.5125, 94.4045, 234.41899999999998, 228.594, 322.081, 234.8515, 102.8605, 111.7465, 92.1455, 185.591, 89.1045, 331.197, 267.9645, 177.746, 102.84899999999999, 94.907, 258.868, 99.04400000000001, 98.909, 124.19149999999999, 112.2205, 100.44200000000001, 87.60849999999999, 190.588, 388.66150000000005, 186.997, 177.5485, 171.164, 215.4135, 168.6475, 167.643, 248.2125, 193.05700000000002, 171.54950000000002, 93.241, 152.06900000000002, 198.4035, 302.54499999999996, 144.57100000000003, 340.057, 95.125, 251.3315, 325.3105, 125.616, 103.78, 92.26650000000001, 165.153, 335.24199999999996, 164.138, 177.011, 178.966, 170.38299999999998, 222.2065, 200.57999999999998, 172.52550000000002, 168.5245, 162.433, 186.325, 212.1905, 194.7, 211.594, 156.5605, 207.4905, 196.37650000000002, 202.8725, 332.9095, 180.4325, 187.6035, 0.0525, 221.7095, 171.5605, 175.855, 177.1255, 87.916, 309.08349999999996, 160.7515, 152.472, 227.221, 169.29500000000002, 177.6885, 103.0995, 94.461, 330.9385, 101.44149999999999, 168.3265, 98.49549999999999, 180.8095, 198.06799999999998, 169.66899999999998, 194.122, 256.8675, 244.6305, 198.9235, 174.9115, 217.962, 87.0935, 215.432, 98.708, 98.9385, 166.94549999999998, 124.32249999999999, 159.4525, 175.6335, 260.21900000000005, 165.6175, 189.186, 353.38599999999997, 237.7195, 109.1045},
+		{263.7175, 140.95850000000002, 26.2685, 54.0205, 312.534, 50.715500000000006, 310.7735, 62.4265, 56.029, 171.8535, 124.343, 124.9765, 158.6415, 135.29399999999998, 73.89, 147.25799999999998, 67.57900000000001, 31.811, 322.5895, 205.0845, 71.89750000000001, 45.4905, 49.5135, 43.962, 43.185500000000005, 198.17000000000002, 39.186499999999995, 48.284499999999994, 196.644, 193.981, 166.15550000000002, 131.147, 58.955, 89.98849999999999, 66.4505, 261.56550000000004, 37.701499999999996, 66.6755, 214.971, 289.29650000000004, 166.78449999999998, 129.3505, 201.2265, 69.047, 162.343, 186.776, 279.2965, 73.936, 351.804, 67.8655, 48.8645, 99.4365, 114.32, 247.9775, 377.07349999999997, 48.911, 126.17, 267.231, 192.031, 98.15700000000001, 62.78, 203.46050000000002, 147.3945, 147.504, 208.279, 143.649, 185.99450000000002, 193.4795, 107.48849999999999, 66.6935, 156.1765, 158.1725, 158.40050000000002, 201.4835, 69.182, 322.9285, 139.6275, 163.025, 200.067, 149.7145, 361.6305, 153.97050000000002, 222.46800000000002, 153.96550000000002, 172.721, 347.236, 327.181, 125.8605, 129.6735, 9.0775, 231.129, 280.291, 169.736, 176.21300000000002, 150.622, 147.1955, 125.096, 230.03300000000002, 166.082, 227.63049999999998, 150.608, 161.3685, 338.406, 177.8875, 120.2895, 204.152, 177.0805, 158.176, 127.793, 26.1065, 216.708, 41.293, 237.0565, 48.169, 14.1785, 59.131, 46.688, 327.188, 45.5805, 48.700500000000005, 138.753, 218.988, 64.283, 357.5585, 174.4835, 251.12, 124.4425, 136.194, 222.1735, 181.841, 123.4195, 146.5525, 64.2855, 168.878, 66.672, 48.6175, 47.602999999999994, 61.9715, 104.3725, 14.8305, 52.7325, 54.318, 51.3765, 38.149, 77.32, 54.397999999999996, 68.7375, 58.19, 74.63, 66.9195, 54.5095, 315.006, 45.150999999999996, 45.624, 222.7125, 0.036, 51.3405, 51.453500000000005, 50.162, 130.782, 211.7745, 55.643, 201.9855, 36.75, 60.7305, 51.827, 152.551, 154.7575, 370.62, 127.714, 55.642, 126.656, 45.207, 263.1275, 66.95, 76.8895, 152.0505, 53.144, 76.4305, 58.491, 91.417, 134.557, 108.06649999999999, 135.73250000000002, 171.9445, 70.735, 228.869, 65.32249999999999, 47.205, 157.75150000000002, 69.414, 19.519, 234.114, 87.458, 157.78300000000002},
+		{208.5315, 93.403, 24.626, 15.167, 220.4355, 6.993, 287.3565, 24.16, 9.0845, 121.596, 75.8365, 79.6005, 111.35050000000001, 99.929, 44.1655, 95.4465, 16.612499999999997, 22.7085, 277.0085, 146.8205, 27.895, 14.192, 23.817500000000003, 24.9765, 15.6755, 137.526, 24.553, 45.6555, 174.7335, 145.646, 109.61500000000001, 84.1275, 9.371, 47.8835, 30.9685, 214.301, 33.7805, 29.275, 166.61149999999998, 244.33100000000002, 112.483, 84.3015, 149.94799999999998, 30.0325, 112.0615, 150.483, 172.281, 46.313, 291.072, 38.1905, 13.39, 64.9445, 71.7865, 222.178, 276.832, 48.8945, 76.999, 188.87650000000002, 144.6, 51.172, 20.874000000000002, 146.221, 92.1605, 90.8755, 154.773, 83.08850000000001, 151.9635, 123.6265, 64.35249999999999, 17.6095, 107.6135, 106.6515, 108.2985, 154.8305, 33.2785, 270.91999999999996, 81.471, 111.03450000000001, 163.584, 89.65, 314.61400000000003, 102.793, 170.39499999999998, 104.5485, 135.36, 259.1025, 274.124, 82.4255, 84.705, 55.480000000000004, 183.77550000000002, 222.746, 116.911, 121.997, 115.1345, 22.28, 78.235, 170.2295, 144.845, 182.33100000000002, 98.5855, 108.207, 319.1195, 116.6985, 70.747, 145.00549999999998, 126.9065, 105.7265, 79.27950000000001, 24.613500000000002, 184.059, 25.2945, 180.043, 6.9565, 36.204, 10.285499999999999, 12.856, 254.0695, 34.3525, 24.701500000000003, 96.28800000000001, 142.2425, 49.325, 262.73900000000003, 122.33500000000001, 217.0345, 73.9355, 97.4115, 169.0285, 133.77749999999997, 78.7975, 97.1755, 19.4665, 137.918, 17.9605, 21.316499999999998, 21.055500000000002, 13.506, 60.5445, 46.223, 5.1080000000000005, 7.834, 4.1635, 21.401, 69.23599999999999, 29.930999999999997, 45.497, 8.478, 74.5915, 33.7965, 33.7065, 220.61, 16.1025, 22.813000000000002, 172.1895, 51.293, 0.0465, 1.7694999999999999, 3.6265, 78.66, 148.79950000000002, 7.512499999999999, 148.871, 63.553, 12.154499999999999, 5.8285, 103.38749999999999, 110.7075, 235.317, 77.1165, 24.728, 76.437, 20.1435, 209.915, 26.3265, 45.196, 117.7835, 77.077, 42.92, 14.1675, 53.4135, 93.62, 66.681, 87.2585, 102.5095, 21.6285, 186.489, 12.4815, 14.134, 104.56299999999999, 23.434, 31.555, 144.39, 76.9505, 105.43},
+		{199.0595, 96.658, 24.991500000000002, 15.668, 230.852, 3.0515, 277.1275, 22.5405, 8.8605, 126.3195, 75.77250000000001, 87.1255, 111.25550000000001, 97.158, 34.638999999999996, 99.1825, 17.7885, 20.572000000000003, 275.5265, 153.327, 36.739000000000004, 15.4035, 22.246, 35.727, 8.669, 140.5815, 23.5265, 44.488, 176.128, 147.3195, 107.6695, 83.011, 9.596499999999999, 47.6255, 29.98, 151.299, 26.283, 34.67, 169.38549999999998, 242.5315, 112.93549999999999, 91.1445, 156.638, 21.686, 115.75999999999999, 137.74, 254.24099999999999, 43.63849999999999, 299.92449999999997, 55.0845, 11.3575, 61.684, 74.7485, 186.39600000000002, 326.909, 49.352000000000004, 77.46199999999999, 251.6095, 145.0445, 50.434, 18.206, 152.696, 88.58850000000001, 88.66499999999999, 155.729, 91.3815, 152.2865, 123.455, 65.7085, 16.887500000000003, 108.279, 100.33449999999999, 106.551, 144.86, 32.068, 279.0535, 79.793, 119.2435, 174.1805, 89.97, 304.327, 102.107, 174.06349999999998, 102.199, 143.505, 301.328, 285.7215, 83.2535, 103.4725, 58.625, 180.03, 220.742, 111.0705, 123.29050000000001, 115.779, 21.200000000000003, 76.815, 180.29399999999998, 119.5135, 183.358, 106.368, 105.995, 285.2015, 122.102, 71.08449999999999, 145.447, 125.3155, 113.5305, 79.6695, 24.9955, 270.8375, 33.982, 188.06650000000002, 6.5145, 37.551500000000004, 9.923, 15.714, 275.1685, 37.192, 21.6755, 94.92150000000001, 164.67849999999999, 51.286, 304.812, 117.1635, 209.046, 76.8985, 86.513, 173.096, 133.96050000000002, 73.65700000000001, 87.703, 17.5435, 136.836, 18.13, 21.1535, 17.1585, 13.791, 66.674, 47.808, 4.775, 5.9384999999999994, 3.5949999999999998, 27.932, 55.5975, 30.476, 47.6355, 7.409, 57.4945, 34.3515, 43.42, 208.471, 15.592500000000001, 23.1865, 175.122, 51.4775, 1.8165, 0.082, 2.7445, 83.7995, 142.266, 6.8675, 148.0765, 60.092, 11.2225, 4.993, 98.873, 108.18450000000001, 248.79649999999998, 77.5245, 22.307, 76.729, 21.4865, 208.45850000000002, 38.415499999999994, 45.291, 119.12950000000001, 80.485, 41.7745, 12.188500000000001, 47.6355, 93.60749999999999, 66.6365, 88.6635, 113.1305, 28.5655, 179.81650000000002, 16.4525, 20.500500000000002, 103.25200000000001, 28.044, 32.6175, 203.6955, 83.453, 105.485},
+		{155.002, 95.695, 23.617, 9.7955, 250.32999999999998, 2.4379999999999997, 284.92949999999996, 24.8235, 5.958, 117.851, 75.5565, 92.8395, 110.43100000000001, 95.443, 33.1065, 96.464, 18.546999999999997, 23.9585, 289.433, 156.935, 30.5325, 12.8155, 26.621499999999997, 35.2295, 13.772, 137.147, 26.887, 38.909, 176.747, 149.747, 106.388, 80.964, 10.309999999999999, 43.5315, 27.0175, 200.821, 30.794, 40.0055, 167.465, 240.708, 133.129, 84.3455, 165.2105, 16.448, 103.7955, 132.1945, 264.62350000000004, 40.370000000000005, 303.2715, 38.171, 8.7905, 61.962, 72.0075, 186.392, 280.6585, 49.305, 77.886, 254.44549999999998, 145.957, 47.545, 18.469, 148.01999999999998, 96.203, 91.5635, 146.2465, 93.1045, 174.3965, 126.6945, 63.031, 15.521, 108.96199999999999, 110.82050000000001, 105.7625, 153.4775, 33.476, 273.3005, 90.106, 107.969, 150.8475, 99.87299999999999, 312.22400000000005, 100.2715, 192.71300000000002, 99.1455, 181.06349999999998, 250.9125, 290.543, 83.714, 89.59299999999999, 57.468, 180.25400000000002, 229.3545, 120.134, 114.7255, 114.3885, 35.088499999999996, 96.761, 166.7045, 120.732, 184.88, 99.243, 99.12100000000001, 288.982, 120.969, 71.76599999999999, 136.531, 117.6455, 110.6285, 78.457, 23.5945, 207.27499999999998, 24.3855, 179.981, 4.7805, 33.7485, 7.919499999999999, 10.2755, 272.456, 38.019999999999996, 23.773, 91.4515, 137.962, 34.8915, 242.6945, 116.596, 218.0585, 72.761, 100.6725, 181.48149999999998, 132.469, 78.101, 97.566, 13.794, 202.6695, 16.0655, 22.4895, 21.275, 10.9815, 54.3865, 56.555, 2.235, 4.5355, 2.622, 22.09, 54.6145, 28.869, 41.674499999999995, 6.3795, 57.229, 29.250999999999998, 39.232, 216.37849999999997, 17.436, 21.881500000000003, 177.548, 50.2235, 3.717, 2.9025, 0.041, 81.63300000000001, 134.0385, 6.429, 158.919, 62.474000000000004, 9.926, 3.928, 107.725, 102.423, 243.21699999999998, 78.08449999999999, 24.4985, 77.333, 14.513, 202.6225, 23.9225, 39.4575, 119.2775, 77.4555, 40.435, 11.487, 43.336, 87.2045, 67.588, 102.813, 100.48150000000001, 26.991500000000002, 179.48450000000003, 11.079, 11.212499999999999, 102.08500000000001, 19.233, 27.0485, 179.237, 90.7795, 105.1745},
+		{147.8355, 14.779, 101.837, 80.4085, 178.3485, 87.0635, 221.63400000000001, 103.85849999999999, 73.27799999999999, 43.0775, 4.314, 11.7375, 34.156499999999994, 10.644, 106.8445, 19.078, 91.113, 107.132, 216.24, 71.297, 102.509, 91.4375, 100.9325, 118.711, 91.6335, 69.9555, 103.495, 130.893, 243.8765, 67.859, 42.4585, 13.99, 82.69749999999999, 114.0505, 103.251, 222.7015, 104.3245, 106.102, 87.70750000000001, 169.1115, 49.761, 11.692499999999999, 69.1665, 85.8445, 33.545, 238.4255, 284.6865, 113.451, 214.9725, 121.103, 89.9105, 129.4225, 135.1055, 273.1355, 262.264, 124.4755, 5.7135, 224.553, 67.975, 110.71449999999999, 80.52799999999999, 75.4815, 21.503999999999998, 22.496499999999997, 73.94149999999999, 19.206000000000003, 224.0615, 53.264, 138.723, 83.868, 36.789, 28.112, 33.372, 75.561, 99.93350000000001, 191.47750000000002, 13.306999999999999, 41.335499999999996, 72.10650000000001, 21.7915, 226.596, 27.3935, 96.2045, 23.1375, 196.514, 225.834, 202.71300000000002, 4.3315, 150.2375, 138.1405, 233.42649999999998, 176.597, 47.766000000000005, 46.7245, 29.3985, 105.223, 4.1495, 232.6605, 178.3825, 100.2705, 24.993, 32.661, 220.5595, 50.48, 10.91, 67.7675, 47.400999999999996, 33.198, 8.2495, 100.7495, 253.55599999999998, 102.3325, 107.8315, 92.0735, 124.695, 72.539, 100.201, 183.0975, 118.461, 88.79599999999999, 16.485999999999997, 69.89150000000001, 105.2865, 214.6705, 44.242999999999995, 259.713, 3.974, 166.495, 239.47050000000002, 56.6695, 14.0745, 17.872, 88.167, 211.2615, 83.63, 96.74000000000001, 100.3335, 76.937, 146.1775, 122.769, 84.107, 85.7955, 82.9975, 110.62299999999999, 135.07150000000001, 102.75999999999999, 118.4615, 73.8635, 134.841, 109.666, 132.652, 278.93600000000004, 99.997, 105.737, 88.75, 130.771, 78.8185, 83.88, 81.662, 0.049, 241.42950000000002, 86.169, 78.32, 136.92149999999998, 75.13900000000001, 80.563, 25.0955, 37.774, 245.71, 10.398, 100.167, 13.6455, 100.4435, 143.789, 96.063, 116.3175, 211.60000000000002, 161.07049999999998, 120.9805, 85.84899999999999, 122.74600000000001, 14.068, 136.3505, 16.737000000000002, 34.0045, 87.8205, 105.4705, 75.749, 102.4405, 170.54149999999998, 83.34299999999999, 110.451, 194.1135, 158.631, 32.205},
+		{360.7715, 214.209, 137.812, 138.78699999999998, 244.846, 142.78300000000002, 154.46699999999998, 166.62, 148.782, 238.7735, 213.12099999999998, 241.8765, 235.704, 225.8025, 183.696, 242.97449999999998, 161.4805, 155.927, 158.4515, 274.2545, 176.164, 159.606, 205.7425, 162.30349999999999, 158.7755, 237.695, 166.787, 160.79250000000002, 45.4675, 215.5385, 238.636, 217.799, 154.124, 188.466, 179.5215, 37.11, 159.598, 173.3415, 305.3625, 342.0585, 254.71, 223.849, 258.86800000000005, 144.2705, 265.249, 14.2415, 49.6015, 153.54399999999998, 359.06399999999996, 163.666, 157.13850000000002, 171.48000000000002, 181.108, 66.9485, 219.352, 163.22899999999998, 262.7815, 73.30449999999999, 212.9685, 178.344, 153.6985, 214.253, 259.5535, 222.77, 248.8705, 249.8875, 9.1405, 291.67650000000003, 201.05900000000003, 156.421, 265.39300000000003, 246.692, 245.3085, 245.401, 156.518, 94.891, 245.45999999999998, 261.4905, 275.93949999999995, 257.069, 398.6855, 230.913, 297.72950000000003, 248.6005, 24.2085, 83.321, 223.707, 207.907, 157.6055, 185.621, 307.14649999999995, 108.7005, 251.9435, 273.52549999999997, 241.36399999999998, 173.171, 215.89749999999998, 313.5525, 241.67700000000002, 284.5575, 240.2785, 245.668, 338.1465, 242.8915, 278.2425, 262.863, 249.456, 236.25349999999997, 211.832, 149.7955, 72.581, 173.717, 282.07, 142.515, 175.92399999999998, 151.67000000000002, 153.828, 127.7285, 181.8995, 123.147, 269.8125, 267.81449999999995, 184.2605, 91.1955, 272.6685, 84.5075, 213.21050000000002, 225.397, 311.418, 261.146, 221.7955, 268.6365, 136.9845, 138.45600000000002, 247.332, 171.949, 158.731, 155.76850000000002, 169.4975, 193.8125, 139.68099999999998, 146.4985, 135.676, 163.08800000000002, 196.35750000000002, 153.594, 174.34, 152.55349999999999, 205.353, 154.99599999999998, 172.505, 66.3295, 172.637, 183.719, 309.94, 212.14999999999998, 149.04899999999998, 141.7545, 134.0595, 241.333, 0.047, 159.699, 276.07399999999996, 197.3425, 149.571, 149.0795, 239.7645, 255.13400000000001, 251.712, 246.26600000000002, 171.461, 230.1755, 149.5575, 357.847, 154.049, 133.9805, 333.83799999999997, 204.639, 288.98249999999996, 141.889, 184.087, 229.4475, 182.53699999999998, 214.8555, 233.91750000000002, 160.4515, 332.03200000000004, 140.6975, 154.461, 241.61200000000002, 197.5125, 177.0045, 17.4315, 279.218, 277.596},
+		{181.679, 92.281, 27.954500000000003, 10.9195, 218.89749999999998, 5.824, 270.49649999999997, 25.5775, 12.321, 118.1035, 83.6385, 83.78800000000001, 109.49549999999999, 87.30250000000001, 32.924, 97.51050000000001, 17.5315, 24.240499999999997, 269.9345, 136.936, 29.179000000000002, 17.5335, 20.519, 28.064, 12.6905, 141.656, 37.819, 47.766000000000005, 164.048, 146.716, 115.213, 89.5805, 14.429, 44.056, 29.9935, 155.1535, 32.794, 27.908, 157.2215, 223.801, 122.2715, 90.173, 138.1385, 13.824, 106.952, 161.39, 171.633, 35.245000000000005, 292.15, 39.8935, 11.395499999999998, 65.5085, 78.846, 182.12849999999997, 274.868, 54.7375, 81.8835, 244.5565, 144.9635, 52.571, 14.469000000000001, 136.3305, 100.35499999999999, 98.1185, 137.7985, 100.6895, 142.13150000000002, 131.69400000000002, 70.4105, 21.539, 107.9645, 105.822, 110.10249999999999, 155.6535, 28.6645, 239.054, 87.79050000000001, 111.229, 151.1205, 104.14, 315.779, 101.219, 175.448, 102.1435, 179.212, 249.662, 271.7085, 82.5935, 124.0635, 55.209500000000006, 175.81349999999998, 223.45499999999998, 126.84, 118.501, 116.26650000000001, 23.2795, 83.952, 165.8025, 128.88, 166.981, 96.60749999999999, 107.95849999999999, 294.664, 128.911, 76.1575, 145.6015, 125.14349999999999, 110.30799999999999, 81.952, 27.9175, 173.6925, 28.21, 183.63150000000002, 9.336500000000001, 39.356, 12.3325, 13.5135, 236.25599999999997, 36.711, 21.6025, 88.851, 144.066, 31.506, 228.471, 117.80199999999999, 198.53050000000002, 80.65, 100.608, 165.7985, 148.96699999999998, 87.0925, 98.94049999999999, 13.8025, 213.74349999999998, 19.256, 23.1025, 20.2445, 15.519, 80.228, 52.9955, 6.6205, 8.48, 6.721, 28.8765, 52.0735, 30.204500000000003, 39.348, 9.9055, 50.6395, 33.02, 46.1445, 222.153, 20.131500000000003, 35.477999999999994, 160.82850000000002, 55.644000000000005, 7.606, 6.8815, 6.4115, 86.14250000000001, 159.671, 0.1325, 162.5895, 56.659, 19.604, 7.769, 100.235, 116.783, 195.852, 88.44800000000001, 19.1875, 81.601, 20.99, 200.976, 22.162, 43.421, 109.977, 73.60149999999999, 45.188, 11.827, 51.0995, 92.689, 67.06, 96.757, 105.6875, 21.344, 178.638, 14.8485, 14.961500000000001, 112.386, 30.017000000000003, 37.3, 211.4075, 81.79400000000001, 107.37100000000001},
+		{156.313, 76.577, 175.7305, 151.482, 125.3865, 158.91, 312.55949999999996, 188.2455, 157.94400000000002, 49.659, 87.4675, 90.14500000000001, 86.1465, 91.2105, 179.601, 89.0675, 151.34699999999998, 171.012, 313.4825, 41.1555, 189.635, 164.3955, 178.67849999999999, 183.1635, 163.235, 21.404, 182.42700000000002, 197.615, 204.839, 20.2395, 63.456500000000005, 82.131, 155.373, 176.32850000000002, 186.642, 283.44550000000004, 187.297, 197.603, 143.1585, 193.10500000000002, 68.26050000000001, 94.2555, 41.8475, 173.41649999999998, 53.661, 261.96799999999996, 212.54, 183.97750000000002, 187.402, 207.06349999999998, 168.17399999999998, 202.058, 207.7165, 217.1055, 233.674, 206.0495, 79.42500000000001, 173.86849999999998, 29.561, 207.76850000000002, 155.5405, 26.368499999999997, 97.30850000000001, 65.42099999999999, 30.9755, 96.26599999999999, 253.7275, 33.8405, 213.7605, 155.858, 88.7575, 54.166, 69.155, 19.679, 162.961, 141.496, 94.9365, 89.87899999999999, 140.00799999999998, 58.3725, 197.483, 70.20949999999999, 167.4235, 73.15100000000001, 278.613, 185.2645, 156.756, 88.8475, 241.6015, 219.20850000000002, 321.899, 119.4665, 55.3775, 55.7565, 93.7705, 194.066, 85.81649999999999, 308.294, 262.6825, 103.1605, 86.004, 80.321, 173.67950000000002, 69.183, 81.253, 46.6595, 53.329, 53.163, 86.1695, 180.8945, 231.9235, 177.67849999999999, 67.731, 161.7865, 178.11, 141.749, 172.4615, 134.10950000000003, 198.257, 172.5735, 95.116, 55.6505, 187.99599999999998, 179.57999999999998, 78.9645, 229.518, 89.07900000000001, 250.63049999999998, 308.25350000000003, 75.77, 92.175, 69.009, 163.81799999999998, 306.1445, 154.83749999999998, 179.748, 166.5115, 144.386, 194.5645, 207.77249999999998, 155.89100000000002, 165.6655, 167.824, 187.269, 198.903, 172.7525, 203.595, 162.745, 207.2945, 197.281, 195.0625, 198.527, 174.6495, 192.4235, 153.7775, 201.90550000000002, 148.85950000000003, 148.04000000000002, 158.8725, 78.31649999999999, 276.2345, 162.5555, 0.034, 210.88600000000002, 143.8375, 168.9595, 60.1125, 91.34049999999999, 263.124, 69.364, 179.188, 80.937, 179.50150000000002, 201.909, 173.53199999999998, 206.24450000000002, 289.1015, 256.09799999999996, 201.8735, 167.53, 205.10750000000002, 80.11500000000001, 208.2105, 100.8105, 65.63550000000001, 153.964, 154.5, 158.382, 170.3945, 256.404, 163.101, 180.88549999999998, 240.0895, 251.857, 91.0415},
+		{267.7595, 152.058, 30.4815, 57.6155, 292.899, 54.806, 321.076, 74.30250000000001, 64.161, 172.8605, 131.98899999999998, 129.2015, 169.89749999999998, 138.464, 82.035, 151.4925, 78.421, 37.947, 337.523, 209.3865, 73.51050000000001, 56.4705, 67.1155, 63.7965, 54.242000000000004, 197.8145, 43.207, 50.286, 231.1375, 200.5255, 166.385, 142.078, 66.0475, 95.47200000000001, 86.9855, 239.0315, 54.685, 70.6335, 209.56, 292.6335, 170.846, 133.31799999999998, 203.0595, 74.3335, 174.309, 217.4275, 243.6345, 75.4505, 363.8105, 72.705, 52.653000000000006, 110.856, 128.6645, 230.74200000000002, 321.926, 11.905999999999999, 138.7735, 231.9005, 205.5755, 109.97399999999999, 67.24000000000001, 205.9345, 151.8935, 159.1725, 219.29250000000002, 150.7005, 202.93900000000002, 172.31799999999998, 75.14500000000001, 72.842, 161.155, 164.29500000000002, 167.4395, 214.3445, 83.4325, 328.07349999999997, 145.36, 174.57999999999998, 207.1405, 153.159, 364.65250000000003, 157.612, 231.97050000000002, 157.095, 181.637, 351.789, 312.95950000000005, 127.439, 132.216, 36.841, 215.59300000000002, 297.501, 173.978, 196.8245, 167.6615, 58.8815, 135.594, 232.58550000000002, 173.3895, 240.92149999999998, 158.2215, 177.97250000000003, 342.7145, 179.4475, 132.5755, 194.67899999999997, 172.7575, 162.916, 135.137, 30.316, 234.449, 63.756, 250.30450000000002, 54.2245, 19.709000000000003, 63.0855, 48.022999999999996, 320.818, 47.445499999999996, 62.08, 151.302, 218.7635, 72.089, 348.866, 195.5365, 332.146, 130.446, 152.786, 216.8025, 187.4185, 135.149, 152.7715, 65.7375, 173.09949999999998, 66.18350000000001, 59.908500000000004, 55.331, 66.719, 115.7695, 29.746499999999997, 56.6725, 70.3765, 65.122, 66.59049999999999, 17.9165, 58.2405, 78.7345, 74.30199999999999, 15.8795, 90.054, 59.18, 245.3935, 72.4255, 54.837, 227.2345, 36.716499999999996, 63.673, 59.9845, 62.391000000000005, 136.877, 197.3705, 56.6025, 210.792, 0.034, 65.211, 69.0655, 153.82850000000002, 161.63150000000002, 304.26300000000003, 131.5385, 78.5195, 130.60399999999998, 49.256, 258.4435, 81.851, 78.862, 156.601, 69.034, 84.9185, 60.1935, 51.0755, 147.869, 116.2775, 153.411, 167.35500000000002, 76.096, 241.2585, 75.274, 66.70949999999999, 161.85500000000002, 77.299, 26.847, 221.39350000000002, 54.941500000000005, 160.67849999999999},
+		{178.4745, 89.476, 33.927499999999995, 12.0475, 238.5665, 12.456, 281.11350000000004, 35.1605, 4.579000000000001, 110.249, 71.4975, 81.481, 107.783, 77.307, 28.137999999999998, 94.22, 14.0775, 29.5035, 300.794, 143.709, 40.3805, 25.8365, 27.919, 33.3875, 19.497999999999998, 134.031, 33.295500000000004, 57.584500000000006, 181.47500000000002, 142.428, 102.648, 80.4985, 14.6755, 50.9855, 36.4465, 209.7875, 34.547, 42.262, 164.329, 239.4615, 107.017, 83.1095, 145.96, 17.3775, 100.1325, 153.675, 337.91499999999996, 46.8195, 288.9355, 56.406499999999994, 17.3335, 60.8875, 71.362, 180.5165, 269.5835, 55.86, 73.637, 293.47749999999996, 141.36700000000002, 41.5955, 10.693, 136.453, 94.158, 88.1445, 145.434, 90.096, 151.5655, 120.005, 61.851, 14.968499999999999, 106.275, 95.5755, 102.5535, 142.47449999999998, 28.3475, 258.8205, 76.162, 104.82, 141.313, 86.29050000000001, 298.5345, 97.94800000000001, 182.76049999999998, 92.091, 129.15949999999998, 301.14099999999996, 267.63300000000004, 72.8135, 81.798, 68.087, 182.7, 229.5495, 107.6035, 110.6075, 101.24950000000001, 38.2445, 72.2105, 177.5375, 125.3195, 172.5245, 94.2365, 97.291, 275.5005, 117.3945, 67.3385, 132.319, 122.7225, 101.964, 75.35149999999999, 33.935, 165.38, 33.554, 175.07150000000001, 15.396999999999998, 45.156000000000006, 5.125, 21.1905, 254.0165, 38.379, 20.805500000000002, 85.9325, 138.4855, 44.43, 238.5025, 115.899, 282.822, 70.21549999999999, 92.898, 167.96, 130.47449999999998, 74.398, 84.0265, 17.807000000000002, 211.27249999999998, 13.5745, 24.0975, 28.329500000000003, 8.8795, 64.174, 52.5985, 13.432, 13.6355, 9.657499999999999, 42.5245, 69.5815, 37.141000000000005, 51.2095, 4.1259999999999994, 61.322, 34.547, 42.022999999999996, 218.26749999999998, 25.723, 32.162, 169.9845, 60.6645, 12.207, 11.136, 9.892, 75.18350000000001, 149.60199999999998, 19.5685, 143.833, 65.21000000000001, 0.019, 12.972999999999999, 97.4805, 98.73849999999999, 180.9925, 73.836, 28.185, 73.003, 30.445500000000003, 200.0965, 25.5335, 42.0515, 100.263, 81.7115, 50.3575, 14.8605, 44.483000000000004, 85.23750000000001, 64.4515, 85.545, 99.893, 16.8235, 183.4805, 7.809, 23.4705, 99.63, 21.890500000000003, 40.9885, 186.97, 77.887, 97.651},
+		{168.513, 96.9045, 24.09, 15.507000000000001, 226.4495, 4.5215, 288.645, 26.222, 8.718, 114.45849999999999, 81.19, 84.0815, 110.235, 86.146, 36.0955, 94.214, 21.924, 24.386, 305.291, 142.19650000000001, 31.75, 16.6355, 24.6375, 24.999, 15.404, 138.902, 42.6295, 39.997, 170.269, 141.3435, 108.3495, 83.90549999999999, 12.665500000000002, 47.1135, 29.5275, 158.127, 26.698, 42.9065, 167.79399999999998, 239.7545, 120.692, 88.194, 151.8965, 23.804499999999997, 108.9505, 152.546, 175.334, 41.0835, 297.749, 40.647, 10.972999999999999, 69.7315, 84.2055, 197.5505, 264.988, 45.8485, 79.448, 192.769, 138.2955, 49.1345, 14.847000000000001, 147.151, 102.0565, 93.84049999999999, 136.4075, 95.06, 141.036, 138.80849999999998, 70.45949999999999, 20.6905, 101.627, 119.7625, 108.489, 148.6065, 33.197, 239.986, 97.191, 108.0285, 174.232, 102.46600000000001, 402.04150000000004, 104.149, 180.346, 96.57650000000001, 126.1945, 250.8065, 280.478, 78.686, 107.205, 59.345, 155.42950000000002, 238.3025, 120.988, 121.981, 107.406, 23.6645, 79.0095, 184.40949999999998, 111.444, 166.284, 100.8475, 100.618, 285.3465, 118.07499999999999, 79.469, 142.449, 118.9345, 114.81800000000001, 82.5675, 23.9775, 194.371, 25.77, 181.434, 7.835, 42.337999999999994, 10.0705, 12.036999999999999, 261.772, 34.4475, 19.11, 94.2795, 141.81, 31.3845, 248.9265, 118.517, 279.1575, 82.268, 95.346, 184.147, 134.8165, 84.4515, 104.77199999999999, 18.6725, 198.44299999999998, 20.6535, 18.838, 19.008, 15.816500000000001, 69.4205, 47.332, 5.5, 8.2705, 4.298, 29.356499999999997, 53.705, 27.625, 42.171499999999995, 8.655999999999999, 61.414500000000004, 30.9265, 36.43899999999999, 271.517, 20.163, 30.962, 178.039, 51.816500000000005, 5.7645, 5.064, 3.7805, 80.54650000000001, 148.769, 7.7115, 169.3545, 69.154, 13.0155, 0.02, 103.4315, 111.17, 261.244, 89.749, 27.060000000000002, 81.5985, 18.8285, 204.877, 32.724500000000006, 45.876999999999995, 235.12349999999998, 86.1455, 43.198499999999996, 13.372, 44.5085, 89.631, 72.82050000000001, 91.02600000000001, 104.684, 37.9735, 183.50900000000001, 14.0765, 14.5425, 104.3965, 28.468, 31.1055, 146.643, 85.8945, 104.36949999999999},
+		{145.8195, 18.183500000000002, 124.13300000000001, 98.561, 138.543, 105.105, 286.16150000000005, 175.213, 89.793, 45.199, 88.511, 27.46, 44.254000000000005, 23.195999999999998, 117.14099999999999, 29.3575, 116.527, 122.131, 260.082, 66.994, 120.166, 113.6725, 119.255, 115.968, 104.8955, 53.467, 128.478, 158.3065, 208.52800000000002, 80.928, 44.9495, 25.03, 101.2695, 129.9035, 122.886, 249.0005, 128.48149999999998, 139.3145, 102.2175, 169.899, 66.0715, 21.587, 76.40950000000001, 115.1765, 65.537, 254.428, 261.01300000000003, 124.3845, 227.984, 187.954, 109.414, 156.166, 153.3945, 250.13, 212.32150000000001, 138.892, 27.3285, 213.11599999999999, 84.565, 202.832, 96.035, 57.2795, 23.9065, 14.438, 48.6385, 40.9705, 237.774, 56.951, 152.7055, 100.97149999999999, 39.951, 10.122, 32.235, 85.67750000000001, 110.769, 179.5765, 27.337, 39.333, 98.34, 17.697499999999998, 222.335, 19.726, 103.7375, 36.376000000000005, 242.7835, 228.27999999999997, 189.6225, 21.250500000000002, 190.786, 159.538, 270.0395, 157.6975, 52.169, 27.554499999999997, 30.625500000000002, 187.1055, 23.586, 252.36, 202.137, 98.286, 21.125, 14.843499999999999, 213.64249999999998, 45.3765, 26.4645, 60.745, 39.4775, 27.503, 28.173000000000002, 123.813, 250.64, 114.9685, 99.68700000000001, 106.898, 145.24450000000002, 94.2405, 106.102, 153.4205, 132.80849999999998, 110.21000000000001, 28.055, 53.5795, 130.6835, 215.391, 38.403, 250.131, 22.798000000000002, 195.1395, 258.2575, 62.2515, 32.169, 10.579, 114.69999999999999, 273.5325, 100.0485, 111.7265, 119.3305, 93.989, 163.45350000000002, 136.15, 108.55250000000001, 102.4705, 103.583, 120.12100000000001, 147.7995, 123.89599999999999, 137.342, 89.94149999999999, 163.1185, 127.9015, 144.8905, 250.1605, 109.78800000000001, 120.9025, 104.4585, 152.511, 103.461, 98.7655, 107.65, 24.9985, 239.6875, 100.184, 60.089, 153.8585, 97.4685, 103.3555, 0.018, 14.277, 235.399, 25.3495, 132.328, 25.771, 118.9115, 155.71800000000002, 115.116, 134.84949999999998, 297.9705, 247.5915, 141.1975, 162.94549999999998, 145.9645, 25.469, 152.534, 31.9085, 15.596, 111.669, 108.54400000000001, 97.448, 107.756, 193.9625, 104.23750000000001, 128.5975, 240.8535, 176.29, 20.896},
+		{153.90550000000002, 41.954499999999996, 129.368, 101.2145, 148.519, 102.59649999999999, 313.9355, 205.05349999999999, 104.9555, 46.581500000000005, 101.66300000000001, 41.2235, 59.533, 23.1265, 119.15100000000001, 28.8725, 118.6075, 126.049, 327.8705, 87.366, 138.609, 123.741, 122.815, 130.716, 115.365, 79.8965, 135.20850000000002, 163.371, 244.7715, 62.579, 25.119, 36.452, 115.1045, 147.898, 131.6285, 240.29950000000002, 131.5, 153.2615, 92.967, 167.45, 80.2445, 42.9775, 70.4585, 122.76599999999999, 92.8365, 275.6285, 254.632, 138.553, 230.81650000000002, 230.0815, 111.14, 170.923, 164.075, 276.9035, 250.87, 158.24650000000003, 34.623000000000005, 208.962, 60.445499999999996, 216.809, 110.216, 94.412, 27.8275, 38.8595, 77.18350000000001, 58.552499999999995, 237.861, 82.0555, 173.785, 110.698, 20.0715, 39.059, 41.4415, 99.9425, 123.103, 190.21249999999998, 51.0655, 29.159, 95.67099999999999, 36.646, 243.69799999999998, 48.7465, 95.729, 28.7825, 225.422, 237.00400000000002, 206.474, 34.6445, 201.8745, 165.986, 271.6195, 170.8235, 53.735, 41.681, 54.3435, 191.16750000000002, 35.0125, 269.38800000000003, 194.051, 117.3055, 24.9715, 28.941000000000003, 212.0815, 44.695499999999996, 40.661500000000004, 70.982, 56.732, 35.7025, 41.1015, 128.812, 267.243, 131.1885, 122.402, 115.62899999999999, 152.0465, 102.0605, 117.0495, 182.2905, 136.084, 111.1705, 29.877000000000002, 82.7675, 153.03199999999998, 231.7225, 52.248000000000005, 322.8355, 33.6155, 205.695, 276.709, 70.574, 48.9675, 24.67, 128.6155, 282.49, 106.021, 123.16, 118.72749999999999, 105.4985, 151.6925, 147.041, 117.7935, 119.6855, 120.455, 126.99000000000001, 163.0515, 134.7135, 144.3485, 116.39349999999999, 164.96300000000002, 130.141, 149.602, 265.845, 132.0095, 129.57, 95.354, 154.702, 110.821, 108.193, 102.40700000000001, 37.928, 255.118, 116.769, 91.378, 161.6875, 98.7645, 111.374, 14.317, 0.023, 231.433, 40.203500000000005, 132.723, 42.408, 123.939, 158.08100000000002, 117.1515, 144.8495, 301.67, 266.2815, 142.952, 183.6365, 145.408, 39.141000000000005, 161.8955, 51.323, 46.957, 122.382, 128.46699999999998, 98.8535, 118.515, 202.4375, 110.97649999999999, 130.3235, 218.414, 212.5855, 34.623000000000005},
+		{356.09000000000003, 337.0595, 272.682, 171.6775, 92.20500000000001, 181.09, 182.03, 277.012, 321.631, 219.801, 233.716, 239.849, 237.2515, 243.5245, 174.22050000000002, 208.29500000000002, 266.642, 274.88, 167.151, 182.243, 206.93200000000002, 268.317, 333.1435, 206.83350000000002, 241.85399999999998, 234.4035, 207.1885, 304.235, 200.177, 185.15699999999998, 209.928, 261.7865, 292.418, 209.52949999999998, 261.975, 142.58249999999998, 342.7875, 349.432, 339.275, 412.84950000000003, 225.0125, 225.11599999999999, 254.584, 281.1575, 226.7335, 262.46299999999997, 47.849000000000004, 267.2775, 333.3755, 285.6205, 267.37249999999995, 314.632, 334.47, 230.8165, 23.8465, 393.731, 246.821, 92.159, 182.027, 351.4225, 257.6995, 234.84, 250.845, 255.155, 221.94650000000001, 247.02949999999998, 83.2525, 274.9425, 291.3645, 266.82, 250.369, 222.57299999999998, 236.7645, 184.43900000000002, 185.0165, 52.732, 244.76600000000002, 281.4165, 295.9945, 221.6975, 348.79200000000003, 239.2175, 293.572, 229.37150000000003, 148.1865, 75.822, 70.3465, 244.2135, 337.6765, 309.7495, 421.604, 89.123, 225.63, 227.87150000000003, 288.3365, 336.765, 230.5885, 462.4875, 357.5365, 226.36849999999998, 240.45, 243.39600000000002, 319.5375, 258.5365, 246.09, 244.87099999999998, 244.9205, 233.98700000000002, 255.3995, 288.1755, 262.877, 195.10399999999998, 243.14249999999998, 257.946, 257.6925, 249.743, 276.876, 82.7485, 282.0965, 264.8375, 240.9795, 228.0865, 302.2895, 93.77, 207.2335, 102.5705, 233.64100000000002, 319.197, 376.64549999999997, 248.464, 226.60649999999998, 227.8875, 267.17600000000004, 278.97249999999997, 259.869, 269.0775, 329.18, 273.919, 311.637, 287.8165, 251.29250000000002, 356.69899999999996, 252.571, 264.677, 313.58050000000003, 297.20950000000005, 270.9935, 203.692, 370.9655, 268.256, 367.201, 68.786, 254.4835, 268.8905, 337.553, 369.437, 236.188, 248.36950000000002, 253.536, 245.9755, 251.887, 197.5975, 262.9895, 304.8885, 181.015, 268.0005, 236.2725, 231.914, 0.068, 244.5765, 253.448, 258.478, 261.00649999999996, 389.953, 241.67450000000002, 390.10850000000005, 375.1805, 323.321, 208.644, 263.903, 269.284, 242.14499999999998, 182.079, 261.573, 225.2775, 281.84749999999997, 327.5785, 257.82849999999996, 264.12850000000003, 366.8665, 349.8415, 208.735, 118.37950000000001, 336.885, 250.1905},
+		{145.62650000000002, 90.00450000000001, 100.3005, 76.8355, 177.16449999999998, 85.0505, 230.0735, 99.964, 70.5665, 48.5935, 8.243500000000001, 11.8565, 38.735, 81.1365, 106.43100000000001, 23.127499999999998, 81.575, 95.75049999999999, 262.395, 79.811, 104.362, 92.3315, 101.0035, 98.725, 84.298, 70.7385, 100.5635, 125.0765, 245.1935, 73.169, 80.4345, 12.809999999999999, 82.18350000000001, 115.8575, 185.52249999999998, 220.7455, 123.2985, 112.298, 93.2925, 210.67000000000002, 91.459, 21.445500000000003, 100.396, 85.2825, 47.574, 256.48, 267.202, 103.3105, 227.5125, 114.8675, 167.124, 137.25650000000002, 147.3015, 255.28199999999998, 252.82999999999998, 133.442, 9.216999999999999, 229.0695, 80.371, 117.969, 79.32, 80.674, 22.723, 23.1735, 83.253, 25.441000000000003, 212.0035, 66.9925, 212.062, 80.6155, 34.719, 30.1355, 97.185, 73.94149999999999, 91.838, 190.61950000000002, 15.9665, 88.256, 78.2995, 24.1695, 236.75799999999998, 93.19049999999999, 96.2515, 29.748, 190.5745, 247.99, 203.648, 5.996, 166.1445, 134.348, 236.3385, 173.6445, 45.965500000000006, 48.326499999999996, 34.922, 105.03550000000001, 6.8645, 248.942, 191.04199999999997, 111.25450000000001, 94.8385, 42.002, 213.9835, 53.329, 11.7995, 71.5595, 44.0985, 38.5285, 15.419, 100.216, 287.76300000000003, 97.392, 112.553, 82.935, 109.274, 72.723, 85.424, 175.6825, 110.5255, 92.90299999999999, 18.056, 103.4495, 110.02, 218.276, 48.655, 262.7475, 7.33, 174.825, 246.279, 59.8585, 12.8555, 21.845, 94.17500000000001, 221.0245, 81.999, 88.225, 93.409, 75.4275, 137.85750000000002, 123.758, 81.473, 98.297, 78.2435, 98.2895, 138.5805, 102.1885, 112.5705, 72.42150000000001, 138.234, 185.263, 129.485, 268.96500000000003, 91.5635, 98.386, 102.544, 127.749, 77.257, 77.52600000000001, 78.05199999999999, 10.352, 246.297, 88.4525, 69.409, 131.609, 73.8535, 89.88, 25.3955, 40.195, 244.266, 0.025, 113.8385, 12.840499999999999, 96.74000000000001, 130.4855, 112.732, 121.1015, 218.1055, 165.512, 199.4, 80.7105, 120.939, 18.4715, 137.945, 97.98349999999999, 33.592, 84.726, 114.422, 74.79, 87.266, 167.23250000000002, 95.545, 107.607, 197.707, 166.8195, 30.24},
+		{251.713, 102.637, 25.8105, 19.34, 234.34199999999998, 18.602, 262.76599999999996, 40.5135, 18.936999999999998, 137.1695, 90.59700000000001, 95.78999999999999, 116.6785, 101.1165, 21.3155, 116.87700000000001, 34.658, 16.0545, 264.7955, 164.13299999999998, 32.288, 32.9105, 19.0855, 21.716, 14.780999999999999, 150.9675, 29.572000000000003, 50.7775, 171.997, 159.249, 120.464, 96.586, 36.6515, 48.557, 33.914500000000004, 163.757, 29.316499999999998, 48.352999999999994, 167.311, 253.0805, 151.275, 99.3805, 169.075, 33.804500000000004, 117.586, 164.612, 363.63750000000005, 36.5215, 300.679, 46.412, 27.4785, 69.8235, 76.0255, 174.317, 192.232, 57.7355, 92.7885, 315.463, 167.041, 68.9635, 28.439999999999998, 155.333, 113.3805, 107.0485, 148.4265, 107.5885, 144.10750000000002, 163.70749999999998, 70.84700000000001, 31.847, 116.351, 132.71249999999998, 124.2585, 185.273, 33.6605, 258.151, 101.25550000000001, 120.6215, 172.204, 108.285, 320.7135, 114.638, 197.76350000000002, 110.1695, 140.453, 233.686, 262.324, 90.261, 101.95949999999999, 62.593, 186.2545, 220.606, 129.161, 127.78999999999999, 121.34100000000001, 30.1055, 92.985, 205.004, 111.0815, 199.316, 112.81299999999999, 113.553, 292.3425, 130.101, 89.548, 139.775, 131.21300000000002, 124.9845, 100.504, 25.701500000000003, 178.2305, 24.000500000000002, 193.5995, 30.09, 52.539, 23.595, 11.9485, 231.3655, 44.164, 9.5225, 99.98349999999999, 153.6475, 52.332, 196.6615, 131.733, 192.47050000000002, 89.137, 92.58, 195.58249999999998, 146.805, 98.406, 132.326, 13.131499999999999, 196.81900000000002, 31.3585, 7.9990000000000006, 25.765, 29.7225, 76.663, 54.1095, 23.5335, 38.694, 23.5965, 22.477, 71.934, 19.808999999999997, 50.8845, 21.0755, 72.286, 35.951499999999996, 38.558, 293.08050000000003, 17.0335, 55.077, 169.881, 55.691500000000005, 24.9, 22.326999999999998, 24.499499999999998, 100.301, 171.4555, 19.213, 179.2185, 78.5455, 28.253, 26.8125, 132.414, 132.7585, 255.0765, 113.8625, 0.026, 95.38550000000001, 6.572, 237.7475, 28.685, 47.9705, 112.65, 89.44399999999999, 52.0565, 24.9825, 52.805, 118.423, 65.8495, 121.0705, 115.1105, 40.232, 200.8245, 24.8075, 13.733, 120.672, 35.0845, 30.2605, 124.6175, 78.331, 109.6585},
+		{145.078, 17.334, 99.5945, 77.4025, 188.06650000000002, 84.5155, 300.3535, 102.964, 76.19149999999999, 45.548500000000004, 8.431999999999999, 7.885999999999999, 37.525, 15.1355, 101.6705, 24.093, 85.1745, 95.12899999999999, 282.06, 71.40950000000001, 112.101, 91.525, 105.265, 110.2295, 90.8595, 75.8685, 98.5495, 123.141, 248.60899999999998, 73.3485, 42.182500000000005, 19.2845, 79.94999999999999, 112.7225, 107.157, 211.834, 110.1895, 113.158, 93.8375, 156.8185, 47.879, 9.0465, 66.22399999999999, 89.2215, 27.878, 227.5505, 268.581, 95.4995, 217.345, 123.703, 83.847, 143.69650000000001, 138.7305, 256.144, 248.107, 140.5335, 9.2905, 220.63400000000001, 70.915, 107.3295, 79.58699999999999, 80.5815, 15.097000000000001, 13.439499999999999, 73.17750000000001, 25.247999999999998, 233.423, 72.9765, 138.433, 78.7465, 34.317499999999995, 23.998, 32.9515, 73.606, 87.405, 189.73000000000002, 4.521, 39.7355, 79.4025, 14.743500000000001, 226.48000000000002, 29.161, 107.757, 31.281, 205.44400000000002, 228.4325, 196.37150000000003, 13.088999999999999, 170.1945, 133.425, 239.364, 168.656, 35.3435, 42.4825, 36.8315, 109.7675, 10.67, 241.8145, 213.90800000000002, 101.0035, 27.031, 27.211, 204.064, 53.128, 8.553, 75.90899999999999, 48.306, 24.1065, 12.1115, 99.5555, 252.1765, 114.9225, 114.5805, 80.85849999999999, 114.53, 71.1265, 100.081, 192.486, 113.607, 94.536, 22.769, 82.195, 108.293, 226.4105, 40.805, 266.823, 7.846, 163.754, 232.4355, 60.0685, 15.531, 12.4665, 89.81549999999999, 208.7805, 81.7835, 88.6215, 96.0595, 74.7325, 127.99549999999999, 121.8195, 79.572, 85.8375, 82.7585, 110.21950000000001, 131.2805, 102.57249999999999, 113.693, 72.43700000000001, 130.2855, 110.357, 131.43200000000002, 267.2215, 90.1345, 97.568, 99.5275, 126.71350000000001, 76.5685, 76.68100000000001, 77.316, 13.6655, 230.256, 81.5875, 80.9735, 130.708, 73.0565, 81.6265, 25.8315, 42.428, 249.861, 12.84, 95.36099999999999, 0.024, 95.87, 164.7465, 100.706, 107.64, 216.896, 155.5325, 119.0925, 83.874, 133.12150000000003, 22.485999999999997, 136.683, 15.3655, 38.8555, 88.26599999999999, 107.595, 80.326, 102.4685, 165.2, 85.86099999999999, 110.7765, 198.06150000000002, 182.791, 24.1935},
+		{243.611, 108.9605, 15.174499999999998, 22.9605, 230.137, 70.50450000000001, 263.1035, 35.239, 24.877000000000002, 132.012, 95.48599999999999, 108.5865, 130.9365, 91.3015, 32.305, 113.547, 36.2665, 14.2985, 263.8355, 175.21949999999998, 32.7215, 27.130499999999998, 25.1935, 24.2855, 26.319, 151.9435, 31.427500000000002, 40.1105, 170.036, 165.96550000000002, 119.1695, 95.1885, 28.843, 47.209, 36.275, 162.21499999999997, 19.57, 44.973, 177.1045, 244.5205, 121.8505, 99.8295, 165.531, 30.8995, 124.6075, 132.82150000000001, 352.284, 34.325500000000005, 321.61850000000004, 55.3695, 22.3355, 65.1035, 71.5955, 177.0075, 265.44500000000005, 45.525, 95.685, 336.94849999999997, 163.6155, 67.7345, 26.9285, 156.991, 117.14, 111.58349999999999, 164.111, 108.82499999999999, 160.30399999999997, 137.885, 81.222, 36.056, 125.3615, 127.5895, 123.2205, 168.50650000000002, 30.5165, 253.414, 108.8515, 125.8265, 180.59449999999998, 118.384, 329.985, 113.32149999999999, 201.1625, 123.50399999999999, 163.365, 259.608, 263.20799999999997, 101.9825, 113.444, 52.081500000000005, 175.6295, 219.839, 139.086, 128.73399999999998, 123.52, 27.23, 98.158, 197.58800000000002, 127.1735, 203.954, 112.8325, 113.72149999999999, 306.5115, 130.11849999999998, 90.25450000000001, 154.09949999999998, 131.7025, 126.5015, 101.05600000000001, 15.033999999999999, 182.268, 24.858, 194.4785, 19.889000000000003, 32.91, 22.0995, 23.759, 231.35750000000002, 37.405, 8.4085, 111.5925, 152.209, 41.7815, 199.298, 135.0365, 191.36849999999998, 88.589, 96.727, 189.926, 149.36149999999998, 95.5145, 116.09, 29.4805, 188.893, 34.332499999999996, 8.133, 12.5045, 32.104, 68.0635, 37.8985, 17.751, 27.485, 15.2315, 19.0925, 54.964, 12.573, 49.712, 20.262999999999998, 60.2145, 39.299, 39.4665, 217.59, 25.5295, 23.6935, 182.178, 45.244, 20.399, 21.4495, 14.5005, 100.408, 149.6055, 20.9595, 179.5265, 49.2615, 30.472, 18.689999999999998, 118.966, 123.94, 260.6005, 96.73949999999999, 6.547499999999999, 95.85300000000001, 0.0265, 231.389, 26.224, 34.5035, 123.6335, 75.901, 42.851, 19.993499999999997, 49.044, 105.196, 70.838, 105.1665, 114.582, 49.1055, 200.043, 28.2805, 26.701500000000003, 122.4925, 32.8985, 64.5515, 173.8795, 67.9145, 118.49549999999999},
+		{71.9205, 141.8675, 232.8065, 229.562, 318.571, 219.476, 363.9635, 254.9635, 227.24849999999998, 162.00900000000001, 156.59500000000003, 136.5635, 121.97200000000001, 221.289, 222.8515, 138.25799999999998, 211.99849999999998, 251.6855, 358.05949999999996, 199.293, 233.22750000000002, 218.55200000000002, 214.5895, 215.472, 224.97750000000002, 186.9815, 255.3275, 262.84299999999996, 355.32349999999997, 176.72050000000002, 151.1175, 137.34949999999998, 235.0635, 261.078, 226.3665, 366.217, 225.142, 253.814, 193.6595, 50.969, 157.51549999999997, 136.0155, 191.671, 230.47899999999998, 157.9275, 346.938, 387.3965, 227.963, 358.32550000000003, 286.332, 214.168, 262.5545, 264.55449999999996, 390.4385, 389.05150000000003, 246.893, 140.673, 404.133, 178.7135, 277.5085, 222.08499999999998, 191.86700000000002, 151.527, 143.699, 184.8905, 143.7875, 371.632, 190.534, 261.1855, 203.7365, 130.3715, 165.404, 162.6205, 194.714, 228.668, 316.69050000000004, 151.9475, 129.9365, 201.928, 158.63850000000002, 360.2725, 153.238, 149.32, 155.88549999999998, 364.714, 341.7955, 342.105, 129.36849999999998, 295.33050000000003, 271.305, 360.5755, 295.46299999999997, 168.1845, 163.60250000000002, 168.2715, 236.25, 145.953, 366.5615, 306.447, 234.188, 145.4845, 155.1035, 346.276, 161.1605, 162.038, 188.0045, 167.2175, 160.02300000000002, 137.55200000000002, 233.115, 384.70050000000003, 216.1585, 229.0485, 220.1255, 247.9925, 197.90449999999998, 221.95850000000002, 307.9645, 235.013, 213.502, 140.2835, 189.92149999999998, 234.8345, 371.024, 170.062, 396.4735, 126.31649999999999, 305.6875, 356.82849999999996, 175.28199999999998, 137.107, 151.066, 223.9645, 386.3225, 205.87099999999998, 222.2475, 209.37599999999998, 200.887, 288.7605, 259.23699999999997, 203.256, 242.9305, 233.1285, 253.672, 263.3735, 233.80700000000002, 238.807, 190.03, 263.9, 226.035, 260.432, 404.6575, 250.6225, 254.554, 198.766, 263.30899999999997, 210.3965, 208.5075, 201.3175, 143.805, 356.817, 200.98950000000002, 202.0075, 258.8085, 201.945, 203.1245, 155.7495, 158.1625, 386.4365, 130.515, 237.975, 164.5265, 231.40949999999998, 0.042499999999999996, 226.622, 254.9115, 177.174, 303.0035, 239.75, 220.48950000000002, 244.6145, 144.1715, 267.5145, 144.8645, 151.78050000000002, 212.2475, 209.881, 196.671, 221.421, 307.40999999999997, 215.2955, 238.30349999999999, 344.9705, 277.57550000000003, 160.154},
+		{199.5165, 111.88900000000001, 37.132, 15.2975, 240.776, 24.883499999999998, 277.6645, 46.358999999999995, 21.4965, 127.7805, 93.37, 103.32900000000001, 119.631, 99.196, 32.0025, 108.5125, 31.8465, 34.0815, 293.826, 153.9505, 46.3615, 36.2935, 30.758499999999998, 35.171, 23.3515, 148.10649999999998, 47.667500000000004, 58.1335, 201.2, 159.541, 122.7105, 100.055, 33.067, 61.439, 47.989999999999995, 158.803, 45.5775, 56.2495, 168.41000000000003, 250.858, 132.398, 97.7535, 172.699, 29.258000000000003, 114.489, 153.833, 290.5485, 38.239000000000004, 316.9645, 65.095, 26.183999999999997, 72.4195, 69.8785, 199.769, 218.36, 89.048, 92.7255, 275.1115, 159.8825, 75.86949999999999, 27.337, 157.2485, 114.116, 106.4905, 145.0695, 104.7505, 156.676, 139.2935, 79.72149999999999, 31.269, 116.938, 118.41300000000001, 123.649, 157.89600000000002, 39.5485, 296.05, 108.16499999999999, 117.842, 159.4485, 114.72200000000001, 315.00649999999996, 113.1705, 188.789, 109.93450000000001, 149.5455, 259.2595, 289.39, 94.6165, 99.226, 74.05099999999999, 164.71699999999998, 260.3935, 128.5095, 124.95949999999999, 117.917, 57.793, 98.971, 184.5815, 130.29149999999998, 188.652, 108.0625, 110.7805, 300.3885, 127.413, 93.6815, 168.211, 128.3505, 122.1285, 99.6305, 36.8775, 192.461, 35.396, 190.761, 30.6485, 59.1455, 23.2435, 26.659, 284.275, 50.387, 24.188000000000002, 114.851, 150.485, 55.8535, 255.97549999999998, 128.67950000000002, 208.8945, 84.8655, 111.5415, 179.30849999999998, 150.502, 93.57900000000001, 115.743, 20.1655, 206.291, 31.845, 29.171, 26.947000000000003, 27.358, 80.7185, 61.554, 29.4085, 26.265, 22.0865, 40.584500000000006, 85.51650000000001, 35.224000000000004, 62.3375, 22.0435, 75.59649999999999, 59.7405, 56.055499999999995, 215.14999999999998, 29.598, 42.6335, 170.812, 67.049, 26.433999999999997, 38.4945, 23.963, 96.14500000000001, 154.215, 22.2315, 173.6285, 81.9405, 25.6605, 32.8305, 115.2815, 117.21199999999999, 240.0285, 112.808, 28.768, 100.7935, 26.308500000000002, 226.356, 0.2265, 55.3565, 256.17499999999995, 100.6385, 56.093500000000006, 25.7805, 46.1785, 103.781, 67.12950000000001, 102.8235, 112.1745, 32.9035, 189.274, 25.7125, 28.839, 119.8055, 32.657, 48.134, 135.8745, 94.4945, 116.11},
+		{242.64600000000002, 131.2585, 45.144000000000005, 30.9645, 253.59050000000002, 44.9965, 284.3765, 71.3395, 39.5535, 153.5915, 102.2055, 123.25049999999999, 135.8765, 117.0675, 53.0305, 121.203, 47.757999999999996, 53.887, 299.198, 177.4405, 66.0955, 53.516999999999996, 38.9535, 61.866, 44.062, 184.301, 62.409, 70.68549999999999, 192.6325, 171.5935, 151.746, 126.261, 50.5275, 72.791, 65.5685, 323.5255, 64.684, 66.6945, 183.3645, 278.4385, 158.518, 113.071, 175.7865, 56.157, 135.321, 156.04000000000002, 387.7875, 55.533, 323.082, 53.141000000000005, 37.69, 74.189, 108.9535, 284.7415, 408.878, 57.2575, 103.862, 339.8215, 170.01850000000002, 91.0925, 50.4885, 185.10899999999998, 125.04050000000001, 132.554, 178.76749999999998, 125.8065, 267.746, 151.6415, 67.893, 48.204499999999996, 132.7885, 129.86, 135.7185, 187.673, 36.7495, 331.832, 110.437, 137.918, 179.1615, 120.71199999999999, 334.3425, 132.162, 199.47449999999998, 129.173, 283.98900000000003, 382.267, 312.0165, 109.3475, 125.041, 82.138, 225.0255, 268.5505, 141.65949999999998, 149.5585, 135.267, 64.8745, 120.2415, 217.5985, 131.142, 206.3265, 132.97050000000002, 133.75549999999998, 309.378, 170.24900000000002, 101.92150000000001, 204.575, 147.934, 129.6635, 116.4705, 45.098, 361.6385, 56.885999999999996, 232.639, 42.587500000000006, 57.5025, 40.2085, 42.278000000000006, 249.23200000000003, 64.59899999999999, 29.491, 129.3725, 194.565, 74.37049999999999, 358.339, 206.834, 285.929, 101.225, 138.40699999999998, 216.082, 158.54199999999997, 103.6885, 117.8155, 38.3845, 307.147, 53.3935, 29.813, 39.097, 43.8735, 114.9545, 54.536, 41.8925, 49.411, 44.7235, 49.269999999999996, 84.4785, 35.403999999999996, 75.357, 43.611000000000004, 79.378, 65.7935, 77.2625, 389.827, 51.2475, 54.2115, 195.046, 76.97550000000001, 45.088, 45.3875, 39.542, 116.364, 133.6, 43.4735, 206.3275, 78.88149999999999, 42.2635, 45.6525, 134.85199999999998, 144.9215, 381.678, 121.0805, 48.034, 107.63300000000001, 34.3995, 255.505, 55.283, 0.053, 116.493, 92.65799999999999, 69.638, 40.1495, 23.39, 140.964, 65.67, 130.341, 153.63299999999998, 50.379000000000005, 211.7955, 40.117000000000004, 44.474999999999994, 137.692, 54.939, 60.512, 210.273, 88.4625, 128.679},
+		{128.00549999999998, 217.74200000000002, 122.772, 100.76249999999999, 323.268, 240.71949999999998, 416.634, 122.2075, 97.0575, 209.7785, 164.6655, 246.89350000000002, 188.0005, 209.777, 228.5525, 181.4645, 116.7375, 254.4975, 410.9105, 258.2545, 228.21249999999998, 117.888, 137.17899999999997, 219.09, 219.089, 277.0155, 258.16949999999997, 142.8885, 267.52099999999996, 283.2715, 291.984, 225.954, 119.142, 69.8535, 130.369, 224.327, 127.43, 127.6925, 252.3545, 193.876, 255.2855, 170.60899999999998, 300.16200000000003, 121.0315, 244.384, 357.278, 290.1705, 111.2415, 386.92150000000004, 137.03449999999998, 107.6055, 257.86749999999995, 163.3945, 283.942, 291.57849999999996, 242.094, 208.81799999999998, 297.134, 280.624, 134.3965, 239.878, 227.4955, 291.3, 200.4475, 275.754, 168.829, 227.5485, 226.584, 159.566, 105.953, 269.63149999999996, 216.2005, 303.05600000000004, 338.8515, 110.071, 321.2265, 217.98450000000003, 183.92399999999998, 215.09449999999998, 213.264, 460.957, 236.564, 286.228, 252.15249999999997, 221.71249999999998, 320.77200000000005, 325.663, 209.05700000000002, 272.61699999999996, 160.233, 173.4875, 325.30899999999997, 219.5205, 254.0645, 212.35, 123.3875, 209.95049999999998, 228.19549999999998, 309.4075, 292.99350000000004, 228.18599999999998, 186.392, 372.856, 242.4255, 216.545, 269.20450000000005, 257.95050000000003, 200.5605, 212.95499999999998, 122.51050000000001, 252.16750000000002, 219.337, 315.9545, 117.967, 229.85399999999998, 98.25200000000001, 114.2755, 336.24, 140.4645, 101.1335, 203.78449999999998, 280.9495, 138.9665, 385.663, 277.432, 451.399, 209.4545, 191.837, 154.0965, 254.57, 191.91199999999998, 203.9435, 101.6515, 331.48400000000004, 106.3765, 245.5365, 108.747, 101.678, 260.2495, 280.8615, 237.988, 108.3905, 110.872, 127.2805, 352.132, 115.965, 238.776, 96.92, 349.83849999999995, 227.59449999999998, 143.424, 289.80550000000005, 115.09100000000001, 128.774, 258.387, 152.172, 117.94800000000001, 119.11250000000001, 119.34, 211.59300000000002, 334.1645, 110.0185, 289.2025, 156.667, 100.364, 234.8965, 298.048, 301.736, 354.101, 218.2145, 112.6335, 216.9035, 123.70599999999999, 176.4125, 256.077, 116.63300000000001, 0.119, 184.423, 267.42150000000004, 213.969, 403.523, 284.9945, 159.381, 285.4955, 244.247, 131.562, 396.4705, 221.877, 115.2505, 192.58350000000002, 116.6405, 269.0725, 245.346, 172.226, 219.724},
+		{309.7165, 174.959, 61.818, 81.9395, 284.595, 81.71600000000001, 332.898, 80.66300000000001, 82.167, 188.59949999999998, 159.26600000000002, 173.21949999999998, 197.5525, 161.33800000000002, 102.0445, 170.6805, 90.186, 75.73949999999999, 352.1165, 231.3295, 89.28, 89.2895, 92.6405, 94.4255, 61.7855, 235.67849999999999, 76.0495, 74.467, 233.921, 223.5455, 183.90699999999998, 168.4105, 91.1725, 117.2315, 95.4715, 247.70350000000002, 76.286, 93.9825, 232.1135, 333.023, 199.522, 156.66750000000002, 229.053, 80.574, 178.17950000000002, 197.601, 398.998, 106.624, 379.58, 66.509, 78.9915, 126.478, 140.3505, 250.1295, 282.071, 33.0235, 163.89499999999998, 404.00199999999995, 220.2775, 122.041, 94.3385, 218.74, 166.3845, 169.68650000000002, 250.23000000000002, 175.8325, 219.35399999999998, 206.23149999999998, 147.1815, 87.22999999999999, 183.9085, 187.6095, 191.26549999999997, 232.779, 98.767, 358.0695, 171.0035, 190.195, 235.6395, 178.4075, 407.703, 185.777, 264.3785, 184.09050000000002, 198.0355, 278.8775, 357.30899999999997, 149.049, 157.168, 90.958, 234.9145, 295.512, 198.123, 195.096, 178.86149999999998, 75.3495, 152.89249999999998, 236.101, 205.586, 255.64, 184.0215, 184.632, 368.123, 204.812, 150.144, 224.031, 208.1805, 192.13400000000001, 152.84300000000002, 61.266000000000005, 254.433, 80.78399999999999, 266.044, 75.148, 71.1675, 79.4375, 71.3955, 343.04650000000004, 93.405, 81.7415, 166.237, 236.2265, 96.6725, 362.38649999999996, 213.583, 338.2575, 148.466, 117.8775, 246.8095, 216.18099999999998, 159.575, 172.257, 64.036, 204.3225, 87.57249999999999, 74.936, 78.69149999999999, 83.217, 119.924, 76.88749999999999, 79.14150000000001, 84.2175, 78.575, 75.503, 108.02250000000001, 80.97900000000001, 101.2045, 92.943, 104.402, 84.6375, 64.80850000000001, 279.7285, 68.31049999999999, 76.87549999999999, 243.9685, 53.1905, 77.219, 80.51750000000001, 77.469, 161.1745, 204.703, 73.6075, 256.167, 69.1045, 81.769, 86.28, 247.49349999999998, 266.30949999999996, 322.43, 165.481, 89.487, 155.5625, 75.88300000000001, 300.656, 100.513, 92.077, 184.45800000000003, 0.028, 106.142, 73.37700000000001, 90.223, 175.024, 129.973, 182.30450000000002, 215.6165, 93.676, 265.89, 93.0255, 73.326, 182.23149999999998, 92.5655, 45.8375, 226.934, 61.242999999999995, 184.3995},
+		{240.26049999999998, 132.983, 46.479, 43.287499999999994, 274.457, 40.902, 304.38300000000004, 62.7405, 45.549499999999995, 155.347, 115.00800000000001, 119.326, 144.6695, 120.1325, 48.7815, 130.501, 58.8685, 45.093500000000006, 323.062, 188.345, 61.6565, 47.199, 46.3825, 50.0515, 33.443, 179.4305, 52.5615, 73.185, 201.72199999999998, 180.71949999999998, 144.5915, 124.6575, 49.5115, 77.0615, 58.335499999999996, 178.113, 49.826, 75.7565, 198.3765, 257.55899999999997, 149.6705, 120.484, 189.301, 55.5465, 147.102, 294.408, 383.475, 66.25200000000001, 326.2615, 55.400999999999996, 46.531, 90.1665, 96.1125, 224.03300000000002, 299.0315, 71.7875, 116.27850000000001, 273.192, 179.3745, 86.447, 51.195, 191.47449999999998, 137.7105, 130.4475, 190.481, 130.2705, 191.828, 179.512, 97.4005, 57.088, 140.161, 159.96949999999998, 148.726, 187.12349999999998, 51.8685, 306.58299999999997, 122.537, 149.84949999999998, 199.137, 138.676, 342.491, 145.13299999999998, 213.38350000000003, 131.878, 181.712, 334.331, 308.397, 125.24549999999999, 143.63, 83.1635, 208.2165, 245.233, 153.841, 162.233, 141.2945, 60.631, 115.5995, 202.3675, 155.669, 203.983, 144.459, 149.5965, 313.82899999999995, 159.9885, 116.178, 185.20350000000002, 159.74849999999998, 154.926, 117.52250000000001, 46.545, 217.70350000000002, 50.571, 230.167, 37.34, 66.533, 48.3655, 37.048, 301.775, 58.653999999999996, 51.466499999999996, 129.18099999999998, 197.7025, 62.819, 346.80449999999996, 160.613, 303.919, 115.85, 130.79500000000002, 198.85950000000003, 171.8605, 119.935, 140.0725, 36.17, 169.3935, 57.493, 41.983000000000004, 25.1665, 52.195, 94.4435, 78.112, 41.3925, 43.891, 41.165499999999994, 50.348, 79.34049999999999, 42.9925, 72.42949999999999, 57.428, 89.5385, 56.414500000000004, 60.053, 363.396, 40.178, 56.0205, 198.974, 76.4315, 43.079, 41.7205, 40.3855, 121.049, 289.092, 45.156, 201.8315, 84.904, 50.3365, 43.1255, 141.222, 142.969, 208.802, 199.318, 50.9515, 119.01650000000001, 42.8335, 239.816, 56.016000000000005, 69.69149999999999, 267.2405, 106.158, 0.0295, 45.0045, 72.1185, 126.9435, 84.344, 125.348, 146.219, 62.5995, 223.076, 50.308, 39.3095, 147.3655, 58.4825, 53.619, 192.958, 135.87349999999998, 140.75650000000002},
+		{229.44549999999998, 97.77600000000001, 27.7455, 11.590499999999999, 222.2995, 11.9245, 268.9235, 26.369, 10.8335, 123.17, 84.205, 85.761, 109.6705, 98.184, 31.106, 95.085, 22.648, 24.6975, 304.0155, 138.95, 49.2425, 18.619, 28.6065, 34.747, 11.1625, 147.066, 32.9305, 42.0565, 181.05900000000003, 139.7615, 117.65299999999999, 89.9555, 18.186, 47.269999999999996, 35.6095, 144.0365, 33.134, 31.646, 171.6585, 245.4315, 124.549, 95.76, 154.2635, 15.789000000000001, 115.066, 154.7835, 249.629, 38.6465, 301.85850000000005, 29.2605, 10.1795, 64.88650000000001, 68.149, 210.54149999999998, 292.883, 46.8365, 81.524, 190.0265, 140.7145, 50.8, 28.601, 147.934, 97.0085, 105.54849999999999, 144.55450000000002, 93.172, 143.998, 130.432, 72.664, 20.8925, 111.138, 101.8355, 115.141, 150.8885, 30.4805, 245.789, 92.21, 117.5615, 164.267, 101.393, 307.31600000000003, 108.6505, 168.24200000000002, 103.233, 134.499, 249.04000000000002, 272.944, 80.0385, 78.9505, 65.25999999999999, 181.036, 227.7585, 126.729, 124.42699999999999, 105.1925, 24.805, 80.5125, 181.833, 117.7115, 169.7415, 102.7945, 110.3755, 291.808, 128.13, 81.068, 154.55700000000002, 121.2745, 122.273, 83.0995, 27.682499999999997, 184.046, 27.8065, 190.5195, 11.961500000000001, 36.8245, 11.4295, 14.212499999999999, 237.0265, 37.054500000000004, 19.231, 93.9765, 149.563, 57.6405, 263.67600000000004, 120.771, 285.1415, 83.0745, 105.82, 183.528, 140.168, 93.91749999999999, 99.56450000000001, 14.121500000000001, 199.954, 21.0105, 21.098, 24.08, 15.566500000000001, 67.033, 45.2275, 13.214500000000001, 9.392, 11.794, 23.875, 58.033, 32.622, 47.574, 16.105, 59.578, 34.540499999999994, 37.588499999999996, 211.7515, 18.964, 24.176499999999997, 175.56400000000002, 58.534, 14.1425, 12.2505, 11.4875, 85.8765, 141.845, 11.8575, 167.595, 60.2075, 14.909, 13.2315, 163.034, 183.673, 257.577, 80.707, 24.966, 83.8695, 19.985500000000002, 220.4225, 25.729, 40.194500000000005, 214.5915, 73.437, 45.0385, 0.02, 50.382, 89.3255, 59.923, 92.50399999999999, 111.325, 38.44, 179.72050000000002, 14.1205, 15.62, 107.561, 29.6655, 30.386, 119.65950000000001, 83.1955, 105.4845},
+		{218.3825, 151.644, 54.453500000000005, 47.733, 291.3315, 48.281000000000006, 325.4125, 67.44800000000001, 58.147, 158.762, 119.605, 122.0975, 141.3645, 113.087, 32.952, 147.5355, 51.299, 60.480999999999995, 330.926, 212.3275, 73.628, 67.583, 28.817, 63.704, 42.906, 182.5965, 79.5, 86.945, 185.303, 187.615, 145.05, 128.9315, 59.3585, 100.291, 71.149, 181.90800000000002, 60.068, 80.013, 198.61599999999999, 261.22900000000004, 169.231, 124.221, 201.9555, 59.6465, 170.409, 170.536, 313.67949999999996, 58.4405, 334.8095, 75.5635, 43.042500000000004, 73.77600000000001, 95.6505, 225.5655, 409.997, 41.602999999999994, 125.57249999999999, 305.7015, 191.3295, 81.92150000000001, 54.1635, 197.1125, 130.784, 135.346, 206.012, 135.728, 193.14249999999998, 164.1615, 63.3995, 61.730999999999995, 145.247, 144.00099999999998, 153.897, 199.781, 67.0875, 319.17949999999996, 128.821, 147.554, 194.635, 154.871, 374.174, 144.495, 199.746, 131.45100000000002, 185.3635, 338.1735, 316.6015, 124.5745, 116.2405, 92.76650000000001, 171.9085, 281.7815, 150.3465, 168.6895, 137.9645, 62.683, 111.629, 218.1905, 114.634, 231.2355, 146.3825, 148.3405, 319.7645, 159.10750000000002, 123.4625, 194.36700000000002, 167.49349999999998, 156.3865, 117.68350000000001, 47.4195, 207.577, 55.7435, 229.6765, 51.713499999999996, 37.1215, 44.0025, 42.188500000000005, 315.83, 64.52250000000001, 43.754, 135.17, 226.4305, 74.9195, 178.95, 170.11849999999998, 321.6825, 126.84450000000001, 135.82850000000002, 201.508, 181.35899999999998, 135.511, 166.6205, 36.016000000000005, 151.1415, 53.1235, 41.965, 30.054000000000002, 57.2185, 95.1265, 49.3825, 50.181, 59.5255, 42.2725, 58.611, 67.08, 41.2855, 89.291, 51.3805, 68.801, 63.998000000000005, 87.28999999999999, 386.50800000000004, 41.6165, 63.278999999999996, 218.412, 91.4375, 53.41, 47.609, 43.288, 122.7525, 184.052, 51.092, 205.137, 51.135000000000005, 44.5095, 44.4925, 146.0445, 145.417, 268.9125, 120.9345, 52.400000000000006, 133.143, 49.057, 244.631, 46.093, 23.381500000000003, 403.44899999999996, 90.27799999999999, 72.1745, 50.3665, 0.0395, 132.293, 85.4585, 127.56, 178.569, 53.3825, 212.5775, 43.974999999999994, 49.7635, 142.0445, 51.085499999999996, 59.677499999999995, 201.95600000000002, 75.091, 139.71550000000002},
+		{136.877, 22.666, 105.981, 84.9255, 178.7495, 88.16550000000001, 222.22, 111.00450000000001, 80.41900000000001, 40.42, 12.287, 18.009, 29.9425, 9.019, 103.1015, 19.713, 102.523, 106.47749999999999, 217.9785, 73.1885, 107.3435, 107.69800000000001, 111.55850000000001, 105.1985, 95.4995, 71.405, 118.6095, 128.7195, 256.5915, 70.752, 34.7975, 10.849, 90.477, 125.285, 111.72800000000001, 232.55450000000002, 113.3235, 124.08949999999999, 82.5, 163.51299999999998, 44.172, 21.189500000000002, 78.006, 97.3385, 49.0955, 273.1, 276.1965, 110.373, 221.6645, 137.571, 95.0445, 141.01, 147.44549999999998, 288.21500000000003, 288.4835, 140.3365, 13.006499999999999, 231.688, 70.28399999999999, 120.389, 86.958, 82.614, 27.278, 22.717, 71.1865, 31.0165, 224.2945, 64.006, 139.7525, 92.781, 27.2315, 29.5375, 37.986000000000004, 73.4565, 117.4645, 197.04500000000002, 20.812, 37.313, 88.66499999999999, 30.201, 234.65800000000002, 31.422, 91.245, 25.274, 255.893, 277.8725, 192.0485, 11.9145, 168.31, 142.4685, 248.566, 173.469, 44.810500000000005, 51.095, 32.7735, 106.719, 12.1675, 241.38299999999998, 201.078, 105.97749999999999, 32.181, 32.3545, 209.221, 54.545500000000004, 19.175, 80.216, 44.069500000000005, 42.979, 17.95, 106.03450000000001, 270.8295, 104.48349999999999, 109.834, 92.417, 127.174, 82.50450000000001, 95.8435, 184.66250000000002, 117.9885, 95.5365, 12.102, 93.9005, 119.00999999999999, 236.167, 56.1105, 258.808, 13.7225, 186.3345, 253.181, 55.728, 21.304000000000002, 13.851, 98.61099999999999, 214.0, 88.978, 101.52799999999999, 107.278, 85.696, 152.331, 134.829, 88.9435, 96.1365, 84.92750000000001, 112.5885, 130.4855, 107.7185, 125.9945, 94.58500000000001, 137.952, 117.945, 135.5485, 249.7475, 103.414, 108.517, 87.392, 134.583, 93.571, 93.5435, 87.138, 13.9875, 229.3505, 92.606, 80.0675, 147.91449999999998, 85.18100000000001, 89.5305, 25.439, 39.092, 241.584, 18.4815, 118.3425, 22.41, 105.1035, 143.5685, 103.634, 140.984, 284.916, 174.94650000000001, 126.9605, 89.267, 132.22750000000002, 0.028, 137.6335, 24.524, 40.509, 100.4435, 101.1815, 85.252, 98.6285, 175.14, 97.623, 117.5115, 254.704, 157.345, 32.2515},
+		{269.863, 145.7775, 76.511, 55.0315, 293.75649999999996, 66.52600000000001, 319.3985, 88.03800000000001, 62.3335, 171.8405, 129.453, 135.099, 159.526, 133.018, 71.6115, 143.86950000000002, 70.936, 72.4195, 353.716, 215.61700000000002, 88.051, 75.8115, 71.7105, 81.7265, 63.542, 199.77100000000002, 88.12100000000001, 101.913, 312.918, 194.494, 157.13799999999998, 136.579, 71.59049999999999, 93.92500000000001, 91.60650000000001, 198.385, 85.40299999999999, 98.0865, 209.37099999999998, 294.794, 165.034, 138.505, 203.59699999999998, 74.8335, 156.2045, 174.4685, 322.4155, 78.663, 354.5145, 107.291, 66.416, 101.85900000000001, 109.93549999999999, 261.988, 354.288, 97.45400000000001, 133.591, 333.382, 192.15949999999998, 106.9495, 73.2945, 204.5845, 154.15949999999998, 144.674, 196.05700000000002, 147.003, 195.624, 185.523, 103.9925, 70.174, 155.29649999999998, 163.6045, 163.3715, 203.73399999999998, 74.695, 337.3245, 138.18, 163.087, 194.728, 149.60649999999998, 367.855, 162.614, 236.44150000000002, 145.142, 199.0965, 384.61350000000004, 326.46500000000003, 132.7285, 158.14100000000002, 114.6095, 199.2895, 291.5775, 167.6805, 171.171, 161.513, 83.744, 134.848, 190.503, 164.7695, 246.319, 158.75400000000002, 156.702, 338.1115, 177.436, 133.8605, 189.2815, 165.7645, 163.606, 138.477, 76.85300000000001, 242.713, 81.28999999999999, 241.529, 70.0235, 97.80799999999999, 63.081, 66.5015, 317.64549999999997, 88.628, 68.327, 151.3125, 211.63799999999998, 92.9235, 295.081, 168.481, 335.63599999999997, 129.272, 162.149, 211.639, 216.63549999999998, 136.62599999999998, 142.5635, 62.1635, 193.0245, 71.504, 73.2875, 64.3685, 66.04650000000001, 111.678, 83.55699999999999, 69.2795, 68.6175, 69.1155, 79.161, 102.0035, 72.3725, 95.2655, 70.46799999999999, 114.2285, 87.7145, 93.888, 319.8755, 69.3895, 85.84049999999999, 216.44400000000002, 108.16900000000001, 67.17150000000001, 66.854, 67.614, 136.59300000000002, 182.66, 67.00450000000001, 208.365, 116.475, 64.77799999999999, 72.1875, 152.73950000000002, 161.9665, 181.75150000000002, 138.29500000000002, 65.689, 136.78300000000002, 70.9985, 268.03499999999997, 67.25, 65.765, 159.4425, 130.265, 84.477, 60.11, 85.7715, 137.9525, 0.026, 146.24099999999999, 162.1215, 74.3295, 228.79500000000002, 64.552, 67.482, 159.7185, 71.724, 87.3905, 174.716, 137.6345, 172.0605},
+		{130.787, 11.842500000000001, 109.1315, 86.02350000000001, 193.326, 91.705, 298.121, 108.506, 83.5975, 53.230000000000004, 12.8695, 18.526000000000003, 42.9775, 21.094, 109.516, 31.02, 89.929, 104.59049999999999, 301.9145, 72.5805, 114.7925, 110.0955, 110.6135, 107.9245, 95.7225, 73.564, 109.065, 130.2645, 219.8545, 80.94999999999999, 43.929500000000004, 20.917, 88.221, 134.2665, 120.582, 226.11700000000002, 112.3135, 125.5735, 91.84049999999999, 177.1205, 49.9405, 5.193, 63.632000000000005, 88.994, 33.426, 268.8745, 269.4805, 119.0, 232.07150000000001, 113.483, 101.4605, 149.12650000000002, 136.6855, 251.1845, 232.598, 129.9955, 14.536999999999999, 223.745, 85.3625, 121.223, 93.868, 75.3315, 24.447000000000003, 28.950000000000003, 72.0715, 22.5485, 244.9535, 56.093500000000006, 147.15800000000002, 95.1565, 40.613, 24.044, 35.299, 81.246, 114.13, 200.55200000000002, 23.0175, 48.491, 101.715, 29.2795, 240.22449999999998, 26.5305, 97.314, 28.677500000000002, 242.4755, 238.3005, 192.921, 26.8505, 168.53050000000002, 142.89499999999998, 246.08249999999998, 169.8655, 48.702, 44.6625, 43.524, 115.6425, 16.2275, 256.33299999999997, 199.0735, 84.24199999999999, 25.2885, 41.1985, 218.225, 49.6635, 17.317999999999998, 66.17349999999999, 45.949, 38.842, 17.611, 106.75, 247.3975, 105.6765, 119.65100000000001, 86.326, 115.5535, 83.56, 87.727, 166.254, 123.091, 93.23750000000001, 25.4025, 102.0575, 140.937, 223.83100000000002, 49.1005, 297.13, 13.795, 180.0075, 247.757, 64.133, 22.919, 24.764000000000003, 96.527, 259.796, 91.747, 101.768, 102.8175, 88.58500000000001, 154.1925, 125.1805, 103.263, 103.8555, 91.8265, 110.65450000000001, 150.9885, 109.2705, 125.175, 81.047, 142.9975, 113.46600000000001, 128.394, 260.8115, 110.53, 117.0155, 99.565, 135.7375, 87.408, 88.5775, 102.777, 16.7805, 214.70999999999998, 96.743, 100.8455, 153.4185, 85.54400000000001, 90.8735, 31.949, 51.307500000000005, 261.3465, 97.9885, 121.05600000000001, 15.3485, 105.138, 147.02800000000002, 102.7135, 130.355, 285.447, 182.249, 125.413, 92.48949999999999, 127.55850000000001, 24.563499999999998, 146.075, 0.032, 39.400999999999996, 91.9065, 110.62899999999999, 85.68799999999999, 95.42349999999999, 177.935, 104.3435, 115.6505, 242.666, 157.9195, 38.451499999999996},
+		{110.458, 22.173499999999997, 144.01850000000002, 116.57, 123.8135, 100.14150000000001, 268.282, 120.717, 95.275, 34.6905, 33.436, 31.257, 40.090500000000006, 39.169, 122.86099999999999, 31.057499999999997, 101.964, 115.197, 247.84199999999998, 42.7055, 128.31099999999998, 111.997, 131.8865, 119.634, 113.68350000000001, 36.7255, 128.70749999999998, 140.471, 196.48399999999998, 59.39, 30.836, 35.2105, 121.5985, 140.2235, 127.15549999999999, 275.1335, 126.925, 134.90550000000002, 95.702, 181.73950000000002, 34.5315, 28.4735, 45.951499999999996, 113.0695, 6.1425, 243.194, 219.9965, 118.4305, 192.88850000000002, 147.9145, 114.619, 163.787, 188.19650000000001, 223.8515, 275.8935, 158.8735, 35.7625, 186.86, 54.524, 144.011, 97.975, 31.5385, 25.061500000000002, 17.545, 40.0985, 44.146, 250.01, 52.385, 160.6655, 105.411, 48.032, 11.465499999999999, 26.124000000000002, 53.8175, 102.511, 174.8345, 34.665499999999994, 36.2565, 89.2935, 17.1765, 211.231, 18.7315, 109.769, 48.1465, 233.563, 188.574, 162.138, 28.3245, 202.7855, 147.502, 294.20050000000003, 146.08499999999998, 30.764499999999998, 13.809000000000001, 41.736, 131.233, 29.206, 255.89999999999998, 194.223, 73.137, 31.511, 36.939, 217.525, 36.010999999999996, 47.842, 45.5155, 19.969, 13.7755, 33.307500000000005, 142.81150000000002, 245.994, 119.7855, 85.9435, 106.9, 130.683, 96.5255, 109.93299999999999, 141.61149999999998, 130.7385, 116.804, 34.6815, 50.8775, 134.733, 197.243, 30.864, 231.253, 28.4525, 190.5855, 251.383, 62.158500000000004, 34.58, 37.3705, 115.801, 272.8315, 103.63749999999999, 116.0095, 118.33449999999999, 98.8015, 149.4925, 147.31599999999997, 105.048, 107.773, 104.02799999999999, 116.222, 150.4055, 121.088, 135.243, 93.737, 148.639, 130.946, 146.784, 205.09550000000002, 118.8985, 130.554, 98.2445, 172.004, 102.702, 113.095, 100.50200000000001, 34.034499999999994, 233.71300000000002, 105.721, 65.708, 167.56650000000002, 99.95400000000001, 104.5965, 15.679, 46.989999999999995, 224.7315, 33.639, 115.1225, 38.894999999999996, 114.62299999999999, 151.7895, 112.1485, 153.6635, 244.3155, 215.56099999999998, 146.2385, 111.35249999999999, 178.6085, 40.626000000000005, 162.2695, 39.433, 0.049, 99.09899999999999, 125.785, 98.78999999999999, 111.4765, 195.67149999999998, 118.28450000000001, 124.297, 272.1205, 161.83550000000002, 35.8035},
+		{189.5445, 107.02250000000001, 42.865, 20.525, 236.221, 21.8505, 278.351, 39.9695, 13.031500000000001, 122.99549999999999, 82.3505, 92.509, 116.7015, 109.44749999999999, 38.741, 103.6665, 3.564, 42.369, 298.07349999999997, 155.99650000000003, 48.7205, 32.6395, 47.7635, 41.522, 40.6335, 134.877, 42.407, 59.607, 188.8915, 157.147, 124.24950000000001, 96.4905, 25.137999999999998, 66.4495, 52.173500000000004, 220.695, 44.802499999999995, 42.3395, 164.426, 237.966, 125.3805, 83.414, 176.8255, 43.706500000000005, 104.1055, 159.0805, 284.3685, 53.815, 293.371, 59.0, 27.875, 82.2705, 82.017, 273.0185, 299.4325, 68.0585, 84.49549999999999, 272.804, 154.6955, 59.5215, 18.070999999999998, 156.76350000000002, 112.3015, 96.27199999999999, 133.7165, 91.412, 204.0455, 131.1705, 76.73949999999999, 22.394, 113.30250000000001, 106.1, 128.423, 151.8675, 38.5575, 268.84950000000003, 93.7485, 124.798, 171.898, 100.295, 312.6515, 105.02600000000001, 187.98399999999998, 108.982, 143.9275, 262.876, 271.62850000000003, 80.8075, 94.774, 77.479, 183.2985, 218.0595, 119.89, 112.3545, 105.269, 39.503, 85.041, 183.64249999999998, 125.76400000000001, 185.275, 122.6915, 96.65549999999999, 282.203, 128.9445, 77.089, 154.9685, 115.719, 104.6875, 87.265, 42.698499999999996, 230.1055, 41.5195, 176.642, 39.4625, 54.119, 14.945, 31.142000000000003, 198.48250000000002, 57.568, 30.305999999999997, 100.109, 137.3515, 55.4375, 258.355, 121.93, 278.39599999999996, 81.5945, 102.6, 179.8425, 140.304, 82.7895, 98.2575, 29.200000000000003, 167.548, 23.3435, 37.343999999999994, 41.700500000000005, 18.5825, 78.71000000000001, 61.788, 37.346000000000004, 32.4885, 27.618499999999997, 48.949, 86.0945, 46.561499999999995, 61.722, 13.2025, 85.834, 52.063500000000005, 58.876999999999995, 224.09050000000002, 42.3675, 41.4245, 167.113, 70.949, 22.762, 28.4105, 27.418, 88.0735, 160.43099999999998, 21.608, 154.45600000000002, 76.146, 16.933, 38.0005, 111.7015, 122.511, 282.0685, 84.8395, 40.3155, 88.2315, 49.096000000000004, 211.77249999999998, 33.385000000000005, 51.311, 131.51350000000002, 93.863, 63.364999999999995, 38.8895, 54.0265, 101.112, 74.211, 91.767, 99.20949999999999, 0.041, 186.42700000000002, 18.262999999999998, 32.694500000000005, 110.456, 24.712000000000003, 47.3745, 199.1655, 120.511, 114.369},
+		{148.1295, 105.106, 198.57999999999998, 178.6905, 251.633, 176.7245, 369.058, 211.62849999999997, 172.962, 106.1575, 111.0235, 107.827, 85.309, 92.0495, 184.606, 86.52600000000001, 184.709, 199.0105, 380.89250000000004, 155.954, 212.19, 197.934, 202.815, 209.25650000000002, 182.27249999999998, 148.91750000000002, 199.6125, 225.2, 322.3385, 139.756, 101.833, 101.822, 183.6705, 209.902, 211.338, 351.2365, 206.237, 215.7995, 120.871, 200.83100000000002, 103.1925, 110.8735, 145.1085, 183.7545, 113.9555, 367.5145, 334.945, 186.7145, 297.738, 226.47899999999998, 183.63549999999998, 237.672, 227.0845, 335.4865, 341.5695, 227.6355, 101.38550000000001, 294.8035, 131.079, 212.5155, 186.9075, 155.4755, 113.2655, 116.2245, 159.3105, 118.5425, 316.2375, 148.4995, 238.17, 183.1855, 79.975, 114.3335, 112.7855, 146.14350000000002, 181.9665, 302.461, 112.831, 76.322, 103.86099999999999, 104.554, 312.7325, 109.61699999999999, 134.1695, 95.96, 302.7205, 294.456, 256.3435, 100.971, 266.199, 238.993, 319.3885, 252.0505, 110.9635, 126.2355, 98.9545, 209.103, 102.41550000000001, 346.27, 340.01300000000003, 184.9535, 102.987, 129.75650000000002, 283.1075, 115.24799999999999, 108.99600000000001, 154.9765, 136.656, 117.9225, 104.4, 198.424, 342.6115, 207.1365, 184.0445, 179.524, 214.433, 174.913, 187.661, 259.16499999999996, 211.20049999999998, 185.55349999999999, 100.366, 175.9685, 222.928, 295.8965, 165.42000000000002, 353.424, 102.58449999999999, 264.5175, 345.20349999999996, 126.553, 109.9225, 106.0735, 176.498, 410.96000000000004, 183.1125, 187.555, 193.92399999999998, 179.9055, 236.537, 224.02800000000002, 184.9645, 182.482, 171.5455, 196.6905, 241.46249999999998, 201.405, 225.4455, 165.9855, 249.703, 209.61700000000002, 224.75400000000002, 357.659, 190.1245, 197.6515, 126.42349999999999, 229.018, 187.1125, 179.962, 178.571, 105.528, 331.926, 179.2985, 154.6135, 241.4005, 183.79250000000002, 183.573, 109.1705, 129.1225, 330.871, 114.9285, 200.8275, 108.138, 199.91750000000002, 209.72750000000002, 189.2155, 211.365, 396.735, 265.89099999999996, 223.529, 179.6685, 212.58499999999998, 101.215, 229.6135, 110.6325, 125.78450000000001, 186.82850000000002, 0.064, 176.442, 187.99599999999998, 276.305, 185.2015, 208.111, 359.819, 254.89350000000002, 110.30000000000001},
+		{209.673, 89.289, 37.975, 16.752000000000002, 227.6445, 11.789000000000001, 269.3845, 34.057500000000005, 4.1945, 114.03200000000001, 74.197, 81.83, 103.137, 80.2295, 28.4695, 88.58699999999999, 16.313499999999998, 31.5225, 288.6845, 135.223, 34.7485, 24.014, 29.194000000000003, 32.544, 20.941499999999998, 133.20499999999998, 33.164, 52.691, 165.2855, 135.20850000000002, 102.78450000000001, 77.7965, 20.555500000000002, 54.995, 37.346000000000004, 153.4005, 36.553, 32.584500000000006, 158.201, 231.812, 111.36850000000001, 81.8355, 141.57150000000001, 19.380499999999998, 104.01599999999999, 143.9615, 337.8115, 35.79, 280.68, 62.113, 19.6515, 62.6115, 70.0805, 255.482, 292.2715, 50.1215, 80.924, 241.5215, 133.24650000000003, 49.3845, 9.301, 146.38049999999998, 95.35650000000001, 93.185, 131.6765, 80.733, 139.947, 121.3205, 61.663, 12.93, 95.82, 97.075, 102.5495, 142.8845, 30.3375, 255.9275, 80.35249999999999, 103.411, 143.1215, 92.6635, 304.15200000000004, 96.671, 174.63, 93.48949999999999, 137.3735, 240.334, 258.957, 74.3325, 93.0325, 72.1775, 173.77749999999997, 227.2195, 112.697, 109.342, 105.688, 30.6675, 74.55350000000001, 166.64749999999998, 97.10849999999999, 163.489, 95.3475, 97.9055, 277.5735, 110.3775, 72.494, 134.4325, 113.982, 109.6375, 75.50450000000001, 38.0295, 185.671, 33.3975, 175.99099999999999, 18.8065, 50.4905, 5.2835, 17.667, 231.215, 44.010999999999996, 20.738999999999997, 84.5475, 136.5435, 41.1125, 283.878, 113.9285, 271.346, 71.3595, 100.5275, 164.841, 137.72899999999998, 78.842, 89.07, 18.1435, 201.235, 13.78, 25.294, 26.012, 8.866499999999998, 73.054, 47.923, 18.6645, 15.8125, 9.679, 39.1595, 67.51050000000001, 31.936, 46.209500000000006, 3.907, 77.15950000000001, 39.192499999999995, 56.352999999999994, 204.394, 26.2955, 37.2775, 158.858, 65.394, 12.538, 16.337, 11.056999999999999, 75.633, 140.687, 14.796, 158.35950000000003, 75.28450000000001, 7.8245000000000005, 13.3565, 97.4455, 98.8015, 255.4325, 74.7435, 24.7225, 80.2625, 28.192999999999998, 196.5685, 25.5835, 39.944, 221.74099999999999, 92.952, 50.308499999999995, 14.089, 43.9065, 85.27350000000001, 64.1845, 85.662, 98.67099999999999, 17.732999999999997, 176.305, 0.039, 19.105, 100.26650000000001, 21.7155, 32.6995, 179.36950000000002, 90.844, 98.42},
+		{161.94, 99.9605, 19.6015, 15.207, 226.45350000000002, 12.1625, 283.5585, 34.4355, 16.156, 123.1315, 91.0745, 87.661, 114.666, 94.173, 29.2725, 100.221, 28.637500000000003, 15.905000000000001, 307.5135, 158.45850000000002, 29.7675, 17.55, 20.637500000000003, 17.0345, 4.967499999999999, 147.236, 30.634999999999998, 36.1055, 169.233, 153.54649999999998, 116.477, 90.45, 23.4815, 50.655, 29.5335, 169.534, 19.481, 36.745, 164.60700000000003, 245.3555, 124.067, 96.0065, 159.2935, 23.810000000000002, 115.539, 135.2925, 273.29449999999997, 38.010000000000005, 329.259, 33.2445, 14.5745, 59.067, 68.888, 209.337, 202.873, 36.715, 87.404, 250.5045, 146.856, 69.91900000000001, 21.426499999999997, 166.281, 100.71549999999999, 103.26750000000001, 145.553, 102.584, 152.921, 128.353, 77.6435, 41.1345, 110.293, 109.089, 113.7635, 170.2305, 35.305499999999995, 203.1515, 100.152, 124.263, 177.26350000000002, 114.243, 314.5135, 108.852, 230.4355, 110.326, 132.30599999999998, 258.5375, 278.8065, 82.136, 103.8945, 54.801500000000004, 176.9115, 243.678, 129.458, 125.195, 118.89349999999999, 29.871499999999997, 80.7235, 184.7115, 119.5135, 176.1615, 102.251, 108.154, 315.35450000000003, 121.46, 94.076, 135.30349999999999, 121.1265, 122.011, 85.764, 19.469, 181.32049999999998, 22.924500000000002, 189.2975, 12.2735, 30.683999999999997, 24.5845, 2.644, 256.227, 31.632, 16.0405, 96.38499999999999, 165.266, 35.417500000000004, 260.16200000000003, 127.914, 277.96900000000005, 82.4995, 93.062, 185.716, 148.5595, 91.1, 107.083, 7.8435, 132.94650000000001, 25.3385, 23.5325, 17.8955, 28.9165, 76.304, 39.233, 12.8885, 22.749, 12.256499999999999, 15.493500000000001, 56.451, 26.2845, 41.3275, 20.333, 49.715, 28.877499999999998, 36.964, 206.427, 8.5455, 26.5315, 176.277, 47.1605, 14.215, 20.396, 11.148, 102.38550000000001, 154.4275, 14.8995, 170.373, 66.71000000000001, 23.4495, 14.5055, 107.746, 118.4565, 263.47, 87.2075, 13.6335, 102.406, 26.628500000000003, 221.33499999999998, 28.694499999999998, 44.352000000000004, 115.12950000000001, 73.31049999999999, 39.254000000000005, 15.577, 49.7055, 98.641, 67.293, 95.375, 111.3895, 32.4195, 187.438, 19.082, 0.029, 121.34899999999999, 28.747500000000002, 24.174, 127.4085, 75.846, 112.2705},
+		{308.2205, 182.3605, 129.202, 107.1525, 328.0305, 104.6365, 395.553, 126.237, 96.78, 204.404, 163.509, 164.54, 199.676, 173.417, 128.1055, 186.7685, 106.3885, 126.28200000000001, 401.33299999999997, 235.38400000000001, 127.8385, 118.3205, 128.63799999999998, 130.844, 112.8455, 224.1745, 125.4365, 152.70499999999998, 271.36850000000004, 235.838, 200.821, 171.9665, 107.49600000000001, 152.4445, 136.72199999999998, 314.7085, 131.84750000000003, 133.21300000000002, 257.701, 331.91049999999996, 206.08550000000002, 176.494, 238.826, 112.881, 191.24849999999998, 243.8345, 353.8115, 148.8555, 382.092, 145.34, 114.12, 167.22250000000003, 172.332, 281.3405, 365.1775, 145.571, 165.785, 348.1065, 232.302, 134.123, 101.952, 229.62650000000002, 183.187, 181.582, 229.9215, 183.5625, 240.71949999999998, 210.4845, 153.588, 105.17250000000001, 195.648, 187.485, 192.133, 235.029, 123.801, 353.286, 168.78, 195.9885, 255.91950000000003, 180.184, 390.4835, 192.683, 265.0485, 190.8845, 236.0895, 351.83, 361.1315, 163.989, 195.696, 164.622, 275.77049999999997, 330.097, 200.0745, 202.63799999999998, 199.36700000000002, 198.10500000000002, 165.8005, 269.307, 225.61700000000002, 264.3795, 183.8845, 189.19549999999998, 367.2405, 211.5625, 159.689, 234.0245, 213.011, 198.603, 168.275, 131.33499999999998, 263.8005, 128.1945, 268.4715, 113.7505, 137.26299999999998, 97.96000000000001, 120.3005, 357.22900000000004, 130.744, 118.2795, 177.2635, 228.01600000000002, 143.8845, 330.2305, 209.238, 329.001, 163.55700000000002, 202.799, 258.8135, 221.8265, 172.262, 178.4025, 112.73400000000001, 298.813, 105.74000000000001, 121.2, 125.87, 101.00999999999999, 159.3515, 149.202, 121.4085, 106.37, 101.8555, 125.847, 176.251, 132.981, 141.6635, 96.5735, 166.401, 126.72, 133.7905, 300.27099999999996, 121.05799999999999, 127.4565, 262.467, 158.0265, 104.50550000000001, 105.179, 102.118, 170.48950000000002, 241.7745, 112.4965, 256.7065, 161.755, 99.757, 104.511, 194.06799999999998, 202.56, 358.649, 167.2215, 120.74549999999999, 165.299, 122.57, 308.45550000000003, 120.4465, 137.6255, 192.6695, 182.2405, 147.4855, 107.555, 142.0975, 175.265, 158.9365, 178.2195, 195.517, 109.343, 275.571, 100.11349999999999, 121.4515, 0.043, 108.0375, 142.822, 289.6725, 198.83800000000002, 187.34300000000002},
+		{222.429, 104.4015, 39.913, 19.348, 227.3625, 26.209, 277.5245, 48.476, 12.0285, 123.28999999999999, 89.376, 95.3015, 113.4815, 90.5685, 36.0565, 97.3785, 29.776, 37.614000000000004, 292.1885, 156.13150000000002, 52.108, 35.7505, 46.366, 40.932, 21.5135, 151.225, 43.1575, 74.1635, 195.546, 149.7255, 115.67099999999999, 90.01650000000001, 23.408, 52.2745, 48.031, 213.8225, 42.1465, 62.7815, 162.2615, 237.415, 126.62, 103.5075, 159.492, 28.5895, 114.098, 197.2995, 282.111, 43.5975, 292.7335, 51.064, 27.933, 79.9745, 72.9745, 260.281, 298.5285, 58.554, 80.896, 270.6145, 149.32150000000001, 55.7515, 23.784999999999997, 155.4015, 109.82900000000001, 101.17349999999999, 157.986, 105.25399999999999, 182.9885, 151.108, 71.82499999999999, 28.095, 109.8155, 102.7685, 116.543, 157.16500000000002, 32.528999999999996, 272.6915, 86.94800000000001, 119.5145, 176.2605, 104.866, 313.29049999999995, 112.581, 188.993, 101.8965, 150.7365, 265.0385, 280.81050000000005, 80.58449999999999, 115.0875, 76.5385, 175.1395, 245.978, 125.219, 132.751, 113.0525, 51.30200000000001, 80.219, 189.1035, 132.4885, 174.93200000000002, 114.6555, 103.30250000000001, 277.7455, 132.0045, 80.2475, 159.7495, 129.79399999999998, 123.2575, 94.31049999999999, 40.211, 239.0075, 41.7565, 196.16699999999997, 25.3925, 58.541, 20.54, 27.006999999999998, 260.2645, 49.322500000000005, 27.8335, 96.255, 145.49650000000003, 61.437, 302.01800000000003, 130.0495, 284.96400000000006, 79.4085, 112.84899999999999, 183.7505, 149.296, 88.775, 103.3835, 27.305500000000002, 157.47, 21.475, 34.306, 41.2175, 16.7805, 79.72200000000001, 66.338, 27.543, 24.5795, 16.8405, 42.221000000000004, 69.1, 41.3965, 60.372, 18.421, 71.663, 47.223, 61.251000000000005, 300.57500000000005, 32.579499999999996, 45.3905, 166.1125, 68.9565, 23.616500000000002, 27.927999999999997, 19.252000000000002, 83.086, 197.3205, 30.248, 163.1995, 77.172, 21.852, 27.77, 104.4905, 111.05250000000001, 333.548, 95.01150000000001, 34.748000000000005, 85.90299999999999, 32.5105, 215.64499999999998, 32.913, 54.4985, 116.632, 92.337, 58.739000000000004, 29.173000000000002, 50.965, 97.526, 71.5745, 104.16900000000001, 117.723, 24.705, 184.0595, 22.1725, 29.039, 107.35300000000001, 0.033, 46.101, 195.38, 128.953, 113.294},
+		{214.29, 121.1215, 9.0505, 36.379, 249.48250000000002, 26.061999999999998, 294.057, 53.1475, 32.3445, 138.3705, 98.44049999999999, 117.25649999999999, 135.032, 114.60749999999999, 44.465500000000006, 122.285, 46.543499999999995, 29.6175, 307.72, 180.274, 41.721999999999994, 41.213499999999996, 34.0935, 26.6015, 18.906, 159.632, 41.798, 56.7215, 182.6285, 173.2125, 131.047, 106.3715, 42.366, 65.136, 44.1145, 176.43200000000002, 19.3665, 56.2545, 174.9565, 256.81, 144.828, 182.031, 175.1885, 39.285, 129.2295, 159.82150000000001, 371.214, 56.3065, 320.2855, 46.478, 32.1945, 72.628, 93.6545, 223.3035, 225.2875, 44.5145, 110.082, 259.129, 169.0965, 75.481, 37.39, 168.34199999999998, 125.0985, 120.9325, 173.765, 116.979, 174.784, 147.414, 78.39349999999999, 38.4045, 130.17950000000002, 129.95850000000002, 149.6775, 180.062, 51.6245, 293.428, 113.9175, 139.126, 196.94400000000002, 131.9765, 344.39549999999997, 138.8825, 194.5575, 120.356, 163.648, 239.57850000000002, 300.9145, 110.85300000000001, 124.6625, 26.082, 183.78449999999998, 261.225, 153.07850000000002, 136.5975, 131.211, 49.436, 110.293, 177.22449999999998, 125.52850000000001, 205.873, 122.723, 121.06700000000001, 312.2015, 146.8135, 103.939, 159.87, 148.2365, 138.48450000000003, 104.0775, 8.907, 291.6315, 25.744999999999997, 201.13549999999998, 27.1325, 25.9235, 33.1235, 21.658, 274.848, 5.218500000000001, 28.245, 117.47800000000001, 180.083, 73.17349999999999, 270.1495, 144.08550000000002, 305.0745, 94.3325, 120.0835, 198.3785, 163.793, 110.1435, 127.79050000000001, 21.462, 239.4, 43.821, 44.0135, 28.012999999999998, 37.067499999999995, 96.286, 43.146, 28.931, 31.5595, 25.875, 26.8595, 62.581500000000005, 37.480000000000004, 53.669, 29.695, 64.16, 43.121, 9.379999999999999, 283.3345, 51.3605, 73.0325, 187.6765, 18.116500000000002, 31.798499999999997, 30.208, 25.3835, 109.4015, 175.6585, 35.7715, 179.7825, 24.734499999999997, 40.298, 29.006999999999998, 127.162, 129.24, 204.6055, 107.1895, 29.597, 109.56200000000001, 63.171, 237.022, 46.3305, 58.5875, 267.077, 44.537, 52.489000000000004, 29.227, 58.391000000000005, 117.003, 85.67750000000001, 114.0875, 123.73599999999999, 45.495999999999995, 206.915, 32.198, 23.110500000000002, 140.9065, 45.564499999999995, 0.1835, 171.8305, 84.8335, 127.1575},
+		{334.9925, 216.8295, 244.9935, 182.357, 130.181, 125.58449999999999, 298.3885, 161.57299999999998, 188.815, 258.2215, 193.8635, 293.322, 272.055, 210.22199999999998, 146.0185, 208.19, 169.54149999999998, 141.4765, 292.7115, 244.4135, 230.4085, 196.711, 166.8155, 219.149, 147.3675, 249.704, 160.46, 188.0885, 65.44399999999999, 265.048, 246.58749999999998, 236.5155, 193.98250000000002, 177.50900000000001, 181.637, 26.436999999999998, 147.591, 207.7715, 327.08799999999997, 340.937, 244.3155, 196.80599999999998, 276.77099999999996, 175.65800000000002, 247.15550000000002, 19.786, 102.66749999999999, 166.19299999999998, 386.4085, 176.958, 196.7035, 147.4835, 174.6055, 119.66550000000001, 228.60250000000002, 206.16199999999998, 187.8915, 91.5275, 238.57799999999997, 160.737, 145.04250000000002, 252.9065, 219.728, 215.494, 220.1945, 246.31, 28.201, 242.95749999999998, 194.409, 186.2255, 231.9895, 263.535, 264.3645, 245.94099999999997, 127.88550000000001, 114.6445, 252.1255, 261.029, 320.0395, 236.3625, 342.674, 257.8395, 307.411, 242.874, 27.783, 98.9495, 238.5215, 188.11900000000003, 225.89600000000002, 270.39, 299.8605, 135.32299999999998, 221.893, 272.1195, 249.59550000000002, 146.329, 191.54149999999998, 265.303, 205.5355, 357.3205, 216.125, 215.8995, 366.244, 249.73250000000002, 188.667, 257.394, 280.9285, 280.6255, 204.192, 233.33249999999998, 74.18350000000001, 188.42149999999998, 233.995, 193.6125, 186.5625, 181.4375, 126.2385, 146.406, 153.371, 165.63, 287.9855, 229.514, 197.096, 100.19149999999999, 277.2645, 274.3275, 252.4675, 237.1235, 324.728, 286.015, 273.76250000000005, 234.51999999999998, 173.1035, 155.63150000000002, 171.64999999999998, 174.483, 167.461, 218.3225, 239.5375, 199.76299999999998, 149.798, 180.405, 141.804, 197.71, 215.1155, 156.07549999999998, 213.40050000000002, 161.5735, 242.2255, 182.29000000000002, 183.4475, 125.345, 180.2135, 147.8155, 353.025, 234.24450000000002, 144.553, 203.6925, 178.53199999999998, 194.1025, 17.5795, 211.207, 240.047, 221.68, 187.04, 146.4445, 240.899, 218.4155, 120.6635, 197.73700000000002, 124.62049999999999, 198.0725, 174.02749999999997, 344.6685, 135.7965, 210.2145, 245.33749999999998, 227.0205, 192.933, 119.72049999999999, 201.9485, 254.9185, 174.674, 242.7595, 272.63, 199.1745, 358.392, 179.4145, 127.55000000000001, 289.7305, 195.37599999999998, 167.3095, 0.0325, 239.19150000000002, 243.69400000000002},
+		{254.226, 171.6465, 68.368, 88.342, 279.5135, 95.64599999999999, 331.7215, 60.064, 83.6865, 183.08350000000002, 150.3715, 163.3225, 190.784, 169.38799999999998, 115.146, 168.95850000000002, 126.649, 66.9255, 345.81949999999995, 215.73950000000002, 85.5695, 82.7175, 79.851, 88.99449999999999, 103.4885, 197.9905, 100.63, 71.3505, 224.59199999999998, 235.8915, 194.5335, 166.5385, 89.6925, 110.601, 96.3415, 226.191, 73.9495, 87.419, 232.6445, 316.5595, 205.7725, 155.82799999999997, 235.88299999999998, 107.445, 186.76600000000002, 269.6545, 247.3885, 134.176, 353.68550000000005, 88.062, 98.1685, 117.8275, 144.245, 246.84550000000002, 261.053, 47.4375, 152.988, 287.471, 209.87, 124.734, 91.9625, 230.1345, 199.98000000000002, 171.987, 238.792, 162.805, 226.54399999999998, 209.494, 126.864, 92.7705, 207.996, 179.01749999999998, 188.69799999999998, 217.3905, 92.751, 355.1415, 167.12, 211.07, 230.1585, 172.0965, 387.168, 177.25, 263.85900000000004, 204.6985, 213.571, 371.66549999999995, 275.87350000000004, 144.233, 154.676, 86.414, 240.293, 320.1995, 190.1115, 175.05399999999997, 167.877, 75.8665, 147.958, 261.61249999999995, 202.0885, 259.568, 202.711, 172.43900000000002, 344.58799999999997, 227.985, 154.91750000000002, 250.34199999999998, 181.483, 182.1725, 185.598, 64.8005, 242.919, 65.856, 240.9735, 71.92699999999999, 74.4745, 77.114, 79.755, 224.4515, 77.1165, 131.365, 165.097, 200.051, 66.572, 267.229, 216.92849999999999, 330.201, 168.585, 73.836, 240.00650000000002, 214.031, 180.04500000000002, 193.047, 104.0495, 316.3065, 93.22749999999999, 75.412, 83.2505, 81.999, 122.2585, 104.465, 83.00999999999999, 77.63300000000001, 86.8275, 70.3235, 108.9065, 90.863, 100.6265, 78.7265, 90.42099999999999, 81.13550000000001, 63.33, 255.2355, 69.729, 66.542, 239.8515, 87.4625, 77.029, 83.43549999999999, 90.10749999999999, 158.601, 278.995, 81.769, 251.90550000000002, 55.094, 77.981, 85.259, 176.3105, 212.55849999999998, 314.1015, 166.7745, 78.31, 182.72199999999998, 67.932, 277.12149999999997, 94.4525, 88.649, 268.687, 62.617999999999995, 135.856, 83.188, 75.1195, 157.429, 137.191, 157.2235, 161.78, 120.01650000000001, 255.233, 90.823, 75.8835, 198.585, 129.411, 88.2985, 239.1945, 0.028, 209.384},
+		{113.87700000000001, 27.558, 126.37, 99.6425, 200.2175, 103.19649999999999, 309.967, 126.0445, 97.8845, 51.9335, 29.458, 33.2145, 38.1165, 28.214, 117.2185, 31.185000000000002, 117.598, 126.76400000000001, 288.765, 61.9975, 124.5865, 122.9425, 122.088, 124.595, 108.774, 69.246, 127.4, 151.362, 258.22299999999996, 63.317, 48.538, 25.762500000000003, 102.8765, 130.685, 127.484, 250.82600000000002, 132.0995, 150.3045, 104.305, 180.404, 47.712, 34.927, 71.8045, 104.983, 32.663, 273.29600000000005, 292.42449999999997, 123.17349999999999, 209.5085, 146.40699999999998, 109.1735, 155.9965, 157.7775, 258.3, 272.4665, 160.7005, 29.3465, 215.15050000000002, 73.503, 148.1895, 99.116, 74.056, 33.665, 13.530000000000001, 72.268, 47.098, 236.748, 38.7255, 161.5815, 99.4855, 38.439, 14.849499999999999, 34.39, 78.37299999999999, 100.867, 194.6255, 19.057, 51.403999999999996, 105.14150000000001, 10.447, 217.9995, 44.57, 112.4015, 37.831500000000005, 232.421, 223.49450000000002, 204.32299999999998, 29.2975, 180.68349999999998, 163.946, 254.32600000000002, 184.046, 27.0845, 43.1555, 42.843500000000006, 134.496, 29.7665, 261.249, 216.2295, 96.971, 14.2985, 35.623000000000005, 194.5775, 61.0985, 29.961, 82.8635, 42.5, 40.152, 32.1385, 127.8305, 256.494, 121.0605, 110.85650000000001, 112.68350000000001, 143.027, 95.58850000000001, 109.5085, 182.872, 134.0315, 109.155, 29.061500000000002, 88.653, 143.75400000000002, 238.185, 51.462, 266.183, 28.593, 203.5565, 253.789, 56.907, 38.1965, 23.814, 109.57849999999999, 282.675, 105.7195, 111.2875, 117.3545, 96.7235, 138.6735, 150.535, 105.271, 107.21600000000001, 102.65350000000001, 127.71549999999999, 150.3135, 124.3735, 145.65449999999998, 92.9505, 150.13549999999998, 132.09750000000003, 146.2095, 269.501, 114.81899999999999, 125.259, 110.1385, 157.72449999999998, 105.6635, 105.4205, 105.13499999999999, 32.1525, 277.6005, 107.4055, 91.0445, 160.6985, 97.648, 104.116, 20.929, 34.6045, 249.83350000000002, 30.2325, 109.5815, 24.1595, 118.47649999999999, 160.043, 115.987, 128.576, 219.635, 184.301, 140.767, 105.47, 139.6935, 32.297, 156.91750000000002, 38.4225, 35.7505, 114.4635, 109.604, 98.45349999999999, 112.3035, 187.2805, 113.485, 128.55349999999999, 243.6485, 209.398, 0.028},
+	}
+
+	stdLatency = [][]float64{
+		{0.0235, 0.487, 1.226, 0.579, 0.516, 0.499, 1.1844999999999999, 0.783, 0.5085, 0.4455, 0.4215, 0.8125, 0.336, 0.426, 0.5635, 0.3645, 0.513, 0.4875, 0.618, 0.5525, 0.584, 0.492, 0.7355, 0.6345000000000001, 1.9015, 0.4155, 0.49, 0.5495000000000001, 0.9904999999999999, 0.3305, 0.416, 0.6005, 2.0215, 0.6025, 0.705, 0.645, 0.6174999999999999, 0.5605, 0.464, 0.4125, 0.31, 0.397, 0.6355, 4.8725000000000005, 0.416, 4.167, 0.707, 0.7355, 0.6174999999999999, 0.7565, 0.5035000000000001, 4.651, 14.410499999999999, 2.261, 0.608, 0.569, 0.4895, 0.79, 0.4175, 0.5925, 0.557, 0.4005, 1.952, 0.4445, 0.403, 0.5005, 0.6365000000000001, 0.401, 0.542, 0.6705000000000001, 0.4545, 0.6225, 0.6455, 0.4205, 0.5085, 0.599, 0.4405, 0.334, 14.1805, 0.581, 0.795, 0.4615, 0.756, 0.324, 0.8394999999999999, 0.5975, 3.299, 0.42, 0.5925, 1.5939999999999999, 0.7175, 0.6865000000000001, 0.3775, 0.4275, 9.9635, 0.6565000000000001, 0.4305, 0.8045, 0.6345000000000001, 0.9475, 0.3735, 0.389, 0.9095, 0.444, 0.488, 5.8435, 0.481, 0.40249999999999997, 0.4795, 0.5854999999999999, 1.134, 0.498, 0.5475, 0.507, 2.5605, 0.547, 0.4475, 0.5714999999999999, 0.52, 1.5510000000000002, 0.6845, 0.9205, 0.871, 4.9864999999999995, 0.45, 0.5794999999999999, 0.3385, 0.8265, 0.5885, 0.3615, 0.449, 0.388, 0.5525, 1.913, 0.5105, 0.6755, 0.456, 0.9889999999999999, 0.669, 0.532, 0.8385, 0.506, 0.5035000000000001, 0.522, 0.5225, 1.764, 27.817, 0.4255, 0.9864999999999999, 0.6745000000000001, 0.5605, 0.63, 0.516, 0.5465, 2.4459999999999997, 0.551, 0.819, 0.558, 0.432, 0.5874999999999999, 0.7144999999999999, 0.4885, 0.433, 0.6825000000000001, 0.449, 0.9655, 0.3935, 0.401, 5.575, 0.4535, 0.5545, 0.352, 0.5145, 0.674, 0.4585, 0.7175, 0.4565, 0.594, 0.4945, 0.542, 0.5465, 0.40449999999999997, 2.2065, 0.376, 0.3955, 1.3210000000000002, 0.0, 0.518, 0.38, 0.778, 3.2405, 5.6685, 0.6845000000000001, 0.5925, 0.392},
+		{0.5375000000000001, 0.015, 0.8705, 0.3725, 0.503, 0.935, 1.0835, 0.8405, 0.4375, 0.7745, 0.401, 1.24, 0.308, 0.293, 0.3815, 0.3705, 0.34099999999999997, 0.4555, 0.588, 0.6315, 0.446, 0.404, 0.806, 0.5529999999999999, 0.3965, 0.349, 0.4475, 0.404, 1.198, 0.327, 0.27, 0.3735, 2.05, 0.4265, 0.9844999999999999, 0.516, 0.567, 0.4705, 0.433, 0.526, 0.2665, 0.19, 0.544, 5.349500000000001, 0.2475, 4.1475, 0.675, 0.782, 0.545, 0.6174999999999999, 0.4325, 4.807, 14.504000000000001, 3.012, 0.5549999999999999, 0.523, 0.2995, 0.685, 0.3615, 0.514, 0.40049999999999997, 0.339, 2.8255, 0.261, 0.3505, 0.34450000000000003, 0.558, 0.2915, 0.4505, 0.824, 0.31, 0.9505, 0.4825, 0.3685, 0.37, 0.5085, 0.3165, 0.2875, 13.142499999999998, 0.26, 1.1960000000000002, 0.2, 0.874, 0.28800000000000003, 0.6325000000000001, 0.6205, 0.511, 0.1855, 0.516, 1.906, 0.658, 0.616, 0.2375, 0.277, 9.370000000000001, 0.4975, 0.2375, 1.44, 0.6025, 1.2135, 0.2505, 0.405, 0.841, 0.364, 0.2105, 8.2035, 0.2875, 0.277, 1.47, 0.3925, 1.369, 0.40449999999999997, 0.4525, 0.439, 1.3925, 0.4145, 0.385, 0.515, 0.53, 1.3115, 0.2965, 0.837, 0.9944999999999999, 6.057499999999999, 0.2975, 0.5745, 0.236, 0.5265, 0.581, 0.4585, 0.28900000000000003, 0.17, 0.648, 0.6315, 0.3715, 0.626, 0.382, 1.053, 0.557, 0.434, 0.912, 0.3425, 0.3575, 0.479, 0.8614999999999999, 2.3735, 27.235500000000002, 0.383, 0.751, 0.4305, 0.541, 0.592, 0.4565, 0.404, 2.8970000000000002, 0.5589999999999999, 1.1745, 0.5355000000000001, 0.387, 0.546, 0.6475, 0.425, 0.4015, 0.6865, 0.4035, 1.3875, 0.1895, 0.305, 12.8015, 0.34550000000000003, 0.444, 0.1875, 0.389, 1.965, 0.3645, 1.877, 0.6234999999999999, 0.5405, 0.403, 0.792, 0.5645, 0.223, 2.1045, 0.195, 0.2585, 1.5185, 0.0, 0.347, 0.36, 1.4195, 3.41, 6.8065, 0.623, 0.6265000000000001, 0.2275},
+		{1.1179999999999999, 1.5150000000000001, 0.015, 0.624, 0.837, 0.732, 1.702, 0.886, 1.055, 1.5415, 0.9395, 1.4489999999999998, 0.8919999999999999, 0.9385, 0.831, 0.7464999999999999, 0.998, 0.8075, 1.2625, 1.1655000000000002, 0.8714999999999999, 1.0585, 1.3555000000000001, 1.1345, 0.8305, 0.9205000000000001, 0.8365, 0.7525, 1.667, 1.0585, 0.848, 1.092, 2.333, 0.9675, 1.058, 1.0234999999999999, 1.1915, 0.8055, 0.969, 1.1139999999999999, 0.663, 0.9185000000000001, 1.3205, 6.352, 0.967, 4.5280000000000005, 0.901, 1.103, 0.9165000000000001, 0.957, 0.831, 4.746, 16.177, 2.7465, 0.9815, 1.156, 0.774, 1.2035, 0.9544999999999999, 0.5435, 1.0474999999999999, 0.8474999999999999, 2.5330000000000004, 0.7875000000000001, 0.7364999999999999, 1.004, 0.9924999999999999, 0.7455, 0.8975, 1.141, 0.6765000000000001, 1.409, 0.9884999999999999, 0.6775, 0.9025000000000001, 1.0835, 0.9475, 0.8225, 12.872499999999999, 1.1684999999999999, 1.2365, 0.9475, 1.3955, 0.846, 1.2955, 1.0015, 0.9175, 0.739, 0.8385, 1.8719999999999999, 1.0855000000000001, 0.8945000000000001, 0.723, 0.935, 9.3845, 1.007, 0.9875, 2.1475, 1.0815000000000001, 1.471, 0.877, 1.3305, 1.1389999999999998, 0.962, 0.8255, 6.3635, 0.9345, 1.0270000000000001, 1.7454999999999998, 0.896, 1.4649999999999999, 0.615, 1.0325, 0.678, 2.4565, 0.919, 0.8295, 0.9695, 0.944, 2.526, 0.9564999999999999, 1.6545, 1.306, 5.1035, 1.0175, 0.7815000000000001, 0.9255, 0.846, 0.9510000000000001, 0.9784999999999999, 0.8955, 1.2429999999999999, 1.6235, 1.0514999999999999, 0.817, 1.311, 0.501, 1.609, 1.044, 0.668, 2.818, 0.8300000000000001, 0.823, 0.823, 1.15, 2.193, 30.685499999999998, 0.9505, 1.367, 1.3115, 0.8505, 1.0095, 0.903, 0.8334999999999999, 2.854, 0.901, 1.325, 0.8985000000000001, 0.8029999999999999, 1.384, 1.2675, 1.0510000000000002, 0.7815000000000001, 1.013, 0.794, 0.9695, 0.651, 0.874, 1.893, 1.1495, 0.8300000000000001, 1.081, 0.837, 1.6070000000000002, 0.8999999999999999, 0.9530000000000001, 1.2475, 0.8985, 0.9515, 0.9624999999999999, 0.909, 0.91, 2.2075, 0.8585, 0.9415, 1.491, 0.0, 1.3399999999999999, 0.8674999999999999, 1.745, 3.6455, 7.1445, 1.0895000000000001, 0.944, 0.8125},
+		{0.55, 0.3365, 0.866, 0.014499999999999999, 0.529, 0.1875, 0.97, 0.491, 0.26, 0.443, 0.346, 0.8005, 0.3725, 0.459, 0.2355, 0.3055, 0.211, 0.2065, 0.5375000000000001, 0.5609999999999999, 0.293, 0.2025, 0.6965, 0.327, 0.1605, 0.457, 0.235, 0.269, 0.9225, 0.3885, 0.3895, 0.4905, 2.1515, 0.2685, 0.6739999999999999, 0.491, 0.393, 0.3045, 0.4545, 0.642, 0.368, 0.313, 0.479, 8.728000000000002, 0.365, 4.217499999999999, 0.645, 0.522, 0.5605, 0.49, 0.159, 3.879, 16.7, 2.066, 0.504, 0.3265, 0.3625, 0.637, 0.4625, 0.3405, 0.1855, 0.4265, 1.9100000000000001, 0.318, 0.4215, 0.3585, 0.4375, 0.414, 0.3355, 0.441, 0.3425, 0.5589999999999999, 0.601, 0.4425, 0.1955, 0.549, 0.291, 0.339, 13.5945, 0.378, 0.7775000000000001, 0.357, 0.746, 0.3755, 0.508, 0.555, 0.5665, 0.307, 0.3215, 1.62, 0.6065, 0.5865, 0.388, 0.411, 10.137, 0.4485, 0.328, 0.5765, 0.5235000000000001, 0.8394999999999999, 0.34450000000000003, 0.397, 0.804, 0.3775, 0.3615, 6.1884999999999994, 0.3585, 0.351, 1.075, 0.2505, 0.9025000000000001, 0.245, 0.5745, 0.2455, 1.249, 0.1945, 0.1655, 0.483, 0.366, 1.4065, 0.3705, 0.9105, 0.619, 4.8475, 0.4035, 0.5655, 0.3125, 0.3805, 0.40349999999999997, 0.4645, 0.306, 0.357, 0.3705, 0.4345, 0.2075, 0.2655, 0.196, 0.667, 0.358, 0.2805, 0.7125, 0.1765, 0.161, 0.2415, 0.317, 1.4735, 26.618, 0.1635, 0.735, 0.286, 0.403, 0.46799999999999997, 0.1975, 0.2225, 2.3495, 0.2605, 0.788, 0.2435, 0.183, 0.5035000000000001, 0.5175000000000001, 0.162, 0.40800000000000003, 0.3615, 0.217, 0.209, 0.3275, 0.34650000000000003, 0.6285000000000001, 0.316, 0.2235, 0.3185, 0.194, 0.988, 0.1925, 0.5455000000000001, 0.48, 0.4195, 0.266, 0.178, 0.269, 0.3295, 1.8865, 0.3175, 0.3495, 1.275, 0.0, 0.427, 0.161, 0.6779999999999999, 3.045, 6.8535, 0.5854999999999999, 0.414, 0.363},
+		{0.5325, 0.496, 1.1019999999999999, 0.482, 0.014, 0.5345, 1.002, 0.6815, 0.528, 0.489, 0.429, 0.617, 0.462, 0.5515000000000001, 0.5155000000000001, 0.452, 0.52, 0.485, 0.40349999999999997, 0.5285, 0.4585, 0.4935, 0.803, 0.602, 0.4725, 0.35950000000000004, 0.4645, 0.46950000000000003, 0.7965, 0.383, 0.449, 0.607, 1.8325, 0.5555000000000001, 0.847, 3.945, 0.6245, 0.49, 0.4795, 0.5805, 0.409, 0.431, 0.4165, 4.133, 0.378, 3.751, 0.3955, 0.75, 0.477, 0.695, 0.535, 4.042999999999999, 13.955, 3.2595, 0.367, 0.701, 0.485, 0.4245, 0.416, 0.6005, 0.515, 0.363, 1.7325, 0.447, 0.304, 0.455, 0.34450000000000003, 0.3905, 0.565, 0.5665, 0.5295000000000001, 0.5674999999999999, 0.6425000000000001, 0.4005, 0.4605, 0.246, 0.4225, 0.438, 11.557, 0.54, 0.743, 0.4005, 0.7885, 0.3965, 0.477, 0.306, 0.3145, 0.377, 0.56, 1.4714999999999998, 0.775, 0.242, 0.433, 0.3575, 7.991, 0.6325000000000001, 2.0244999999999997, 0.8265, 2.2430000000000003, 0.6285000000000001, 0.4005, 0.3645, 0.825, 0.439, 0.434, 6.2625, 0.357, 0.3535, 0.587, 0.5515000000000001, 0.783, 0.4, 0.4145, 0.612, 1.0805, 0.507, 0.492, 0.152, 0.614, 0.751, 0.487, 0.725, 0.92, 5.1155, 0.40149999999999997, 0.4305, 0.423, 0.5885, 0.6645000000000001, 0.4125, 0.4405, 0.4555, 0.6455, 0.4485, 0.4785, 0.625, 0.534, 0.8765000000000001, 0.5805, 0.507, 1.1555, 0.4765, 0.5485, 0.5625, 0.59, 1.775, 27.789, 0.486, 0.828, 0.735, 0.612, 0.3145, 0.5695, 0.5475000000000001, 2.64, 0.5005, 0.8645, 0.5015000000000001, 0.472, 0.5925, 0.6545000000000001, 0.429, 0.423, 0.705, 0.504, 1.2175, 0.3055, 0.429, 0.46499999999999997, 0.498, 0.521, 0.4565, 0.504, 0.804, 0.5165, 0.6825, 0.602, 0.5605, 0.507, 0.4315, 0.4925, 0.445, 1.928, 0.524, 0.4145, 1.4184999999999999, 0.0, 0.486, 0.4965, 1.0625, 3.3875, 4.1405, 0.5245, 0.628, 0.477},
+		{0.491, 0.40800000000000003, 0.778, 0.184, 0.5635, 0.014, 1.1075, 0.585, 0.2465, 0.5725, 0.2925, 1.0445, 0.406, 0.3885, 0.2365, 0.3745, 0.191, 0.1845, 0.681, 0.623, 0.2795, 0.1745, 0.6970000000000001, 0.3615, 0.165, 0.4155, 0.217, 0.269, 0.7204999999999999, 0.344, 0.353, 0.4885, 2.0235000000000003, 0.245, 0.4265, 0.432, 0.405, 0.2825, 0.508, 0.534, 0.371, 0.3805, 0.47450000000000003, 8.258, 0.40149999999999997, 4.217499999999999, 0.6275, 0.471, 0.644, 0.417, 0.6565, 4.214499999999999, 14.746500000000001, 3.008, 0.8445, 0.34950000000000003, 0.3815, 0.6525000000000001, 0.412, 0.376, 0.1755, 0.4, 2.1045, 0.355, 0.4005, 0.3655, 0.437, 0.432, 0.2975, 0.39149999999999996, 0.414, 0.625, 0.5345, 0.442, 0.185, 0.5235000000000001, 0.403, 0.4225, 14.355, 0.435, 0.77, 0.3315, 0.8405, 0.4235, 0.824, 0.4935, 0.579, 0.2615, 0.366, 1.6315, 0.5885, 0.6034999999999999, 0.396, 0.38, 6.1545000000000005, 0.4145, 1.3094999999999999, 0.62, 0.47, 1.5055, 0.3315, 0.364, 0.85, 0.357, 0.349, 6.6335, 0.355, 0.383, 0.384, 0.218, 0.911, 0.278, 0.4985, 0.23099999999999998, 0.909, 0.1995, 0.162, 0.4965, 0.39, 1.4695, 0.33, 0.7565, 1.3355000000000001, 5.0595, 0.3515, 0.5825, 0.3495, 1.3675000000000002, 0.4435, 0.378, 0.3035, 0.637, 0.459, 0.5555000000000001, 0.1815, 0.3075, 0.182, 0.966, 0.897, 0.6065, 0.737, 0.131, 0.1285, 0.248, 0.312, 1.5495, 27.459, 0.1615, 0.6045, 0.265, 0.4065, 0.5325, 0.2235, 0.2675, 2.799, 0.2655, 1.322, 0.20600000000000002, 0.234, 0.6695, 0.43, 0.153, 0.423, 0.3505, 0.1915, 1.0085, 0.3225, 0.337, 0.605, 0.3095, 0.2255, 0.318, 0.311, 1.101, 0.2275, 0.51, 0.952, 0.5760000000000001, 0.207, 0.1425, 0.2585, 0.3325, 2.0635000000000003, 0.378, 0.4055, 1.367, 0.1355, 0.194, 0.174, 0.699, 3.1865, 4.3934999999999995, 0.568, 0.503, 0.3745},
+		{0.706, 0.623, 1.4445000000000001, 0.6735, 0.485, 0.985, 0.0185, 0.977, 0.673, 0.9395, 0.687, 0.8674999999999999, 0.712, 0.638, 0.624, 0.5455, 0.634, 0.669, 0.257, 0.6915, 0.7235, 0.8095, 0.969, 0.6865, 0.642, 0.586, 0.696, 0.6565000000000001, 1.0455, 0.4685, 0.5385, 0.9015, 2.1275, 0.608, 2.7295, 4.2379999999999995, 0.892, 0.6765000000000001, 0.673, 0.8145, 0.6165, 0.619, 0.619, 4.1370000000000005, 0.578, 4.1045, 0.542, 0.846, 0.1735, 0.857, 0.79, 4.185499999999999, 15.549499999999998, 10.999500000000001, 0.5994999999999999, 0.8374999999999999, 0.765, 0.692, 0.585, 0.68, 0.5680000000000001, 0.47050000000000003, 2.8805, 0.646, 0.6194999999999999, 0.6984999999999999, 0.5135000000000001, 0.557, 0.664, 0.7555000000000001, 0.593, 0.7244999999999999, 0.7805, 0.569, 0.5105, 0.5575, 0.5655, 0.652, 11.1695, 0.6835, 1.1164999999999998, 0.6745000000000001, 0.871, 0.5705, 0.7095, 4.072, 0.6805000000000001, 0.532, 0.6435, 1.701, 0.999, 0.6975, 0.6214999999999999, 0.5725, 7.1455, 0.7845, 0.549, 2.492, 0.9085000000000001, 0.848, 0.7404999999999999, 0.6405000000000001, 0.685, 0.865, 0.672, 6.7425, 0.7625, 0.676, 1.8725, 0.674, 0.978, 0.6555, 0.749, 0.7055, 1.3655, 0.657, 0.6034999999999999, 0.4455, 0.7845, 1.676, 0.6455, 1.2135, 1.1055, 4.9855, 0.568, 0.3575, 0.758, 0.797, 1.998, 0.7124999999999999, 0.638, 0.5845, 0.74, 0.6815, 0.637, 0.6805000000000001, 0.655, 1.1895, 0.614, 0.662, 1.1085, 0.7075, 0.615, 0.8554999999999999, 1.486, 2.3845, 28.8575, 0.638, 1.062, 0.983, 0.6685000000000001, 0.515, 0.582, 0.687, 3.005, 0.703, 1.411, 0.6234999999999999, 5.721, 0.7375, 0.5405, 0.629, 0.706, 0.8634999999999999, 0.599, 1.5830000000000002, 0.6134999999999999, 0.6705, 1.885, 0.581, 0.624, 0.6475, 0.567, 1.038, 0.7875000000000001, 1.8755, 0.754, 0.6675, 0.663, 0.854, 0.8165, 0.502, 2.2065, 0.703, 0.5754999999999999, 1.749, 0.0, 0.6930000000000001, 0.657, 1.2135, 3.2024999999999997, 5.0085, 3.9385, 0.856, 0.6034999999999999},
+		{0.8474999999999999, 0.7655000000000001, 1.0425, 0.528, 0.7095, 0.6625000000000001, 1.23, 0.017, 0.589, 0.9015, 0.617, 1.2469999999999999, 0.6964999999999999, 0.8785000000000001, 0.5185, 0.6114999999999999, 0.703, 0.635, 0.861, 0.964, 0.5335000000000001, 0.441, 0.921, 0.9205, 0.604, 0.752, 0.581, 0.4545, 1.752, 0.5994999999999999, 0.715, 0.6635, 1.981, 0.5705, 1.062, 0.706, 0.8105, 0.6595, 0.6345000000000001, 0.865, 0.6214999999999999, 0.606, 0.9145000000000001, 6.5745000000000005, 0.66, 4.5345, 0.8995, 0.75, 0.744, 0.652, 0.7809999999999999, 4.293, 16.5815, 3.1765, 0.767, 0.745, 0.7535000000000001, 0.833, 0.663, 0.68, 0.554, 0.7565, 3.0435, 0.7735000000000001, 0.8235, 0.6025, 0.6525000000000001, 0.5605, 0.47850000000000004, 0.7785, 0.8585, 1.0735000000000001, 0.798, 0.8075, 0.593, 0.89, 0.6194999999999999, 0.754, 11.6735, 0.7555000000000001, 1.1235, 0.6475, 1.1635, 0.557, 0.8155, 0.8265, 0.8025, 0.755, 0.5925, 1.9565000000000001, 0.747, 0.9484999999999999, 0.667, 0.8, 10.524999999999999, 0.653, 0.7505, 0.8865000000000001, 0.7845, 1.0185, 0.6555, 0.9305000000000001, 1.1015000000000001, 0.698, 0.6745, 6.2795000000000005, 0.6859999999999999, 0.6755, 0.878, 0.6455, 1.165, 0.47250000000000003, 0.807, 0.627, 2.057, 0.59, 0.4555, 0.7605, 0.5755, 2.7515, 0.6839999999999999, 1.3864999999999998, 0.845, 6.24, 0.7295, 0.896, 0.658, 0.679, 0.7715000000000001, 0.713, 0.645, 0.7805, 0.6615, 1.0235, 0.591, 0.9359999999999999, 0.5714999999999999, 0.988, 0.6625000000000001, 0.614, 0.9504999999999999, 0.4575, 0.451, 0.5605, 0.665, 1.807, 26.950000000000003, 0.514, 1.252, 0.5525, 0.6725, 0.6839999999999999, 0.5275000000000001, 0.534, 2.7350000000000003, 0.606, 0.9724999999999999, 0.9715, 0.516, 1.0735000000000001, 0.7845, 0.602, 0.6725, 0.729, 0.568, 1.736, 0.657, 0.638, 1.947, 0.593, 0.5505, 0.5525, 0.5734999999999999, 1.139, 0.658, 1.054, 0.804, 0.7024999999999999, 0.5165, 0.4975, 0.541, 0.8, 2.1835, 0.688, 0.613, 1.3225, 0.0, 0.6910000000000001, 0.414, 0.9884999999999999, 3.0335, 5.055, 0.7075, 0.6365000000000001, 0.6465000000000001},
+		{0.754, 0.509, 0.949, 0.298, 0.5714999999999999, 0.7689999999999999, 1.4695, 0.8029999999999999, 0.0285, 0.5695, 0.5215000000000001, 1.0070000000000001, 0.504, 0.518, 0.3815, 0.457, 0.2975, 0.3105, 0.6415, 0.5665, 0.4875, 0.901, 0.671, 0.384, 0.4145, 0.498, 0.367, 0.356, 1.0025, 0.45899999999999996, 0.46950000000000003, 0.6315, 2.0975, 0.3405, 0.6855, 0.508, 0.5105, 0.3255, 0.477, 0.6345000000000001, 0.469, 0.389, 0.681, 7.4079999999999995, 0.377, 4.0645, 0.566, 0.724, 3.5005, 0.5814999999999999, 0.351, 4.329, 14.469999999999999, 3.82, 0.6855, 0.365, 0.4325, 0.752, 0.5085, 0.41400000000000003, 0.3245, 0.4925, 1.841, 0.4335, 0.5335000000000001, 0.4365, 0.5495000000000001, 0.458, 0.3815, 0.5235000000000001, 0.53, 0.806, 0.6944999999999999, 0.4825, 0.2765, 0.644, 0.395, 0.4475, 14.025, 0.474, 1.5735000000000001, 0.435, 0.7925, 0.4085, 0.6885, 0.628, 0.7304999999999999, 0.352, 0.462, 1.5235, 0.585, 0.655, 0.4775, 0.477, 7.2445, 0.4985, 0.446, 0.8075, 0.6545000000000001, 0.9425, 0.648, 0.4595, 0.9455, 2.2445000000000004, 0.4415, 6.182, 0.47050000000000003, 0.39649999999999996, 3.3965, 0.2845, 1.117, 0.371, 0.732, 0.3455, 1.4285, 0.368, 0.2645, 2.1725, 0.446, 1.1520000000000001, 0.5575, 0.7915000000000001, 0.825, 4.934, 0.5760000000000001, 0.645, 3.0105, 0.5125, 0.4895, 0.569, 0.3575, 0.411, 0.42100000000000004, 0.5725, 0.3535, 0.5465, 0.363, 0.8305, 0.479, 0.321, 0.9375, 0.269, 0.2375, 1.3984999999999999, 0.8905000000000001, 1.2919999999999998, 28.4605, 0.33, 0.8815, 0.3135, 0.4635, 0.5475000000000001, 0.306, 0.3265, 2.7995, 0.412, 1.6315, 0.36, 0.321, 0.667, 0.5635, 0.2575, 0.4505, 0.471, 0.28, 1.429, 0.404, 0.4255, 0.8160000000000001, 0.392, 0.34099999999999997, 0.394, 0.351, 0.8195, 0.3535, 1.858, 0.5245, 0.436, 0.3355, 0.8240000000000001, 0.996, 0.3965, 2.0205, 0.408, 0.496, 1.4220000000000002, 0.0, 0.2165, 0.2725, 2.4444999999999997, 3.3405, 6.2725, 0.6345000000000001, 0.483, 0.4315},
+		{0.4935, 0.5075, 1.427, 0.9555, 0.4615, 1.178, 1.6145, 1.0055, 0.46950000000000003, 0.02, 0.534, 2.651, 0.288, 0.8520000000000001, 0.4675, 0.6085, 0.4565, 0.5225, 1.1345, 0.66, 0.7845, 0.5055000000000001, 1.48, 0.5485, 0.4885, 0.2995, 0.4965, 0.535, 1.0979999999999999, 0.238, 0.2635, 1.1065, 2.6795, 0.47150000000000003, 2.515, 0.7064999999999999, 0.6174999999999999, 0.5195000000000001, 0.424, 1.0390000000000001, 0.2655, 0.33899999999999997, 1.365, 7.4585, 0.2355, 4.675, 0.658, 1.092, 0.5415000000000001, 1.0685, 0.47050000000000003, 5.149, 15.183, 5.9375, 0.6074999999999999, 0.7235, 1.749, 0.7885, 0.279, 0.656, 0.4575, 0.27, 3.2795, 0.312, 0.333, 0.3265, 0.6435, 0.2675, 0.563, 0.9635, 0.437, 2.0015, 0.5754999999999999, 0.357, 0.472, 0.6859999999999999, 0.5885, 0.4265, 13.5805, 0.3265, 1.4455, 0.2025, 0.7955000000000001, 0.2855, 1.207, 0.5934999999999999, 0.597, 0.3025, 0.6275, 2.234, 0.838, 0.5435000000000001, 0.182, 0.311, 11.1425, 0.5685, 0.5495000000000001, 1.17, 0.717, 1.4365, 0.372, 0.4215, 1.532, 0.2505, 0.4165, 11.5265, 0.2265, 0.2025, 3.3105, 0.4795, 3.1135, 0.5135000000000001, 1.1355, 0.482, 2.3025, 0.562, 0.41000000000000003, 0.537, 0.605, 3.084, 0.412, 1.427, 1.1440000000000001, 6.3245000000000005, 0.34199999999999997, 0.637, 0.303, 0.572, 1.1495, 0.263, 0.2965, 1.268, 1.1844999999999999, 0.612, 0.3995, 0.7055, 0.5155000000000001, 1.3115, 0.572, 0.478, 1.3125, 0.4615, 0.439, 0.7404999999999999, 1.3475, 3.3245, 26.3365, 0.4275, 2.1205, 0.793, 0.5525, 0.8365, 0.466, 0.523, 3.24, 0.769, 1.3695, 0.5275000000000001, 0.475, 0.9595, 0.8185, 0.367, 0.3205, 0.9435, 0.5305, 2.5505, 0.3175, 0.289, 14.8355, 0.27749999999999997, 0.4975, 0.309, 0.5125, 7.241, 0.448, 1.226, 0.668, 0.631, 0.5135000000000001, 0.7065, 0.5285, 0.2725, 2.1500000000000004, 0.5715, 0.47750000000000004, 2.1025, 0.0645, 0.488, 0.431, 1.7625, 3.9035, 7.6215, 0.7575000000000001, 0.6915, 0.3435},
+		{0.3945, 0.155, 0.906, 0.327, 0.406, 0.279, 0.8975, 0.674, 0.3295, 0.314, 0.009, 0.6165, 0.20650000000000002, 0.1875, 0.3585, 0.17, 0.3205, 0.3845, 0.434, 0.462, 0.37, 0.31, 0.6100000000000001, 0.464, 0.2775, 0.2875, 0.3235, 0.3735, 0.8165, 0.2425, 0.2295, 0.3985, 2.0515, 0.379, 0.8615, 0.4905, 0.4755, 0.422, 0.333, 0.426, 0.191, 0.123, 0.3625, 4.919499999999999, 0.178, 3.9795, 0.586, 0.698, 0.5295000000000001, 0.5295000000000001, 0.2975, 4.41, 12.2065, 1.7045, 0.593, 0.3985, 0.1095, 0.5575, 0.311, 0.4245, 0.3015, 0.309, 1.587, 0.182, 0.314, 0.221, 0.516, 0.283, 0.3755, 0.422, 0.1805, 0.3885, 0.483, 0.3165, 0.265, 0.447, 0.177, 0.198, 11.582, 0.21, 0.713, 0.2005, 0.61, 0.151, 0.535, 0.5405, 0.4615, 0.07, 0.3805, 1.71, 0.656, 0.531, 0.239, 0.2445, 7.734, 0.41000000000000003, 0.2085, 0.624, 0.6085, 0.661, 0.2015, 0.224, 0.795, 0.2365, 0.135, 7.5145, 0.223, 0.1595, 0.185, 0.3695, 0.9355, 0.286, 0.387, 0.349, 0.9764999999999999, 0.292, 0.2965, 0.421, 0.423, 2.6870000000000003, 0.2, 0.73, 0.7195, 4.3965, 0.206, 0.4585, 0.077, 0.40049999999999997, 0.481, 0.2845, 0.124, 0.1305, 0.4125, 0.563, 0.326, 0.413, 0.321, 0.728, 0.5155000000000001, 0.382, 0.8160000000000001, 0.245, 0.2925, 0.3005, 0.3775, 1.557, 28.351999999999997, 0.2765, 0.7395, 0.375, 0.4665, 0.5135000000000001, 0.3645, 0.356, 2.652, 0.309, 0.745, 0.335, 0.3125, 0.356, 0.493, 0.246, 0.2865, 0.4545, 0.301, 1.214, 0.274, 0.358, 0.671, 0.111, 0.313, 0.107, 0.3415, 0.539, 0.328, 0.537, 0.5654999999999999, 0.4395, 0.341, 0.3065, 0.36, 0.1355, 1.818, 0.14250000000000002, 0.2125, 1.4935, 0.0555, 0.27849999999999997, 0.299, 0.677, 3.505, 8.3305, 0.5385, 0.4485, 0.182},
+		{0.40700000000000003, 0.611, 1.5535, 0.4795, 0.5625, 0.8175, 1.4725000000000001, 1.2405, 0.614, 0.9219999999999999, 0.4205, 0.706, 0.419, 0.5665, 0.4575, 0.35250000000000004, 0.5175000000000001, 0.5355, 0.6665000000000001, 0.604, 0.7945, 0.6679999999999999, 1.004, 0.9390000000000001, 0.592, 0.6635, 0.491, 0.5575000000000001, 2.2715, 0.46499999999999997, 0.417, 0.7595000000000001, 2.2455, 0.583, 2.2275, 0.7284999999999999, 0.6725, 0.507, 0.549, 0.563, 0.3575, 1.039, 0.878, 7.406499999999999, 0.332, 4.3475, 0.781, 0.7855000000000001, 0.6950000000000001, 0.778, 0.904, 5.01, 16.435000000000002, 3.4139999999999997, 0.7515000000000001, 0.6545000000000001, 0.6815, 1.0485, 0.40549999999999997, 0.8029999999999999, 0.692, 0.4095, 2.6734999999999998, 0.432, 0.4485, 0.6225, 0.872, 0.354, 0.574, 0.8395, 0.909, 1.232, 0.6445000000000001, 0.469, 0.4745, 0.656, 0.397, 0.4215, 13.4605, 0.899, 1.2315, 1.0625, 1.2915, 0.426, 0.7675, 0.6875, 0.6125, 0.383, 0.649, 2.4905, 0.98, 0.7235, 0.8955, 0.503, 11.7025, 0.6845, 0.4585, 1.502, 0.87, 1.3115, 0.6120000000000001, 0.416, 0.898, 0.5435, 0.332, 7.747, 0.5469999999999999, 0.373, 2.0035, 0.6065, 1.9144999999999999, 0.481, 0.639, 0.61, 1.6435, 0.5495, 0.4215, 0.6145, 0.9675, 2.1045, 0.5475, 1.391, 1.0045, 5.7165, 0.551, 0.7104999999999999, 0.349, 0.7424999999999999, 0.6435, 0.6845, 0.1725, 0.7335, 0.926, 0.717, 0.585, 0.9584999999999999, 0.492, 1.2385000000000002, 0.7885, 0.575, 1.035, 0.515, 0.399, 0.46950000000000003, 0.7985, 3.2539999999999996, 26.6415, 0.4805, 1.18, 0.723, 0.6335, 0.738, 0.47650000000000003, 0.7284999999999999, 2.9, 0.515, 1.134, 0.6234999999999999, 0.4365, 0.961, 0.968, 0.505, 0.494, 0.852, 0.5285, 2.0575, 0.7024999999999999, 0.4785, 3.158, 0.3805, 0.5589999999999999, 0.2995, 0.591, 1.473, 0.573, 1.393, 0.9575, 0.9495, 0.5529999999999999, 0.5225, 0.662, 0.492, 2.3315, 0.5645, 0.429, 1.9215, 0.122, 0.4905, 0.4525, 1.2080000000000002, 3.4314999999999998, 4.633, 1.0545, 0.766, 0.53},
+		{0.39, 0.305, 0.771, 0.417, 0.4565, 0.5974999999999999, 1.009, 0.663, 0.419, 0.28600000000000003, 0.258, 1.0165, 0.015, 0.305, 0.4405, 0.1895, 0.3565, 0.4385, 0.6145, 0.578, 0.46199999999999997, 0.47050000000000003, 0.8434999999999999, 0.5035000000000001, 0.355, 0.347, 0.4425, 0.391, 0.7925, 0.306, 0.3035, 0.4485, 1.9815, 0.4295, 0.6265000000000001, 0.511, 0.566, 0.46799999999999997, 0.3415, 0.44, 0.2455, 0.281, 0.6125, 6.5465, 0.258, 4.307, 0.558, 0.6034999999999999, 0.562, 0.5985, 0.3785, 3.5055, 16.0105, 3.0115, 0.6435, 0.524, 0.241, 0.6845, 0.313, 0.4545, 0.388, 0.318, 1.5590000000000002, 0.2465, 0.3625, 0.327, 0.5654999999999999, 0.347, 0.454, 0.5845, 0.1635, 0.554, 0.572, 0.3425, 0.415, 0.47, 0.215, 0.111, 11.7865, 0.2625, 1.0125, 0.23249999999999998, 0.677, 0.187, 0.6945, 0.5905, 0.5725, 0.1845, 0.489, 1.6324999999999998, 0.753, 0.4915, 0.263, 0.289, 8.163499999999999, 0.4945, 0.726, 5.3465, 0.5595000000000001, 0.8065, 0.279, 0.259, 0.8155, 0.2785, 0.261, 6.6370000000000005, 0.282, 0.267, 2.092, 0.40449999999999997, 0.876, 0.43, 0.4775, 0.41400000000000003, 1.0055, 0.4225, 0.433, 0.479, 0.439, 1.3785, 0.3135, 0.5345, 0.648, 4.593500000000001, 0.302, 0.5575000000000001, 0.2495, 0.475, 0.552, 0.402, 0.252, 0.236, 0.5780000000000001, 0.655, 0.4485, 0.46950000000000003, 0.3585, 0.8314999999999999, 0.5854999999999999, 0.457, 0.884, 0.3335, 0.373, 0.46699999999999997, 0.614, 1.4689999999999999, 26.976, 0.3605, 0.762, 0.411, 0.5045, 0.579, 0.46799999999999997, 0.416, 2.762, 0.507, 0.7224999999999999, 0.428, 3.6100000000000003, 0.5794999999999999, 0.5585, 0.3755, 0.309, 0.6054999999999999, 0.446, 1.161, 0.274, 0.307, 0.855, 0.2495, 0.4375, 0.2105, 0.355, 0.7905, 0.4225, 0.6964999999999999, 0.5654999999999999, 0.556, 0.4455, 0.431, 0.458, 0.213, 1.932, 0.2465, 0.27949999999999997, 1.5055, 0.010499999999999999, 0.338, 0.3935, 1.02, 3.161, 6.8454999999999995, 0.6465000000000001, 0.612, 0.221},
+		{0.4245, 0.4665, 1.0375, 0.534, 0.4995, 0.414, 0.9904999999999999, 0.6944999999999999, 0.458, 0.6285000000000001, 0.2465, 0.612, 0.228, 0.006, 0.38, 0.4495, 0.504, 0.3825, 0.503, 0.474, 0.456, 0.3795, 0.6815, 0.47550000000000003, 0.416, 0.3845, 0.416, 0.4455, 1.638, 0.344, 0.321, 0.431, 2.1725, 0.387, 0.823, 0.523, 0.546, 0.4475, 0.489, 0.576, 0.2715, 0.398, 0.3935, 5.6274999999999995, 0.294, 3.965, 0.6335, 0.6695, 0.5640000000000001, 0.599, 0.618, 4.1690000000000005, 11.6835, 3.0170000000000003, 0.5885, 0.5585, 0.1845, 0.6964999999999999, 0.5415000000000001, 0.4505, 0.3895, 0.3395, 2.4450000000000003, 0.2535, 0.3585, 0.2855, 0.6185, 0.2515, 0.5725, 0.794, 0.252, 0.6285000000000001, 0.5694999999999999, 0.4, 0.511, 0.47250000000000003, 0.252, 0.2325, 12.7745, 0.472, 0.8025, 0.2345, 0.696, 0.4145, 0.6335, 0.64, 0.523, 0.16, 0.4245, 1.638, 0.74, 0.5625, 0.42, 0.334, 12.380500000000001, 0.4665, 0.265, 0.716, 0.6305000000000001, 0.9224999999999999, 0.454, 0.269, 0.8694999999999999, 0.388, 0.2355, 6.109500000000001, 0.325, 0.318, 0.522, 0.3795, 1.0539999999999998, 0.337, 0.4475, 0.46499999999999997, 1.0495, 0.4185, 0.3595, 0.448, 0.434, 0.9945, 0.331, 1.1325, 0.7115, 4.713, 0.301, 0.6, 0.4445, 0.5445, 0.628, 0.4075, 0.2055, 0.174, 0.639, 0.648, 0.358, 0.463, 0.4285, 0.9405, 0.505, 0.3815, 0.918, 0.344, 0.34, 0.437, 0.4755, 1.376, 27.2095, 0.4785, 0.8895, 0.475, 0.519, 0.672, 0.5435000000000001, 0.383, 2.5305, 0.4225, 0.8815, 0.588, 0.4265, 0.443, 0.5914999999999999, 0.3625, 0.367, 0.605, 0.3395, 1.3795000000000002, 0.22, 0.2305, 0.7025, 0.3615, 0.582, 0.23299999999999998, 0.381, 0.8240000000000001, 0.379, 0.661, 0.5720000000000001, 0.5714999999999999, 0.46599999999999997, 0.3545, 0.37, 0.1825, 1.9695, 0.2955, 0.3065, 1.482, 0.331, 0.372, 0.3835, 0.815, 3.347, 5.7995, 0.6154999999999999, 0.6265000000000001, 0.259},
+		{0.4955, 0.4025, 1.0314999999999999, 0.2515, 0.5055000000000001, 0.2265, 0.949, 0.543, 0.2755, 0.507, 0.3235, 0.717, 0.4095, 0.437, 0.011, 0.388, 0.2365, 0.216, 0.5605, 0.5435000000000001, 0.3105, 0.2515, 0.4945, 0.299, 0.2025, 0.432, 0.237, 0.2875, 1.0779999999999998, 0.364, 0.388, 0.4685, 2.0355, 0.176, 0.535, 0.40449999999999997, 0.3955, 0.357, 0.41, 0.611, 0.381, 0.339, 0.542, 7.426, 0.3315, 4.2829999999999995, 0.728, 0.54, 0.6125, 0.488, 0.3175, 3.94, 16.7065, 2.0995, 0.4575, 0.3515, 0.384, 0.787, 0.4155, 0.3805, 0.2365, 0.359, 1.513, 0.352, 0.40149999999999997, 0.378, 0.4835, 0.4455, 0.3655, 0.338, 0.4075, 0.5265, 0.606, 0.442, 0.1595, 0.546, 0.34750000000000003, 0.3655, 12.1785, 0.457, 0.7215, 0.373, 0.8605, 0.3575, 0.527, 0.48850000000000005, 0.5565, 0.3285, 0.393, 1.547, 0.529, 0.599, 0.376, 0.417, 8.952, 0.349, 0.6525000000000001, 0.6515, 0.499, 0.7244999999999999, 0.3465, 0.442, 0.829, 0.4115, 0.385, 6.141, 0.3855, 0.36, 0.4445, 0.2755, 0.7295, 0.2295, 0.6, 0.2745, 0.894, 0.23349999999999999, 0.20400000000000001, 0.441, 0.344, 1.3675000000000002, 0.4155, 0.687, 0.5205, 4.5615000000000006, 0.384, 0.5185, 0.331, 0.418, 0.392, 0.4855, 0.354, 0.3505, 0.269, 0.5375000000000001, 0.2415, 0.319, 0.216, 0.694, 0.4895, 0.265, 0.9405, 0.2375, 0.197, 0.261, 0.3645, 1.298, 28.913, 0.23299999999999998, 0.604, 0.2995, 0.3985, 0.5914999999999999, 0.2325, 0.2905, 2.5205, 0.3085, 0.653, 0.314, 0.2215, 0.4955, 0.5505, 0.23399999999999999, 0.4515, 0.386, 0.223, 1.248, 0.377, 0.40800000000000003, 0.557, 0.366, 0.2185, 0.3355, 0.2165, 0.691, 0.241, 0.5429999999999999, 0.555, 0.4155, 0.26, 0.21, 0.225, 0.305, 1.948, 0.3875, 0.3845, 1.2610000000000001, 0.0015, 0.2185, 0.193, 0.664, 3.1365, 3.263, 0.5035000000000001, 0.449, 0.3805},
+		{0.359, 0.2205, 1.079, 0.367, 0.4155, 0.395, 0.891, 0.5605, 0.40449999999999997, 0.251, 0.173, 0.865, 0.1785, 0.32, 0.377, 0.0095, 0.334, 0.374, 0.46, 0.429, 0.4315, 0.333, 0.5675, 0.4745, 0.28200000000000003, 0.2645, 0.351, 0.3765, 0.869, 0.242, 0.1915, 0.4035, 2.13, 0.388, 0.713, 0.4945, 0.481, 0.3695, 0.3525, 0.4995, 0.1935, 0.2335, 0.335, 6.671, 0.244, 3.867, 0.584, 0.5395000000000001, 0.46799999999999997, 0.53, 0.326, 4.0634999999999994, 16.4345, 2.7235, 0.502, 0.4485, 0.1845, 0.649, 0.288, 0.4205, 0.2925, 0.2855, 1.7349999999999999, 0.172, 0.3095, 0.27849999999999997, 0.574, 0.2645, 0.368, 0.4885, 0.1325, 0.552, 0.488, 0.314, 0.3225, 0.47, 0.22749999999999998, 0.1535, 12.817499999999999, 0.22, 0.717, 0.19, 0.5825, 0.1185, 0.867, 0.542, 0.403, 0.129, 0.453, 1.6775, 0.632, 0.4985, 0.20400000000000001, 0.237, 7.51, 0.506, 0.1975, 2.9924999999999997, 0.5734999999999999, 0.6205, 0.157, 0.1935, 0.758, 0.20350000000000001, 0.192, 7.4990000000000006, 0.203, 0.214, 0.37849999999999995, 0.371, 0.9450000000000001, 0.302, 0.34299999999999997, 0.3475, 1.1385, 0.3665, 0.2995, 0.426, 0.4465, 1.93, 0.2445, 0.765, 0.591, 5.3035, 0.2715, 0.576, 0.163, 0.5255000000000001, 0.4535, 0.2285, 0.187, 0.1785, 0.40149999999999997, 0.622, 0.292, 0.404, 0.3355, 0.824, 0.5515000000000001, 0.4335, 0.7605, 0.35, 0.305, 0.2815, 0.392, 1.2774999999999999, 26.974, 0.318, 0.8220000000000001, 0.423, 0.4555, 0.517, 0.3525, 0.3355, 2.6765, 0.381, 0.773, 0.40549999999999997, 1.0005, 0.448, 0.639, 0.28049999999999997, 0.331, 0.496, 0.3345, 1.1895, 0.186, 0.211, 0.6615, 0.1605, 0.3585, 0.182, 0.388, 0.509, 0.392, 0.5665, 0.55, 0.483, 0.3915, 0.3075, 0.3865, 0.173, 1.8130000000000002, 0.2275, 0.1945, 1.343, 0.296, 0.3765, 0.3285, 0.6665000000000001, 3.1745, 5.666, 0.5954999999999999, 0.5085, 0.2155},
+		{0.48, 0.4165, 1.046, 0.228, 0.51, 0.2075, 0.9325000000000001, 0.46299999999999997, 0.23, 0.489, 0.267, 0.7475, 0.3945, 0.478, 0.244, 0.323, 0.013, 0.235, 0.5954999999999999, 0.5865, 0.283, 0.20750000000000002, 0.6839999999999999, 0.338, 0.1855, 0.3885, 0.2545, 0.27649999999999997, 0.813, 0.385, 0.3675, 0.4925, 2.1029999999999998, 0.2565, 0.6739999999999999, 0.4015, 0.398, 0.291, 0.4295, 0.5, 0.3675, 0.3245, 0.5155000000000001, 8.522, 0.34, 4.2405, 0.583, 0.512, 0.611, 0.5565, 0.209, 4.064, 13.8855, 2.037, 0.5705, 0.3755, 0.3435, 0.607, 0.4245, 0.386, 0.165, 0.396, 1.995, 0.374, 0.3855, 0.365, 0.437, 0.404, 0.336, 0.45599999999999996, 0.3195, 0.4935, 0.5865, 0.455, 0.2255, 0.503, 0.3545, 0.3575, 11.8705, 0.4275, 0.7945, 0.3765, 0.7735000000000001, 0.338, 0.535, 0.4935, 0.5615, 0.328, 0.34650000000000003, 1.585, 0.531, 0.601, 0.3595, 0.394, 7.827, 0.341, 0.395, 0.585, 0.5215000000000001, 0.6445000000000001, 0.3715, 0.365, 0.8125, 0.398, 0.341, 6.086, 0.369, 0.2975, 0.398, 0.256, 0.8260000000000001, 0.2525, 0.5285, 0.231, 2.196, 0.1895, 0.2065, 0.5015000000000001, 0.364, 1.139, 0.3955, 0.622, 0.5740000000000001, 4.68, 0.369, 0.5175000000000001, 0.321, 0.424, 0.338, 0.385, 0.2905, 0.3625, 0.3145, 0.5425, 0.1955, 0.29, 0.22699999999999998, 0.7815000000000001, 0.4965, 0.2915, 0.938, 0.199, 0.1735, 0.249, 0.322, 1.4529999999999998, 26.718, 0.1645, 0.8554999999999999, 0.27449999999999997, 0.4225, 0.535, 0.2155, 0.229, 2.5220000000000002, 0.28400000000000003, 0.5765, 0.265, 0.1935, 0.5355000000000001, 0.5385, 0.1985, 0.469, 0.402, 0.202, 1.2155, 0.34199999999999997, 0.3705, 0.8654999999999999, 0.326, 0.2235, 0.3045, 0.227, 0.634, 0.2375, 0.482, 0.487, 0.417, 0.2595, 0.188, 0.243, 0.3205, 1.69, 0.3375, 0.354, 1.3505, 0.0, 0.1845, 0.182, 0.653, 3.24, 7.057, 0.472, 0.5095000000000001, 0.335},
+		{0.519, 0.427, 0.813, 0.222, 0.55, 0.1735, 1.0175, 0.514, 0.272, 0.451, 0.322, 0.744, 0.338, 0.3965, 0.1945, 0.3795, 0.2475, 0.006, 0.5985, 0.57, 0.2295, 0.2075, 0.818, 0.1795, 0.132, 0.4425, 0.1935, 0.2545, 1.142, 0.451, 0.357, 0.47, 2.168, 0.278, 0.896, 0.4525, 0.3325, 0.262, 0.5155000000000001, 0.6165, 0.373, 0.323, 0.5315000000000001, 5.8885, 0.329, 4.8095, 0.582, 0.549, 0.5965, 0.427, 0.1585, 4.1405, 14.23, 2.46, 0.577, 0.309, 0.3815, 0.779, 0.4325, 0.344, 0.1725, 0.4445, 2.778, 0.3745, 0.42, 0.3755, 0.434, 0.399, 0.277, 0.415, 0.3665, 0.7665, 0.598, 0.46950000000000003, 0.199, 0.518, 0.337, 0.3965, 12.0075, 0.4285, 0.849, 0.351, 0.7044999999999999, 0.321, 0.47450000000000003, 0.5734999999999999, 0.5435000000000001, 0.2805, 0.3815, 1.6195, 0.625, 0.592, 0.3785, 0.41, 8.9915, 0.3215, 0.3185, 0.609, 0.5035000000000001, 0.753, 0.361, 0.3915, 0.8294999999999999, 0.4505, 0.322, 6.9265, 0.406, 0.349, 1.1095, 0.11, 0.9905, 0.122, 0.515, 0.2475, 0.9710000000000001, 0.2165, 0.1305, 0.5105, 0.2295, 1.297, 0.4325, 0.731, 0.6305000000000001, 4.9495000000000005, 0.3385, 0.6174999999999999, 0.307, 0.371, 0.47050000000000003, 0.4735, 0.2885, 0.3645, 0.398, 0.51, 0.226, 0.2605, 0.125, 0.6945, 0.3555, 0.193, 0.829, 0.1745, 0.19, 0.151, 0.3315, 1.6025, 29.3095, 0.1965, 0.5295000000000001, 0.19, 0.343, 0.5615000000000001, 0.1355, 0.1175, 2.6955, 0.20350000000000001, 0.649, 0.247, 0.208, 0.4965, 0.4715, 0.197, 0.3995, 0.2925, 0.2395, 0.188, 0.358, 0.4065, 0.713, 0.32, 0.183, 0.28200000000000003, 0.1465, 1.0855000000000001, 0.2105, 0.6859999999999999, 0.6395, 0.3635, 0.22, 0.1685, 0.252, 0.3305, 2.082, 0.3345, 0.375, 1.2705, 0.0, 0.208, 0.1345, 0.7695000000000001, 2.9505, 4.7265, 0.524, 0.422, 0.40700000000000003},
+		{0.577, 0.563, 1.162, 0.5660000000000001, 0.3715, 0.648, 1.0745, 0.819, 0.6615, 0.5605, 0.5025, 0.732, 0.492, 0.5785, 0.5825, 0.471, 0.6635, 0.551, 0.013, 0.6415, 0.6034999999999999, 0.642, 0.808, 0.7, 0.5155000000000001, 0.496, 0.5609999999999999, 0.574, 0.8725, 0.4375, 0.47050000000000003, 0.61, 2.1304999999999996, 0.5765, 1.17, 4.1875, 0.712, 0.7144999999999999, 0.6415, 0.648, 0.5315000000000001, 0.601, 0.515, 2.9865, 0.473, 4.5725, 0.55, 0.7324999999999999, 0.2775, 0.7795000000000001, 0.7475, 3.9665, 14.0415, 11.2665, 0.532, 0.758, 0.7395, 0.6145, 0.4665, 0.558, 0.5325, 0.469, 2.2805, 0.579, 0.478, 0.5465, 0.482, 0.4835, 0.571, 0.7935000000000001, 0.5569999999999999, 0.6395, 0.7024999999999999, 0.533, 0.471, 0.584, 0.471, 0.47, 11.745000000000001, 0.5814999999999999, 0.428, 0.575, 0.8745, 0.4485, 2.4115, 3.6435, 0.583, 0.4915, 0.538, 1.745, 0.9835, 0.515, 0.569, 0.444, 7.730500000000001, 0.664, 0.5255000000000001, 3.5854999999999997, 0.8314999999999999, 0.681, 0.672, 0.5825, 0.6795, 0.4875, 0.584, 6.541, 0.5295000000000001, 0.502, 0.5934999999999999, 0.629, 0.825, 0.5495000000000001, 0.5914999999999999, 0.656, 1.077, 0.665, 0.5025, 0.4225, 0.7324999999999999, 0.9319999999999999, 0.46950000000000003, 0.8065, 0.836, 5.262499999999999, 0.4875, 0.2635, 0.5215000000000001, 0.687, 0.9624999999999999, 0.546, 0.5185, 0.4915, 0.6835, 0.5489999999999999, 0.5865, 0.7484999999999999, 0.5415000000000001, 1.069, 0.7625, 0.6745000000000001, 0.967, 0.5905, 0.5894999999999999, 0.594, 0.7444999999999999, 1.7885, 28.5225, 0.574, 1.114, 1.083, 0.622, 0.44, 0.6445000000000001, 0.557, 2.9395, 0.602, 0.734, 0.577, 6.4775, 0.659, 0.507, 0.535, 0.5925, 0.825, 0.6085, 1.3965, 0.573, 0.556, 3.8925, 0.491, 0.6194999999999999, 0.514, 0.551, 1.071, 0.6964999999999999, 0.8265, 0.728, 0.717, 0.626, 0.561, 0.6275, 0.5225, 1.6844999999999999, 0.6185, 0.571, 1.5295, 0.0, 0.632, 0.5825, 0.8855, 2.927, 3.2245, 4.2395, 0.7495, 0.477},
+		{0.569, 0.477, 1.1705, 0.578, 0.5385, 0.6895, 1.2839999999999998, 0.8734999999999999, 0.644, 0.7145, 0.40449999999999997, 1.1589999999999998, 0.48050000000000004, 0.597, 0.6214999999999999, 0.422, 0.6005, 0.5774999999999999, 0.5805, 0.003, 0.647, 0.542, 0.7795000000000001, 0.699, 0.567, 0.484, 0.6295, 0.6495, 1.4295, 0.378, 0.3915, 0.7835000000000001, 2.259, 0.587, 1.0554999999999999, 0.6695, 0.672, 0.554, 0.538, 0.705, 0.417, 0.3665, 0.7035, 3.1224999999999996, 0.4545, 4.077999999999999, 0.6950000000000001, 0.762, 0.5934999999999999, 0.774, 0.5609999999999999, 4.774, 15.3595, 2.5404999999999998, 0.6355, 0.734, 0.509, 0.8554999999999999, 0.4125, 0.659, 0.5145, 0.4005, 1.927, 0.4405, 0.439, 0.4655, 0.698, 0.40049999999999997, 0.6065, 0.8614999999999999, 0.4215, 0.759, 0.67, 0.4605, 0.5395000000000001, 0.564, 0.4125, 0.529, 12.802, 0.6305000000000001, 0.8745, 0.4385, 0.841, 0.4575, 1.1575, 0.6505000000000001, 0.541, 0.4835, 0.6950000000000001, 1.7385, 0.8285, 0.676, 0.5025, 0.4835, 7.2715, 0.651, 0.612, 0.9245000000000001, 0.7835000000000001, 0.8694999999999999, 0.4515, 0.5155000000000001, 0.8725, 0.423, 0.507, 6.075, 0.4335, 0.45799999999999996, 0.86, 0.658, 1.1244999999999998, 0.567, 0.8140000000000001, 0.576, 1.9979999999999998, 0.6385000000000001, 0.601, 0.5115000000000001, 0.763, 1.741, 0.5285, 0.944, 1.0415, 5.380000000000001, 0.40549999999999997, 0.666, 0.485, 0.7224999999999999, 0.6975, 0.3995, 0.4695, 0.5549999999999999, 0.7395, 0.883, 0.4965, 0.629, 0.5, 1.049, 0.7875000000000001, 0.669, 1.034, 0.5685, 0.556, 0.739, 0.655, 1.6815, 26.295499999999997, 0.473, 1.3185, 0.575, 0.688, 0.685, 0.6615, 0.557, 2.8545, 0.6365000000000001, 0.9855, 0.561, 0.6675, 0.8885000000000001, 0.7444999999999999, 0.607, 0.374, 0.7475, 0.5985, 0.53, 0.4295, 0.4835, 1.724, 0.41200000000000003, 0.6174999999999999, 0.45699999999999996, 0.5854999999999999, 0.9824999999999999, 0.5774999999999999, 0.874, 0.7495, 0.6905, 0.582, 0.5785, 0.6545000000000001, 0.475, 1.916, 0.444, 0.463, 1.5605, 0.0, 0.541, 0.5495000000000001, 0.976, 3.25, 4.477, 0.7925, 0.7095, 0.441},
+		{0.611, 0.4605, 1.145, 0.2855, 0.472, 0.292, 1.0779999999999998, 0.577, 0.4105, 0.622, 0.4135, 1.124, 0.4635, 0.5655, 0.2875, 0.4305, 0.274, 0.2715, 0.6295, 0.6725000000000001, 0.0485, 0.23, 0.8055, 0.3705, 0.225, 0.497, 0.233, 0.3055, 0.8705, 0.4555, 0.465, 0.594, 1.9785, 0.334, 0.759, 0.51, 0.4285, 0.296, 0.5605, 0.6125, 0.417, 0.446, 0.5874999999999999, 7.2745, 0.4355, 4.1815, 0.565, 0.6485000000000001, 0.669, 0.6245, 0.7144999999999999, 3.817, 15.288499999999999, 2.3914999999999997, 0.654, 0.373, 0.74, 0.7064999999999999, 0.474, 0.391, 0.3115, 0.4135, 1.7269999999999999, 0.4145, 0.454, 0.4445, 0.5205, 0.4495, 0.3645, 0.5165, 0.5035000000000001, 0.7344999999999999, 0.6565000000000001, 0.5425, 0.293, 0.538, 0.40449999999999997, 0.456, 12.722999999999999, 0.442, 0.827, 0.4595, 0.8955, 0.39849999999999997, 0.6385000000000001, 0.5595000000000001, 0.6475, 0.3915, 0.4475, 1.6425, 0.6034999999999999, 0.6605000000000001, 0.45, 0.4335, 12.5335, 0.368, 0.409, 0.682, 0.588, 0.6385000000000001, 0.47450000000000003, 0.456, 0.8354999999999999, 0.4785, 0.4195, 6.9395, 0.4465, 0.4115, 1.032, 0.295, 1.0990000000000002, 0.257, 0.644, 0.314, 1.9605000000000001, 0.3695, 0.2465, 0.5205, 0.419, 1.4615, 0.48350000000000004, 0.863, 0.6805000000000001, 5.7135, 0.4255, 0.5985, 0.3875, 0.354, 0.514, 0.6205, 0.3995, 0.431, 0.417, 0.5445, 0.273, 0.3385, 0.2685, 0.702, 0.4335, 0.2875, 0.9605, 0.25, 0.268, 0.28700000000000003, 0.3985, 1.4455, 29.188499999999998, 0.258, 0.9924999999999999, 0.264, 0.434, 0.6165, 0.2805, 0.2945, 2.7424999999999997, 0.35150000000000003, 0.7364999999999999, 0.305, 0.7575000000000001, 0.5745, 0.639, 0.253, 0.5, 0.857, 0.3085, 1.337, 0.4, 0.4555, 0.7364999999999999, 0.419, 0.303, 0.372, 0.2565, 0.819, 0.318, 0.656, 2.0505, 0.4005, 0.315, 0.28200000000000003, 0.3325, 0.5015000000000001, 1.928, 0.4115, 0.464, 1.3645, 0.0, 0.27749999999999997, 0.226, 0.7215, 2.7880000000000003, 5.480499999999999, 0.676, 0.4435, 0.40700000000000003},
+		{0.535, 0.40700000000000003, 1.0535, 0.2275, 0.4675, 0.148, 0.956, 0.422, 0.2605, 0.484, 0.256, 0.6134999999999999, 0.4475, 0.40249999999999997, 0.24, 0.368, 0.1945, 0.196, 0.61, 0.5680000000000001, 0.226, 0.008, 0.39849999999999997, 0.3125, 0.1285, 0.40349999999999997, 0.224, 0.2425, 0.8385, 0.3925, 0.3775, 0.4635, 1.863, 0.2395, 0.708, 0.395, 0.3735, 0.2175, 0.4795, 0.5025, 0.421, 0.335, 0.5615000000000001, 5.522, 0.347, 3.8280000000000003, 0.706, 0.576, 0.486, 0.5095000000000001, 0.34650000000000003, 3.9135, 16.0105, 3.0315, 0.572, 0.2915, 0.3365, 0.7755000000000001, 0.41300000000000003, 0.3115, 0.1985, 0.40800000000000003, 1.7585, 0.3585, 0.40349999999999997, 0.4005, 0.419, 0.348, 0.3155, 0.381, 0.386, 0.467, 0.6305000000000001, 0.4545, 0.208, 0.5635, 0.3305, 0.3715, 13.8735, 0.4325, 0.7565, 0.334, 0.7875, 0.3765, 0.435, 0.5635, 0.584, 0.319, 0.338, 1.5685, 0.6085, 0.59, 0.391, 0.386, 6.907, 0.2485, 0.3495, 2.1479999999999997, 0.495, 0.707, 0.3755, 0.392, 0.8180000000000001, 0.385, 0.3225, 6.2995, 0.386, 0.354, 0.402, 0.1895, 0.8965000000000001, 0.2315, 0.585, 0.235, 1.2695, 0.20400000000000001, 0.1475, 0.5405, 0.3265, 0.99, 0.39, 0.975, 0.4665, 5.4615, 0.3905, 0.5974999999999999, 0.302, 0.2855, 0.4125, 0.4325, 0.304, 0.286, 0.2385, 0.519, 0.182, 0.3405, 0.183, 0.8220000000000001, 0.438, 0.225, 0.764, 0.1405, 0.137, 0.184, 0.2935, 1.54, 26.735, 0.1825, 0.622, 0.226, 0.393, 0.471, 0.1445, 0.131, 2.6585, 0.2465, 0.672, 0.222, 0.208, 0.4735, 0.4545, 0.162, 0.45, 0.3215, 0.219, 1.416, 0.333, 0.342, 0.7825, 0.3625, 0.21200000000000002, 0.2715, 0.1995, 0.717, 0.248, 0.46299999999999997, 0.46, 0.372, 0.2215, 0.1555, 0.254, 0.3935, 1.833, 0.349, 0.362, 1.1695, 0.006, 0.1785, 0.1575, 0.729, 2.8995, 3.0435, 0.5854999999999999, 0.492, 0.34650000000000003},
+		{0.644, 0.883, 1.1179999999999999, 0.5700000000000001, 0.839, 0.606, 1.486, 0.938, 0.6365000000000001, 0.7505, 0.522, 1.0105, 0.71, 0.752, 0.4895, 0.4995, 0.492, 0.6625, 0.808, 1.08, 0.709, 0.493, 0.008, 0.7665, 0.433, 0.769, 0.553, 0.6145, 1.4075, 0.6275, 0.594, 0.733, 1.988, 0.5985, 1.305, 0.696, 0.938, 0.518, 0.7075, 0.8314999999999999, 0.61, 0.7989999999999999, 0.7464999999999999, 5.5705, 0.6955, 4.01, 0.8614999999999999, 0.831, 0.802, 0.668, 0.533, 4.2645, 15.338999999999999, 2.492, 1.6065, 0.7444999999999999, 0.765, 0.8354999999999999, 0.663, 0.5785, 0.7135, 0.6615, 2.5, 0.8485, 0.766, 0.665, 0.7055, 0.6515, 0.753, 0.8445, 0.956, 1.1219999999999999, 0.7515000000000001, 0.7865, 0.626, 0.7255, 0.7115, 0.722, 12.546, 0.684, 1.1669999999999998, 0.8225, 1.068, 0.607, 1.2185000000000001, 0.873, 0.8245, 0.5785, 0.6154999999999999, 1.7429999999999999, 0.659, 0.898, 0.6475, 0.7925, 12.96, 0.7935000000000001, 1.1045, 0.8160000000000001, 0.7264999999999999, 1.2705, 0.718, 0.767, 0.9775, 0.8160000000000001, 0.598, 7.817, 4.3945, 0.5905, 1.311, 0.487, 1.412, 0.6735, 0.9864999999999999, 0.507, 1.7435, 0.6639999999999999, 0.4335, 0.6695, 0.6425000000000001, 1.737, 0.6725000000000001, 1.431, 0.9450000000000001, 4.524, 0.651, 0.8855, 0.6194999999999999, 0.646, 0.641, 0.6595, 0.758, 0.7815, 0.815, 0.9385, 0.546, 0.7135, 0.5095000000000001, 0.9145000000000001, 0.613, 0.439, 1.1175, 0.5065, 0.547, 0.6875, 0.5725, 2.1675000000000004, 24.5925, 0.5205, 1.1915, 0.683, 0.5555, 0.912, 0.5505, 0.48, 2.7225, 0.7035, 1.0005, 0.866, 0.514, 1.043, 0.838, 0.6955, 0.67, 0.746, 0.6005, 1.6615, 0.506, 0.6275, 1.732, 0.5535000000000001, 0.881, 0.712, 0.5345, 0.961, 0.7310000000000001, 0.9525, 0.6835, 0.7124999999999999, 0.5325, 0.517, 0.426, 0.596, 1.9969999999999999, 0.5954999999999999, 0.799, 1.532, 0.0, 0.434, 0.599, 0.9704999999999999, 2.9905, 5.365, 0.8785000000000001, 1.496, 0.639},
+		{0.6285000000000001, 0.4875, 1.0785, 0.382, 0.6125, 0.264, 1.1684999999999999, 0.7384999999999999, 0.448, 0.5605, 0.4535, 1.1975, 0.5375000000000001, 0.4865, 0.3275, 0.4425, 0.328, 0.222, 0.6265000000000001, 0.687, 0.4565, 0.35850000000000004, 0.8925000000000001, 0.011, 0.3735, 0.547, 0.2965, 0.28300000000000003, 0.8765000000000001, 0.563, 0.473, 1.1404999999999998, 2.1825, 0.4025, 0.886, 0.519, 0.4005, 0.442, 0.6859999999999999, 0.6605000000000001, 0.4295, 0.503, 0.629, 7.697, 0.442, 4.502000000000001, 0.669, 0.6675, 0.6395, 0.405, 0.9319999999999999, 4.522, 14.400500000000001, 3.206, 0.6045, 0.8015, 0.5095000000000001, 0.86, 0.5475000000000001, 0.433, 0.3415, 0.549, 2.9720000000000004, 0.432, 0.5405, 0.48, 0.501, 0.5275000000000001, 0.4065, 0.5754999999999999, 0.6154999999999999, 0.9035, 0.6455, 0.538, 0.334, 0.665, 0.5285, 0.4765, 13.6975, 0.5785, 0.925, 0.4975, 0.9335, 0.4585, 0.6665000000000001, 0.6535, 0.6605000000000001, 0.4135, 0.5065, 1.641, 0.609, 0.7855000000000001, 0.5315000000000001, 0.495, 11.709499999999998, 0.3995, 0.46, 0.681, 0.608, 0.7889999999999999, 0.4505, 0.501, 0.954, 0.4965, 0.481, 7.0175, 0.964, 0.4785, 1.183, 0.28200000000000003, 1.181, 0.2235, 0.628, 0.469, 1.27, 0.4995, 0.2615, 0.6735, 0.502, 0.9055, 0.511, 1.2725, 0.7015, 5.7765, 0.4655, 0.661, 0.403, 0.438, 0.484, 0.572, 0.4195, 0.4715, 0.4945, 0.725, 0.2815, 0.7224999999999999, 0.319, 0.9665, 0.4435, 0.2775, 1.166, 0.257, 0.328, 0.265, 0.4335, 1.6385, 27.7265, 0.33199999999999996, 0.6805, 0.311, 0.4145, 0.617, 0.3485, 0.2785, 2.721, 0.3665, 0.792, 0.4215, 0.3105, 0.7505, 0.888, 0.2765, 0.5640000000000001, 0.6875, 0.3795, 1.5005000000000002, 0.46699999999999997, 0.498, 1.4409999999999998, 0.4905, 0.3035, 0.4115, 0.33699999999999997, 0.736, 0.297, 0.793, 1.9049999999999998, 0.519, 0.324, 0.28, 0.3695, 0.4455, 1.975, 0.474, 0.4755, 1.535, 0.0, 0.3325, 0.212, 0.863, 3.1094999999999997, 6.1965, 0.7745, 1.1995, 0.4375},
+		{1.915, 0.374, 0.849, 0.163, 0.475, 0.153, 0.913, 0.5509999999999999, 0.2435, 0.4555, 0.289, 0.9289999999999999, 0.397, 0.375, 0.2005, 0.3225, 0.1725, 0.11699999999999999, 0.5985, 0.5555000000000001, 0.229, 0.125, 0.47350000000000003, 0.314, 0.009, 0.418, 0.199, 0.1845, 0.9235, 0.40549999999999997, 0.3875, 0.553, 2.0255, 0.23299999999999998, 0.5425, 0.463, 0.359, 0.244, 0.481, 0.46950000000000003, 0.3825, 0.331, 0.5425, 5.922, 0.3315, 4.194, 0.538, 0.454, 0.5985, 0.4245, 0.129, 4.1385000000000005, 14.344999999999999, 1.6105, 0.5115000000000001, 0.2255, 0.34850000000000003, 0.563, 0.3995, 0.316, 0.189, 0.4415, 1.8054999999999999, 0.333, 0.359, 0.3585, 0.4075, 0.2975, 0.2875, 0.46599999999999997, 0.289, 0.5375000000000001, 0.605, 0.4775, 0.1965, 0.5265, 0.2925, 0.3675, 13.339500000000001, 0.4135, 0.7965, 0.351, 0.845, 0.3185, 0.5225, 0.5355000000000001, 1.7645, 0.307, 0.367, 1.6484999999999999, 0.529, 0.5814999999999999, 0.3325, 0.41700000000000004, 12.6455, 0.3105, 0.9345000000000001, 0.5765, 0.5005, 0.723, 0.363, 0.3955, 0.8554999999999999, 0.3745, 0.3365, 6.5600000000000005, 0.2965, 0.357, 0.4015, 0.163, 0.9855, 0.159, 0.551, 0.212, 0.8534999999999999, 0.18, 0.0835, 0.5215000000000001, 0.279, 1.9735, 0.3935, 0.938, 0.664, 5.0645, 0.3395, 0.565, 0.2595, 0.333, 0.4465, 0.4115, 0.3295, 0.338, 0.315, 0.455, 0.17149999999999999, 0.2005, 0.148, 0.8245, 0.299, 0.181, 0.8625, 0.14100000000000001, 0.11, 0.132, 0.2715, 1.5715, 26.505000000000003, 0.178, 0.7929999999999999, 0.211, 0.3795, 0.4445, 0.125, 0.1605, 2.609, 0.1995, 0.5974999999999999, 0.171, 0.17, 0.45, 0.4765, 0.14100000000000001, 0.401, 0.35050000000000003, 0.1935, 1.099, 0.3205, 0.3315, 0.637, 0.3, 0.1485, 0.353, 0.1935, 0.658, 0.202, 0.4555, 2.035, 0.358, 0.207, 0.1105, 0.219, 0.3165, 2.1784999999999997, 0.3305, 0.3775, 1.234, 0.0, 0.136, 0.0845, 0.674, 3.3994999999999997, 5.9125, 0.4815, 0.4465, 0.3365},
+		{0.51, 0.383, 1.15, 0.4545, 0.363, 0.3945, 0.901, 0.744, 0.516, 0.3315, 0.293, 0.7270000000000001, 0.3655, 0.465, 0.459, 0.304, 0.4385, 0.391, 0.5515000000000001, 0.5705, 0.527, 0.452, 0.795, 0.536, 0.426, 0.015, 0.496, 0.3775, 0.922, 0.145, 0.298, 0.49, 2.1485, 0.4845, 0.955, 0.5615, 0.554, 0.5205, 0.446, 0.49, 0.2715, 0.311, 0.312, 5.1035, 0.2415, 4.093, 0.622, 0.702, 0.5, 0.6585000000000001, 0.4505, 4.171, 15.561, 4.031000000000001, 0.461, 0.594, 0.353, 0.6174999999999999, 0.213, 0.5175000000000001, 0.3735, 0.1865, 1.8940000000000001, 0.294, 0.1865, 0.388, 0.6114999999999999, 0.1795, 0.4175, 0.6875, 0.391, 0.7505, 0.5445, 0.206, 0.392, 0.478, 0.34850000000000003, 0.3195, 12.07, 0.3435, 0.7364999999999999, 0.317, 0.8015000000000001, 0.352, 1.1219999999999999, 0.55, 0.403, 0.2715, 0.613, 2.0549999999999997, 0.738, 0.506, 0.309, 0.216, 7.8629999999999995, 0.548, 0.3315, 0.8135, 0.6695, 0.665, 0.302, 0.312, 0.75, 0.258, 0.3445, 6.232, 0.2645, 0.256, 0.39749999999999996, 0.457, 1.1524999999999999, 0.4285, 0.48350000000000004, 0.496, 1.1909999999999998, 0.4985, 0.45899999999999996, 0.3565, 0.5045, 2.096, 0.3755, 0.764, 0.853, 5.864, 0.292, 0.502, 0.2585, 0.5325, 0.56, 0.3465, 0.345, 0.319, 0.5525, 0.617, 0.4455, 0.5585, 0.428, 0.9085000000000001, 0.552, 0.526, 0.9255, 0.3885, 0.432, 0.402, 0.529, 1.839, 25.987000000000002, 0.44, 1.0455, 0.521, 0.4895, 0.493, 0.46950000000000003, 0.4555, 2.6875, 0.53, 0.776, 0.482, 0.593, 0.61, 0.541, 0.3895, 0.1895, 0.7535000000000001, 0.4105, 1.126, 0.2665, 0.3265, 1.5525, 0.2825, 0.473, 0.3215, 0.4435, 0.6214999999999999, 0.464, 0.6995, 1.4075, 0.6295, 0.4775, 0.40800000000000003, 0.473, 0.301, 2.048, 0.299, 0.2165, 1.5939999999999999, 0.0245, 0.3825, 0.427, 0.85, 3.5145, 4.9805, 0.6174999999999999, 0.6174999999999999, 0.352},
+		{0.582, 0.424, 1.026, 0.2685, 0.4975, 0.221, 0.879, 0.609, 0.301, 0.4745, 0.297, 0.624, 0.444, 0.3925, 0.2465, 0.365, 0.2355, 0.2155, 0.574, 0.575, 0.2175, 0.221, 0.9245000000000001, 0.357, 0.177, 0.3925, 0.015, 0.192, 0.8374999999999999, 0.4345, 0.39, 0.535, 2.073, 0.287, 0.5925, 0.3995, 0.407, 0.2995, 0.4985, 0.578, 0.39, 0.3525, 0.665, 7.7059999999999995, 0.3375, 4.0045, 0.691, 0.474, 0.525, 0.4145, 0.2075, 3.8035, 15.249500000000001, 2.4605, 0.849, 0.281, 0.371, 0.6725000000000001, 0.4395, 0.3655, 0.251, 0.415, 2.2475, 0.318, 0.4445, 0.4045, 0.4185, 0.4335, 0.304, 0.41400000000000003, 0.3605, 0.598, 0.6, 0.453, 0.2535, 0.5375000000000001, 0.307, 0.3825, 12.534500000000001, 0.466, 0.7535000000000001, 0.309, 0.6415, 0.34450000000000003, 0.999, 0.573, 0.529, 0.3365, 0.3435, 1.6665, 0.596, 0.6305000000000001, 0.386, 0.4655, 8.844000000000001, 0.33, 0.3475, 0.6065, 0.512, 0.6615, 0.3745, 0.384, 0.905, 0.4385, 0.346, 6.5975, 0.4095, 0.3975, 0.465, 0.187, 0.742, 0.2465, 0.5894999999999999, 0.2525, 2.074, 0.264, 0.213, 0.4855, 0.346, 0.8694999999999999, 0.4385, 0.8345, 1.2115, 4.584, 0.3895, 0.6054999999999999, 0.3665, 0.914, 0.4445, 0.4275, 0.3585, 0.3815, 0.3595, 0.5135000000000001, 0.2455, 0.289, 0.2205, 0.895, 0.4245, 0.7085, 0.836, 0.2305, 0.20350000000000001, 0.2375, 0.3805, 1.2115, 28.445, 0.207, 0.752, 0.1585, 0.3865, 0.539, 0.2375, 0.271, 2.7969999999999997, 0.23349999999999999, 0.5575000000000001, 0.2555, 0.23, 0.4595, 0.445, 0.241, 0.3995, 0.359, 0.2575, 0.2585, 0.352, 0.3795, 0.554, 0.33999999999999997, 0.2465, 0.34299999999999997, 0.23049999999999998, 0.661, 0.274, 0.642, 0.8725, 0.3775, 0.2455, 0.21, 0.314, 0.3385, 1.9515, 0.3275, 0.4045, 1.0695000000000001, 0.0, 0.2195, 0.20600000000000002, 0.6505000000000001, 2.5675, 3.6095, 0.556, 0.4535, 0.354},
+		{0.529, 0.4575, 0.779, 0.3135, 0.4935, 0.258, 0.851, 0.6005, 0.341, 0.516, 0.315, 0.732, 0.46799999999999997, 0.387, 0.2825, 0.392, 0.28700000000000003, 0.2445, 0.642, 0.604, 0.281, 0.2565, 0.569, 0.2875, 0.21, 0.40449999999999997, 0.2025, 0.014499999999999999, 1.085, 0.4445, 0.421, 0.563, 1.9135, 0.3245, 0.5035000000000001, 0.452, 0.3785, 0.2745, 0.543, 0.6415, 0.4005, 0.405, 0.5865, 5.0725, 0.459, 4.06, 0.6555, 0.553, 0.602, 0.59, 0.2445, 4.2285, 15.3465, 1.7799999999999998, 0.6415, 0.3225, 0.39749999999999996, 0.699, 0.445, 0.377, 0.269, 0.446, 2.2119999999999997, 0.361, 0.3975, 0.4285, 0.457, 0.3965, 0.3505, 0.4655, 0.4335, 0.645, 0.6475, 0.482, 0.2655, 0.5395000000000001, 0.4185, 0.4015, 13.0085, 0.481, 0.808, 0.3655, 0.7144999999999999, 0.414, 0.556, 0.6034999999999999, 0.617, 0.3435, 0.3535, 1.5594999999999999, 0.575, 0.708, 0.473, 0.4185, 11.391, 0.349, 0.334, 0.6265000000000001, 0.525, 0.697, 0.3945, 0.4235, 0.878, 0.4525, 0.4235, 6.1259999999999994, 0.421, 0.3685, 0.791, 0.209, 0.8145, 0.2055, 0.5914999999999999, 0.288, 0.901, 0.317, 0.212, 0.6005, 0.362, 0.6055, 0.4315, 0.857, 0.582, 5.202999999999999, 0.392, 0.596, 0.349, 0.2755, 0.4615, 0.49, 0.318, 0.435, 0.37, 0.523, 0.3085, 0.297, 0.262, 0.8714999999999999, 0.3895, 0.216, 0.785, 0.217, 0.223, 0.222, 0.367, 1.2374999999999998, 27.417, 0.28, 0.47750000000000004, 0.219, 0.3915, 0.5275000000000001, 0.234, 0.23, 2.594, 0.2505, 0.71, 0.3075, 0.51, 0.524, 0.522, 0.263, 0.4765, 0.333, 0.302, 1.2645, 0.3695, 0.3975, 0.696, 0.309, 0.2605, 0.373, 0.2355, 0.715, 0.2755, 0.575, 0.5385, 0.359, 0.2925, 0.2125, 0.3435, 0.348, 1.9555, 0.393, 0.4465, 1.258, 0.0, 0.251, 0.1925, 0.6635, 3.0705, 3.601, 0.5705, 0.4835, 0.4305},
+		{1.2925, 1.5045000000000002, 1.7915, 1.0865, 1.6164999999999998, 1.0865, 2.6085000000000003, 2.0865, 0.9889999999999999, 1.8065, 1.08, 2.351, 0.869, 1.4495, 0.9544999999999999, 1.0145, 0.7889999999999999, 1.3965, 0.8234999999999999, 0.966, 1.367, 1.18, 2.2185, 0.956, 0.989, 0.877, 1.249, 1.1115, 0.0085, 0.874, 1.0105, 1.377, 5.0525, 0.785, 1.249, 1.0150000000000001, 7.9745, 1.057, 0.996, 8.0765, 1.2345, 0.8065, 4.179, 6.4079999999999995, 0.8265, 4.806, 1.1095000000000002, 2.413, 1.869, 1.392, 1.3375, 9.0815, 15.572000000000001, 3.65, 0.846, 1.3875000000000002, 1.0074999999999998, 1.169, 0.778, 0.928, 0.6819999999999999, 0.7905, 4.977, 1.025, 0.8540000000000001, 1.0, 0.8049999999999999, 0.6595, 1.0434999999999999, 1.1155, 1.246, 1.5459999999999998, 1.2505000000000002, 0.996, 0.747, 0.8069999999999999, 0.7565, 0.859, 12.809999999999999, 1.3290000000000002, 1.8805, 0.8554999999999999, 1.448, 1.041, 1.0765, 0.577, 1.4775, 0.7455, 1.494, 2.7720000000000002, 1.0194999999999999, 0.7235, 0.925, 1.89, 11.0465, 1.0065, 0.9695, 1.064, 0.9085000000000001, 2.2365, 1.012, 1.4529999999999998, 1.858, 1.42, 0.9255, 7.904, 1.2005, 0.9735, 5.244, 0.9375, 1.512, 0.9894999999999999, 1.9260000000000002, 1.1545, 2.1529999999999996, 1.0779999999999998, 0.8115, 0.761, 0.7645, 4.886, 0.973, 1.2795, 1.5295, 7.0805, 0.865, 0.6599999999999999, 1.0154999999999998, 0.904, 0.745, 1.3475000000000001, 3.965, 0.803, 1.1960000000000002, 2.151, 0.9075, 1.0165, 1.0995, 5.968, 1.6745, 1.4505, 1.4745, 1.0884999999999998, 0.661, 1.4340000000000002, 1.2475, 4.2395, 26.7865, 0.7935000000000001, 6.621, 1.0505, 0.9544999999999999, 0.767, 0.8979999999999999, 0.8815, 3.7359999999999998, 0.8395, 1.782, 1.8685, 1.18, 1.4125, 0.815, 0.806, 5.5489999999999995, 1.472, 1.1019999999999999, 2.6235, 0.9335, 1.4285, 9.84, 0.966, 0.995, 1.5905, 1.183, 1.3935, 0.9844999999999999, 2.036, 1.0285, 1.1965, 1.4905, 1.0325, 1.624, 1.0085, 2.6305, 0.9775, 1.9255, 4.981, 0.0, 1.326, 0.762, 3.0465, 3.77, 5.4735, 0.9755, 1.2214999999999998, 0.9145000000000001},
+		{0.4375, 0.3445, 1.0074999999999998, 0.4195, 0.3645, 0.362, 0.9285, 0.6765, 0.4925, 0.2755, 0.2635, 0.75, 0.28200000000000003, 0.5225, 0.4085, 0.2405, 0.3395, 0.4405, 0.4445, 0.34299999999999997, 0.394, 0.3355, 0.696, 0.531, 0.3855, 0.147, 0.4625, 0.483, 0.8574999999999999, 0.033, 0.23399999999999999, 0.3735, 2.051, 0.4185, 0.7805, 0.5055000000000001, 0.5425, 0.46599999999999997, 0.394, 0.5665, 0.2135, 0.2965, 0.268, 3.8905, 0.214, 3.8665, 0.4965, 0.579, 0.41200000000000003, 0.5475000000000001, 0.547, 4.0535, 14.4085, 2.651, 0.428, 0.5105, 0.2775, 0.5255000000000001, 0.1545, 0.481, 0.39649999999999996, 0.1115, 2.4095, 0.2615, 0.196, 0.3295, 0.512, 0.18, 0.376, 0.5465, 0.29, 0.396, 0.504, 0.2065, 0.3835, 0.404, 0.2865, 0.2435, 12.310500000000001, 0.292, 0.664, 0.2835, 0.7969999999999999, 0.2695, 0.7435, 0.391, 0.4155, 0.2435, 0.483, 1.4965000000000002, 0.6545000000000001, 0.4325, 0.2415, 0.217, 7.6015, 0.5005, 0.316, 0.757, 0.6154999999999999, 0.569, 0.3, 0.3245, 0.6905, 0.2185, 0.336, 7.1395, 0.229, 0.228, 0.349, 0.423, 0.784, 0.3535, 0.347, 0.39, 1.3205, 0.403, 0.3865, 0.3645, 0.513, 0.8440000000000001, 0.3015, 0.8375, 0.679, 4.708, 0.302, 0.4405, 0.257, 0.555, 0.5885, 0.2645, 0.29, 0.2455, 0.526, 0.6125, 0.4145, 0.53, 0.4215, 0.7324999999999999, 0.533, 0.414, 0.886, 0.3815, 0.3725, 0.4475, 0.46399999999999997, 1.7885, 25.5505, 0.394, 0.6955, 0.3615, 0.5345, 0.4615, 0.4185, 0.434, 2.572, 0.40349999999999997, 0.6205, 0.477, 1.6635, 0.389, 0.491, 0.374, 0.2015, 0.526, 0.422, 1.261, 0.316, 0.2475, 1.103, 0.2575, 0.443, 0.2815, 0.40349999999999997, 0.603, 0.4375, 0.6565000000000001, 0.6485000000000001, 0.558, 0.3885, 0.382, 0.4415, 0.2645, 1.8235000000000001, 0.2885, 0.2685, 1.2445, 0.0, 0.397, 0.34450000000000003, 0.7344999999999999, 3.167, 1.9104999999999999, 0.6445000000000001, 0.6065, 0.27},
+		{0.40900000000000003, 0.2835, 0.904, 0.4015, 0.4235, 0.3695, 0.8585, 0.647, 0.425, 0.345, 0.2475, 0.5794999999999999, 0.252, 0.331, 0.377, 0.193, 0.389, 0.345, 0.442, 0.395, 0.4455, 0.372, 0.6779999999999999, 0.482, 0.3825, 0.253, 0.4205, 0.456, 1.496, 0.213, 0.007, 0.3815, 2.2535, 0.397, 0.706, 0.597, 0.52, 0.4485, 0.3835, 0.494, 0.1455, 0.2605, 0.4225, 5.966, 0.2125, 4.102, 0.579, 0.512, 0.4945, 0.611, 0.541, 4.231999999999999, 14.963000000000001, 3.5335, 0.608, 0.507, 0.275, 0.617, 0.2705, 0.458, 0.371, 0.28300000000000003, 1.6705, 0.243, 0.2325, 0.312, 0.501, 0.2495, 0.4375, 0.511, 0.259, 0.5505, 0.4985, 0.269, 0.3585, 0.40349999999999997, 0.2305, 0.24, 11.317499999999999, 0.2515, 0.756, 0.221, 0.609, 0.228, 0.6575, 0.4995, 0.43, 0.2355, 0.4685, 1.7995, 0.732, 0.5894999999999999, 0.168, 0.196, 7.239, 0.482, 0.2975, 0.716, 0.549, 0.753, 0.229, 0.2515, 0.7535000000000001, 0.189, 0.273, 6.2235, 0.241, 0.2375, 0.3405, 0.409, 0.825, 0.422, 0.4285, 0.42, 1.1835, 0.3975, 0.401, 0.448, 0.4425, 0.8665, 0.2705, 0.718, 0.7205, 4.498, 0.257, 0.536, 0.2495, 0.504, 0.5665, 0.3935, 0.271, 0.246, 0.512, 0.585, 0.3945, 0.5285, 0.372, 1.045, 0.4485, 0.43, 0.7375, 0.3805, 0.329, 0.4145, 0.4615, 1.4435, 27.5715, 0.3805, 0.666, 0.4805, 0.4995, 0.504, 0.40449999999999997, 0.428, 2.5309999999999997, 0.4165, 0.573, 0.491, 0.373, 0.4625, 0.5545, 0.353, 0.2985, 0.5654999999999999, 0.3805, 1.1655, 0.233, 0.203, 0.655, 0.27649999999999997, 0.4235, 0.23399999999999999, 0.326, 0.662, 0.432, 0.627, 0.632, 0.52, 0.40349999999999997, 0.33799999999999997, 0.4285, 0.2565, 2.2495, 0.2525, 0.237, 1.353, 0.0285, 0.3685, 0.3965, 0.731, 3.0125, 3.5309999999999997, 0.619, 0.575, 0.276},
+		{0.512, 0.3815, 0.9904999999999999, 0.462, 0.5655, 0.4775, 1.312, 0.9025000000000001, 0.483, 0.641, 0.222, 1.073, 0.487, 0.873, 0.612, 0.41400000000000003, 0.5535, 0.4415, 0.5740000000000001, 0.6405000000000001, 0.5700000000000001, 0.47250000000000003, 0.693, 0.4805, 0.462, 0.432, 0.46, 0.4515, 1.0695000000000001, 0.404, 0.40349999999999997, 0.009, 2.1559999999999997, 0.4985, 1.1865, 0.6475, 0.5834999999999999, 0.5205, 0.4725, 0.5974999999999999, 0.362, 0.3435, 0.5734999999999999, 6.342, 0.398, 3.8765, 0.794, 0.7195, 0.591, 0.6975, 0.666, 4.389, 13.653, 2.4945, 0.976, 0.88, 0.4255, 0.7404999999999999, 0.588, 0.622, 0.46, 0.458, 2.152, 0.38149999999999995, 0.5085, 0.487, 0.7435, 0.5509999999999999, 0.561, 0.731, 0.479, 1.0314999999999999, 0.6085, 0.6054999999999999, 0.4535, 0.579, 0.4095, 0.371, 13.0425, 0.428, 0.947, 0.3685, 1.04, 0.3865, 0.963, 0.6615, 0.622, 0.3225, 0.554, 2.05, 0.732, 0.658, 0.4205, 0.458, 7.404999999999999, 0.5225, 0.4075, 2.6925, 0.6695, 1.4344999999999999, 0.40800000000000003, 0.544, 1.2850000000000001, 0.40149999999999997, 0.52, 6.0375, 0.395, 0.3285, 1.42, 0.46799999999999997, 1.0975000000000001, 0.4265, 0.7, 0.5265, 1.0095, 0.554, 0.4375, 0.6154999999999999, 0.597, 2.636, 0.433, 0.9315, 0.845, 5.394500000000001, 0.379, 0.639, 0.289, 0.5405, 0.6375, 0.421, 0.2885, 0.36, 0.7909999999999999, 0.669, 0.522, 0.5965, 0.4325, 1.072, 0.78, 0.505, 1.0665, 0.452, 0.522, 0.6295, 0.5665, 1.7275, 28.217, 0.4785, 1.1125, 0.6515, 0.625, 0.683, 0.524, 0.565, 2.9015, 0.6234999999999999, 1.0, 0.733, 0.9015, 0.753, 0.9219999999999999, 0.4275, 0.5075000000000001, 0.619, 0.4405, 1.4635, 0.442, 0.422, 1.545, 0.5, 0.479, 0.2715, 0.559, 1.296, 0.5700000000000001, 0.8245, 0.7695000000000001, 0.6890000000000001, 0.476, 0.4315, 0.497, 0.3375, 2.194, 0.368, 0.4905, 1.689, 0.3255, 0.5035000000000001, 0.4655, 0.948, 3.2235, 1.6985000000000001, 0.7515000000000001, 0.6225, 0.4205},
+		{2.1075, 2.1895, 2.3605, 1.8439999999999999, 1.879, 2.317, 2.382, 1.8895, 1.6655, 2.2575000000000003, 2.3685, 2.485, 1.9340000000000002, 2.174, 2.093, 2.0095, 1.7115, 2.0115, 1.6505, 2.0075000000000003, 2.037, 1.8965, 1.8455, 2.1325000000000003, 1.972, 2.0615, 1.775, 1.7245, 2.6740000000000004, 1.4575, 1.7715, 1.8719999999999999, 0.033, 1.763, 2.3275, 1.917, 1.9125, 1.8965, 1.7985, 2.1085000000000003, 2.0935, 1.762, 1.976, 8.7455, 2.0505, 4.5655, 2.005, 2.016, 1.8210000000000002, 1.9005, 2.2744999999999997, 5.1215, 15.0525, 3.3305, 2.0839999999999996, 2.0075000000000003, 1.8595000000000002, 2.093, 1.951, 1.9965, 1.7745, 2.034, 3.078, 2.047, 2.0345, 1.962, 1.7235, 1.924, 1.8585, 2.0175, 1.831, 2.455, 1.9445000000000001, 2.0380000000000003, 1.897, 1.7645, 1.9899999999999998, 1.9415, 11.7095, 2.1325000000000003, 2.5885, 1.782, 2.0625, 2.038, 1.819, 2.0140000000000002, 2.186, 1.799, 1.9409999999999998, 2.746, 1.9465, 1.8245, 1.8860000000000001, 1.6885, 8.347, 1.777, 1.655, 4.054, 1.7245, 2.496, 1.6795, 2.1470000000000002, 2.0675, 2.011, 1.7125, 7.573499999999999, 1.77, 1.7965, 3.0220000000000002, 1.504, 2.4045, 1.7599999999999998, 2.2435, 1.7734999999999999, 2.2104999999999997, 1.9049999999999998, 1.875, 1.9805000000000001, 2.0825, 2.9995000000000003, 2.0620000000000003, 2.5140000000000002, 2.3055000000000003, 4.7095, 2.0385, 1.7934999999999999, 2.0895, 1.8065, 1.9885, 1.8130000000000002, 1.8965, 1.8050000000000002, 2.0540000000000003, 2.0575, 2.0940000000000003, 2.083, 2.0285, 2.0795, 1.987, 1.9335, 2.1155, 1.7360000000000002, 1.729, 1.995, 2.4859999999999998, 2.8965, 26.9915, 1.7705, 2.3565, 2.472, 1.9135, 1.964, 1.9849999999999999, 1.8475000000000001, 3.3175, 1.8975, 2.174, 1.7280000000000002, 1.7275, 2.326, 1.7205, 1.8610000000000002, 1.891, 2.1395, 1.6179999999999999, 1.8545, 2.083, 2.0665, 4.0045, 1.8875, 1.91, 1.9895, 1.2495, 3.239, 2.2135, 2.609, 1.987, 1.9049999999999998, 1.8855, 2.417, 1.6935, 1.9015, 2.7775, 1.867, 1.968, 2.2525, 0.1105, 2.1125, 1.988, 2.7685, 4.161, 3.1935000000000002, 2.067, 1.7715, 1.8094999999999999},
+		{0.628, 0.45, 1.0745, 0.2705, 0.5185, 0.22, 0.95, 0.5225, 0.348, 0.4935, 0.3525, 0.734, 0.4345, 0.397, 0.1855, 0.34099999999999997, 0.2485, 0.2455, 0.652, 0.5555000000000001, 0.352, 0.2285, 0.747, 0.3755, 0.2285, 0.4915, 0.264, 0.323, 0.8545, 0.4065, 0.483, 0.6435, 1.9325, 0.011, 0.833, 0.436, 0.436, 0.3395, 0.438, 0.573, 0.416, 0.422, 0.571, 7.4805, 0.40449999999999997, 3.915, 0.646, 0.34550000000000003, 0.713, 0.4905, 0.524, 3.7285, 15.453499999999998, 1.9725000000000001, 0.5854999999999999, 0.408, 0.4105, 0.6955, 0.443, 0.388, 0.3025, 0.4265, 2.002, 0.3305, 0.4585, 0.4195, 0.442, 0.4415, 0.33899999999999997, 0.508, 0.39, 0.5305, 0.626, 0.452, 0.212, 0.57, 0.3365, 0.383, 12.455, 0.451, 0.8345, 0.3775, 0.8485, 0.39, 0.6234999999999999, 0.6425000000000001, 0.653, 0.337, 0.3795, 1.5554999999999999, 0.6585000000000001, 0.67, 0.389, 0.383, 8.1095, 0.392, 0.3775, 0.6895, 0.503, 0.8235, 0.4535, 0.42, 0.9874999999999999, 0.4365, 0.4225, 7.8345, 0.39349999999999996, 0.388, 0.4675, 0.306, 0.8795, 0.295, 0.5505, 0.3275, 1.9260000000000002, 0.24, 0.2475, 0.571, 0.3655, 1.8094999999999999, 0.428, 0.919, 0.5505, 5.2945, 0.3885, 0.91, 0.336, 0.371, 0.4305, 0.437, 0.383, 0.371, 0.341, 0.4965, 0.28049999999999997, 0.3665, 0.301, 0.799, 0.492, 0.326, 0.73, 0.2745, 0.242, 0.286, 0.3795, 1.8744999999999998, 26.989, 0.263, 0.6975, 0.7105, 0.4515, 0.6014999999999999, 0.2525, 0.2865, 2.6174999999999997, 0.344, 0.452, 0.3, 0.2465, 0.4915, 0.5435000000000001, 0.26, 0.426, 0.4325, 0.2795, 1.393, 0.3745, 0.4065, 0.6970000000000001, 0.356, 0.2895, 0.3565, 0.2745, 0.7505, 0.3075, 0.602, 0.444, 0.4475, 0.3135, 0.2585, 0.3915, 0.3835, 1.7995, 0.3855, 0.3855, 1.234, 0.0, 0.232, 0.2395, 0.7155, 2.5605, 4.181, 0.539, 0.5135000000000001, 0.3455},
+		{0.877, 0.8985000000000001, 1.2795, 0.749, 0.9215, 0.781, 3.1864999999999997, 1.2395, 0.668, 3.716, 0.9904999999999999, 5.3759999999999994, 0.6675, 0.883, 1.1280000000000001, 0.6745000000000001, 1.249, 0.8315, 1.097, 1.01, 0.5485, 0.7955000000000001, 1.384, 0.5575, 0.7405, 0.714, 0.5625, 0.887, 1.556, 1.075, 0.8594999999999999, 0.9835, 2.476, 1.408, 0.0365, 1.0790000000000002, 0.8925000000000001, 0.9995, 0.633, 1.3485, 0.73, 0.6545, 1.5095, 8.929, 0.6265000000000001, 4.936999999999999, 0.9085000000000001, 0.7025, 0.9385, 1.153, 1.567, 4.3835, 14.241, 2.0665, 0.902, 0.8160000000000001, 0.5485, 1.3355, 0.782, 0.629, 0.42400000000000004, 0.796, 2.8625, 1.061, 0.5794999999999999, 0.829, 0.9055, 0.749, 0.8575, 1.0495, 0.863, 1.7535, 0.967, 0.9295, 0.535, 0.7935, 1.3405, 0.5195000000000001, 14.299, 1.536, 1.246, 0.569, 1.0725, 0.5685, 2.4145, 1.0055, 0.8574999999999999, 0.8414999999999999, 0.621, 1.9605000000000001, 1.0185, 0.8485, 0.5295000000000001, 1.355, 10.1675, 0.751, 0.7865, 1.5019999999999998, 0.9335, 1.4715, 1.1480000000000001, 1.26, 1.226, 1.0145, 0.9864999999999999, 7.140499999999999, 1.1145, 2.5925000000000002, 1.594, 0.8795, 1.5905, 2.5505, 0.9390000000000001, 0.994, 1.5594999999999999, 0.9125000000000001, 0.471, 0.839, 0.7905, 2.623, 0.63, 1.6844999999999999, 1.486, 5.2725, 0.8915, 0.911, 0.622, 0.5635, 0.98, 0.8220000000000001, 0.72, 0.992, 1.04, 1.1364999999999998, 0.6775, 1.1404999999999998, 0.7949999999999999, 2.6885, 1.282, 0.4, 1.736, 0.9155, 1.064, 1.403, 1.109, 2.3275, 23.5365, 0.776, 1.38, 0.488, 0.8634999999999999, 1.1349999999999998, 0.51, 0.5569999999999999, 2.7605, 0.731, 1.202, 1.1475, 0.7805, 1.855, 1.3325, 0.5974999999999999, 0.7155, 1.3555000000000001, 0.9515, 2.1565, 0.4885, 0.6174999999999999, 2.959, 1.1415, 0.7929999999999999, 0.5940000000000001, 0.779, 2.9515000000000002, 0.8865, 1.4805000000000001, 1.0835, 0.6405000000000001, 0.494, 0.854, 0.4635, 0.8240000000000001, 2.3875, 0.653, 0.7545, 2.2355, 0.0, 1.432, 1.066, 1.8715000000000002, 3.625, 6.434, 1.075, 0.901, 0.601},
+		{0.645, 0.565, 1.055, 0.5335000000000001, 3.864, 0.46499999999999997, 5.075, 0.699, 0.4825, 0.7595000000000001, 0.5674999999999999, 1.057, 0.5435000000000001, 0.5065, 0.516, 0.52, 0.406, 0.543, 4.4615, 0.665, 0.5065, 0.5065, 0.8305, 0.5745, 0.40549999999999997, 0.6719999999999999, 0.4575, 0.426, 0.938, 0.485, 0.527, 0.6945, 2.0095, 0.4275, 1.0635, 0.013999999999999999, 0.531, 0.4525, 0.6005, 0.6305000000000001, 0.5625, 0.5, 0.637, 4.6695, 0.5654999999999999, 3.843, 0.469, 0.7435, 0.7115, 0.6325000000000001, 0.4815, 3.9805, 14.215, 2.653, 0.4955, 0.5545, 0.552, 0.5155000000000001, 0.516, 0.522, 0.531, 0.5445, 2.8975, 0.5045, 0.586, 0.503, 0.2605, 0.5685, 0.505, 0.576, 0.55, 0.7685, 0.669, 0.536, 0.349, 0.34750000000000003, 0.5265, 0.488, 11.779, 0.5765, 0.8545, 0.5285, 0.854, 0.479, 0.316, 0.4095, 0.7435, 0.483, 0.5205, 1.512, 0.8245, 0.5615, 0.5615000000000001, 0.622, 8.057500000000001, 0.523, 0.454, 0.822, 0.6074999999999999, 0.7885, 0.5705, 0.536, 0.966, 0.572, 0.506, 6.4565, 0.508, 0.5365, 0.7905, 0.525, 0.7795000000000001, 0.396, 0.697, 0.464, 1.314, 0.40549999999999997, 0.466, 0.401, 0.533, 1.1835, 0.6465000000000001, 0.9624999999999999, 0.6234999999999999, 5.1825, 0.504, 4.349500000000001, 0.629, 0.5225, 0.675, 0.7364999999999999, 0.5345, 0.462, 0.5925, 0.5355000000000001, 0.388, 0.4685, 0.5495000000000001, 0.878, 0.612, 0.4985, 0.815, 0.395, 0.385, 0.46599999999999997, 0.5834999999999999, 1.3835, 26.2785, 0.347, 0.8614999999999999, 0.572, 0.5285, 0.427, 0.3665, 0.395, 2.74, 0.4945, 0.988, 0.467, 0.4545, 0.654, 0.262, 0.37, 0.59, 0.625, 0.4925, 0.4475, 0.4505, 0.4625, 4.215, 0.5894999999999999, 0.494, 0.47, 0.421, 0.792, 0.4415, 0.7675000000000001, 0.592, 0.592, 0.373, 0.4575, 0.4585, 0.524, 1.8575, 0.489, 0.54, 1.3875, 0.0, 0.39249999999999996, 0.4815, 1.103, 3.2744999999999997, 3.684, 0.2945, 0.5885, 0.5265},
+		{0.6345000000000001, 0.5549999999999999, 0.9664999999999999, 0.397, 0.688, 0.3975, 0.966, 0.6375, 0.448, 0.584, 0.454, 0.7415, 0.5255000000000001, 0.5055000000000001, 0.4085, 0.475, 0.3905, 0.333, 0.657, 0.6234999999999999, 0.4105, 0.3585, 1.29, 0.418, 0.3615, 0.5225, 0.406, 0.3915, 0.9385, 0.5305, 0.5135000000000001, 0.587, 1.8895, 0.4415, 0.889, 0.5549999999999999, 0.006500000000000001, 0.4355, 0.562, 0.5785, 0.5015000000000001, 0.4685, 0.6114999999999999, 7.552, 0.4745, 3.9429999999999996, 0.71, 0.553, 0.6114999999999999, 0.5845, 0.66, 3.9945, 14.850999999999999, 2.476, 0.605, 0.7985, 0.521, 0.7615000000000001, 0.575, 0.4845, 0.3715, 0.509, 1.8415, 0.497, 0.516, 0.4755, 0.4995, 0.539, 0.5445, 0.5095000000000001, 0.554, 0.642, 0.6485000000000001, 0.5455000000000001, 0.3895, 0.704, 0.4815, 0.523, 12.242, 0.5794999999999999, 0.9195, 0.502, 0.8505, 0.489, 0.6194999999999999, 0.6415, 5.015000000000001, 2.5700000000000003, 0.477, 1.493, 0.6445000000000001, 0.6955, 0.53, 0.5235000000000001, 9.141, 0.51, 48.0835, 0.638, 0.59, 0.7415, 0.4835, 0.5529999999999999, 0.914, 0.553, 0.4915, 6.0415, 0.5195000000000001, 0.4785, 0.572, 0.3495, 0.83, 0.351, 0.66, 0.4105, 1.0884999999999998, 0.3945, 0.3675, 0.6154999999999999, 0.447, 2.3555, 0.5135000000000001, 1.138, 0.6615, 4.835, 0.486, 0.625, 0.466, 0.503, 0.534, 0.5575000000000001, 0.4555, 0.519, 0.4675, 0.594, 0.3955, 0.5125, 0.368, 0.7224999999999999, 0.578, 0.3715, 0.98, 0.36, 0.3685, 0.363, 0.433, 1.2155, 26.537, 0.393, 0.723, 0.434, 0.476, 0.633, 0.373, 0.362, 2.58, 0.39, 0.706, 0.40549999999999997, 0.409, 0.5455000000000001, 0.529, 0.3795, 0.5774999999999999, 0.6805000000000001, 0.406, 0.8705, 0.4235, 0.5015000000000001, 3.3034999999999997, 0.46950000000000003, 0.3985, 0.46699999999999997, 0.3735, 0.9305000000000001, 0.4105, 7.0995, 0.5834999999999999, 0.6455, 0.4, 0.376, 6.192, 0.495, 2.0585, 0.481, 0.508, 1.29, 0.0, 0.3935, 0.358, 0.78, 3.2729999999999997, 3.6925, 0.6114999999999999, 0.523, 0.5345},
+		{0.6154999999999999, 0.451, 1.021, 0.2875, 0.5, 0.311, 1.1155, 0.578, 0.3165, 0.5435000000000001, 0.415, 0.825, 0.4735, 0.4775, 0.374, 0.404, 0.2745, 0.304, 0.6465000000000001, 0.612, 0.223, 0.205, 0.6335, 0.503, 0.22, 0.5255000000000001, 0.271, 0.2875, 0.875, 0.419, 0.447, 0.53, 1.9045, 0.327, 0.622, 0.427, 0.4185, 0.0175, 0.4825, 0.6575, 0.4125, 0.416, 0.5495000000000001, 4.7065, 0.406, 4.345000000000001, 0.657, 0.5925, 0.626, 0.5345, 0.5525, 3.2169999999999996, 13.9725, 2.1559999999999997, 0.52, 0.343, 0.417, 0.608, 0.4925, 0.412, 0.2785, 0.432, 2.6574999999999998, 0.4475, 0.497, 0.46, 0.513, 0.3945, 0.3185, 0.727, 0.51, 0.595, 0.6475, 0.504, 0.309, 0.5655, 0.34199999999999997, 0.4505, 14.023499999999999, 0.4815, 0.7605, 0.471, 0.7875000000000001, 0.4125, 0.8665, 0.6174999999999999, 0.6225, 0.395, 0.383, 1.6915, 0.5714999999999999, 0.6565000000000001, 0.457, 0.435, 8.5195, 0.361, 0.4355, 0.7215, 0.525, 0.7675000000000001, 0.4975, 0.453, 0.909, 0.505, 0.3825, 6.779999999999999, 0.4855, 0.4275, 0.8, 0.266, 0.902, 0.288, 0.5575, 0.282, 0.924, 0.3175, 0.2005, 0.605, 0.46799999999999997, 1.21, 0.46199999999999997, 1.0045, 0.6485000000000001, 5.4535, 0.3925, 0.588, 0.3545, 0.399, 0.4715, 0.4925, 0.373, 0.406, 0.4745, 0.5645, 0.3055, 0.373, 0.3065, 0.9115, 0.536, 0.32, 0.896, 0.263, 0.274, 0.264, 0.389, 1.7125, 25.446, 0.263, 0.897, 0.6214999999999999, 0.4655, 0.492, 0.2925, 0.27949999999999997, 2.5995, 0.3325, 0.685, 0.4155, 0.2735, 0.714, 0.533, 0.216, 0.5045, 0.356, 0.333, 1.295, 0.387, 0.4905, 0.751, 0.382, 0.2845, 0.403, 0.3185, 0.7565, 0.3335, 0.613, 0.522, 0.445, 0.3115, 0.253, 0.3315, 0.43, 1.8900000000000001, 0.391, 0.475, 1.3490000000000002, 0.0, 0.248, 0.207, 0.7905, 2.8810000000000002, 5.9875, 0.5934999999999999, 0.447, 0.446},
+		{0.4315, 0.405, 1.1564999999999999, 0.5085, 0.5255000000000001, 0.5305, 0.975, 0.792, 0.4625, 0.3955, 0.3195, 0.8205, 0.339, 0.3905, 0.5055000000000001, 0.334, 0.421, 0.5285, 0.652, 0.5315000000000001, 0.528, 0.425, 0.6944999999999999, 0.78, 0.5365, 0.397, 0.563, 0.58, 1.4835, 0.3685, 0.358, 0.497, 1.994, 0.494, 0.8975, 0.627, 0.612, 0.473, 0.0135, 0.415, 0.3505, 0.3885, 0.5775, 5.82, 0.446, 4.8475, 0.7435, 0.673, 0.591, 0.6355, 0.6174999999999999, 4.5465, 16.372, 2.262, 0.5925, 0.634, 0.28700000000000003, 0.7885, 0.4025, 0.506, 0.5025, 0.4255, 2.4745, 0.356, 0.3835, 0.394, 0.601, 0.3635, 0.5589999999999999, 0.587, 0.249, 0.6545000000000001, 0.5515000000000001, 0.45, 0.3705, 0.5865, 0.333, 0.3035, 13.8065, 0.414, 0.817, 0.3675, 0.659, 0.3315, 1.0635, 0.5885, 5.0120000000000005, 0.38, 0.5055000000000001, 1.6705, 0.7875000000000001, 0.609, 0.35150000000000003, 0.422, 7.3375, 0.533, 0.361, 2.5395000000000003, 0.675, 0.698, 0.412, 0.369, 0.8414999999999999, 0.37, 0.368, 6.5344999999999995, 0.404, 0.369, 0.7615000000000001, 0.46, 1.0510000000000002, 0.558, 0.5235000000000001, 0.4665, 1.0695000000000001, 0.4775, 0.4485, 0.5640000000000001, 0.588, 1.537, 0.3935, 0.8835, 1.1684999999999999, 5.451, 0.40149999999999997, 0.653, 0.376, 0.5085, 0.5745, 0.4525, 0.398, 0.3285, 0.602, 0.7525, 0.4125, 0.7084999999999999, 0.4865, 0.808, 0.7464999999999999, 0.493, 0.8185, 0.505, 0.3945, 0.482, 0.6305000000000001, 1.6280000000000001, 28.4625, 0.4755, 1.006, 0.5880000000000001, 0.5465, 0.565, 0.4035, 0.4865, 2.5585, 0.5055000000000001, 0.7575000000000001, 0.5015000000000001, 0.602, 0.502, 0.704, 0.416, 0.4125, 0.741, 0.454, 1.541, 0.377, 0.3275, 6.118, 0.362, 0.4975, 0.318, 0.49, 0.59, 0.4545, 0.6505000000000001, 0.6465000000000001, 0.563, 0.463, 0.4795, 0.479, 0.317, 1.8615, 0.3595, 0.349, 1.436, 0.0, 0.376, 0.4845, 0.8089999999999999, 3.0875, 5.9005, 0.6915, 0.6505000000000001, 0.3675},
+		{0.425, 0.5854999999999999, 1.4235, 0.625, 0.553, 0.5714999999999999, 1.2534999999999998, 0.7525, 0.5505, 0.6125, 0.4705, 1.06, 0.504, 0.579, 0.606, 0.524, 0.615, 0.564, 0.7104999999999999, 0.6355, 0.5974999999999999, 0.608, 0.9375, 0.7015, 0.57, 0.5085, 0.548, 0.669, 1.9465, 0.486, 0.547, 0.597, 2.083, 0.554, 1.135, 0.7164999999999999, 0.633, 0.572, 0.40800000000000003, 0.012, 0.4455, 0.489, 0.668, 3.572, 0.583, 4.28, 0.7645, 0.7665, 0.607, 0.7775000000000001, 0.647, 4.2405, 15.706, 1.9365, 0.6395, 0.675, 0.47350000000000003, 0.8214999999999999, 0.4495, 0.661, 0.5814999999999999, 0.5555000000000001, 3.1715, 0.471, 0.554, 0.4665, 0.6805, 0.415, 0.66, 0.921, 1.0510000000000002, 0.629, 0.7315, 0.4885, 0.527, 0.589, 0.478, 0.4805, 13.811499999999999, 0.687, 0.9949999999999999, 0.6014999999999999, 0.8105, 0.454, 0.8385, 0.739, 0.6125, 0.465, 0.591, 1.6629999999999998, 0.8915, 0.7095, 0.5605, 0.525, 9.329, 0.651, 6.1594999999999995, 3.347, 0.687, 0.6955, 0.5105, 0.574, 0.8895, 0.568, 0.4955, 6.832, 0.4685, 0.4645, 1.209, 0.602, 0.967, 0.501, 0.6085, 0.613, 1.487, 0.6375, 0.512, 0.5495000000000001, 0.6745000000000001, 1.811, 0.483, 1.17, 1.1595, 5.6105, 0.5375000000000001, 0.7195, 0.4395, 0.66, 0.6465000000000001, 0.558, 0.45999999999999996, 0.513, 0.6455, 0.7364999999999999, 0.5475000000000001, 0.6735, 0.5205, 1.0195, 0.6944999999999999, 0.596, 0.835, 0.5595, 0.633, 0.5974999999999999, 0.675, 1.6295000000000002, 30.324, 0.562, 0.9835, 0.7044999999999999, 0.6645000000000001, 0.6425000000000001, 0.5125, 0.443, 2.792, 0.6485000000000001, 0.9844999999999999, 0.6964999999999999, 0.723, 0.803, 0.745, 0.5135000000000001, 0.607, 0.671, 0.611, 1.2205, 0.5654999999999999, 0.5235000000000001, 1.0434999999999999, 0.615, 0.5700000000000001, 0.47450000000000003, 0.6005, 0.784, 0.671, 0.808, 0.6605000000000001, 0.6074999999999999, 0.58, 0.59, 0.587, 0.4365, 2.0545, 0.536, 0.4225, 1.46, 0.0, 0.5205, 0.5445, 0.8075000000000001, 2.996, 4.467, 0.713, 0.697, 0.5705},
+		{0.336, 0.293, 0.968, 0.3625, 0.432, 0.327, 0.967, 0.6995, 0.427, 0.1555, 0.213, 0.7090000000000001, 0.2185, 0.3365, 0.387, 0.1785, 0.3925, 0.3765, 0.5449999999999999, 0.41200000000000003, 0.433, 0.415, 0.634, 0.4715, 0.3415, 0.281, 0.4135, 0.3635, 0.7965, 0.188, 0.1435, 0.377, 1.8969999999999998, 0.414, 0.8025, 0.4585, 0.4785, 0.3945, 0.2865, 0.442, 0.011, 0.24, 0.381, 3.311, 0.155, 4.1065000000000005, 0.558, 0.6415, 0.46299999999999997, 0.5754999999999999, 0.5075000000000001, 4.648, 15.6795, 2.566, 0.535, 0.5035000000000001, 0.2495, 0.601, 0.235, 0.4655, 0.3285, 0.2205, 1.555, 0.2245, 0.225, 0.3145, 0.532, 0.2265, 0.4585, 0.6105, 0.2465, 0.385, 0.5245, 0.333, 0.348, 0.439, 0.266, 0.2055, 11.9215, 0.2725, 0.7304999999999999, 0.222, 0.887, 0.1675, 0.6305000000000001, 3.8335, 0.4785, 0.248, 0.46950000000000003, 1.8359999999999999, 0.6955, 0.487, 0.173, 0.2255, 8.058, 0.5305, 0.579, 3.1385, 0.56, 0.699, 0.2295, 0.245, 0.786, 0.162, 0.243, 6.9245, 0.1185, 0.173, 0.313, 0.3895, 0.733, 0.393, 0.3775, 0.3915, 1.072, 0.414, 0.367, 0.441, 0.4995, 2.6745, 0.2765, 0.7384999999999999, 0.5660000000000001, 4.705, 0.2485, 0.4665, 0.2385, 0.4385, 0.6034999999999999, 0.24, 0.275, 0.223, 0.46950000000000003, 0.544, 0.3245, 0.587, 0.377, 0.752, 0.607, 0.418, 0.7969999999999999, 0.392, 0.359, 0.386, 0.489, 1.4449999999999998, 26.023, 0.3675, 0.7285, 0.46399999999999997, 0.5055000000000001, 0.518, 0.348, 0.375, 2.6115, 0.4125, 0.784, 0.4085, 0.392, 0.389, 0.595, 0.368, 0.26, 0.536, 0.3615, 0.391, 0.2755, 0.269, 1.564, 0.2925, 0.40700000000000003, 0.2385, 0.3575, 0.597, 0.385, 0.633, 0.615, 0.4965, 0.323, 0.336, 0.416, 0.20500000000000002, 1.9815, 0.2415, 0.216, 1.4674999999999998, 0.0, 0.3775, 0.39749999999999996, 0.746, 3.135, 7.3785, 0.589, 0.593, 0.2375},
+		{0.397, 0.1565, 0.966, 0.328, 0.3895, 0.3845, 0.9924999999999999, 0.669, 0.388, 0.2995, 0.122, 0.631, 0.258, 0.3325, 0.3855, 0.211, 0.235, 0.341, 0.5885, 0.4855, 0.389, 0.3475, 0.7355, 0.498, 0.28200000000000003, 0.3105, 0.361, 0.34750000000000003, 0.8009999999999999, 0.297, 0.27, 0.393, 2.1425, 0.3855, 0.6595, 0.5015000000000001, 0.449, 0.4625, 0.3585, 0.4485, 0.2785, 0.007, 0.38, 7.1025, 0.207, 4.0645, 0.5874999999999999, 0.5549999999999999, 0.5945, 0.6045, 0.33, 4.167999999999999, 16.062, 2.129, 0.54, 0.502, 0.1585, 0.6245, 0.332, 0.445, 0.4145, 0.2705, 1.708, 0.2175, 0.314, 0.2125, 0.517, 0.22549999999999998, 0.413, 0.46, 0.1815, 0.5405, 0.515, 0.369, 0.3235, 0.48, 0.1825, 0.2345, 12.774999999999999, 0.2025, 0.669, 0.20700000000000002, 0.693, 0.2055, 0.676, 0.4985, 0.417, 0.1475, 0.5085, 1.4755, 0.611, 0.535, 0.23299999999999998, 0.255, 7.375, 0.438, 0.2, 0.5874999999999999, 0.6185, 0.7464999999999999, 0.238, 0.2595, 0.799, 0.228, 0.1695, 6.9775, 0.229, 0.2045, 0.22949999999999998, 0.3925, 0.867, 0.309, 0.3665, 0.3915, 0.907, 0.3645, 0.382, 0.39, 0.4285, 1.5725, 0.256, 0.7985, 0.592, 5.201, 0.2385, 0.546, 0.161, 0.4635, 0.52, 0.3145, 0.1955, 0.149, 0.407, 0.664, 0.3195, 0.3945, 0.3865, 0.885, 0.4735, 0.3825, 0.9664999999999999, 0.33599999999999997, 0.3155, 0.3565, 0.4405, 2.137, 26.6845, 0.326, 0.77, 0.421, 0.4885, 0.52, 0.3425, 0.3595, 2.6334999999999997, 0.347, 0.7, 0.4035, 0.515, 0.377, 0.6265000000000001, 0.3595, 0.34299999999999997, 0.4995, 0.3645, 1.308, 0.1745, 0.242, 0.6575, 0.187, 0.3525, 0.115, 0.353, 0.5315000000000001, 0.346, 0.566, 0.54, 0.5015000000000001, 0.3745, 0.3345, 0.3905, 0.1775, 1.7145000000000001, 0.1025, 0.212, 1.451, 0.2445, 0.3455, 0.328, 0.861, 3.4165, 3.4210000000000003, 0.613, 0.48, 0.2065},
+		{0.6535, 0.668, 1.443, 0.5305, 0.473, 0.519, 1.159, 1.046, 0.539, 0.802, 0.348, 1.395, 0.5954999999999999, 0.4865, 0.53, 0.40549999999999997, 0.5345, 0.525, 0.5994999999999999, 0.5925, 0.5545, 0.542, 0.9570000000000001, 0.582, 0.5515000000000001, 0.4115, 0.575, 0.5135000000000001, 1.3439999999999999, 0.4445, 0.3235, 1.008, 2.301, 0.56, 1.0295, 0.6890000000000001, 0.667, 0.5834999999999999, 0.539, 0.642, 0.4415, 0.3535, 0.0155, 5.366, 0.3155, 4.172499999999999, 0.672, 0.8325, 0.5775, 0.7745, 0.8035000000000001, 3.939, 16.291, 2.9405, 0.664, 0.7424999999999999, 0.456, 0.671, 0.3165, 0.644, 0.5585, 0.3165, 3.1535, 0.3325, 0.359, 0.5814999999999999, 0.69, 0.3615, 0.6225, 0.829, 0.507, 1.0255, 0.627, 0.34299999999999997, 0.524, 0.54, 0.5145, 0.4025, 11.336500000000001, 0.3455, 0.79, 0.3665, 0.9365, 0.3855, 0.7855000000000001, 0.5725, 0.501, 0.3245, 0.6345000000000001, 1.8145, 0.8374999999999999, 0.667, 0.3085, 0.5309999999999999, 7.934, 0.672, 0.41200000000000003, 0.949, 0.7555000000000001, 1.2255, 0.617, 0.418, 0.8995, 0.405, 0.494, 6.125, 0.34450000000000003, 0.37, 1.172, 0.6855, 1.1415, 0.547, 0.7030000000000001, 0.5225, 1.3575, 0.6665000000000001, 0.4645, 0.41000000000000003, 0.5834999999999999, 1.7525, 0.516, 1.262, 1.3679999999999999, 5.3165, 0.2685, 0.572, 0.3275, 0.6365000000000001, 0.651, 0.4655, 0.4295, 0.3435, 0.6695, 0.759, 0.533, 1.2655, 0.505, 1.09, 0.8694999999999999, 0.545, 0.966, 0.493, 0.4245, 0.5115000000000001, 0.578, 1.863, 26.515, 0.493, 1.241, 0.6435, 0.6154999999999999, 0.6745, 0.573, 0.486, 2.7024999999999997, 0.5774999999999999, 0.9199999999999999, 0.7135, 0.6085, 0.761, 0.668, 0.5315000000000001, 0.2945, 0.733, 0.5335000000000001, 1.455, 0.3495, 0.3605, 1.8105, 0.40449999999999997, 0.577, 0.3635, 0.5640000000000001, 0.78, 0.6305000000000001, 1.1045, 0.775, 0.6005, 0.4645, 0.504, 0.5765, 0.3965, 2.3025, 0.393, 0.3395, 1.5625, 0.0, 0.5095000000000001, 0.504, 1.024, 3.2335000000000003, 3.8465000000000003, 0.7865, 0.675, 0.3855},
+		{4.5055, 3.7455, 6.4565, 27.947000000000003, 3.0869999999999997, 33.329499999999996, 3.0835, 7.021, 23.127499999999998, 31.3105, 6.2955000000000005, 6.4335, 29.429000000000002, 2.6515, 15.818, 1.952, 17.575499999999998, 23.7405, 2.6025, 7.197, 7.877000000000001, 24.706, 13.0995, 6.569, 32.771, 7.1505, 6.7684999999999995, 6.9285, 6.763999999999999, 6.631, 29.700499999999998, 6.330500000000001, 22.2775, 6.5825, 9.743, 6.779, 6.8145, 7.077500000000001, 4.9350000000000005, 6.2684999999999995, 20.4765, 5.5115, 6.6240000000000006, 0.228, 26.841, 6.6465, 6.269, 7.17, 2.7104999999999997, 6.5235, 31.360500000000002, 7.293, 18.563000000000002, 6.4455, 2.7465, 6.365, 6.576, 3.4685, 4.3255, 6.507, 31.4345, 6.5305, 2.6105, 1.4165, 6.949, 1.8399999999999999, 6.156499999999999, 6.748, 6.947, 31.259500000000003, 27.326, 4.2925, 26.677, 6.7425, 23.433, 4.4945, 6.1625, 26.549500000000002, 31.6705, 2.8165, 3.1535, 6.782, 6.992, 2.8895, 5.6265, 2.76, 5.3535, 6.6305, 2.332, 6.8955, 3.287, 3.667, 30.9385, 22.252499999999998, 31.744, 6.0575, 6.64, 4.968999999999999, 15.871500000000001, 7.9915, 13.335, 1.795, 7.457, 7.3925, 6.445, 7.1835, 15.186499999999999, 23.9585, 6.2335, 6.7915, 7.1015, 7.6195, 5.186, 32.829499999999996, 7.357, 28.9835, 31.5905, 7.167, 6.7895, 29.8425, 2.2705, 6.461, 6.5695, 5.4515, 28.069499999999998, 2.2154999999999996, 6.9755, 3.0425, 3.675, 6.95, 5.2495, 2.2990000000000004, 31.5505, 6.0265, 31.435000000000002, 17.3375, 13.6045, 32.656000000000006, 3.4215, 6.539, 32.2535, 31.865, 27.531, 8.96, 7.9735, 6.8485, 29.2605, 25.725, 6.7575, 6.987, 6.0845, 2.411, 29.505000000000003, 6.7575, 5.9615, 6.659, 32.763000000000005, 30.0295, 33.3125, 6.6605, 5.556, 23.823999999999998, 4.5075, 7.369, 27.4705, 29.421, 16.8375, 28.2935, 20.6595, 6.854, 13.092, 4.930999999999999, 17.836, 22.850499999999997, 24.72, 7.339, 13.494, 6.5915, 6.4085, 24.5405, 7.063, 4.7435, 6.967, 5.756, 20.2485, 6.8835, 0.0, 30.4315, 31.282, 28.7635, 26.9115, 8.1225, 7.156000000000001, 3.3585, 5.404},
+		{0.4495, 0.248, 1.1125, 0.3655, 0.4035, 0.377, 0.98, 0.5640000000000001, 0.3955, 0.2325, 0.1775, 0.748, 0.273, 0.3025, 0.34650000000000003, 0.229, 0.3275, 0.3375, 0.521, 0.43, 0.4355, 0.355, 0.673, 0.446, 0.318, 0.225, 0.387, 0.4045, 0.8955, 0.213, 0.202, 0.3955, 1.9769999999999999, 0.4105, 0.611, 0.454, 0.482, 0.406, 0.397, 0.522, 0.156, 0.204, 0.3025, 5.9625, 0.005, 4.3605, 0.5965, 0.6194999999999999, 0.5295000000000001, 0.593, 0.315, 4.1825, 15.119499999999999, 2.1025, 0.439, 0.4815, 0.2295, 0.6405000000000001, 0.258, 0.46950000000000003, 0.329, 0.274, 2.1025, 0.1795, 0.219, 0.27949999999999997, 0.552, 0.1625, 0.3825, 0.5015000000000001, 0.2215, 0.3735, 0.4885, 0.2875, 0.3025, 0.5085, 0.16649999999999998, 0.2165, 11.095500000000001, 0.20350000000000001, 0.6505000000000001, 0.162, 0.5985, 0.206, 0.588, 0.476, 0.477, 0.199, 0.385, 1.5859999999999999, 0.702, 0.5634999999999999, 0.135, 0.185, 9.1495, 0.4735, 0.2195, 0.6485000000000001, 0.62, 0.5449999999999999, 0.2015, 0.2195, 0.775, 0.197, 0.222, 6.794, 0.1325, 0.0955, 0.2695, 0.39949999999999997, 0.8285, 0.353, 0.353, 0.4205, 1.119, 0.3655, 0.3395, 0.35, 0.4315, 1.0699999999999998, 0.296, 0.694, 0.7645, 4.614, 0.1975, 0.5255000000000001, 0.23349999999999999, 0.41000000000000003, 0.523, 0.2545, 0.2115, 0.1625, 0.3935, 0.6395, 0.329, 0.493, 0.3925, 0.732, 0.513, 0.4265, 0.867, 0.358, 0.3675, 0.411, 0.4355, 1.617, 28.014499999999998, 0.338, 0.884, 0.40800000000000003, 0.528, 0.5355000000000001, 0.3785, 0.3045, 2.6905, 0.3955, 0.658, 0.4445, 0.516, 0.579, 0.631, 0.357, 0.2615, 0.5355000000000001, 0.33799999999999997, 1.1475, 0.229, 0.2705, 0.643, 0.2155, 0.3745, 0.1805, 0.348, 0.6054999999999999, 0.402, 0.651, 0.586, 0.462, 0.3685, 0.3545, 0.371, 0.2475, 1.7599999999999998, 0.2195, 0.1115, 1.266, 0.199, 0.3085, 0.3595, 0.703, 3.2984999999999998, 5.8655, 0.6475, 0.5635, 0.193},
+		{5.3855, 4.5809999999999995, 5.02, 4.4295, 4.9559999999999995, 5.859, 5.045999999999999, 5.286, 4.9885, 6.177, 5.25, 5.058, 5.1975, 4.6425, 5.048500000000001, 4.923, 4.375, 5.3605, 4.7509999999999994, 4.886, 5.702999999999999, 5.3149999999999995, 5.308, 4.9535, 4.8134999999999994, 4.952, 4.6675, 4.7315000000000005, 5.5405, 5.053, 4.878, 4.683, 4.765, 4.5355, 5.5835, 5.479, 5.4295, 5.1575, 5.4625, 4.4925, 4.8100000000000005, 5.795999999999999, 4.736, 6.3085, 4.503, 0.10300000000000001, 4.736, 4.843, 4.636, 5.856, 5.083, 7.2325, 15.6375, 7.3795, 5.193, 4.895, 5.1145, 5.441, 5.6185, 4.3614999999999995, 6.846500000000001, 5.0600000000000005, 6.0805, 4.478999999999999, 4.8495, 5.129, 4.599, 4.8415, 4.4925, 5.442, 5.1985, 5.1754999999999995, 4.312, 5.2285, 4.6405, 4.072, 4.7635000000000005, 4.0525, 13.8935, 4.57, 6.098000000000001, 4.7105, 4.872, 4.7490000000000006, 4.9765, 5.165, 4.7075, 6.8335, 5.335000000000001, 5.7204999999999995, 4.6315, 5.420999999999999, 4.8605, 4.9735, 14.4185, 5.6145, 3.94, 8.2705, 5.802, 5.228999999999999, 4.4879999999999995, 4.910500000000001, 6.826499999999999, 5.041, 4.893000000000001, 10.407, 5.118, 5.539, 7.568, 4.7495, 6.5215, 5.542, 5.529, 4.5415, 4.769500000000001, 4.8015, 4.898, 5.2645, 5.4835, 5.5225, 4.7865, 5.0105, 5.957, 7.202, 4.795, 5.1685, 5.215, 4.955, 4.5825, 5.395, 5.1985, 4.508, 5.907500000000001, 5.562, 4.6945, 4.6365, 4.58, 5.1385000000000005, 4.6754999999999995, 3.9669999999999996, 5.016, 4.452, 4.493, 3.9915000000000003, 4.945, 6.138, 25.930500000000002, 4.869, 5.5425, 4.419499999999999, 4.661, 4.9015, 5.5135, 5.283, 5.723, 4.3705, 6.65, 5.211, 4.643000000000001, 5.4670000000000005, 4.379, 4.800000000000001, 5.241, 4.797, 4.615, 5.271, 4.7415, 4.7885, 9.158, 5.010999999999999, 4.4864999999999995, 4.1805, 5.2585, 6.372, 5.4425, 7.024, 4.8365, 4.4605, 5.792, 5.1375, 4.529999999999999, 4.8955, 5.2615, 5.4375, 4.2225, 5.0305, 0.0, 4.518000000000001, 4.7059999999999995, 7.048, 6.643, 7.2989999999999995, 5.2075, 4.166, 5.056},
+		{0.7135, 0.6845000000000001, 1.0745, 0.587, 0.42, 0.8365, 1.082, 0.884, 0.635, 1.3325, 0.575, 1.6445, 0.633, 0.6955, 0.7455, 0.6435, 0.6375, 0.594, 0.5195000000000001, 0.661, 0.528, 0.8494999999999999, 0.8165, 0.658, 0.4925, 0.586, 0.615, 0.6325000000000001, 0.741, 0.5405, 0.64, 0.7825, 2.3855000000000004, 0.639, 1.0575, 0.436, 0.7064999999999999, 0.741, 0.679, 0.8614999999999999, 0.5675, 0.613, 0.675, 3.2715, 0.527, 3.9335000000000004, 0.02, 0.9115, 0.785, 0.835, 0.577, 6.039, 13.5125, 3.6465, 0.3545, 0.823, 0.6345000000000001, 0.5589999999999999, 0.51, 0.6984999999999999, 0.4935, 0.5589999999999999, 3.0475, 0.6335, 0.5, 0.6395, 0.4295, 0.615, 0.553, 0.824, 0.7084999999999999, 0.8925, 0.7244999999999999, 0.5589999999999999, 0.4995, 0.417, 0.6835, 0.628, 12.2075, 0.6465000000000001, 0.9784999999999999, 0.5814999999999999, 1.0745, 0.6074999999999999, 0.549, 0.3385, 0.5595000000000001, 0.574, 0.647, 1.78, 0.7655000000000001, 0.5315000000000001, 0.532, 0.6495, 9.514, 0.8005, 0.646, 1.407, 0.6575, 1.0154999999999998, 0.6355, 0.6185, 0.888, 0.6375, 0.6114999999999999, 7.3595, 0.5285, 0.6295, 2.6775, 0.715, 0.88, 0.5075000000000001, 0.5985, 0.58, 1.2810000000000001, 0.6475, 0.599, 0.388, 0.722, 1.7665000000000002, 0.7064999999999999, 0.8425, 0.794, 6.2405, 0.5785, 0.3445, 0.582, 0.565, 0.6485000000000001, 0.6495, 0.625, 0.664, 0.734, 0.4725, 0.6225, 0.9415, 0.5609999999999999, 1.4085, 0.753, 0.6855, 1.028, 0.518, 0.4555, 2.206, 0.7605, 1.6435, 26.0325, 0.539, 1.329, 1.1955, 0.649, 0.3665, 0.634, 0.5565, 3.1305, 0.6565000000000001, 0.8885000000000001, 0.6855, 0.6635, 0.801, 0.402, 0.471, 0.5645, 0.734, 0.7965, 1.3875, 0.609, 0.533, 0.6865, 0.6955, 0.98, 0.579, 0.7415, 2.8520000000000003, 0.6435, 1.1324999999999998, 0.6725000000000001, 0.738, 0.7055, 0.6695, 0.7144999999999999, 0.6535, 2.3135000000000003, 0.63, 0.6045, 1.6635, 0.0, 0.883, 0.7004999999999999, 1.1355, 3.591, 6.6285, 0.499, 0.727, 0.599},
+		{0.76, 0.692, 1.0554999999999999, 0.3945, 0.7235, 0.589, 0.8975, 0.823, 0.58, 0.6815, 0.6315, 0.9195, 0.5515, 0.6779999999999999, 0.4985, 0.528, 0.5135000000000001, 0.566, 0.788, 0.7855000000000001, 0.6225, 0.503, 0.973, 0.782, 0.504, 0.621, 0.4795, 0.6405000000000001, 1.2605, 0.5965, 0.5805, 0.9045, 2.0095, 0.4275, 1.081, 0.782, 0.6415, 0.5595, 0.623, 0.6910000000000001, 0.5694999999999999, 0.5385, 0.885, 8.413, 0.6154999999999999, 4.0920000000000005, 0.9544999999999999, 0.039, 0.8405, 0.73, 0.4865, 3.9480000000000004, 14.517, 2.0020000000000002, 0.6305000000000001, 0.7925, 0.5455000000000001, 0.946, 0.552, 0.6365000000000001, 0.5674999999999999, 0.496, 1.531, 0.627, 0.6415, 0.592, 0.658, 0.6375, 0.568, 0.7765, 0.6185, 0.799, 0.787, 0.639, 0.39849999999999997, 0.7255, 0.534, 0.6034999999999999, 11.947500000000002, 0.5680000000000001, 0.916, 0.624, 0.9924999999999999, 0.5725, 0.8505, 0.7575000000000001, 0.778, 0.603, 0.562, 1.5765, 0.765, 0.8145, 0.5885, 0.647, 10.458, 0.7665, 0.572, 0.894, 0.7725, 0.9744999999999999, 0.5714999999999999, 0.6995, 0.9530000000000001, 0.59, 0.485, 7.1775, 0.593, 0.607, 0.7805, 0.51, 1.0465, 0.547, 0.693, 0.404, 1.045, 0.5435000000000001, 0.4745, 0.682, 0.67, 1.3085, 0.558, 1.023, 1.0045, 4.996, 0.6915, 0.661, 0.57, 0.6315, 0.57, 0.5355000000000001, 0.519, 0.5785, 0.537, 0.754, 0.5175000000000001, 0.559, 0.5745, 1.0715, 0.649, 0.5814999999999999, 0.869, 0.5625, 0.5, 0.6815, 0.5485, 1.554, 28.481, 0.4545, 0.977, 0.715, 0.6665000000000001, 0.8805000000000001, 0.6335, 0.6174999999999999, 2.6785, 0.53, 0.8825000000000001, 0.528, 0.5145, 0.733, 0.764, 0.506, 0.627, 0.7725, 0.5155000000000001, 1.5085, 0.663, 0.5934999999999999, 1.0715, 0.5585, 0.578, 0.46950000000000003, 0.455, 1.0065, 0.679, 0.6955, 0.798, 0.58, 0.5055000000000001, 0.5860000000000001, 0.551, 0.5285, 1.908, 0.6395, 0.616, 1.479, 0.0, 0.5155000000000001, 0.4675, 0.9375, 2.7355, 4.909000000000001, 0.68, 0.7825, 0.67},
+		{0.601, 0.603, 1.1735, 0.6385000000000001, 0.5295000000000001, 0.63, 0.6805, 0.8035000000000001, 0.588, 0.558, 0.484, 0.7855, 0.4875, 0.5945, 0.5525, 0.411, 0.563, 0.6765000000000001, 0.237, 0.57, 0.6815, 0.5605, 0.7545, 0.628, 0.446, 0.427, 0.6205, 0.63, 0.917, 0.3985, 0.4955, 0.629, 2.0205, 0.679, 1.046, 0.6505000000000001, 0.667, 0.598, 0.448, 0.6355, 0.505, 0.605, 0.63, 2.687, 0.455, 5.1205, 0.6675, 0.754, 0.009, 0.6475, 0.533, 3.2235, 13.8645, 2.9915000000000003, 0.6525000000000001, 0.649, 0.5375000000000001, 0.7095, 0.4325, 0.632, 0.5794999999999999, 0.449, 1.9874999999999998, 0.4235, 0.4165, 0.46399999999999997, 0.6595, 0.36, 0.634, 0.7164999999999999, 0.4325, 0.8260000000000001, 0.616, 0.4585, 0.6385000000000001, 0.636, 0.5005, 0.4505, 13.382000000000001, 0.45499999999999996, 0.528, 0.3945, 0.7735000000000001, 0.47, 0.737, 0.6174999999999999, 0.585, 0.4025, 0.577, 1.4155, 0.982, 0.559, 0.493, 0.4745, 5.3365, 0.7104999999999999, 2.8285, 0.922, 0.737, 0.822, 0.515, 0.479, 0.588, 0.433, 0.4915, 6.2115, 0.4535, 0.428, 0.6094999999999999, 0.6074999999999999, 3.509, 0.5529999999999999, 0.547, 0.5609999999999999, 1.071, 0.5555, 0.598, 0.539, 0.655, 1.8915, 0.52, 0.9664999999999999, 0.8109999999999999, 5.518, 0.4245, 0.319, 0.4885, 0.6074999999999999, 0.578, 0.4865, 0.48, 0.459, 0.5965, 0.8154999999999999, 0.594, 0.6990000000000001, 0.6134999999999999, 0.9335, 0.8194999999999999, 0.6275, 0.9530000000000001, 0.5545, 0.5934999999999999, 0.6174999999999999, 0.5665, 1.5625, 24.227, 0.5609999999999999, 0.8505, 0.574, 0.7384999999999999, 0.623, 0.553, 0.594, 2.819, 0.5694999999999999, 0.6685000000000001, 0.632, 0.5545, 0.5934999999999999, 0.671, 0.525, 0.4585, 0.7244999999999999, 0.5355000000000001, 1.6475, 0.4985, 0.46799999999999997, 0.959, 0.392, 0.5705, 0.47450000000000003, 0.605, 0.948, 0.6585000000000001, 0.6885, 0.6910000000000001, 0.6795, 0.626, 0.5694999999999999, 0.4985, 0.5165, 2.0825, 0.431, 0.46, 1.505, 0.0, 0.556, 0.561, 0.92, 3.008, 0.972, 0.6805, 0.7375, 0.463},
+		{0.708, 0.599, 1.1, 0.5615, 0.672, 0.4195, 0.9984999999999999, 0.7665, 0.52, 0.656, 0.52, 1.097, 0.5894999999999999, 0.5985, 0.4545, 0.499, 0.5245, 0.3855, 0.666, 0.7344999999999999, 0.4925, 0.578, 0.753, 0.5335000000000001, 0.4495, 0.6665000000000001, 0.447, 0.6014999999999999, 1.5859999999999999, 0.614, 0.6695, 0.688, 2.0225, 0.5, 0.8160000000000001, 0.599, 0.526, 0.5, 0.6295, 0.729, 0.5885, 0.6134999999999999, 0.8160000000000001, 4.262499999999999, 0.571, 3.5175, 0.837, 0.6995, 0.6904999999999999, 0.013, 0.727, 3.7925, 16.72, 1.834, 0.757, 0.587, 0.568, 0.8005, 0.597, 0.594, 0.4955, 0.6234999999999999, 2.3345000000000002, 0.5505, 0.6805000000000001, 0.548, 0.6185, 0.6105, 0.548, 0.7825, 0.617, 0.8300000000000001, 0.8055000000000001, 0.5994999999999999, 0.455, 0.7284999999999999, 0.5545, 0.5335000000000001, 13.132000000000001, 0.6074999999999999, 0.901, 0.559, 0.9475, 0.5285, 0.666, 0.7575000000000001, 0.6305000000000001, 0.5045, 0.5395000000000001, 2.016, 0.919, 0.7484999999999999, 0.5615000000000001, 0.6145, 13.261, 0.585, 1.945, 1.0030000000000001, 0.6355, 0.8985, 0.5505, 0.5925, 1.0635, 0.566, 0.605, 7.264, 0.6795, 0.688, 0.7605, 0.46950000000000003, 1.2265000000000001, 0.444, 0.8135, 0.588, 1.1480000000000001, 0.5725, 0.4045, 0.688, 0.581, 1.0015, 0.645, 1.2915, 0.8620000000000001, 4.8175, 0.6585000000000001, 0.791, 0.5934999999999999, 1.5875, 0.646, 0.616, 0.4895, 0.607, 0.69, 0.6895, 0.4685, 0.8285, 0.4355, 0.8334999999999999, 0.719, 0.44, 1.009, 0.6025, 0.511, 0.462, 0.4785, 1.3655, 26.399, 0.601, 0.937, 0.9929999999999999, 0.5125, 0.674, 0.446, 0.392, 2.476, 0.5215000000000001, 0.8795, 0.493, 0.5195000000000001, 0.742, 0.9844999999999999, 0.48, 0.637, 0.5325, 0.534, 1.69, 0.6455, 0.705, 0.826, 0.5225, 0.6675, 0.4685, 0.6225, 1.186, 0.552, 0.7255, 0.6214999999999999, 0.5205, 0.3565, 0.514, 0.5065, 0.596, 1.9304999999999999, 0.5640000000000001, 0.6275, 1.3860000000000001, 0.0, 0.5235000000000001, 0.456, 0.87, 2.58, 3.561, 0.627, 0.6014999999999999, 0.423},
+		{0.558, 0.397, 0.6325000000000001, 0.1885, 0.5155000000000001, 0.315, 1.1205, 0.729, 0.2345, 0.4785, 0.3005, 0.8294999999999999, 0.387, 0.41400000000000003, 0.327, 0.349, 0.1925, 0.1635, 0.645, 0.494, 0.348, 0.4555, 0.46699999999999997, 0.548, 0.117, 0.4795, 0.2105, 0.2625, 0.9085, 0.4705, 0.438, 0.513, 2.145, 0.347, 0.9610000000000001, 0.449, 0.407, 0.4285, 0.479, 0.6125, 0.429, 0.2995, 0.543, 8.569500000000001, 0.3595, 4.189500000000001, 0.608, 0.507, 0.5505, 0.5589999999999999, 0.0155, 3.991, 14.939, 2.316, 0.5685, 0.3315, 0.35850000000000004, 0.6825, 0.4475, 0.367, 0.1635, 0.429, 1.3535, 0.3315, 0.4285, 0.366, 0.408, 0.3675, 0.2915, 0.4315, 0.7475, 0.5945, 0.6225, 0.4735, 0.179, 0.5660000000000001, 0.2975, 0.344, 13.341000000000001, 0.4115, 0.79, 0.3675, 0.783, 0.362, 0.492, 0.5135000000000001, 0.7355, 0.4, 0.387, 1.3860000000000001, 0.5585, 0.625, 0.392, 0.4675, 7.4345, 0.478, 0.3765, 0.585, 0.613, 0.952, 0.514, 0.353, 0.8305, 0.476, 0.46799999999999997, 8.2625, 0.3625, 0.3645, 1.5030000000000001, 0.1925, 0.809, 0.236, 0.5205, 0.221, 2.096, 0.3625, 0.1295, 0.563, 0.3865, 0.9450000000000001, 0.4175, 1.0445, 1.181, 4.3655, 0.433, 0.524, 0.3305, 0.4625, 0.5075000000000001, 0.4585, 0.2805, 0.3565, 0.679, 0.44, 0.27749999999999997, 0.6485000000000001, 0.2125, 0.7855, 0.40549999999999997, 0.216, 0.725, 0.1295, 0.147, 0.519, 0.288, 1.663, 26.1045, 0.259, 0.8225, 0.299, 0.3745, 0.5634999999999999, 0.1425, 0.185, 2.3865, 0.281, 0.746, 0.1795, 1.323, 0.625, 0.475, 0.152, 0.4825, 0.4015, 0.258, 1.4015, 0.3305, 0.427, 0.765, 0.4685, 0.2125, 0.2875, 0.21, 0.607, 0.2235, 0.534, 0.497, 0.4725, 0.24, 0.344, 0.266, 0.3745, 1.9475, 0.337, 0.4145, 1.218, 0.0, 0.5794999999999999, 0.173, 0.6034999999999999, 3.218, 4.963, 0.53, 0.4975, 0.388},
+		{1.4945, 4.58, 2.3685, 3.411, 2.6605, 1.2735, 3.0885, 3.9835000000000003, 4.3309999999999995, 4.7005, 1.5230000000000001, 3.675, 2.9225, 4.76, 2.543, 2.3085, 2.0934999999999997, 2.3884999999999996, 3.945, 3.1189999999999998, 3.66, 3.167, 3.1795, 2.7569999999999997, 2.3834999999999997, 2.1465, 4.4055, 3.2845, 4.013, 3.8810000000000002, 2.1335, 3.5445, 3.2190000000000003, 1.6640000000000001, 5.2715, 3.5564999999999998, 2.87, 3.835, 4.968999999999999, 2.009, 2.7015000000000002, 2.6265, 3.3064999999999998, 9.735, 2.4850000000000003, 6.1125, 3.645, 2.748, 2.959, 2.4485, 1.797, 0.0475, 17.681, 9.658999999999999, 3.5055, 4.715, 3.759, 3.566, 3.2545, 3.4314999999999998, 1.71, 3.181, 6.017, 3.4234999999999998, 2.399, 1.6905000000000001, 2.1574999999999998, 3.8385, 3.5175, 3.4615, 2.9755000000000003, 3.8905000000000003, 2.674, 2.8695000000000004, 3.0555000000000003, 3.0225, 2.105, 3.4115, 15.1465, 3.188, 1.8515000000000001, 1.8555000000000001, 4.3155, 1.2255, 3.848, 3.7, 1.5865, 2.379, 2.9415, 3.7855, 2.4055, 2.343, 2.1265, 4.673500000000001, 12.549499999999998, 3.1580000000000004, 3.3440000000000003, 4.1125, 2.46, 5.8115000000000006, 3.77, 4.016, 5.785, 2.576, 4.2015, 8.699, 4.541, 3.0315000000000003, 3.7555, 2.512, 4.837, 5.426, 2.8255, 2.2135, 4.111, 2.4859999999999998, 2.6925, 2.468, 1.6320000000000001, 4.4755, 1.884, 3.411, 2.339, 6.4595, 3.1105, 4.84, 2.4225000000000003, 2.5060000000000002, 1.845, 2.9375, 3.822, 4.4985, 2.1045, 3.9844999999999997, 3.848, 4.6715, 1.645, 3.674, 3.9050000000000002, 1.865, 2.002, 2.2095000000000002, 3.208, 2.747, 1.5225, 4.787000000000001, 24.9315, 3.802, 3.0445, 4.0655, 2.085, 2.7794999999999996, 3.2675, 3.3525, 5.207, 1.4385, 1.5419999999999998, 3.706, 3.677, 1.9685000000000001, 2.9204999999999997, 4.432, 2.461, 4.3285, 2.5330000000000004, 3.4355, 2.797, 2.8165, 5.8065, 1.9905, 3.282, 3.74, 3.6420000000000003, 3.2475, 2.8415, 2.327, 2.956, 3.475, 4.033, 1.189, 3.9979999999999998, 4.141, 4.316, 2.9105, 2.3145, 4.211, 0.0, 1.4695, 3.9734999999999996, 1.7095, 3.912, 7.590999999999999, 2.8289999999999997, 3.064, 3.385},
+		{10.809999999999999, 14.843499999999999, 5.254, 11.2225, 11.690999999999999, 8.496, 11.542, 10.5725, 15.4295, 20.509999999999998, 4.473000000000001, 18.603499999999997, 8.908, 7.7035, 16.774, 6.311, 19.3945, 9.6015, 19.685499999999998, 4.891500000000001, 11.0775, 19.430999999999997, 9.155000000000001, 11.618500000000001, 12.474499999999999, 14.100999999999999, 6.6475, 11.868, 13.1615, 13.577000000000002, 7.1419999999999995, 11.4075, 13.862, 11.0135, 12.3625, 8.600999999999999, 18.407, 18.507, 8.371, 18.992, 11.626, 6.144, 9.382000000000001, 15.368, 9.5785, 8.812999999999999, 12.2615, 7.8165, 18.8515, 6.957000000000001, 6.0985, 19.524500000000003, 0.007, 21.4725, 10.294, 8.878499999999999, 11.7515, 10.7655, 9.735, 10.377500000000001, 9.5765, 9.245000000000001, 21.6205, 16.688499999999998, 6.5795, 12.464, 20.669, 11.6785, 9.019, 6.9265, 9.157499999999999, 13.6875, 10.854, 6.5395, 7.9985, 9.131, 19.4, 8.5625, 20.584000000000003, 15.105, 9.0515, 10.6375, 12.3325, 8.169, 9.5105, 9.154, 9.1725, 6.2205, 11.0565, 11.3965, 12.0485, 9.7595, 10.3455, 20.7365, 19.884999999999998, 10.969999999999999, 10.9555, 11.639, 19.267000000000003, 10.835999999999999, 18.226, 16.59, 11.0705, 9.3625, 9.774000000000001, 15.282, 10.4545, 16.5675, 12.699, 19.33, 19.35, 7.8455, 8.8505, 20.889000000000003, 12.184999999999999, 9.285499999999999, 6.891500000000001, 8.714, 10.764, 14.770499999999998, 8.978, 13.145, 9.1375, 19.9495, 11.253, 7.766, 7.0975, 10.2165, 12.6445, 13.738, 17.6715, 8.7225, 8.206, 11.316500000000001, 20.311500000000002, 9.150500000000001, 12.415, 16.701500000000003, 6.5525, 9.5415, 20.008, 11.5145, 11.383, 16.777, 10.11, 10.2335, 28.3325, 18.124499999999998, 18.4675, 14.553, 12.1675, 10.6555, 8.357, 10.212, 17.909, 4.91, 9.075, 5.284, 7.936, 9.3035, 18.697499999999998, 7.233499999999999, 12.151, 6.818, 16.553, 14.777000000000001, 7.656000000000001, 7.291499999999999, 21.183500000000002, 18.648, 19.9405, 9.502500000000001, 16.7325, 22.935000000000002, 10.188500000000001, 12.254000000000001, 7.0184999999999995, 7.177, 8.341, 7.7635000000000005, 15.9025, 18.872, 18.863999999999997, 11.789000000000001, 6.975, 17.8545, 0.0, 18.617, 12.101500000000001, 10.8265, 19.363, 19.6455, 5.954000000000001, 13.278500000000001, 12.553},
+		{1.617, 2.8689999999999998, 2.7824999999999998, 2.145, 2.5519999999999996, 2.784, 9.501, 2.344, 1.8944999999999999, 4.1745, 2.5324999999999998, 4.6690000000000005, 1.47, 2.1260000000000003, 1.7945, 2.2424999999999997, 1.9095, 2.5925000000000002, 7.143000000000001, 2.1849999999999996, 2.293, 3.1945, 3.3369999999999997, 3.0175, 1.924, 1.6955, 1.366, 1.568, 3.258, 2.1710000000000003, 1.603, 2.2655000000000003, 3.4425, 2.7545, 3.146, 3.1295, 1.682, 1.7005, 2.5765000000000002, 2.7794999999999996, 1.7774999999999999, 1.9495, 2.96, 12.263, 2.8185000000000002, 5.3285, 1.1709999999999998, 2.3775, 2.4265, 1.574, 2.034, 9.3475, 17.412, 0.035, 1.5925, 2.933, 1.4705, 1.287, 1.5885, 3.8575, 1.7525, 1.968, 6.4335, 1.633, 1.9660000000000002, 2.019, 3.4245, 2.1020000000000003, 2.551, 1.7405, 1.6535000000000002, 2.5695, 2.8810000000000002, 2.4314999999999998, 2.403, 3.283, 1.0070000000000001, 4.8265, 13.892, 2.79, 2.5060000000000002, 1.326, 2.1975, 2.5075, 2.741, 3.46, 2.864, 4.2255, 3.0265, 3.304, 3.5614999999999997, 1.465, 1.776, 2.9605, 14.273, 1.8624999999999998, 1.8995000000000002, 3.269, 1.5705, 2.8920000000000003, 2.9735, 5.404, 2.3995, 1.6655, 2.028, 8.6025, 2.5925000000000002, 1.5105, 6.0825, 2.1245000000000003, 6.4105, 1.855, 2.0575, 2.088, 2.0765000000000002, 2.0565, 1.5659999999999998, 1.6975, 2.25, 4.8255, 2.3339999999999996, 4.0285, 2.6745, 7.6485, 1.6065, 7.2425, 1.8944999999999999, 2.5454999999999997, 1.8864999999999998, 1.862, 3.011, 2.936, 4.904999999999999, 2.54, 1.9075, 1.7945, 2.0949999999999998, 2.814, 1.9580000000000002, 2.011, 2.6734999999999998, 2.974, 2.1005000000000003, 1.6905000000000001, 3.5755, 4.681, 26.1705, 1.399, 5.5634999999999994, 3.403, 1.7475, 3.0725, 1.5225, 1.8615, 4.444, 2.5555, 2.322, 3.989, 2.036, 1.8145, 1.887, 1.6395, 1.6545, 1.9655, 1.463, 2.8925, 2.1105, 2.6195, 11.068, 2.3925, 2.4219999999999997, 1.746, 1.933, 7.6370000000000005, 2.3120000000000003, 4.4745, 4.5305, 2.7430000000000003, 2.072, 2.049, 2.454, 2.8600000000000003, 2.535, 1.63, 2.0835, 4.6685, 0.0, 2.2315, 1.6949999999999998, 2.582, 4.33, 6.843, 1.8054999999999999, 1.4755, 1.9565000000000001},
+		{0.776, 0.6375, 1.1825, 0.543, 0.4, 2.1390000000000002, 0.97, 0.834, 0.5505, 0.611, 0.6305000000000001, 0.711, 0.5295000000000001, 0.5754999999999999, 0.397, 0.5575000000000001, 0.6094999999999999, 0.591, 0.4815, 0.6355, 0.6094999999999999, 0.5095000000000001, 1.0339999999999998, 0.662, 0.5265, 0.4935, 0.6435, 0.6145, 1.0594999999999999, 0.4855, 0.6205, 0.7084999999999999, 1.9264999999999999, 0.6245, 0.7505, 0.4975, 0.6174999999999999, 0.551, 0.6045, 0.6815, 0.5, 0.6005, 0.6365000000000001, 5.7684999999999995, 0.439, 4.077, 0.35, 0.628, 0.763, 0.696, 0.552, 3.8005, 14.8955, 2.578, 0.0185, 0.791, 0.507, 0.396, 0.516, 0.5674999999999999, 0.8785000000000001, 0.4695, 2.0515, 0.5415000000000001, 0.459, 0.5375000000000001, 0.46950000000000003, 0.443, 0.578, 0.718, 0.5825, 0.6615, 0.6635, 0.497, 0.517, 0.2945, 0.557, 0.477, 12.981, 0.6134999999999999, 1.067, 0.4505, 0.8075000000000001, 0.527, 0.6955, 0.2485, 0.4725, 0.472, 0.469, 1.787, 0.806, 0.429, 0.513, 0.472, 7.743, 0.5894999999999999, 12.3225, 1.022, 0.5754999999999999, 1.258, 0.5355000000000001, 0.5495000000000001, 0.8605, 0.605, 0.593, 9.9955, 0.461, 0.4795, 7.9879999999999995, 0.6305000000000001, 0.7665, 0.563, 0.5645, 0.5645, 1.2745, 0.5609999999999999, 0.482, 0.481, 0.6745000000000001, 2.1125, 0.538, 1.0339999999999998, 0.8160000000000001, 5.275, 0.509, 0.3505, 0.5125, 0.693, 0.62, 0.5645, 0.4905, 0.542, 0.5635, 0.45899999999999996, 0.4495, 0.618, 0.471, 1.024, 0.723, 0.518, 1.028, 0.624, 0.6245, 0.5, 1.068, 1.8465, 29.9725, 0.541, 0.9205000000000001, 0.6194999999999999, 0.6485000000000001, 0.295, 0.46799999999999997, 0.5595, 2.815, 0.665, 1.2149999999999999, 0.7655000000000001, 1.6764999999999999, 0.6984999999999999, 0.5994999999999999, 0.5734999999999999, 0.437, 0.8, 0.601, 1.5525, 0.4665, 0.5375000000000001, 0.392, 0.4705, 0.478, 0.549, 0.5535000000000001, 0.8705, 0.5765, 2.903, 0.6105, 0.64, 0.6335, 0.7765, 0.596, 0.504, 1.877, 0.5285, 0.5205, 1.347, 0.0, 0.571, 0.501, 3.0925000000000002, 3.1665, 2.746, 0.569, 0.6635, 0.6114999999999999},
+		{0.6094999999999999, 0.5389999999999999, 1.104, 0.3265, 2.551, 0.45199999999999996, 0.8935, 0.772, 0.433, 0.7044999999999999, 0.41000000000000003, 1.2360000000000002, 0.565, 0.5075000000000001, 0.405, 0.4375, 0.337, 0.276, 0.791, 0.8089999999999999, 0.34450000000000003, 0.315, 0.79, 2.3105, 0.2645, 0.6065, 0.277, 1.7705, 1.182, 0.513, 0.492, 0.4935, 2.221, 0.419, 0.995, 0.5635, 0.8745, 0.357, 0.6735, 0.706, 0.5105, 0.4915, 0.8785000000000001, 8.3935, 0.4965, 5.2215, 0.8075, 0.754, 0.67, 2.7065, 0.7685, 5.6735, 13.5115, 3.808, 0.6890000000000001, 0.017, 0.451, 0.849, 0.5585, 0.5215000000000001, 0.3585, 0.5315000000000001, 3.3994999999999997, 0.498, 0.532, 2.3310000000000004, 3.141, 2.6820000000000004, 0.4195, 0.771, 0.5205, 1.0035, 0.598, 0.59, 0.334, 0.6495, 2.534, 0.4885, 14.149000000000001, 3.5140000000000002, 0.9855, 2.076, 0.95, 2.3565, 0.7555000000000001, 0.7464999999999999, 0.631, 2.7634999999999996, 0.4265, 1.4740000000000002, 0.649, 1.5165, 0.4405, 0.563, 8.372, 0.4235, 0.704, 2.729, 3.2485, 1.4165, 0.535, 2.9505, 0.951, 0.5055000000000001, 0.5265, 7.8225, 0.451, 1.8719999999999999, 2.7445, 2.8765, 0.7765, 0.3105, 0.748, 0.382, 1.2545, 0.43, 0.265, 0.581, 2.722, 3.1425, 0.485, 3.17, 0.727, 5.287000000000001, 0.5665, 0.662, 0.46799999999999997, 0.4155, 3.692, 0.528, 3.5405, 0.5395000000000001, 0.9055, 1.14, 0.335, 1.008, 0.3405, 1.02, 0.6365000000000001, 0.3205, 0.858, 0.297, 0.351, 0.304, 0.344, 1.808, 26.4585, 0.395, 1.458, 0.388, 2.2744999999999997, 0.7195, 0.278, 0.2975, 2.7409999999999997, 0.28500000000000003, 0.8029999999999999, 0.365, 2.2765, 0.9504999999999999, 0.5605, 3.8305, 1.4465, 0.4785, 0.34199999999999997, 3.1189999999999998, 0.4805, 0.5025, 2.768, 3.2335, 0.3795, 0.486, 3.255, 0.8945000000000001, 3.5084999999999997, 2.5389999999999997, 3.4320000000000004, 2.6094999999999997, 3.2439999999999998, 0.288, 0.28200000000000003, 3.211, 2.432, 0.5055000000000001, 0.5215000000000001, 1.5430000000000001, 0.0, 0.4235, 0.27, 0.8009999999999999, 3.5185, 5.532, 0.666, 2.269, 0.436},
+		{0.4115, 0.2285, 1.156, 0.376, 0.4905, 0.4, 1.0375, 0.7095, 0.36, 0.545, 0.1145, 0.9644999999999999, 0.23249999999999998, 0.278, 0.335, 0.165, 0.2995, 0.378, 0.6795, 0.6005, 0.3735, 0.3115, 0.76, 0.46699999999999997, 0.3065, 0.344, 0.412, 0.3855, 1.125, 0.28049999999999997, 0.247, 0.477, 1.883, 0.3805, 0.634, 0.529, 0.492, 0.3705, 0.359, 0.5075000000000001, 0.26, 0.1645, 0.491, 6.059, 0.2105, 4.1495, 0.6655, 0.5065, 0.517, 0.6145, 0.623, 4.0045, 14.497499999999999, 2.5484999999999998, 0.617, 0.4885, 0.008, 0.6245, 0.3455, 0.4985, 0.329, 0.3, 1.9329999999999998, 0.16649999999999998, 0.296, 0.2465, 0.4615, 0.3415, 0.481, 0.7424999999999999, 0.221, 0.506, 0.4855, 0.3585, 0.297, 0.4885, 0.1565, 0.1995, 11.339500000000001, 0.2975, 0.8300000000000001, 0.2465, 0.9105000000000001, 0.1955, 0.6779999999999999, 0.5529999999999999, 0.653, 0.105, 0.495, 1.581, 0.6385000000000001, 0.5805, 0.2465, 0.2965, 8.3595, 0.4685, 0.16899999999999998, 0.6915, 0.625, 1.099, 0.2275, 0.245, 0.7775000000000001, 0.287, 0.20950000000000002, 6.948, 0.2655, 0.207, 0.3095, 0.4365, 1.034, 0.3715, 0.453, 0.381, 1.194, 0.357, 0.3385, 0.473, 0.504, 1.748, 0.2465, 0.8220000000000001, 0.6924999999999999, 4.697, 0.26, 0.571, 0.1175, 0.482, 0.4765, 0.3085, 0.1495, 0.178, 0.4405, 0.5865, 0.315, 0.4015, 0.35, 0.9555, 0.5645, 0.40149999999999997, 0.86, 0.3365, 0.3265, 0.401, 0.4275, 1.437, 29.561500000000002, 0.2985, 0.62, 1.009, 0.479, 0.607, 0.368, 0.3575, 2.4325, 0.41200000000000003, 0.7835000000000001, 0.433, 0.317, 0.372, 0.647, 0.346, 0.3305, 0.5225, 0.327, 0.356, 0.204, 0.23099999999999998, 3.9170000000000003, 0.1255, 0.354, 0.129, 0.346, 0.7264999999999999, 0.3455, 0.659, 0.524, 0.569, 0.39849999999999997, 0.3435, 0.3675, 0.1725, 1.899, 0.172, 0.2575, 1.3265, 0.164, 0.406, 0.3335, 0.658, 3.3095, 6.055, 0.606, 0.5894999999999999, 0.231},
+		{0.83, 0.746, 1.1235, 0.717, 0.4, 0.6535, 1.0394999999999999, 0.749, 0.7015, 0.66, 0.613, 0.964, 0.6765, 0.655, 0.7575000000000001, 0.64, 0.688, 0.6335, 0.5665, 0.7545, 0.7264999999999999, 0.706, 0.7415, 0.7505, 0.6154999999999999, 0.6655, 0.585, 0.641, 0.9535, 0.58, 0.6225, 0.8394999999999999, 2.134, 0.673, 0.9835, 0.4775, 0.7475, 0.658, 0.6695, 0.6995, 0.6125, 0.636, 0.66, 3.665, 0.6194999999999999, 3.817, 0.519, 0.9564999999999999, 0.653, 0.8685, 0.69, 4.4350000000000005, 14.9125, 2.58, 0.34450000000000003, 0.849, 0.6575, 0.014499999999999999, 0.534, 0.7144999999999999, 0.5834999999999999, 0.5485, 1.85, 0.632, 0.5525, 0.677, 0.4895, 0.6285000000000001, 0.596, 0.802, 0.6955, 0.789, 0.7275, 0.611, 0.586, 0.532, 0.639, 0.591, 11.5125, 0.7455, 0.7715000000000001, 0.5774999999999999, 0.948, 0.654, 0.5295000000000001, 0.3825, 0.5665, 0.594, 0.642, 1.7675, 0.979, 0.5665, 0.5685, 0.612, 9.649000000000001, 0.7124999999999999, 1.051, 3.3890000000000002, 0.788, 1.1095, 0.597, 0.685, 0.952, 0.618, 0.6245, 6.01, 0.6335, 0.6495, 0.8234999999999999, 0.701, 0.76, 0.7004999999999999, 0.571, 0.6355, 2.059, 0.692, 0.596, 0.4145, 0.715, 1.3645, 0.666, 0.8155, 0.9185000000000001, 5.459, 0.5665, 0.5015000000000001, 0.546, 0.75, 0.665, 0.6305000000000001, 0.561, 0.5814999999999999, 0.802, 0.6234999999999999, 0.692, 0.7695000000000001, 0.595, 0.866, 0.759, 0.6835, 1.0755, 0.6615, 0.5865, 1.303, 0.6194999999999999, 1.4825, 24.5045, 0.6675, 0.9305, 0.6825, 0.7635000000000001, 0.4405, 0.736, 0.656, 2.7845, 0.6825, 0.835, 0.69, 0.642, 0.7735000000000001, 0.505, 0.607, 0.55, 0.8694999999999999, 0.715, 1.7155, 0.6145, 0.5994999999999999, 2.3225, 0.573, 0.832, 0.556, 0.698, 1.067, 0.6725000000000001, 0.8325, 0.747, 0.717, 0.708, 0.5925, 0.663, 0.661, 2.084, 0.6275, 0.5865, 1.338, 0.0, 0.637, 0.642, 1.3039999999999998, 3.6005000000000003, 3.8535, 0.658, 0.8005, 0.5714999999999999},
+		{0.408, 0.3585, 0.9575, 0.429, 0.321, 0.40700000000000003, 0.841, 0.6375, 0.4855, 0.31, 0.2875, 0.714, 0.3165, 0.4635, 0.425, 0.2795, 0.403, 0.473, 0.474, 0.41800000000000004, 0.4485, 0.3985, 0.603, 0.543, 0.358, 0.197, 0.4, 0.4625, 0.764, 0.152, 0.266, 0.445, 1.8835, 0.427, 0.978, 0.527, 0.5475000000000001, 0.4905, 0.377, 0.4975, 0.2405, 0.336, 0.3175, 4.9695, 0.2755, 3.556, 0.5905, 0.54, 0.4575, 0.6054999999999999, 0.5425, 4.638, 16.5085, 1.805, 0.504, 0.575, 0.3255, 0.586, 0.007, 0.5425, 0.4925, 0.1755, 1.5554999999999999, 0.3265, 0.248, 0.3685, 0.5345, 0.223, 0.4505, 0.534, 0.2915, 0.55, 0.5405, 0.2545, 0.4315, 0.4115, 0.2775, 0.2565, 13.0245, 0.381, 0.679, 0.2925, 0.7355, 0.267, 0.6775, 0.46799999999999997, 0.432, 0.2985, 0.4685, 1.8014999999999999, 0.776, 0.5395000000000001, 0.2835, 0.2565, 10.835, 0.5994999999999999, 0.34199999999999997, 1.3330000000000002, 0.6985, 0.487, 0.306, 0.329, 0.7685, 0.2735, 0.34450000000000003, 6.871, 0.27849999999999997, 0.278, 0.3475, 0.448, 0.855, 0.4075, 0.34299999999999997, 0.4535, 1.2385000000000002, 0.477, 0.4195, 0.3715, 0.5865, 1.9705, 0.354, 0.5885, 0.6765000000000001, 4.7515, 0.3295, 0.429, 0.2575, 0.4995, 0.5645, 0.324, 0.31, 0.3125, 0.555, 0.652, 0.388, 0.4525, 0.443, 1.0234999999999999, 0.5425, 0.46199999999999997, 0.9225000000000001, 0.391, 0.3725, 0.478, 0.5175000000000001, 1.5434999999999999, 30.3385, 0.3615, 0.703, 0.5145, 0.5285, 0.5435000000000001, 0.403, 0.4485, 2.4345, 0.493, 0.6885, 0.51, 1.7295, 0.435, 0.599, 0.40549999999999997, 0.2395, 0.581, 0.40800000000000003, 1.1755, 0.328, 0.305, 0.645, 0.301, 0.403, 0.323, 0.4185, 0.603, 0.47250000000000003, 0.602, 0.573, 0.56, 0.409, 0.41900000000000004, 0.431, 0.3125, 1.9015, 0.34099999999999997, 0.3105, 1.1284999999999998, 0.0, 0.4065, 0.3955, 0.765, 3.181, 4.327500000000001, 0.6094999999999999, 0.5725, 0.313},
+		{0.593, 0.4725, 1.039, 0.32, 0.5725, 0.422, 0.9724999999999999, 0.7095, 0.3705, 0.5774999999999999, 0.40249999999999997, 0.7685, 0.443, 0.4775, 0.3285, 0.3845, 0.382, 0.3465, 0.742, 0.5805, 0.412, 0.2965, 0.6945, 0.486, 0.2955, 0.5145, 0.3525, 0.372, 0.859, 0.4355, 0.4505, 0.6375, 2.0709999999999997, 0.415, 0.6395, 0.478, 0.4655, 0.3945, 0.5445, 0.6154999999999999, 0.4875, 0.435, 0.587, 6.971, 0.4195, 3.5265, 0.742, 0.582, 0.631, 0.549, 0.447, 4.1025, 13.7775, 4.151, 0.574, 0.451, 0.441, 0.8105, 0.5615, 0.018, 0.3275, 0.496, 2.3055000000000003, 0.383, 0.515, 0.5065, 0.448, 0.4625, 0.418, 0.519, 0.518, 0.657, 0.6365000000000001, 0.5095000000000001, 0.355, 0.6265000000000001, 0.419, 0.448, 13.3565, 0.521, 0.927, 0.4605, 0.8305, 0.3735, 0.5965, 0.6105, 0.706, 0.4415, 0.428, 1.4515, 0.677, 0.6275, 0.4475, 0.45, 7.801, 0.4785, 0.398, 0.745, 0.579, 0.8095000000000001, 0.46599999999999997, 0.4735, 0.8474999999999999, 0.4825, 0.40549999999999997, 7.856, 0.461, 0.4735, 0.5285, 0.352, 0.771, 0.377, 0.607, 0.40549999999999997, 1.0230000000000001, 0.3765, 0.3245, 0.583, 0.4465, 1.331, 0.5555000000000001, 0.999, 0.605, 4.888, 0.443, 0.542, 0.4215, 0.4935, 0.524, 0.5195000000000001, 0.454, 0.4245, 0.4675, 0.5825, 0.3385, 0.4495, 0.3615, 0.759, 0.486, 0.3845, 0.8714999999999999, 0.377, 0.3165, 0.3575, 0.40549999999999997, 1.341, 29.71, 0.3345, 0.894, 0.5549999999999999, 0.5055000000000001, 0.6, 0.3385, 0.3585, 2.7755, 0.39, 0.6705, 0.397, 0.301, 0.605, 0.5595, 0.328, 0.5305, 0.5025, 0.3065, 1.4745, 0.4685, 0.513, 0.77, 0.4045, 0.367, 0.411, 0.3535, 0.8165, 0.40449999999999997, 0.6805000000000001, 0.5654999999999999, 0.4905, 0.393, 0.315, 0.3395, 0.405, 2.097, 0.4415, 0.422, 1.4075, 0.0, 0.3185, 0.34450000000000003, 0.727, 2.882, 6.545999999999999, 0.5355000000000001, 0.498, 0.4475},
+		{0.5545, 0.4255, 0.927, 0.218, 0.4555, 0.166, 1.0835, 0.592, 0.28400000000000003, 0.445, 0.3115, 1.111, 0.41000000000000003, 0.4825, 0.2845, 0.326, 0.172, 0.2235, 0.581, 0.57, 0.2915, 0.202, 0.712, 0.376, 0.184, 0.4395, 0.2285, 0.2785, 0.7164999999999999, 0.405, 0.358, 0.543, 1.9689999999999999, 0.28, 0.8055, 0.5025, 0.3935, 0.28049999999999997, 0.53, 0.575, 0.347, 0.4155, 0.5365, 6.642, 0.347, 3.8205, 0.5665, 0.5415000000000001, 0.6325000000000001, 0.517, 0.1995, 3.6325000000000003, 13.787500000000001, 3.3105, 0.909, 0.3315, 0.3185, 0.6845, 0.4235, 0.335, 0.009, 0.4115, 2.471, 0.313, 0.419, 0.395, 0.4585, 0.322, 0.317, 0.5665, 0.358, 0.499, 0.6295, 0.4495, 0.207, 0.499, 0.323, 0.3315, 14.5595, 0.3665, 0.774, 0.351, 0.7464999999999999, 0.355, 0.5335000000000001, 0.5045, 0.5874999999999999, 0.277, 0.356, 1.5765, 0.7065, 0.635, 0.35, 0.4315, 7.2565, 0.3, 0.375, 3.2175000000000002, 0.5125, 0.651, 0.404, 0.365, 0.932, 0.414, 0.333, 6.444, 0.392, 0.3325, 0.3955, 0.2245, 0.7255, 0.241, 0.517, 0.265, 0.8365, 0.2275, 0.202, 0.498, 0.3775, 0.9199999999999999, 0.4155, 0.99, 1.5234999999999999, 5.1545000000000005, 0.3545, 0.573, 0.392, 1.197, 0.452, 0.4165, 0.304, 0.337, 0.404, 0.527, 0.2085, 0.3585, 0.2835, 0.7695000000000001, 0.359, 0.256, 0.7965, 0.2065, 0.177, 0.244, 0.3115, 1.5415, 29.066499999999998, 0.154, 0.572, 0.286, 0.3835, 0.5275000000000001, 0.237, 0.3265, 2.8609999999999998, 0.3, 0.615, 0.2265, 0.20550000000000002, 0.505, 0.458, 0.2215, 0.466, 0.376, 0.1845, 0.218, 0.33899999999999997, 0.3935, 0.6945, 0.3165, 0.246, 0.326, 0.2155, 0.7055, 0.2255, 0.532, 0.601, 0.4255, 0.2705, 0.203, 0.23349999999999999, 0.344, 1.8904999999999998, 0.3005, 0.3845, 1.32, 0.0, 0.1775, 0.20600000000000002, 0.698, 3.247, 5.5835, 0.6205, 0.44, 0.367},
+		{0.41200000000000003, 0.3635, 0.7375, 0.387, 0.362, 0.3985, 0.8425, 0.727, 0.4825, 0.299, 0.296, 0.5754999999999999, 0.3135, 0.363, 0.4115, 0.263, 0.3905, 0.449, 0.509, 0.3435, 0.4435, 0.416, 0.721, 0.4685, 0.37749999999999995, 0.1855, 0.4275, 0.449, 0.8614999999999999, 0.098, 0.264, 0.4355, 1.9615, 0.4495, 0.6535, 0.541, 0.564, 0.427, 0.397, 0.6114999999999999, 0.2165, 0.305, 0.355, 4.159, 0.23399999999999999, 4.4415, 0.5425, 0.5654999999999999, 0.4165, 0.5894999999999999, 0.4085, 4.326, 17.515500000000003, 4.0975, 0.4385, 0.5665, 0.3055, 0.5985, 0.182, 0.5275000000000001, 0.353, 0.016, 1.8075, 0.2475, 0.186, 0.339, 0.462, 0.1905, 0.4845, 0.5625, 0.292, 0.426, 0.5375000000000001, 0.219, 0.3835, 0.35, 0.271, 0.2745, 11.8205, 0.312, 0.703, 0.2485, 0.73, 0.2455, 0.67, 0.419, 0.3725, 0.27249999999999996, 0.46799999999999997, 1.613, 0.587, 0.4485, 0.278, 0.20450000000000002, 7.4285, 0.5515000000000001, 0.312, 0.7695000000000001, 0.653, 0.714, 0.2845, 0.2975, 0.7735000000000001, 0.2185, 0.3395, 7.9045000000000005, 0.2995, 0.231, 0.436, 0.4905, 0.7975000000000001, 0.364, 0.2985, 0.42, 1.4885000000000002, 0.4225, 0.3595, 0.392, 0.5255000000000001, 0.6755, 0.328, 0.6655, 0.7010000000000001, 4.6905, 0.277, 0.4635, 0.297, 0.471, 0.578, 0.33, 0.2425, 0.28049999999999997, 0.476, 0.6134999999999999, 0.4025, 0.5105, 0.433, 0.7064999999999999, 0.6154999999999999, 0.45199999999999996, 0.814, 0.4345, 0.4345, 0.45, 0.493, 1.3625, 27.392, 0.356, 0.742, 0.534, 0.5640000000000001, 0.5555000000000001, 0.365, 0.417, 2.5625, 0.494, 0.7685, 0.543, 0.5765, 0.4895, 1.8809999999999998, 0.3665, 0.196, 0.5985, 0.3725, 1.094, 0.27, 0.287, 0.6815, 0.269, 0.454, 0.309, 0.3445, 0.595, 0.4105, 0.6225, 0.585, 0.582, 0.4425, 0.398, 0.5155000000000001, 0.289, 2.154, 0.311, 0.211, 1.461, 0.0, 0.4675, 0.40449999999999997, 0.7424999999999999, 2.598, 6.087, 0.61, 0.5135000000000001, 0.303},
+		{2.553, 3.5325, 2.074, 1.43, 0.9530000000000001, 0.9215, 2.404, 1.6895, 1.1604999999999999, 2.758, 1.536, 2.426, 2.4725, 1.3045, 0.7444999999999999, 2.495, 1.1255000000000002, 0.9215, 1.7855, 1.8515, 1.1955, 1.1155, 1.7605, 1.2705, 2.0205, 2.3259999999999996, 1.381, 1.1135, 2.2190000000000003, 0.6525000000000001, 1.7570000000000001, 1.1985000000000001, 2.799, 0.8305, 2.9635, 1.049, 1.311, 1.26, 0.788, 1.576, 0.9864999999999999, 0.916, 2.593, 7.638, 1.532, 5.258, 2.0225, 1.2175, 0.843, 2.2485, 1.348, 5.3465, 17.247500000000002, 4.4544999999999995, 2.327, 3.6545, 0.6515, 2.4835, 2.085, 1.516, 1.367, 1.3755000000000002, 0.010499999999999999, 1.051, 1.7425000000000002, 1.742, 0.7745, 1.593, 1.553, 1.7645, 2.667, 3.193, 1.495, 1.1760000000000002, 0.8025, 1.6225, 1.153, 0.72, 13.349499999999999, 1.108, 1.9434999999999998, 0.679, 1.8215, 1.1275, 2.003, 2.7415, 2.143, 0.7475, 1.9275000000000002, 2.541, 2.0145, 1.385, 1.8835000000000002, 1.682, 9.1655, 1.2195, 1.459, 7.115, 1.9165, 2.6515000000000004, 1.31, 2.4505, 1.2189999999999999, 1.6975, 1.036, 8.822, 0.841, 2.205, 3.9055, 1.792, 2.934, 1.1389999999999998, 2.3955, 1.623, 2.2435, 1.3605, 0.9155, 2.199, 1.6745, 4.781000000000001, 1.1325, 2.3695, 2.973, 6.5045, 1.7069999999999999, 1.756, 1.0670000000000002, 1.409, 2.276, 1.9260000000000002, 1.327, 0.8514999999999999, 1.6545, 1.965, 1.444, 1.024, 1.671, 1.3215, 1.276, 0.9055, 2.0725, 1.401, 0.7145, 1.6044999999999998, 1.3245, 3.4435000000000002, 27.3425, 0.6085, 1.8035, 1.7890000000000001, 1.2269999999999999, 2.1855, 1.1025, 1.0105, 3.2175000000000002, 1.067, 1.492, 1.1195, 2.8295000000000003, 2.0625, 1.137, 1.0185, 1.2035, 2.845, 1.6515, 3.3935, 1.2315, 1.677, 7.292, 1.6205, 1.71, 0.774, 1.0045, 3.661, 0.8395, 2.9314999999999998, 1.225, 1.024, 1.7955, 1.2565, 1.9665, 0.8345, 2.9139999999999997, 1.0030000000000001, 1.3965, 2.4305, 0.254, 3.589, 1.7635, 2.355, 3.9575, 5.746, 1.444, 1.37, 0.8009999999999999},
+		{0.3785, 0.2255, 0.7545, 0.332, 0.4055, 0.418, 0.8725, 0.576, 0.377, 0.3045, 0.213, 0.9195, 0.2225, 0.4095, 0.3805, 0.181, 0.3515, 0.3545, 0.5914999999999999, 0.3955, 0.391, 0.355, 0.7064999999999999, 0.45, 0.3705, 0.331, 0.3475, 0.4085, 1.346, 0.2705, 0.268, 0.347, 1.8135, 0.4255, 0.582, 0.46299999999999997, 0.492, 0.4295, 0.34, 0.4405, 0.227, 0.1985, 0.336, 7.4915, 0.1835, 3.6790000000000003, 0.59, 0.648, 0.4445, 0.59, 0.3705, 3.634, 16.1445, 1.7245, 0.531, 0.5175000000000001, 0.20350000000000001, 0.5845, 0.3195, 0.4595, 0.3605, 0.23, 1.3975, 0.011, 0.2615, 0.2865, 0.509, 0.2665, 0.3765, 0.513, 0.242, 0.398, 0.4635, 0.3235, 0.3555, 0.414, 0.173, 0.2015, 12.653, 0.1945, 0.683, 0.157, 0.696, 0.207, 0.617, 0.5335000000000001, 0.4685, 0.1835, 0.402, 1.492, 0.739, 0.5025, 0.2145, 0.2265, 6.356999999999999, 0.491, 0.23, 0.6295, 0.627, 0.693, 0.1725, 0.20400000000000001, 0.8345, 0.23, 0.2, 7.2669999999999995, 0.21050000000000002, 0.207, 0.25, 0.4075, 0.852, 0.361, 0.40249999999999997, 0.3895, 0.92, 0.3795, 0.3295, 0.414, 0.4385, 1.1995, 0.304, 0.778, 0.664, 5.4425, 0.187, 0.521, 0.1915, 0.454, 0.547, 0.4085, 0.207, 0.182, 0.422, 0.6465000000000001, 0.2995, 0.4635, 0.3415, 0.775, 0.5355000000000001, 0.401, 1.0755, 0.358, 0.3785, 0.369, 0.399, 1.6235, 27.549500000000002, 0.346, 0.77, 0.429, 0.494, 0.504, 0.3675, 0.367, 2.917, 0.46399999999999997, 0.7675000000000001, 0.401, 0.34750000000000003, 0.5225, 0.5994999999999999, 0.3135, 0.2925, 0.486, 0.334, 1.2160000000000002, 0.1725, 0.1975, 0.74, 0.1785, 0.3995, 0.159, 0.382, 0.531, 0.358, 0.6645000000000001, 0.5615, 0.5065, 0.393, 0.326, 0.401, 0.20350000000000001, 1.9540000000000002, 0.2155, 0.1795, 1.2999999999999998, 0.347, 0.356, 0.3635, 0.9655, 2.6464999999999996, 4.7255, 0.589, 0.579, 0.186},
+		{0.4255, 0.316, 1.065, 0.379, 0.3515, 0.394, 0.8185, 0.5974999999999999, 0.4595, 0.34299999999999997, 0.262, 0.7415, 0.3275, 0.3785, 0.421, 0.281, 0.4075, 0.403, 0.4935, 0.509, 0.445, 0.4225, 0.86, 0.479, 0.448, 0.17149999999999999, 0.4135, 0.456, 0.71, 0.1665, 0.228, 0.4305, 2.1435, 0.48, 1.028, 0.5585, 0.536, 0.5155000000000001, 0.437, 0.527, 0.2485, 0.3065, 0.2545, 4.232, 0.20800000000000002, 3.8475, 0.515, 0.6265000000000001, 0.455, 0.73, 0.3525, 4.2684999999999995, 16.098, 2.3495, 0.4265, 0.538, 0.329, 0.532, 0.2395, 0.5345, 0.345, 0.20400000000000001, 2.1745, 0.29, 0.009, 0.3345, 0.5275000000000001, 0.2025, 0.477, 0.6859999999999999, 0.308, 0.491, 0.5335000000000001, 0.242, 0.3925, 0.4485, 0.2655, 0.2695, 12.737, 0.3325, 0.6034999999999999, 0.2485, 0.742, 0.307, 0.5674999999999999, 0.4215, 0.388, 0.261, 0.5125, 1.6985000000000001, 0.741, 0.43, 0.20550000000000002, 0.2115, 9.186, 0.5105, 0.3515, 0.675, 0.637, 0.492, 0.3035, 0.3475, 0.751, 0.3045, 0.3435, 7.2065, 0.284, 0.24, 0.401, 0.421, 0.914, 0.39749999999999996, 0.319, 0.45799999999999996, 1.059, 0.43, 0.401, 0.328, 0.527, 1.056, 0.35, 0.5445, 0.6964999999999999, 4.932499999999999, 0.2265, 0.47550000000000003, 0.2645, 0.463, 0.5475000000000001, 0.3055, 0.272, 0.297, 0.502, 0.643, 0.439, 0.49, 0.419, 0.92, 0.5535000000000001, 0.447, 0.798, 0.4125, 0.4445, 0.462, 0.462, 1.6225, 28.185499999999998, 0.3955, 0.8180000000000001, 0.4835, 0.5585, 0.461, 0.473, 0.34099999999999997, 2.5145, 0.5125, 0.72, 0.3955, 0.3665, 0.48150000000000004, 0.557, 0.4345, 0.208, 0.623, 0.383, 1.258, 0.2495, 0.291, 0.663, 0.2915, 0.427, 0.275, 0.4095, 0.6445000000000001, 0.4305, 0.685, 1.0979999999999999, 0.555, 0.45899999999999996, 0.4195, 0.4385, 0.32, 2.049, 0.325, 0.24, 1.3010000000000002, 0.0, 0.3605, 0.4115, 0.9584999999999999, 2.9535, 7.4695, 0.567, 0.688, 0.258},
+		{0.5485, 0.3175, 0.94, 0.3725, 0.4435, 0.3745, 0.9795, 0.6885, 0.4165, 0.40349999999999997, 0.238, 0.593, 0.35450000000000004, 0.4005, 0.41, 0.2665, 0.40900000000000003, 0.428, 0.674, 0.5105, 0.464, 0.438, 0.8425, 0.5225, 0.4175, 0.3175, 0.3735, 0.435, 0.884, 0.348, 0.372, 0.477, 2.028, 0.4585, 1.083, 0.5235000000000001, 0.5025, 0.4395, 0.4465, 0.4915, 0.3075, 0.2465, 0.4265, 7.968999999999999, 0.3015, 4.374499999999999, 0.624, 0.627, 0.565, 0.56, 0.4165, 4.292, 15.3735, 2.468, 0.473, 0.4825, 0.2555, 0.6785000000000001, 0.391, 0.485, 0.399, 0.363, 1.8025, 0.2905, 0.368, 0.016, 0.5345, 0.2675, 0.46950000000000003, 0.549, 0.301, 0.445, 0.531, 0.3865, 0.3515, 0.5365, 0.317, 0.3155, 13.2975, 0.3325, 0.7435, 0.293, 0.757, 0.2545, 0.6944999999999999, 0.6285000000000001, 0.565, 0.2515, 0.5045, 1.5310000000000001, 0.741, 0.5994999999999999, 0.336, 0.3325, 8.152, 0.4835, 0.296, 0.743, 0.5934999999999999, 0.655, 0.2865, 0.334, 0.851, 0.338, 0.2595, 6.4895, 0.3485, 0.3335, 0.312, 0.3885, 0.9924999999999999, 0.4185, 0.534, 0.448, 1.1795, 0.482, 0.4205, 0.481, 0.4665, 2.468, 0.4175, 0.75, 0.8405, 4.9830000000000005, 0.2815, 0.5680000000000001, 0.273, 0.5095000000000001, 0.531, 0.508, 0.249, 0.293, 0.5155000000000001, 0.6735, 0.365, 0.48, 0.44, 0.769, 0.486, 0.4235, 0.785, 0.393, 0.3595, 0.4825, 0.471, 1.8495, 27.308500000000002, 0.3965, 0.6295, 0.4445, 0.512, 0.527, 0.425, 0.4195, 2.5374999999999996, 0.4805, 0.6805, 0.4935, 0.447, 0.3235, 0.5894999999999999, 0.391, 0.3555, 0.558, 0.395, 1.6075, 0.3005, 0.338, 0.9945, 0.2625, 0.449, 0.266, 0.4065, 0.6305000000000001, 0.433, 0.6505000000000001, 0.5694999999999999, 0.5405, 0.4245, 0.3925, 0.4305, 0.29, 1.9369999999999998, 0.2375, 0.3275, 1.0379999999999998, 0.3455, 0.3455, 0.363, 0.8975, 3.521, 2.7675, 0.624, 0.5825, 0.3115},
+		{0.7344999999999999, 0.6025, 0.999, 0.469, 0.362, 0.43, 0.8885000000000001, 0.7384999999999999, 0.486, 0.649, 0.495, 0.876, 0.5665, 0.626, 0.5225, 0.479, 0.38, 0.3985, 0.4255, 0.639, 0.5045, 0.435, 0.626, 0.5565, 0.4, 0.5814999999999999, 0.4885, 0.451, 0.7905, 0.46699999999999997, 0.618, 0.605, 2.112, 0.449, 0.769, 0.247, 0.548, 0.5085, 0.6415, 0.657, 0.5515, 0.551, 0.7424999999999999, 3.7, 0.542, 4.144, 0.4225, 0.6615, 0.626, 0.6045, 0.4625, 3.677, 15.8665, 2.1790000000000003, 0.503, 0.5055000000000001, 0.5425, 0.5385, 0.5665, 0.5654999999999999, 0.434, 0.4705, 2.1205, 0.518, 0.525, 0.512, 0.025, 0.5205, 0.494, 0.724, 0.5325, 0.729, 0.6944999999999999, 0.577, 0.3805, 0.3575, 0.4535, 0.5945, 10.5155, 0.626, 0.9325000000000001, 0.5115000000000001, 0.8145, 0.464, 0.5345, 0.3575, 0.618, 0.5305, 0.5195000000000001, 1.782, 0.6355, 0.4375, 0.5145, 0.5635, 5.803000000000001, 0.554, 0.5734999999999999, 0.6990000000000001, 0.6074999999999999, 0.799, 0.5395000000000001, 0.5365, 0.8714999999999999, 0.518, 0.616, 7.3195, 0.537, 0.5255000000000001, 0.542, 0.471, 0.9365, 0.4735, 0.6635, 0.453, 0.971, 0.425, 0.387, 0.3775, 0.52, 1.9685, 0.663, 0.787, 0.8115, 5.32, 0.4975, 0.3665, 0.4885, 0.4725, 0.563, 0.6715, 0.535, 0.56, 0.5255000000000001, 0.5125, 0.42300000000000004, 0.568, 0.433, 0.8445, 0.6174999999999999, 0.461, 0.6984999999999999, 0.4295, 0.40549999999999997, 0.4745, 0.5145, 1.091, 26.16, 0.42, 0.971, 0.4225, 0.5894999999999999, 0.3315, 0.4505, 0.487, 2.7554999999999996, 0.4635, 0.7769999999999999, 0.5055000000000001, 0.924, 0.6535, 0.224, 0.3955, 0.4575, 0.646, 0.4655, 0.4355, 0.4455, 0.533, 0.3925, 0.4285, 0.4175, 0.5245, 0.47250000000000003, 0.8185, 0.4635, 0.7375, 0.6045, 0.5894999999999999, 0.4585, 0.4015, 0.475, 0.4975, 1.853, 0.5115000000000001, 0.5994999999999999, 1.334, 0.0, 0.3915, 0.40249999999999997, 0.7175, 3.034, 6.173, 0.378, 0.591, 0.502},
+		{0.4285, 0.292, 0.9595, 0.4075, 0.38, 0.4265, 0.7044999999999999, 0.8220000000000001, 0.4495, 0.246, 0.2235, 0.52, 0.335, 0.2905, 0.37, 0.259, 0.334, 0.3725, 0.494, 0.4215, 0.4585, 0.336, 0.6885, 0.482, 0.34099999999999997, 0.188, 0.3875, 0.396, 0.8274999999999999, 0.166, 0.2525, 0.479, 2.045, 0.46299999999999997, 0.852, 0.4875, 0.519, 0.448, 0.356, 0.4625, 0.223, 0.2535, 0.388, 5.655, 0.159, 3.808, 0.54, 0.584, 0.411, 0.615, 0.348, 4.5965, 15.437999999999999, 3.596, 0.45, 0.4745, 0.2735, 0.6234999999999999, 0.211, 0.495, 0.3415, 0.197, 1.5505, 0.2355, 0.216, 0.293, 0.547, 0.0095, 0.398, 0.509, 0.3035, 0.379, 0.5345, 0.271, 0.324, 0.413, 0.2785, 0.3105, 13.003499999999999, 0.2895, 0.6595, 0.2645, 0.6819999999999999, 0.2575, 0.6785000000000001, 0.516, 0.4555, 0.2185, 0.441, 1.609, 0.708, 0.43, 0.2405, 0.2375, 8.776499999999999, 0.4935, 0.2565, 0.72, 0.6134999999999999, 0.7665, 0.3095, 0.2975, 0.7775000000000001, 0.2765, 0.276, 6.500500000000001, 0.181, 0.1775, 0.3575, 0.47050000000000003, 0.863, 0.418, 0.3365, 0.428, 1.0835, 0.42300000000000004, 0.3375, 0.404, 0.5035000000000001, 1.6644999999999999, 0.327, 0.7915000000000001, 0.6225, 4.8665, 0.273, 0.404, 0.202, 0.5335000000000001, 0.5085, 0.3155, 0.242, 0.22849999999999998, 0.5085, 0.5905, 0.3835, 0.4595, 0.3755, 0.793, 0.5105, 0.366, 0.8645, 0.3965, 0.378, 0.3515, 0.4475, 1.7645, 29.4735, 0.373, 0.905, 0.456, 0.5075000000000001, 0.4355, 0.4065, 0.339, 2.42, 0.438, 0.6545000000000001, 0.4665, 0.4055, 0.4655, 0.622, 0.3635, 0.23349999999999999, 0.506, 0.387, 1.391, 0.2385, 0.289, 0.9675, 0.298, 0.4355, 0.27, 0.3685, 0.628, 0.4005, 0.633, 0.547, 0.513, 0.392, 0.3575, 0.4155, 0.298, 1.8915, 0.2675, 0.27949999999999997, 1.5455, 0.0, 0.34650000000000003, 0.40549999999999997, 0.621, 3.3265000000000002, 4.351, 0.6085, 0.54, 0.2065},
+		{0.528, 0.473, 0.8945000000000001, 0.33, 0.522, 0.33, 1.0125, 0.611, 0.344, 0.588, 0.3375, 0.671, 0.476, 0.4395, 0.3135, 0.3625, 0.3035, 0.3015, 0.606, 0.5765, 0.355, 0.2755, 0.75, 0.4125, 0.2995, 0.4645, 0.3415, 0.3555, 1.0645, 0.4385, 0.4195, 0.508, 1.8925, 0.374, 0.61, 0.4655, 0.6165, 0.365, 0.583, 0.6375, 0.429, 0.4305, 0.5405, 7.067500000000001, 0.424, 3.7255000000000003, 0.5934999999999999, 0.524, 0.705, 0.6605000000000001, 0.29, 3.9295, 13.914, 2.3049999999999997, 0.6515, 0.383, 0.4675, 0.562, 0.448, 0.423, 0.3325, 0.503, 1.3424999999999998, 0.4205, 0.47350000000000003, 0.4495, 0.464, 0.4125, 0.012, 0.4475, 0.3945, 0.5705, 0.5985, 0.502, 0.344, 0.511, 0.466, 0.46, 13.9305, 0.497, 0.9735, 0.4445, 0.839, 0.418, 0.649, 0.631, 0.539, 0.3955, 0.386, 1.93, 0.6859999999999999, 0.6375, 0.452, 0.4845, 13.921, 0.39749999999999996, 0.419, 0.6205, 0.513, 0.6839999999999999, 0.4375, 0.444, 0.878, 0.4385, 0.4875, 6.698, 0.377, 0.437, 0.511, 0.299, 0.9575, 0.3025, 0.633, 0.3845, 1.2825, 0.315, 0.296, 0.4625, 0.42, 1.194, 0.4655, 0.944, 0.6325000000000001, 5.221, 0.4275, 0.5595000000000001, 0.3805, 0.378, 0.458, 0.5655, 0.4, 0.4045, 0.4025, 0.6094999999999999, 0.307, 0.3715, 0.2855, 0.7275, 0.4395, 0.3185, 0.6910000000000001, 0.275, 0.2845, 0.2915, 0.3685, 1.698, 26.9635, 0.265, 0.7324999999999999, 0.381, 0.4345, 0.559, 0.28049999999999997, 0.326, 2.8725, 0.349, 0.6924999999999999, 0.373, 0.341, 0.6125, 0.561, 0.314, 0.482, 0.403, 0.307, 1.3399999999999999, 0.4345, 0.4465, 0.7404999999999999, 0.489, 0.307, 0.3765, 0.3395, 0.6325000000000001, 0.303, 0.6725000000000001, 0.5275000000000001, 0.4655, 0.34450000000000003, 0.3125, 0.2975, 0.391, 1.8824999999999998, 0.403, 0.4175, 1.3784999999999998, 0.0, 0.3395, 0.2965, 0.8194999999999999, 3.2355, 3.232, 0.5655, 0.511, 0.456},
+		{0.867, 0.8135, 1.274, 0.484, 0.922, 0.5740000000000001, 1.314, 0.86, 0.5625, 1.182, 0.6375, 1.1724999999999999, 0.594, 0.7825, 0.4465, 0.688, 0.421, 0.505, 0.925, 0.9299999999999999, 0.5445, 0.5065, 1.1920000000000002, 0.9875, 0.46399999999999997, 0.704, 0.4975, 0.696, 1.9969999999999999, 0.5885, 0.806, 0.9195, 2.176, 0.543, 0.9045000000000001, 0.7925, 0.6034999999999999, 0.4535, 0.6995, 0.942, 0.7324999999999999, 0.787, 0.9715, 5.3125, 0.7395, 3.7855, 1.0495, 0.8554999999999999, 1.008, 0.78, 0.565, 4.372, 15.393, 2.5875, 0.9544999999999999, 0.8155, 0.6705000000000001, 0.9745, 0.636, 0.75, 0.5255000000000001, 0.8275, 2.2925, 0.645, 0.597, 0.6114999999999999, 0.681, 0.6859999999999999, 0.6755, 0.006, 0.5734999999999999, 1.3265, 0.782, 0.847, 0.591, 0.9505, 0.7565, 0.63, 12.439499999999999, 0.692, 1.1865, 0.6675, 1.025, 0.5735, 0.825, 0.953, 0.831, 0.64, 0.594, 2.0525, 1.037, 0.963, 0.6705, 0.657, 6.405, 0.6485000000000001, 1.201, 2.0595, 0.835, 1.123, 0.601, 0.6915, 1.182, 0.753, 0.591, 6.4015, 0.5589999999999999, 0.6785, 1.0910000000000002, 0.423, 1.463, 0.443, 0.904, 0.5655, 1.4745, 0.613, 0.543, 0.883, 0.613, 1.3639999999999999, 0.637, 1.338, 1.001, 5.032500000000001, 0.5660000000000001, 1.0114999999999998, 0.519, 0.6905, 0.657, 0.844, 0.604, 0.641, 0.8434999999999999, 0.9279999999999999, 0.485, 0.9365, 0.451, 1.117, 0.713, 0.59, 0.9265000000000001, 0.5075000000000001, 0.31, 0.5794999999999999, 0.609, 1.4675, 28.9405, 0.32899999999999996, 0.875, 1.061, 0.575, 0.819, 0.4965, 0.5695, 2.698, 0.567, 0.894, 0.607, 0.5115000000000001, 0.821, 0.797, 0.433, 0.6965, 0.8625, 0.4665, 0.495, 0.6134999999999999, 0.633, 2.3555, 0.7815000000000001, 0.4025, 0.56, 0.47850000000000004, 0.978, 0.485, 0.858, 0.7865, 0.6625000000000001, 0.522, 0.3675, 0.5195000000000001, 0.61, 1.9425, 0.5525, 0.6114999999999999, 1.6219999999999999, 0.0, 0.6, 0.516, 1.3725, 3.2565, 7.4705, 0.8765000000000001, 0.8714999999999999, 0.611},
+		{0.4205, 0.339, 0.911, 0.376, 0.519, 0.389, 1.032, 0.911, 0.4745, 0.3035, 0.202, 0.948, 0.1565, 0.342, 0.442, 0.142, 0.327, 0.393, 0.5565, 0.46799999999999997, 0.475, 0.3515, 0.7495, 0.6315, 0.35150000000000003, 0.372, 0.3955, 0.4115, 1.29, 0.243, 0.224, 0.76, 2.0915, 0.413, 0.7629999999999999, 0.544, 0.5215000000000001, 0.4595, 0.2585, 0.9570000000000001, 0.2595, 0.208, 0.383, 4.5945, 0.1945, 3.8970000000000002, 0.705, 0.606, 0.452, 0.6205, 0.646, 4.3095, 15.718, 1.7245, 0.571, 0.514, 0.2325, 0.687, 0.2805, 0.537, 0.33, 0.273, 1.907, 0.2195, 0.338, 0.31, 0.545, 0.3005, 0.367, 0.4655, 0.0155, 0.39549999999999996, 0.5505, 0.393, 0.299, 0.529, 0.217, 0.1625, 12.7085, 0.303, 0.7344999999999999, 0.2745, 0.83, 0.255, 1.06, 0.514, 0.514, 0.1795, 0.56, 1.662, 0.6855, 0.623, 0.235, 0.345, 8.157, 0.481, 0.2195, 0.698, 0.6034999999999999, 0.5805, 0.236, 0.23299999999999998, 0.7655000000000001, 0.2675, 0.252, 6.593999999999999, 0.278, 0.228, 0.886, 0.418, 0.8654999999999999, 0.428, 0.462, 0.368, 1.0625, 0.3355, 0.408, 0.525, 0.4825, 1.257, 0.3115, 1.026, 0.893, 4.8855, 0.2525, 0.5005, 0.192, 0.4405, 0.47, 0.299, 0.202, 0.188, 0.5145, 0.588, 0.434, 0.49, 0.3655, 0.907, 0.4445, 0.3235, 0.8155, 0.4315, 0.3855, 0.4465, 0.4885, 1.504, 27.917499999999997, 0.4165, 0.9085000000000001, 0.494, 0.582, 0.531, 0.41100000000000003, 0.3645, 2.5065, 0.441, 0.7224999999999999, 0.395, 1.7115, 0.40700000000000003, 0.6465000000000001, 0.439, 0.414, 0.4795, 0.38, 1.4625, 0.258, 0.1835, 0.789, 0.1915, 0.4315, 0.2165, 0.3845, 0.969, 0.4535, 0.5425, 0.984, 0.517, 0.453, 0.3955, 0.487, 0.2515, 2.1995, 0.27, 0.3115, 1.3170000000000002, 0.005, 0.3795, 0.4265, 0.6395, 3.277, 5.0285, 0.558, 0.62, 0.2525},
+		{0.6555, 1.5510000000000002, 1.7365, 0.711, 0.635, 0.759, 1.6015000000000001, 0.97, 0.657, 1.331, 0.46699999999999997, 2.216, 0.4845, 0.7085, 0.6234999999999999, 0.5025, 0.511, 0.5785, 0.6174999999999999, 1.0230000000000001, 0.7995, 0.5735, 1.0074999999999998, 0.6435, 0.6005, 0.8015000000000001, 0.5914999999999999, 0.7245, 2.4074999999999998, 0.628, 0.538, 0.8605, 2.0759999999999996, 0.659, 1.677, 0.7665, 0.6365000000000001, 0.595, 0.7845, 0.6865, 0.8555, 0.597, 1.262, 5.6645, 0.442, 4.6635, 0.7164999999999999, 1.2770000000000001, 0.6805000000000001, 0.8315, 0.5755, 5.0455, 14.091000000000001, 2.54, 0.766, 0.7535000000000001, 0.746, 0.8645, 1.126, 0.752, 0.964, 0.46399999999999997, 3.2865, 0.5875, 0.671, 0.5974999999999999, 0.75, 0.5635, 0.8385, 0.986, 0.387, 0.054, 0.9485, 0.6295, 0.663, 0.776, 0.5595, 0.4665, 11.0515, 0.685, 1.071, 0.447, 1.039, 0.549, 0.866, 0.661, 0.605, 0.6415, 0.7145, 2.2175000000000002, 1.0755, 0.6955, 0.6705, 0.5025, 10.972000000000001, 0.76, 0.8775, 5.689, 0.806, 1.3645, 0.657, 0.6485000000000001, 1.0155, 0.486, 0.9339999999999999, 7.393000000000001, 0.399, 0.5125, 2.1769999999999996, 0.8160000000000001, 1.3475000000000001, 0.478, 1.4415, 0.579, 1.8645, 0.676, 0.5135000000000001, 0.5135000000000001, 1.0154999999999998, 2.5755, 0.5405, 1.2525, 1.4245, 5.6, 0.5529999999999999, 0.8245, 0.6535, 0.629, 0.6839999999999999, 1.174, 0.4265, 1.0779999999999998, 0.8835, 1.2745, 0.502, 1.278, 0.5760000000000001, 1.062, 0.7865, 0.9515, 1.4580000000000002, 0.7645, 0.5529999999999999, 0.7565, 0.8815, 2.515, 26.789, 0.6605000000000001, 1.337, 0.744, 0.7444999999999999, 0.8414999999999999, 0.6105, 0.583, 2.8979999999999997, 0.7015, 1.1595, 0.8265, 1.5735000000000001, 1.062, 0.932, 0.5565, 0.5875, 0.804, 0.4985, 1.8455, 0.49250000000000005, 0.4125, 1.6749999999999998, 0.5965, 0.7284999999999999, 0.5075000000000001, 0.6635, 1.3784999999999998, 0.5569999999999999, 1.129, 0.9555, 0.8180000000000001, 1.063, 0.497, 1.2365, 0.44299999999999995, 2.2060000000000004, 0.4065, 0.8765000000000001, 1.6995, 0.0, 0.673, 0.7195, 1.3205, 3.2195, 7.004, 0.8180000000000001, 0.7344999999999999, 0.5365},
+		{0.5714999999999999, 0.5035000000000001, 1.0795, 0.5075000000000001, 0.599, 0.556, 1.2025000000000001, 0.7015, 0.55, 0.481, 0.4305, 1.1345, 0.5085, 0.47650000000000003, 0.535, 0.432, 0.505, 0.5660000000000001, 0.681, 0.5705, 0.5934999999999999, 0.5625, 0.784, 0.644, 0.581, 0.502, 0.5774999999999999, 0.594, 1.0655000000000001, 0.4765, 0.431, 0.572, 2.2065, 0.577, 1.131, 0.666, 0.653, 0.6014999999999999, 0.5335000000000001, 0.668, 0.417, 0.472, 0.526, 4.2995, 0.428, 4.0184999999999995, 0.7084999999999999, 0.6964999999999999, 0.609, 0.731, 0.627, 4.3420000000000005, 13.1145, 2.482, 0.642, 0.643, 0.4565, 0.7244999999999999, 0.48, 0.6165, 0.5694999999999999, 0.476, 2.225, 0.3915, 0.4865, 0.4985, 0.664, 0.486, 0.5569999999999999, 0.633, 0.486, 0.583, 0.004, 0.5095000000000001, 0.5535000000000001, 0.579, 0.473, 0.427, 11.358, 0.445, 0.8135, 0.458, 0.773, 0.437, 0.827, 0.661, 0.615, 0.4515, 0.6045, 1.5474999999999999, 0.7625, 0.696, 0.439, 0.4685, 8.1395, 0.629, 0.4925, 0.7284999999999999, 0.666, 1.0659999999999998, 0.439, 0.4595, 0.841, 0.456, 0.4925, 6.311999999999999, 0.4065, 0.4455, 0.596, 0.623, 0.9664999999999999, 0.551, 0.5774999999999999, 0.556, 1.1275, 0.5805, 0.524, 0.534, 0.6154999999999999, 0.99, 0.483, 0.9159999999999999, 0.787, 4.702500000000001, 0.452, 0.681, 0.4775, 0.687, 0.682, 0.6054999999999999, 0.485, 0.4755, 0.669, 0.7184999999999999, 0.4945, 0.649, 0.513, 0.8225, 0.6194999999999999, 0.5665, 0.94, 0.5275000000000001, 0.5175000000000001, 0.582, 0.563, 1.6575, 28.652, 0.53, 0.9315, 0.5625, 0.6265000000000001, 0.6859999999999999, 0.5385, 0.519, 2.6950000000000003, 0.5725, 0.892, 0.573, 0.5365, 0.644, 0.7324999999999999, 0.519, 0.5, 0.66, 0.553, 1.3435, 0.418, 0.463, 1.3375, 0.5085, 0.5665, 0.4345, 0.5315000000000001, 1.4455, 0.586, 0.842, 0.744, 0.6944999999999999, 0.6, 0.5415000000000001, 0.538, 0.4565, 1.8355000000000001, 0.4575, 0.478, 1.2945, 0.0, 0.534, 0.4935, 1.041, 3.1959999999999997, 6.4719999999999995, 0.799, 0.7084999999999999, 0.43},
+		{0.454, 0.381, 1.096, 0.4285, 0.439, 0.42, 0.905, 0.6575, 0.491, 0.3605, 0.306, 0.5155000000000001, 0.365, 0.4215, 0.4555, 0.3455, 0.4875, 0.4435, 0.5375000000000001, 0.3375, 0.4215, 0.421, 0.6225, 0.546, 0.4275, 0.2305, 0.4825, 0.4605, 0.8345, 0.215, 0.3145, 0.485, 1.807, 0.47450000000000003, 0.9005000000000001, 0.569, 0.559, 0.5275000000000001, 0.414, 0.504, 0.3035, 0.375, 0.341, 3.9455, 0.2955, 3.919, 0.585, 0.653, 0.4855, 0.5925, 0.5045, 4.827500000000001, 14.0135, 2.5095, 0.49, 0.5655, 0.3775, 0.596, 0.2725, 0.5595, 0.423, 0.249, 2.3975, 0.3245, 0.24, 0.4025, 0.535, 0.2605, 0.501, 0.587, 0.405, 0.6695, 0.54, 0.016, 0.462, 0.3895, 0.3535, 0.347, 11.82, 0.367, 0.629, 0.312, 0.772, 0.339, 0.6924999999999999, 0.4985, 0.424, 0.3065, 0.502, 1.6655, 0.6735, 0.505, 0.307, 0.312, 7.852, 0.489, 0.5854999999999999, 0.711, 0.685, 1.002, 0.3445, 0.3835, 0.779, 0.312, 0.362, 6.611000000000001, 0.314, 0.2875, 0.4615, 0.487, 0.913, 0.435, 0.425, 0.5345, 2.0709999999999997, 0.48, 0.4245, 0.5345, 0.5325, 1.7309999999999999, 0.3935, 0.944, 0.8415, 5.165, 0.3225, 0.5515000000000001, 0.348, 0.5295000000000001, 0.5845, 0.3845, 0.358, 0.3175, 0.577, 0.6715, 0.473, 0.549, 0.4155, 0.898, 0.545, 0.4865, 0.737, 0.467, 0.388, 0.5225, 0.556, 1.5150000000000001, 26.3435, 0.462, 0.8465, 0.7044999999999999, 0.543, 0.479, 0.44, 0.4545, 2.641, 0.5155000000000001, 0.739, 0.5185, 0.475, 0.591, 0.608, 0.422, 0.256, 0.617, 0.4115, 1.2654999999999998, 0.363, 0.3855, 0.635, 0.36, 0.5305, 0.341, 0.507, 0.7055, 0.4845, 0.6645000000000001, 0.643, 0.569, 0.531, 0.414, 0.45, 0.3465, 1.951, 0.3485, 0.3135, 1.3595000000000002, 0.0, 0.3625, 0.457, 0.8594999999999999, 2.8025, 3.041, 0.6890000000000001, 0.6315, 0.3615},
+		{0.483, 0.432, 0.8685, 0.2015, 0.481, 0.1855, 1.0725, 0.6435, 0.291, 0.4275, 0.303, 0.867, 0.4715, 0.3985, 0.1595, 0.35, 0.2015, 0.218, 0.622, 0.503, 0.299, 0.228, 0.5075000000000001, 0.3625, 0.174, 0.3925, 0.2215, 0.2985, 0.879, 0.33, 0.3745, 0.491, 2.0774999999999997, 0.246, 0.604, 0.347, 0.392, 0.31, 0.4355, 0.5365, 0.3245, 0.313, 0.5025, 7.359500000000001, 0.341, 3.8964999999999996, 0.5165, 0.4425, 0.5385, 0.441, 0.1885, 4.175000000000001, 14.8015, 1.736, 0.4955, 0.329, 0.305, 0.5615, 0.41100000000000003, 0.3575, 0.199, 0.385, 1.939, 0.348, 0.388, 0.3685, 0.387, 0.3895, 0.2875, 0.351, 0.34299999999999997, 0.524, 0.6355, 0.47, 0.005, 0.505, 0.2825, 0.3395, 13.237, 0.3735, 0.8109999999999999, 0.397, 0.738, 0.321, 0.451, 0.5645, 0.664, 0.264, 0.375, 1.7605, 0.6094999999999999, 0.638, 0.387, 0.3505, 10.1095, 0.3565, 1.455, 0.6665000000000001, 0.478, 0.7464999999999999, 0.3345, 0.4, 0.8145, 0.3345, 0.3685, 7.457, 0.369, 0.338, 0.485, 0.233, 0.8425, 0.2115, 0.441, 0.2635, 0.912, 0.191, 0.20450000000000002, 0.535, 0.3305, 1.9635, 0.345, 0.7515000000000001, 0.5445, 4.5760000000000005, 0.3135, 0.54, 0.3255, 0.312, 0.379, 0.40900000000000003, 0.28500000000000003, 0.301, 0.368, 0.473, 0.215, 0.221, 0.1665, 0.83, 0.3925, 0.2535, 0.819, 0.2135, 0.16, 0.238, 0.3255, 1.6245, 24.576, 0.2085, 0.6605000000000001, 0.2625, 0.421, 0.4575, 0.221, 0.2495, 2.465, 0.293, 0.5665, 0.2465, 2.9314999999999998, 0.4395, 0.489, 0.2055, 0.41800000000000004, 0.396, 0.2165, 1.2414999999999998, 0.33299999999999996, 0.363, 0.5845, 0.2525, 0.214, 0.248, 0.179, 0.6775, 0.2175, 0.442, 0.461, 0.4195, 0.2245, 0.1855, 0.276, 0.3165, 1.9275, 0.3695, 0.3575, 1.0885, 0.0, 0.172, 0.17, 0.681, 2.987, 6.0655, 0.4815, 0.406, 0.3375},
+		{0.7015, 0.5315000000000001, 1.0195, 0.5415000000000001, 0.2295, 0.538, 0.911, 0.817, 0.715, 0.714, 0.4655, 0.815, 0.5025, 0.586, 0.5475000000000001, 0.524, 0.6174999999999999, 0.597, 0.53, 0.519, 0.558, 0.543, 0.754, 0.6685000000000001, 0.6335, 0.4855, 0.5825, 0.5845, 0.8959999999999999, 0.334, 0.4475, 0.675, 1.9264999999999999, 0.6065, 0.7575000000000001, 0.417, 0.6785, 0.638, 0.62, 0.6565000000000001, 0.424, 0.46799999999999997, 0.6545000000000001, 2.856, 0.4645, 4.3195, 0.526, 0.759, 0.655, 0.794, 0.587, 3.9669999999999996, 14.305, 2.9665, 0.3745, 0.737, 0.5335000000000001, 0.542, 0.357, 0.6315, 0.504, 0.3775, 2.1414999999999997, 0.4905, 0.4795, 0.4995, 0.416, 0.4395, 0.5265, 0.5925, 0.5974999999999999, 0.7344999999999999, 0.574, 0.437, 0.641, 0.0205, 0.491, 0.3865, 12.2415, 0.5635, 0.739, 0.444, 0.8965000000000001, 0.496, 0.55, 0.2365, 0.303, 0.4765, 0.565, 1.642, 0.8140000000000001, 0.3195, 0.449, 0.533, 8.1205, 0.7004999999999999, 0.7084999999999999, 0.7895000000000001, 0.71, 0.921, 0.4595, 0.501, 0.8254999999999999, 0.469, 0.49, 6.357, 0.485, 0.5165, 0.621, 0.535, 0.9655, 0.592, 0.589, 0.571, 1.1135, 0.5505, 0.4715, 0.2725, 0.6910000000000001, 1.8065, 0.53, 0.9245, 0.8925000000000001, 5.4695, 0.5095000000000001, 0.5385, 0.5085, 0.673, 0.5695, 0.5665, 0.457, 0.433, 0.6815, 0.603, 0.601, 0.6074999999999999, 0.6125, 0.926, 0.6795, 0.537, 0.892, 0.635, 0.5575000000000001, 0.5894999999999999, 0.6805000000000001, 1.6475, 24.8945, 0.578, 0.9119999999999999, 0.5685, 0.6545000000000001, 0.3005, 0.5085, 0.576, 2.714, 0.5714999999999999, 0.8145, 0.563, 0.644, 0.7845, 0.3985, 0.58, 0.40800000000000003, 0.915, 0.566, 1.1995, 0.4845, 0.48350000000000004, 0.475, 0.4325, 0.6114999999999999, 0.4715, 0.5765, 1.2875, 0.565, 0.896, 0.6605000000000001, 0.62, 0.569, 0.5235000000000001, 0.53, 0.461, 1.799, 0.46799999999999997, 0.5135000000000001, 1.4435, 0.0, 0.605, 0.5285, 0.933, 3.189, 4.63, 0.46399999999999997, 0.7095, 0.46299999999999997},
+		{0.479, 0.3055, 0.9365, 0.306, 0.423, 0.34850000000000003, 0.7855000000000001, 0.627, 0.3325, 0.2745, 0.169, 0.738, 0.233, 0.199, 0.3505, 0.27749999999999997, 0.2895, 0.321, 0.482, 0.45, 0.3755, 0.3, 0.5985, 0.4765, 0.2925, 0.354, 0.335, 0.374, 0.8815, 0.2235, 0.2415, 0.519, 1.9344999999999999, 0.3685, 0.831, 0.501, 0.4435, 0.327, 0.3585, 0.4765, 0.264, 0.20350000000000001, 0.459, 5.640000000000001, 0.17, 4.294499999999999, 0.679, 0.562, 0.4965, 0.51, 0.3115, 4.314, 15.14, 1.1404999999999998, 0.538, 0.423, 0.1645, 0.611, 0.3035, 0.4615, 0.252, 0.2995, 2.0, 0.1965, 0.266, 0.274, 0.479, 0.2695, 0.451, 0.6865, 0.2135, 0.45099999999999996, 0.4645, 0.332, 0.276, 0.475, 0.012, 0.227, 12.739, 0.173, 0.86, 0.1725, 0.6335, 0.186, 0.7444999999999999, 0.559, 0.4215, 0.1255, 0.39449999999999996, 1.6625, 0.6595, 0.4855, 0.2015, 0.23299999999999998, 8.504, 0.4585, 0.437, 0.7575000000000001, 0.608, 0.848, 0.2455, 0.2595, 0.798, 0.251, 0.2525, 7.144500000000001, 0.211, 0.3045, 0.5715, 0.417, 0.8815, 0.356, 0.4315, 0.4085, 0.9305, 0.311, 0.341, 0.447, 0.4665, 2.9, 0.246, 0.8674999999999999, 0.7735, 6.045, 0.2815, 0.5365, 0.128, 0.452, 0.392, 0.29000000000000004, 0.1405, 0.2495, 0.5265, 0.6, 0.3365, 0.3575, 0.339, 0.78, 0.4605, 0.3695, 0.819, 0.352, 0.335, 0.3845, 0.4295, 1.924, 26.9545, 0.3055, 0.786, 0.7270000000000001, 0.4745, 0.482, 0.3395, 0.3245, 2.867, 0.434, 0.874, 0.321, 0.328, 0.41900000000000004, 0.6785000000000001, 0.323, 0.3945, 0.47450000000000003, 0.3425, 0.3215, 0.2375, 0.263, 0.7809999999999999, 0.143, 0.3135, 0.08549999999999999, 0.345, 0.618, 0.3415, 0.5285, 0.4855, 0.5395000000000001, 0.3285, 0.417, 0.415, 0.1665, 1.975, 0.191, 0.228, 1.332, 0.339, 0.2955, 0.3425, 0.654, 3.629, 3.21, 0.6365000000000001, 0.5145, 0.157},
+		{0.3685, 0.2565, 1.096, 0.358, 0.484, 0.357, 1.1095000000000002, 0.649, 0.426, 0.2925, 0.20400000000000001, 0.9915, 0.1375, 0.277, 0.377, 0.153, 0.3695, 0.365, 0.542, 0.475, 0.40249999999999997, 0.3245, 0.698, 0.4285, 0.3115, 0.327, 0.352, 0.306, 1.0354999999999999, 0.2205, 0.2285, 0.41400000000000003, 2.0540000000000003, 0.4235, 0.5335000000000001, 0.529, 0.4995, 0.494, 0.28500000000000003, 0.4535, 0.2065, 0.2665, 0.446, 4.5005, 0.1935, 4.1315, 0.604, 0.6045, 0.4935, 0.5974999999999999, 0.36, 4.122, 16.329, 3.8729999999999998, 0.514, 0.527, 0.23399999999999999, 0.632, 0.3065, 0.4345, 0.3525, 0.254, 1.741, 0.2135, 0.3375, 0.2875, 0.51, 0.308, 0.432, 0.486, 0.22749999999999998, 0.424, 0.4995, 0.311, 0.3165, 0.4235, 0.245, 0.01, 13.633, 0.2675, 0.6995, 0.201, 0.7175, 0.173, 0.6815, 0.495, 0.4585, 0.2025, 0.438, 1.4585, 0.6964999999999999, 0.511, 0.2155, 0.275, 10.238499999999998, 0.497, 0.2765, 2.9880000000000004, 0.6295, 0.6315, 0.23399999999999999, 0.2495, 0.8005, 0.19, 0.239, 6.323, 0.254, 0.2205, 0.3045, 0.4415, 0.9704999999999999, 0.3385, 0.425, 0.4185, 1.2890000000000001, 0.3505, 0.34450000000000003, 0.422, 0.516, 1.6315, 0.289, 0.7885, 0.7755000000000001, 4.98, 0.294, 0.48, 0.192, 0.434, 0.442, 0.2615, 0.2385, 0.2255, 0.442, 0.5945, 0.3825, 0.4655, 0.3795, 0.9644999999999999, 0.668, 0.427, 0.991, 0.3835, 0.442, 0.4105, 0.426, 1.2385000000000002, 28.4175, 0.339, 0.826, 0.449, 0.542, 0.501, 0.3605, 0.326, 2.612, 0.4605, 0.66, 0.4515, 0.3685, 0.439, 0.6385000000000001, 0.3525, 0.31, 0.5455000000000001, 0.367, 1.055, 0.2205, 0.184, 0.7295, 0.3165, 0.3975, 0.232, 0.372, 0.56, 0.3395, 0.586, 0.5754999999999999, 0.514, 0.392, 0.3195, 0.3995, 0.242, 1.8045, 0.2455, 0.2415, 1.318, 0.0, 0.394, 0.35150000000000003, 0.7195, 3.3695, 5.698, 0.607, 0.5045, 0.246},
+		{5.2985, 2.685, 2.7515, 2.4059999999999997, 2.338, 2.1485, 3.8815, 3.0195, 2.2649999999999997, 5.1685, 2.8834999999999997, 3.5845000000000002, 2.685, 2.37, 2.016, 2.1029999999999998, 4.2105, 3.0695, 2.795, 2.833, 2.13, 4.777, 2.3765, 2.323, 3.806, 3.8525, 2.42, 3.8689999999999998, 2.989, 2.253, 4.976, 2.4115, 3.6845, 1.978, 4.735, 2.0865, 3.24, 2.3724999999999996, 2.878, 2.508, 2.65, 2.036, 2.848, 6.4285, 3.5789999999999997, 7.1405, 3.7055, 2.9585, 5.4399999999999995, 4.410500000000001, 2.473, 7.8004999999999995, 19.477, 4.6125, 4.48, 4.724, 2.977, 5.0285, 2.3545, 2.3520000000000003, 2.303, 2.548, 5.6765, 4.7535, 2.5140000000000002, 5.5725, 4.2735, 2.583, 2.2035, 2.4619999999999997, 2.4865, 3.6155, 2.933, 2.7885, 2.215, 2.106, 3.5735, 2.4265, 0.01, 2.4240000000000004, 2.153, 2.4924999999999997, 2.3449999999999998, 2.177, 2.8635, 2.5705, 5.745, 2.516, 2.3529999999999998, 2.9215, 4.5329999999999995, 2.3680000000000003, 1.7774999999999999, 3.6959999999999997, 10.3125, 2.7095000000000002, 3.298, 3.9139999999999997, 4.1105, 3.8209999999999997, 3.387, 4.1855, 3.125, 2.1065, 2.4450000000000003, 8.497499999999999, 3.569, 5.3525, 5.972, 2.2305, 6.0265, 2.5365, 5.4885, 5.4385, 2.9699999999999998, 2.388, 1.857, 3.075, 2.323, 5.345000000000001, 2.2875, 3.653, 5.281000000000001, 6.949, 2.6925, 2.722, 2.59, 3.786, 4.355499999999999, 5.423500000000001, 2.213, 2.8689999999999998, 2.0545, 3.338, 5.7, 2.685, 2.304, 3.5665, 3.0865, 2.2015000000000002, 4.882, 3.787, 2.8975, 3.012, 1.736, 4.6935, 29.109, 2.9160000000000004, 3.8115, 4.821999999999999, 2.6605, 3.515, 2.3465, 3.418, 3.8225, 2.44, 2.165, 2.4619999999999997, 3.036, 3.2885, 2.088, 2.1375, 1.9725, 4.3685, 2.2315, 5.5655, 4.734, 2.1755, 13.5745, 2.7535, 2.1215, 2.004, 2.3405, 5.144500000000001, 2.5335, 2.9665, 3.2275, 1.9315, 2.1795, 1.693, 2.0825, 1.8085, 2.9745, 1.767, 2.2104999999999997, 3.9505, 0.2195, 5.1265, 4.911, 2.8289999999999997, 5.9719999999999995, 7.933999999999999, 3.8810000000000002, 2.0695, 2.7195},
+		{0.6924999999999999, 0.264, 1.2825, 0.3865, 0.529, 0.4505, 1.541, 0.819, 0.47050000000000003, 1.02, 0.1895, 1.3535, 0.4645, 0.348, 0.4505, 0.2845, 0.3955, 0.419, 0.577, 0.6725, 0.4485, 0.434, 0.7835000000000001, 0.5994999999999999, 0.402, 0.278, 0.4435, 0.502, 2.1775, 0.3085, 0.319, 0.603, 2.1189999999999998, 0.446, 1.002, 0.607, 0.5974999999999999, 0.466, 0.4115, 0.587, 0.2595, 0.224, 0.341, 6.3345, 0.22899999999999998, 4.4315, 0.713, 0.577, 0.566, 0.6495, 0.7015, 4.2219999999999995, 13.810500000000001, 3.6725000000000003, 0.624, 0.656, 0.403, 0.6995, 0.404, 0.545, 0.414, 0.3215, 2.3659999999999997, 0.21250000000000002, 0.3525, 0.3365, 0.648, 0.32, 0.493, 0.6615, 0.2745, 1.0065, 0.5185, 0.374, 0.368, 0.5235000000000001, 0.26, 0.279, 12.928, 0.006, 0.873, 0.2115, 0.952, 0.2525, 1.238, 0.611, 0.5834999999999999, 0.231, 0.519, 1.7525, 0.7264999999999999, 0.599, 0.239, 0.3495, 10.0625, 0.5754999999999999, 0.3615, 5.329000000000001, 0.662, 1.0535, 0.275, 0.246, 0.8174999999999999, 0.2595, 0.28800000000000003, 7.4745, 0.294, 0.1805, 2.134, 0.5205, 1.198, 0.428, 0.6779999999999999, 0.4645, 1.199, 0.5435, 0.3825, 0.525, 0.6859999999999999, 0.9285, 0.3135, 1.1055000000000001, 1.0554999999999999, 4.8795, 0.243, 0.626, 0.281, 0.5015000000000001, 0.608, 0.345, 0.2905, 0.311, 0.6105, 0.6575, 0.4125, 0.4985, 0.4345, 1.0154999999999998, 0.556, 0.46499999999999997, 0.9844999999999999, 0.4215, 0.391, 0.434, 0.5205, 1.572, 24.316, 0.3835, 0.9025, 1.23, 0.5675, 0.5894999999999999, 0.40900000000000003, 0.3995, 2.6785, 0.444, 1.0705, 0.4405, 0.4405, 0.7464999999999999, 0.802, 0.4435, 0.3265, 0.587, 0.403, 0.428, 0.193, 0.32, 5.657, 0.2405, 0.483, 0.2445, 0.452, 1.85, 0.471, 1.3780000000000001, 0.644, 0.5625, 0.422, 0.431, 0.4575, 0.25, 2.1285, 0.2585, 0.28, 1.8875, 0.043, 0.5415, 0.3715, 1.5735, 3.31, 6.6995000000000005, 0.724, 0.5625, 0.218},
+		{0.7735000000000001, 0.92, 1.2565, 0.7955000000000001, 0.69, 0.771, 1.2795, 1.003, 0.7375, 1.1145, 0.661, 1.524, 0.6485000000000001, 0.8145, 0.6855, 0.604, 0.7065, 0.8385, 0.302, 0.9035, 0.7275, 0.758, 1.0215, 0.8385, 0.7645, 0.644, 0.8069999999999999, 0.7184999999999999, 1.5470000000000002, 0.5185, 0.7015, 0.9485, 2.1319999999999997, 0.791, 1.358, 0.7055, 0.873, 0.8254999999999999, 0.7244999999999999, 0.842, 0.649, 0.702, 0.7084999999999999, 2.9535, 0.6365000000000001, 4.137499999999999, 0.8325, 0.865, 0.46399999999999997, 0.9425, 0.764, 3.763, 13.55, 4.76, 0.8069999999999999, 1.3635, 0.742, 0.8065, 0.596, 0.7875000000000001, 0.837, 0.586, 2.1265, 0.645, 0.5794999999999999, 0.792, 0.7735000000000001, 0.645, 0.8135, 0.86, 0.706, 0.812, 0.7875000000000001, 0.586, 0.68, 0.71, 0.632, 0.722, 12.974499999999999, 0.7985, 0.1585, 0.5905, 1.001, 0.6595, 0.991, 0.734, 0.687, 0.6285000000000001, 0.8565, 2.1245000000000003, 0.9475, 0.7295, 0.6595, 0.6745, 8.703, 0.8405, 0.6775, 0.9564999999999999, 0.906, 1.0314999999999999, 0.6795, 0.633, 0.8565, 0.7315, 0.7224999999999999, 6.493, 0.697, 0.591, 1.377, 0.758, 1.3475000000000001, 0.79, 0.6825000000000001, 0.7264999999999999, 1.3865, 0.709, 0.7615000000000001, 0.6875, 0.774, 1.6560000000000001, 0.781, 1.117, 1.0625, 4.616, 0.747, 0.37, 0.6525000000000001, 0.855, 0.8274999999999999, 0.812, 0.6405000000000001, 0.6475, 0.88, 0.8565, 0.735, 0.849, 0.6765000000000001, 1.2814999999999999, 0.8454999999999999, 0.7805, 1.0525, 0.687, 0.7295, 0.7495, 0.9484999999999999, 2.129, 26.8555, 0.7135, 1.045, 1.2495, 0.739, 0.8955, 0.7675000000000001, 0.7725, 2.8209999999999997, 0.816, 1.0594999999999999, 0.775, 0.8109999999999999, 0.878, 0.9105000000000001, 0.673, 0.6355, 1.0035, 0.6255, 0.813, 0.6355, 0.7144999999999999, 3.0035, 0.614, 0.7455, 0.6445000000000001, 0.7705, 1.0095, 0.7355, 1.0645, 0.871, 0.8625, 0.6884999999999999, 0.7555000000000001, 0.83, 0.704, 1.9975, 0.588, 0.6445000000000001, 1.662, 0.0, 0.872, 0.7315, 1.4535, 2.87, 4.6085, 0.81, 0.919, 0.6345000000000001},
+		{0.415, 0.239, 0.9005000000000001, 0.394, 0.414, 0.372, 0.887, 0.7264999999999999, 0.432, 0.2565, 0.199, 0.55, 0.229, 0.2765, 0.3835, 0.1935, 0.3685, 0.404, 0.551, 0.437, 0.383, 0.359, 0.8095, 0.427, 0.3305, 0.2885, 0.3595, 0.3805, 0.6865, 0.2365, 0.2455, 0.412, 2.084, 0.3845, 1.0695000000000001, 0.545, 0.4805, 0.4665, 0.35, 0.575, 0.1995, 0.20400000000000001, 0.3555, 6.249499999999999, 0.163, 4.5805, 0.6114999999999999, 0.608, 0.504, 0.6034999999999999, 0.33599999999999997, 4.5705, 14.4535, 3.152, 0.414, 0.471, 0.241, 0.551, 0.27949999999999997, 0.486, 0.3245, 0.2435, 1.529, 0.1495, 0.2605, 0.2655, 0.479, 0.305, 0.487, 0.47050000000000003, 0.2555, 0.4425, 0.4755, 0.3115, 0.386, 0.488, 0.172, 0.213, 12.3915, 0.2605, 0.708, 0.0085, 0.6895, 0.2545, 0.631, 0.4445, 0.3955, 0.191, 0.505, 1.7485, 0.6655, 0.4915, 0.182, 0.246, 8.293, 0.453, 0.2285, 0.6265000000000001, 0.59, 0.7424999999999999, 0.213, 0.256, 0.7865, 0.228, 0.2305, 7.5395, 0.228, 0.16, 0.28600000000000003, 0.405, 0.794, 0.3425, 0.38, 0.383, 1.0965, 0.406, 0.2945, 0.4065, 0.444, 0.8999999999999999, 0.273, 0.7955000000000001, 0.8115, 5.5265, 0.2305, 0.5725, 0.18, 0.4965, 0.509, 0.3395, 0.20450000000000002, 0.162, 0.46, 0.622, 0.3285, 0.4825, 0.3715, 0.7244999999999999, 0.55, 0.37, 0.827, 0.3835, 0.3285, 0.3465, 0.4495, 1.498, 26.243000000000002, 0.385, 0.808, 0.378, 0.4955, 0.5355000000000001, 0.3715, 0.328, 2.5575, 0.4085, 0.76, 0.397, 0.6505000000000001, 0.633, 0.6185, 0.366, 0.294, 0.5255000000000001, 0.3765, 1.0905, 0.1645, 0.2285, 0.6234999999999999, 0.292, 0.333, 0.206, 0.39, 0.7044999999999999, 0.3935, 0.5145, 0.5665, 0.524, 0.374, 0.36, 0.388, 0.201, 2.008, 0.2155, 0.174, 1.408, 0.20650000000000002, 0.3225, 0.356, 0.9235, 3.4435000000000002, 4.493, 0.6935, 0.5754999999999999, 0.233},
+		{0.46799999999999997, 0.47250000000000003, 0.9145000000000001, 0.4665, 0.573, 0.5705, 0.9844999999999999, 0.742, 0.4965, 0.578, 0.368, 0.7, 0.296, 0.3845, 0.502, 0.309, 0.441, 0.447, 0.591, 0.564, 0.5235000000000001, 0.5155000000000001, 0.8069999999999999, 0.608, 0.5115000000000001, 0.54, 0.493, 0.5445, 1.1835, 0.3815, 0.433, 0.5175000000000001, 2.0060000000000002, 0.5235000000000001, 0.8805000000000001, 0.6025, 0.623, 0.5435000000000001, 0.444, 0.4275, 0.385, 0.4065, 0.54, 3.992, 0.3905, 4.1485, 0.766, 0.7055, 0.636, 0.6859999999999999, 0.694, 3.8845, 14.9665, 2.8404999999999996, 0.5625, 0.598, 0.3595, 0.781, 0.4575, 0.625, 0.45599999999999996, 0.4295, 2.7984999999999998, 0.40249999999999997, 0.421, 0.4345, 0.595, 0.416, 0.4775, 0.7855000000000001, 0.5055000000000001, 0.718, 0.5845, 0.478, 0.502, 0.5755, 0.408, 0.328, 10.915500000000002, 0.423, 0.9675, 0.396, 0.01, 0.3495, 0.743, 0.6305000000000001, 0.571, 0.322, 0.558, 1.7854999999999999, 0.813, 0.599, 0.382, 0.392, 9.9895, 0.628, 4.0485, 0.8865000000000001, 0.6885, 0.7705, 0.41800000000000004, 0.416, 0.812, 0.3715, 0.43, 6.561, 0.394, 0.374, 0.8835, 0.5345, 0.909, 0.454, 0.607, 0.5185, 1.278, 0.5065, 0.526, 0.5615, 0.567, 1.744, 0.4225, 0.974, 0.8825, 4.2655, 0.422, 0.702, 0.3515, 0.588, 0.7135, 0.495, 0.403, 0.3635, 0.6125, 0.7264999999999999, 0.4135, 0.593, 0.4845, 1.1395, 0.8394999999999999, 0.5195000000000001, 0.9855, 0.492, 0.403, 0.479, 0.6984999999999999, 1.799, 26.9495, 0.4885, 0.8314999999999999, 0.512, 0.6154999999999999, 0.623, 0.478, 0.488, 2.6455, 0.5345, 0.884, 0.504, 6.064500000000001, 0.553, 0.693, 0.505, 0.487, 0.669, 0.565, 1.3185, 0.378, 0.372, 1.5434999999999999, 0.3795, 0.5475000000000001, 0.308, 0.453, 0.8375, 0.5255000000000001, 0.836, 0.7104999999999999, 0.622, 0.545, 0.517, 0.491, 0.3325, 2.1574999999999998, 0.3525, 0.373, 1.5110000000000001, 0.0, 0.501, 0.602, 1.0325, 3.57, 2.6625, 0.7104999999999999, 0.615, 0.3365},
+		{0.302, 0.245, 0.8345, 0.3765, 0.4025, 0.392, 0.8374999999999999, 0.6515, 0.3965, 0.243, 0.1565, 0.552, 0.1935, 0.3775, 0.361, 0.1225, 0.34199999999999997, 0.371, 0.5005, 0.42, 0.40049999999999997, 0.3355, 0.6545000000000001, 0.4805, 0.3775, 0.319, 0.335, 0.42, 1.0055, 0.28049999999999997, 0.2355, 0.322, 1.9420000000000002, 0.3945, 1.1055, 0.496, 0.484, 0.4265, 0.323, 0.4625, 0.16949999999999998, 0.2015, 0.3495, 4.3625, 0.219, 4.0005, 0.613, 0.5315000000000001, 0.4485, 0.5545, 0.4945, 3.973, 15.660499999999999, 2.4720000000000004, 0.5285, 0.527, 0.2225, 0.5165, 0.283, 0.4565, 0.3395, 0.1965, 1.988, 0.189, 0.265, 0.26, 0.4645, 0.225, 0.44, 0.6255, 0.228, 0.4555, 0.4795, 0.34750000000000003, 0.331, 0.4085, 0.1775, 0.1835, 10.935500000000001, 0.2315, 0.704, 0.231, 0.7575000000000001, 0.0175, 0.9445, 0.4335, 0.4795, 0.1695, 0.4595, 1.4615, 0.5785, 0.5655, 0.2285, 0.253, 7.061999999999999, 0.4495, 0.221, 3.216, 0.5894999999999999, 0.5135000000000001, 0.22, 0.28, 0.7464999999999999, 0.253, 0.2155, 8.2675, 0.23349999999999999, 0.2275, 0.4745, 0.382, 1.0295, 0.376, 0.4185, 0.379, 0.9445, 0.3125, 0.32, 0.41400000000000003, 0.468, 2.4325, 0.241, 0.7135, 0.624, 5.5015, 0.2615, 0.48250000000000004, 0.173, 0.4815, 0.462, 0.27, 0.1755, 0.1875, 0.4655, 0.4675, 0.3095, 0.433, 0.353, 0.972, 0.5589999999999999, 0.4135, 0.721, 0.3525, 0.275, 0.3635, 0.4375, 1.404, 27.1145, 0.36, 0.769, 0.4135, 0.4955, 0.5015000000000001, 0.357, 0.367, 2.8165, 0.395, 0.7695000000000001, 0.3755, 1.1345, 0.4475, 0.5245, 0.335, 0.2885, 0.491, 0.33899999999999997, 1.3399999999999999, 0.1885, 0.161, 0.5994999999999999, 0.17149999999999999, 0.353, 0.1915, 0.3625, 0.706, 0.3405, 0.598, 0.5765, 0.503, 0.3565, 0.2985, 0.3875, 0.18, 2.02, 0.213, 0.2775, 1.198, 0.287, 0.333, 0.303, 0.76, 3.2984999999999998, 5.028499999999999, 0.5705, 0.5555000000000001, 0.202},
+		{0.7545, 0.778, 1.2665, 0.596, 0.4395, 1.1444999999999999, 1.8685, 0.8254999999999999, 0.547, 0.9545, 0.65, 1.6855000000000002, 0.6, 0.6445000000000001, 0.541, 1.0170000000000001, 0.5615, 0.5225, 1.853, 0.905, 0.673, 0.5415000000000001, 1.391, 0.7985, 0.517, 1.3775, 0.944, 0.5545, 1.6875, 0.799, 0.6905, 1.0510000000000002, 2.127, 0.916, 1.5495, 0.383, 0.7845, 1.6185, 0.858, 0.7955000000000001, 0.6105, 0.6915, 0.847, 5.182, 0.58, 3.795, 0.6265000000000001, 0.772, 0.6904999999999999, 0.676, 0.516, 4.498, 15.6345, 2.6225, 1.3235000000000001, 0.768, 0.8280000000000001, 0.743, 0.6234999999999999, 0.623, 0.5495000000000001, 0.667, 2.4349999999999996, 0.7215, 0.624, 0.6775, 0.4185, 0.8105, 0.5794999999999999, 0.747, 1.4655, 1.0145, 0.713, 0.68, 0.4785, 0.485, 0.833, 0.5894999999999999, 11.9145, 0.8855, 1.1669999999999998, 0.5854999999999999, 1.0074999999999998, 0.7075, 0.0095, 0.4425, 0.605, 0.54, 0.547, 1.9375, 0.878, 0.6875, 0.6825000000000001, 0.711, 6.4555, 0.656, 0.8254999999999999, 5.5405, 0.619, 1.8065, 0.647, 0.624, 0.961, 0.883, 0.602, 6.6095, 0.924, 0.679, 2.52, 0.5225, 1.1099999999999999, 0.4975, 1.1625, 0.495, 1.795, 0.5395000000000001, 0.569, 0.6715, 0.613, 2.045, 0.637, 1.233, 1.1680000000000001, 4.5675, 0.7084999999999999, 0.863, 0.59, 0.6325000000000001, 0.7905, 0.6970000000000001, 0.6575, 0.8085, 0.578, 0.596, 0.4995, 1.7730000000000001, 0.4355, 0.9255, 0.931, 0.567, 1.0105, 0.5515000000000001, 0.4655, 1.654, 0.9275, 1.8325, 29.822499999999998, 0.616, 1.0885, 1.0514999999999999, 0.641, 0.5125, 0.5525, 0.551, 3.1485000000000003, 1.3165, 1.032, 0.522, 0.5325, 1.4385, 0.6955, 0.5694999999999999, 0.7949999999999999, 0.631, 0.7545, 1.5485, 0.583, 0.559, 1.9669999999999999, 0.5525, 1.093, 0.5125, 0.5489999999999999, 1.313, 0.546, 0.921, 0.7905, 0.621, 0.518, 0.512, 0.603, 1.053, 2.0175, 0.6775, 0.664, 1.38, 0.0, 0.4925, 0.4985, 0.8105, 3.246, 6.849, 0.46799999999999997, 1.0, 1.143},
+		{0.6965, 0.7505, 1.3570000000000002, 0.671, 0.366, 0.5775, 15.090499999999999, 12.301, 0.717, 4.7844999999999995, 0.628, 3.9764999999999997, 0.652, 0.9395, 0.6735, 0.5495, 0.823, 0.6715, 3.941, 0.8065, 3.1265, 4.288, 0.963, 1.0685, 3.991, 0.5605, 0.612, 0.841, 6.2055, 0.471, 0.562, 0.6915, 2.5265, 0.8534999999999999, 1.418, 0.476, 2.7874999999999996, 0.64, 0.6505000000000001, 0.8205, 4.080500000000001, 0.545, 0.7390000000000001, 4.8365, 0.512, 4.1705000000000005, 0.526, 0.9005000000000001, 0.6950000000000001, 1.5765, 0.649, 4.6725, 15.208, 5.692, 10.1805, 4.241, 1.076, 0.5535000000000001, 0.4365, 0.704, 0.6205, 2.4625, 2.8135, 0.663, 2.492, 0.605, 1.432, 0.6185, 0.593, 1.0265, 0.4805, 6.2620000000000005, 3.2495000000000003, 0.922, 0.547, 0.32, 0.6435, 0.5589999999999999, 11.282, 26.5045, 1.709, 0.51, 1.0755, 0.6174999999999999, 0.5105, 0.013, 0.5525, 4.016, 1.6644999999999999, 3.4924999999999997, 2.0945, 0.696, 0.8035, 0.59, 9.1055, 0.6185, 5.414, 1.6105, 0.7095, 1.4515, 0.6285000000000001, 0.622, 1.1604999999999999, 0.6174999999999999, 0.622, 8.9315, 0.538, 0.676, 7.374499999999999, 0.713, 2.7190000000000003, 0.5985, 0.9249999999999999, 0.723, 29.6795, 1.2205, 0.613, 4.2445, 0.6645000000000001, 2.3985000000000003, 0.556, 1.0625, 1.3014999999999999, 6.9755, 6.0969999999999995, 0.3845, 0.5595000000000001, 3.907, 0.513, 5.212, 0.586, 0.7505, 0.787, 0.5485, 0.755, 1.029, 0.5625, 1.3255, 0.9115, 0.711, 1.1640000000000001, 0.633, 0.6435, 1.725, 0.754, 6.144, 24.512999999999998, 0.6365000000000001, 1.181, 0.761, 0.7855000000000001, 0.357, 0.736, 0.795, 2.9345, 0.7025, 1.79, 0.7104999999999999, 0.719, 4.105, 0.443, 0.6034999999999999, 0.5035000000000001, 0.787, 0.6635, 2.317, 0.6105, 0.5485, 3.5275, 0.62, 0.5475000000000001, 0.4965, 3.6580000000000004, 1.393, 0.6545000000000001, 1.202, 0.789, 0.726, 0.74, 0.7885, 3.8890000000000002, 0.6595, 2.2675, 0.6525000000000001, 1.6560000000000001, 1.6840000000000002, 0.0, 0.583, 0.646, 4.069, 10.528500000000001, 7.734, 0.642, 1.4435, 0.599},
+		{3.2885, 0.537, 1.0150000000000001, 0.593, 0.375, 0.5385, 1.077, 0.6855, 0.5894999999999999, 0.542, 0.459, 0.7665, 0.467, 0.57, 0.588, 0.4525, 0.573, 0.5874999999999999, 0.6395, 0.5774999999999999, 0.584, 0.5545, 0.9175, 0.6134999999999999, 1.75, 0.4245, 0.5065, 0.6174999999999999, 0.9095, 0.4565, 0.4365, 0.6225, 2.077, 0.592, 1.045, 0.6295, 5.015499999999999, 0.5654999999999999, 5.0015, 0.611, 0.456, 0.4765, 0.478, 3.1630000000000003, 0.4425, 4.048, 0.5765, 0.7525, 0.6525000000000001, 0.7404999999999999, 0.657, 4.335, 14.852, 2.1625, 0.512, 0.6445000000000001, 0.5635, 0.576, 0.46699999999999997, 0.6325000000000001, 0.5575000000000001, 0.381, 1.8605, 0.3955, 0.3645, 0.4685, 0.6904999999999999, 0.48350000000000004, 0.5235000000000001, 0.7455, 0.4265, 0.7004999999999999, 0.6425000000000001, 0.4125, 0.6105, 0.3365, 0.4955, 0.537, 14.515, 0.4225, 0.8374999999999999, 0.457, 0.8494999999999999, 0.459, 0.658, 0.4595, 0.005, 3.268, 0.5754999999999999, 1.5910000000000002, 0.879, 0.331, 0.4395, 0.4655, 7.561, 0.6719999999999999, 0.5575, 0.8205, 0.6935, 0.626, 0.471, 0.4915, 0.834, 0.441, 0.528, 6.5440000000000005, 0.426, 0.488, 0.599, 0.5794999999999999, 1.1560000000000001, 0.526, 0.5640000000000001, 0.5635, 1.073, 0.595, 0.5974999999999999, 0.426, 0.698, 1.0939999999999999, 0.518, 0.7275, 0.866, 5.493, 0.463, 0.516, 0.5175000000000001, 0.5745, 0.6935, 0.562, 0.3835, 0.4485, 0.676, 0.65, 0.5295000000000001, 0.6845, 0.673, 0.9339999999999999, 0.7144999999999999, 0.524, 1.0299999999999998, 0.5725, 0.6105, 0.54, 0.6045, 1.7810000000000001, 23.7345, 0.539, 0.8025, 0.619, 10.803, 1.8175, 0.539, 5.0440000000000005, 5.732, 0.5874999999999999, 0.771, 0.617, 5.105, 0.622, 0.607, 0.495, 0.426, 0.6904999999999999, 0.576, 1.2349999999999999, 0.4235, 0.4605, 0.3905, 0.4635, 0.5475000000000001, 0.481, 0.582, 0.8009999999999999, 0.591, 0.767, 0.6935, 0.67, 0.5385, 0.586, 0.55, 1.7915, 2.032, 0.3555, 0.4575, 1.1265, 0.0, 0.5625, 0.6625000000000001, 0.9624999999999999, 3.2515, 4.2475000000000005, 0.5325, 0.5834999999999999, 0.5155000000000001},
+		{0.403, 0.1825, 0.8115, 0.3425, 0.4765, 0.246, 0.694, 0.608, 0.357, 0.2905, 0.073, 0.5145, 0.188, 0.3135, 0.3365, 0.145, 0.2645, 0.3125, 0.4245, 0.3605, 0.413, 0.332, 0.7695000000000001, 0.415, 0.2995, 0.305, 0.324, 0.335, 0.715, 0.277, 0.218, 0.396, 1.947, 0.383, 0.767, 0.4685, 2.8385, 0.4235, 0.3605, 0.4615, 0.2285, 0.1685, 0.3485, 7.7005, 0.191, 4.2145, 0.4945, 0.538, 0.439, 0.492, 0.45199999999999996, 3.8335, 13.302499999999998, 4.5205, 0.514, 0.4705, 0.114, 0.578, 0.313, 0.3995, 0.27, 0.2585, 2.0125, 0.176, 0.2795, 0.237, 0.4795, 0.246, 0.377, 0.4895, 0.183, 0.4185, 0.5165, 0.329, 0.2975, 0.448, 0.128, 0.2455, 11.530000000000001, 0.2215, 0.6735, 0.1885, 0.641, 0.159, 0.5415000000000001, 4.048, 3.275, 0.01, 0.402, 1.775, 0.685, 0.5145, 0.23, 0.224, 11.1175, 0.40900000000000003, 0.129, 0.6034999999999999, 0.5765, 0.677, 0.2115, 0.2215, 0.766, 0.2395, 0.1385, 7.2805, 0.221, 0.20450000000000002, 0.259, 0.3565, 0.9385, 0.3085, 0.434, 0.39849999999999997, 0.7735000000000001, 0.329, 0.273, 0.3885, 0.397, 1.9035, 0.237, 0.8865000000000001, 0.661, 4.798, 0.264, 0.503, 0.088, 0.424, 0.4475, 0.314, 0.1205, 0.1475, 0.4195, 0.5605, 0.293, 0.3635, 0.3225, 0.9085000000000001, 0.483, 0.33499999999999996, 0.796, 0.3225, 0.2975, 0.335, 0.3985, 1.569, 29.387999999999998, 0.28400000000000003, 0.6225, 0.6505, 0.505, 0.4745, 0.3135, 0.2655, 2.8385, 0.3905, 0.6805000000000001, 0.391, 0.316, 0.21, 0.5565, 0.299, 0.2845, 0.505, 0.3055, 0.784, 0.167, 0.1995, 0.678, 0.1075, 0.35150000000000003, 0.135, 0.3515, 0.527, 0.33, 0.5565, 0.5505, 0.47050000000000003, 0.345, 0.268, 0.34450000000000003, 0.151, 1.7105000000000001, 0.2135, 0.2195, 1.196, 0.2725, 0.324, 0.29, 0.687, 3.359, 5.42, 0.5005, 0.5345, 0.2},
+		{0.6295, 0.578, 1.0635, 0.3255, 0.6275, 0.3185, 0.9415, 0.6174999999999999, 0.3885, 0.517, 0.3805, 0.636, 0.4335, 0.43, 0.377, 0.46, 0.3425, 0.359, 0.595, 0.589, 0.412, 0.3065, 0.651, 0.46599999999999997, 0.308, 0.5585, 0.3035, 0.374, 0.791, 0.4955, 0.45599999999999996, 0.5854999999999999, 1.9685000000000001, 0.3515, 0.849, 0.5135000000000001, 0.472, 0.433, 0.454, 0.5854999999999999, 0.4885, 0.434, 0.6505000000000001, 6.8525, 0.4165, 3.7215, 0.6395, 0.5225, 0.6895, 0.5505, 0.4395, 3.226, 14.5135, 2.4065000000000003, 0.4215, 0.4505, 0.46399999999999997, 0.756, 0.47450000000000003, 0.461, 0.364, 0.48, 2.016, 0.4265, 0.527, 0.442, 0.509, 0.4655, 0.396, 0.5015000000000001, 0.494, 0.5965, 0.6134999999999999, 0.51, 0.325, 0.594, 0.35, 0.4095, 10.867, 0.503, 0.89, 0.4275, 0.795, 0.46699999999999997, 0.5834999999999999, 0.474, 0.5445, 0.4065, 0.017, 1.4665, 0.611, 0.692, 0.414, 0.567, 8.09, 0.438, 0.4115, 2.9755000000000003, 0.3525, 0.829, 0.4375, 0.524, 0.9065000000000001, 0.4365, 0.436, 7.1475, 0.4925, 0.4495, 0.5335000000000001, 0.355, 0.827, 0.337, 0.6475, 0.397, 1.911, 0.376, 0.2855, 0.572, 0.463, 1.6635, 0.4385, 0.96, 0.6695, 5.289, 0.51, 0.5685, 0.4555, 0.406, 0.4115, 0.4795, 0.4095, 0.4335, 0.487, 0.411, 0.294, 0.4375, 0.3385, 0.8685, 0.426, 0.3445, 0.6990000000000001, 0.3005, 0.325, 0.3745, 0.3855, 1.3345, 28.573, 0.394, 0.865, 0.4605, 0.469, 0.469, 0.329, 0.355, 2.859, 0.431, 0.61, 0.4135, 0.3135, 0.575, 0.469, 0.3755, 0.5285, 0.514, 0.34650000000000003, 1.0345, 0.5025, 0.46799999999999997, 0.837, 0.3895, 0.3595, 0.4325, 0.33999999999999997, 0.7985, 0.356, 0.548, 1.9335, 0.509, 0.427, 0.2805, 0.397, 0.4425, 1.8239999999999998, 0.3875, 0.4975, 1.3515000000000001, 0.0, 0.323, 0.325, 0.6495, 3.2584999999999997, 2.74, 0.614, 0.5285, 0.4605},
+		{1.4304999999999999, 1.6205, 1.9565000000000001, 1.4095, 1.7035, 1.6595, 2.068, 1.725, 1.435, 1.978, 1.4215, 2.274, 1.3505, 1.8035, 1.3900000000000001, 1.5755, 1.701, 1.634, 1.815, 1.5950000000000002, 1.6360000000000001, 1.5735000000000001, 1.5125, 1.5434999999999999, 1.3845, 1.5585, 1.4025, 1.533, 2.1399999999999997, 1.425, 1.6324999999999998, 1.7215, 2.837, 1.455, 2.127, 1.5695000000000001, 1.3094999999999999, 1.709, 1.669, 1.782, 1.666, 1.6005, 1.4849999999999999, 6.487500000000001, 1.513, 4.0425, 1.525, 1.6440000000000001, 1.7585, 1.6204999999999998, 1.8285, 5.5455000000000005, 15.746500000000001, 3.1975, 1.4255, 1.5725, 1.4775, 1.6520000000000001, 1.5005000000000002, 1.367, 1.5245, 1.537, 3.433, 1.581, 1.4135, 1.37, 1.4155, 1.4289999999999998, 1.5234999999999999, 1.7309999999999999, 1.4275000000000002, 1.8860000000000001, 1.7355, 1.6235, 1.6505, 1.797, 1.514, 1.3315000000000001, 14.7675, 1.6444999999999999, 1.863, 1.4820000000000002, 1.781, 1.4785, 1.954, 1.9975, 1.709, 1.4855, 1.552, 0.007, 1.6225, 1.6675, 1.393, 1.467, 14.198, 1.7185000000000001, 1.7605, 1.726, 1.714, 2.069, 1.4905, 1.6655, 1.908, 1.901, 1.7135, 7.08, 1.5899999999999999, 1.6955, 2.0965, 1.5619999999999998, 2.1835000000000004, 1.6125, 1.7785, 1.3530000000000002, 1.9205, 1.7665, 1.5825, 1.6695, 1.5554999999999999, 2.0069999999999997, 1.534, 2.053, 1.9064999999999999, 5.4, 1.6219999999999999, 1.841, 1.6444999999999999, 1.3895, 1.6885, 1.5030000000000001, 1.5585, 1.5845, 1.549, 1.8639999999999999, 1.5965, 1.613, 1.6895, 1.861, 1.67, 1.5114999999999998, 1.7345000000000002, 1.4355, 1.7665, 1.612, 1.7925, 2.9985, 27.3555, 1.48, 1.7725, 2.0949999999999998, 1.6305, 1.7115, 1.6335, 1.5790000000000002, 3.3885, 1.6520000000000001, 1.7365, 1.6, 1.6484999999999999, 1.824, 1.3605, 1.5925, 1.7505000000000002, 1.6655, 1.711, 1.377, 1.4905, 1.6715, 9.0885, 1.49, 1.7229999999999999, 1.5415, 1.4104999999999999, 1.9955, 1.7375, 1.912, 1.8319999999999999, 1.532, 1.649, 1.365, 1.666, 1.5045000000000002, 2.303, 1.3635000000000002, 1.44, 2.415, 0.0, 2.039, 1.596, 1.761, 3.746, 4.9925, 1.549, 1.3014999999999999, 1.5305},
+		{0.8135, 0.82, 1.268, 0.6174999999999999, 0.8194999999999999, 15.0125, 2.9364999999999997, 0.8414999999999999, 0.6944999999999999, 0.8674999999999999, 0.7675000000000001, 1.211, 0.705, 0.8365, 0.636, 0.711, 0.5175000000000001, 0.6970000000000001, 1.024, 0.8394999999999999, 0.7375, 0.6755, 0.86, 0.654, 0.611, 0.798, 0.6655, 2.0285, 1.2000000000000002, 0.684, 0.795, 0.9875, 2.1905, 0.71, 1.3935, 0.841, 0.763, 0.619, 0.838, 0.925, 0.7304999999999999, 0.8955, 0.9035, 3.8415, 0.7244999999999999, 3.9234999999999998, 0.883, 0.763, 0.919, 0.9664999999999999, 0.9325, 3.428, 14.498999999999999, 3.9219999999999997, 1.1255000000000002, 0.846, 0.7655000000000001, 1.0965, 0.81, 0.7124999999999999, 0.7044999999999999, 0.899, 1.92, 0.8089999999999999, 0.8795, 0.7115, 0.7725, 0.7155, 0.7055, 0.788, 0.7175, 0.9835, 0.8725, 0.6925, 0.9604999999999999, 0.8405, 0.639, 0.6759999999999999, 14.148, 0.7304999999999999, 12.931999999999999, 1.009, 1.0735000000000001, 0.8795, 0.9359999999999999, 1.3559999999999999, 0.795, 0.6875, 0.701, 1.8975, 0.007, 0.9065000000000001, 0.7625, 0.8, 7.7059999999999995, 0.8734999999999999, 1.0310000000000001, 1.0525, 0.829, 1.081, 0.727, 0.748, 1.1484999999999999, 0.844, 0.843, 16.8475, 4.195, 1.1315, 15.219, 0.8240000000000001, 1.1364999999999998, 0.645, 0.897, 0.6944999999999999, 1.3265, 0.8095, 0.6799999999999999, 0.878, 0.7969999999999999, 2.875, 0.774, 1.1680000000000001, 1.0095, 5.821999999999999, 0.683, 1.045, 0.808, 1.8515000000000001, 0.484, 0.871, 0.743, 0.8374999999999999, 0.767, 2.0145, 0.7115, 1.2885, 0.6565000000000001, 1.1415, 0.7304999999999999, 0.6505000000000001, 0.962, 0.7155, 0.6895, 0.6265000000000001, 12.449, 1.5585, 27.7845, 0.641, 0.8365, 0.6525000000000001, 0.683, 1.1315, 0.6535, 1.1825, 2.8150000000000004, 0.785, 14.2555, 0.7064999999999999, 0.61, 1.6604999999999999, 0.773, 1.174, 0.813, 0.761, 0.6765000000000001, 1.6644999999999999, 0.7055, 0.7815000000000001, 2.651, 0.6465000000000001, 0.872, 0.7224999999999999, 0.6715, 0.887, 1.2035, 16.564999999999998, 3.0985, 0.702, 0.627, 13.8, 0.6775, 0.754, 2.104, 0.8214999999999999, 0.8215, 1.609, 0.0, 1.0315, 0.6205, 18.4715, 3.279, 5.052, 0.893, 0.7585, 0.8325},
+		{0.6859999999999999, 0.597, 1.186, 0.6174999999999999, 0.2815, 0.607, 0.9339999999999999, 0.8435, 0.665, 0.6585, 0.446, 0.7144999999999999, 0.5505, 0.737, 0.6214999999999999, 0.4805, 0.629, 0.6345000000000001, 0.4785, 0.745, 0.701, 0.593, 0.822, 0.602, 0.5774999999999999, 0.496, 0.6555, 0.623, 0.9265000000000001, 0.515, 0.5215000000000001, 0.9, 1.9675, 0.66, 0.9435, 0.4935, 0.634, 0.631, 0.6025, 0.7435, 0.4945, 0.5205, 0.5765, 2.8705, 0.4565, 4.765, 0.5005, 0.789, 0.5645, 0.7695000000000001, 0.5894999999999999, 2.9775, 16.274, 2.175, 0.4, 0.75, 0.5585, 0.5395000000000001, 0.5325, 0.5575000000000001, 0.5525, 0.4565, 2.0095, 0.498, 0.456, 0.584, 0.427, 0.458, 0.692, 0.7975000000000001, 0.599, 0.8300000000000001, 0.713, 0.476, 0.5495000000000001, 0.4725, 0.529, 0.528, 11.503, 0.5625, 0.758, 0.556, 0.749, 0.4845, 0.6645000000000001, 0.386, 0.3575, 0.5415000000000001, 0.632, 1.4535, 0.8454999999999999, 0.017, 0.51, 0.5075000000000001, 8.004999999999999, 0.6935, 6.3885000000000005, 5.4155, 0.802, 1.055, 0.5045, 0.5335000000000001, 0.7975000000000001, 0.498, 0.572, 5.8195, 0.531, 0.5165, 0.875, 0.6065, 0.8865000000000001, 0.6435, 0.5974999999999999, 0.6895, 1.057, 0.6485000000000001, 0.5814999999999999, 0.325, 0.632, 1.9655, 0.6094999999999999, 0.8435, 0.851, 4.4635, 0.5535000000000001, 0.5275000000000001, 0.549, 0.55, 0.5780000000000001, 0.659, 0.5485, 0.5445, 0.723, 0.648, 0.646, 0.707, 0.5725, 0.9065000000000001, 0.646, 0.579, 1.0285, 0.626, 0.626, 0.6154999999999999, 0.6445000000000001, 1.561, 25.821, 0.563, 1.0539999999999998, 0.829, 0.6765000000000001, 0.424, 0.5945, 0.6375, 2.652, 0.6425000000000001, 1.005, 0.6145, 0.5985, 0.7625, 0.5325, 0.5985, 0.46399999999999997, 0.931, 0.576, 1.374, 0.4685, 0.537, 0.634, 0.545, 0.6054999999999999, 0.5205, 0.591, 0.9375, 0.629, 0.9045000000000001, 0.7024999999999999, 0.643, 0.6045, 0.6045, 0.5615000000000001, 0.5125, 1.891, 0.532, 0.4755, 1.754, 0.0, 0.5655, 0.608, 0.9115, 2.857, 3.5045, 0.592, 0.814, 0.5295000000000001},
+		{0.3815, 0.275, 0.976, 0.3635, 0.3915, 0.3585, 1.216, 0.6685, 0.429, 0.188, 0.227, 0.457, 0.264, 0.295, 0.35150000000000003, 0.20500000000000002, 0.379, 0.3535, 0.455, 0.3925, 0.369, 0.3745, 0.7364999999999999, 0.5085, 0.359, 0.28200000000000003, 0.4205, 0.388, 0.775, 0.2485, 0.18, 0.337, 2.0060000000000002, 0.40549999999999997, 0.6094999999999999, 0.497, 0.53, 0.4425, 0.3515, 0.569, 0.1365, 0.253, 0.37, 4.6225000000000005, 0.13, 4.1259999999999994, 0.605, 0.5365, 0.47050000000000003, 0.5635, 0.3575, 4.0985, 15.866, 2.07, 0.4615, 0.522, 0.2685, 0.5545, 0.251, 0.4455, 0.345, 0.2715, 1.9505, 0.2195, 0.2395, 0.3325, 0.442, 0.2115, 0.413, 0.45599999999999996, 0.2075, 0.4225, 0.4725, 0.302, 0.347, 0.439, 0.2075, 0.219, 12.2365, 0.21, 0.6014999999999999, 0.20850000000000002, 0.7055, 0.2345, 0.6885, 0.48150000000000004, 0.438, 0.2185, 0.442, 1.5510000000000002, 0.622, 0.5045, 0.008, 0.1755, 8.560500000000001, 0.4385, 0.2845, 8.295, 0.6455, 0.5589999999999999, 0.2165, 0.255, 0.727, 0.18, 0.235, 7.401, 0.1945, 0.1525, 0.46799999999999997, 0.412, 0.952, 0.3865, 0.4155, 0.392, 0.9335, 0.379, 0.379, 0.431, 0.529, 1.0419999999999998, 0.3015, 0.856, 0.659, 4.6325, 0.207, 0.5025, 0.242, 0.501, 0.52, 0.261, 0.242, 0.191, 0.461, 0.557, 0.3525, 0.389, 0.3605, 0.9299999999999999, 0.5025, 0.4575, 0.8945000000000001, 0.395, 0.3335, 0.3495, 0.4355, 1.274, 27.5215, 0.328, 0.7150000000000001, 0.5085, 0.523, 0.498, 0.3565, 0.3935, 2.6265, 0.4125, 0.7495, 0.4345, 0.482, 0.47450000000000003, 0.5815, 0.4015, 0.2815, 0.582, 0.3575, 0.399, 0.23299999999999998, 0.2295, 1.22, 0.224, 0.395, 0.181, 0.3365, 0.6094999999999999, 0.3645, 0.597, 0.5685, 0.5095000000000001, 0.3865, 0.3335, 0.406, 0.23399999999999999, 1.7795, 0.2645, 0.2195, 1.2785, 0.0295, 0.4, 0.353, 0.694, 3.364, 5.7705, 0.5985, 0.554, 0.2065},
+		{0.4045, 0.28, 1.318, 0.40900000000000003, 0.4085, 0.384, 0.948, 0.6665000000000001, 0.4545, 0.311, 0.267, 0.5465, 0.3265, 0.3755, 0.4635, 0.244, 0.368, 0.4305, 0.5335000000000001, 0.6435, 0.439, 0.376, 0.722, 0.529, 0.418, 0.23399999999999999, 0.466, 0.489, 1.0985, 0.213, 0.216, 0.3855, 2.0545, 0.454, 0.5965, 0.639, 0.5605, 0.4985, 0.4155, 0.478, 0.2655, 0.289, 0.316, 7.6895, 0.2315, 4.2655, 0.603, 0.6435, 0.516, 0.667, 0.5805, 4.054, 14.564, 3.0275, 0.4845, 0.5645, 0.281, 0.649, 0.279, 0.4805, 0.399, 0.201, 0.8805000000000001, 0.2145, 0.236, 0.3505, 0.6305000000000001, 0.2705, 0.5065, 0.5215000000000001, 0.286, 0.4485, 0.52, 0.287, 0.3995, 0.512, 0.2285, 0.2875, 11.633, 0.258, 0.6775, 0.226, 0.7915000000000001, 0.265, 0.729, 0.476, 0.479, 0.237, 0.5395000000000001, 1.9355, 0.6485000000000001, 0.47450000000000003, 0.2025, 0.014499999999999999, 8.7695, 0.5125, 0.274, 0.6194999999999999, 0.5985, 0.5894999999999999, 0.2375, 0.2955, 0.8665, 0.2145, 0.321, 7.6425, 0.222, 0.1995, 0.389, 0.4365, 1.0825, 0.393, 0.3885, 0.4495, 2.4539999999999997, 0.491, 0.433, 0.40349999999999997, 0.4925, 1.9969999999999999, 0.3345, 0.933, 0.8294999999999999, 5.511, 0.2585, 0.507, 0.275, 0.575, 0.543, 0.3955, 0.2535, 0.247, 0.522, 0.6415, 0.375, 0.5255000000000001, 0.3845, 0.7184999999999999, 0.518, 0.485, 0.9624999999999999, 0.3825, 0.405, 0.4445, 0.563, 2.085, 27.2705, 0.4335, 0.7815000000000001, 0.4625, 0.477, 0.5255000000000001, 0.475, 0.4235, 2.7270000000000003, 0.469, 0.712, 0.4125, 0.415, 0.404, 0.677, 0.3585, 0.2695, 0.6819999999999999, 0.421, 1.3525, 0.2275, 0.2375, 1.087, 0.249, 0.446, 0.2435, 0.4625, 0.694, 0.458, 0.618, 1.464, 0.5805, 0.4585, 0.40800000000000003, 0.509, 0.2805, 2.0975, 0.267, 0.19, 1.441, 0.102, 0.3705, 0.424, 0.9764999999999999, 3.408, 5.415, 0.7525, 0.6845, 0.27549999999999997},
+		{14.915, 8.426, 4.7675, 5.5169999999999995, 7.5855, 7.496, 5.295999999999999, 8.995, 12.7705, 11.379, 7.098, 13.068999999999999, 6.8095, 10.0765, 8.48, 7.2745, 7.12, 5.718999999999999, 4.5915, 8.078, 11.4465, 3.7270000000000003, 11.3215, 11.350999999999999, 9.915, 8.5595, 6.6754999999999995, 16.683999999999997, 6.0275, 10.3945, 11.2545, 6.193, 6.933999999999999, 8.304, 6.3815, 5.0794999999999995, 9.6735, 7.6754999999999995, 7.509499999999999, 7.67, 7.0015, 4.838, 9.3235, 11.71, 7.3675, 14.5515, 12.738499999999998, 8.413499999999999, 8.6035, 11.99, 7.974, 15.278500000000001, 20.314999999999998, 8.943999999999999, 7.1415, 9.821, 8.601500000000001, 5.8895, 4.7885, 4.5705, 8.5305, 4.7524999999999995, 8.9715, 9.741, 4.3875, 8.7665, 5.7025, 7.671, 15.4855, 7.797499999999999, 9.192, 11.0175, 8.352, 6.471, 8.6365, 6.859, 6.9085, 9.2165, 19.4565, 11.880500000000001, 11.7895, 5.548, 9.530000000000001, 6.0015, 7.198, 7.0305, 7.5885, 13.1155, 7.983499999999999, 13.149999999999999, 10.574, 9.8315, 7.349, 7.5009999999999994, 0.011, 4.208, 4.013, 8.108, 10.504999999999999, 18.6095, 13.931999999999999, 9.962, 6.362500000000001, 7.288, 6.755, 12.786999999999999, 7.5655, 8.218, 11.7455, 3.7875, 8.1795, 7.605, 3.6645, 6.676, 5.271, 7.3035, 8.261, 7.164, 11.682, 13.029, 6.6579999999999995, 8.461, 8.0265, 12.85, 6.38, 6.8765, 8.097999999999999, 9.847999999999999, 12.589500000000001, 10.1305, 8.718, 6.475, 7.4305, 10.585, 8.1615, 8.9905, 5.298, 8.077, 10.894, 6.577500000000001, 5.76, 5.249, 6.830500000000001, 4.9305, 12.727, 9.05, 34.2285, 9.620000000000001, 5.734, 16.3065, 5.726, 6.6025, 7.5355, 10.003499999999999, 15.201, 7.161, 10.166, 12.664, 7.9705, 5.601, 5.8315, 6.355, 5.7905, 12.3805, 5.761, 7.165, 7.7145, 7.942, 16.307, 6.897, 10.138, 7.859, 5.1095, 13.2475, 15.1785, 8.25, 7.7925, 16.231, 7.9245, 11.1305, 7.397, 7.182, 6.7595, 5.538, 9.065, 7.0600000000000005, 0.1875, 11.3655, 5.8635, 13.6465, 8.405, 10.257, 4.859, 6.1555, 5.0385},
+		{0.5814999999999999, 0.513, 1.1059999999999999, 0.5265, 0.694, 0.3075, 1.153, 0.5754999999999999, 0.47, 0.7035, 0.3755, 0.9405, 0.47550000000000003, 0.5934999999999999, 0.34099999999999997, 0.46799999999999997, 0.333, 0.313, 0.638, 0.6895, 0.267, 0.271, 0.903, 0.4445, 0.266, 0.581, 0.3115, 0.3435, 1.1015, 0.52, 0.4375, 0.8545, 2.112, 0.367, 0.9365, 0.545, 0.4715, 0.298, 0.556, 0.637, 0.494, 0.429, 0.7585, 7.635, 0.41300000000000003, 3.6555, 0.775, 0.706, 0.6435, 0.5415000000000001, 0.7424999999999999, 4.234, 14.6335, 2.1405000000000003, 0.5934999999999999, 0.396, 0.4505, 0.7695000000000001, 0.525, 0.4935, 0.324, 0.5325, 1.839, 0.444, 0.542, 0.417, 0.568, 0.4955, 0.408, 0.6174999999999999, 0.546, 0.852, 0.6705000000000001, 0.592, 0.3485, 0.666, 0.4265, 0.484, 12.7345, 0.4785, 0.8045, 0.4485, 0.8035000000000001, 0.4535, 0.613, 0.5925, 0.68, 0.4345, 0.429, 1.7325, 0.6675, 0.758, 0.47750000000000004, 0.5165, 8.466000000000001, 0.025, 1.3125, 1.323, 0.622, 0.9165000000000001, 0.474, 0.4305, 0.8815, 0.507, 0.6225, 6.194, 0.523, 0.481, 0.6915, 0.452, 0.9275, 0.2945, 0.6595, 0.40900000000000003, 1.4825, 0.3795, 0.262, 0.5685, 0.45, 1.0819999999999999, 0.571, 0.994, 0.733, 5.5685, 0.48, 0.662, 0.4385, 0.424, 0.5674999999999999, 0.5475000000000001, 0.422, 0.433, 0.42600000000000005, 0.5605, 0.3195, 1.1625, 0.285, 0.8694999999999999, 0.5615, 0.348, 0.7475, 0.284, 0.3125, 0.3795, 0.403, 1.467, 29.528, 0.2935, 0.897, 0.308, 0.492, 0.694, 0.2585, 0.324, 2.5175, 0.629, 0.929, 0.467, 0.3115, 0.6385000000000001, 0.7450000000000001, 0.274, 0.5825, 0.491, 0.3835, 1.4815, 0.533, 0.5275000000000001, 1.2405, 0.42500000000000004, 0.314, 0.4505, 0.301, 0.903, 0.3985, 0.6935, 0.5535000000000001, 0.4555, 0.363, 0.258, 0.351, 0.3705, 2.112, 0.4685, 0.4715, 1.2865, 0.0, 0.283, 0.262, 0.8505, 3.05, 6.688000000000001, 0.6385000000000001, 0.5914999999999999, 0.5265},
+		{0.4325, 0.2135, 1.0514999999999999, 0.3515, 2.0294999999999996, 1.294, 0.8625, 0.6595, 0.362, 0.3365, 0.227, 0.623, 0.704, 0.21, 0.663, 0.205, 0.356, 0.374, 0.5495000000000001, 0.4025, 0.431, 0.3645, 1.0745, 0.503, 0.945, 0.325, 0.396, 0.4085, 0.866, 0.2805, 0.3125, 0.6185, 2.114, 0.413, 0.7290000000000001, 0.4655, 46.298500000000004, 0.46399999999999997, 0.353, 5.8965, 0.5595000000000001, 0.187, 0.403, 8.087, 0.243, 3.6275, 0.595, 0.6, 2.8045, 1.9304999999999999, 0.34750000000000003, 4.3934999999999995, 15.477, 2.9065000000000003, 12.8155, 0.5205, 0.279, 1.0455, 0.353, 0.47350000000000003, 0.4005, 0.335, 1.8735, 0.228, 0.346, 0.247, 0.529, 0.286, 0.4255, 1.0274999999999999, 0.247, 0.768, 0.485, 0.5695, 1.4900000000000002, 0.716, 0.444, 0.2755, 10.427, 0.3245, 0.7545, 0.22, 4.1045, 0.215, 0.797, 5.3305, 0.433, 0.1225, 0.4705, 1.5705, 0.958, 6.473000000000001, 0.3445, 0.2585, 7.4595, 1.3685, 0.026, 2.7715, 0.596, 0.9005000000000001, 0.2595, 0.255, 0.8165, 2.1224999999999996, 0.216, 6.9635, 0.2745, 0.257, 0.9735, 0.434, 0.865, 0.368, 0.5, 0.7455, 1.2675, 0.3575, 0.3405, 0.4475, 2.4785, 2.057, 0.4755, 0.6225, 0.7295, 5.186999999999999, 0.245, 0.5640000000000001, 0.13, 0.69, 0.841, 0.294, 0.181, 0.8505, 0.46299999999999997, 0.5595000000000001, 0.394, 3.5265, 0.725, 0.982, 0.457, 0.36, 0.8015000000000001, 0.621, 0.3965, 0.4135, 0.819, 1.9325, 25.685000000000002, 0.307, 10.2345, 0.92, 0.555, 0.6415, 0.416, 0.3955, 2.4699999999999998, 0.4725, 0.737, 0.6194999999999999, 0.3475, 0.5605, 0.597, 0.3245, 0.331, 0.5465, 0.393, 1.1255, 0.1785, 0.231, 0.7025, 0.3475, 0.364, 0.1485, 1.0535, 0.716, 3.4825, 10.051, 0.608, 4.5024999999999995, 0.3775, 0.359, 0.3925, 0.556, 2.2925, 0.267, 0.256, 1.4100000000000001, 0.0915, 1.294, 0.3515, 0.8109999999999999, 3.291, 3.8185000000000002, 0.5854999999999999, 0.5285, 0.397},
+		{0.8025, 1.001, 1.4649999999999999, 0.628, 5.486000000000001, 0.5994999999999999, 8.173, 1.217, 0.6799999999999999, 0.955, 0.708, 6.102, 0.6945, 3.0934999999999997, 5.672000000000001, 3.0895, 2.0505, 0.855, 2.988, 3.026, 0.6425000000000001, 2.0605, 0.9195, 0.582, 0.5794999999999999, 0.6465000000000001, 0.707, 0.6214999999999999, 1.463, 1.9235, 0.683, 1.2035, 3.6734999999999998, 5.5045, 1.0310000000000001, 0.756, 11.902999999999999, 0.725, 1.947, 5.12, 3.1944999999999997, 0.666, 1.404, 6.829, 0.6275, 5.041, 0.8995, 2.1515, 1.3445, 1.454, 0.589, 4.741, 11.9125, 3.1925, 1.3275000000000001, 5.7165, 0.804, 6.3195, 0.76, 5.628, 3.1695, 0.794, 2.7605, 0.6355, 0.647, 0.6635, 0.657, 6.6885, 8.133, 2.981, 0.6964999999999999, 1.1185, 1.045, 5.4655000000000005, 0.6565000000000001, 0.7615000000000001, 1.6155, 3.0095, 12.804, 5.634, 0.9085000000000001, 1.245, 6.558, 2.8975, 0.9405, 0.8089999999999999, 0.867, 5.308999999999999, 8.2225, 2.7005, 0.7715000000000001, 6.413, 3.1310000000000002, 0.658, 10.4785, 0.705, 2.9535, 0.02, 0.762, 1.405, 0.662, 0.6905, 6.7145, 0.8009999999999999, 1.0325, 7.0009999999999994, 0.6970000000000001, 0.626, 2.0005, 3.0225, 1.5065, 0.5885, 0.9445, 0.624, 3.9335, 8.064, 0.5725, 0.8765000000000001, 7.1255, 5.6625, 0.724, 6.4815000000000005, 0.962, 4.6035, 0.6695, 1.4255, 0.621, 0.735, 0.6605000000000001, 3.2355, 0.634, 0.7675000000000001, 0.756, 0.724, 2.934, 1.2065000000000001, 5.664, 2.059, 0.7104999999999999, 0.978, 1.102, 5.255, 3.2705, 0.6225, 5.327, 3.469, 26.9585, 0.5725, 4.059, 0.902, 0.786, 6.692, 1.301, 0.6074999999999999, 7.1145, 2.9665, 0.967, 0.613, 0.704, 1.4075, 0.749, 0.5640000000000001, 2.8175, 1.4945, 1.8784999999999998, 1.7075, 3.5725, 0.6605000000000001, 5.21, 5.413, 0.5754999999999999, 1.916, 0.5774999999999999, 4.4235, 1.3159999999999998, 1.0785, 0.848, 6.0695, 6.6255, 0.5515000000000001, 0.8195, 0.5994999999999999, 2.0629999999999997, 0.595, 0.6679999999999999, 1.835, 0.0, 1.842, 3.1965, 1.057, 7.5705, 2.1665, 0.6944999999999999, 6.416499999999999, 1.9620000000000002},
+		{0.6655, 0.731, 0.8885000000000001, 0.508, 2.2295, 0.4545, 1.338, 0.756, 0.6295, 0.7915000000000001, 0.556, 1.3085, 0.6194999999999999, 0.6745000000000001, 0.5035000000000001, 0.548, 0.525, 0.5425, 0.86, 0.761, 0.5645, 0.494, 0.7775000000000001, 0.675, 0.4145, 0.684, 0.4345, 0.4965, 1.0074999999999998, 0.591, 0.5655, 0.692, 2.1470000000000002, 0.476, 0.9515, 0.6635, 0.553, 0.5265, 0.6984999999999999, 0.701, 0.5994999999999999, 0.5694999999999999, 0.787, 7.393, 0.5675, 3.4930000000000003, 0.671, 0.736, 0.7915000000000001, 0.58, 0.6765000000000001, 3.765, 14.31, 2.5585, 0.601, 0.6325000000000001, 0.6185, 0.7484999999999999, 0.6785000000000001, 0.603, 0.5015000000000001, 0.631, 1.543, 0.6305000000000001, 0.737, 0.557, 0.597, 0.6014999999999999, 0.5145, 0.7965, 0.655, 0.964, 0.696, 0.547, 0.458, 0.893, 0.6005, 0.6085, 11.086500000000001, 0.6575, 0.945, 0.5345, 0.908, 0.573, 0.524, 0.569, 0.6725000000000001, 0.5155000000000001, 0.3105, 1.509, 0.688, 0.8165, 0.578, 0.593, 12.686, 0.6094999999999999, 0.5615, 0.8140000000000001, 0.034, 1.004, 0.706, 0.5925, 0.948, 0.696, 0.581, 6.884, 0.5754999999999999, 0.5505, 0.9625, 0.5445, 1.153, 0.4795, 0.8115, 0.5325, 1.15, 0.502, 0.4995, 0.603, 0.6385000000000001, 1.3344999999999998, 0.5565, 1.0535, 0.804, 4.849, 0.6145, 0.6855, 0.5974999999999999, 0.5265, 0.5485, 0.6535, 0.613, 0.549, 0.6145, 0.5874999999999999, 0.511, 0.6695, 0.423, 1.2530000000000001, 0.7215, 0.457, 0.8654999999999999, 0.611, 0.469, 0.547, 0.5435000000000001, 1.3125, 28.4255, 0.532, 0.988, 0.521, 0.599, 0.5865, 0.5095000000000001, 0.4845, 2.713, 0.5640000000000001, 0.781, 0.543, 0.553, 0.699, 0.6465000000000001, 0.48, 0.6325000000000001, 0.6585000000000001, 0.5925, 1.492, 0.5405, 0.543, 1.2215, 0.5925, 0.5045, 0.593, 0.523, 0.961, 0.55, 0.662, 1.9780000000000002, 0.6975, 0.5645, 0.429, 0.447, 0.497, 1.787, 0.5794999999999999, 0.5825, 1.2890000000000001, 0.0, 0.443, 0.436, 0.8514999999999999, 3.2835, 3.1390000000000002, 0.675, 0.6585000000000001, 0.5765},
+		{0.96, 0.944, 1.5554999999999999, 0.616, 0.791, 0.848, 1.565, 1.198, 0.8109999999999999, 1.3565, 0.644, 1.6044999999999998, 0.5585, 0.882, 0.768, 0.709, 0.592, 0.762, 0.8425, 0.859, 0.7375, 0.6835, 1.1135000000000002, 0.7775000000000001, 0.677, 0.5945, 0.865, 0.6595, 1.8479999999999999, 0.7495, 0.67, 0.761, 2.3045, 0.812, 1.4805000000000001, 0.8825000000000001, 0.9195, 0.757, 0.8174999999999999, 0.779, 0.715, 0.6565000000000001, 0.9385, 3.5675, 0.485, 4.449, 0.9485, 1.0965, 0.6365000000000001, 0.968, 1.197, 4.0735, 14.54, 2.4379999999999997, 0.772, 1.3119999999999998, 1.0375, 1.0165, 0.5475000000000001, 0.817, 0.9195, 0.4415, 2.1765, 0.802, 0.7230000000000001, 0.524, 0.887, 0.698, 0.7915000000000001, 1.112, 0.567, 1.158, 0.9315, 0.889, 0.7210000000000001, 0.8525, 1.459, 0.498, 11.746500000000001, 0.9925, 1.0455, 0.6375, 1.089, 0.621, 1.6800000000000002, 0.65, 0.726, 0.688, 0.8174999999999999, 2.1, 1.1955, 0.8654999999999999, 0.543, 0.509, 9.72, 0.9325, 0.66, 1.3860000000000001, 1.1545, 0.015, 0.754, 0.879, 0.9325000000000001, 0.9664999999999999, 1.186, 8.117999999999999, 0.8385, 0.5640000000000001, 1.8544999999999998, 0.6845, 1.6595, 0.582, 1.0945, 0.6859999999999999, 1.7475, 0.813, 0.7384999999999999, 0.7115, 0.845, 2.105, 0.7705, 1.359, 1.113, 5.1850000000000005, 0.631, 0.7444999999999999, 0.7395, 0.788, 0.6995, 0.611, 0.507, 0.6134999999999999, 1.0865, 1.072, 0.7945, 1.188, 0.8025, 1.4100000000000001, 1.1219999999999999, 0.6495, 1.116, 0.754, 0.6445000000000001, 0.985, 0.774, 2.1740000000000004, 26.073, 0.646, 1.3159999999999998, 0.745, 0.884, 1.1320000000000001, 0.7945, 0.6275, 2.9285, 0.928, 1.109, 1.013, 0.624, 1.0670000000000002, 0.9944999999999999, 0.652, 0.698, 0.861, 0.6795, 1.697, 0.7115, 0.5275000000000001, 1.8275000000000001, 0.6455, 1.1095000000000002, 0.571, 0.6085, 1.7595, 1.0015, 1.2080000000000002, 0.7455, 0.9039999999999999, 0.821, 0.697, 0.7315, 0.8385, 2.303, 0.762, 0.441, 1.962, 0.0, 0.7575000000000001, 0.71, 1.6205, 3.254, 5.2395, 1.0225, 0.8805000000000001, 0.843},
+		{0.354, 0.243, 0.919, 0.3215, 0.4605, 0.3555, 0.8935, 0.715, 0.428, 0.47350000000000003, 0.19, 0.637, 0.2735, 0.286, 0.38, 0.161, 0.359, 0.3785, 0.6, 0.5135000000000001, 0.397, 0.348, 0.7795000000000001, 0.4295, 0.352, 0.3315, 0.396, 0.4115, 0.778, 0.2685, 0.252, 0.4415, 2.031, 0.4225, 0.7805, 0.46799999999999997, 0.5235000000000001, 0.5245, 0.404, 0.498, 0.2265, 0.2435, 0.4035, 7.0760000000000005, 0.2065, 3.895, 0.6265000000000001, 0.5854999999999999, 0.4685, 0.571, 0.481, 4.3405000000000005, 14.4345, 3.7830000000000004, 0.5585, 0.525, 0.225, 0.5965, 0.3005, 0.458, 0.3555, 0.292, 1.521, 0.1555, 0.3105, 0.281, 0.5025, 0.2875, 0.3815, 0.5105, 0.276, 0.6485000000000001, 0.48, 0.316, 0.3855, 0.448, 0.2445, 0.2425, 11.5525, 0.2025, 0.74, 0.176, 0.761, 0.2115, 0.592, 0.4095, 0.435, 0.204, 0.4485, 1.482, 0.6845, 0.5585, 0.1995, 0.22649999999999998, 8.7895, 0.47050000000000003, 0.2505, 0.6114999999999999, 0.597, 0.7075, 0.0905, 0.2425, 0.769, 0.2455, 0.224, 7.567, 0.245, 0.1975, 0.37, 0.4265, 0.724, 0.3635, 0.4035, 0.363, 1.8765, 0.3885, 0.3545, 0.4535, 0.4515, 1.7085, 0.277, 0.7164999999999999, 0.7424999999999999, 4.9275, 0.225, 0.6065, 0.1995, 0.4475, 0.5025, 0.3895, 0.2045, 0.1705, 0.4545, 0.55, 0.3675, 0.4635, 0.3755, 0.8225, 0.497, 0.3795, 0.9065000000000001, 0.3215, 0.306, 0.397, 0.4765, 1.343, 28.4535, 0.373, 0.7255, 0.448, 0.4675, 0.5695, 0.384, 0.3715, 2.834, 0.4845, 0.9085000000000001, 0.4455, 0.4005, 0.357, 0.619, 0.374, 0.3405, 0.46699999999999997, 0.362, 1.2429999999999999, 0.186, 0.1805, 0.942, 0.3635, 0.424, 0.181, 0.3565, 0.531, 0.3635, 0.54, 0.604, 0.538, 0.4335, 0.365, 0.4095, 0.229, 1.8435000000000001, 0.218, 0.2325, 1.3784999999999998, 0.321, 0.3375, 0.327, 0.903, 3.008, 4.8095, 0.5655, 0.61, 0.1665},
+		{0.40900000000000003, 0.359, 1.0985, 0.395, 0.47, 0.333, 1.1175, 0.6535, 0.4415, 0.3635, 0.2225, 0.8085, 0.285, 0.289, 0.4085, 0.18, 0.3755, 0.4085, 0.4855, 0.5035000000000001, 0.481, 0.3585, 1.094, 0.426, 0.329, 0.3355, 0.402, 0.46950000000000003, 1.5575, 0.3345, 0.281, 0.5085, 2.0775, 0.46950000000000003, 0.6990000000000001, 0.587, 0.5185, 0.444, 0.4135, 0.487, 0.1955, 0.2595, 0.6465, 7.3165, 0.2365, 4.6215, 0.6645000000000001, 0.6715, 0.4895, 0.5805, 0.4315, 4.1145, 14.8375, 2.8795, 0.5515000000000001, 0.4605, 0.216, 0.6475, 0.3365, 0.476, 0.3525, 0.2915, 2.673, 0.1845, 0.308, 0.3185, 0.503, 0.2905, 0.487, 0.7095, 0.2635, 0.389, 0.4755, 0.3475, 0.34550000000000003, 0.4995, 0.32, 0.259, 13.5095, 0.26, 0.72, 0.2105, 0.851, 0.251, 0.7375, 0.5445, 0.531, 0.2125, 0.48, 1.823, 0.708, 0.5834999999999999, 0.27, 0.273, 12.1125, 0.504, 0.2385, 0.698, 0.6245, 0.7875000000000001, 0.2505, 0.006, 0.829, 0.273, 0.2495, 6.017, 0.28200000000000003, 0.268, 1.007, 0.4355, 0.897, 0.368, 0.537, 0.4455, 0.819, 0.43, 0.3765, 0.443, 0.467, 1.4275, 0.303, 0.9864999999999999, 0.8705, 5.524, 0.24, 0.55, 0.214, 0.527, 0.5545, 0.46499999999999997, 0.2315, 0.198, 0.4815, 0.7095, 0.3535, 0.4825, 0.4195, 0.83, 0.499, 0.3795, 1.0295, 0.351, 0.416, 0.394, 0.5045, 2.2695, 26.813499999999998, 0.3685, 0.884, 0.45999999999999996, 0.53, 0.5575000000000001, 0.442, 0.464, 2.7195, 0.4885, 0.742, 0.4795, 0.402, 0.597, 0.5885, 0.40700000000000003, 0.3385, 0.539, 0.3945, 1.847, 0.14, 0.203, 2.275, 0.232, 0.4375, 0.203, 0.392, 0.6205, 0.4, 0.546, 0.612, 0.591, 0.4135, 0.355, 0.4345, 0.23, 2.29, 0.2615, 0.236, 1.409, 0.2135, 0.35, 0.391, 0.8274999999999999, 3.37, 5.2575, 0.59, 0.5635, 0.2345},
+		{0.8194999999999999, 0.7415, 1.0819999999999999, 0.7304999999999999, 0.708, 0.8895, 0.9075, 0.995, 0.778, 0.662, 0.6935, 1.1840000000000002, 0.629, 0.783, 0.7805, 0.6435, 0.6984999999999999, 0.6915, 0.475, 0.7144999999999999, 0.8765000000000001, 0.745, 0.9904999999999999, 0.8414999999999999, 0.7765, 0.6755, 0.825, 0.744, 1.077, 0.6174999999999999, 0.655, 0.815, 2.0865, 0.848, 1.1324999999999998, 0.77, 0.8174999999999999, 0.7515000000000001, 0.679, 0.818, 0.5954999999999999, 0.6805000000000001, 0.7484999999999999, 3.2424999999999997, 0.6365000000000001, 5.8015, 0.7975000000000001, 0.885, 0.5095000000000001, 0.8815, 0.7304999999999999, 3.8804999999999996, 13.77, 2.482, 0.8945000000000001, 0.8614999999999999, 0.706, 0.835, 0.5874999999999999, 0.814, 0.7535000000000001, 0.633, 2.221, 0.701, 0.667, 0.73, 0.7735000000000001, 0.623, 0.856, 0.9319999999999999, 0.6485000000000001, 0.8160000000000001, 0.7455, 0.6065, 0.748, 0.76, 0.6655, 0.6225, 12.621, 0.7144999999999999, 1.0835, 0.64, 1.0565, 0.642, 0.863, 0.8005, 0.7124999999999999, 0.665, 0.84, 1.517, 1.1225, 0.6875, 0.6505000000000001, 0.6425000000000001, 7.145, 0.7895000000000001, 0.716, 1.1035, 0.8965000000000001, 0.895, 0.6275, 0.6925, 0.032, 0.6915, 0.6575, 6.542, 0.637, 0.628, 1.392, 0.741, 3.5535, 0.7255, 0.834, 0.7255, 1.291, 0.719, 0.7164999999999999, 0.7355, 0.8160000000000001, 1.514, 0.6964999999999999, 1.0105, 0.992, 5.348000000000001, 0.66, 0.514, 0.668, 0.827, 0.9085000000000001, 0.6865, 0.6735, 0.6385000000000001, 0.77, 0.847, 0.747, 0.8274999999999999, 0.77, 1.0114999999999998, 0.8534999999999999, 0.732, 1.0365, 0.7565, 0.774, 0.729, 0.8965000000000001, 1.742, 28.137999999999998, 0.7344999999999999, 0.959, 0.9530000000000001, 0.8075000000000001, 0.8405, 0.752, 0.7975000000000001, 2.8289999999999997, 0.768, 1.1205, 0.744, 0.7605, 0.824, 2.1174999999999997, 0.6904999999999999, 0.6285000000000001, 0.9430000000000001, 0.7424999999999999, 1.451, 0.634, 0.5965, 3.544, 0.6145, 0.7535000000000001, 0.643, 0.7124999999999999, 0.988, 0.7735000000000001, 0.979, 0.819, 0.8865000000000001, 0.722, 0.8705, 0.804, 0.79, 1.93, 0.6595, 0.7295, 1.539, 0.0, 0.7024999999999999, 0.7495, 1.2805, 2.9635, 4.862500000000001, 0.8554999999999999, 0.9005000000000001, 0.6455},
+		{0.412, 0.3085, 1.1145, 0.3905, 0.43, 0.3605, 0.834, 0.6185, 0.4665, 0.29200000000000004, 0.2085, 0.627, 0.246, 0.2675, 0.424, 0.2105, 0.3585, 0.40800000000000003, 0.4945, 0.4405, 0.469, 0.3615, 0.9465, 0.444, 0.4025, 0.264, 0.374, 0.436, 0.692, 0.20450000000000002, 0.199, 0.417, 2.1135, 0.42, 0.735, 0.5355000000000001, 0.546, 0.442, 0.356, 0.5265, 0.176, 0.2755, 0.358, 7.572, 0.2175, 4.103, 0.555, 0.5765, 0.492, 0.588, 0.489, 4.161, 15.9175, 4.0685, 0.5195000000000001, 0.5365, 0.268, 0.6615, 0.2675, 0.49, 0.3695, 0.2425, 1.3010000000000002, 0.2305, 0.262, 0.317, 0.508, 0.2545, 0.473, 0.441, 0.2955, 0.48750000000000004, 0.477, 0.306, 0.3305, 0.418, 0.2385, 0.1745, 11.838000000000001, 0.264, 0.656, 0.23299999999999998, 0.741, 0.2425, 0.598, 0.5005, 0.487, 0.1885, 0.4365, 1.8199999999999998, 0.701, 0.4765, 0.199, 0.216, 7.9515, 0.5165, 2.141, 5.6530000000000005, 0.5974999999999999, 0.7024999999999999, 0.2525, 0.2815, 0.765, 0.015, 0.273, 7.4719999999999995, 0.2195, 0.255, 0.3755, 0.4015, 1.1375, 0.35550000000000004, 0.4015, 0.4635, 0.5874999999999999, 0.40149999999999997, 0.3545, 0.3975, 0.4755, 2.2199999999999998, 0.3045, 0.663, 0.6455, 5.396, 0.257, 0.51, 0.2375, 0.4885, 0.5105, 0.253, 0.2635, 0.242, 0.502, 0.584, 0.351, 0.4575, 0.4165, 0.8105, 0.552, 0.398, 0.841, 0.383, 0.363, 0.363, 0.46799999999999997, 1.6955, 28.493000000000002, 0.321, 0.859, 0.4295, 0.508, 0.47050000000000003, 0.39, 0.366, 2.9575, 0.463, 0.733, 0.44, 0.3665, 0.40549999999999997, 0.557, 0.3605, 0.262, 0.4865, 0.3615, 0.998, 0.244, 0.238, 0.67, 0.258, 0.3815, 0.209, 0.3665, 0.6174999999999999, 0.386, 0.631, 0.578, 0.5545, 0.362, 0.40900000000000003, 0.428, 0.2845, 1.8405, 0.2645, 0.2305, 1.2585, 0.0, 0.323, 0.354, 0.683, 3.3655, 5.359500000000001, 0.6225, 0.588, 0.256},
+		{0.4765, 0.2185, 0.95, 0.3415, 0.4835, 0.3045, 0.9929999999999999, 0.695, 0.3675, 0.314, 0.1235, 0.6375, 0.2545, 0.4285, 0.3555, 0.1755, 0.287, 0.366, 0.499, 0.459, 0.377, 0.2995, 0.624, 0.4685, 0.3145, 0.351, 0.3295, 0.3995, 1.053, 0.281, 0.2465, 0.404, 2.1065, 0.4085, 0.6465000000000001, 0.424, 0.4745, 0.394, 0.313, 0.499, 0.2325, 0.145, 0.33999999999999997, 6.8495, 0.184, 4.1495, 0.6094999999999999, 0.476, 0.497, 0.4895, 0.515, 3.8644999999999996, 13.932500000000001, 2.6675, 0.5325, 0.4285, 0.164, 0.615, 0.3165, 0.4245, 0.289, 0.3045, 1.7645, 0.1875, 0.2525, 0.244, 0.5215000000000001, 0.314, 0.34199999999999997, 0.4995, 0.243, 0.4155, 0.5305, 0.3365, 0.2925, 0.454, 0.21000000000000002, 0.2295, 12.287500000000001, 0.2705, 0.8145, 0.2265, 0.6945, 0.2075, 0.57, 0.494, 0.5105, 0.126, 0.4375, 1.444, 0.698, 0.5745, 0.264, 0.27749999999999997, 9.1175, 0.4645, 0.1765, 0.706, 0.6145, 0.9645, 0.2225, 0.246, 0.7435, 0.276, 0.006500000000000001, 6.923, 0.2405, 0.213, 0.7374999999999999, 0.359, 0.898, 0.3205, 0.4345, 0.3365, 2.1014999999999997, 0.357, 0.288, 0.5215000000000001, 0.4575, 1.4015, 0.231, 0.8325, 0.7295, 4.8305, 0.246, 0.498, 0.131, 0.3995, 0.4965, 0.293, 0.111, 0.175, 0.49350000000000005, 0.4585, 0.335, 0.397, 0.348, 0.8325, 0.551, 0.349, 0.9115, 0.3305, 0.2765, 0.3545, 0.4275, 1.802, 25.547, 0.318, 0.644, 0.5025, 0.495, 0.481, 0.334, 0.3175, 2.62, 0.3605, 0.7264999999999999, 0.3075, 0.34299999999999997, 0.363, 0.7144999999999999, 0.282, 0.281, 0.453, 0.325, 0.3395, 0.196, 0.2665, 0.6944999999999999, 0.1515, 0.342, 0.1295, 0.299, 0.9685, 0.374, 0.721, 0.6114999999999999, 0.4295, 0.4015, 0.307, 0.3655, 0.1955, 2.128, 0.1805, 0.22349999999999998, 1.249, 0.1765, 0.388, 0.312, 0.88, 2.898, 6.5375, 0.5814999999999999, 0.543, 0.198},
+		{7.872, 3.3705, 5.6335, 6.6075, 3.662, 6.354, 8.359, 9.987, 9.3925, 10.503499999999999, 6.327999999999999, 11.39, 8.193999999999999, 3.1725000000000003, 6.6215, 9.432500000000001, 9.0075, 3.0595, 6.058999999999999, 12.454, 3.0225, 6.558, 8.2605, 5.390000000000001, 5.0845, 4.133, 2.67, 6.7955000000000005, 10.5755, 10.902999999999999, 5.652, 8.0755, 6.021, 6.0024999999999995, 4.9815000000000005, 8.486, 4.6055, 5.468500000000001, 7.109, 5.4625, 8.940999999999999, 9.933, 3.4055, 12.008500000000002, 9.309999999999999, 8.7925, 8.8655, 3.731, 8.2395, 4.365, 5.3075, 11.231, 17.16, 11.124500000000001, 6.794499999999999, 7.757999999999999, 8.1115, 7.577999999999999, 8.0745, 8.689, 6.7925, 7.8485, 8.726500000000001, 9.1595, 8.744499999999999, 5.656000000000001, 3.8775, 6.763999999999999, 2.729, 5.667, 4.424, 10.0265, 7.801, 9.498999999999999, 9.530999999999999, 3.8745000000000003, 4.2875, 2.6045, 15.667000000000002, 11.3515, 7.1585, 7.8309999999999995, 7.9795, 7.5465, 8.7515, 7.879, 13.0705, 6.786, 6.5675, 4.138, 7.9625, 5.345000000000001, 6.0875, 6.0905000000000005, 18.264499999999998, 7.84, 9.4065, 5.4925, 9.607, 6.957000000000001, 6.259, 8.4115, 3.1109999999999998, 10.019, 7.461, 0.009, 8.250499999999999, 7.916, 8.8295, 8.437000000000001, 10.606, 4.637499999999999, 5.6125, 8.5435, 11.6975, 8.558, 8.871, 4.7225, 6.4035, 8.7205, 5.57, 5.7615, 6.4239999999999995, 8.756499999999999, 5.168, 7.971, 10.644, 9.5805, 9.938, 4.946999999999999, 8.131499999999999, 9.6115, 6.4215, 8.1485, 5.625, 8.2165, 4.836, 10.809999999999999, 8.8945, 2.5575, 7.502, 6.6594999999999995, 7.821, 6.6175, 6.461, 5.2524999999999995, 29.1615, 7.1105, 4.571, 5.147, 6.2135, 6.196, 6.1455, 9.7915, 8.0635, 9.899000000000001, 6.0655, 2.934, 3.2295, 6.647, 7.5245, 6.5915, 7.558, 6.17, 8.6965, 4.661, 8.133, 6.837, 11.5515, 6.391500000000001, 6.6825, 6.251, 4.438000000000001, 11.776, 6.964, 8.374500000000001, 8.100999999999999, 2.982, 3.2355, 7.166, 4.026, 4.2905, 7.181, 8.1835, 7.613, 10.002500000000001, 0.1605, 4.7145, 7.481, 8.0855, 9.322, 10.131, 7.149, 7.2385, 8.4575},
+		{0.3725, 0.265, 0.9784999999999999, 0.389, 0.432, 0.3585, 0.896, 0.673, 0.451, 0.2875, 0.2095, 0.89, 0.2495, 0.357, 0.3685, 0.2085, 0.364, 0.3335, 0.4655, 0.416, 0.4205, 0.3685, 1.032, 0.441, 0.364, 0.2635, 0.3915, 0.4495, 0.813, 0.2215, 0.2165, 0.369, 1.8094999999999999, 0.4165, 0.6739999999999999, 0.545, 0.497, 0.40549999999999997, 0.3485, 0.4305, 0.1065, 0.249, 0.357, 3.692, 0.1345, 4.4305, 0.5854999999999999, 0.6034999999999999, 0.5085, 0.5945, 0.3545, 3.5905, 14.341000000000001, 2.8805, 0.4765, 0.489, 0.2675, 0.6335, 0.264, 0.4685, 0.3635, 0.2835, 1.9495, 0.1895, 0.2605, 0.3185, 0.521, 0.2145, 0.4125, 0.5265, 0.293, 0.357, 0.513, 0.306, 0.387, 0.486, 0.217, 0.2465, 11.6765, 0.2825, 0.7084999999999999, 0.2305, 0.676, 0.20350000000000001, 0.6895, 0.483, 0.454, 0.2155, 0.4615, 1.801, 0.7525, 0.539, 0.1915, 0.2195, 10.2335, 0.46950000000000003, 0.245, 0.69, 0.582, 0.8265, 0.2285, 0.2395, 0.766, 0.229, 0.2665, 6.458, 0.0115, 0.151, 0.3915, 0.4605, 0.9615, 0.33499999999999996, 0.40549999999999997, 0.4535, 0.9085000000000001, 0.458, 0.261, 0.396, 0.4815, 2.2045, 0.3125, 0.7324999999999999, 0.7545, 6.1434999999999995, 0.2445, 0.452, 0.221, 0.41, 0.572, 0.266, 0.2085, 0.239, 0.523, 0.618, 0.3685, 0.4955, 0.3715, 0.8394999999999999, 0.598, 0.3645, 0.993, 0.3745, 0.38, 0.378, 0.4505, 2.0805, 28.4575, 0.3625, 0.7064999999999999, 0.476, 0.4765, 0.5175000000000001, 0.3935, 0.4055, 2.735, 0.3985, 0.734, 0.4105, 0.516, 0.6065, 0.5515, 0.3585, 0.255, 0.515, 0.3965, 1.2915, 0.1995, 0.2575, 0.7595, 0.237, 0.47050000000000003, 0.2005, 0.4045, 0.523, 0.3765, 0.59, 0.985, 0.5465, 0.3965, 0.34299999999999997, 0.40549999999999997, 0.2185, 1.8824999999999998, 0.253, 0.164, 1.066, 0.16349999999999998, 0.372, 0.34199999999999997, 0.915, 3.081, 5.397, 0.614, 0.4935, 0.229},
+		{0.364, 0.2655, 1.0175, 0.325, 0.326, 0.3765, 0.743, 0.6425000000000001, 0.4005, 0.2205, 0.187, 0.7435, 0.2565, 0.2765, 0.3615, 0.214, 0.328, 0.3205, 0.4955, 0.4285, 0.4, 0.351, 0.773, 0.4465, 0.3355, 0.25, 0.364, 0.428, 0.953, 0.217, 0.2365, 0.419, 1.95, 0.3785, 2.403, 0.546, 0.492, 0.438, 0.3685, 0.5, 0.20900000000000002, 0.2, 0.357, 7.0455000000000005, 0.1115, 4.6475, 0.5705, 0.588, 0.463, 0.663, 0.3845, 4.2315000000000005, 15.978, 2.0055, 0.4425, 0.4725, 0.2065, 0.5754999999999999, 0.2705, 0.4645, 0.325, 0.219, 2.3615000000000004, 0.197, 0.2505, 0.3105, 0.471, 0.17149999999999999, 0.4345, 0.475, 0.23, 0.536, 0.503, 0.295, 0.3265, 0.4915, 0.384, 0.22, 11.542, 0.168, 0.5375000000000001, 0.173, 0.758, 0.229, 0.7095, 0.4805, 0.4275, 0.225, 0.46399999999999997, 1.5739999999999998, 0.853, 0.4985, 0.153, 0.183, 7.268, 0.4425, 0.2635, 0.599, 0.582, 0.63, 0.195, 0.264, 0.773, 0.27, 0.23199999999999998, 5.881, 0.1485, 0.01, 0.32, 0.397, 0.8925000000000001, 0.3805, 0.33599999999999997, 0.4085, 0.9075, 0.384, 0.335, 0.40249999999999997, 0.457, 1.596, 0.28200000000000003, 0.756, 0.586, 5.487, 0.201, 0.495, 0.197, 0.449, 0.4885, 0.2505, 0.182, 0.158, 0.46699999999999997, 0.5914999999999999, 0.3765, 0.4855, 0.3905, 1.0274999999999999, 0.4715, 0.4035, 0.9055, 0.3865, 0.3645, 0.3745, 0.433, 1.5590000000000002, 29.5905, 0.27, 0.7185, 0.439, 0.5275000000000001, 0.4945, 0.4015, 0.3365, 2.6285, 0.397, 0.562, 0.40800000000000003, 0.6485000000000001, 0.4145, 0.6205, 0.3755, 0.293, 0.5495000000000001, 0.35, 1.0899999999999999, 0.176, 0.188, 1.3559999999999999, 0.179, 0.434, 0.14200000000000002, 0.387, 1.105, 0.358, 0.5734999999999999, 0.54, 0.5015000000000001, 0.40249999999999997, 0.401, 0.41400000000000003, 0.23299999999999998, 1.9125, 0.215, 0.142, 1.2530000000000001, 0.11499999999999999, 0.382, 0.362, 0.782, 2.784, 5.9405, 0.569, 0.5974999999999999, 0.221},
+		{0.829, 0.9485, 1.802, 0.427, 0.5814999999999999, 0.3955, 2.0015, 0.987, 0.5175000000000001, 3.8914999999999997, 0.161, 1.3785, 0.5235, 0.6025, 0.4195, 0.223, 0.413, 1.131, 0.617, 1.599, 0.7095, 0.651, 1.0525, 0.48750000000000004, 0.3905, 1.305, 2.2725, 0.4955, 3.5365, 0.349, 0.40149999999999997, 0.6315, 2.2954999999999997, 0.40049999999999997, 2.512, 0.6659999999999999, 0.9835, 0.469, 0.415, 1.4835, 0.2565, 0.43300000000000005, 1.5185, 8.579, 0.2705, 5.255, 0.8315, 0.871, 0.618, 0.7745, 0.9075, 7.846, 16.1515, 5.015000000000001, 0.763, 2.035, 0.653, 0.7244999999999999, 0.362, 0.4855, 0.3795, 0.391, 4.497, 0.2095, 0.377, 1.476, 0.745, 0.9525000000000001, 0.371, 1.5145, 1.3210000000000002, 1.0905, 0.482, 0.41, 0.4435, 0.6025, 0.565, 0.33499999999999996, 12.02, 1.469, 1.033, 0.2525, 0.9490000000000001, 0.242, 1.9455, 6.1175, 0.568, 0.13, 0.5225, 2.5205, 1.8035, 3.2315, 0.2455, 0.3275, 12.5455, 0.891, 0.3085, 0.8420000000000001, 0.6845, 3.5774999999999997, 0.2755, 0.5275, 1.053, 0.4465, 0.5525, 7.3625, 0.907, 0.2715, 0.015, 0.4315, 6.0440000000000005, 0.434, 1.6455, 1.933, 3.1390000000000002, 0.7115, 1.0025, 0.4895, 0.5075000000000001, 4.2835, 0.714, 1.6909999999999998, 2.2735, 6.8855, 0.2825, 0.679, 0.1825, 0.8045, 2.6845, 0.3715, 0.1645, 0.2185, 0.618, 0.8895, 0.366, 2.4234999999999998, 0.417, 1.392, 0.5605, 0.4925, 0.967, 0.421, 0.365, 0.4195, 0.517, 3.596, 26.7105, 0.347, 1.923, 0.696, 0.57, 0.677, 0.443, 0.7775, 3.195, 0.4985, 1.476, 0.4375, 0.3855, 1.0350000000000001, 1.1045, 0.419, 0.40800000000000003, 3.649, 0.4425, 2.6494999999999997, 0.2585, 0.2565, 5.111, 0.20850000000000002, 0.40700000000000003, 0.147, 0.40700000000000003, 0.718, 0.6214999999999999, 1.397, 0.8694999999999999, 0.5714999999999999, 0.4675, 0.3995, 0.46599999999999997, 0.1945, 2.1870000000000003, 0.224, 0.29700000000000004, 1.6415, 0.334, 0.367, 0.4, 1.323, 4.6345, 6.038, 0.611, 0.7685, 0.3695},
+		{0.599, 0.4295, 1.0455, 0.235, 0.537, 0.2025, 1.1869999999999998, 0.666, 0.269, 0.455, 0.376, 1.033, 0.391, 0.368, 0.2705, 0.352, 0.268, 0.0935, 0.6, 0.6065, 0.3005, 0.1935, 0.5345, 0.3125, 0.158, 0.487, 0.1735, 0.324, 1.0194999999999999, 0.437, 0.459, 0.4815, 1.956, 0.3155, 0.9335, 0.5075000000000001, 0.359, 0.229, 0.5005, 0.52, 0.368, 0.40349999999999997, 0.5765, 7.703, 0.4295, 3.9924999999999997, 0.619, 0.487, 0.603, 0.4335, 0.183, 4.2805, 15.869, 2.4275, 0.682, 0.305, 0.3795, 0.6775, 0.4315, 0.359, 0.2415, 0.4765, 2.2445, 0.3965, 0.487, 0.3705, 0.457, 0.3915, 0.2895, 0.3885, 0.3965, 0.569, 0.635, 0.4715, 0.223, 0.657, 0.4185, 0.371, 14.014, 0.465, 0.9355, 0.3755, 0.8895, 0.35, 0.491, 0.642, 0.6275, 0.313, 0.387, 1.5905, 0.6255, 0.628, 0.4525, 0.41300000000000003, 7.68, 0.5145, 0.428, 0.6895, 0.533, 0.8365, 0.4225, 0.453, 0.8705, 0.446, 0.3505, 6.787, 0.429, 0.3835, 0.5415, 0.036, 0.8805000000000001, 0.1475, 0.584, 0.238, 0.982, 0.2455, 0.156, 0.5615, 0.29, 0.6214999999999999, 0.4195, 0.9215, 0.6565, 4.3275, 0.437, 0.5265, 0.3875, 0.899, 0.5315000000000001, 0.40900000000000003, 0.3235, 0.47050000000000003, 0.35050000000000003, 0.4735, 0.254, 0.2905, 0.241, 0.7164999999999999, 0.5015000000000001, 0.174, 0.9795, 0.196, 0.182, 0.1615, 0.3415, 1.4115, 28.3575, 0.2385, 0.527, 0.5585, 0.353, 0.596, 0.2285, 0.1755, 2.7115, 0.205, 0.6255, 0.2715, 0.212, 0.5625, 0.602, 0.20350000000000001, 0.4575, 0.2785, 0.265, 0.246, 0.365, 0.4115, 1.5939999999999999, 0.34850000000000003, 0.221, 0.3285, 0.1775, 0.6984999999999999, 0.248, 0.5965, 0.481, 0.3325, 0.2345, 0.2105, 0.244, 0.385, 1.927, 0.3835, 0.4635, 1.2795, 0.0, 0.3625, 0.1755, 0.5925, 3.2779999999999996, 3.8259999999999996, 0.5774999999999999, 0.3815, 0.40800000000000003},
+		{1.306, 1.0295, 1.3305, 0.663, 0.8140000000000001, 0.8785000000000001, 1.4500000000000002, 1.3115, 0.8935, 1.488, 0.9955, 1.9529999999999998, 0.856, 1.168, 0.7044999999999999, 0.835, 0.762, 0.9524999999999999, 0.8425, 1.126, 0.9065000000000001, 0.8365, 1.2814999999999999, 0.933, 0.9655, 0.8945000000000001, 1.119, 0.7175, 1.1815, 0.8065, 0.9335, 1.1815, 2.5035, 0.8945000000000001, 1.4144999999999999, 0.7115, 0.765, 0.944, 1.021, 1.0314999999999999, 0.7505, 0.895, 1.064, 4.436999999999999, 0.7464999999999999, 4.6625, 0.9575, 0.9884999999999999, 2.292, 0.9404999999999999, 0.7715000000000001, 5.7684999999999995, 15.3025, 3.7779999999999996, 0.703, 1.138, 0.7015, 1.0375, 0.9550000000000001, 0.98, 0.735, 0.8085, 3.3165, 0.803, 0.7605, 0.9295, 0.872, 0.7695000000000001, 0.793, 0.8965000000000001, 0.888, 1.4224999999999999, 0.9305000000000001, 0.8725, 0.7615000000000001, 0.8280000000000001, 0.7675000000000001, 1.0095, 13.18, 0.9704999999999999, 1.3105, 0.8425, 1.1675, 0.862, 1.0345, 0.874, 0.9975, 0.826, 0.874, 2.118, 1.1175000000000002, 0.7955000000000001, 0.764, 0.876, 10.286999999999999, 0.9215, 0.962, 0.9475, 0.9530000000000001, 1.7850000000000001, 0.8714999999999999, 1.0925, 4.4045000000000005, 0.864, 0.7444999999999999, 8.3195, 0.842, 0.903, 2.36, 0.855, 0.0235, 1.0190000000000001, 1.145, 0.913, 2.207, 0.8745, 0.8374999999999999, 0.869, 0.875, 2.8265000000000002, 1.063, 1.4660000000000002, 1.702, 4.7915, 0.9994999999999999, 0.734, 0.767, 0.7845, 0.9975, 0.91, 0.807, 1.21, 0.8314999999999999, 0.753, 0.7955000000000001, 1.1099999999999999, 0.8985, 1.1465, 0.8254999999999999, 0.7, 1.2535, 0.8025, 0.844, 0.7444999999999999, 0.9735, 1.658, 25.433999999999997, 0.6705000000000001, 1.4695, 0.776, 0.889, 0.734, 0.792, 0.6745000000000001, 3.251, 0.7945, 1.0925, 0.881, 1.181, 1.054, 0.849, 0.8674999999999999, 0.8140000000000001, 1.315, 0.8140000000000001, 1.6735, 0.9464999999999999, 0.8285, 17.228, 0.8494999999999999, 0.855, 0.7605, 0.668, 1.6444999999999999, 0.965, 1.4695, 0.978, 0.92, 0.733, 0.887, 0.733, 0.913, 2.2215, 0.953, 1.0314999999999999, 1.687, 0.0, 0.8905000000000001, 0.7845, 1.5735, 3.867, 4.4585, 0.9125000000000001, 1.1615, 0.9584999999999999},
+		{0.5155000000000001, 0.397, 0.9504999999999999, 0.259, 0.4785, 0.232, 1.201, 0.586, 0.296, 0.482, 0.325, 0.5954999999999999, 0.406, 0.381, 0.2325, 0.305, 0.216, 0.1225, 0.6225, 0.5435000000000001, 0.2405, 0.2235, 0.6415, 0.2545, 0.158, 0.4265, 0.255, 0.2255, 0.8235, 0.40549999999999997, 0.4225, 0.47, 1.867, 0.288, 2.1595, 0.4245, 0.3325, 0.288, 0.489, 0.511, 0.4195, 0.3625, 0.5125, 7.1155, 0.3595, 4.1345, 0.46950000000000003, 0.5225, 0.6045, 0.47150000000000003, 0.3175, 4.577999999999999, 17.4095, 2.2875, 0.56, 0.3265, 0.388, 0.6935, 0.459, 0.421, 0.2555, 0.3475, 1.4365, 0.326, 0.459, 0.393, 0.452, 0.374, 0.307, 0.4225, 0.4385, 0.56, 0.606, 0.499, 0.2085, 0.5954999999999999, 0.35, 0.365, 12.121500000000001, 0.4415, 0.8174999999999999, 0.3735, 0.705, 0.3605, 0.501, 0.5589999999999999, 0.535, 0.328, 0.3975, 1.528, 0.6154999999999999, 0.593, 0.382, 0.3955, 9.4355, 0.35250000000000004, 0.3665, 0.5435000000000001, 0.5165, 0.6859999999999999, 0.34450000000000003, 0.4185, 0.883, 0.412, 0.3815, 5.932, 0.403, 0.3545, 0.4625, 0.1765, 0.851, 0.007, 0.5295000000000001, 0.264, 1.9955, 0.2755, 0.1815, 0.496, 0.2905, 1.474, 0.3895, 0.8855, 0.641, 5.984500000000001, 0.40449999999999997, 0.5145, 0.304, 0.3255, 0.4425, 0.539, 0.3405, 0.3585, 0.35450000000000004, 0.475, 0.2375, 0.286, 0.169, 0.7655000000000001, 0.3885, 0.2375, 0.7575000000000001, 0.222, 0.1935, 0.1465, 0.3045, 1.2665, 25.7175, 0.24, 0.7435, 0.1965, 0.3695, 0.457, 0.165, 0.183, 2.8855, 0.2375, 0.6025, 0.2695, 0.191, 0.442, 0.525, 0.203, 0.4265, 0.379, 0.247, 1.3575, 0.3485, 0.365, 0.72, 0.35, 0.2305, 0.3085, 0.201, 0.6859999999999999, 0.236, 0.519, 1.9215, 0.4025, 0.1965, 0.2075, 0.272, 0.3275, 2.077, 0.333, 0.366, 0.982, 0.0, 0.225, 0.177, 0.5595000000000001, 2.8375000000000004, 6.172499999999999, 0.571, 0.3825, 0.3915},
+		{0.5435000000000001, 0.444, 0.9664999999999999, 0.503, 0.336, 0.7475, 1.0885, 0.839, 0.493, 0.6555, 0.428, 0.8855, 0.3965, 0.459, 0.5645, 0.3195, 0.4235, 0.498, 0.4965, 0.4495, 0.5335000000000001, 0.5615, 0.9675, 0.5155000000000001, 0.508, 0.321, 0.424, 0.5205, 0.7155, 0.2195, 0.361, 0.5595, 2.1159999999999997, 0.493, 1.0845, 0.617, 0.6505000000000001, 0.5575, 0.4345, 0.547, 0.3645, 0.3515, 0.4145, 3.7685, 0.31, 3.9955, 0.5814999999999999, 0.6839999999999999, 0.5295000000000001, 0.6655, 0.499, 4.559, 15.48, 3.9055, 0.556, 0.623, 0.464, 0.587, 0.3095, 0.575, 0.5085, 0.28300000000000003, 1.992, 0.388, 0.287, 0.414, 0.6855, 0.3425, 0.538, 0.6165, 0.454, 0.603, 0.551, 0.3565, 0.439, 0.475, 0.3895, 0.368, 12.4235, 0.45199999999999996, 1.073, 0.3355, 0.8254999999999999, 0.3645, 0.68, 0.4645, 0.523, 0.3805, 0.5, 1.672, 0.713, 0.486, 0.3815, 0.377, 9.9975, 0.637, 0.4205, 0.755, 0.6435, 0.5295000000000001, 0.396, 0.4005, 0.819, 0.4295, 0.451, 7.1145, 0.34, 0.3805, 2.056, 0.511, 0.9575, 0.513, 0.025500000000000002, 0.5045, 1.0165, 0.513, 0.5065, 0.403, 0.6285000000000001, 2.243, 0.47350000000000003, 0.8485, 1.133, 5.947, 0.3625, 0.4085, 0.434, 0.6355, 0.651, 0.4415, 0.396, 0.393, 0.6065, 0.629, 0.5175000000000001, 0.6034999999999999, 0.53, 0.9299999999999999, 0.679, 0.5569999999999999, 1.037, 0.4835, 0.4965, 0.577, 0.817, 1.4295, 24.2185, 0.475, 0.9119999999999999, 0.6074999999999999, 0.575, 0.4565, 0.539, 0.581, 2.6845, 0.528, 1.1404999999999998, 0.542, 0.612, 0.661, 0.6174999999999999, 0.5225, 0.283, 0.8140000000000001, 0.5055000000000001, 1.6075, 0.338, 0.40800000000000003, 0.73, 0.4425, 0.438, 0.31, 0.482, 0.7455, 0.5255000000000001, 1.0345, 1.955, 0.6205, 0.556, 0.622, 0.595, 0.3925, 2.0395000000000003, 0.402, 0.3305, 1.388, 0.0, 0.5700000000000001, 0.487, 1.1935, 2.9005, 5.1925, 0.6505000000000001, 0.708, 0.432},
+		{0.5635, 0.4655, 0.8140000000000001, 0.2785, 0.5894999999999999, 0.2755, 1.103, 0.553, 0.3485, 0.5105, 0.363, 0.939, 0.4245, 0.572, 0.3345, 0.378, 0.2585, 0.266, 0.6114999999999999, 0.587, 0.364, 0.28400000000000003, 0.5700000000000001, 0.407, 0.2585, 0.492, 0.2895, 0.3175, 1.065, 0.4525, 0.471, 0.6025, 2.1464999999999996, 0.327, 0.7335, 0.453, 0.4035, 0.3585, 0.4775, 0.6365000000000001, 0.425, 0.4135, 0.578, 7.4510000000000005, 0.429, 4.8345, 0.627, 0.4815, 0.613, 0.613, 0.2605, 4.1155, 14.0905, 1.9235, 0.62, 0.3895, 0.408, 0.5605, 0.4705, 0.3845, 0.2905, 0.4995, 1.637, 0.4365, 0.46499999999999997, 0.45, 0.48, 0.4265, 0.3725, 0.565, 0.4195, 0.5685, 0.6355, 0.489, 0.2875, 0.614, 0.4515, 0.4, 14.564, 0.4355, 1.1495, 0.395, 0.7035, 0.395, 0.697, 0.6385000000000001, 0.6245, 0.344, 0.4005, 1.6360000000000001, 0.606, 0.5885, 0.448, 0.4925, 12.584, 0.4125, 0.7404999999999999, 0.591, 0.5745, 0.831, 0.435, 0.46799999999999997, 0.8605, 0.4965, 0.3915, 6.2575, 0.462, 0.441, 2.6820000000000004, 0.2925, 0.852, 0.2785, 0.6245, 0.0315, 1.2570000000000001, 0.311, 0.272, 0.6435, 0.3755, 1.5255, 0.486, 0.801, 0.556, 5.338, 0.435, 0.533, 0.3935, 0.443, 0.47150000000000003, 0.4755, 0.418, 0.386, 0.4355, 0.6145, 0.294, 0.40049999999999997, 0.294, 0.824, 0.4655, 0.309, 0.698, 0.255, 0.229, 0.32, 0.4085, 1.5935000000000001, 28.3915, 0.265, 0.723, 0.349, 0.443, 0.566, 0.2875, 0.2855, 2.6475, 0.329, 0.64, 0.2875, 0.2485, 0.5725, 0.5545, 0.2505, 0.5215000000000001, 0.379, 0.2935, 1.315, 0.392, 0.458, 0.791, 0.388, 0.323, 0.386, 0.3035, 1.1764999999999999, 0.3315, 0.694, 0.534, 0.4285, 0.3165, 0.291, 0.425, 0.3995, 1.8559999999999999, 0.4015, 0.439, 1.564, 0.016, 0.3675, 0.288, 1.6035, 2.8045, 5.6485, 0.611, 0.5714999999999999, 0.455},
+		{2.0949999999999998, 1.0579999999999998, 2.2729999999999997, 0.9365, 1.014, 0.9995, 1.651, 1.9305, 0.702, 1.8385, 0.752, 1.3575, 0.88, 1.278, 0.9954999999999999, 0.9655, 2.1245000000000003, 0.688, 1.0405, 2.1959999999999997, 1.9955, 0.643, 0.9955, 0.88, 0.74, 1.1155, 1.6440000000000001, 0.8105, 1.7654999999999998, 0.7909999999999999, 0.9974999999999999, 1.245, 2.1355, 1.995, 1.3755000000000002, 0.9975, 0.95, 0.838, 0.7605, 1.2160000000000002, 0.7715, 0.7135, 1.3065, 7.0465, 1.0, 4.3475, 1.2605, 1.041, 0.8265, 0.979, 1.2795, 4.366, 14.336500000000001, 2.354, 0.7855000000000001, 1.1575, 0.9339999999999999, 1.9795, 0.9954999999999999, 0.9584999999999999, 0.7775000000000001, 0.9045000000000001, 2.6995, 0.9755, 0.84, 1.009, 1.0350000000000001, 1.1385, 1.088, 1.08, 1.0765, 1.2025000000000001, 0.9319999999999999, 1.6989999999999998, 0.956, 0.9225000000000001, 0.8654999999999999, 1.1065, 12.7285, 1.0979999999999999, 1.3375, 1.1480000000000001, 1.355, 0.6285000000000001, 1.23, 1.0425, 0.8714999999999999, 0.9075, 1.8565, 2.266, 1.206, 1.092, 0.952, 1.8565, 10.056000000000001, 0.8105, 1.0055, 3.682, 1.1265, 1.323, 1.8370000000000002, 1.127, 1.2205, 0.79, 1.683, 6.490500000000001, 0.8185, 0.8280000000000001, 2.8235, 0.788, 2.3985, 1.705, 1.184, 0.74, 0.0645, 0.8555, 0.763, 0.8545, 0.868, 1.4095, 1.7160000000000002, 1.625, 1.5045, 5.0355, 1.0110000000000001, 1.2694999999999999, 1.9, 1.869, 0.7715000000000001, 0.9415, 0.959, 2.1585, 1.1025, 1.0895000000000001, 0.696, 0.964, 0.6525000000000001, 1.3035, 1.0514999999999999, 0.888, 1.382, 0.8065, 0.7055, 0.875, 0.9615, 2.21, 25.9265, 0.8165, 1.5605, 0.9984999999999999, 0.844, 1.1735, 0.64, 0.774, 3.1125, 2.0985, 1.2805, 1.1404999999999998, 0.9095, 2.2375, 1.3255, 0.742, 0.8135, 1.9705, 1.825, 0.853, 0.935, 1.012, 1.647, 1.4845000000000002, 0.799, 0.8354999999999999, 1.009, 1.4785, 2.149, 1.262, 2.7175000000000002, 0.9635, 1.1655, 0.7045, 0.846, 1.0295, 2.091, 0.854, 0.8305, 1.8875, 0.0, 0.752, 0.8525, 1.392, 3.3585, 7.4985, 1.9089999999999998, 0.884, 1.3969999999999998},
+		{0.495, 0.414, 0.8375, 0.1745, 0.5195000000000001, 0.1705, 1.1324999999999998, 0.6285000000000001, 0.28400000000000003, 0.46, 0.3205, 0.9165, 0.40449999999999997, 0.39, 0.239, 0.32, 0.1535, 0.17099999999999999, 0.6074999999999999, 0.554, 0.3125, 0.202, 0.567, 0.2915, 0.1595, 0.4265, 0.2255, 0.318, 1.1095000000000002, 0.3565, 0.395, 0.532, 2.0285, 0.2575, 0.9045, 0.44, 0.3915, 0.3255, 0.46799999999999997, 0.565, 0.4355, 0.3755, 0.507, 7.7669999999999995, 0.418, 4.0975, 0.6755, 0.556, 0.4915, 0.486, 0.6085, 4.651, 11.259, 2.8345000000000002, 0.6174999999999999, 0.363, 0.3115, 0.7004999999999999, 0.416, 0.379, 0.159, 0.40249999999999997, 1.7155, 0.40449999999999997, 0.433, 0.371, 0.4245, 0.363, 0.3245, 0.4175, 0.3265, 0.4645, 0.6145, 0.4545, 0.1805, 0.439, 0.3185, 0.334, 11.993, 0.3665, 0.7745, 0.3385, 0.8025, 0.3745, 0.5265, 0.585, 0.522, 0.2895, 0.3745, 1.487, 0.6735, 0.628, 0.371, 0.43, 11.562999999999999, 0.303, 0.353, 3.1835, 0.5375000000000001, 0.902, 0.4165, 0.4315, 0.8125, 0.3915, 0.344, 6.365, 0.435, 0.363, 0.4505, 0.22999999999999998, 0.893, 0.23349999999999999, 0.5734999999999999, 0.255, 1.0165, 0.005, 0.2195, 0.4665, 0.399, 2.3735, 0.4005, 0.958, 0.71, 5.1695, 0.395, 0.5495000000000001, 0.3085, 0.393, 0.501, 0.4135, 0.3225, 0.378, 0.386, 0.5785, 0.211, 0.383, 0.21, 1.1155, 0.628, 0.226, 0.912, 0.1705, 0.1385, 0.2125, 0.317, 1.5065, 25.7635, 0.1355, 0.9595, 1.3835, 0.421, 0.4865, 0.202, 0.221, 2.7625, 0.324, 0.649, 0.2105, 0.3205, 0.5734999999999999, 0.55, 0.1815, 0.424, 0.375, 0.2155, 0.1735, 0.339, 0.396, 0.6765000000000001, 0.28600000000000003, 0.277, 0.3185, 0.222, 0.7845, 0.211, 0.513, 0.5680000000000001, 0.4635, 0.257, 0.14, 0.2515, 0.329, 1.9825, 0.34199999999999997, 0.3725, 1.5259999999999998, 0.0, 0.40900000000000003, 0.2085, 0.8274999999999999, 3.2640000000000002, 5.965999999999999, 0.6395, 0.515, 0.4025},
+		{1.8295, 3.0425, 2.95, 2.4450000000000003, 2.1305, 0.194, 3.7960000000000003, 2.4815, 1.761, 3.3825000000000003, 2.5300000000000002, 4.029, 2.4015, 2.9604999999999997, 2.179, 2.8895, 1.8495, 1.958, 1.858, 2.2800000000000002, 2.2024999999999997, 2.4429999999999996, 2.5694999999999997, 2.2515, 2.234, 2.1155, 2.6470000000000002, 1.956, 3.0705, 2.067, 1.791, 2.5069999999999997, 2.4835000000000003, 1.963, 2.918, 2.4665, 2.4775, 1.815, 2.8295000000000003, 2.5229999999999997, 2.1025, 2.3335, 2.5285, 7.6625, 2.2655, 4.8895, 2.0860000000000003, 1.679, 2.9465, 1.978, 2.7125, 5.209, 14.4695, 4.5335, 2.9205, 2.525, 2.0805, 2.5525, 2.325, 2.2165, 1.9085, 3.1355, 3.6050000000000004, 1.9995, 2.766, 2.0355, 2.0345, 2.2960000000000003, 2.189, 2.4325, 2.0620000000000003, 2.6065, 2.5324999999999998, 2.2295, 2.4745, 2.1275, 2.009, 2.1254999999999997, 11.937999999999999, 3.3715, 1.162, 2.2969999999999997, 2.3890000000000002, 2.8505000000000003, 2.8920000000000003, 2.3005000000000004, 3.217, 2.4619999999999997, 1.9555, 3.405, 2.5585, 2.316, 2.2785, 2.5445, 7.605, 2.615, 1.7734999999999999, 2.718, 1.9184999999999999, 2.608, 1.686, 2.372, 2.9555, 2.7325, 2.4945, 7.3815, 2.534, 2.1315, 2.3274999999999997, 2.4379999999999997, 2.738, 2.1265, 2.985, 2.2765, 2.8, 2.0365, 1.601, 2.5075, 2.365, 2.9245, 2.1235, 2.364, 2.142, 5.3535, 2.3625, 2.0095, 2.2960000000000003, 2.1585, 2.3970000000000002, 2.5795, 2.215, 2.592, 2.6885, 2.553, 2.675, 2.097, 2.259, 2.4625, 2.509, 2.248, 2.264, 2.7874999999999996, 1.9555, 2.107, 1.0574999999999999, 3.862, 26.401, 2.6205, 2.271, 2.499, 2.6879999999999997, 1.8385, 1.929, 2.814, 4.0255, 2.6715, 1.4505, 2.3434999999999997, 2.0895, 2.3935, 2.331, 1.9445000000000001, 2.058, 2.929, 2.029, 2.909, 2.013, 2.3325, 3.9210000000000003, 2.4165, 2.518, 2.3365, 1.769, 2.402, 1.97, 1.826, 2.2795, 2.3819999999999997, 2.2359999999999998, 0.6825, 2.7795, 1.9264999999999999, 2.8415, 2.2569999999999997, 2.6185, 2.654, 0.0, 2.0105, 2.096, 3.2335, 3.743, 7.513999999999999, 1.9940000000000002, 2.758, 2.0004999999999997},
+		{0.5615, 0.503, 0.9015, 0.5385, 0.177, 0.561, 0.913, 0.726, 0.49, 0.519, 0.4595, 0.713, 0.443, 0.4385, 0.5215000000000001, 0.3655, 0.486, 0.4845, 0.4255, 0.55, 0.5425, 0.5045, 0.666, 0.6839999999999999, 0.5745, 0.4025, 0.508, 0.568, 0.6825, 0.3405, 0.425, 0.626, 1.9995, 0.5695, 0.768, 0.4285, 0.587, 0.5095000000000001, 0.5185, 0.5565, 0.445, 0.503, 0.404, 2.803, 0.3735, 4.0375, 0.398, 0.729, 0.576, 0.6930000000000001, 0.553, 3.939, 12.8765, 2.0925, 0.512, 0.7235, 0.481, 0.4645, 0.382, 0.5045, 0.5385, 0.364, 2.0985, 0.3925, 0.312, 0.4685, 0.4105, 0.397, 0.5814999999999999, 0.63, 0.502, 0.7225, 0.6325000000000001, 0.534, 0.512, 0.307, 0.372, 0.443, 12.275500000000001, 0.529, 0.6034999999999999, 0.425, 0.846, 0.444, 0.569, 0.2885, 0.40249999999999997, 0.40349999999999997, 0.625, 1.8170000000000002, 0.738, 0.2285, 0.368, 0.41200000000000003, 8.551, 0.6255, 0.4755, 6.383, 0.661, 0.553, 0.4275, 0.4345, 0.8674999999999999, 0.4165, 0.526, 5.7115, 0.4325, 0.3735, 0.5765, 0.6245, 0.889, 0.4635, 0.434, 0.579, 0.9135, 0.5135000000000001, 0.54, 0.007, 0.535, 1.537, 0.5505, 0.7415, 0.864, 5.3425, 0.384, 0.402, 0.366, 0.437, 0.672, 0.492, 0.399, 0.4685, 0.58, 0.5145, 0.484, 0.5885, 0.5754999999999999, 0.848, 0.6910000000000001, 0.4645, 1.0015, 0.485, 0.555, 0.4635, 0.6105, 1.319, 27.563, 0.40349999999999997, 0.9615, 0.46950000000000003, 0.5925, 0.33999999999999997, 0.5315000000000001, 0.606, 2.9445, 0.5785, 0.778, 0.665, 0.5805, 0.5934999999999999, 0.4725, 0.5245, 0.377, 0.6655, 0.5385, 1.2854999999999999, 0.415, 0.469, 0.4125, 0.3785, 0.505, 0.4415, 0.459, 5.975, 0.5595000000000001, 0.707, 0.679, 0.6585000000000001, 0.5235000000000001, 0.46699999999999997, 0.566, 0.439, 1.7625, 0.4395, 0.4595, 1.322, 0.0, 0.499, 0.5265, 0.9775, 3.409, 3.3609999999999998, 0.4805, 0.609, 0.4865},
+		{0.565, 0.5165, 1.108, 0.337, 0.602, 0.389, 1.287, 0.7755000000000001, 0.4275, 0.584, 0.466, 0.8895, 0.47050000000000003, 0.6165, 0.334, 0.481, 0.3745, 0.2735, 0.709, 0.627, 0.381, 0.415, 0.6745000000000001, 0.4405, 0.2905, 0.5734999999999999, 0.354, 0.3995, 0.784, 0.5475000000000001, 0.491, 0.7070000000000001, 2.0995, 0.4015, 0.6165, 0.5505, 0.441, 0.441, 0.555, 0.667, 0.494, 0.493, 0.661, 6.640000000000001, 0.475, 4.4915, 0.67, 0.6575, 0.7164999999999999, 0.548, 0.8935, 5.5115, 15.2005, 3.0060000000000002, 0.668, 0.4745, 0.4485, 0.7635000000000001, 0.523, 0.437, 0.341, 0.522, 2.668, 0.476, 0.46950000000000003, 0.4935, 0.4945, 0.491, 0.362, 0.513, 0.4315, 0.773, 0.804, 0.556, 0.3735, 0.61, 0.588, 0.479, 12.221, 0.523, 0.9125000000000001, 0.5195000000000001, 0.801, 0.49, 0.556, 0.612, 0.5505, 0.446, 0.4255, 1.5755, 0.666, 0.6425000000000001, 0.4825, 0.521, 6.571, 0.396, 2.52, 3.572, 0.651, 0.7605, 0.5035000000000001, 0.517, 0.91, 0.4655, 0.4775, 7.1585, 0.551, 0.5105, 0.5765, 0.3505, 0.952, 0.318, 0.573, 0.431, 1.7135, 0.467, 0.328, 0.631, 0.0275, 1.045, 0.507, 0.909, 0.7435, 5.4190000000000005, 0.501, 0.657, 0.44, 0.5085, 0.538, 0.564, 0.465, 0.47550000000000003, 0.433, 0.659, 0.348, 0.394, 0.269, 0.861, 0.474, 0.3, 0.8765000000000001, 0.313, 0.27649999999999997, 0.3665, 0.3995, 1.7315, 28.978, 0.3735, 0.758, 0.40549999999999997, 0.4005, 0.5385, 0.3285, 0.3015, 2.636, 0.353, 0.605, 0.408, 0.396, 0.666, 0.674, 0.3785, 0.5285, 0.4975, 0.399, 1.4985, 0.4265, 0.453, 0.8035000000000001, 0.4595, 0.3655, 0.419, 0.381, 0.9039999999999999, 0.378, 0.6325000000000001, 0.5685, 0.5105, 0.3695, 0.3155, 0.363, 0.5025, 1.7225000000000001, 0.49, 0.5055000000000001, 1.3855, 0.0, 0.3635, 0.3335, 0.778, 3.068, 7.859500000000001, 0.5665, 0.6125, 0.4845},
+		{2.5765000000000002, 2.7485, 2.306, 2.182, 1.3815, 1.1485, 2.11, 2.1665, 1.0735000000000001, 3.2845000000000004, 1.342, 4.336, 0.5405, 2.0180000000000002, 1.584, 1.5594999999999999, 0.9185, 0.614, 1.1575, 2.492, 2.2729999999999997, 1.8975, 2.1685, 2.3120000000000003, 0.741, 3.2015, 2.7205, 1.362, 3.2635, 1.3984999999999999, 2.2515, 2.1959999999999997, 3.058, 1.804, 2.935, 1.482, 1.668, 1.3855, 1.8835000000000002, 2.875, 2.1665, 2.4705, 1.862, 9.497499999999999, 0.9754999999999999, 5.198, 1.6015, 1.6355, 2.6195000000000004, 1.675, 2.2735000000000003, 5.7780000000000005, 16.694000000000003, 4.293, 2.7875, 3.8045, 1.6975, 2.105, 0.9375, 1.8584999999999998, 0.946, 1.4369999999999998, 3.82, 2.3064999999999998, 1.6505, 2.6905, 2.2359999999999998, 1.8605, 0.981, 1.837, 2.2465, 2.708, 3.3315, 1.241, 2.617, 2.152, 1.6829999999999998, 1.8025000000000002, 13.397, 1.9295, 1.96, 1.5025, 3.9335, 1.8435, 1.8155000000000001, 2.2045, 1.847, 1.6635, 1.274, 3.021, 2.8105, 1.9220000000000002, 1.9024999999999999, 2.496, 12.461, 2.127, 2.362, 3.0860000000000003, 1.5915, 2.129, 1.901, 1.6520000000000001, 2.1079999999999997, 2.75, 1.2005, 7.3065, 2.0025, 1.534, 3.8705, 1.273, 2.8035, 2.605, 1.758, 0.853, 3.3945, 1.49, 1.3565, 1.194, 1.2385, 0.2445, 1.474, 2.431, 1.4715, 6.6205, 1.289, 2.378, 2.004, 2.0410000000000004, 1.553, 1.3995000000000002, 1.4340000000000002, 2.1630000000000003, 1.0325, 2.637, 2.637, 1.5139999999999998, 3.1835, 2.3369999999999997, 2.6345, 1.2035, 2.8890000000000002, 1.639, 1.627, 2.6045, 1.851, 3.364, 28.1475, 1.1680000000000001, 1.5735000000000001, 2.0484999999999998, 1.4205, 1.2919999999999998, 0.7070000000000001, 2.6685, 3.2375, 2.1925, 1.249, 0.9405, 1.6895, 2.3129999999999997, 1.024, 2.2355, 1.1895, 1.9885, 1.3264999999999998, 2.2245, 1.3639999999999999, 2.021, 5.1240000000000006, 1.7934999999999999, 2.058, 1.8875, 0.72, 3.0484999999999998, 1.5590000000000002, 2.0635000000000003, 2.4255, 0.9949999999999999, 1.0105, 1.028, 1.251, 0.74, 2.7960000000000003, 2.71, 1.884, 2.7800000000000002, 0.0, 1.644, 1.7125, 1.635, 4.496, 8.082, 2.048, 1.1549999999999998, 0.7915000000000001},
+		{0.927, 0.302, 0.9644999999999999, 0.3675, 0.5025, 0.3645, 1.017, 0.604, 0.456, 0.3825, 0.1945, 0.8109999999999999, 0.28, 0.2885, 0.3765, 0.213, 0.422, 0.423, 0.512, 0.5914999999999999, 0.4335, 0.3845, 0.686, 0.4855, 0.3965, 0.3745, 0.377, 0.447, 0.8805000000000001, 0.3265, 0.2775, 0.4065, 2.1765, 0.47150000000000003, 0.6705000000000001, 0.644, 0.509, 0.5065, 0.4445, 0.483, 0.263, 0.228, 0.41600000000000004, 6.646, 0.273, 4.23, 0.6825000000000001, 0.594, 0.5485, 0.587, 0.4235, 4.2995, 11.8905, 4.15, 0.5565, 0.5075000000000001, 0.279, 0.6765000000000001, 0.335, 0.4865, 0.365, 0.3285, 2.3545, 0.23, 0.322, 0.307, 0.605, 0.322, 0.416, 0.555, 0.3465, 0.5135000000000001, 0.5555, 0.383, 0.389, 0.54, 0.212, 0.2855, 13.931999999999999, 0.287, 0.7284999999999999, 0.3155, 0.716, 0.2005, 0.6625000000000001, 0.5195000000000001, 0.5015000000000001, 0.222, 0.442, 1.728, 0.745, 0.5435000000000001, 0.2955, 0.3275, 12.658999999999999, 0.494, 0.4235, 5.375, 0.6014999999999999, 0.8160000000000001, 0.3075, 0.256, 0.817, 0.2865, 0.27149999999999996, 6.633, 0.322, 0.284, 0.46099999999999997, 0.397, 0.944, 0.36, 0.394, 0.3925, 2.158, 0.41, 0.3265, 0.495, 0.528, 2.6855, 0.01, 0.7935, 0.8625, 5.5855, 0.2865, 0.6154999999999999, 0.20700000000000002, 0.462, 0.559, 0.3255, 0.238, 0.1895, 0.4315, 0.6525000000000001, 0.3675, 0.469, 0.404, 0.8895, 0.58, 0.3825, 0.8625, 0.3685, 0.361, 0.4355, 0.4605, 1.8415, 26.932000000000002, 0.3775, 0.7144999999999999, 1.0270000000000001, 0.5225, 0.61, 0.388, 0.4245, 2.558, 0.471, 0.7985, 0.4775, 0.391, 0.44299999999999995, 0.65, 0.354, 0.3665, 0.5994999999999999, 0.3375, 1.179, 0.24, 0.2535, 0.927, 0.237, 0.41, 0.217, 0.42, 0.7665, 0.419, 0.6345000000000001, 1.3415, 0.5155000000000001, 0.4075, 0.333, 0.47150000000000003, 0.1845, 1.9224999999999999, 0.253, 0.292, 1.2385000000000002, 0.0535, 0.3475, 0.3665, 0.905, 3.1630000000000003, 6.0715, 0.7, 0.5714999999999999, 0.272},
+		{1.4729999999999999, 1.3504999999999998, 1.3435000000000001, 0.688, 0.7244999999999999, 2.0945, 1.6505, 1.326, 0.9299999999999999, 1.142, 1.803, 1.883, 0.829, 0.7965, 0.9, 0.8320000000000001, 0.7655000000000001, 1.0819999999999999, 1.502, 1.009, 0.7105, 0.8015, 1.385, 0.9949999999999999, 0.722, 0.7995, 0.8685, 0.7985, 2.6545, 0.652, 0.6495, 1.0915, 2.7705, 0.887, 2.2555, 1.8155000000000001, 0.8975, 1.0345, 0.7215, 0.915, 0.7985, 0.709, 1.033, 5.1345, 0.715, 4.6225000000000005, 1.5935000000000001, 1.5715, 0.867, 1.1669999999999998, 1.452, 4.8815, 18.0035, 3.738, 1.0325, 1.005, 0.911, 1.212, 0.763, 0.9165000000000001, 0.7175, 0.6165, 2.4625, 1.068, 0.5195000000000001, 0.6455, 0.8254999999999999, 0.9584999999999999, 0.721, 1.1985000000000001, 0.877, 1.2695, 0.81, 0.736, 0.7789999999999999, 0.9365, 0.748, 0.769, 12.456, 1.1465, 2.2615, 0.7195, 1.1335, 0.7805, 1.0185, 1.5045, 1.8065, 0.7965, 0.7335, 2.1685, 1.0619999999999998, 0.8734999999999999, 0.648, 0.8514999999999999, 8.826, 0.9390000000000001, 1.1059999999999999, 1.146, 1.0405, 1.468, 0.88, 0.9530000000000001, 1.413, 1.375, 0.694, 7.5025, 0.7865, 0.776, 4.0995, 0.6855, 2.0679999999999996, 0.854, 0.8425, 0.797, 1.4865, 1.0255, 0.776, 0.889, 0.9410000000000001, 4.25, 1.3175, 0.02, 2.059, 6.0365, 0.7495, 0.9035, 1.061, 1.0585, 0.87, 0.6495, 0.6615, 0.9235, 1.2525, 1.3075, 0.7905, 0.9455, 0.714, 1.354, 0.8165, 0.7575000000000001, 1.2075, 0.884, 0.7925, 1.495, 2.1215, 3.5335, 30.843, 1.088, 1.355, 1.546, 0.7575000000000001, 0.751, 0.7935, 0.7050000000000001, 3.064, 0.959, 2.154, 0.7585, 1.158, 1.021, 1.2555, 0.779, 0.9510000000000001, 1.3685, 2.6635, 2.027, 0.876, 1.0215, 2.0685000000000002, 0.742, 0.907, 0.696, 0.8845000000000001, 1.865, 0.971, 2.207, 3.822, 1.017, 1.019, 1.8255, 1.366, 0.8345, 2.316, 0.647, 0.8065, 1.7655, 0.0, 0.8939999999999999, 0.712, 2.4210000000000003, 3.484, 5.021, 0.785, 1.54, 0.7224999999999999},
+		{0.73, 0.854, 1.2805, 0.47850000000000004, 0.652, 1.6920000000000002, 1.3319999999999999, 0.894, 0.626, 0.9744999999999999, 0.5245, 1.549, 0.657, 0.7070000000000001, 0.48450000000000004, 0.583, 0.45199999999999996, 0.7055, 0.723, 0.8045, 0.5275000000000001, 0.4525, 0.874, 0.8240000000000001, 0.758, 0.7775000000000001, 1.0350000000000001, 0.6225, 1.17, 0.611, 0.71, 0.8380000000000001, 2.049, 0.5375000000000001, 1.1955, 0.667, 0.628, 0.5385, 0.8385, 0.8995, 0.5545, 0.5665, 1.141, 6.282500000000001, 0.5965, 4.1305, 0.642, 0.837, 0.845, 0.821, 1.0265, 4.022, 13.329, 3.105, 0.753, 0.9315, 0.6915, 0.8775, 0.618, 0.57, 1.4809999999999999, 0.5774999999999999, 2.0465, 0.7215, 0.621, 0.544, 0.6625000000000001, 0.658, 0.47250000000000003, 1.0935000000000001, 0.9145, 1.0295, 0.776, 0.7004999999999999, 0.5605, 0.734, 0.605, 0.539, 11.8905, 0.696, 1.499, 0.5794999999999999, 1.054, 0.6755, 1.0135, 0.793, 0.848, 0.512, 0.4985, 2.3495, 0.8135, 0.814, 0.565, 0.5874999999999999, 12.6455, 0.674, 0.5694999999999999, 1.139, 0.669, 1.2635, 0.6685000000000001, 0.668, 1.158, 0.797, 0.591, 6.8260000000000005, 0.6695, 0.6995, 1.71, 0.46799999999999997, 1.4545, 0.5105, 0.9525, 0.4565, 1.373, 0.578, 0.381, 0.6890000000000001, 0.6425000000000001, 1.7345000000000002, 0.6825, 1.3275000000000001, 0.02, 5.1434999999999995, 0.5585, 0.675, 0.6785, 0.5085, 0.716, 0.608, 0.7535000000000001, 0.608, 0.9145, 0.762, 0.5974999999999999, 1.0685, 0.499, 1.2105000000000001, 0.7645, 0.499, 1.2745, 0.4305, 0.4095, 0.5365, 0.7095, 2.051, 28.603, 0.39749999999999996, 1.326, 0.6485000000000001, 0.5365, 0.6985, 0.5865, 0.597, 2.9234999999999998, 0.6345000000000001, 1.3319999999999999, 0.5335000000000001, 0.558, 0.92, 0.73, 0.5654999999999999, 0.591, 0.885, 0.4995, 1.5899999999999999, 0.5555000000000001, 0.5914999999999999, 0.962, 0.5765, 0.479, 0.474, 0.42700000000000005, 1.6305, 0.5934999999999999, 1.4425, 0.76, 0.6025, 0.5655, 0.866, 0.754, 0.52, 2.295, 0.646, 0.8029999999999999, 1.263, 0.0, 0.8140000000000001, 0.445, 1.327, 3.0865, 4.986, 0.826, 0.5665, 0.642},
+		{0.951, 1.3975, 1.548, 0.8614999999999999, 1.4005, 0.9345, 1.754, 1.1764999999999999, 0.9235, 1.8780000000000001, 0.952, 2.1390000000000002, 0.9955, 1.4409999999999998, 1.435, 0.984, 0.869, 0.915, 1.0474999999999999, 1.213, 2.3515, 0.958, 1.2095, 2.387, 1.2015, 2.6165000000000003, 1.0085, 0.8985000000000001, 2.6305, 1.617, 0.7295, 1.536, 2.394, 1.7605, 3.0215, 1.012, 0.91, 1.4705, 1.0459999999999998, 1.1280000000000001, 0.8175, 2.1405000000000003, 1.2000000000000002, 3.824, 0.741, 4.542, 0.973, 1.2055, 1.0565, 1.388, 0.9135, 4.605499999999999, 15.709999999999999, 5.5925, 1.299, 1.3395000000000001, 0.956, 1.296, 0.8885000000000001, 0.866, 1.7245, 0.7545, 2.261, 2.127, 2.1710000000000003, 2.508, 0.7575000000000001, 0.895, 2.2785, 1.3165, 0.785, 1.3465, 1.599, 1.8105, 1.039, 0.994, 0.9695, 0.8035, 11.2395, 1.2495, 1.189, 2.2545, 1.355, 0.922, 1.1345, 9.099, 2.4195, 0.914, 0.9644999999999999, 2.2060000000000004, 2.4825, 0.832, 0.957, 2.121, 9.935, 1.104, 1.4, 1.732, 0.9425, 2.1275, 0.9724999999999999, 2.359, 1.033, 2.121, 1.025, 7.140499999999999, 2.154, 2.1145, 1.4180000000000001, 1.01, 2.8, 2.2104999999999997, 2.4005, 1.0645, 1.325, 0.835, 1.1960000000000002, 1.0105, 1.725, 3.115, 1.2000000000000002, 2.443, 2.0285, 0.022, 2.0650000000000004, 1.6415, 1.01, 2.1035, 0.9624999999999999, 0.792, 2.3515, 1.7135, 1.3765, 2.9585, 1.4, 1.278, 0.981, 1.3425, 0.9735, 1.0179999999999998, 1.539, 0.915, 0.861, 1.0979999999999999, 0.8815, 3.3040000000000003, 26.6425, 2.3185000000000002, 1.845, 1.8275, 1.1265, 0.9884999999999999, 1.0835, 1.15, 3.082, 1.0335, 1.1804999999999999, 0.968, 1.116, 1.197, 1.863, 2.0300000000000002, 1.2025000000000001, 1.7065000000000001, 0.8694999999999999, 1.923, 1.006, 1.6070000000000002, 3.0345, 1.129, 1.73, 0.9515, 1.0554999999999999, 1.4129999999999998, 0.958, 1.415, 1.1755, 1.017, 0.8325, 3.782, 1.3005, 1.0505, 2.926, 0.962, 2.3914999999999997, 2.3289999999999997, 0.0, 1.161, 0.925, 1.6335000000000002, 4.372, 4.4715, 0.8965000000000001, 1.474, 0.9655},
+		{0.444, 0.33, 0.9610000000000001, 0.3745, 0.397, 0.379, 1.1435, 0.6435, 0.401, 0.3145, 0.213, 0.631, 0.3175, 0.309, 0.386, 0.2925, 0.3415, 0.384, 0.533, 0.376, 0.4435, 0.421, 0.6165, 0.484, 0.3075, 0.277, 0.41500000000000004, 0.401, 1.0510000000000002, 0.2735, 0.2935, 0.4235, 1.8704999999999998, 0.4195, 0.8140000000000001, 0.4855, 0.4655, 0.4265, 0.3935, 0.451, 0.237, 0.252, 0.306, 5.4105, 0.195, 3.925, 0.627, 0.615, 0.4665, 0.6405000000000001, 0.4185, 3.8045, 17.177, 2.4785, 0.508, 0.6005, 0.246, 0.566, 0.331, 0.5035000000000001, 0.394, 0.293, 2.2195, 0.2055, 0.2105, 0.2855, 0.495, 0.2535, 0.464, 0.5415, 0.2485, 0.49050000000000005, 0.4655, 0.37, 0.3795, 0.5415, 0.2845, 0.2475, 12.440999999999999, 0.2455, 0.713, 0.2105, 0.7905, 0.279, 0.6405000000000001, 5.7955, 0.451, 0.2345, 0.4615, 1.374, 0.7104999999999999, 0.556, 0.247, 0.2785, 9.285, 0.522, 0.287, 0.7184999999999999, 0.61, 0.794, 0.2465, 0.222, 0.7955000000000001, 0.2645, 0.256, 7.7565, 0.2485, 0.199, 0.318, 0.4265, 0.942, 0.375, 0.373, 0.4465, 1.373, 0.4355, 0.2945, 0.4375, 0.498, 1.25, 0.3135, 0.7064999999999999, 0.666, 4.7875, 0.0155, 0.457, 0.41100000000000003, 0.4665, 0.473, 0.405, 0.2295, 0.2485, 0.5025, 0.657, 0.3485, 0.4975, 0.376, 0.7515000000000001, 0.5115000000000001, 0.48, 1.0114999999999998, 0.38, 0.349, 0.452, 0.4435, 1.638, 26.366, 0.3605, 0.7435, 0.4195, 0.533, 0.517, 0.45299999999999996, 0.4355, 2.7805, 0.46, 0.625, 0.409, 0.392, 0.6, 0.5694999999999999, 0.336, 0.2945, 0.584, 0.4155, 1.072, 0.2145, 0.227, 0.5834999999999999, 0.262, 0.439, 0.1755, 0.346, 0.6839999999999999, 0.434, 0.661, 0.9824999999999999, 0.5685, 0.431, 0.3485, 0.442, 0.2785, 1.7959999999999998, 0.2375, 0.2025, 1.3385, 0.0, 0.3585, 0.385, 0.7215, 2.8445, 6.308, 0.683, 0.53, 0.2645},
+		{0.6054999999999999, 0.49, 1.189, 0.6045, 0.40549999999999997, 0.6025, 0.962, 0.7275, 0.6125, 0.5954999999999999, 0.537, 0.841, 0.505, 0.6455, 0.4915, 0.497, 0.5934999999999999, 0.525, 0.2805, 0.643, 0.5814999999999999, 0.6355, 0.7635000000000001, 0.6815, 0.5640000000000001, 0.46399999999999997, 0.547, 0.6005, 0.821, 0.418, 0.5315000000000001, 0.652, 2.137, 0.849, 1.274, 4.1255, 0.668, 0.61, 0.634, 0.612, 0.493, 0.512, 0.5934999999999999, 2.4725, 0.4555, 3.7830000000000004, 0.4115, 0.675, 0.33, 0.7515000000000001, 0.5175000000000001, 3.9615, 14.345500000000001, 6.6915, 0.3695, 0.7415, 0.5640000000000001, 0.499, 0.4215, 0.698, 0.5754999999999999, 0.4135, 3.012, 0.5685, 0.5035000000000001, 0.58, 0.3665, 0.49, 0.4475, 0.743, 0.5525, 0.667, 0.6905, 0.5285, 0.56, 0.4475, 0.4905, 0.5485, 14.161000000000001, 0.514, 0.6074999999999999, 0.5165, 0.842, 0.455, 1.1915, 0.33999999999999997, 0.4985, 0.549, 0.5674999999999999, 1.7035, 0.9135, 0.53, 0.4845, 0.537, 7.842499999999999, 0.675, 0.59, 5.5329999999999995, 0.7284999999999999, 0.833, 0.5595, 0.552, 0.652, 0.496, 0.5654999999999999, 6.8625, 0.4905, 0.4815, 0.704, 0.5725, 0.864, 0.576, 0.6255, 0.537, 1.068, 0.599, 0.531, 0.3435, 0.6765000000000001, 1.487, 0.607, 0.837, 0.973, 5.5785, 0.509, 0.013, 0.5255000000000001, 0.6154999999999999, 0.7995000000000001, 0.5985, 0.587, 0.5425, 0.7355, 0.4685, 0.5974999999999999, 0.5705, 0.583, 0.915, 0.679, 0.5575000000000001, 1.0845, 0.574, 0.6825, 0.6465000000000001, 0.739, 2.045, 26.6655, 0.5165, 0.8434999999999999, 0.744, 0.6214999999999999, 0.331, 0.5105, 0.5475000000000001, 2.7705, 0.4815, 0.7315, 0.538, 5.4965, 0.6735, 0.3495, 0.5205, 0.494, 0.7324999999999999, 0.6074999999999999, 1.4415, 0.501, 0.5754999999999999, 0.9339999999999999, 0.4435, 0.5025, 0.5005, 0.4155, 0.871, 0.6245, 0.7555000000000001, 0.747, 0.6455, 0.6005, 0.541, 0.6185, 0.5175000000000001, 2.2805, 0.683, 0.538, 1.465, 0.0, 0.5680000000000001, 0.5365, 0.7875000000000001, 3.503, 6.453, 4.128, 0.6705000000000001, 0.5355000000000001},
+		{0.311, 0.2195, 1.0190000000000001, 0.314, 0.4175, 0.447, 1.0685, 0.577, 0.355, 0.2765, 0.0725, 0.5985, 0.213, 0.2255, 0.3595, 0.162, 0.2845, 0.3385, 0.5485, 0.4385, 0.3805, 0.3295, 0.716, 0.448, 0.3005, 0.331, 0.358, 0.373, 1.0545, 0.268, 0.2235, 0.396, 1.8359999999999999, 0.306, 0.9450000000000001, 0.5085, 0.483, 0.3435, 0.4215, 0.4615, 0.2545, 0.158, 0.353, 5.123, 0.2465, 4.215, 0.6165, 0.612, 0.49, 0.628, 0.2945, 4.001, 14.586, 2.45, 0.4615, 0.4295, 0.1285, 0.5640000000000001, 0.309, 0.451, 0.3465, 0.2555, 2.151, 0.206, 0.281, 0.2195, 0.472, 0.251, 0.3605, 0.516, 0.185, 0.4935, 0.476, 0.3415, 0.293, 0.507, 0.133, 0.2065, 11.9415, 0.23199999999999998, 0.7005, 0.2065, 0.711, 0.189, 0.543, 0.554, 0.404, 0.086, 0.4735, 1.7635, 0.736, 0.547, 0.232, 0.261, 11.7605, 0.4255, 0.1295, 0.5865, 0.574, 0.705, 0.213, 0.2165, 0.7605, 0.257, 0.1465, 5.704, 0.213, 0.1915, 0.3295, 0.346, 0.91, 0.3045, 0.4455, 0.3465, 2.085, 0.33699999999999997, 0.261, 0.4235, 0.40700000000000003, 1.0865, 0.2275, 0.7565, 0.5465, 5.2715, 0.638, 0.523, 0.015, 0.3665, 0.5105, 0.295, 0.142, 0.128, 0.4335, 0.531, 0.317, 0.426, 0.3355, 0.744, 0.431, 0.311, 1.0225, 0.308, 0.34299999999999997, 0.3275, 0.40349999999999997, 1.5830000000000002, 26.084, 0.2875, 0.8025, 0.3665, 0.456, 0.397, 0.371, 0.311, 2.7365000000000004, 0.3975, 0.697, 0.347, 0.5965, 0.3365, 0.514, 0.309, 0.3075, 0.447, 0.2915, 1.605, 0.172, 0.2165, 0.6605000000000001, 0.1045, 0.3205, 0.1185, 0.3375, 0.8220000000000001, 0.3375, 0.551, 0.554, 0.4495, 0.3145, 0.293, 0.3655, 0.158, 1.866, 0.166, 0.222, 1.524, 0.0975, 0.299, 0.3375, 0.678, 3.0709999999999997, 3.349, 0.611, 0.5185, 0.209},
+		{0.8394999999999999, 0.5275000000000001, 1.1065, 0.34299999999999997, 0.6014999999999999, 1.0855000000000001, 1.2614999999999998, 0.453, 0.406, 0.5994999999999999, 0.4595, 0.8245, 0.505, 0.518, 0.3925, 0.452, 0.3855, 0.3895, 0.641, 0.687, 0.2855, 0.269, 0.8225, 0.414, 0.344, 0.5545, 0.7455, 0.3035, 0.7645, 0.506, 0.5634999999999999, 0.7064999999999999, 2.0205, 0.334, 0.491, 0.4825, 0.5, 0.425, 0.5415000000000001, 0.6185, 0.5315000000000001, 0.483, 0.6475, 5.7795000000000005, 0.3805, 4.1665, 0.592, 0.5615, 0.715, 1.6030000000000002, 0.558, 3.9245, 15.604, 2.692, 0.6705000000000001, 0.3985, 0.4955, 0.685, 0.4795, 0.5215000000000001, 0.8480000000000001, 0.436, 1.9955, 0.506, 0.479, 0.496, 0.5115000000000001, 0.4625, 0.4605, 0.547, 0.4535, 0.67, 0.714, 0.635, 0.3415, 0.598, 0.449, 0.4655, 10.744499999999999, 0.5105, 0.9185000000000001, 0.5335000000000001, 0.789, 0.4425, 0.6465000000000001, 0.674, 0.48, 0.4485, 0.3995, 1.7135, 0.689, 0.61, 0.498, 0.5275000000000001, 14.9555, 0.443, 0.5834999999999999, 1.3635, 0.55, 0.9974999999999999, 0.4895, 0.504, 0.8985000000000001, 0.5575, 0.4685, 6.8055, 0.5385, 0.43, 1.159, 0.356, 0.878, 0.2965, 0.654, 0.3465, 2.045, 0.355, 0.295, 0.461, 0.391, 1.3125, 0.565, 1.036, 0.594, 5.297499999999999, 0.4565, 0.547, 0.41300000000000003, 0.0235, 0.4835, 0.542, 0.497, 0.494, 0.453, 0.671, 0.379, 0.423, 0.3435, 0.963, 0.597, 0.406, 0.7935, 0.3415, 0.2795, 0.375, 0.487, 1.634, 28.3915, 0.2995, 0.8049999999999999, 0.354, 0.5135000000000001, 0.68, 0.33999999999999997, 0.331, 2.674, 1.5910000000000002, 0.752, 0.3565, 4.6114999999999995, 0.577, 0.543, 0.331, 0.48, 0.563, 0.327, 1.491, 0.483, 0.544, 2.1035, 0.349, 0.3725, 0.424, 0.359, 1.3175, 0.412, 0.715, 0.5615, 0.514, 0.376, 0.3055, 0.3945, 0.4395, 1.8885, 0.4845, 0.5055000000000001, 1.3094999999999999, 0.0, 0.379, 0.3105, 0.7655000000000001, 2.9195, 6.3405000000000005, 0.6255, 0.498, 0.481},
+		{0.5315000000000001, 0.639, 1.2650000000000001, 0.4395, 0.616, 0.508, 2.132, 0.635, 0.4355, 0.602, 0.452, 0.7635000000000001, 0.545, 0.5495000000000001, 0.4115, 0.539, 0.405, 0.4185, 0.9055, 0.6655, 0.486, 0.443, 0.649, 0.503, 0.4105, 0.5405, 0.4365, 0.47250000000000003, 0.7905, 0.554, 0.5529999999999999, 0.676, 1.8455, 0.4845, 1.0314999999999999, 0.6114999999999999, 0.516, 0.452, 0.7645, 0.636, 0.4545, 0.469, 0.6875, 4.959, 0.534, 3.8485, 0.6014999999999999, 0.6275, 0.6885, 0.714, 0.5774999999999999, 4.605, 14.6005, 2.7744999999999997, 0.637, 0.5515000000000001, 0.494, 0.7725, 0.5745, 0.504, 0.4765, 0.576, 1.871, 0.481, 0.5, 0.5065, 0.552, 0.537, 0.4835, 0.6025, 0.524, 0.7044999999999999, 0.657, 0.593, 0.3595, 0.569, 0.4705, 0.5635, 12.997, 0.555, 0.9285, 0.512, 0.8594999999999999, 0.51, 0.718, 0.437, 0.636, 0.4905, 0.424, 1.3530000000000002, 0.3855, 0.592, 0.5405, 0.612, 11.8425, 0.545, 0.7925, 1.875, 0.575, 0.8025, 0.5445, 0.5675, 0.913, 0.5145, 0.5325, 6.83, 0.552, 0.47050000000000003, 0.691, 0.404, 0.9864999999999999, 0.439, 0.7005, 0.5045, 0.931, 0.4525, 0.458, 0.6325000000000001, 0.499, 2.3049999999999997, 0.53, 0.889, 0.6485000000000001, 4.659000000000001, 0.515, 0.9185000000000001, 0.4725, 0.427, 0.014499999999999999, 0.5774999999999999, 0.4265, 0.4935, 0.5854999999999999, 0.6385000000000001, 0.4165, 0.498, 0.4575, 0.77, 0.6025, 0.448, 0.788, 0.449, 0.388, 0.4635, 0.533, 1.3185, 22.9715, 0.4295, 0.8334999999999999, 0.56, 0.5615, 0.8765000000000001, 0.427, 0.378, 2.9375, 0.5055000000000001, 0.812, 0.4945, 0.454, 0.6775, 0.6725000000000001, 0.429, 0.525, 0.6555, 0.477, 1.3805, 0.5495000000000001, 0.4905, 2.1414999999999997, 0.5635, 0.487, 0.491, 0.4485, 0.7875000000000001, 0.4955, 0.7095, 0.479, 0.5445, 0.49, 0.378, 0.415, 0.5335000000000001, 1.8525, 0.5015000000000001, 0.4795, 1.335, 0.0, 0.56, 0.388, 0.929, 3.2285000000000004, 2.1905, 0.686, 0.6125, 0.523},
+		{0.446, 0.401, 1.009, 0.415, 0.428, 0.4555, 0.9515, 0.673, 0.4705, 0.253, 0.28049999999999997, 0.766, 0.309, 0.4485, 0.4415, 0.246, 0.397, 0.4015, 0.5165, 0.4445, 0.5355000000000001, 0.442, 0.7295, 0.5685, 0.3695, 0.28400000000000003, 0.4415, 0.5035000000000001, 1.039, 0.2515, 0.272, 0.529, 2.0125, 0.4855, 0.87, 0.629, 0.571, 0.5095000000000001, 0.3885, 0.5525, 0.2495, 0.312, 0.5475000000000001, 4.856, 0.2145, 4.264, 0.587, 0.5135000000000001, 0.521, 0.6795, 0.421, 4.2095, 15.8275, 2.178, 0.5075000000000001, 0.5705, 0.3265, 0.6, 0.301, 0.556, 0.4105, 0.2855, 1.521, 0.322, 0.2915, 0.3785, 0.5445, 0.3165, 0.4515, 0.6255, 0.29, 0.49, 0.594, 0.367, 0.41600000000000004, 0.555, 0.272, 0.277, 11.677, 0.338, 0.7585, 0.3075, 0.668, 0.2845, 0.6799999999999999, 0.4815, 0.538, 0.28, 0.5375000000000001, 1.6560000000000001, 0.7155, 0.5645, 0.244, 0.346, 8.496, 0.5445, 0.31, 3.7625, 0.6595, 0.7775000000000001, 0.334, 0.427, 0.815, 0.245, 0.319, 6.3875, 0.265, 0.2685, 0.479, 0.46, 0.819, 0.478, 0.5035000000000001, 0.418, 1.2445, 0.42, 0.4275, 0.5025, 0.583, 0.6305000000000001, 0.3965, 0.9079999999999999, 0.8334999999999999, 4.8545, 0.396, 0.588, 0.2765, 0.486, 0.512, 0.0545, 0.263, 0.309, 0.6675, 0.5495000000000001, 0.42, 0.4805, 0.415, 0.8194999999999999, 0.613, 0.4625, 0.9315, 0.4325, 0.4785, 0.4625, 0.529, 1.911, 25.508, 0.427, 0.9265000000000001, 0.4985, 0.5415000000000001, 0.6045, 0.4175, 0.413, 2.7275, 0.493, 0.655, 0.431, 2.2569999999999997, 0.6605000000000001, 0.632, 0.443, 0.35, 0.55, 0.423, 0.3955, 0.269, 0.3145, 0.754, 0.2875, 0.435, 0.279, 0.46950000000000003, 0.607, 0.502, 0.606, 0.6585000000000001, 0.5415000000000001, 0.5575, 0.40800000000000003, 0.4865, 0.288, 1.8745, 0.3345, 0.311, 1.444, 0.0, 0.535, 0.397, 0.798, 3.0220000000000002, 6.739, 0.675, 0.5914999999999999, 0.3115},
+		{0.3935, 0.231, 0.8605, 0.2925, 0.443, 0.34550000000000003, 0.891, 0.623, 0.3665, 0.2785, 0.113, 0.567, 0.2475, 0.308, 0.3565, 0.1985, 0.27, 0.341, 0.5675, 0.4605, 0.3515, 0.3215, 0.688, 0.442, 0.3055, 0.3315, 0.338, 0.405, 0.8045, 0.3025, 0.2555, 0.3775, 2.0045, 0.3435, 0.7715000000000001, 0.545, 0.4695, 0.43, 0.3655, 0.47150000000000003, 0.2945, 0.20350000000000001, 0.3775, 6.0425, 0.228, 4.2635000000000005, 0.583, 0.556, 0.512, 0.524, 0.3145, 3.7439999999999998, 15.36, 2.591, 0.4665, 0.4885, 0.185, 0.6, 0.3295, 0.442, 0.305, 0.2525, 1.8145, 0.1875, 0.3095, 0.2275, 0.5275000000000001, 0.23299999999999998, 0.397, 0.464, 0.2205, 0.537, 0.498, 0.337, 0.2685, 0.484, 0.133, 0.2525, 11.7725, 0.23399999999999999, 0.6755, 0.206, 0.7585, 0.1865, 0.6165, 0.541, 0.4915, 0.1385, 0.3855, 1.6475, 0.635, 0.512, 0.263, 0.259, 11.0375, 0.4665, 0.164, 0.578, 0.56, 0.586, 0.2235, 0.222, 0.829, 0.23349999999999999, 0.1235, 8.4095, 0.254, 0.1995, 0.4795, 0.3745, 0.99, 0.34450000000000003, 0.377, 0.376, 0.7745, 0.359, 0.305, 0.433, 0.433, 1.7245, 0.2725, 0.6845, 0.6065, 5.7285, 0.258, 0.5115000000000001, 0.166, 0.4485, 0.482, 0.324, 0.005, 0.163, 0.4165, 0.6859999999999999, 0.3245, 0.4015, 0.345, 0.8755, 0.4165, 0.3505, 0.965, 0.3585, 0.276, 0.3355, 0.4415, 1.414, 26.343, 0.3085, 0.761, 0.462, 0.474, 0.5665, 0.3395, 0.3635, 2.7255000000000003, 0.375, 0.603, 0.40700000000000003, 0.4885, 0.4235, 0.5345, 0.3275, 0.344, 0.464, 0.329, 1.134, 0.197, 0.241, 0.8075, 0.142, 0.313, 0.141, 0.3395, 0.5495000000000001, 0.291, 0.537, 0.5874999999999999, 0.46599999999999997, 0.333, 0.3235, 0.39949999999999997, 0.195, 1.9665, 0.206, 0.221, 1.1509999999999998, 0.3335, 0.302, 0.33, 0.788, 2.9665, 4.319, 0.649, 0.491, 0.238},
+		{0.397, 0.1595, 0.9099999999999999, 0.366, 0.3965, 0.8285, 0.9675, 0.5674999999999999, 0.365, 0.274, 0.139, 0.666, 0.2435, 0.2395, 0.351, 0.187, 0.3005, 0.362, 0.452, 0.4605, 0.437, 0.3115, 0.525, 0.437, 0.3215, 0.324, 0.3405, 0.3675, 0.7595000000000001, 0.2905, 0.263, 0.377, 2.083, 0.3885, 0.6519999999999999, 0.5245, 0.4995, 0.3765, 0.3195, 0.43, 0.23249999999999998, 0.1615, 0.3405, 7.0495, 0.16, 4.333500000000001, 0.6715, 0.568, 0.482, 0.59, 0.316, 4.289, 15.4245, 2.5765000000000002, 0.446, 0.5155000000000001, 0.1905, 0.576, 0.312, 0.467, 0.3365, 0.2955, 1.996, 0.152, 0.2525, 0.267, 0.521, 0.232, 0.399, 0.596, 0.1575, 0.6014999999999999, 0.502, 0.325, 0.3105, 0.4285, 0.2455, 0.1935, 11.092500000000001, 0.183, 0.721, 0.1955, 0.827, 0.1845, 0.6575, 0.5854999999999999, 0.421, 0.1365, 0.461, 1.78, 0.7035, 0.517, 0.2185, 0.2465, 7.52, 0.4605, 0.844, 1.9415, 0.5725, 0.658, 0.174, 0.177, 0.779, 0.2495, 0.186, 8.2865, 0.289, 0.1585, 1.043, 0.4445, 0.8255, 0.3215, 0.461, 0.359, 2.194, 0.3675, 0.321, 0.439, 0.4525, 1.069, 0.2465, 0.868, 0.7235, 4.9635, 0.2155, 0.4805, 0.1275, 0.4475, 0.4995, 0.307, 0.154, 0.006, 0.425, 0.621, 0.3425, 0.4295, 0.354, 0.904, 0.469, 0.4155, 0.8305, 0.3615, 0.3245, 0.42, 0.4835, 2.051, 25.963, 0.297, 0.86, 0.3755, 0.4675, 0.5565, 0.3615, 0.371, 2.698, 0.4335, 0.8825000000000001, 0.366, 0.3315, 0.5915, 0.5905, 0.3175, 0.3145, 0.4945, 0.3445, 0.346, 0.113, 0.1685, 1.5085000000000002, 0.173, 0.3725, 0.119, 0.34299999999999997, 0.645, 0.3305, 0.6034999999999999, 0.589, 0.5345, 0.3875, 0.31, 0.4295, 0.1675, 2.0875, 0.1885, 0.224, 1.404, 0.040499999999999994, 0.273, 0.317, 0.766, 3.003, 6.559, 0.668, 0.571, 0.1945},
+		{0.773, 0.5609999999999999, 1.1364999999999998, 0.4025, 0.6535, 1.17, 1.846, 0.771, 0.55, 0.6944999999999999, 0.6525000000000001, 0.905, 0.547, 0.5405, 0.4505, 0.508, 0.357, 0.285, 0.6034999999999999, 0.698, 0.5095000000000001, 0.46499999999999997, 0.9235, 0.78, 0.3595, 0.5965, 0.2655, 0.5365, 0.8734999999999999, 0.5315000000000001, 0.5725, 0.795, 2.391, 0.4365, 0.984, 0.572, 0.6, 0.5945, 0.655, 0.7344999999999999, 0.51, 0.547, 0.653, 7.3485, 0.4395, 4.294499999999999, 0.698, 0.607, 0.8140000000000001, 0.7084999999999999, 0.793, 4.2895, 15.264, 2.76, 0.611, 0.534, 0.4915, 0.7705, 0.625, 0.528, 0.415, 0.5585, 1.8365, 0.5235000000000001, 0.5325, 0.46399999999999997, 0.545, 0.5734999999999999, 0.5145, 0.639, 0.589, 0.7235, 0.732, 0.658, 0.3285, 0.6074999999999999, 0.613, 0.4685, 14.2975, 0.636, 1.5499999999999998, 0.4985, 0.906, 0.7235, 0.6705000000000001, 0.733, 1.0065, 0.542, 0.536, 1.6755, 0.72, 0.71, 0.472, 0.505, 8.567499999999999, 0.623, 0.5335000000000001, 0.7975000000000001, 0.7435, 0.977, 0.488, 0.473, 0.9655, 0.6005, 0.556, 6.487, 1.1804999999999999, 0.53, 2.3525, 0.4155, 0.8965000000000001, 0.2665, 0.6984999999999999, 0.5075000000000001, 0.938, 0.487, 0.274, 0.615, 0.6525000000000001, 2.024, 0.6065, 0.9484999999999999, 1.0345, 5.2035, 0.5415000000000001, 0.726, 0.549, 0.478, 0.613, 0.6295, 0.497, 0.5225, 0.019, 0.6055, 0.417, 0.40349999999999997, 0.318, 0.89, 0.6145, 0.4645, 0.897, 0.304, 0.3015, 0.5725, 1.1735, 1.9255, 29.0435, 0.3825, 0.973, 0.3385, 0.534, 0.719, 0.45899999999999996, 0.3945, 2.821, 0.506, 1.3915, 0.356, 0.4255, 0.761, 0.618, 0.40149999999999997, 0.5155000000000001, 0.76, 0.352, 1.5434999999999999, 0.455, 0.516, 1.113, 0.643, 0.4155, 0.411, 0.47150000000000003, 0.883, 0.404, 1.4355, 0.8999999999999999, 0.502, 0.383, 0.958, 0.39, 0.4255, 2.0145, 0.4405, 0.4725, 1.246, 0.0, 0.548, 0.31, 1.4965000000000002, 2.5629999999999997, 6.449, 0.728, 0.6775, 0.5805},
+		{2.2504999999999997, 0.7655000000000001, 0.9744999999999999, 0.432, 0.487, 0.5645, 1.2225000000000001, 0.839, 0.5075000000000001, 0.667, 0.47350000000000003, 1.1829999999999998, 0.662, 0.7395, 0.5195000000000001, 0.6525000000000001, 0.572, 0.4905, 0.5974999999999999, 0.705, 0.588, 0.5485, 0.8745, 0.721, 0.47050000000000003, 0.6695, 0.474, 0.553, 1.0555, 0.599, 0.617, 0.749, 2.0355, 0.5215000000000001, 0.9035, 0.489, 0.6375, 0.6045, 0.79, 0.7815000000000001, 0.6054999999999999, 0.652, 0.689, 7.1285, 0.616, 4.027, 0.513, 0.737, 0.763, 0.764, 0.553, 4.386, 14.576, 2.7830000000000004, 0.4285, 0.7669999999999999, 0.5765, 0.572, 0.61, 0.5395000000000001, 0.5654999999999999, 0.6415, 2.564, 0.643, 0.6495, 0.657, 0.431, 0.6034999999999999, 0.6134999999999999, 0.6695, 0.5535000000000001, 0.9615, 0.7615000000000001, 0.6154999999999999, 0.431, 0.5715, 0.5165, 0.6265000000000001, 11.9485, 0.6835, 0.8494999999999999, 0.554, 0.9584999999999999, 0.538, 0.617, 0.517, 0.6225, 0.5375000000000001, 0.439, 1.9765000000000001, 0.836, 0.587, 0.568, 0.617, 13.5135, 0.573, 0.5854999999999999, 0.839, 0.5815, 1.248, 0.631, 0.621, 0.9155, 0.58, 0.5934999999999999, 7.1125, 0.6525000000000001, 0.6225, 0.858, 0.51, 0.851, 0.5435000000000001, 0.6625000000000001, 0.5455000000000001, 1.041, 0.533, 0.414, 0.5245, 0.638, 1.315, 0.6375, 1.1179999999999999, 0.875, 5.6195, 0.687, 0.4345, 0.6025, 0.7164999999999999, 0.6765000000000001, 0.6675, 0.6325000000000001, 0.5455000000000001, 0.725, 0.007, 0.587, 0.6145, 0.547, 1.003, 0.685, 0.4895, 1.0790000000000002, 0.4795, 0.4535, 0.523, 0.5775, 1.81, 29.6715, 0.539, 0.9715, 0.5925, 0.596, 0.447, 0.4345, 0.511, 3.0195, 0.499, 0.7315, 0.5635, 0.5135000000000001, 0.7255, 0.529, 0.567, 0.5495000000000001, 0.7364999999999999, 0.556, 1.6385, 0.656, 0.5874999999999999, 1.811, 0.59, 0.576, 0.5365, 0.495, 1.1475, 0.538, 0.892, 2.1125, 0.565, 0.433, 0.586, 0.4735, 0.581, 1.8559999999999999, 0.5774999999999999, 0.647, 1.486, 0.0, 0.5894999999999999, 0.452, 0.865, 3.5535, 3.955, 0.509, 0.794, 0.642},
+		{0.521, 0.4025, 1.2349999999999999, 0.2725, 0.5, 0.1635, 1.0295, 0.526, 0.2475, 0.4855, 0.3065, 0.8195, 0.3695, 0.3865, 0.2415, 0.3265, 0.1935, 0.2155, 0.622, 0.5335000000000001, 0.247, 0.215, 0.4435, 0.27, 0.181, 0.373, 0.243, 0.303, 1.3525, 0.3375, 0.368, 0.4805, 1.9045, 0.287, 1.0145, 0.379, 0.395, 0.2855, 0.43, 0.5515000000000001, 0.3645, 0.34299999999999997, 0.5295000000000001, 7.6135, 0.3535, 3.859, 0.597, 0.5425, 0.5045, 0.4305, 0.8300000000000001, 4.1370000000000005, 16.215, 2.0535, 0.522, 0.351, 0.40900000000000003, 0.5545, 0.4585, 0.3125, 0.193, 0.4315, 1.9340000000000002, 0.319, 0.432, 0.399, 0.3115, 0.323, 0.277, 0.472, 0.423, 0.539, 0.582, 0.444, 0.21, 0.5545, 0.355, 0.3865, 11.2305, 0.3825, 0.7915000000000001, 0.34950000000000003, 0.752, 0.3075, 0.494, 0.619, 0.519, 0.235, 0.348, 1.9285, 0.608, 0.5934999999999999, 0.399, 0.3715, 9.821, 0.346, 0.3565, 2.7569999999999997, 0.4865, 0.8275, 0.34650000000000003, 0.33, 0.837, 0.415, 0.355, 6.7955000000000005, 0.343, 0.326, 0.4325, 0.297, 1.055, 0.213, 0.581, 0.2765, 0.9664999999999999, 0.1755, 0.177, 0.466, 0.332, 1.4075, 0.3975, 0.817, 0.5205, 4.4975000000000005, 0.371, 0.6405000000000001, 0.364, 0.34550000000000003, 0.4325, 0.3885, 0.356, 0.2985, 0.25, 0.5874999999999999, 0.009, 0.5195000000000001, 0.194, 0.887, 0.4555, 0.2505, 0.73, 0.1675, 0.143, 0.216, 0.343, 1.3535, 26.014499999999998, 0.15, 0.657, 0.2525, 0.4025, 0.5785, 0.2405, 0.278, 2.5694999999999997, 0.3425, 0.669, 0.3215, 0.3135, 0.45699999999999996, 0.6074999999999999, 0.172, 0.417, 0.4325, 0.171, 1.2845, 0.325, 0.3485, 2.8209999999999997, 0.2885, 0.2395, 0.306, 0.262, 0.6165, 0.2355, 0.6525000000000001, 0.5075000000000001, 0.4075, 0.29, 0.1675, 0.2775, 0.364, 1.654, 0.3025, 0.344, 1.385, 0.0, 0.148, 0.1825, 0.7505, 3.2295, 5.1495, 0.575, 0.504, 0.338},
+		{0.647, 0.5469999999999999, 1.0825, 0.23249999999999998, 0.5055000000000001, 0.306, 11.793, 4.599, 0.3335, 0.5145, 0.3635, 1.302, 0.411, 0.466, 0.5215000000000001, 0.40549999999999997, 0.248, 0.211, 0.606, 0.62, 0.3725, 0.274, 0.841, 0.349, 0.1745, 0.513, 0.2555, 0.278, 2.2344999999999997, 0.4655, 0.49, 0.568, 2.177, 0.385, 1.1444999999999999, 0.435, 0.405, 0.34850000000000003, 0.5654999999999999, 0.696, 0.436, 0.39849999999999997, 0.6275, 8.748999999999999, 0.358, 4.016, 0.724, 0.515, 0.7344999999999999, 0.7155, 0.6285000000000001, 4.000500000000001, 14.868500000000001, 4.148, 0.6395, 2.0175, 0.749, 0.803, 0.4515, 0.4275, 0.27549999999999997, 0.734, 1.7095, 0.8825000000000001, 0.5595, 0.409, 0.4975, 0.428, 0.317, 0.4925, 0.4125, 1.292, 0.71, 0.8554999999999999, 0.2135, 0.5745, 0.3545, 0.4665, 15.508, 1.0575, 0.9205, 0.399, 0.765, 0.3645, 0.7415, 0.5694999999999999, 0.6445000000000001, 0.311, 0.4425, 2.3125, 0.6375, 0.663, 0.482, 0.4625, 12.0535, 0.453, 3.7235, 0.665, 0.5485, 1.1875, 0.401, 0.4475, 0.8925000000000001, 0.4505, 0.418, 7.319, 7.564, 3.7105, 0.5745, 0.2345, 3.4000000000000004, 0.2445, 0.6990000000000001, 0.3535, 13.343499999999999, 0.2825, 0.2135, 0.6495, 0.39149999999999996, 1.4565000000000001, 0.4855, 1.131, 1.0390000000000001, 4.828, 0.447, 0.577, 0.9744999999999999, 0.4595, 0.53, 0.6125, 0.378, 0.404, 0.616, 0.5465, 0.533, 0.1035, 0.2255, 0.8725, 0.422, 0.279, 0.969, 0.253, 0.2365, 0.2755, 0.3435, 2.582, 27.432000000000002, 0.243, 0.8545, 0.28600000000000003, 0.4485, 0.528, 0.229, 0.2505, 2.7590000000000003, 0.318, 0.9155, 0.28049999999999997, 0.433, 0.6485000000000001, 0.5235000000000001, 0.26, 0.492, 0.3655, 0.285, 1.649, 0.386, 0.4755, 1.03, 0.373, 0.2915, 0.365, 0.2175, 1.3325, 0.23149999999999998, 0.6885, 0.7255, 0.4465, 0.291, 0.2115, 0.29, 0.3685, 2.077, 0.425, 0.4845, 1.3415, 0.0, 0.3425, 0.2175, 1.5865, 4.326499999999999, 8.5085, 0.962, 0.539, 0.423},
+		{0.454, 0.3915, 0.7164999999999999, 0.214, 0.5185, 0.16949999999999998, 0.926, 0.6275, 0.277, 0.4875, 0.2875, 0.6145, 0.3755, 0.491, 0.213, 0.362, 0.2065, 0.162, 0.598, 0.5275000000000001, 0.241, 0.217, 0.713, 0.276, 0.144, 0.491, 0.2105, 0.3015, 0.793, 0.4125, 0.3875, 0.434, 2.1585, 0.287, 0.5705, 0.4145, 0.3605, 0.286, 0.4615, 0.4665, 0.362, 0.3715, 0.5345, 7.5145, 0.3955, 4.3895, 0.623, 0.6385000000000001, 0.6085, 0.501, 0.4665, 3.915, 15.979, 3.157, 0.5, 0.316, 0.3585, 0.643, 0.314, 0.345, 0.28, 0.4645, 2.7865, 0.376, 0.40900000000000003, 0.4115, 0.4515, 0.3965, 0.2885, 0.54, 0.318, 0.511, 0.581, 0.455, 0.158, 0.5365, 0.31, 0.371, 13.244, 0.3885, 0.786, 0.369, 0.7415, 0.352, 0.411, 0.516, 0.6234999999999999, 0.3015, 0.316, 1.8185, 0.5874999999999999, 0.582, 0.3855, 0.395, 7.2735, 0.3375, 0.722, 0.6, 0.472, 0.792, 0.38, 0.3985, 0.872, 0.381, 0.3415, 6.724, 0.40349999999999997, 0.396, 0.404, 0.22, 0.9975, 0.162, 0.5625, 0.2465, 0.9824999999999999, 0.226, 0.139, 0.579, 0.3135, 1.3825, 0.347, 0.9575, 0.6385000000000001, 5.3309999999999995, 0.401, 0.597, 0.28300000000000003, 0.3085, 0.431, 0.4335, 0.3115, 0.359, 0.2745, 0.5754999999999999, 0.2, 0.2915, 0.005, 0.8049999999999999, 0.4165, 0.2095, 0.6970000000000001, 0.2105, 0.1455, 0.1645, 0.274, 2.106, 26.6025, 0.2115, 0.5355000000000001, 0.2425, 0.3845, 0.4645, 0.145, 0.2075, 2.7845, 0.2825, 0.6325000000000001, 0.2185, 0.17, 0.527, 0.5055000000000001, 0.213, 0.437, 0.5640000000000001, 0.203, 1.3385, 0.391, 0.346, 0.761, 0.34099999999999997, 0.196, 0.34450000000000003, 0.148, 0.7995000000000001, 0.197, 0.4675, 0.495, 0.373, 0.1895, 0.148, 0.2215, 0.3625, 1.795, 0.34450000000000003, 0.36, 1.1795, 0.0, 0.1645, 0.153, 0.6305000000000001, 2.8520000000000003, 4.6555, 0.538, 0.451, 0.38},
+		{1.3965, 1.123, 1.588, 0.868, 1.1284999999999998, 5.461, 1.4365, 0.791, 0.812, 1.029, 3.4284999999999997, 1.8319999999999999, 0.824, 0.9035, 1.1625, 0.735, 0.8015, 0.9335, 0.8494999999999999, 0.9515, 0.724, 0.785, 1.1395, 1.0415, 0.8685, 1.3225, 0.804, 0.7195, 1.948, 0.7935000000000001, 0.817, 0.9305, 2.334, 0.982, 2.8025, 0.669, 0.864, 1.0445, 0.9195, 1.1764999999999999, 0.5814999999999999, 0.871, 1.1150000000000002, 8.488, 0.7364999999999999, 4.006, 0.7765, 1.1145, 0.8554999999999999, 0.865, 1.125, 4.58, 13.629999999999999, 2.4219999999999997, 1.182, 0.923, 0.8245, 0.9655, 0.7915000000000001, 0.6445000000000001, 1.0065, 0.7745, 2.6705, 0.9015, 0.8645, 1.0779999999999998, 0.92, 0.8234999999999999, 0.591, 1.0154999999999998, 0.9265000000000001, 1.0945, 0.985, 0.828, 0.8975, 0.8135, 1.0875, 0.742, 11.208, 0.8585, 5.8645, 0.8565, 1.385, 1.174, 1.0365, 1.3014999999999999, 1.2845, 1.016, 0.7595000000000001, 1.896, 1.044, 0.933, 0.652, 0.788, 7.398, 0.8725, 0.878, 1.3075, 1.2345, 1.5114999999999998, 0.839, 0.8899999999999999, 1.156, 0.9610000000000001, 0.9755, 8.7735, 0.8855, 0.694, 7.1905, 1.1244999999999998, 1.2395, 0.8445, 1.0385, 0.5895, 1.4409999999999998, 1.0935000000000001, 0.7835000000000001, 1.0274999999999999, 0.9575, 1.8319999999999999, 1.1535, 1.564, 1.9205, 4.531499999999999, 0.8394999999999999, 0.8965000000000001, 0.7865, 0.725, 0.954, 0.8765000000000001, 0.6445000000000001, 0.565, 1.085, 1.016, 0.757, 1.807, 0.98, 0.014, 0.761, 1.0270000000000001, 1.3935, 0.7215, 0.5575, 0.733, 5.605, 2.035, 26.761499999999998, 0.5945, 1.185, 4.0365, 0.951, 0.9275, 0.7925, 0.6265000000000001, 2.7220000000000004, 0.6114999999999999, 1.266, 0.651, 0.8634999999999999, 1.3235000000000001, 1.0845, 0.8180000000000001, 0.8095, 1.3885, 0.6975, 0.775, 0.89, 1.015, 1.6520000000000001, 1.005, 0.7315, 0.7945, 0.94, 2.2795, 0.95, 6.087, 1.042, 0.7989999999999999, 0.787, 5.881, 0.683, 0.8275, 2.0105, 0.74, 0.6835, 1.538, 0.0915, 0.8095000000000001, 0.7135, 5.822, 3.5564999999999998, 4.4445, 0.8795, 1.2085, 0.6579999999999999},
+		{0.649, 0.5155000000000001, 1.1545, 0.349, 0.6365000000000001, 0.5825, 1.0865, 0.6425000000000001, 0.4495, 0.646, 0.5435000000000001, 1.017, 0.533, 0.6655, 0.4425, 0.5275000000000001, 0.4615, 0.386, 0.7705, 0.7865, 0.472, 0.455, 0.7845, 0.4885, 0.278, 0.59, 0.4775, 0.423, 0.9790000000000001, 0.55, 0.4785, 0.569, 2.0805, 0.4465, 1.2735, 0.617, 0.543, 0.491, 0.7084999999999999, 0.7745, 0.6214999999999999, 0.498, 0.739, 5.3919999999999995, 0.474, 4.391500000000001, 0.8234999999999999, 0.7695000000000001, 0.822, 0.825, 1.4055, 5.09, 13.679, 3.1295, 0.74, 0.655, 0.63, 0.733, 0.6205, 0.545, 0.3215, 0.6505000000000001, 2.6155, 0.521, 0.5405, 0.553, 0.6785, 0.5135000000000001, 0.449, 0.6645000000000001, 0.4985, 0.905, 0.684, 0.6094999999999999, 0.479, 0.68, 0.485, 0.6145, 12.631499999999999, 0.649, 1.0394999999999999, 0.518, 0.933, 0.563, 0.859, 0.6885, 0.635, 0.4635, 0.4635, 1.969, 0.637, 0.839, 0.505, 0.5645, 8.975999999999999, 0.5625, 0.524, 0.831, 0.6595, 0.963, 0.4975, 0.577, 0.954, 0.6225, 0.5545, 5.971500000000001, 0.575, 0.5355000000000001, 0.658, 0.5045, 0.9775, 0.3905, 0.913, 0.4835, 1.004, 0.5595, 0.319, 0.745, 0.5155000000000001, 1.7315, 0.6595, 0.825, 0.8335, 5.586, 0.49, 0.728, 0.5, 0.6, 0.655, 0.6975, 0.5175000000000001, 0.4945, 0.587, 0.707, 0.482, 0.46299999999999997, 0.501, 0.866, 0.011, 0.4965, 0.998, 0.3635, 0.3785, 0.687, 0.525, 2.3695, 28.5205, 0.367, 0.9410000000000001, 0.48, 0.568, 0.6275, 0.4235, 0.419, 2.832, 0.423, 1.065, 0.5854999999999999, 0.474, 0.8065, 0.579, 0.389, 0.5905, 0.63, 0.3765, 0.3795, 0.578, 0.494, 0.933, 0.5495000000000001, 0.592, 0.4845, 0.421, 0.9775, 0.584, 1.0025, 2.0895, 0.5385, 0.431, 0.45999999999999996, 0.484, 0.519, 2.1790000000000003, 0.505, 0.5295000000000001, 1.4165, 0.0, 0.348, 0.394, 0.9524999999999999, 3.1595, 5.0205, 0.6955, 0.5165, 0.47},
+		{0.5375000000000001, 0.4595, 0.984, 0.252, 0.49, 0.8005, 1.1745, 0.5455, 0.2975, 0.5115000000000001, 0.332, 0.638, 0.4475, 0.5125, 0.2845, 0.3755, 0.269, 0.184, 0.635, 0.6335, 0.2935, 0.2205, 0.624, 0.2725, 0.1945, 0.493, 0.5445, 0.232, 0.8875, 0.4035, 0.4, 0.574, 1.912, 0.325, 0.962, 0.3745, 0.368, 0.3005, 0.444, 0.56, 0.417, 0.387, 0.5315000000000001, 7.4605, 0.4275, 4.3475, 0.702, 0.5945, 0.526, 0.4415, 0.194, 3.5759999999999996, 13.182500000000001, 3.4314999999999998, 0.4495, 0.295, 0.4105, 0.717, 0.4295, 0.373, 0.248, 0.455, 1.2355, 0.4265, 0.419, 0.3975, 0.4445, 0.383, 0.346, 0.4265, 0.38, 0.5914999999999999, 0.5985, 0.435, 0.2775, 0.6085, 0.4355, 0.4345, 12.6665, 0.472, 0.9185000000000001, 0.385, 0.869, 0.349, 0.577, 0.5465, 0.555, 0.3305, 0.338, 1.3475000000000001, 0.5885, 0.575, 0.421, 0.4765, 8.197, 0.368, 0.3845, 0.986, 0.5265, 0.821, 0.40449999999999997, 0.439, 0.814, 0.4125, 0.396, 6.1325, 0.447, 0.40349999999999997, 0.4785, 0.1635, 0.752, 0.264, 0.6094999999999999, 0.3115, 1.0390000000000001, 0.273, 0.2095, 0.4715, 0.41200000000000003, 2.8075, 0.4425, 0.9744999999999999, 0.632, 4.9495000000000005, 0.434, 0.5085, 0.353, 0.348, 0.4385, 0.4935, 0.347, 0.3795, 0.3325, 0.436, 0.2135, 0.302, 0.1955, 0.774, 0.4445, 0.007, 0.7615000000000001, 0.21150000000000002, 0.2095, 0.232, 0.282, 1.532, 27.283, 0.2525, 0.6325000000000001, 0.2985, 0.402, 0.5945, 0.2055, 0.1955, 2.811, 0.1535, 0.6074999999999999, 0.307, 0.2345, 0.4605, 0.577, 0.256, 0.455, 0.2445, 0.2905, 1.4954999999999998, 0.35, 0.374, 1.393, 0.3945, 0.252, 0.3625, 0.21, 0.7004999999999999, 0.2695, 0.571, 0.6145, 0.362, 0.254, 0.202, 0.241, 0.4005, 1.984, 0.2995, 0.445, 1.3295, 0.0, 0.26, 0.1735, 0.638, 2.597, 5.6525, 0.5885, 0.4595, 0.388},
+		{1.117, 0.9495, 1.3025, 0.6715, 1.068, 1.0545, 1.7269999999999999, 1.2189999999999999, 1.0765, 1.0915, 1.0335, 1.709, 0.778, 0.913, 1.061, 0.729, 0.771, 0.702, 0.997, 1.1065, 0.8225, 1.2475, 0.978, 1.193, 0.861, 1.0194999999999999, 0.675, 0.6475, 2.2015000000000002, 0.787, 0.8225, 0.981, 2.4725, 1.0245, 1.2905, 0.712, 0.787, 0.6745000000000001, 0.876, 0.971, 0.668, 0.8605, 0.998, 8.0515, 0.9915, 3.7865, 0.9235, 1.3415, 0.963, 1.087, 0.9795, 4.37, 14.297, 2.7245, 1.1155, 1.1625, 0.84, 0.9205000000000001, 0.6965, 0.848, 0.675, 1.0110000000000001, 2.1195, 0.8674999999999999, 0.9830000000000001, 0.9664999999999999, 0.769, 0.9385, 0.6045, 0.9105000000000001, 0.8145, 0.96, 0.92, 0.823, 0.6685000000000001, 0.8154999999999999, 0.709, 0.912, 12.3205, 0.895, 1.8105, 0.999, 1.1355, 0.901, 0.8365, 1.0925, 1.1135000000000002, 0.83, 0.7905, 2.2505, 0.823, 0.905, 0.8634999999999999, 0.9390000000000001, 7.3759999999999994, 0.8654999999999999, 0.8514999999999999, 1.5925, 1.0265, 1.2355, 0.9099999999999999, 0.9085000000000001, 1.0790000000000002, 1.3995, 1.0095, 6.6195, 0.9115, 0.8705, 2.4314999999999998, 0.806, 1.555, 0.7585, 0.993, 0.629, 1.3599999999999999, 0.681, 0.672, 0.9794999999999999, 0.7665, 1.482, 0.937, 1.4849999999999999, 1.3285, 4.7265, 0.884, 1.1110000000000002, 1.521, 0.9949999999999999, 0.741, 0.9025000000000001, 0.852, 0.8394999999999999, 0.841, 1.0985, 0.6855, 0.9915, 0.647, 1.026, 0.97, 0.7444999999999999, 0.016, 0.6105, 0.636, 1.145, 1.2765, 2.325, 27.166, 0.676, 1.2814999999999999, 0.8795, 0.6775, 0.805, 0.892, 0.735, 2.6900000000000004, 0.8205, 1.6875, 0.653, 0.6955, 1.131, 0.82, 0.745, 0.8155, 1.0825, 0.6605000000000001, 1.1975, 0.7375, 0.87, 3.482, 0.63, 0.7105, 0.6795, 0.704, 1.323, 0.725, 1.7175, 0.9704999999999999, 0.745, 0.5515000000000001, 1.0910000000000002, 1.0105, 0.73, 2.2625, 0.787, 1.1315, 1.6195, 0.0705, 0.687, 0.7070000000000001, 1.9304999999999999, 3.5385, 3.5825, 0.974, 1.2155, 0.6910000000000001},
+		{0.445, 0.3805, 0.909, 0.199, 0.4965, 0.131, 0.8775, 0.506, 0.188, 0.46799999999999997, 0.3315, 0.607, 0.3595, 0.4095, 0.2535, 0.3295, 0.167, 0.179, 0.5865, 0.5075000000000001, 0.2115, 0.1685, 0.5865, 0.3365, 0.1515, 0.4275, 0.20450000000000002, 0.2325, 0.786, 0.343, 0.364, 0.527, 1.83, 0.2575, 0.779, 0.395, 0.366, 0.2625, 0.447, 0.577, 0.3485, 0.329, 0.485, 8.493, 0.361, 4.2395, 0.5125, 0.565, 0.6005, 0.5545, 0.114, 4.38, 13.783999999999999, 3.2845, 0.5814999999999999, 0.292, 0.3485, 0.648, 0.402, 0.3405, 0.2005, 0.427, 1.9775, 0.343, 0.382, 0.381, 0.4015, 0.383, 0.308, 0.39949999999999997, 0.414, 0.606, 0.5885, 0.4445, 0.2065, 0.5665, 0.317, 0.382, 13.347, 0.3765, 0.754, 0.334, 0.774, 0.376, 0.517, 0.615, 0.5355000000000001, 0.348, 0.319, 1.4874999999999998, 0.5545, 0.6105, 0.346, 0.35850000000000004, 9.7, 0.293, 0.5674999999999999, 5.3065, 0.5325, 0.9185, 0.40549999999999997, 0.363, 0.8374999999999999, 0.383, 0.3545, 6.514, 0.356, 0.346, 0.4325, 0.2145, 0.9584999999999999, 0.195, 0.609, 0.202, 0.778, 0.1555, 0.1485, 0.543, 0.278, 1.06, 0.3525, 0.782, 0.554, 4.021, 0.40800000000000003, 0.569, 0.317, 0.35, 0.442, 0.4275, 0.315, 0.348, 0.387, 0.5045, 0.1725, 0.3035, 0.181, 0.758, 0.3465, 0.248, 0.752, 0.009, 0.102, 0.2105, 0.3495, 1.577, 28.085, 0.12, 0.8405, 0.2505, 0.3725, 0.4165, 0.1615, 0.1945, 2.7205, 0.2405, 0.62, 0.352, 0.1085, 0.517, 0.458, 0.1305, 0.4615, 0.3995, 0.1765, 1.0665, 0.376, 0.3955, 0.768, 0.28300000000000003, 0.23349999999999999, 0.2905, 0.213, 0.7164999999999999, 0.192, 0.5734999999999999, 0.479, 0.379, 0.2535, 0.122, 0.2635, 0.317, 1.881, 0.337, 0.382, 1.2985, 0.0, 0.1305, 0.1495, 0.8055, 3.162, 5.338, 0.568, 0.4215, 0.3075},
+		{0.473, 0.4325, 0.817, 0.189, 0.496, 0.1225, 0.955, 0.541, 0.2115, 0.5505, 0.265, 1.041, 0.374, 0.377, 0.225, 0.3585, 0.164, 0.2495, 0.6245, 0.556, 0.262, 0.1535, 0.7315, 0.2915, 0.1225, 0.46599999999999997, 0.19, 0.2185, 0.7929999999999999, 0.3645, 0.354, 0.5465, 2.093, 0.2575, 0.661, 0.4065, 0.36, 0.2625, 0.446, 0.6475, 0.37, 0.3345, 0.531, 7.944, 0.333, 3.721, 0.4615, 0.452, 0.5635, 0.5205, 0.34600000000000003, 4.1385000000000005, 15.222, 1.9235000000000002, 0.563, 0.3275, 0.3195, 0.653, 0.376, 0.3145, 0.1735, 0.3735, 1.6815, 0.487, 0.4405, 0.3985, 0.4885, 0.3655, 0.2355, 0.641, 0.3805, 0.5195000000000001, 0.5894999999999999, 0.4365, 0.157, 0.619, 0.357, 0.5605, 14.494, 0.3915, 0.7255, 0.331, 0.8105, 0.309, 0.4615, 0.606, 0.6025, 0.2745, 0.3105, 1.5535, 0.5914999999999999, 0.616, 0.3585, 0.352, 8.5705, 0.2845, 0.34199999999999997, 0.607, 0.46799999999999997, 0.6525000000000001, 0.3355, 0.3885, 0.8905000000000001, 0.4095, 0.2735, 5.771000000000001, 0.39, 0.34450000000000003, 0.5714999999999999, 0.1885, 0.8514999999999999, 0.1875, 0.554, 0.2025, 1.1255000000000002, 0.22249999999999998, 0.098, 0.4665, 0.3125, 0.6040000000000001, 0.356, 0.9295, 0.505, 4.9245, 0.3165, 0.6335, 0.344, 0.2935, 0.386, 0.4595, 0.2805, 0.323, 0.296, 0.456, 0.132, 0.2525, 0.165, 0.894, 0.3265, 0.228, 0.815, 0.089, 0.006, 0.236, 0.34299999999999997, 1.411, 24.988999999999997, 0.093, 0.6665000000000001, 0.5285, 0.3665, 0.5135000000000001, 0.1555, 0.21000000000000002, 2.6870000000000003, 0.249, 0.6895, 0.20750000000000002, 0.118, 0.444, 0.4375, 0.128, 0.46699999999999997, 0.353, 0.14150000000000001, 1.064, 0.2945, 0.3245, 0.697, 0.361, 0.1655, 0.288, 0.155, 0.8, 0.1785, 0.45, 0.447, 0.4015, 0.183, 0.1095, 0.2205, 0.3505, 1.7785000000000002, 0.314, 0.3475, 1.0790000000000002, 0.0, 0.1215, 0.0945, 0.671, 3.2554999999999996, 6.4265, 0.503, 0.5, 0.311},
+		{0.606, 0.47050000000000003, 0.798, 0.235, 0.4735, 0.248, 0.981, 0.5185, 0.2955, 0.508, 0.3845, 0.6895, 0.4785, 0.45, 0.2615, 0.4005, 0.20850000000000002, 0.1445, 0.5905, 0.562, 0.265, 0.1925, 0.6625000000000001, 0.266, 0.139, 0.478, 0.198, 0.23, 1.104, 0.4375, 0.4375, 0.567, 1.8485, 0.2995, 1.0915, 0.4105, 0.357, 0.2925, 0.485, 0.558, 0.441, 0.34650000000000003, 0.5125, 7.8965, 0.376, 3.7265, 1.2685, 0.556, 0.6385000000000001, 0.5255000000000001, 0.8255, 3.9350000000000005, 16.724, 2.9935, 0.47, 0.293, 0.457, 1.5074999999999998, 0.4155, 0.40700000000000003, 0.216, 0.4185, 1.5685, 0.3915, 0.441, 0.4265, 0.476, 0.4335, 0.309, 0.6985, 0.4475, 0.7685, 0.6065, 0.462, 0.2445, 0.5015000000000001, 0.3775, 0.4085, 14.515, 0.44399999999999995, 0.8105, 0.4015, 0.856, 0.368, 1.205, 0.6005, 0.6105, 0.3475, 0.388, 1.6925, 0.53, 0.597, 0.442, 0.441, 7.9855, 0.363, 0.401, 2.008, 0.5365, 0.7895000000000001, 0.4125, 0.4035, 0.9205000000000001, 0.41900000000000004, 0.4235, 6.3134999999999994, 0.404, 0.3805, 0.5585, 0.1635, 0.964, 0.139, 0.635, 0.2535, 1.2120000000000002, 0.2205, 0.1285, 0.503, 0.339, 1.627, 0.4105, 1.0579999999999998, 0.7010000000000001, 4.8695, 0.3935, 0.629, 0.267, 0.3265, 0.443, 0.4655, 0.3245, 0.3845, 0.3365, 0.5325, 0.218, 0.491, 0.1835, 0.726, 0.46699999999999997, 0.197, 0.8089999999999999, 0.215, 0.225, 0.0125, 0.33599999999999997, 1.5899999999999999, 27.618000000000002, 0.193, 0.5455000000000001, 0.1895, 0.3845, 0.526, 0.16749999999999998, 0.1335, 2.652, 0.2515, 0.647, 0.266, 0.1785, 0.5395, 0.5165, 0.20450000000000002, 0.4985, 0.3635, 0.27, 1.5274999999999999, 0.3565, 0.397, 0.6174999999999999, 0.3285, 0.20650000000000002, 0.3835, 0.163, 0.865, 0.2645, 0.536, 0.4895, 0.3475, 0.241, 0.176, 0.2585, 0.3935, 1.9329999999999998, 0.3785, 0.3785, 1.2425, 0.0, 0.2125, 0.151, 0.7364999999999999, 3.2885, 5.4855, 0.556, 0.5505, 0.4215},
+		{0.5075000000000001, 0.46099999999999997, 0.9305000000000001, 0.314, 0.5675, 0.3035, 1.827, 0.7395, 0.373, 0.5255000000000001, 0.432, 0.7969999999999999, 0.4125, 0.4885, 0.3355, 0.443, 0.3035, 0.315, 0.8, 0.6085, 0.432, 0.344, 0.545, 0.3885, 0.31, 0.492, 0.373, 0.3275, 0.7925, 0.4325, 0.473, 0.6, 2.1654999999999998, 0.3885, 1.0285, 0.515, 0.4795, 0.3505, 0.605, 0.653, 0.49, 0.4675, 0.573, 7.817, 0.4325, 3.487, 0.677, 0.665, 0.683, 0.496, 0.3355, 4.515, 14.5605, 2.6585, 0.6495, 0.4475, 0.4805, 0.6895, 0.4995, 0.41100000000000003, 0.303, 0.5685, 1.7409999999999999, 0.4375, 0.505, 0.427, 0.518, 0.3875, 0.3665, 0.54, 0.5235000000000001, 0.5475000000000001, 0.706, 0.597, 0.3445, 0.552, 0.4075, 0.41600000000000004, 13.1985, 0.463, 0.9005000000000001, 0.449, 0.847, 0.445, 0.6785, 0.6475, 0.589, 0.3555, 0.4615, 1.749, 0.631, 0.661, 0.535, 0.5085, 12.190999999999999, 0.446, 0.751, 0.69, 0.5455, 0.913, 0.4775, 0.5085, 0.8905000000000001, 0.678, 0.427, 6.1155, 0.4565, 0.428, 0.8495, 0.297, 0.882, 0.284, 0.7795000000000001, 0.366, 1.0105, 0.344, 0.2645, 0.6795, 0.383, 1.7494999999999998, 0.5035000000000001, 1.029, 0.593, 4.48, 0.455, 0.695, 0.491, 0.5640000000000001, 0.459, 0.531, 0.383, 0.398, 0.5345, 0.6165, 0.3765, 0.3905, 0.3175, 0.9119999999999999, 0.454, 0.306, 0.85, 0.332, 0.28200000000000003, 0.639, 0.0215, 1.754, 26.934, 0.34, 0.6045, 0.7535000000000001, 0.413, 0.8525, 0.3275, 0.317, 2.659, 0.3275, 0.6995, 0.376, 0.409, 0.651, 0.534, 0.291, 0.438, 0.265, 0.3635, 1.226, 0.4395, 0.445, 0.7865, 0.3885, 0.3675, 0.421, 0.2925, 0.699, 0.3325, 0.582, 0.739, 0.443, 0.34450000000000003, 0.2945, 0.344, 0.396, 1.877, 0.4145, 0.5395000000000001, 1.334, 0.0, 0.3235, 0.2815, 0.9125, 3.4555, 6.303, 0.6405000000000001, 0.5095000000000001, 0.45},
+		{1.431, 2.8659999999999997, 1.916, 1.2095, 1.2365, 0.9390000000000001, 2.231, 1.4434999999999998, 1.423, 3.6515, 1.0514999999999999, 3.1645, 1.1455, 2.3135, 1.8805, 1.1215000000000002, 1.2845, 1.2469999999999999, 1.1855, 1.6215000000000002, 0.6395, 1.1364999999999998, 1.522, 1.428, 1.2045, 1.2585000000000002, 1.2530000000000001, 0.961, 2.6595000000000004, 1.6835, 1.319, 1.9755, 2.8905000000000003, 1.1875, 0.9795, 1.01, 1.4129999999999998, 1.2275, 1.4775, 1.0425, 1.948, 1.04, 1.2345, 10.538, 1.2675, 4.9595, 2.989, 1.22, 1.3605, 1.43, 1.154, 6.606, 15.582, 5.0145, 1.0899999999999999, 1.514, 1.4245, 1.5855000000000001, 1.8445, 1.385, 1.2685, 1.0790000000000002, 3.541, 1.0615, 1.07, 1.0935000000000001, 0.7084999999999999, 1.193, 1.0815000000000001, 1.46, 1.219, 2.1065, 1.075, 1.3125, 1.8664999999999998, 1.157, 1.2855, 1.413, 12.7445, 1.5924999999999998, 1.6575, 0.8245, 1.833, 1.1909999999999998, 1.6835, 1.1204999999999998, 1.4295, 1.0265, 1.3039999999999998, 2.531, 1.0885, 1.281, 1.08, 1.274, 10.469999999999999, 0.994, 0.964, 1.704, 0.716, 2.0085, 1.2155, 1.4715, 1.4365, 2.032, 1.3635, 8.806000000000001, 1.3025, 1.1775, 5.187, 1.085, 1.5105, 0.765, 1.1295000000000002, 1.1604999999999999, 1.9235, 1.7134999999999998, 1.893, 0.8565, 1.3775, 4.0635, 1.44, 2.2515, 1.5394999999999999, 6.1525, 1.0554999999999999, 1.5175, 1.138, 1.222, 1.2705, 1.18, 0.871, 1.4285, 1.177, 1.259, 1.278, 2.2915, 1.2605, 1.1935, 1.6895, 0.9495, 1.599, 1.8535, 1.1035, 1.2875, 1.2925, 0.0675, 27.8065, 1.6085, 1.887, 2.5625, 1.3815, 1.486, 1.1110000000000002, 1.1825, 3.137, 1.3915, 1.504, 1.6185, 1.2650000000000001, 1.847, 1.565, 1.4075, 1.072, 1.6395, 1.184, 1.4505, 0.9644999999999999, 1.5775000000000001, 5.891, 1.3519999999999999, 1.1595, 1.0975000000000001, 1.1560000000000001, 3.223, 1.488, 2.0515, 1.8485, 1.3944999999999999, 1.1015000000000001, 1.244, 1.2285, 1.5579999999999998, 2.793, 1.1185, 0.9125000000000001, 1.805, 0.0, 2.2755, 1.475, 2.0745, 4.284000000000001, 5.939, 0.8385, 1.8405, 1.006},
+		{22.471, 31.697499999999998, 31.7575, 29.57, 23.0305, 35.099999999999994, 36.507000000000005, 31.738, 30.7915, 29.945999999999998, 30.826999999999998, 30.456, 29.506, 31.9155, 33.8785, 29.15, 30.273, 32.026, 30.275, 31.627499999999998, 29.057000000000002, 32.306, 30.4035, 33.403999999999996, 27.681, 29.916, 30.157, 32.232, 25.8315, 30.407, 30.1605, 29.89, 30.572, 34.010000000000005, 31.7925, 29.555500000000002, 30.451, 30.441, 25.5465, 31.248, 29.704500000000003, 30.186500000000002, 28.156, 35.815, 30.3825, 29.962, 30.136000000000003, 30.195500000000003, 29.5775, 30.435000000000002, 33.18, 30.512500000000003, 32.9965, 32.0905, 23.6605, 31.3295, 30.908, 25.108, 29.802500000000002, 31.0575, 30.7795, 34.4755, 28.342, 28.639499999999998, 31.651, 30.019, 28.5735, 29.6185, 29.3425, 32.179500000000004, 30.332, 29.789, 31.8765, 32.183499999999995, 27.975, 25.41, 28.377000000000002, 29.4465, 30.377000000000002, 27.747999999999998, 39.1545, 28.497, 36.7055, 29.207, 29.0745, 24.455, 31.6725, 28.9835, 31.6845, 35.308499999999995, 26.5915, 22.027, 30.976, 29.8425, 33.4505, 30.644, 30.363999999999997, 25.923000000000002, 28.387999999999998, 28.113, 31.198999999999998, 30.86, 31.6295, 31.110999999999997, 31.336, 38.6435, 30.969, 28.1685, 37.3155, 26.7785, 24.679000000000002, 29.649500000000003, 26.522, 28.618499999999997, 31.5075, 31.6445, 30.0205, 28.698500000000003, 31.118000000000002, 30.463, 29.5945, 30.14, 29.222, 28.108, 29.472, 30.936999999999998, 31.4185, 30.058500000000002, 26.615000000000002, 29.9345, 30.161, 29.6895, 30.5485, 30.828, 29.493000000000002, 36.7085, 31.962000000000003, 29.747, 35.283500000000004, 30.860500000000002, 29.570999999999998, 29.209000000000003, 27.076999999999998, 31.1965, 38.2005, 31.8955, 1.4785, 29.514499999999998, 30.420499999999997, 29.984, 31.2675, 22.124499999999998, 31.11, 31.918, 27.992, 31.0895, 33.962999999999994, 31.4945, 28.5195, 31.860999999999997, 28.697499999999998, 33.004000000000005, 25.734, 33.2665, 30.025, 28.8005, 28.581, 29.418999999999997, 25.6115, 30.006999999999998, 30.685, 28.413, 29.2545, 24.5595, 31.6985, 38.6845, 27.191000000000003, 29.1625, 29.533, 35.661500000000004, 30.4375, 30.6475, 30.404, 29.384, 33.346000000000004, 29.2785, 0.0, 31.028, 29.7305, 37.744, 33.682500000000005, 33.092, 27.8575, 29.093, 30.9175},
+		{0.4745, 0.361, 0.7585, 0.185, 0.437, 0.35350000000000004, 1.1684999999999999, 0.4625, 0.24, 0.435, 0.3025, 0.655, 0.379, 0.5065, 0.236, 0.3025, 0.1525, 0.1935, 0.4955, 0.5055000000000001, 0.2745, 0.1915, 0.6995, 0.2705, 0.173, 0.42, 0.2285, 0.2735, 0.8375, 0.352, 0.371, 0.583, 2.0205, 0.272, 0.8805000000000001, 0.381, 0.4185, 0.28600000000000003, 0.5, 0.538, 0.34550000000000003, 0.34299999999999997, 0.4475, 7.5915, 0.352, 3.9705, 0.5635, 0.4995, 0.581, 0.618, 0.6505000000000001, 4.2985, 15.802499999999998, 2.235, 0.487, 0.364, 0.3555, 0.677, 0.3895, 0.3695, 0.1425, 0.3745, 1.9485000000000001, 0.319, 0.3775, 0.3775, 0.394, 0.3745, 0.271, 0.379, 0.418, 0.6555, 0.5785, 0.4895, 0.2175, 0.5515000000000001, 0.287, 0.33, 13.975, 0.3935, 1.001, 0.352, 0.772, 0.374, 0.641, 0.577, 0.541, 0.288, 0.3215, 1.7109999999999999, 0.56, 0.629, 0.376, 0.386, 13.1955, 0.358, 0.3265, 0.4945, 0.4945, 0.6085, 0.4105, 0.389, 0.907, 0.35, 0.34550000000000003, 6.041, 0.4085, 0.345, 0.8360000000000001, 0.2145, 1.025, 0.204, 0.535, 0.23, 1.0425, 0.18, 0.1475, 0.52, 0.342, 1.6005, 0.397, 0.8225, 0.5995, 5.487500000000001, 0.4105, 0.505, 0.277, 0.3815, 0.3855, 0.4965, 0.3045, 0.2615, 0.281, 0.5665, 0.14250000000000002, 0.327, 0.235, 0.6725000000000001, 0.379, 0.2345, 0.997, 0.144, 0.0925, 0.1945, 0.3905, 1.873, 26.9905, 0.0055, 0.701, 0.238, 0.371, 0.518, 0.249, 0.2065, 2.5435, 0.2615, 0.8634999999999999, 0.179, 0.305, 0.597, 0.4815, 0.146, 0.40800000000000003, 0.492, 0.1855, 1.0405, 0.311, 0.36, 0.998, 0.3105, 0.2115, 0.318, 0.2005, 0.7244999999999999, 0.2075, 0.6395, 0.399, 0.383, 0.2565, 0.1965, 0.2145, 0.3745, 1.8065, 0.3265, 0.336, 1.1135000000000002, 0.0, 0.079, 0.149, 1.3975, 3.3994999999999997, 6.4035, 0.602, 0.4375, 0.35},
+		{0.7995000000000001, 0.694, 1.3675000000000002, 0.653, 0.766, 0.5705, 1.5065, 0.9325000000000001, 0.6905, 1.4155, 0.537, 1.2925, 0.6375, 0.8674999999999999, 0.595, 0.6505000000000001, 0.48750000000000004, 0.8745, 0.8674999999999999, 0.978, 0.96, 0.6335, 0.8474999999999999, 0.6575, 0.541, 0.6525000000000001, 0.7935, 0.569, 1.795, 0.654, 0.628, 1.0045, 2.135, 0.6445000000000001, 1.204, 0.8035, 0.9584999999999999, 0.6535, 1.1059999999999999, 1.0995, 0.779, 0.8155, 1.251, 6.405, 0.683, 4.5775, 0.873, 0.9524999999999999, 0.9199999999999999, 0.8314999999999999, 0.5265, 4.731, 15.5245, 3.4455, 0.8714999999999999, 0.88, 0.6819999999999999, 1.0965, 0.6395, 0.7244999999999999, 0.603, 0.6715, 3.217, 0.5874999999999999, 0.944, 0.7995000000000001, 0.762, 0.737, 0.67, 1.031, 0.867, 1.261, 0.8545, 0.657, 0.536, 0.855, 0.7565, 0.6515, 11.739, 1.0205, 1.0095, 0.576, 1.2635, 0.794, 0.798, 1.1044999999999998, 0.8345, 0.858, 0.717, 2.1225, 0.7935000000000001, 1.0110000000000001, 0.889, 0.9045000000000001, 11.2865, 0.7284999999999999, 10.3045, 1.3905, 0.7665, 1.439, 0.673, 0.8985, 1.158, 0.7775000000000001, 0.788, 5.5815, 0.7264999999999999, 0.6025, 1.1835, 0.653, 1.3145, 0.683, 1.1775, 0.5185, 1.545, 0.802, 0.73, 0.767, 0.9325000000000001, 2.641, 0.7515000000000001, 1.496, 1.1415, 5.566, 0.6955, 0.7855, 0.5715, 0.5175000000000001, 0.78, 0.687, 0.733, 0.7825, 0.877, 0.6955, 0.5885, 0.881, 0.7715000000000001, 1.3725, 0.8545, 0.537, 1.057, 0.7295, 0.5385, 0.635, 0.5965, 2.0845000000000002, 29.002, 0.7344999999999999, 0.009, 1.108, 0.6165, 0.7925, 0.677, 0.38, 2.7685, 0.6385000000000001, 1.0415, 0.797, 0.5745, 0.9045000000000001, 1.2435, 0.505, 0.788, 0.9345, 0.692, 1.5074999999999998, 0.5705, 0.613, 3.0105, 0.61, 0.6705, 0.8815, 0.722, 1.4775, 0.927, 1.2025000000000001, 0.9450000000000001, 0.683, 0.6855, 0.666, 0.489, 0.7949999999999999, 2.37, 0.628, 0.5974999999999999, 1.5314999999999999, 0.0, 0.596, 0.5025, 1.3315000000000001, 3.104, 7.317, 0.7975, 0.8035000000000001, 0.5525},
+		{0.657, 0.444, 1.2805, 0.29, 0.901, 0.2345, 1.483, 0.6765, 0.3535, 4.0, 0.35, 0.9075, 0.402, 0.527, 0.262, 0.4395, 0.2475, 0.196, 0.734, 0.6765000000000001, 0.241, 0.217, 0.678, 0.3165, 0.218, 0.4225, 0.1605, 0.222, 1.0579999999999998, 0.4395, 0.4475, 0.541, 2.2145, 0.5265, 0.933, 0.45799999999999996, 1.2770000000000001, 0.34450000000000003, 0.6565000000000001, 0.696, 0.461, 0.3985, 0.599, 9.072, 0.449, 4.2295, 0.8334999999999999, 0.534, 0.579, 1.48, 0.537, 4.2905, 15.7675, 3.1725000000000003, 0.6805000000000001, 0.3815, 0.6465, 0.695, 0.49, 0.47150000000000003, 0.3305, 0.5055000000000001, 1.6600000000000001, 0.4415, 0.5025, 0.461, 0.4365, 0.46299999999999997, 0.405, 1.235, 0.525, 0.7255, 0.6505000000000001, 0.6455, 0.2735, 0.553, 0.685, 0.421, 13.533999999999999, 1.381, 1.3475000000000001, 0.422, 0.8095, 0.407, 1.0545, 0.6375, 0.645, 0.5305, 0.4505, 1.718, 0.6425000000000001, 0.983, 0.8925000000000001, 0.4365, 14.477, 0.322, 0.8945, 0.8734999999999999, 0.531, 0.7965, 0.3845, 0.47150000000000003, 1.0699999999999998, 0.4295, 0.729, 7.1745, 0.4085, 0.438, 1.1935, 0.303, 0.8835, 0.232, 0.6165, 0.292, 1.466, 2.0035, 0.216, 0.526, 0.3615, 1.6095, 0.817, 1.0070000000000001, 0.912, 5.743, 0.389, 0.859, 0.3775, 0.383, 0.5385, 0.5660000000000001, 0.4, 0.367, 0.4175, 0.611, 0.2905, 0.3905, 0.2785, 1.627, 0.5805, 0.314, 1.5155, 0.32799999999999996, 0.8825000000000001, 0.2045, 0.387, 2.1115, 25.133499999999998, 0.273, 0.9890000000000001, 0.014, 0.443, 0.777, 0.266, 0.2205, 2.8505000000000003, 0.329, 0.75, 0.4625, 0.461, 0.5814999999999999, 0.5295000000000001, 0.249, 0.5854999999999999, 0.742, 0.823, 1.755, 0.431, 0.3625, 4.216, 0.9099999999999999, 0.3175, 0.688, 0.2535, 3.0940000000000003, 0.3395, 0.6255, 2.0374999999999996, 1.3455, 0.281, 0.2365, 0.3635, 0.4835, 2.207, 0.4815, 0.4285, 1.5495, 0.0, 0.253, 0.224, 1.982, 3.6035000000000004, 4.541, 0.7495, 0.9490000000000001, 0.4425},
+		{0.625, 0.463, 0.711, 0.401, 0.542, 0.4125, 0.9644999999999999, 0.603, 0.457, 0.5954999999999999, 0.517, 0.8455, 0.51, 0.595, 0.4135, 0.5255000000000001, 0.412, 0.3555, 0.7135, 0.6795, 0.451, 0.423, 0.5705, 0.4465, 0.3855, 0.545, 0.4035, 0.3915, 0.9904999999999999, 0.5255000000000001, 0.504, 0.6465000000000001, 1.9460000000000002, 0.497, 0.716, 0.5714999999999999, 0.4745, 0.4605, 0.5885, 0.6825000000000001, 0.506, 0.48, 0.6415, 8.7975, 0.478, 3.9465000000000003, 0.7484999999999999, 0.646, 0.6585000000000001, 0.5405, 0.7615000000000001, 3.6525, 14.0275, 2.439, 0.6685000000000001, 0.48, 0.5415000000000001, 0.7515000000000001, 0.5525, 0.52, 0.4015, 0.547, 2.5439999999999996, 0.503, 0.533, 0.465, 0.582, 0.472, 0.43, 0.5015000000000001, 0.6094999999999999, 0.704, 0.6915, 0.611, 0.418, 0.696, 0.48, 0.5165, 11.965, 0.5585, 0.8945000000000001, 0.4855, 0.908, 0.4965, 0.607, 0.6605000000000001, 11.895499999999998, 0.4475, 0.5315000000000001, 1.6520000000000001, 0.673, 0.698, 0.488, 0.517, 7.7145, 0.47, 0.5255000000000001, 0.7495, 0.6245, 0.9405, 0.5205, 0.482, 0.9075, 0.5285, 0.512, 6.6739999999999995, 0.5475000000000001, 0.5005, 0.749, 0.385, 1.025, 0.374, 0.6485000000000001, 0.4495, 0.8685, 0.4215, 0.364, 0.565, 0.453, 2.721, 0.534, 0.835, 0.6765, 4.8725000000000005, 0.4905, 0.6884999999999999, 0.501, 0.4985, 0.5894999999999999, 0.555, 0.484, 0.481, 0.48450000000000004, 0.5714999999999999, 0.415, 0.458, 0.393, 0.7825, 0.5485, 0.425, 0.873, 0.3865, 0.3665, 0.4, 0.4305, 1.4655, 28.6845, 0.3885, 0.698, 0.438, 0.01, 0.6074999999999999, 0.3885, 0.409, 2.7649999999999997, 0.406, 0.813, 0.4265, 0.3875, 0.635, 0.59, 0.381, 0.5465, 0.4615, 0.4155, 1.393, 0.4965, 0.5085, 0.8815, 0.4605, 0.4065, 0.483, 0.378, 0.8234999999999999, 0.4235, 0.678, 0.5934999999999999, 0.476, 0.3975, 0.381, 0.488, 0.5135000000000001, 1.7885, 0.4745, 0.5275000000000001, 1.4100000000000001, 0.0, 0.418, 0.381, 0.7905, 2.7565, 6.1075, 0.642, 0.5025, 0.5365},
+		{0.716, 0.567, 1.1475, 0.563, 0.3195, 0.658, 1.013, 0.6910000000000001, 0.5714999999999999, 0.573, 0.607, 0.9135, 0.5994999999999999, 0.6105, 0.485, 0.542, 0.6275, 0.5505, 0.4255, 0.6415, 0.5595, 0.524, 0.834, 0.633, 0.509, 0.453, 0.537, 0.4895, 0.6859999999999999, 0.444, 0.577, 1.0655000000000001, 2.0655, 0.624, 1.129, 0.4285, 0.637, 0.56, 0.6385000000000001, 0.622, 0.4595, 0.5720000000000001, 0.645, 2.8175, 0.5285, 3.872, 0.394, 0.8274999999999999, 0.622, 0.7084999999999999, 0.585, 4.843999999999999, 14.452, 2.7880000000000003, 0.2735, 0.7555000000000001, 0.5595, 0.396, 0.5015000000000001, 0.5765, 0.5435000000000001, 0.4865, 2.034, 0.4995, 0.4385, 0.518, 0.3505, 0.4615, 0.6014999999999999, 0.7104999999999999, 0.4535, 0.7625, 0.657, 0.5115000000000001, 0.4845, 0.325, 0.5725, 0.4775, 13.132000000000001, 0.67, 0.967, 0.525, 0.811, 0.48750000000000004, 0.5325, 0.2685, 1.6364999999999998, 0.506, 0.4755, 1.986, 1.112, 0.4095, 0.482, 0.4935, 7.5, 0.7010000000000001, 0.6214999999999999, 1.38, 0.6014999999999999, 1.2335, 0.5640000000000001, 0.4935, 0.8965000000000001, 0.493, 0.5275000000000001, 6.6594999999999995, 0.891, 0.4315, 0.665, 0.5914999999999999, 0.8605, 0.5215000000000001, 0.523, 0.5155000000000001, 1.1505, 0.5785, 0.4245, 0.3235, 0.6295, 1.361, 0.57, 0.8505, 0.7735000000000001, 4.765, 0.4885, 0.344, 0.40349999999999997, 0.5925, 0.796, 0.668, 0.502, 0.5235000000000001, 0.68, 0.4695, 0.564, 0.61, 0.498, 0.888, 0.6025, 0.576, 0.9205000000000001, 0.46699999999999997, 0.5515000000000001, 0.532, 1.451, 1.6165, 27.5895, 0.503, 0.9884999999999999, 0.7475, 0.5914999999999999, 0.0115, 0.474, 0.5774999999999999, 2.8045, 0.5894999999999999, 0.829, 0.4965, 0.4125, 0.729, 1.1165, 0.502, 0.446, 0.6890000000000001, 0.577, 1.4715, 0.5495, 0.52, 0.766, 0.5335000000000001, 0.657, 0.5714999999999999, 0.4645, 1.131, 0.4875, 0.8545, 0.704, 0.6265000000000001, 0.625, 0.4615, 0.6675, 0.524, 1.9754999999999998, 0.542, 0.4945, 1.4645000000000001, 0.0, 0.475, 0.5055000000000001, 0.788, 3.4405, 4.1935, 0.5275000000000001, 1.1724999999999999, 0.618},
+		{0.51, 0.4335, 0.8140000000000001, 0.2135, 0.548, 0.3565, 1.0594999999999999, 0.567, 0.281, 0.452, 0.3535, 0.6685, 0.402, 0.442, 0.2585, 0.3865, 0.191, 0.1395, 0.6995, 0.508, 0.276, 0.2375, 0.5715, 0.3115, 0.11699999999999999, 0.517, 0.189, 0.261, 1.1095000000000002, 0.362, 0.4145, 0.5175000000000001, 1.9100000000000001, 0.2865, 0.5289999999999999, 0.426, 0.3705, 0.27949999999999997, 0.504, 0.622, 0.402, 0.336, 0.46399999999999997, 8.462, 0.3535, 4.225, 0.6799999999999999, 0.599, 0.6745, 0.4775, 0.156, 4.4305, 15.3785, 2.7355, 0.515, 0.317, 0.3625, 0.8075000000000001, 0.40900000000000003, 0.3435, 0.2285, 0.437, 1.7065000000000001, 0.375, 0.46950000000000003, 0.405, 0.4875, 0.3885, 0.3095, 0.482, 0.4215, 0.5615, 0.64, 0.4865, 0.22849999999999998, 0.5625, 0.342, 0.359, 13.2955, 0.4345, 0.8514999999999999, 0.3375, 0.7785, 0.3785, 0.4675, 0.6134999999999999, 0.5385, 0.3405, 0.338, 1.658, 0.612, 0.5205, 0.356, 0.47650000000000003, 8.245, 0.34299999999999997, 0.3805, 6.325, 0.4845, 0.8474999999999999, 0.368, 0.401, 0.8554999999999999, 0.47350000000000003, 0.32, 6.204, 0.4105, 0.3555, 0.7785, 0.2325, 0.848, 0.1685, 0.5805, 0.2335, 0.894, 0.2155, 0.1095, 0.5125, 0.257, 1.168, 0.4085, 0.9624999999999999, 0.5735, 5.348, 0.4155, 0.538, 0.3965, 0.352, 0.4815, 0.417, 0.386, 0.357, 0.3785, 0.443, 0.23199999999999998, 0.2525, 0.1355, 0.727, 0.3485, 0.2295, 0.794, 0.191, 0.1515, 0.25, 0.3625, 1.435, 26.271500000000003, 0.214, 0.757, 0.2875, 0.377, 0.4355, 0.012, 0.2705, 2.5315000000000003, 0.241, 0.8775, 0.3115, 0.175, 0.5720000000000001, 0.483, 0.187, 0.43, 0.437, 0.2125, 1.2795, 0.3085, 0.377, 0.6595, 0.318, 0.21700000000000003, 0.298, 0.1635, 0.718, 0.2725, 0.6765, 0.51, 0.337, 0.2475, 0.1565, 0.255, 0.3385, 1.8065, 0.40700000000000003, 0.455, 1.1680000000000001, 0.0, 0.185, 0.11, 0.6385000000000001, 2.6315, 6.4925, 0.578, 0.389, 0.3945},
+		{0.6085, 0.409, 0.745, 0.233, 0.43, 0.274, 0.9325, 0.6005, 0.255, 0.4665, 0.335, 0.745, 0.4135, 0.3725, 0.2885, 0.34650000000000003, 0.239, 0.1275, 0.658, 0.569, 0.2265, 0.157, 0.5195000000000001, 0.214, 0.1655, 0.4355, 0.2495, 0.2415, 0.765, 0.4, 0.35, 0.4825, 2.0275, 0.2785, 1.013, 0.4625, 0.3525, 0.271, 0.4205, 0.529, 0.415, 0.3315, 0.5655, 5.8260000000000005, 0.3155, 3.891, 0.6405000000000001, 0.5295000000000001, 0.6225, 0.431, 0.182, 4.4025, 15.3215, 2.3075, 0.5025, 0.319, 0.351, 0.629, 0.4735, 0.365, 0.278, 0.3605, 2.009, 0.4, 0.3375, 0.367, 0.456, 0.424, 0.3185, 0.522, 0.3795, 0.615, 0.5665, 0.4385, 0.2455, 0.5794999999999999, 0.338, 0.313, 14.175, 0.433, 0.8105, 0.3625, 0.7355, 0.321, 0.5225, 0.6174999999999999, 5.0565, 0.3075, 0.2835, 1.4625, 0.533, 0.5914999999999999, 0.399, 0.44, 10.59, 0.3505, 0.415, 1.9224999999999999, 0.512, 0.853, 0.4155, 0.4195, 0.88, 0.437, 0.36, 5.6995000000000005, 0.4285, 0.3715, 0.41800000000000004, 0.1695, 0.9075, 0.195, 0.5994999999999999, 0.23399999999999999, 1.2865, 0.2555, 0.1665, 0.5005, 0.275, 1.666, 0.396, 0.9, 0.631, 5.0055, 0.40549999999999997, 0.553, 0.315, 0.352, 0.4645, 0.454, 0.319, 0.386, 0.3215, 0.5555000000000001, 0.2455, 0.2765, 0.1895, 0.8445, 0.4095, 0.167, 0.7855000000000001, 0.196, 0.23249999999999998, 0.1245, 0.328, 1.1665, 24.9035, 0.1565, 0.7455, 0.232, 0.3765, 0.5145, 0.255, 0.012, 2.5149999999999997, 0.24, 0.5785, 0.308, 0.194, 0.5135000000000001, 0.5255000000000001, 0.194, 0.384, 0.3065, 0.2205, 1.1295000000000002, 0.336, 0.366, 0.6775, 0.314, 0.2845, 0.3235, 0.19, 0.671, 0.2575, 0.491, 0.4845, 0.3705, 0.246, 0.172, 0.243, 0.3615, 1.9455, 0.33999999999999997, 0.4315, 1.225, 0.0, 0.236, 0.1835, 0.562, 3.315, 5.504, 0.492, 0.4485, 0.3565},
+		{2.951, 2.9325, 2.9775, 0.5255000000000001, 1.6825, 2.0115, 2.7965, 2.0285, 0.752, 3.352, 0.5975, 3.7720000000000002, 0.6635, 2.8555, 1.293, 2.6805, 0.5245, 2.1165, 0.915, 2.5885, 2.5045, 1.97, 3.0374999999999996, 2.424, 3.883, 3.141, 2.7889999999999997, 1.4455, 4.165, 2.207, 2.3145, 1.045, 2.339, 2.372, 1.7, 1.815, 2.4945, 2.7889999999999997, 3.5415, 0.7925, 0.9455, 2.2560000000000002, 2.271, 7.055, 3.1995, 5.319, 4.261, 1.3559999999999999, 3.896, 1.025, 0.7005, 4.6655, 15.1455, 4.0265, 1.365, 4.0835, 2.9325, 1.77, 1.9695, 0.64, 2.456, 1.455, 3.7155, 0.7155, 3.5055, 0.4915, 3.106, 0.47150000000000003, 2.5115, 1.3795, 1.3335, 0.9485000000000001, 2.1414999999999997, 1.821, 1.7015, 2.758, 0.5740000000000001, 2.73, 13.739, 0.7025, 2.324, 3.4395, 1.661, 1.88, 2.2175000000000002, 1.8665, 5.0745000000000005, 2.055, 2.3695, 4.0835, 1.7445, 0.9390000000000001, 2.526, 1.496, 7.997999999999999, 0.88, 1.199, 2.9605, 0.774, 3.184, 3.1879999999999997, 2.152, 2.848, 0.9575, 1.1515, 7.0735, 0.5665, 0.5235, 3.0519999999999996, 1.589, 2.9029999999999996, 2.754, 1.9615, 2.386, 1.866, 3.4875, 3.2270000000000003, 3.787, 1.7625, 3.4695, 1.8855, 1.8570000000000002, 2.5934999999999997, 5.561, 3.008, 2.759, 1.884, 2.541, 1.873, 2.698, 0.756, 3.6205, 2.1825, 2.0335, 2.107, 2.6845, 0.9035, 1.77, 4.0225, 3.1195000000000004, 2.0549999999999997, 3.082, 0.5025, 1.5425, 2.2085, 4.1754999999999995, 26.172, 1.84, 2.2445000000000004, 2.511, 1.8445, 2.365, 2.395, 1.256, 0.048, 1.1175, 1.995, 3.4795, 2.6365, 1.93, 1.7825, 2.817, 0.504, 3.349, 2.5525, 3.3515, 1.287, 2.306, 3.608, 1.6715, 1.1084999999999998, 1.0065, 0.7245, 3.0465, 1.58, 2.2074999999999996, 1.3119999999999998, 2.9715, 1.9515, 2.098, 2.4444999999999997, 3.0345, 2.5055, 1.952, 3.6189999999999998, 2.529, 0.0, 3.124, 2.157, 2.2925, 3.7875, 6.4505, 3.5305, 1.6204999999999998, 1.889},
+		{0.5754999999999999, 0.487, 0.9415, 0.3045, 0.567, 0.259, 1.2555, 0.5545, 0.3, 0.5415000000000001, 0.3695, 0.5595, 0.4425, 0.5375000000000001, 0.296, 0.47, 0.314, 0.197, 0.5994999999999999, 0.585, 0.326, 0.248, 0.669, 0.31, 0.23, 0.5005, 0.231, 0.253, 0.973, 0.49, 0.416, 0.5635, 1.9145, 0.3575, 0.7985, 0.5265, 0.3645, 0.3495, 0.4985, 0.6859999999999999, 0.399, 0.391, 0.602, 6.0125, 0.401, 4.1690000000000005, 0.582, 0.4595, 0.553, 0.515, 0.2915, 4.108499999999999, 16.425, 3.308, 0.6755, 0.309, 0.435, 0.69, 0.5085, 0.434, 0.311, 0.4735, 1.3094999999999999, 0.433, 0.495, 0.4115, 0.468, 0.41, 0.3865, 0.526, 0.429, 0.562, 0.7244999999999999, 0.516, 0.2745, 0.6174999999999999, 0.3805, 0.388, 12.7865, 0.5, 0.8755, 0.514, 0.786, 0.414, 0.716, 0.678, 0.58, 0.348, 0.3805, 1.5354999999999999, 0.5714999999999999, 0.616, 0.424, 0.4565, 6.5675, 0.4945, 0.3895, 2.817, 0.5660000000000001, 0.85, 0.464, 0.4765, 0.896, 0.4755, 0.3845, 6.0745000000000005, 0.4935, 0.4395, 0.5675, 0.212, 0.845, 0.227, 0.5725, 0.3225, 1.87, 0.3265, 0.236, 0.571, 0.315, 1.8755000000000002, 0.4465, 1.024, 0.739, 3.9895, 0.4255, 0.5734999999999999, 0.3825, 1.578, 0.512, 0.517, 0.378, 0.418, 0.4245, 0.517, 0.331, 0.3555, 0.254, 0.7725, 0.46299999999999997, 0.149, 0.8434999999999999, 0.278, 0.2685, 0.2085, 0.3505, 1.1735, 25.7245, 0.2505, 0.7155, 0.29400000000000004, 0.42, 0.548, 0.2555, 0.245, 2.5890000000000004, 0.038, 0.7030000000000001, 0.2835, 0.2565, 0.5265, 0.5535, 0.28200000000000003, 0.508, 0.3115, 0.3245, 0.2465, 0.354, 0.3925, 0.738, 0.382, 0.281, 0.3755, 0.239, 0.6405000000000001, 0.3295, 0.5405, 0.556, 0.349, 0.287, 0.271, 0.3145, 0.3445, 1.906, 0.4335, 0.4105, 1.4205, 0.0, 0.499, 0.20350000000000001, 0.671, 3.2755, 5.506, 0.6365000000000001, 0.4005, 0.4255},
+		{0.7135, 0.608, 1.0785, 0.3295, 0.6275, 1.2645, 1.2035, 0.7745, 0.3955, 0.634, 0.8195, 0.9450000000000001, 0.4525, 0.5934999999999999, 0.537, 0.49, 0.2575, 0.3855, 0.7175, 0.682, 0.34450000000000003, 0.4525, 0.883, 0.5705, 0.384, 0.6045, 0.428, 0.39449999999999996, 1.412, 0.518, 0.559, 0.5335000000000001, 2.1130000000000004, 0.4325, 0.943, 0.6375, 0.515, 0.531, 0.5785, 0.643, 0.534, 0.5235000000000001, 0.5674999999999999, 8.3355, 0.4555, 4.124499999999999, 0.6425000000000001, 0.6695, 0.698, 0.7464999999999999, 0.4485, 4.5024999999999995, 13.68, 2.7495000000000003, 0.6895, 0.591, 0.585, 0.8045, 0.5115000000000001, 0.52, 0.3395, 0.5095000000000001, 1.9725000000000001, 0.427, 0.525, 0.4735, 0.59, 0.47, 0.4575, 0.5694999999999999, 0.4475, 0.9125, 0.638, 0.6054999999999999, 0.3235, 0.6975, 0.5745, 0.5814999999999999, 11.463000000000001, 0.5655, 1.2145000000000001, 0.396, 0.879, 0.608, 0.7545, 0.655, 0.7315, 0.4505, 0.445, 1.6415, 0.6515, 0.718, 0.49, 0.484, 13.379999999999999, 0.49250000000000005, 0.484, 0.7295, 0.705, 1.0350000000000001, 0.5725, 0.538, 0.98, 0.7235, 0.402, 6.0265, 0.565, 0.4535, 1.877, 0.3685, 0.864, 0.33999999999999997, 0.843, 0.383, 1.244, 0.499, 0.42300000000000004, 0.716, 0.586, 1.6975, 0.5605, 0.9835, 0.9299999999999999, 4.3934999999999995, 0.4955, 0.6445000000000001, 0.4685, 0.4435, 0.491, 0.5665, 0.4365, 0.458, 0.59, 0.758, 0.483, 0.628, 0.381, 0.88, 0.6295, 0.492, 0.9045000000000001, 0.332, 0.3595, 0.3625, 0.8705, 1.5725, 28.0665, 0.371, 0.844, 0.615, 0.4665, 0.6435, 0.4, 0.358, 2.6775, 0.5994999999999999, 0.0165, 0.41900000000000004, 0.497, 0.683, 0.5475000000000001, 0.2675, 0.585, 0.601, 0.364, 1.308, 0.457, 0.51, 0.993, 0.393, 0.472, 0.42800000000000005, 0.3255, 0.883, 0.5985, 1.0025, 0.5945, 0.48, 0.3365, 0.599, 0.6745, 0.608, 1.7025000000000001, 0.407, 0.5285, 1.34, 0.3135, 0.4515, 0.3055, 1.3775, 3.0975, 2.362, 0.5654999999999999, 0.601, 0.475},
+		{0.5415000000000001, 0.5095000000000001, 0.972, 0.2385, 0.5275000000000001, 0.138, 1.1745, 0.726, 0.321, 0.5215000000000001, 0.3115, 1.0314999999999999, 0.395, 0.5005, 0.282, 0.3985, 0.2075, 0.222, 0.603, 0.5595, 0.3025, 0.197, 0.7835000000000001, 0.40800000000000003, 0.1685, 0.479, 0.235, 0.2915, 1.287, 0.4225, 0.4105, 0.615, 2.117, 0.2945, 0.637, 0.439, 0.405, 0.274, 0.4815, 0.6984999999999999, 0.36, 0.396, 0.532, 8.501999999999999, 0.437, 4.0015, 0.6685000000000001, 0.4825, 0.617, 0.5015000000000001, 0.169, 4.5695, 14.575, 2.698, 0.6905, 0.345, 0.421, 0.792, 0.5095000000000001, 0.358, 0.2115, 0.419, 1.7555, 0.379, 0.47250000000000003, 0.438, 0.6094999999999999, 0.442, 0.3595, 0.435, 0.39, 0.7825, 0.5825, 0.5395000000000001, 0.2495, 0.558, 0.3105, 0.4, 13.546, 0.385, 0.7855000000000001, 0.3935, 0.771, 0.389, 0.5055000000000001, 0.641, 0.556, 0.3925, 0.3805, 1.7075, 0.5845, 0.6225, 0.3665, 0.3895, 7.5985000000000005, 0.353, 0.6265000000000001, 0.623, 0.5765, 0.7595000000000001, 0.40549999999999997, 0.3965, 0.8234999999999999, 0.3975, 0.381, 6.748, 0.4285, 0.3775, 0.5465, 0.2685, 1.0234999999999999, 0.2535, 0.623, 0.2305, 1.166, 0.1895, 0.181, 0.6825, 0.401, 0.8180000000000001, 0.471, 0.882, 0.72, 4.6175, 0.3645, 0.5245, 0.34650000000000003, 0.33899999999999997, 0.5075000000000001, 0.443, 0.40049999999999997, 0.3615, 0.391, 0.5295000000000001, 0.2635, 0.253, 0.191, 0.951, 0.507, 0.2665, 0.912, 0.2655, 0.17149999999999999, 0.23249999999999998, 0.325, 1.697, 28.198, 0.1645, 1.1335, 0.455, 0.4105, 0.51, 0.1905, 0.3005, 2.6525, 0.307, 0.6990000000000001, 0.0985, 0.3065, 0.502, 0.497, 0.156, 0.4575, 0.5409999999999999, 0.23349999999999999, 0.919, 0.3735, 0.3815, 1.589, 0.305, 0.273, 0.31, 0.199, 0.7845, 0.2875, 0.522, 0.549, 0.395, 0.257, 0.164, 0.3045, 0.3895, 1.954, 0.343, 0.465, 1.2375, 0.0, 0.736, 0.228, 0.7144999999999999, 3.0, 6.058, 0.597, 0.464, 0.40549999999999997},
+		{0.498, 0.422, 0.9524999999999999, 0.224, 0.5754999999999999, 0.186, 5.807499999999999, 0.487, 0.2665, 0.4275, 0.2865, 0.788, 3.583, 0.6, 0.2365, 1.0114999999999998, 0.172, 0.2115, 6.5545, 0.675, 1.0125, 0.227, 0.722, 0.3165, 0.1795, 0.6194999999999999, 0.23149999999999998, 0.5135000000000001, 0.829, 1.6555, 0.3595, 0.4555, 2.0235000000000003, 0.256, 0.645, 0.46699999999999997, 0.402, 0.353, 0.6675, 0.6819999999999999, 0.387, 0.5385, 0.6775, 8.693, 0.5035000000000001, 4.153499999999999, 0.623, 0.445, 0.4955, 0.5814999999999999, 1.325, 3.755, 14.9755, 2.5925000000000002, 1.694, 0.3475, 0.382, 0.7064999999999999, 1.7240000000000002, 0.336, 0.18, 0.6355, 2.9130000000000003, 0.354, 0.4555, 0.40549999999999997, 0.8815, 0.3205, 0.363, 0.518, 1.711, 1.595, 0.594, 0.499, 2.9379999999999997, 0.658, 0.354, 0.3775, 12.336500000000001, 0.4405, 0.7224999999999999, 0.661, 6.0535, 1.5219999999999998, 0.5425, 0.666, 5.072, 0.326, 0.39, 1.524, 0.5974999999999999, 0.6045, 0.5105, 0.3735, 9.343, 0.332, 0.3895, 0.605, 0.572, 0.755, 0.4145, 0.428, 0.8145, 0.401, 0.3555, 6.243, 0.537, 0.664, 0.49250000000000005, 0.2175, 0.886, 0.201, 0.6964999999999999, 0.223, 1.0215, 0.3975, 0.1365, 0.546, 0.3445, 2.686, 0.4265, 1.046, 0.798, 4.9815000000000005, 0.411, 5.4205000000000005, 0.61, 2.951, 0.399, 2.268, 0.5205, 0.3575, 0.424, 0.5865, 0.336, 0.475, 0.1875, 0.709, 0.4395, 0.2655, 0.785, 0.1105, 0.126, 0.215, 0.4205, 1.4460000000000002, 26.0105, 0.2865, 0.46950000000000003, 0.48, 0.406, 0.5275000000000001, 0.1955, 0.1995, 2.9154999999999998, 0.285, 0.7535000000000001, 0.3795, 0.015, 0.482, 0.778, 0.2085, 0.45, 0.3725, 0.3305, 1.4275, 0.376, 0.373, 3.5155, 0.325, 0.2255, 0.3165, 0.189, 0.6875, 0.2105, 0.6545, 0.4745, 0.413, 0.1985, 0.1675, 0.2865, 0.301, 1.8645, 0.3635, 0.3875, 1.3075, 0.0, 0.328, 0.127, 0.85, 3.1435, 7.048, 0.5505, 0.54, 0.426},
+		{0.789, 0.6995, 1.414, 0.784, 0.558, 0.6505000000000001, 1.267, 0.8105, 0.564, 1.2994999999999999, 0.33199999999999996, 1.343, 0.562, 0.6295, 0.45699999999999996, 0.279, 0.523, 0.579, 0.609, 0.798, 0.526, 0.492, 0.9319999999999999, 0.674, 0.5215000000000001, 0.508, 0.6035, 0.71, 1.6675, 0.401, 0.40249999999999997, 0.7975, 2.302, 0.494, 1.075, 0.6839999999999999, 0.73, 0.5934999999999999, 0.6295, 0.78, 0.351, 0.5555000000000001, 0.65, 5.572, 0.424, 4.7795000000000005, 0.9445, 0.7905, 0.6335, 0.9185000000000001, 0.424, 4.822, 12.9515, 2.1535, 0.7084999999999999, 0.833, 0.41100000000000003, 0.8745, 0.5365, 0.584, 0.4575, 0.569, 2.49, 0.3185, 0.523, 0.297, 0.7055, 0.5085, 0.5385, 0.8475, 0.40800000000000003, 0.829, 0.6405000000000001, 0.545, 0.4765, 0.605, 0.327, 0.5525, 11.285499999999999, 0.754, 1.1600000000000001, 0.429, 1.1455, 0.505, 1.2200000000000002, 3.9915000000000003, 0.692, 0.3075, 0.5525, 1.7565, 0.929, 1.001, 0.5355000000000001, 0.5775, 7.785, 0.916, 0.4945, 1.5585, 0.7605, 1.5445, 0.401, 0.55, 0.9575, 0.5385, 0.5985, 7.007, 0.512, 0.66, 1.2770000000000001, 0.711, 1.826, 0.6545000000000001, 0.995, 0.5874999999999999, 2.0484999999999998, 0.795, 0.5355000000000001, 0.5914999999999999, 0.6005, 1.8125, 0.7105, 1.048, 1.1025, 5.379, 0.403, 0.6825, 0.361, 0.695, 0.729, 0.4375, 0.7444999999999999, 0.609, 0.698, 0.921, 0.41100000000000003, 1.059, 0.5195000000000001, 1.1125, 0.8274999999999999, 0.5295000000000001, 1.0635, 0.5075000000000001, 0.4925, 0.5854999999999999, 0.697, 2.213, 28.682000000000002, 0.5845, 1.1375000000000002, 0.605, 0.661, 0.8175, 0.5734999999999999, 0.5115000000000001, 2.7525000000000004, 0.582, 0.975, 0.6094999999999999, 0.6365000000000001, 0.017, 0.9390000000000001, 0.4675, 0.4775, 0.6365000000000001, 0.5145, 0.5885, 0.603, 0.389, 1.5474999999999999, 0.554, 0.5385, 0.366, 0.5485, 1.0865, 0.585, 0.956, 0.8055000000000001, 0.6165, 0.496, 0.504, 0.49, 0.536, 2.059, 0.406, 0.5365, 1.4805000000000001, 0.1345, 0.6805000000000001, 0.5375000000000001, 1.311, 3.1955, 5.784000000000001, 0.7795000000000001, 0.7315, 0.6595},
+		{0.7335, 0.6375, 1.148, 0.4785, 0.544, 0.4595, 1.052, 0.709, 0.4645, 0.719, 0.619, 1.011, 0.586, 0.7155, 0.522, 0.5545, 0.507, 0.4645, 0.5055000000000001, 0.718, 0.517, 0.486, 0.6715, 0.58, 0.532, 0.594, 0.471, 0.501, 1.1305, 0.458, 0.602, 0.7175, 1.9304999999999999, 0.4735, 1.1655, 0.273, 0.6005, 0.5455000000000001, 0.637, 0.7224999999999999, 0.555, 0.5365, 0.66, 4.6579999999999995, 0.579, 3.9245, 0.3335, 0.7224999999999999, 0.7435, 0.7905, 0.477, 3.504, 15.032, 2.3525, 0.5515000000000001, 0.524, 0.6385000000000001, 0.48, 0.5465, 0.573, 0.5045, 1.9115, 2.1195, 0.4635, 0.5774999999999999, 0.5865, 0.253, 0.6375, 0.46599999999999997, 0.695, 0.773, 0.863, 0.8314999999999999, 0.6045, 0.435, 0.466, 0.5585, 0.5055000000000001, 11.4045, 0.6950000000000001, 0.973, 0.593, 0.9425, 0.5575000000000001, 0.3835, 0.381, 0.5954999999999999, 0.5325, 0.549, 1.685, 0.8145, 0.548, 0.663, 0.6174999999999999, 11.8705, 0.599, 0.6165, 0.7785, 0.661, 0.931, 0.6225, 0.584, 2.0934999999999997, 0.571, 0.632, 6.8629999999999995, 0.587, 0.5694999999999999, 0.9135, 0.5395000000000001, 0.706, 0.43, 0.6375, 0.525, 1.0114999999999998, 0.47350000000000003, 0.4615, 0.4365, 0.618, 1.7695, 0.6385000000000001, 1.0070000000000001, 0.885, 5.1865, 0.5965, 0.403, 0.5529999999999999, 0.626, 0.72, 0.6795, 0.529, 0.574, 0.641, 0.479, 0.5825, 0.5985, 0.5595000000000001, 0.8240000000000001, 0.5874999999999999, 0.523, 0.8574999999999999, 0.4795, 0.399, 0.485, 0.645, 1.381, 27.7385, 0.432, 0.7575000000000001, 0.4745, 0.519, 0.3535, 0.5325, 0.563, 2.7935, 0.558, 0.964, 0.514, 0.7955000000000001, 0.6895, 0.018, 0.493, 0.6054999999999999, 0.6825000000000001, 0.5225, 1.2690000000000001, 0.5734999999999999, 0.592, 13.979, 0.5035000000000001, 0.526, 0.5465, 0.472, 0.8734999999999999, 0.5195000000000001, 1.2, 0.7164999999999999, 0.5905, 0.6174999999999999, 0.4365, 0.493, 0.6105, 1.7069999999999999, 0.5674999999999999, 0.5745, 1.4865, 0.0, 0.433, 0.4165, 1.101, 3.2875, 4.7245, 0.373, 0.7555000000000001, 0.6125},
+		{0.397, 0.4045, 0.886, 0.184, 0.5595, 0.195, 0.978, 0.5765, 0.2465, 0.4305, 0.32, 0.647, 0.3475, 0.431, 0.2325, 0.286, 0.196, 0.187, 0.5575000000000001, 0.5235000000000001, 0.2465, 0.1635, 0.656, 0.264, 0.1445, 0.413, 0.222, 0.2595, 0.782, 0.4375, 0.3825, 0.4475, 2.076, 0.2505, 0.8325, 0.421, 0.3835, 0.216, 0.4065, 0.4895, 0.3385, 0.3275, 0.529, 7.757, 0.34450000000000003, 3.9345, 0.516, 0.4855, 0.5505, 0.4475, 0.3085, 3.9835000000000003, 14.166, 2.997, 0.544, 0.3005, 0.3475, 0.6675, 0.4195, 0.3425, 0.1915, 0.4185, 1.663, 0.2975, 0.425, 0.3675, 0.4295, 0.328, 0.3255, 0.456, 0.40449999999999997, 0.6775, 0.595, 0.3915, 0.2065, 0.554, 0.3055, 0.3445, 13.961, 0.4085, 0.806, 0.3795, 0.7925, 0.3285, 0.5285, 0.5894999999999999, 0.5475000000000001, 0.3245, 0.3395, 1.5785, 0.587, 0.597, 0.401, 0.428, 8.86, 0.3175, 0.34299999999999997, 0.547, 0.4795, 0.6599999999999999, 0.361, 0.405, 0.828, 0.332, 0.3275, 6.1635, 0.393, 0.3775, 0.402, 0.211, 0.8665, 0.215, 0.4855, 0.224, 1.197, 0.258, 0.1595, 0.41000000000000003, 0.316, 1.5915, 0.3555, 1.0390000000000001, 0.707, 5.062, 0.3305, 0.5125, 0.288, 0.3665, 0.386, 0.457, 0.2905, 0.336, 0.302, 0.5295000000000001, 0.16899999999999998, 0.33799999999999997, 0.207, 0.783, 0.3635, 0.255, 0.8305, 0.1325, 0.126, 0.237, 0.321, 1.321, 26.6545, 0.1385, 0.868, 0.2535, 0.384, 0.455, 0.1815, 0.218, 2.5945, 0.242, 0.597, 0.165, 0.195, 0.505, 0.4735, 0.024, 0.454, 0.3785, 0.228, 1.355, 0.3415, 0.366, 0.6585, 0.3555, 0.2165, 0.2485, 0.186, 0.6665000000000001, 0.191, 0.5675, 0.4455, 0.399, 0.242, 0.1425, 0.253, 0.349, 2.0065, 0.315, 0.349, 1.2505000000000002, 0.0, 0.1585, 0.1755, 0.699, 3.101, 4.616, 0.6034999999999999, 0.4255, 0.3855},
+		{0.475, 0.393, 1.0425, 0.3765, 0.39, 0.4115, 1.025, 0.697, 0.501, 0.317, 0.346, 0.859, 0.3155, 0.3465, 0.4915, 0.33, 0.416, 0.4185, 0.6134999999999999, 0.399, 0.536, 0.4235, 0.783, 0.521, 0.446, 0.1875, 0.4985, 0.514, 0.8885000000000001, 0.1865, 0.28, 0.429, 2.0540000000000003, 0.4615, 0.974, 0.4755, 0.4985, 0.4645, 0.3745, 0.6545000000000001, 0.275, 0.3575, 0.2745, 3.6224999999999996, 0.284, 3.911, 0.5545, 0.594, 0.40149999999999997, 0.64, 0.5685, 3.9685, 14.1845, 2.4219999999999997, 0.3605, 0.5635, 0.3215, 0.597, 0.241, 0.579, 0.45, 0.1945, 1.5594999999999999, 0.2955, 0.1985, 0.365, 0.5785, 0.226, 0.4865, 0.55, 0.39, 0.4995, 0.5255000000000001, 0.2415, 0.4275, 0.404, 0.399, 0.3255, 12.176, 0.3435, 0.7244999999999999, 0.294, 0.777, 0.313, 0.6595, 0.497, 0.4135, 0.2775, 0.5325, 1.8495, 0.748, 0.4945, 0.282, 0.269, 7.1855, 0.575, 0.3435, 3.1319999999999997, 0.6245, 0.7084999999999999, 0.309, 0.3405, 0.7324999999999999, 0.3205, 0.3305, 5.8085, 0.2445, 0.291, 0.421, 0.4765, 0.924, 0.3855, 0.3195, 0.5065, 0.9575, 0.413, 0.446, 0.364, 0.575, 1.206, 0.3675, 0.761, 0.692, 4.9465, 0.2755, 0.5705, 0.307, 0.5165, 0.5834999999999999, 0.381, 0.318, 0.3025, 0.5575000000000001, 0.7315, 0.40900000000000003, 0.573, 0.4245, 0.974, 0.485, 0.4335, 0.8554999999999999, 0.4475, 0.4655, 0.4435, 0.5375000000000001, 1.556, 27.833, 0.42, 0.8634999999999999, 0.536, 0.5475000000000001, 0.426, 0.4815, 0.423, 2.57, 0.502, 0.6795, 0.5135000000000001, 0.4615, 0.5275000000000001, 0.5894999999999999, 0.3675, 0.007, 0.6245, 0.424, 1.3679999999999999, 0.255, 0.3195, 1.3375, 0.28900000000000003, 0.4685, 0.311, 0.4935, 0.5874999999999999, 0.5295000000000001, 0.69, 0.6655, 0.592, 0.427, 0.4305, 0.4345, 0.3315, 1.919, 0.327, 0.304, 1.3085, 0.0, 0.3955, 0.436, 0.7785, 3.1959999999999997, 5.427, 0.626, 0.5714999999999999, 0.3005},
+		{0.705, 0.6255, 1.2945, 0.4285, 0.6625000000000001, 0.36, 1.4184999999999999, 0.708, 0.5700000000000001, 0.6985, 0.45399999999999996, 2.2365, 0.5175000000000001, 0.641, 0.431, 0.5615, 0.415, 0.32999999999999996, 0.848, 0.9075, 0.498, 0.358, 1.1435, 0.776, 0.3855, 0.672, 0.3125, 0.395, 2.8145, 0.573, 0.533, 0.766, 2.1385, 0.441, 1.169, 0.6775, 0.781, 0.41100000000000003, 0.739, 0.7395, 0.4955, 0.563, 0.878, 6.510999999999999, 0.5225, 4.6525, 0.739, 0.6695, 0.807, 0.567, 0.3555, 4.638, 16.7905, 3.5915, 0.7184999999999999, 0.56, 0.5125, 0.869, 0.5854999999999999, 0.4835, 0.3745, 0.5765, 2.477, 0.5615, 0.653, 0.5205, 0.651, 0.572, 0.40700000000000003, 1.083, 0.529, 0.879, 0.705, 0.565, 0.40700000000000003, 0.7515000000000001, 0.5155000000000001, 0.525, 14.277, 0.565, 1.066, 0.5305, 0.9584999999999999, 0.548, 0.6495, 0.8265, 0.727, 0.4965, 0.5005, 1.9285, 0.6875, 0.8315, 0.58, 0.657, 13.8325, 0.607, 0.5075000000000001, 6.7305, 0.598, 0.9464999999999999, 0.498, 0.616, 0.977, 0.541, 0.48050000000000004, 7.445, 0.5605, 0.518, 2.8579999999999997, 0.5680000000000001, 1.2970000000000002, 0.415, 1.027, 0.46599999999999997, 2.412, 0.352, 0.298, 0.6625000000000001, 0.5135000000000001, 2.6915, 0.5485, 1.3165, 0.9265, 5.832000000000001, 0.6005, 0.7475, 0.4985, 0.5175000000000001, 0.613, 0.5745, 0.4985, 0.506, 0.6835, 0.6025, 0.441, 0.6585, 0.3765, 1.094, 0.6355, 0.279, 1.1065, 0.399, 0.3635, 0.41100000000000003, 0.48850000000000005, 2.274, 30.374, 0.6065, 0.944, 0.4805, 0.463, 0.6645000000000001, 0.4125, 0.313, 2.776, 0.354, 0.8474999999999999, 0.49550000000000005, 0.4435, 0.899, 0.813, 0.4195, 0.542, 0.007, 0.487, 0.388, 0.498, 0.51, 1.7229999999999999, 0.5035000000000001, 0.5285, 0.4905, 0.437, 1.1275, 0.777, 1.286, 0.5785, 0.4145, 0.3795, 0.4445, 0.41900000000000004, 0.526, 2.247, 0.479, 0.601, 1.439, 0.0, 0.3365, 0.35, 1.244, 3.1835, 6.8445, 0.753, 0.607, 0.5255000000000001},
+		{0.4935, 0.4545, 1.1724999999999999, 0.249, 0.5425, 0.1975, 1.004, 0.6225, 0.2585, 0.4375, 0.3245, 0.697, 0.422, 0.435, 0.2395, 0.315, 0.1985, 0.22999999999999998, 0.594, 0.547, 0.295, 0.219, 0.514, 0.336, 0.1975, 0.449, 0.2725, 0.32, 0.9085000000000001, 0.4415, 0.339, 0.542, 2.0359999999999996, 0.307, 1.0274999999999999, 0.5215000000000001, 0.383, 0.3255, 0.4295, 0.596, 0.3505, 0.377, 0.538, 6.6705, 0.384, 4.464, 0.706, 0.4635, 0.516, 0.4785, 0.761, 4.3115, 14.924, 2.2365, 0.54, 0.334, 0.345, 0.731, 0.4085, 0.356, 0.1615, 0.396, 2.019, 0.375, 0.4545, 0.3935, 0.4565, 0.4115, 0.337, 0.421, 0.367, 0.591, 0.5734999999999999, 0.4655, 0.2185, 0.5685, 0.332, 0.3845, 11.59, 0.4005, 0.7945, 0.3375, 0.829, 0.3255, 0.5694999999999999, 0.63, 0.6194999999999999, 0.3075, 0.354, 1.7985, 0.5934999999999999, 0.6205, 0.393, 0.396, 9.1475, 0.3865, 0.427, 2.107, 0.513, 0.7204999999999999, 0.397, 0.4025, 0.8345, 0.405, 0.327, 6.455, 0.401, 0.324, 0.404, 0.289, 0.685, 0.2485, 0.569, 0.3075, 1.8125, 0.211, 0.1945, 0.546, 0.387, 1.6070000000000002, 0.356, 0.7284999999999999, 0.6655, 5.1965, 0.37, 0.659, 0.2935, 0.374, 0.45, 0.4355, 0.2795, 0.3205, 0.46799999999999997, 0.589, 0.1865, 0.264, 0.2195, 0.562, 0.389, 0.256, 0.889, 0.1775, 0.1385, 0.26, 0.351, 1.5565, 25.518, 0.202, 0.7275, 1.0305, 0.3935, 0.5375000000000001, 0.218, 0.2405, 2.716, 0.2875, 0.7284999999999999, 0.20750000000000002, 0.3155, 0.5660000000000001, 0.476, 0.2205, 0.40149999999999997, 0.4295, 0.005, 0.2225, 0.34099999999999997, 0.3815, 0.7110000000000001, 0.322, 0.2375, 0.3245, 0.2445, 0.595, 0.2235, 0.5135000000000001, 0.4565, 0.4455, 0.2495, 0.152, 0.26, 0.3375, 1.6075, 0.369, 0.403, 1.287, 0.1075, 0.2365, 0.2025, 0.73, 2.9295, 2.273, 0.615, 0.4675, 0.382},
+		{0.9724999999999999, 1.805, 1.1275, 0.229, 1.4689999999999999, 1.2245, 2.5015, 1.69, 1.492, 2.0595, 1.0995, 2.739, 1.1755, 1.294, 1.9785, 1.7175, 1.411, 0.192, 1.7850000000000001, 0.5865, 1.3559999999999999, 1.2429999999999999, 1.9535, 1.4025, 1.565, 1.5685, 0.2575, 1.5935000000000001, 2.9735, 1.495, 1.2425, 1.9365, 1.983, 1.385, 2.1685, 0.532, 1.6355, 1.855, 1.3145, 2.1155, 0.34950000000000003, 1.569, 1.984, 7.7165, 1.115, 4.6335, 1.612, 1.546, 1.8855, 1.5474999999999999, 2.6475, 4.294, 17.049500000000002, 3.2720000000000002, 0.5245, 1.8645, 0.3855, 1.4849999999999999, 1.8605, 1.451, 0.224, 1.2905, 2.9450000000000003, 0.998, 1.5975000000000001, 1.384, 0.46599999999999997, 1.4140000000000001, 0.3535, 0.6865, 1.669, 2.1915, 1.5085, 1.58, 1.2685, 1.4435, 0.34, 1.2785, 13.653, 0.404, 0.876, 1.302, 1.5605, 1.2999999999999998, 1.758, 1.932, 2.902, 1.7045, 1.409, 1.476, 1.9865, 1.7325, 0.361, 1.565, 13.158, 1.419, 1.155, 2.4355, 1.3185, 0.849, 1.7575, 1.877, 1.712, 1.4209999999999998, 0.377, 6.4695, 1.6435, 1.0394999999999999, 3.124, 0.2675, 2.2965, 1.4785, 1.476, 1.072, 0.9595, 0.21450000000000002, 1.1075, 1.2505, 2.1555, 2.959, 1.304, 2.224, 1.7734999999999999, 4.948, 1.7374999999999998, 2.0505, 1.992, 0.3755, 1.5314999999999999, 0.4495, 1.6425, 0.368, 0.9475, 1.9589999999999999, 1.1480000000000001, 2.055, 1.74, 0.7965, 0.4015, 1.0355, 1.8345, 1.639, 1.5175, 1.9165, 1.4809999999999999, 1.6675, 27.187, 1.666, 2.2175, 1.738, 1.4935, 1.9565000000000001, 1.482, 1.4465, 3.114, 0.289, 1.7455, 1.222, 1.3395000000000001, 0.5785, 0.546, 1.401, 2.0244999999999997, 0.4075, 0.2385, 0.005, 0.872, 1.4555, 2.085, 1.3824999999999998, 1.6524999999999999, 0.307, 1.8325, 3.242, 1.5345, 2.567, 1.3665, 1.3319999999999999, 1.327, 1.539, 1.251, 0.301, 3.1390000000000002, 1.661, 1.2854999999999999, 2.6615, 0.013, 2.1995, 1.3795000000000002, 1.7890000000000001, 3.5495, 5.446, 1.8679999999999999, 2.9215, 1.4565000000000001},
+		{0.4215, 0.1975, 0.9395, 0.37, 0.391, 0.334, 0.96, 0.673, 0.4025, 0.359, 0.304, 0.6285000000000001, 0.2495, 0.2145, 0.3415, 0.1845, 0.34, 0.3525, 0.5475000000000001, 0.47, 0.435, 0.307, 0.7050000000000001, 0.4025, 0.3325, 0.261, 0.3535, 0.3665, 1.263, 0.2435, 0.244, 0.342, 1.9765, 0.3825, 0.6045, 0.482, 0.463, 0.421, 0.368, 0.586, 0.276, 0.1805, 0.3925, 6.434, 0.22, 3.798, 0.5785, 0.64, 0.5115000000000001, 0.639, 0.4315, 3.8535, 13.776499999999999, 3.3405, 0.4795, 0.483, 0.2025, 0.6234999999999999, 0.3045, 0.489, 0.3025, 0.264, 1.3025, 0.1685, 0.2745, 0.2905, 0.4255, 0.229, 0.349, 0.651, 0.238, 0.3715, 0.471, 0.3355, 0.333, 0.478, 0.2325, 0.2245, 11.7935, 0.1785, 0.695, 0.165, 0.715, 0.1805, 0.661, 0.542, 0.36850000000000005, 0.133, 0.4725, 1.5445, 0.692, 0.502, 0.229, 0.222, 9.7975, 0.541, 0.2185, 3.019, 0.5675, 0.9319999999999999, 0.1895, 0.1535, 0.788, 0.2465, 0.19, 7.3285, 0.2245, 0.1875, 2.176, 0.358, 1.013, 0.351, 0.409, 0.3635, 1.2885, 0.3385, 0.321, 0.409, 0.451, 2.536, 0.24, 0.768, 0.732, 5.326, 0.2285, 0.5015000000000001, 0.162, 0.4615, 0.4645, 0.286, 0.2015, 0.1165, 0.4825, 0.5529999999999999, 0.3125, 0.4055, 0.35, 0.7765, 0.5505, 0.3685, 0.864, 0.289, 0.329, 0.353, 0.401, 1.5510000000000002, 27.3235, 0.3605, 0.718, 0.412, 0.5005, 0.514, 0.3485, 0.3425, 2.7035, 0.4205, 0.718, 0.40249999999999997, 0.33, 0.47750000000000004, 0.549, 0.3505, 0.2685, 0.547, 0.3585, 1.2934999999999999, 0.006, 0.126, 0.637, 0.1615, 0.4115, 0.1775, 0.311, 0.875, 0.404, 0.6485000000000001, 0.7184999999999999, 0.562, 0.4135, 0.4575, 0.3835, 0.199, 1.875, 0.193, 0.1685, 1.3815, 0.1645, 0.3125, 0.3285, 0.7745, 3.236, 5.686, 0.5825, 0.553, 0.142},
+		{0.46799999999999997, 0.2785, 0.861, 0.3545, 0.382, 0.3315, 1.0979999999999999, 0.6425000000000001, 0.4155, 0.308, 0.2895, 0.427, 0.2815, 0.243, 0.4035, 0.2085, 0.3605, 0.3795, 0.603, 0.455, 0.418, 0.342, 0.5195000000000001, 0.475, 0.382, 0.2895, 0.3965, 0.4555, 0.8165, 0.2485, 0.1995, 0.40449999999999997, 2.1005000000000003, 0.439, 0.937, 0.5225, 0.4975, 0.47050000000000003, 0.334, 0.495, 0.3025, 0.2405, 0.3845, 4.999, 0.232, 4.023, 0.5694999999999999, 0.6275, 0.5005, 0.681, 0.4435, 4.2595, 16.346, 1.8625, 0.613, 0.489, 0.27, 0.5615, 0.2855, 0.55, 0.344, 0.3425, 1.7650000000000001, 0.2395, 0.314, 0.3075, 0.52, 0.2965, 0.458, 0.519, 0.197, 0.469, 0.4975, 0.3695, 0.3555, 0.4455, 0.292, 0.182, 12.881499999999999, 0.2475, 0.7845, 0.242, 0.7024999999999999, 0.1625, 0.5914999999999999, 0.499, 0.475, 0.2225, 0.46699999999999997, 1.5265, 0.6875, 0.507, 0.25, 0.25, 7.439, 0.5375000000000001, 0.2485, 6.372, 0.6065, 0.8485, 0.1755, 0.1995, 0.7404999999999999, 0.2345, 0.3265, 7.2145, 0.263, 0.199, 0.2745, 0.4225, 0.9135, 0.3655, 0.4015, 0.3925, 1.202, 0.3945, 0.341, 0.415, 0.4825, 0.575, 0.276, 0.6805000000000001, 0.7210000000000001, 4.826499999999999, 0.2355, 0.603, 0.218, 0.528, 0.5, 0.296, 0.239, 0.17049999999999998, 0.46099999999999997, 0.496, 0.3305, 0.499, 0.324, 0.882, 0.5235000000000001, 0.401, 0.923, 0.34450000000000003, 0.32, 0.4415, 0.4955, 1.612, 25.941499999999998, 0.351, 0.665, 0.395, 0.5275000000000001, 0.502, 0.336, 0.351, 2.525, 0.4275, 0.8325, 0.4235, 0.3705, 0.9355, 0.5914999999999999, 0.346, 0.3305, 0.4835, 0.3735, 1.2665, 0.1095, 0.008, 0.629, 0.193, 0.3845, 0.2095, 0.315, 0.5545, 0.403, 0.6785, 0.664, 0.558, 0.3965, 0.425, 0.3615, 0.22999999999999998, 2.1055, 0.246, 0.2155, 1.453, 0.037000000000000005, 0.346, 0.36, 0.9085, 3.1, 3.7805, 0.5974999999999999, 0.552, 0.22549999999999998},
+		{31.477, 9.4165, 3.173, 0.7395, 20.108, 0.5794999999999999, 5.223, 1.4729999999999999, 1.1244999999999998, 9.155999999999999, 1.369, 2.6915, 2.3579999999999997, 2.208, 1.816, 0.8855, 1.911, 4.255, 1.0939999999999999, 1.2694999999999999, 2.7715, 2.8865, 1.252, 66.3655, 25.5, 3.3535, 2.067, 73.837, 7.7045, 1.8155000000000001, 0.7675000000000001, 4.1525, 84.887, 1.1835, 3.5015, 56.7395, 18.6095, 1.3335, 3.7135, 1.3995000000000002, 1.117, 1.681, 2.2085, 25.2295, 2.319, 7.895, 1.6644999999999999, 2.9195, 8.991, 1.3370000000000002, 1.4615, 15.116500000000002, 54.330999999999996, 19.000500000000002, 3.6885, 5.275499999999999, 0.768, 4.1405, 1.9965000000000002, 2.247, 0.7885, 2.9160000000000004, 89.291, 7.272, 2.617, 1.194, 1.045, 8.5625, 0.9315, 4.184, 1.268, 3.7805, 1.063, 2.8785, 3.944, 3.2095, 57.7355, 2.2865, 25.272, 1.853, 54.2125, 1.5590000000000002, 1.457, 1.123, 3.1345, 1.354, 1.5535, 2.559, 5.1765, 6.6594999999999995, 3.0265, 1.4605000000000001, 1.436, 1.852, 33.422, 12.4915, 3.7125000000000004, 7.5565, 2.3545, 67.697, 0.968, 1.9935, 1.621, 49.14149999999999, 0.7455, 10.495000000000001, 34.4985, 1.053, 8.686, 84.1165, 6.138, 0.7855, 5.077500000000001, 1.929, 2.1095, 5.365500000000001, 105.42, 1.2715, 2.8125, 12.438500000000001, 2.1125, 2.59, 58.3275, 11.1645, 0.9864999999999999, 4.379, 62.8795, 6.4215, 71.5795, 3.884, 0.9995, 3.4875000000000003, 3.138, 0.889, 1.0815000000000001, 10.1485, 0.884, 8.954, 30.8785, 1.0594999999999999, 2.5374999999999996, 6.237, 0.765, 1.1635, 0.844, 9.484, 31.9925, 47.608000000000004, 1.9779999999999998, 62.989999999999995, 0.865, 1.7955, 78.9795, 4.356, 6.2684999999999995, 0.9590000000000001, 1.2919999999999998, 1.787, 24.0475, 3.4985, 1.5755, 5.7795000000000005, 1.2240000000000002, 1.8845, 0.668, 3.7485, 2.347, 1.2999999999999998, 0.0465, 1.311, 1.748, 4.78, 1.8135000000000001, 30.328, 4.274, 15.476500000000001, 19.305999999999997, 2.94, 3.991, 7.6625, 1.2415, 2.1795, 2.9290000000000003, 0.864, 2.079, 3.1085000000000003, 0.0, 2.294, 2.2435, 10.4915, 80.46000000000001, 10.1365, 1.7975, 112.2745, 1.6840000000000002},
+		{0.4305, 0.325, 0.978, 0.3355, 0.415, 0.3, 0.7735000000000001, 0.5625, 0.3585, 0.298, 0.099, 1.209, 0.245, 0.3445, 0.3625, 0.1605, 0.3265, 0.326, 0.5095000000000001, 0.4565, 0.3975, 0.3255, 0.5845, 0.4445, 0.2275, 0.343, 0.365, 0.359, 1.0125, 0.2245, 0.3255, 0.2545, 1.9505, 0.363, 0.729, 0.4215, 0.526, 0.4145, 0.366, 0.595, 0.294, 0.1765, 0.415, 7.751, 0.255, 3.862, 0.604, 0.527, 0.478, 0.5575, 0.5854999999999999, 4.075, 14.990000000000002, 2.0635, 0.5095000000000001, 0.469, 0.1495, 0.648, 0.3195, 0.417, 0.3095, 0.288, 1.65, 0.1835, 0.297, 0.255, 0.4905, 0.238, 0.45, 0.46099999999999997, 0.2065, 0.5865, 0.5225, 0.345, 0.3295, 0.477, 0.149, 0.3345, 10.754999999999999, 0.224, 0.7, 0.3495, 0.5935, 0.189, 0.511, 0.501, 0.4565, 0.0985, 0.4515, 1.6095000000000002, 0.612, 0.5255000000000001, 0.228, 0.264, 7.108499999999999, 0.436, 0.3515, 0.6515, 0.5095000000000001, 0.5805, 0.3195, 0.2295, 0.7795000000000001, 0.2315, 0.158, 8.35, 0.231, 0.2245, 0.6825, 0.331, 0.868, 0.337, 0.4585, 0.359, 1.1880000000000002, 0.3215, 0.2935, 0.47, 0.4535, 2.6825, 0.239, 0.6835, 0.7075, 4.7915, 0.248, 0.5705, 0.11, 0.449, 0.5335000000000001, 0.317, 0.126, 0.1615, 0.4615, 0.571, 0.3225, 0.4275, 0.329, 0.849, 0.5635, 0.3205, 0.7735000000000001, 0.313, 0.342, 0.3075, 0.475, 2.0095, 25.948500000000003, 0.292, 0.729, 0.591, 0.485, 0.5145, 0.296, 0.271, 2.6915, 0.398, 0.7175, 0.3715, 0.2875, 0.4015, 0.489, 0.2935, 0.2865, 0.4965, 0.3145, 1.5985, 0.158, 0.20800000000000002, 0.7124999999999999, 0.007, 0.3935, 0.1145, 0.3075, 0.5205, 0.368, 0.554, 0.512, 0.5105, 0.472, 0.29000000000000004, 0.3805, 0.155, 1.8035, 0.324, 0.207, 1.053, 0.339, 0.286, 0.3065, 0.8645, 3.044, 1.9745, 0.5565, 0.5165, 0.1875},
+		{0.594, 0.394, 1.0234999999999999, 0.2245, 0.46099999999999997, 0.2695, 0.895, 0.523, 0.2755, 0.5155000000000001, 0.354, 0.9075, 0.3915, 0.366, 0.2475, 0.4175, 0.256, 0.5535000000000001, 0.6005, 0.5645, 0.2645, 0.2465, 0.7025, 0.3475, 0.14100000000000001, 0.492, 0.2415, 0.253, 0.8394999999999999, 0.4365, 0.399, 0.5125, 1.8895, 0.2805, 0.764, 0.4065, 0.4065, 0.271, 0.482, 0.588, 0.408, 0.403, 1.4049999999999998, 6.9955, 0.3855, 4.321, 0.8220000000000001, 1.3925, 0.568, 0.633, 0.214, 3.3689999999999998, 15.301, 2.1414999999999997, 0.4995, 0.3305, 0.35, 0.8365, 0.4375, 0.387, 0.2345, 0.409, 1.3035, 0.406, 0.4795, 0.40349999999999997, 0.4195, 0.4495, 0.298, 0.363, 0.4725, 0.5545, 0.5914999999999999, 0.47, 0.229, 0.6944999999999999, 0.3265, 0.356, 12.197, 0.4365, 0.8474999999999999, 0.401, 0.898, 0.3745, 0.8215, 0.5785, 0.527, 0.3075, 0.358, 1.8345, 0.6205, 0.5974999999999999, 0.4305, 0.457, 12.4475, 0.372, 0.3645, 0.693, 0.5589999999999999, 0.871, 0.414, 0.453, 0.8745, 0.403, 0.3075, 6.718999999999999, 0.4375, 0.422, 0.66, 0.2125, 0.9595, 0.208, 0.4975, 0.29, 0.874, 0.2535, 0.2015, 0.503, 0.4095, 2.152, 0.456, 0.8275, 0.514, 5.247, 0.3915, 0.5055000000000001, 0.334, 0.3205, 0.496, 0.4355, 0.336, 0.433, 0.3675, 0.561, 0.21, 0.4355, 0.2, 0.681, 0.4655, 0.244, 0.882, 0.2335, 0.17099999999999999, 0.20450000000000002, 0.3975, 1.8175, 27.521, 0.2095, 0.6625000000000001, 0.2675, 0.40700000000000003, 0.5994999999999999, 0.2115, 0.295, 2.8075, 0.301, 0.6775, 0.28200000000000003, 0.2135, 0.874, 0.5295000000000001, 0.223, 0.413, 0.4195, 0.2225, 0.8845000000000001, 0.3675, 0.371, 3.239, 0.383, 0.008, 0.33, 0.1385, 1.008, 0.229, 0.4855, 0.4625, 0.433, 1.1600000000000001, 0.1965, 0.9095, 0.4235, 2.0825, 0.396, 0.4195, 1.4605000000000001, 0.0, 0.208, 0.1585, 0.538, 3.181, 4.6635, 0.517, 0.492, 0.395},
+		{0.421, 0.181, 0.997, 0.33699999999999997, 0.4125, 0.3005, 0.9065000000000001, 0.6145, 0.3425, 0.3005, 0.1045, 0.5425, 0.202, 0.1735, 0.3095, 0.1635, 0.304, 0.3055, 0.566, 0.3925, 0.4215, 0.2945, 0.537, 0.411, 0.326, 0.2965, 0.32, 0.345, 0.968, 0.27849999999999997, 0.2395, 0.3, 1.9264999999999999, 0.3685, 0.765, 0.47850000000000004, 0.421, 0.411, 0.346, 0.4635, 0.2445, 0.12, 0.3575, 5.3740000000000006, 0.174, 4.2765, 0.6915, 0.441, 0.451, 0.55, 0.3255, 4.9385, 14.3675, 2.1334999999999997, 0.4845, 0.474, 0.135, 0.5974999999999999, 0.3245, 0.427, 0.277, 0.2465, 1.8145, 0.1535, 0.2495, 0.229, 0.523, 0.2145, 0.406, 0.4945, 0.181, 0.456, 0.5025, 0.3385, 0.276, 0.4605, 0.087, 0.2055, 12.2015, 0.188, 0.6735, 0.2155, 0.6955, 0.1935, 0.5405, 0.48, 0.435, 0.132, 0.43, 1.7069999999999999, 0.688, 0.539, 0.20400000000000001, 0.23349999999999999, 7.179, 0.464, 0.178, 0.726, 0.574, 0.5974999999999999, 0.2015, 0.1955, 0.789, 0.2415, 0.1455, 7.2315000000000005, 0.2195, 0.158, 0.216, 0.364, 0.9524999999999999, 0.357, 0.40800000000000003, 0.3365, 1.0775000000000001, 0.2995, 0.3225, 0.405, 0.4405, 2.0185, 0.224, 0.698, 0.538, 4.718999999999999, 0.192, 0.5765, 0.1025, 0.394, 0.45, 0.2955, 0.13, 0.1135, 0.4105, 0.554, 0.33, 0.392, 0.332, 0.792, 0.47550000000000003, 0.362, 0.745, 0.27949999999999997, 0.28, 0.3535, 0.421, 1.7405, 25.808, 0.267, 0.8160000000000001, 0.493, 0.5055000000000001, 0.5095000000000001, 0.3085, 0.352, 2.4145, 0.373, 0.7004999999999999, 0.3515, 0.289, 0.365, 0.625, 0.318, 0.2645, 0.4905, 0.3045, 0.33599999999999997, 0.1555, 0.197, 0.7625, 0.1245, 0.337, 0.007, 0.3095, 1.1375, 0.3385, 0.539, 0.508, 0.4635, 0.3315, 0.2505, 0.34750000000000003, 0.1785, 1.9540000000000002, 0.165, 0.2235, 1.3845, 0.10300000000000001, 0.46799999999999997, 0.3175, 0.784, 3.0615, 4.1525, 0.5640000000000001, 0.5285, 0.1585},
+		{0.5015000000000001, 0.391, 0.845, 0.2275, 0.545, 0.288, 0.9045000000000001, 0.39, 0.253, 0.5680000000000001, 0.344, 0.8045, 0.403, 0.4515, 0.2515, 0.3115, 0.2105, 0.143, 0.572, 0.547, 0.264, 0.1835, 0.47150000000000003, 0.2655, 0.1985, 0.4445, 0.20400000000000001, 0.217, 1.0705, 0.372, 0.3975, 0.5754999999999999, 2.1550000000000002, 0.2465, 0.6025, 0.3965, 0.367, 0.319, 0.473, 0.5295000000000001, 0.361, 0.375, 0.5385, 7.48, 0.3905, 4.24, 0.759, 0.4615, 0.54, 0.5545, 0.2335, 4.634, 14.79, 3.3259999999999996, 0.509, 0.2915, 0.36, 0.805, 0.437, 0.354, 0.2005, 0.382, 2.2265, 0.3945, 0.4215, 0.3855, 0.4605, 0.372, 0.2775, 0.505, 0.3765, 0.641, 0.61, 0.40549999999999997, 0.1785, 0.553, 0.3075, 0.3615, 14.2585, 0.3625, 0.929, 0.3335, 0.771, 0.4305, 0.5375000000000001, 3.5869999999999997, 0.5894999999999999, 0.3055, 0.314, 1.866, 0.571, 0.5754999999999999, 0.394, 0.4435, 11.5545, 0.2905, 1.0535, 0.6635, 0.4925, 0.641, 0.3845, 0.3895, 0.772, 0.388, 0.3395, 5.904, 0.3945, 0.329, 0.611, 0.183, 1.123, 0.1945, 0.579, 0.2575, 0.8035, 0.1985, 0.183, 0.488, 0.337, 1.3125, 0.4095, 0.7224999999999999, 0.873, 5.274, 0.4325, 0.4815, 0.323, 0.3595, 0.4675, 0.46, 0.3225, 0.33799999999999997, 0.27949999999999997, 0.5485, 0.2565, 0.223, 0.151, 0.849, 0.3275, 0.227, 0.8134999999999999, 0.196, 0.124, 0.1815, 0.322, 1.6019999999999999, 30.593, 0.192, 0.737, 0.275, 0.373, 0.5, 0.1915, 0.162, 2.5945, 0.2595, 0.6775, 0.2225, 0.1855, 0.4465, 0.4555, 0.213, 0.4175, 0.2935, 0.2205, 1.3945, 0.3695, 0.3255, 0.704, 0.3055, 0.149, 0.297, 0.009, 0.6905, 0.215, 0.563, 0.499, 0.386, 0.208, 0.172, 0.235, 0.3525, 1.9344999999999999, 0.3185, 0.384, 1.428, 0.0, 0.254, 0.185, 0.5874999999999999, 3.2584999999999997, 3.627, 0.527, 0.4535, 0.40549999999999997},
+		{0.434, 1.7814999999999999, 1.8824999999999998, 0.823, 0.8385, 0.9664999999999999, 1.625, 1.1395, 1.3585, 1.5345, 0.862, 2.149, 1.4135, 0.7745, 0.9590000000000001, 0.53, 0.9864999999999999, 0.8, 0.9724999999999999, 1.0739999999999998, 0.8055, 0.969, 1.1985000000000001, 1.1635, 1.0865, 0.831, 1.3279999999999998, 1.2465000000000002, 2.0220000000000002, 0.59, 0.768, 1.0605, 2.9785000000000004, 1.1915, 3.3810000000000002, 2.6574999999999998, 0.7344999999999999, 0.6839999999999999, 1.01, 1.674, 0.5589999999999999, 0.7845, 1.2225000000000001, 7.205, 0.6715, 6.1515, 1.061, 0.828, 1.308, 0.9715, 0.8434999999999999, 9.341000000000001, 17.758000000000003, 6.75, 0.832, 0.8265, 1.2435, 1.2515, 0.591, 1.1165, 1.0245, 1.0765, 3.0755, 0.616, 0.9155, 0.6305000000000001, 0.8445, 0.7949999999999999, 0.714, 1.308, 1.0964999999999998, 2.6390000000000002, 1.166, 0.652, 0.6105, 0.9695, 0.642, 0.487, 15.425, 1.111, 1.2995, 0.872, 1.3250000000000002, 1.1055, 1.367, 1.1125, 0.876, 1.1695, 0.8009999999999999, 2.939, 1.373, 2.295, 0.952, 0.6085, 19.206, 1.121, 0.7765, 4.6205, 0.9969999999999999, 2.222, 0.795, 1.125, 1.3455, 0.907, 0.5885, 9.0715, 1.3895, 0.5665, 7.252, 0.9390000000000001, 1.5175, 1.47, 0.9704999999999999, 1.12, 1.573, 1.131, 1.1835, 1.0775, 0.766, 4.0395, 1.0085, 1.3595000000000002, 2.1145, 6.601, 0.578, 1.0215, 0.554, 1.052, 0.853, 0.784, 0.7915000000000001, 0.764, 1.127, 1.6305, 0.8345, 2.9775, 1.717, 1.775, 1.2650000000000001, 0.6945, 1.1095000000000002, 0.719, 0.6415, 0.7615000000000001, 0.7064999999999999, 3.4844999999999997, 29.151, 0.9315, 1.7175, 1.2774999999999999, 3.2960000000000003, 0.96, 0.9964999999999999, 0.8294999999999999, 2.9924999999999997, 0.7255, 1.9474999999999998, 1.049, 0.6950000000000001, 1.3155000000000001, 1.207, 0.6759999999999999, 0.6545000000000001, 1.566, 1.0675, 1.6945000000000001, 0.575, 0.9735, 15.754000000000001, 0.5874999999999999, 1.387, 0.766, 0.7829999999999999, 0.016, 2.564, 0.976, 2.8685, 1.1115, 1.3785, 0.696, 0.865, 0.628, 2.4764999999999997, 0.9884999999999999, 1.0, 2.6085000000000003, 0.0, 0.688, 0.6719999999999999, 1.2415, 4.835, 7.564, 1.1915, 1.1545, 0.637},
+		{0.546, 0.4395, 1.142, 0.219, 0.5914999999999999, 0.218, 1.4745, 0.5375000000000001, 0.3155, 0.7395, 0.366, 1.3815, 0.397, 0.45, 0.27, 0.389, 0.2465, 0.2265, 0.738, 0.62, 0.3085, 0.23249999999999998, 0.7575000000000001, 0.4, 0.215, 0.451, 0.2635, 0.307, 0.9195, 0.413, 0.448, 0.605, 2.129, 0.329, 1.2094999999999998, 0.4245, 0.4255, 0.367, 0.44, 0.5665, 0.418, 0.339, 0.613, 7.777, 0.384, 4.031000000000001, 0.707, 0.5640000000000001, 0.696, 0.5874999999999999, 0.20450000000000002, 4.225, 16.43, 2.197, 0.5865, 0.447, 0.398, 0.7555000000000001, 0.4835, 0.406, 0.2205, 0.4395, 2.7845, 0.4115, 0.429, 0.4365, 0.5205, 0.398, 0.3785, 0.552, 0.41, 0.6565, 0.6345000000000001, 0.423, 0.246, 0.538, 0.3605, 0.404, 14.189499999999999, 0.4845, 0.9215, 0.368, 0.7264999999999999, 0.404, 0.5645, 0.5805, 0.6054999999999999, 0.322, 0.321, 1.8215, 0.584, 0.698, 0.417, 0.4185, 11.9495, 0.4155, 3.4204999999999997, 0.996, 0.623, 0.7875000000000001, 0.3775, 0.43, 0.865, 0.391, 0.388, 7.2005, 0.431, 0.4105, 0.4245, 0.282, 1.0415, 0.2455, 0.6014999999999999, 0.307, 1.9315, 0.2395, 0.20400000000000001, 0.614, 0.367, 1.06, 0.4485, 1.0545, 0.66, 5.194, 0.4245, 0.62, 0.3305, 0.398, 0.475, 0.4495, 0.319, 0.3275, 0.458, 0.5575, 0.247, 0.2885, 0.209, 0.9455, 0.4825, 0.2995, 0.881, 0.2235, 0.177, 0.287, 0.355, 2.0085, 27.5805, 0.2305, 0.7044999999999999, 0.343, 0.412, 0.482, 0.2305, 0.281, 2.495, 0.321, 0.796, 0.307, 0.21, 0.595, 0.545, 0.2115, 0.5095000000000001, 0.6014999999999999, 0.262, 1.4344999999999999, 0.3885, 0.396, 0.7585, 0.40449999999999997, 0.2395, 0.402, 0.238, 0.9755, 0.058, 0.5765, 0.6805000000000001, 0.4505, 0.2935, 0.203, 0.2675, 0.3675, 2.0625, 0.3915, 0.3785, 1.3395000000000001, 0.0, 0.201, 0.224, 0.777, 3.1265, 4.539, 0.486, 0.458, 0.41},
+		{0.751, 1.2155, 1.8025, 0.62, 0.679, 0.6154999999999999, 1.4020000000000001, 0.8775, 0.6134999999999999, 1.3385, 0.535, 1.238, 0.7244999999999999, 0.8734999999999999, 0.5195000000000001, 0.5754999999999999, 0.8374999999999999, 0.5605, 1.1175000000000002, 0.8185, 0.573, 0.506, 1.587, 0.798, 0.5095000000000001, 0.7164999999999999, 0.739, 0.5625, 4.0725, 0.6535, 0.6575, 1.0394999999999999, 2.791, 0.5375000000000001, 1.3900000000000001, 0.8565, 1.2770000000000001, 0.7104999999999999, 0.6325000000000001, 0.841, 0.8755, 0.5754999999999999, 0.8325, 8.9435, 0.5774999999999999, 4.945, 0.9464999999999999, 0.8305, 0.827, 0.872, 0.556, 5.1525, 17.2155, 3.8475, 0.846, 0.847, 0.751, 1.0095, 0.662, 0.742, 0.657, 0.7785, 3.563, 0.565, 0.6505000000000001, 0.6655, 0.763, 0.6805000000000001, 0.6775, 1.14, 0.5874999999999999, 0.9504999999999999, 1.077, 0.659, 0.439, 0.8514999999999999, 0.6275, 0.619, 14.704, 0.8274999999999999, 1.2995, 0.6405000000000001, 1.2865, 0.8255, 0.8225, 0.8875, 0.9065000000000001, 0.589, 0.675, 2.2035, 0.833, 0.961, 0.6125, 0.7555000000000001, 10.097, 0.617, 10.0525, 1.1749999999999998, 0.9909999999999999, 1.7555, 0.625, 0.8045, 1.1075, 0.9035, 0.6835, 8.8785, 0.6375, 0.6245, 5.093999999999999, 0.581, 1.6760000000000002, 0.529, 1.454, 0.599, 1.6920000000000002, 0.4995, 0.449, 0.706, 0.6815, 2.5815, 0.6365000000000001, 2.779, 1.9, 5.471, 0.715, 0.744, 0.551, 0.6485000000000001, 0.6025, 0.687, 0.5985, 0.579, 0.8320000000000001, 1.034, 0.6114999999999999, 1.0325, 0.656, 1.511, 0.8534999999999999, 0.6275, 1.5365, 0.496, 0.5485, 0.75, 0.48, 4.035, 27.7215, 0.5765, 1.282, 0.7845, 0.626, 0.94, 0.5535000000000001, 0.46599999999999997, 3.1574999999999998, 0.5905, 0.748, 0.8225, 0.76, 0.9435, 0.8955, 0.5465, 0.6905, 1.287, 0.8300000000000001, 2.2865, 0.569, 0.7145, 3.354, 0.549, 0.714, 0.562, 0.5035000000000001, 4.5115, 0.812, 0.0465, 0.772, 0.778, 0.535, 0.4245, 0.601, 0.635, 2.5385, 0.6675, 0.7024999999999999, 1.522, 0.0, 0.7324999999999999, 0.5245, 1.111, 3.8275, 6.497999999999999, 0.7795000000000001, 1.5465, 0.5635},
+		{0.4695, 0.5555, 1.1564999999999999, 0.42, 0.527, 1.0055, 0.8935, 0.695, 0.495, 0.612, 0.515, 0.977, 0.484, 0.6315, 0.537, 0.486, 0.432, 0.5745, 0.7124999999999999, 0.6890000000000001, 1.9184999999999999, 0.4285, 0.6255, 2.0015, 1.9075, 3.1105, 0.868, 0.5005, 1.169, 0.6525000000000001, 0.576, 0.771, 2.026, 0.368, 0.7464999999999999, 0.582, 0.5435000000000001, 0.434, 0.575, 0.542, 0.5025, 0.478, 0.7545, 7.9235, 0.5255000000000001, 4.2735, 0.5825, 0.702, 0.7204999999999999, 0.6345000000000001, 0.4575, 4.3815, 14.210999999999999, 2.8520000000000003, 0.651, 2.181, 0.551, 0.755, 0.537, 0.529, 0.5215000000000001, 0.52, 1.8485, 0.539, 1.1895, 0.5, 0.5965, 0.4985, 0.4775, 0.712, 0.7045, 0.6255, 0.712, 0.697, 0.419, 0.6245, 0.5665, 0.5115000000000001, 13.4025, 0.585, 1.0, 0.5185, 0.872, 0.5495000000000001, 0.661, 0.637, 0.57, 0.4945, 1.9020000000000001, 2.0155, 0.589, 0.715, 0.549, 1.314, 8.9825, 0.5015000000000001, 0.5285, 0.6965, 1.7535, 0.8089999999999999, 0.548, 0.5035000000000001, 0.9784999999999999, 0.5375000000000001, 0.5615, 7.491, 1.359, 0.518, 0.8275, 0.458, 1.046, 1.924, 2.667, 0.4975, 2.8520000000000003, 0.455, 0.436, 0.67, 0.5255000000000001, 1.745, 0.773, 3.967, 0.67, 5.2545, 0.825, 0.7184999999999999, 0.5589999999999999, 0.501, 0.461, 0.6785000000000001, 0.4705, 0.516, 0.7064999999999999, 1.9994999999999998, 0.444, 0.7404999999999999, 0.374, 0.836, 1.9209999999999998, 0.539, 0.859, 0.403, 0.414, 0.501, 0.7495, 2.0949999999999998, 26.5445, 0.392, 1.1179999999999999, 2.0694999999999997, 0.544, 0.607, 0.4605, 0.437, 2.7510000000000003, 0.5085, 0.906, 0.4845, 0.4565, 0.6054999999999999, 0.6465000000000001, 0.422, 0.576, 0.5155000000000001, 0.446, 1.1335, 0.6185, 0.5965, 2.378, 0.531, 0.4005, 0.542, 0.469, 0.8180000000000001, 0.6205, 0.8415, 0.176, 0.5694999999999999, 0.5865, 1.8385, 0.6865, 0.5505, 2.092, 0.5985, 0.996, 1.2694999999999999, 0.0, 0.653, 0.431, 0.78, 3.2915, 5.568, 0.743, 0.614, 0.5565},
+		{0.663, 0.5805, 1.081, 0.392, 0.56, 0.442, 0.9555, 0.5865, 0.4255, 0.6305000000000001, 0.527, 0.6675, 0.532, 0.5665, 0.4065, 0.4915, 0.402, 0.3575, 0.7175, 0.673, 0.4365, 0.4215, 0.6485000000000001, 0.5195000000000001, 0.34199999999999997, 0.5509999999999999, 0.353, 0.3795, 0.8525, 0.5745, 0.549, 0.601, 2.0010000000000003, 0.475, 0.7315, 0.549, 0.6685000000000001, 0.395, 0.605, 0.676, 0.501, 0.511, 0.6214999999999999, 7.7355, 0.526, 3.961, 0.7735000000000001, 0.6625000000000001, 0.7645, 0.5135000000000001, 0.7665, 4.0325, 14.993500000000001, 2.44, 0.5925, 0.3845, 0.5095000000000001, 0.8975, 0.516, 0.515, 0.4265, 0.615, 1.802, 0.528, 0.5225, 0.448, 0.592, 0.569, 0.4415, 0.569, 0.6245, 0.8140000000000001, 0.7235, 0.611, 0.444, 0.6455, 0.518, 0.5305, 10.516, 0.5335000000000001, 0.9145000000000001, 0.532, 0.8165, 0.5265, 0.6435, 0.6645000000000001, 0.636, 0.5115000000000001, 0.494, 1.7945, 0.668, 0.68, 0.519, 0.5475000000000001, 8.7905, 0.483, 4.439500000000001, 3.7055, 0.6575, 0.781, 0.561, 0.565, 0.934, 0.5605, 0.5085, 7.1695, 0.589, 0.522, 0.7030000000000001, 0.375, 0.8354999999999999, 0.3855, 0.64, 0.4105, 1.0795, 0.5105, 0.379, 0.6445000000000001, 0.4895, 0.8165, 0.562, 0.946, 0.7865, 4.531, 0.5635, 0.6085, 0.458, 0.4835, 0.577, 0.5845, 0.4815, 0.5115000000000001, 0.511, 0.5945, 0.4175, 0.4275, 0.33999999999999997, 0.745, 0.4985, 0.3525, 0.8125, 0.40249999999999997, 0.371, 0.433, 0.40249999999999997, 1.3445, 27.752000000000002, 0.435, 0.8405, 0.89, 0.4415, 0.4995, 0.365, 0.397, 2.7295, 0.3235, 0.7164999999999999, 0.4025, 0.398, 0.6925, 0.576, 0.3915, 0.5974999999999999, 0.429, 0.4205, 1.2645, 0.5535000000000001, 0.595, 0.8905000000000001, 0.4995, 0.443, 0.4385, 0.355, 0.8214999999999999, 0.4335, 0.72, 0.6, 0.0095, 0.443, 0.3775, 0.3865, 0.5, 1.791, 0.5295000000000001, 0.5885, 1.474, 0.0, 0.4125, 0.3885, 0.8995, 2.7285, 5.789, 0.627, 0.5495000000000001, 0.5814999999999999},
+		{0.4955, 0.39, 1.008, 0.2635, 0.52, 0.243, 1.0715, 0.563, 0.313, 0.501, 0.3745, 0.5585, 0.417, 0.384, 0.2255, 0.3255, 0.218, 0.217, 0.5555000000000001, 0.5945, 0.308, 0.234, 0.5865, 0.3205, 0.188, 0.429, 0.2305, 0.296, 0.8665, 0.411, 0.4255, 0.5255000000000001, 2.074, 0.3045, 0.7955000000000001, 0.423, 0.40700000000000003, 0.33999999999999997, 0.4665, 0.623, 0.4415, 0.348, 0.603, 7.5024999999999995, 0.391, 4.0215, 0.723, 0.506, 0.582, 0.3725, 0.6455, 3.5945, 12.342, 2.097, 0.567, 0.3245, 0.4135, 0.7015, 0.452, 0.3805, 0.20850000000000002, 0.47150000000000003, 1.619, 0.3865, 0.4005, 0.3935, 0.457, 0.44, 0.3675, 0.391, 0.4785, 0.63, 0.59, 0.495, 0.215, 0.601, 0.363, 0.414, 11.945, 0.4555, 0.81, 0.3785, 0.7184999999999999, 0.32799999999999996, 0.5609999999999999, 0.6134999999999999, 0.5465, 0.3795, 0.3625, 1.7185000000000001, 0.589, 0.542, 0.39149999999999996, 0.4095, 7.726500000000001, 0.3785, 0.3575, 6.523999999999999, 0.5215000000000001, 0.8135, 0.422, 0.424, 0.8305, 0.346, 0.398, 6.923, 0.396, 0.404, 0.84, 0.2595, 0.6930000000000001, 0.252, 0.589, 0.26, 1.2985, 0.2905, 0.195, 0.5954999999999999, 0.3215, 1.782, 0.4275, 0.892, 0.7835000000000001, 5.1845, 0.3945, 0.5965, 0.337, 0.40549999999999997, 0.44, 0.5145, 0.3115, 0.3655, 0.351, 0.4335, 0.2855, 0.3045, 0.1765, 0.9025, 0.4205, 0.291, 0.7615000000000001, 0.206, 0.1975, 0.254, 0.274, 1.7375, 28.729, 0.266, 0.7224999999999999, 0.268, 0.381, 0.6114999999999999, 0.23199999999999998, 0.2305, 2.6525, 0.301, 0.7164999999999999, 0.2715, 0.196, 0.547, 0.622, 0.2195, 0.4565, 0.372, 0.275, 1.3335, 0.34950000000000003, 0.3925, 1.9209999999999998, 0.40800000000000003, 0.2455, 0.368, 0.2255, 1.26, 0.3085, 0.594, 0.659, 0.42, 0.009, 0.2565, 0.267, 0.304, 1.9575, 0.368, 0.3925, 1.32, 0.0, 0.245, 0.1905, 0.57, 2.749, 4.13, 0.647, 0.4685, 0.4075},
+		{0.55, 0.385, 0.918, 0.1655, 0.491, 0.1615, 1.0295, 0.548, 0.2435, 0.43, 0.312, 0.8554999999999999, 0.3875, 0.377, 0.219, 0.3075, 0.1735, 0.1525, 0.5734999999999999, 0.5814999999999999, 0.2755, 0.1415, 0.523, 0.2815, 0.1175, 0.4245, 0.2175, 0.221, 1.001, 0.3935, 0.3755, 0.46799999999999997, 1.9209999999999998, 0.2355, 0.617, 0.412, 0.404, 0.21, 0.5195000000000001, 0.614, 0.2935, 0.388, 0.4905, 7.894, 0.3415, 4.083, 0.5325, 0.582, 0.578, 0.4225, 0.43300000000000005, 3.81, 16.971, 3.169, 0.591, 0.261, 0.3165, 0.639, 0.401, 0.3195, 0.2075, 0.439, 1.992, 0.3615, 0.4125, 0.3585, 0.4445, 0.4085, 0.295, 0.2835, 0.3835, 0.5405, 0.5874999999999999, 0.4205, 0.1805, 0.485, 0.3775, 0.347, 12.1435, 0.4015, 0.7935000000000001, 0.4015, 0.655, 0.2975, 0.438, 0.5485, 0.595, 0.2955, 0.281, 1.596, 0.5805, 0.5885, 0.393, 0.389, 5.46, 0.29, 0.311, 6.343500000000001, 0.4305, 0.8, 0.356, 0.334, 0.827, 0.4235, 0.3235, 6.314500000000001, 0.3275, 0.3525, 0.3725, 0.2165, 0.72, 0.186, 0.548, 0.2285, 0.9225, 0.1795, 0.1235, 0.4825, 0.3175, 1.6880000000000002, 0.3755, 0.628, 0.5565, 5.642, 0.386, 0.5754999999999999, 0.2655, 0.3625, 0.40800000000000003, 0.414, 0.3, 0.3275, 0.3635, 0.46699999999999997, 0.169, 0.22, 0.1505, 0.633, 0.384, 0.201, 0.6685, 0.1095, 0.1175, 0.175, 0.298, 1.446, 27.6995, 0.1795, 0.7525, 0.2515, 0.372, 0.436, 0.158, 0.171, 2.7005, 0.2505, 0.716, 0.178, 0.1565, 0.442, 0.447, 0.1605, 0.377, 0.3775, 0.169, 1.2469999999999999, 0.391, 0.4575, 0.6915, 0.274, 0.1755, 0.27849999999999997, 0.15, 0.65, 0.199, 0.506, 1.9685000000000001, 0.349, 0.224, 0.006, 0.2385, 0.3195, 1.744, 0.349, 0.352, 1.2915, 0.0985, 0.1305, 0.129, 0.627, 3.3775, 4.016, 0.481, 0.403, 0.3405},
+		{0.4265, 0.476, 1.1524999999999999, 0.2875, 0.5495000000000001, 0.252, 1.148, 0.476, 0.34, 0.5225, 0.3595, 0.635, 0.4225, 0.3985, 0.232, 0.34550000000000003, 0.266, 0.276, 0.571, 0.632, 0.322, 0.2745, 0.6915, 0.328, 0.2275, 0.3965, 0.301, 0.3145, 1.2215, 0.41, 0.4115, 0.5765, 2.1785, 0.3795, 0.9390000000000001, 0.449, 0.5509999999999999, 0.3205, 0.4165, 0.5694999999999999, 0.453, 0.3855, 0.5445, 7.696, 0.4425, 3.956, 0.641, 0.4865, 0.5205, 0.5065, 0.2215, 3.7430000000000003, 13.4955, 2.584, 0.5914999999999999, 0.2815, 0.3775, 0.6505000000000001, 0.4195, 0.38, 0.226, 0.4525, 1.0434999999999999, 0.3655, 0.451, 0.388, 0.4245, 0.429, 0.3065, 0.3725, 0.4225, 0.6685000000000001, 0.582, 0.5025, 0.254, 0.5685, 0.411, 0.4185, 12.4775, 0.516, 0.91, 0.4085, 0.8195, 0.3545, 0.4855, 0.5834999999999999, 0.5565, 0.364, 0.317, 1.805, 0.551, 0.6094999999999999, 0.371, 0.466, 8.5245, 0.379, 0.40700000000000003, 0.8385, 0.4645, 0.7444999999999999, 0.388, 0.3955, 0.858, 0.4155, 0.366, 6.282, 0.421, 0.40900000000000003, 0.4005, 0.273, 0.906, 0.259, 0.667, 0.3465, 1.0659999999999998, 0.264, 0.2065, 0.575, 0.3925, 1.5685, 0.3925, 0.8049999999999999, 0.692, 4.8915, 0.3915, 0.5305, 0.3765, 0.3745, 0.451, 0.5085, 0.369, 0.405, 0.315, 0.4615, 0.2395, 0.292, 0.1825, 1.0895000000000001, 0.533, 0.243, 0.773, 0.2575, 0.24, 0.287, 0.318, 1.6309999999999998, 30.704500000000003, 0.276, 0.7155, 0.5605, 0.471, 0.626, 0.2125, 0.2635, 2.8455000000000004, 0.33999999999999997, 0.562, 0.3245, 0.271, 0.5105, 0.518, 0.25, 0.431, 0.5135000000000001, 0.2445, 1.131, 0.379, 0.391, 0.6485000000000001, 0.3395, 0.276, 0.351, 0.256, 0.9410000000000001, 0.2485, 0.484, 0.7404999999999999, 0.388, 0.274, 0.215, 0.014, 0.382, 1.9405000000000001, 0.3935, 0.4105, 1.375, 0.0, 0.206, 0.2285, 0.64, 3.1105, 4.029, 0.607, 0.4605, 0.367},
+		{0.3515, 0.20500000000000002, 1.034, 0.3435, 0.40149999999999997, 0.315, 0.9595, 0.651, 0.335, 0.2775, 0.133, 0.5475, 0.229, 0.1885, 0.365, 0.1555, 0.3065, 0.33, 0.487, 0.4325, 0.387, 0.319, 0.735, 0.40349999999999997, 0.3175, 0.3215, 0.3245, 0.3855, 0.8374999999999999, 0.285, 0.223, 0.3045, 1.9184999999999999, 0.383, 0.624, 0.5345, 0.4875, 0.444, 0.3295, 0.4785, 0.1905, 0.1895, 0.386, 4.3215, 0.2325, 3.7555, 0.6585000000000001, 0.513, 0.499, 0.5934999999999999, 0.448, 3.9589999999999996, 15.193000000000001, 2.8935, 0.512, 0.49, 0.1575, 0.63, 0.3055, 0.424, 0.3225, 0.2995, 1.6135000000000002, 0.1865, 0.296, 0.2585, 0.5265, 0.245, 0.358, 0.481, 0.245, 0.3935, 0.479, 0.2995, 0.3275, 0.4565, 0.1775, 0.239, 13.1135, 0.258, 0.7284999999999999, 0.2145, 0.615, 0.16999999999999998, 0.6955, 0.5609999999999999, 1.771, 0.1225, 0.448, 1.4304999999999999, 0.5685, 0.544, 0.217, 0.281, 8.824, 0.4425, 0.521, 0.6395, 0.6014999999999999, 0.6875, 0.2255, 0.2145, 0.815, 0.2615, 0.1785, 6.452999999999999, 0.1955, 0.222, 0.2115, 0.3905, 0.8045, 0.379, 0.389, 0.3725, 1.0285, 0.302, 0.281, 0.4545, 0.4505, 2.6325, 0.2125, 0.8089999999999999, 0.6495, 4.303, 0.248, 0.512, 0.156, 0.5, 0.5005, 0.27849999999999997, 0.182, 0.157, 0.418, 0.5125, 0.2645, 0.4305, 0.294, 0.7665, 0.454, 0.33199999999999996, 0.781, 0.277, 0.3005, 0.394, 0.395, 1.4809999999999999, 27.3475, 0.325, 0.8145, 0.445, 0.493, 0.5445, 0.348, 0.351, 2.8245, 0.382, 0.5265, 0.431, 0.3385, 0.3765, 0.539, 0.364, 0.294, 0.5295000000000001, 0.321, 0.3175, 0.178, 0.1995, 1.202, 0.1805, 0.3695, 0.158, 0.3525, 0.6194999999999999, 0.315, 0.662, 0.626, 0.5085, 0.364, 0.3105, 0.3865, 0.015, 1.8685, 0.191, 0.228, 1.4005, 0.1545, 0.28, 0.303, 0.7070000000000001, 3.2335000000000003, 3.6525, 0.5874999999999999, 0.522, 0.201},
+		{1.7505, 2.1775, 1.91, 1.982, 1.873, 1.7635, 2.2295, 2.1745, 1.899, 2.246, 1.889, 2.1565, 1.62, 1.6825, 1.738, 1.7029999999999998, 1.697, 2.1485000000000003, 2.0655, 2.102, 1.81, 1.711, 1.8690000000000002, 2.0045, 1.7265000000000001, 1.8860000000000001, 2.01, 1.7894999999999999, 2.5315000000000003, 2.1255, 1.8065, 1.933, 2.6559999999999997, 1.756, 2.2625, 1.8265, 1.8405, 1.6640000000000001, 1.851, 2.2285000000000004, 1.6869999999999998, 1.8159999999999998, 2.1135, 7.5365, 2.0365, 4.3925, 2.176, 1.7814999999999999, 1.7845, 1.893, 1.9275, 4.8175, 15.7775, 3.3485, 2.1135, 2.1695, 1.738, 2.0305, 1.9264999999999999, 1.7890000000000001, 1.883, 1.8559999999999999, 3.004, 1.919, 1.927, 1.8784999999999998, 1.71, 1.7854999999999999, 1.9100000000000001, 1.733, 1.9915, 2.0635000000000003, 1.6585, 1.932, 1.886, 1.912, 1.8745, 1.832, 12.8585, 1.8655, 1.9929999999999999, 1.9575, 2.0165, 1.7785, 2.0345, 2.167, 2.121, 1.6345, 1.7925, 2.596, 2.0355, 1.9665, 1.7610000000000001, 1.9075, 7.1905, 2.017, 2.0735, 1.9155, 1.7635, 2.3785, 2.075, 1.98, 2.063, 1.6164999999999998, 1.918, 7.552, 1.8904999999999998, 1.903, 2.7195, 2.0605, 2.5835, 1.96, 2.056, 1.536, 2.634, 1.9129999999999998, 1.85, 1.866, 1.7810000000000001, 2.677, 1.915, 2.189, 2.2765, 5.781499999999999, 1.7200000000000002, 2.001, 1.6625, 1.949, 1.995, 1.9855, 1.5115, 2.1155, 2.003, 2.0675, 1.85, 1.9115, 1.669, 2.1045, 1.7525, 1.826, 1.8815, 1.82, 1.633, 2.0300000000000002, 1.9035, 2.55, 26.759500000000003, 1.983, 1.8929999999999998, 2.2285, 1.5385, 1.768, 1.822, 1.9435, 3.066, 1.7934999999999999, 1.8395, 1.9065, 1.7055, 2.2199999999999998, 1.7835, 1.6895, 1.6844999999999999, 2.3375, 1.6745, 2.9395, 1.818, 1.958, 2.4395, 1.745, 1.884, 1.853, 1.7715, 2.707, 2.081, 1.9304999999999999, 2.0629999999999997, 1.6625, 1.629, 1.773, 2.005, 1.895, 0.006, 1.7255, 1.8639999999999999, 2.2225, 0.0, 2.011, 1.9620000000000002, 2.51, 3.5235000000000003, 5.9704999999999995, 1.9729999999999999, 2.0945, 22.526},
+		{0.431, 0.227, 0.878, 0.3735, 0.463, 0.325, 0.959, 0.592, 0.4075, 0.389, 0.165, 0.6345, 0.246, 0.226, 0.366, 0.2105, 0.3295, 0.365, 0.5225, 0.4605, 0.429, 0.349, 0.7395, 0.4445, 0.3565, 0.3045, 0.349, 0.4, 0.849, 0.33, 0.2475, 0.34950000000000003, 1.9925000000000002, 0.4305, 0.6034999999999999, 0.5455000000000001, 0.477, 0.406, 0.3665, 0.5105, 0.27, 0.1385, 0.3615, 5.2695, 0.2265, 4.3004999999999995, 0.6365000000000001, 0.5595, 0.545, 0.4985, 0.3615, 4.4795, 11.646999999999998, 1.9075, 0.5105, 0.526, 0.1905, 0.6305000000000001, 0.336, 0.4355, 0.34099999999999997, 0.33, 1.954, 0.217, 0.342, 0.235, 0.508, 0.2785, 0.427, 0.5245, 0.2725, 0.4595, 0.4845, 0.348, 0.3235, 0.4675, 0.196, 0.2665, 10.716000000000001, 0.2645, 0.8645, 0.20600000000000002, 0.64, 0.22699999999999998, 0.645, 0.598, 0.4745, 0.214, 0.4625, 1.7555, 0.704, 0.5345, 0.2755, 0.2765, 8.888, 0.4365, 0.222, 0.6395, 0.612, 0.6890000000000001, 0.2285, 0.241, 0.832, 0.2645, 0.1985, 7.368, 0.252, 0.23, 0.258, 0.388, 0.957, 0.3355, 0.479, 0.372, 1.0095, 0.3545, 0.2825, 0.44, 0.523, 1.4035, 0.2435, 0.7384999999999999, 0.7110000000000001, 5.1745, 0.2445, 0.5914999999999999, 0.177, 0.4515, 0.518, 0.333, 0.183, 0.1965, 0.439, 0.5775, 0.34199999999999997, 0.5, 0.3795, 0.8340000000000001, 0.4845, 0.324, 0.8634999999999999, 0.335, 0.3055, 0.3755, 0.4365, 1.4355, 26.908, 0.349, 0.7484999999999999, 0.5885, 0.446, 0.5825, 0.346, 0.353, 2.844, 0.34299999999999997, 0.673, 0.3565, 0.396, 0.487, 0.5925, 0.3185, 0.3315, 0.4395, 0.354, 0.998, 0.1875, 0.2375, 0.6884999999999999, 0.359, 0.4195, 0.1615, 0.33999999999999997, 0.5585, 0.365, 0.6165, 0.664, 0.512, 0.3475, 0.3605, 0.42, 0.195, 1.9365, 0.009, 0.2515, 1.387, 0.1405, 0.3415, 0.318, 0.732, 3.3985000000000003, 6.3870000000000005, 0.666, 0.4955, 0.2475},
+		{0.3825, 0.311, 0.9894999999999999, 0.3745, 0.3635, 0.3355, 0.7855000000000001, 0.6695, 0.393, 0.522, 0.20350000000000001, 0.6145, 0.311, 0.3935, 0.4275, 0.19, 0.333, 0.3645, 0.494, 0.3785, 0.4145, 0.426, 0.8365, 0.467, 0.3775, 0.245, 0.4165, 0.3245, 0.885, 0.2685, 0.2285, 0.443, 2.0244999999999997, 0.436, 0.7075, 0.5925, 0.5589999999999999, 0.552, 0.337, 0.5545, 0.223, 0.207, 0.369, 4.9955, 0.101, 4.049, 0.5565, 0.597, 0.579, 0.6275, 0.4175, 4.072, 17.228, 1.7650000000000001, 0.5405, 0.568, 0.2545, 0.6775, 0.291, 0.4805, 0.366, 0.2345, 2.498, 0.17099999999999999, 0.2295, 0.299, 0.6085, 0.2435, 0.4845, 0.532, 0.324, 0.3815, 0.5055000000000001, 0.3385, 0.349, 0.4965, 0.35, 0.2535, 11.2605, 0.2155, 0.7344999999999999, 0.1855, 0.7765, 0.2735, 0.648, 0.5365, 0.4055, 0.218, 0.5205, 1.6920000000000002, 0.629, 0.46199999999999997, 0.2145, 0.268, 7.5095, 0.477, 0.2555, 0.641, 0.592, 0.756, 0.2705, 0.328, 0.7845, 0.2505, 0.3045, 6.218, 0.165, 0.158, 0.269, 0.474, 1.1720000000000002, 0.3945, 0.4095, 0.434, 1.1865, 0.41800000000000004, 0.363, 0.4455, 0.4715, 1.4035, 0.3205, 0.8280000000000001, 0.7615000000000001, 5.195, 0.2055, 0.5285, 0.2305, 0.5165, 0.492, 0.297, 0.232, 0.2375, 0.4885, 0.6505000000000001, 0.39, 0.48, 0.3835, 0.7295, 0.517, 0.3885, 0.9924999999999999, 0.351, 0.3375, 0.4005, 0.473, 1.193, 25.250999999999998, 0.393, 0.8374999999999999, 0.441, 0.5015000000000001, 0.4635, 0.3965, 0.404, 2.732, 0.4365, 0.8394999999999999, 0.4575, 0.34850000000000003, 0.4625, 0.5375000000000001, 0.384, 0.306, 0.6174999999999999, 0.399, 1.2135, 0.166, 0.23349999999999999, 0.6485000000000001, 0.236, 0.4845, 0.2115, 0.3595, 0.856, 0.4045, 0.615, 0.7484999999999999, 0.563, 0.448, 0.391, 0.3935, 0.2825, 2.0295, 0.244, 0.018, 1.3795, 0.0885, 0.4165, 0.3855, 1.1615, 3.2075, 4.102, 0.705, 0.5925, 0.2635},
+		{2.2325, 1.5565, 1.6945000000000001, 1.3130000000000002, 1.0659999999999998, 2.3125, 4.7225, 1.658, 1.1015000000000001, 1.863, 1.7955, 2.201, 0.9835, 1.705, 1.1995, 1.9165, 1.104, 1.626, 1.2215, 1.0745, 1.191, 2.9939999999999998, 1.3545, 1.1345, 1.6125, 1.342, 1.217, 1.8695, 2.854, 1.3395000000000001, 1.211, 1.7015, 2.81, 1.1404999999999998, 1.7155, 1.2025000000000001, 1.2005, 1.4315, 1.1495, 1.4135, 1.2965, 1.298, 1.597, 10.1, 1.238, 4.329000000000001, 1.315, 2.172, 1.8039999999999998, 2.0525, 1.611, 4.875, 16.144, 4.827999999999999, 1.381, 2.017, 1.5474999999999999, 1.315, 1.1925, 1.2925, 1.1915, 1.283, 3.1319999999999997, 1.4315, 1.5310000000000001, 1.4205, 1.6320000000000001, 2.2515, 1.5939999999999999, 1.169, 1.6, 1.988, 1.7530000000000001, 1.8315000000000001, 1.4365, 1.227, 1.214, 1.338, 13.7635, 2.2585, 2.5454999999999997, 1.0554999999999999, 1.7730000000000001, 1.8995, 1.3295, 1.4649999999999999, 2.034, 1.0590000000000002, 1.5035, 2.5745, 1.574, 1.9754999999999998, 1.6055000000000001, 1.5034999999999998, 13.5735, 1.3815, 1.1465, 2.326, 1.5585, 1.7885, 1.302, 2.1395, 1.795, 3.451, 1.396, 7.4385, 3.2135, 2.3085, 3.7725, 1.096, 1.8705, 1.1995, 1.869, 1.2785, 2.1845, 1.1815, 0.9704999999999999, 1.8305, 1.0485, 2.1215, 1.7875, 1.888, 1.811, 5.641, 1.5219999999999998, 1.259, 3.0795, 1.4035, 1.085, 2.2555, 1.8765, 0.986, 1.5255, 1.278, 1.5030000000000001, 1.6825, 1.333, 1.762, 1.2755, 1.463, 1.9245, 1.314, 1.3355000000000001, 3.0705, 2.3529999999999998, 3.5780000000000003, 26.9115, 0.9185000000000001, 1.573, 1.2735, 1.3119999999999998, 1.218, 1.0845, 1.5830000000000002, 2.7960000000000003, 1.5255, 2.891, 1.2995, 2.023, 1.597, 1.476, 1.749, 1.7395, 1.416, 1.0354999999999999, 2.598, 1.443, 1.3735, 2.8594999999999997, 1.0795, 1.5585, 1.216, 1.1495, 2.4364999999999997, 1.8765, 2.7634999999999996, 1.228, 2.02, 2.05, 2.2190000000000003, 2.6215, 2.08, 2.4505, 1.02, 1.537, 0.026, 0.0, 2.3064999999999998, 1.5325000000000002, 3.455, 3.6980000000000004, 6.6475, 1.2015, 2.333, 1.1575},
+		{0.487, 0.42, 1.1195, 0.4345, 1.339, 0.461, 3.3019999999999996, 0.8005, 0.535, 4.237, 1.7785, 1.702, 0.3305, 0.338, 0.5035000000000001, 2.3165, 1.197, 0.4275, 3.3885, 0.606, 0.474, 0.481, 0.6924999999999999, 0.5965, 0.44, 0.507, 0.5095000000000001, 2.077, 3.8855, 1.064, 0.3635, 3.112, 2.041, 1.5554999999999999, 0.6425000000000001, 2.8635, 0.609, 0.544, 2.2880000000000003, 0.5285, 0.3715, 3.752, 0.6585000000000001, 4.2625, 1.877, 3.7685, 0.71, 0.6445000000000001, 1.5345, 0.6795, 0.465, 6.019, 16.072499999999998, 4.4094999999999995, 0.704, 3.4625000000000004, 4.7795000000000005, 0.6984999999999999, 0.4535, 4.9135, 0.4185, 0.3975, 1.2974999999999999, 2.2865, 2.468, 1.299, 0.7475, 0.489, 1.1235, 0.5854999999999999, 1.1675, 2.856, 2.3335, 0.4515, 1.9794999999999998, 0.621, 1.3295, 0.325, 11.369, 0.3835, 2.7474999999999996, 2.9135, 2.004, 1.1545, 1.3765, 0.511, 0.5814999999999999, 0.34299999999999997, 1.696, 3.226, 1.1175000000000002, 2.2305, 0.3265, 0.4245, 12.2325, 0.582, 0.438, 0.981, 2.3979999999999997, 0.5754999999999999, 0.3745, 0.433, 1.4735, 0.355, 2.4455, 6.8465, 2.588, 0.9490000000000001, 0.5645, 0.463, 1.016, 0.5, 0.5635, 0.5275000000000001, 1.1325, 0.496, 2.3095, 0.511, 0.582, 1.0125, 0.4805, 2.8485, 0.7024999999999999, 5.1675, 0.438, 0.749, 1.9595, 0.596, 0.7415, 1.3679999999999999, 3.0495, 4.471, 0.589, 0.7135, 1.2155, 0.537, 0.49, 2.0705, 0.6335, 1.123, 0.9205000000000001, 0.4755, 0.478, 0.567, 0.51, 1.6760000000000002, 28.429000000000002, 0.46299999999999997, 2.1925, 0.575, 5.058, 2.6505, 1.2675, 0.445, 3.2279999999999998, 0.542, 1.7530000000000001, 0.5625, 1.0685, 0.499, 1.7985, 2.317, 0.7515000000000001, 0.603, 1.04, 1.2475, 2.5615, 2.3215000000000003, 9.181999999999999, 1.283, 0.4785, 2.096, 0.482, 0.644, 0.528, 0.7395, 0.68, 0.6174999999999999, 1.2595, 0.3905, 0.486, 0.4105, 2.849, 0.3485, 0.593, 1.3615, 0.0155, 0.5505, 2.0305, 2.642, 3.34, 3.9335, 1.794, 0.6815, 2.3440000000000003},
+		{0.511, 0.357, 1.117, 0.357, 0.5045, 0.1685, 0.935, 0.623, 0.2005, 0.413, 0.266, 0.571, 0.358, 0.378, 0.208, 0.311, 0.151, 0.17149999999999999, 0.6255, 0.545, 0.258, 0.1845, 0.363, 0.367, 0.1465, 0.4655, 0.182, 0.2585, 1.182, 0.38, 0.379, 0.477, 2.4050000000000002, 0.277, 1.0465, 0.393, 0.383, 0.2195, 0.4445, 0.4865, 0.363, 0.34, 0.5395000000000001, 8.243500000000001, 0.302, 3.6355, 0.6865, 0.5165, 0.563, 0.531, 1.0435, 4.359500000000001, 15.259, 2.9105, 0.581, 0.2915, 0.44, 0.639, 0.414, 0.3075, 0.148, 0.438, 2.053, 0.558, 0.3655, 0.359, 0.4205, 0.301, 0.3745, 0.3155, 0.3595, 1.2065, 0.5885, 0.43, 0.1735, 0.544, 0.34550000000000003, 0.3505, 12.306000000000001, 0.7044999999999999, 1.005, 0.3085, 0.85, 0.3425, 0.4715, 0.553, 0.545, 0.2395, 0.3075, 2.0614999999999997, 0.7335, 0.599, 0.452, 0.40349999999999997, 14.4565, 0.3055, 1.2814999999999999, 7.8260000000000005, 0.439, 0.7484999999999999, 0.351, 0.363, 0.8585, 0.371, 0.361, 7.336, 0.3255, 0.3885, 1.6404999999999998, 0.418, 1.0705, 0.20350000000000001, 0.519, 0.3305, 1.22, 0.2535, 0.129, 0.452, 0.3155, 0.9179999999999999, 0.355, 0.7515000000000001, 0.716, 5.7325, 0.312, 0.53, 0.257, 0.3315, 0.511, 0.507, 0.297, 0.3065, 0.813, 0.484, 0.127, 0.3265, 0.17149999999999999, 0.6659999999999999, 0.348, 0.2425, 0.7865, 0.1505, 0.109, 0.2205, 0.338, 1.2445, 28.127000000000002, 0.081, 0.621, 0.2565, 0.396, 0.3925, 0.199, 0.2475, 2.685, 0.63, 0.707, 0.4295, 0.3505, 0.489, 0.4505, 0.1635, 0.405, 0.341, 0.255, 1.179, 0.309, 0.3455, 0.9430000000000001, 0.2755, 0.20350000000000001, 0.402, 0.20350000000000001, 0.6285000000000001, 0.185, 0.5135000000000001, 0.668, 0.3765, 0.2125, 0.1265, 0.1825, 0.336, 1.83, 0.3635, 0.3225, 1.339, 0.038, 0.014, 0.123, 1.1975, 3.1755, 2.2110000000000003, 0.5035000000000001, 0.509, 0.32},
+		{0.42, 0.413, 0.8895, 0.194, 0.532, 0.139, 0.9085, 0.5825, 0.221, 0.4495, 0.2895, 0.513, 0.3745, 0.3885, 0.2025, 0.3075, 0.1935, 0.1335, 0.4885, 0.535, 0.2095, 0.153, 0.511, 0.2165, 0.073, 0.4685, 0.198, 0.20400000000000001, 0.779, 0.398, 0.37, 0.477, 1.7685, 0.263, 0.704, 0.379, 0.369, 0.1975, 0.447, 0.502, 0.364, 0.315, 0.5025, 7.768, 0.2915, 4.5595, 0.639, 0.574, 0.602, 0.3855, 0.3985, 4.4065, 14.92, 2.1355, 0.522, 0.2535, 0.3515, 0.6395, 0.3475, 0.357, 0.1835, 0.381, 2.1625, 0.35, 0.3915, 0.3655, 0.387, 0.353, 0.2695, 0.342, 0.4095, 0.4695, 0.5705, 0.4525, 0.1655, 0.5065, 0.356, 0.315, 13.206, 0.434, 0.803, 0.3615, 0.87, 0.362, 0.4575, 0.5925, 0.599, 0.32, 0.34450000000000003, 1.767, 0.5135000000000001, 0.5865, 0.33, 0.41100000000000003, 8.237, 0.2885, 0.331, 3.2465, 0.494, 0.7989999999999999, 0.3335, 0.398, 0.8005, 0.367, 0.352, 6.7195, 0.3295, 0.3735, 0.48550000000000004, 0.164, 1.1244999999999998, 0.1785, 0.5405, 0.216, 1.1355, 0.23, 0.0545, 0.4175, 0.28200000000000003, 0.9570000000000001, 0.34299999999999997, 0.6995, 0.594, 5.1485, 0.3595, 0.512, 0.2885, 0.314, 0.412, 0.452, 0.277, 0.339, 0.278, 0.4135, 0.1675, 0.2625, 0.144, 0.7295, 0.353, 0.1995, 0.6145, 0.16949999999999998, 0.1105, 0.1375, 0.292, 1.5095, 25.744, 0.1705, 0.7055, 0.20600000000000002, 0.3615, 0.486, 0.10350000000000001, 0.172, 2.6405, 0.23249999999999998, 0.6214999999999999, 0.264, 0.1395, 0.5255000000000001, 0.4555, 0.168, 0.4205, 0.3325, 0.1865, 1.066, 0.36, 0.31, 0.867, 0.296, 0.1505, 0.3095, 0.1985, 0.7404999999999999, 0.195, 0.488, 0.478, 0.391, 0.208, 0.1315, 0.218, 0.3395, 1.627, 0.309, 0.4275, 1.3279999999999998, 0.014, 0.134, 0.005, 0.544, 3.1710000000000003, 3.3354999999999997, 0.4045, 0.428, 0.3435},
+		{1.1644999999999999, 4.721500000000001, 1.536, 0.6325000000000001, 1.0, 0.863, 1.5594999999999999, 1.375, 0.8434999999999999, 2.8535, 0.6944999999999999, 1.981, 1.096, 0.924, 1.058, 0.847, 0.7725, 0.72, 0.925, 1.016, 0.7855, 0.6995, 1.409, 8.2925, 0.536, 0.914, 0.6575, 0.891, 1.6135000000000002, 0.8005, 0.677, 1.2755, 2.556, 0.9115, 1.2525, 3.8395, 0.664, 1.263, 2.3685, 0.906, 0.9105000000000001, 1.024, 1.1804999999999999, 8.937999999999999, 1.15, 4.7515, 1.0314999999999999, 1.0665, 1.017, 1.1205, 0.8135, 5.3175, 18.3465, 4.156000000000001, 0.9655, 1.3355, 0.8545, 1.107, 1.0630000000000002, 0.97, 0.954, 0.7805, 2.857, 0.747, 1.4300000000000002, 0.8625, 0.733, 0.8155, 0.974, 0.909, 1.1795, 1.5905, 1.446, 0.9185000000000001, 0.9909999999999999, 1.1480000000000001, 2.128, 1.0835, 14.446, 7.1135, 1.2175, 1.152, 1.338, 0.8254999999999999, 0.974, 1.1560000000000001, 0.9415, 0.8859999999999999, 0.8285, 1.9575, 0.9824999999999999, 2.5605, 0.956, 0.7685, 17.744500000000002, 1.0775000000000001, 2.8825000000000003, 1.7445, 0.8385, 1.6644999999999999, 0.723, 0.9635, 1.0474999999999999, 0.877, 0.9025000000000001, 7.0809999999999995, 1.2065000000000001, 0.755, 2.007, 11.129, 1.4965000000000002, 0.6034999999999999, 1.044, 1.627, 2.04, 1.302, 1.1535000000000002, 1.065, 1.166, 3.8689999999999998, 1.04, 1.2635, 1.47, 5.077999999999999, 0.851, 1.7305000000000001, 0.888, 0.823, 0.831, 0.99, 0.87, 8.9925, 1.0070000000000001, 0.8895, 0.7729999999999999, 1.3575, 0.569, 1.4005, 7.207, 0.6765000000000001, 1.194, 1.4845000000000002, 0.6305000000000001, 0.9784999999999999, 0.9035, 4.0, 27.615499999999997, 1.323, 1.55, 1.9455, 1.08, 0.7175, 0.872, 0.643, 7.9245, 0.8394999999999999, 1.199, 7.3469999999999995, 0.8625, 1.1005, 2.205, 0.844, 0.8985000000000001, 0.7655000000000001, 0.95, 1.9235, 0.8089999999999999, 1.052, 5.083, 0.8245, 0.736, 1.2229999999999999, 0.7725, 3.706, 3.2275, 1.1724999999999999, 1.142, 0.7424999999999999, 0.657, 0.548, 0.8985000000000001, 0.949, 2.2535, 1.0505, 0.9235, 1.7525, 0.0, 0.8805000000000001, 0.6805, 0.0495, 4.011, 8.404, 0.8554999999999999, 1.794, 0.946},
+		{3.521, 4.337, 3.007, 2.9905, 3.1765, 2.537, 3.2075, 2.983, 3.2285, 3.6695, 2.4509999999999996, 3.524, 3.237, 3.058, 3.056, 2.473, 3.3525, 3.1574999999999998, 2.6929999999999996, 2.9855, 3.011, 3.2245, 3.8914999999999997, 3.315, 3.0145, 2.9245, 2.8514999999999997, 3.1225, 4.0905000000000005, 2.8765, 3.3434999999999997, 2.6029999999999998, 3.8305, 3.065, 4.4094999999999995, 3.045, 2.9225000000000003, 2.5105000000000004, 2.62, 2.645, 2.6775, 3.8145, 3.42, 7.8065, 3.0635000000000003, 4.79, 3.399, 3.282, 2.526, 2.6055, 2.9445, 5.0755, 15.6925, 5.035, 3.3045, 3.3724999999999996, 2.9375, 3.3765, 2.496, 2.7725, 2.778, 3.249, 4.4239999999999995, 3.237, 2.519, 2.753, 2.9314999999999998, 3.3225, 3.5469999999999997, 3.0300000000000002, 3.3405, 3.8325, 3.434, 2.6725, 2.7615, 3.1260000000000003, 2.8215, 2.92, 13.255500000000001, 3.01, 3.3605, 3.419, 2.766, 2.984, 3.0149999999999997, 3.4535, 3.0865, 3.2395, 3.577, 4.2010000000000005, 3.058, 2.9885, 3.5015, 2.974, 9.378, 3.035, 3.0595, 4.064, 3.2190000000000003, 3.393, 2.5805, 3.622, 3.0355, 1.933, 2.5545, 7.2585, 2.8205, 3.584, 4.128, 2.924, 3.956, 2.7035, 3.0465, 3.2375, 2.9055, 3.3375000000000004, 2.816, 2.917, 2.42, 3.6115, 3.1755, 3.151, 3.6865, 6.1395, 3.092, 3.8795, 2.864, 3.291, 2.755, 3.1719999999999997, 3.09, 3.29, 2.969, 3.541, 3.622, 3.5815, 3.721, 3.4745, 3.0255, 2.886, 3.3914999999999997, 3.1375, 2.976, 2.547, 2.95, 4.054, 27.6315, 2.8425000000000002, 3.2455, 3.7835, 2.9535, 3.285, 2.917, 2.715, 3.9800000000000004, 2.6145, 3.081, 2.8585000000000003, 2.983, 3.1645000000000003, 3.085, 3.008, 2.862, 3.524, 2.9145000000000003, 2.9234999999999998, 3.4255, 3.2535, 5.9135, 2.623, 2.6174999999999997, 2.9785000000000004, 2.535, 5.241, 3.3310000000000004, 3.3735, 3.233, 3.239, 3.495, 2.4495, 3.2445, 2.7039999999999997, 3.6985, 2.8135000000000003, 2.674, 3.5725, 0.0, 3.245, 3.1734999999999998, 2.9745, 0.011, 5.273, 3.1545, 2.5235000000000003, 3.231},
+		{0.503, 0.515, 0.906, 0.286, 0.5415000000000001, 0.241, 2.4725, 0.6904999999999999, 0.309, 0.534, 0.306, 5.276999999999999, 0.4465, 0.6154999999999999, 0.2885, 0.4285, 0.2485, 0.2455, 0.6525000000000001, 0.681, 0.328, 0.297, 0.6975, 0.33, 0.2225, 0.5285, 0.2875, 0.4085, 3.47, 0.41900000000000004, 0.389, 0.523, 2.0625, 0.3325, 1.363, 0.469, 0.3815, 0.899, 0.54, 0.613, 0.394, 15.58, 0.5874999999999999, 8.755500000000001, 0.3795, 4.1415, 0.746, 0.5435000000000001, 1.1915, 0.5065, 0.5335, 4.278499999999999, 17.779, 5.464, 4.487, 7.9575, 0.4335, 0.7545, 0.41200000000000003, 0.3995, 0.269, 0.5265, 2.259, 0.4405, 3.3195, 0.46399999999999997, 0.5085, 0.3345, 0.339, 0.5700000000000001, 0.661, 1.4365, 25.5045, 0.5640000000000001, 0.258, 0.616, 0.438, 0.39749999999999996, 15.304, 0.5765, 1.041, 25.180999999999997, 0.768, 0.4255, 1.2075, 0.9165, 0.557, 0.4105, 0.4795, 2.9589999999999996, 0.659, 0.6835, 5.6315, 0.376, 8.995000000000001, 0.5205, 0.434, 0.7975, 0.46499999999999997, 0.8625, 0.461, 0.454, 0.8755, 4.657500000000001, 0.42, 6.6555, 20.5835, 0.4185, 1.3555, 0.1875, 1.0025, 0.2215, 0.619, 0.2655, 2.301, 0.415, 0.195, 0.929, 0.352, 1.107, 0.452, 1.351, 1.5235, 4.7795000000000005, 0.4185, 0.5665, 0.4105, 1.5055, 0.57, 5.209, 0.3585, 0.4075, 0.4395, 0.529, 0.347, 0.447, 0.223, 0.812, 0.4965, 1.0895000000000001, 0.836, 0.232, 0.23199999999999998, 0.243, 0.3715, 10.426, 28.134500000000003, 0.28, 0.7745, 0.2865, 0.349, 0.5860000000000001, 20.9815, 0.3785, 2.8445, 0.201, 1.7505000000000002, 0.294, 0.235, 0.704, 0.516, 0.2575, 0.445, 0.2685, 0.2635, 1.282, 0.40549999999999997, 0.3925, 0.606, 0.381, 0.264, 0.402, 0.313, 0.7625, 0.309, 0.8475, 1.131, 0.40700000000000003, 0.278, 0.2455, 0.353, 0.379, 1.962, 0.351, 2.7165, 1.351, 0.0, 0.2565, 0.213, 1.539, 5.1545000000000005, 0.33599999999999997, 10.089500000000001, 0.9510000000000001, 0.379},
+		{0.7255, 0.6535, 1.057, 0.5645, 0.5165, 0.5365, 4.478, 0.6915, 0.5185, 0.7645, 0.572, 0.9795, 0.685, 0.589, 0.5355000000000001, 0.628, 0.508, 0.507, 4.1754999999999995, 0.8265, 0.5665, 0.544, 0.8325, 0.6605000000000001, 0.5285, 0.623, 0.552, 0.537, 0.739, 0.615, 0.6435, 0.8, 1.8845, 0.544, 0.8805000000000001, 0.275, 0.6285000000000001, 0.605, 0.657, 0.676, 0.6875, 0.5705, 0.6675, 5.296, 0.633, 4.034, 0.5965, 0.6915, 0.7105, 0.7755000000000001, 0.5255000000000001, 5.282, 12.2665, 3.275, 0.6085, 0.633, 0.5725, 0.711, 0.67, 0.547, 0.536, 0.6365000000000001, 2.3075, 0.508, 0.5325, 0.6134999999999999, 0.3695, 0.59, 0.5135000000000001, 0.838, 0.558, 0.8394999999999999, 0.7424999999999999, 0.68, 0.4805, 0.4385, 0.625, 0.671, 11.9805, 0.628, 0.834, 0.5934999999999999, 0.946, 0.655, 0.6525000000000001, 0.524, 0.622, 0.523, 0.6245, 1.8984999999999999, 0.6765, 0.525, 0.6134999999999999, 0.7235, 9.724, 0.617, 0.57, 0.705, 0.6595, 1.1444999999999999, 0.586, 0.553, 0.928, 0.644, 0.585, 7.3155, 0.6585000000000001, 0.6775, 0.6595, 0.575, 0.935, 0.574, 0.688, 0.581, 1.9045, 0.5965, 0.4915, 0.4935, 0.554, 1.999, 0.6964999999999999, 1.186, 0.8234999999999999, 4.7455, 0.694, 4.159, 0.621, 0.602, 0.681, 0.6875, 0.604, 0.565, 0.6505000000000001, 0.5415000000000001, 0.578, 0.841, 0.4975, 0.9904999999999999, 0.721, 0.6065, 0.9275, 0.5805, 0.456, 0.585, 0.633, 1.9355, 24.799999999999997, 0.539, 0.9099999999999999, 0.7849999999999999, 0.6165, 0.462, 0.5645, 0.505, 2.668, 0.613, 0.7044999999999999, 0.611, 0.524, 0.7215, 0.371, 0.63, 0.6415, 0.734, 0.5954999999999999, 1.326, 0.632, 0.509, 5.2595, 0.5694999999999999, 0.5045, 0.509, 0.584, 0.8075, 0.5075000000000001, 0.867, 0.726, 0.648, 0.601, 0.4725, 0.5845, 0.6034999999999999, 2.1710000000000003, 0.5865, 0.703, 1.5070000000000001, 0.0, 0.5455000000000001, 0.48, 0.8625, 3.032, 2.846, 0.017, 0.686, 0.585},
+		{0.5925, 0.684, 1.0965, 0.454, 0.6585000000000001, 0.605, 1.392, 0.7264999999999999, 0.489, 0.6655, 0.529, 1.338, 0.5365, 0.66, 0.486, 0.4905, 0.497, 0.4415, 0.7064999999999999, 0.741, 0.471, 0.4735, 0.7905, 0.5535000000000001, 0.4545, 0.7075, 0.46, 0.4605, 2.0395, 0.6174999999999999, 0.5595000000000001, 0.685, 2.008, 0.4895, 0.937, 0.595, 0.5535000000000001, 0.459, 0.6825, 0.7595000000000001, 0.572, 0.6114999999999999, 0.677, 7.633, 0.576, 4.741, 0.7, 0.7645, 0.6325000000000001, 0.7525, 0.71, 4.054, 16.4595, 2.277, 0.757, 0.441, 0.512, 0.808, 0.5095000000000001, 0.54, 0.486, 0.573, 1.9874999999999998, 0.571, 0.622, 0.572, 0.57, 0.549, 0.5854999999999999, 1.0215, 0.6114999999999999, 0.845, 0.8534999999999999, 0.629, 0.40449999999999997, 0.6859999999999999, 0.489, 0.5954999999999999, 13.614, 0.615, 0.966, 0.605, 0.8875, 0.523, 0.9295, 0.79, 0.59, 0.4755, 0.533, 1.7025000000000001, 0.7335, 0.7805, 0.5335000000000001, 0.5994999999999999, 5.775, 0.554, 0.549, 0.8225, 0.724, 0.9595, 0.609, 0.5925, 0.9115, 0.5455000000000001, 0.515, 6.5995, 0.5714999999999999, 0.494, 0.7555000000000001, 0.442, 1.2574999999999998, 0.392, 0.964, 0.614, 1.0975000000000001, 0.5665, 0.4275, 0.5745, 0.8185, 1.1255000000000002, 0.574, 1.5405, 0.8009999999999999, 5.355, 0.6445000000000001, 0.6645000000000001, 0.5245, 0.473, 0.6325000000000001, 0.5445, 0.5725, 0.573, 0.5495, 0.7805, 0.509, 0.5785, 0.421, 1.3695, 0.526, 0.4395, 1.191, 0.4325, 0.616, 0.505, 0.499, 1.8385, 23.841, 0.428, 0.6635, 1.0985, 0.5075000000000001, 0.626, 0.3925, 0.4035, 2.651, 0.418, 0.7655, 0.5609999999999999, 0.5205, 0.7164999999999999, 0.6884999999999999, 0.4535, 0.67, 0.675, 0.5195000000000001, 2.0475000000000003, 0.551, 0.563, 0.9435, 0.48, 0.484, 0.581, 0.46099999999999997, 1.0474999999999999, 0.506, 0.728, 1.9805000000000001, 0.4895, 0.4645, 0.422, 0.4205, 0.5605, 1.765, 0.5675, 0.5674999999999999, 1.6804999999999999, 0.0, 0.4445, 0.4325, 1.0495, 3.1855, 6.205500000000001, 0.786, 0.007, 0.6005},
+		{0.4625, 0.2325, 0.9949999999999999, 0.334, 0.474, 0.3545, 1.1705, 0.575, 0.418, 0.51, 0.19, 0.8995, 0.255, 0.2855, 0.3755, 0.1725, 0.35750000000000004, 0.3835, 0.493, 0.642, 0.4215, 0.4455, 0.6335, 0.48, 0.355, 0.3415, 0.4205, 0.4615, 0.8625, 0.246, 0.235, 0.4555, 2.19, 0.4225, 0.7765, 0.536, 0.485, 0.472, 0.40349999999999997, 0.5525, 0.2605, 0.2515, 0.3765, 3.9555, 0.2175, 4.737500000000001, 0.623, 0.639, 0.582, 0.5435000000000001, 0.5694999999999999, 3.9505, 15.3245, 2.162, 0.523, 0.5734999999999999, 0.2905, 0.649, 0.313, 0.4975, 0.377, 0.483, 2.59, 0.158, 0.3145, 0.335, 0.4735, 0.2095, 0.4565, 0.47250000000000003, 0.2375, 0.526, 0.522, 0.366, 0.3135, 0.4195, 0.1725, 0.278, 12.264, 0.22399999999999998, 0.679, 0.2525, 0.694, 0.215, 0.978, 0.563, 0.388, 0.1945, 0.4325, 1.5055, 0.5680000000000001, 0.582, 0.232, 0.28300000000000003, 6.2684999999999995, 0.5275000000000001, 0.3955, 0.749, 0.614, 0.9345, 0.167, 0.228, 0.743, 0.326, 0.2365, 6.197, 0.2345, 0.2255, 0.3155, 0.383, 0.9085000000000001, 0.3595, 0.522, 0.418, 1.024, 0.417, 0.3745, 0.491, 0.4545, 2.3890000000000002, 0.2875, 0.7705, 0.6975, 5.0215, 0.269, 0.55, 0.241, 0.4835, 0.5525, 0.2855, 0.2445, 0.1825, 0.4665, 0.6165, 0.405, 1.151, 0.388, 1.1035, 0.5165, 0.425, 0.825, 0.314, 0.303, 0.4285, 0.4195, 1.7805, 29.424500000000002, 0.3485, 0.684, 0.4365, 0.53, 0.583, 0.3855, 0.4085, 2.6765, 0.452, 0.719, 0.437, 0.416, 0.4715, 0.6014999999999999, 0.528, 0.3525, 0.497, 0.339, 1.2905, 0.172, 0.211, 0.982, 0.202, 0.421, 0.1755, 0.3745, 0.7335, 0.40449999999999997, 0.7235, 0.5365, 0.498, 0.402, 0.389, 0.3945, 0.196, 2.1685, 0.2435, 0.2725, 1.4445000000000001, 0.3025, 0.348, 0.354, 0.8445, 3.3265000000000002, 6.1579999999999995, 0.555, 0.648, 0.0075},
+	}
+
+	return meanLatency, stdLatency
+}
@@ -0,0 +1,119 @@
+package emissionsim
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// Latency models delays of delivering events between validators, in milliseconds
+type Latency interface {
+	// Latency returns the delay of delivering an event from the sender to the receiver
+	Latency(sender, receiver int, rng *rand.Rand) int
+	// Max returns the max delay, larger delays are truncated
+	Max() int
+}
+
+// GaussianLatency draws latencies between all the validators from the same normal distribution
+type GaussianLatency struct {
+	Mean float64
+	Std  float64
+}
+
+// Latency returns the delay of delivering an event from the sender to the receiver
+func (lat *GaussianLatency) Latency(sender, receiver int, rng *rand.Rand) int {
+	return int(rng.NormFloat64()*lat.Std + lat.Mean)
+}
+
+// Max returns the max delay, larger delays are truncated
+func (lat *GaussianLatency) Max() int {
+	return int(lat.Mean + 4*lat.Std)
+}
+
+// MainNetLatency draws latencies from a dataset of latencies observed by a Fantom main net validator.
+// All the pairs of validators use the same distribution.
+type MainNetLatency struct{}
+
+// Latency returns the delay of delivering an event from the sender to the receiver
+func (lat *MainNetLatency) Latency(sender, receiver int, rng *rand.Rand) int {
+	return mainNetDelays[rng.Intn(len(mainNetDelays))]
+}
+
+// Max returns the max delay, larger delays are truncated
+func (lat *MainNetLatency) Max() int {
+	max := 0
+	for _, delay := range mainNetDelays {
+		if delay > max {
+			max = delay
+		}
+	}
+	return max
+}
+
+// CityLatency models latencies using a dataset of real world internet latencies between cities.
+// Every validator is located in a random city.
+type CityLatency struct {
+	meanLatencies [][]float64
+	stdLatencies  [][]float64
+}
+
+// NewCityLatency locates the validators in random cities
+func NewCityLatency(numValidators int, seed int64) *CityLatency {
+	r := rand.New(rand.NewSource(seed)) // nolint:gosec
+	allMeanDelays, allStdDelays := geographicLatencyData()
+	locations := make([]int, numValidators)
+	for i := range locations {
+		locations[i] = r.Intn(len(allMeanDelays))
+	}
+
+	lat := &CityLatency{
+		meanLatencies: make([][]float64, numValidators),
+		stdLatencies:  make([][]float64, numValidators),
+	}
+	for i, loci := range locations {
+		for _, locj := range locations {
+			lat.meanLatencies[i] = append(lat.meanLatencies[i], allMeanDelays[loci][locj])
+			lat.stdLatencies[i] = append(lat.stdLatencies[i], allStdDelays[loci][locj])
+		}
+	}
+	return lat
+}
+
+// Latency returns the delay of delivering an event from the sender to the receiver
+func (lat *CityLatency) Latency(sender, receiver int, rng *rand.Rand) int {
+	return int(rng.NormFloat64()*lat.stdLatencies[sender][receiver] + lat.meanLatencies[sender][receiver])
+}
+
+// Max returns the max delay, larger delays are truncated
+func (lat *CityLatency) Max() int {
+	max := 1
+	for sender := range lat.meanLatencies {
+		for receiver, mean := range lat.meanLatencies[sender] {
+			if latency := int(mean + 4*lat.stdLatencies[sender][receiver]); latency > max {
+				max = latency
+			}
+		}
+	}
+	return max
+}
+
+// MainNetWeights draws validators weights from the Fantom main net validators stake distribution, in thousands of FTM.
+// The weights are sorted in descending order.
+func MainNetWeights(numValidators int, seed int64) []pos.Weight {
+	r := rand.New(rand.NewSource(seed)) // nolint:gosec
+	weights := make([]pos.Weight, numValidators)
+	for i := range weights {
+		weights[i] = pos.Weight(mainNetStakes[r.Intn(len(mainNetStakes))]/1000) + 1
+	}
+	sort.Slice(weights, func(i, j int) bool {
+		return weights[i] > weights[j]
+	})
+	return weights
+}
+
+// mainNetStakes is a list of the main net validators stakes in July 2022
+var mainNetStakes = [...]float64{198081564.62, 170755849.45, 145995219.17, 136839786.82, 69530006.55, 40463200.25, 39124627.82, 32452971, 29814402.94, 29171276.63, 26284696.12, 25121739.54, 24461049.53, 23823498.37, 22093834.4, 21578984.4, 20799555.11, 19333530.31, 18250949.01, 17773018.94, 17606393.73, 16559031.91, 15950172.21, 12009825.67, 11049478.07, 9419996.86, 9164450.96, 9162745.35, 7822093.53, 7540197.22, 7344958.29, 7215437.9, 6922757.07, 6556643.44, 5510793.7, 5228201.11, 5140257.3, 4076474.17, 3570632.17, 3428553.68, 3256601.94, 3185019, 3119162.23, 3011027.22, 2860160.77, 2164550.78, 1938492.01, 1690762.63, 1629428.73, 1471177.28, 1300562.06, 1237812.75, 1199822.32, 1095856.64, 1042099.38, 1020613.06, 1020055.55, 946528.43, 863022.57, 826015.44, 800010, 730537, 623529.61, 542996.04, 538920.36, 536288, 519803.37, 505401, 502231, 500100, 500001, 500000}
+
+// mainNetDelays is a list of latencies in milliseconds, observed by a main net validator
+var mainNetDelays = [...]int{54, 110, 60, 124, 75, 47, 165, 152, 18, 18, 52, 83, 80, 92, 51, 11, 21, 32, 120, 9, 18, 129, 64, 53, 83, 118, 12, 79, 54, 21, 18, 62, 121, 7, 22, 147, 73, 170, 198, 145, 25, 138, 123, 68, 109, 73, 34, 122, 10, 121, 23, 129, 82, 85, 58, 129, 281, 275, 300, 174, 158, 169, 124, 186, 61, 51, 107, 85, 49, 131, 12, 52, 100, 17, 32, 70, 121, 6, 17, 190, 59, 16, 372, 233, 201, 169, 97, 91, 101, 80, 127, 26, 12, 10, 49, 49, 83, 19, 91, 61, 52, 129, 34, 125, 66, 116, 110, 82, 104, 82, 52, 29, 95, 72, 133, 65, 338, 285, 221, 282, 196, 234, 315, 183, 135, 69, 102, 187, 79, 79, 82, 20, 129, 122, 54, 9, 9, 52, 21, 91, 74, 14, 20, 18, 63, 47, 83, 124, 7, 131, 18, 132, 285, 186, 242, 190, 131, 127, 72, 243, 218, 223, 185, 140, 136, 87, 123, 265, 166, 112, 94, 82, 92, 226, 95, 78, 35, 54, 63, 223, 59, 30, 56, 87, 163, 195, 69, 173, 37, 25, 64, 52, 121, 36, 14, 29, 76, 170, 144, 131, 162, 133, 15, 17, 129, 50, 67, 176, 40, 23, 51, 79, 85, 128, 17, 18, 45, 62, 84, 134, 40, 130, 32, 55, 66, 93, 26, 132, 15, 19, 27, 56, 106, 35, 30, 54, 60, 83, 128, 125, 11, 8, 18, 83, 63, 49, 94, 94, 45, 17, 21, 49, 51, 79, 82, 97, 123, 127, 8, 14, 20, 54, 107, 41, 30, 52, 91, 122, 9, 13, 136, 54, 46, 72, 131, 51, 233, 167, 172, 63, 31, 59, 67, 88, 134, 15, 17, 21, 97, 129, 35, 54, 23, 50, 82, 83, 80, 130, 36, 22, 33, 71, 46, 39, 85, 101, 121, 82, 122, 50, 26, 27, 95, 24, 137, 9, 25, 130, 62, 193, 57, 55, 22, 98}
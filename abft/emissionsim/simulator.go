@@ -0,0 +1,431 @@
+// Package emissionsim simulates events emission of validators connected by a network with latencies.
+// Every validator chooses parents with FCIndexer, decides when to emit by the DAG progress,
+// and runs its own consensus, so the time to finality and the DAG efficiency can be measured.
+package emissionsim
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Fantom-foundation/lachesis-base/abft"
+	"github.com/Fantom-foundation/lachesis-base/emitter/ancestor"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
+	"github.com/Fantom-foundation/lachesis-base/utils/adapters"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+const (
+	// DefaultDuration is the default simulated time
+	DefaultDuration = 50 * time.Second
+
+	// checkInterval is the interval between checks if an event should be emitted, in milliseconds
+	checkInterval = 11
+	// minEmitInterval is the min interval between self-events, in milliseconds
+	minEmitInterval = 11
+	// emitThreshold is the min time since the previous self-event, weighted by the DAG progress, to emit an event
+	emitThreshold = 350
+)
+
+// Config of simulation
+type Config struct {
+	Weights []pos.Weight
+	// FCParents is the max number of parents chosen by FCIndexer, including the self-parent
+	FCParents int
+	// RandParents is the max number of parents chosen randomly, after the ones chosen by FCIndexer
+	RandParents int
+	// Offline makes the smallest validators offline, as long as the online validators have a quorum
+	Offline bool
+
+	Latency Latency
+	// Duration of the simulated time, with a millisecond resolution
+	Duration time.Duration
+	Seed     int64
+}
+
+// Result of simulation.
+// Durations are in milliseconds.
+type Result struct {
+	Validators       int     `json:"validators"`
+	OnlineValidators int     `json:"onlineValidators"`
+	DurationMs       int     `json:"durationMs"`
+	Events           int     `json:"events"`
+	MaxFrame         uint32  `json:"maxFrame"`
+	DecidedFrames    uint32  `json:"decidedFrames"`
+	FramesPerSecond  float64 `json:"framesPerSecond"`
+	EventsPerFrame   float64 `json:"eventsPerFrame"`
+	// EventRate is the number of events per second per online validator
+	EventRate float64 `json:"eventRate"`
+	// TTF is the time from an event emission until its confirmation, over all the confirmed events and online validators
+	TTFMeanMs   float64 `json:"ttfMeanMs"`
+	TTFMedianMs int     `json:"ttfMedianMs"`
+	TTFP95Ms    int     `json:"ttfP95Ms"`
+}
+
+type event struct {
+	tdag.TestEvent
+	creationTime int
+}
+
+type node struct {
+	id     idx.ValidatorID
+	online bool
+
+	lch   *abft.IndexedLachesis
+	store *abft.Store
+	input *abft.EventStore
+	fc    *ancestor.FCIndexer
+
+	heads      dag.Events
+	buffer     []*event
+	selfParent *event
+	// checks is the number of checks to skip before the first event, to avoid synchronous events
+	checks    int
+	lastCheck int
+
+	latencyRNG *rand.Rand
+	parentsRNG *rand.Rand
+
+	now int
+	// ttfs are times to finality of the confirmed events
+	ttfs []int
+}
+
+func newNode(id idx.ValidatorID, validators *pos.Validators) (*node, error) {
+	crit := func(err error) {
+		panic(err)
+	}
+	openEDB := func(epoch idx.Epoch) kvdb.Store {
+		return memorydb.New()
+	}
+	store := abft.NewStore(memorydb.New(), openEDB, crit, abft.LiteStoreConfig())
+	err := store.ApplyGenesis(&abft.Genesis{
+		Validators: validators,
+		Epoch:      abft.FirstEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	input := abft.NewEventStore()
+	dagIndexer := &adapters.VectorToDagIndexer{Index: vecfc.NewIndex(crit, vecfc.LiteConfig())}
+
+	n := &node{
+		id:    id,
+		lch:   abft.NewIndexedLachesis(store, input, dagIndexer, crit, abft.LiteConfig()),
+		store: store,
+		input: input,
+		fc:    ancestor.NewFCIndexer(validators, dagIndexer, id),
+	}
+	err = n.lch.Bootstrap(lachesis.ConsensusCallbacks{
+		BeginBlock: func(block *lachesis.Block) lachesis.BlockCallbacks {
+			return lachesis.BlockCallbacks{
+				ApplyEvent: func(e dag.Event) {
+					n.ttfs = append(n.ttfs, n.now-e.(*event).creationTime)
+				},
+				EndBlock: func() (sealEpoch *pos.Validators) {
+					return nil
+				},
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// receive processes the buffered events whose parents are already processed
+func (n *node) receive() error {
+	pending := n.buffer[:0]
+	for _, e := range n.buffer {
+		if !n.hasParents(e) {
+			pending = append(pending, e)
+			continue
+		}
+		n.input.SetEvent(e)
+		if err := n.lch.Process(e); err != nil {
+			return err
+		}
+		n.fc.ProcessEvent(e)
+		n.updateHeads(e)
+	}
+	n.buffer = pending
+	return nil
+}
+
+func (n *node) hasParents(e dag.Event) bool {
+	for _, p := range e.Parents() {
+		if !n.input.HasEvent(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *node) updateHeads(e dag.Event) {
+	parents := e.Parents().Set()
+	heads := n.heads[:0]
+	for _, head := range n.heads {
+		if !parents.Contains(head.ID()) {
+			heads = append(heads, head)
+		}
+	}
+	n.heads = append(heads, e)
+}
+
+// shouldEmit returns true if the time since the previous self-event, weighted by the DAG progress, is enough
+func (n *node) shouldEmit(validators *pos.Validators) bool {
+	if n.selfParent == nil {
+		return true
+	}
+	passed := n.now - n.selfParent.creationTime
+	if passed <= minEmitInterval {
+		return false
+	}
+	metric := n.fc.ValidatorsPastMe()
+	if metric < validators.Quorum() {
+		metric /= 20
+	}
+	passedEff := int(uint64(passed) * uint64(metric) / uint64(validators.TotalWeight()))
+	return passedEff > emitThreshold
+}
+
+// emit creates a new self-event
+func (n *node) emit(cfg Config) (*event, error) {
+	e := &event{
+		creationTime: n.now,
+	}
+	e.SetEpoch(abft.FirstEpoch)
+	e.SetCreator(n.id)
+	e.SetParents(hash.Events{})
+	e.SetSeq(1)
+	e.SetLamport(1)
+
+	if n.selfParent != nil {
+		e.SetSeq(n.selfParent.Seq() + 1)
+		parents := dag.Events{n.selfParent}
+		heads := make(dag.Events, 0, len(n.heads))
+		for _, head := range n.heads {
+			if head.ID() != n.selfParent.ID() {
+				heads = append(heads, head)
+			}
+		}
+		choose := func(best int) {
+			parents = append(parents, heads[best])
+			heads[best] = heads[len(heads)-1]
+			heads = heads[:len(heads)-1]
+		}
+		for i := 0; i < cfg.FCParents-1 && len(heads) != 0; i++ {
+			choose(n.fc.SearchStrategy().Choose(parents.IDs(), heads.IDs()))
+		}
+		for i := 0; i < cfg.RandParents && len(heads) != 0; i++ {
+			choose(n.parentsRNG.Intn(len(heads)))
+		}
+		for _, p := range parents {
+			e.AddParent(p.ID())
+			if e.Lamport() <= p.Lamport() {
+				e.SetLamport(p.Lamport() + 1)
+			}
+		}
+	}
+
+	if err := n.lch.Build(e); err != nil {
+		return nil, err
+	}
+	e.SetID([24]byte{})
+	var id [24]byte
+	h := sha256.Sum256(e.Bytes())
+	copy(id[:], h[:24])
+	e.SetID(id)
+	n.selfParent = e
+	return e, nil
+}
+
+// Run simulates the emission.
+// The result is reproducible for the same config.
+func Run(cfg Config) (*Result, error) {
+	if len(cfg.Weights) == 0 {
+		return nil, errors.New("no validators")
+	}
+	if cfg.FCParents < 1 {
+		return nil, errors.New("at least a self-parent is required")
+	}
+	numValidators := len(cfg.Weights)
+	ids := tdag.GenNodes(numValidators)
+	validators := pos.ArrayToValidators(ids, cfg.Weights)
+
+	r := rand.New(rand.NewSource(cfg.Seed)) // nolint:gosec
+	maxLatency := cfg.Latency.Max()
+	if maxLatency < 1 {
+		maxLatency = 1
+	}
+	nodes := make([]*node, numValidators)
+	for i, id := range ids {
+		n, err := newNode(id, validators)
+		if err != nil {
+			return nil, err
+		}
+		n.online = true
+		n.checks = r.Intn(maxLatency)
+		n.latencyRNG = rand.New(rand.NewSource(r.Int63())) // nolint:gosec
+		n.parentsRNG = rand.New(rand.NewSource(r.Int63())) // nolint:gosec
+		nodes[i] = n
+	}
+	if cfg.Offline {
+		// make the smallest validators offline
+		onlineWeight := validators.TotalWeight()
+		sorted := make([]*node, numValidators)
+		copy(sorted, nodes)
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return validators.Get(sorted[i].id) < validators.Get(sorted[j].id)
+		})
+		for _, n := range sorted {
+			if onlineWeight-validators.Get(n.id) < validators.Quorum() {
+				break
+			}
+			onlineWeight -= validators.Get(n.id)
+			n.online = false
+		}
+	}
+
+	// inflight is a circular buffer of events in delivery, indexed by [time][receiver][sender]
+	inflight := make([][][][]*event, maxLatency+1)
+	for t := range inflight {
+		inflight[t] = make([][][]*event, numValidators)
+		for receiver := range inflight[t] {
+			inflight[t][receiver] = make([][]*event, numValidators)
+		}
+	}
+
+	events := 0
+	duration := int(cfg.Duration / time.Millisecond)
+	errs := make([]error, numValidators)
+	wg := sync.WaitGroup{}
+	for now := 0; now <= duration; now++ {
+		slot := inflight[now%len(inflight)]
+		// deliver events, every node is processed in parallel
+		for i := range nodes {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				n := nodes[i]
+				n.now = now
+				for sender := range slot[i] {
+					n.buffer = append(n.buffer, slot[i][sender]...)
+					slot[i][sender] = slot[i][sender][:0]
+				}
+				if errs[i] == nil {
+					errs[i] = n.receive()
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		// emit events, every node writes only its own column of the inflight buffer
+		emitted := make([]bool, numValidators)
+		for i := range nodes {
+			n := nodes[i]
+			if now-n.lastCheck < checkInterval {
+				continue
+			}
+			n.lastCheck = now
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if n.checks > 0 {
+					n.checks--
+					return
+				}
+				if !n.online || !n.shouldEmit(validators) || errs[i] != nil {
+					return
+				}
+				e, err := n.emit(cfg)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				emitted[i] = true
+				for receiver := range nodes {
+					delay := 1
+					if receiver != i {
+						delay = cfg.Latency.Latency(i, receiver, n.latencyRNG)
+					}
+					if delay < 1 {
+						delay = 1
+					}
+					if delay > maxLatency {
+						delay = maxLatency
+					}
+					t := (now + delay) % len(inflight)
+					inflight[t][receiver][i] = append(inflight[t][receiver][i], e)
+				}
+			}(i)
+		}
+		wg.Wait()
+		for i := range nodes {
+			if errs[i] != nil {
+				return nil, errs[i]
+			}
+			if emitted[i] {
+				events++
+			}
+		}
+	}
+
+	return newResult(cfg, nodes, events), nil
+}
+
+func newResult(cfg Config, nodes []*node, events int) *Result {
+	res := &Result{
+		Validators: len(nodes),
+		DurationMs: int(cfg.Duration / time.Millisecond),
+		Events:     events,
+	}
+	var ttfs []int
+	for _, n := range nodes {
+		for _, head := range n.heads {
+			if res.MaxFrame < uint32(head.Frame()) {
+				res.MaxFrame = uint32(head.Frame())
+			}
+		}
+		if !n.online {
+			continue
+		}
+		res.OnlineValidators++
+		decided := uint32(n.store.GetLastDecidedFrame())
+		if res.OnlineValidators == 1 || decided < res.DecidedFrames {
+			res.DecidedFrames = decided
+		}
+		ttfs = append(ttfs, n.ttfs...)
+	}
+
+	seconds := cfg.Duration.Seconds()
+	if seconds > 0 {
+		res.FramesPerSecond = float64(res.DecidedFrames) / seconds
+		res.EventRate = float64(events) / float64(res.OnlineValidators) / seconds
+	}
+	if res.DecidedFrames != 0 {
+		res.EventsPerFrame = float64(events) / float64(res.DecidedFrames)
+	}
+	if len(ttfs) != 0 {
+		sort.Ints(ttfs)
+		sum := 0
+		for _, ttf := range ttfs {
+			sum += ttf
+		}
+		res.TTFMeanMs = float64(sum) / float64(len(ttfs))
+		res.TTFMedianMs = ttfs[len(ttfs)/2]
+		res.TTFP95Ms = ttfs[len(ttfs)*95/100]
+	}
+	return res
+}
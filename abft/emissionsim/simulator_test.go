@@ -0,0 +1,78 @@
+package emissionsim
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+func TestRun(t *testing.T) {
+	for name, cfg := range map[string]Config{
+		"gaussian": {
+			Weights:   []pos.Weight{5, 4, 3, 2, 1},
+			FCParents: 3,
+			Latency:   &GaussianLatency{Mean: 100, Std: 10},
+		},
+		"mainnet": {
+			Weights:     MainNetWeights(7, 0),
+			FCParents:   3,
+			RandParents: 1,
+			Latency:     &MainNetLatency{},
+			Seed:        1,
+		},
+		"city": {
+			Weights:   MainNetWeights(7, 1),
+			FCParents: 5,
+			Offline:   true,
+			Latency:   NewCityLatency(7, 2),
+			Seed:      2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			cfg.Duration = 5 * time.Second
+
+			res, err := Run(cfg)
+			require.NoError(err)
+			require.Equal(len(cfg.Weights), res.Validators)
+			require.Greater(res.DecidedFrames, uint32(0))
+			require.GreaterOrEqual(res.MaxFrame, res.DecidedFrames)
+			require.Greater(res.TTFMeanMs, float64(0))
+			require.LessOrEqual(res.TTFMedianMs, res.TTFP95Ms)
+			if cfg.Offline {
+				require.Less(res.OnlineValidators, res.Validators)
+			}
+
+			// the result is reproducible
+			again, err := Run(cfg)
+			require.NoError(err)
+			require.Equal(res, again)
+		})
+	}
+}
+
+func Benchmark_Emission(b *testing.B) {
+	weights := MainNetWeights(20, 0)
+	for _, parents := range []int{3, 6, 12} {
+		b.Run(fmt.Sprintf("parents=%d", parents), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res, err := Run(Config{
+					Weights:   weights,
+					FCParents: parents,
+					Latency:   &GaussianLatency{Mean: 100, Std: 10},
+					Duration:  10 * time.Second,
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.ReportMetric(res.FramesPerSecond, "frames/s")
+				b.ReportMetric(res.EventsPerFrame, "events/frame")
+				b.ReportMetric(res.TTFMeanMs, "ttf-ms")
+			}
+		})
+	}
+}
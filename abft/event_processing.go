@@ -1,8 +1,11 @@
 package abft
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 
+	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
 	"github.com/Fantom-foundation/lachesis-base/abft/election"
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag"
@@ -13,6 +16,17 @@ var (
 	ErrWrongFrame = errors.New("claimed frame mismatched with calculated")
 )
 
+// FrameJumpError is returned if event's frame exceeds the highest frame of its parents by more than Config.MaxFrameJump
+type FrameJumpError struct {
+	ParentsFrame idx.Frame
+	Frame        idx.Frame
+	MaxFrameJump idx.Frame
+}
+
+func (e *FrameJumpError) Error() string {
+	return fmt.Sprintf("event frame %d exceeds parents frame %d by more than %d", e.Frame, e.ParentsFrame, e.MaxFrameJump)
+}
+
 // Build fills consensus-related fields: Frame, IsRoot
 // returns error if event should be dropped
 func (p *Orderer) Build(e dag.MutableEvent) error {
@@ -24,7 +38,10 @@ func (p *Orderer) Build(e dag.MutableEvent) error {
 		p.crit(errors.New("event wasn't created by an existing validator"))
 	}
 
-	_, frame := p.calcFrameIdx(e)
+	_, frame, err := p.calcFrameIdx(e)
+	if err != nil {
+		return err
+	}
 	e.SetFrame(frame)
 
 	return nil
@@ -52,10 +69,23 @@ func (p *Orderer) Process(e dag.Event) (err error) {
 // checkAndSaveEvent checks consensus-related fields: Frame, IsRoot
 func (p *Orderer) checkAndSaveEvent(e dag.Event) (error, idx.Frame) {
 	// check frame & isRoot
-	selfParentFrame, frameIdx := p.calcFrameIdx(e)
+	selfParentFrame, frameIdx, err := p.calcFrameIdx(e)
+	if err != nil {
+		return err, 0
+	}
 	if !p.config.SuppressFramePanic && e.Frame() != frameIdx {
 		return ErrWrongFrame, 0
 	}
+	// claimed frame may differ from calculated if the frame mismatch is suppressed
+	if e.Frame() != frameIdx {
+		parentsFrame, err := p.parentsFrame(e)
+		if err != nil {
+			return err, 0
+		}
+		if err := p.checkFrameJump(parentsFrame, e.Frame()); err != nil {
+			return err, 0
+		}
+	}
 
 	if selfParentFrame != frameIdx {
 		p.store.AddRoot(selfParentFrame, e)
@@ -146,32 +176,104 @@ func (p *Orderer) processKnownRoots() (*election.Res, hash.Event, error) {
 	return nil, hash.ZeroEvent, nil
 }
 
+// forklessCauseBatch is the number of roots whose forkless cause is evaluated at once by a DAG index which supports it.
+// Evaluation stops after the batch where the quorum is reached.
+const forklessCauseBatch = 16
+
 // forklessCausedByQuorumOn returns true if event is forkless caused by 2/3W roots on specified frame
 func (p *Orderer) forklessCausedByQuorumOn(e dag.Event, f idx.Frame) bool {
 	observedCounter := p.store.GetValidators().NewCounter()
+	batchSize := 1
+	if _, ok := p.dagIndex.(dagidx.ForklessCauseMany); ok {
+		batchSize = forklessCauseBatch
+	}
 	// check "observing" prev roots only if called by creator, or if creator has marked that event as root
-	for _, it := range p.store.GetFrameRoots(f) {
-		if p.dagIndex.ForklessCause(e.ID(), it.ID) {
-			observedCounter.Count(it.Slot.Validator)
+	frameRoots := p.store.GetFrameRoots(f)
+	for start := 0; start < len(frameRoots) && !observedCounter.HasQuorum(); start += batchSize {
+		batch := frameRoots[start:min(start+batchSize, len(frameRoots))]
+		ids := make(hash.Events, len(batch))
+		for i, it := range batch {
+			ids[i] = it.ID
 		}
-		if observedCounter.HasQuorum() {
-			break
+		for i, observed := range p.forklessCauseMany(e.ID(), ids) {
+			if observed {
+				observedCounter.Count(batch[i].Slot.Validator)
+			}
+			if observedCounter.HasQuorum() {
+				break
+			}
 		}
 	}
 	return observedCounter.HasQuorum()
 }
 
+// forklessCauseMany returns ForklessCause(a, b) for each of bs, evaluates them at once if DAG index supports it
+func (p *Orderer) forklessCauseMany(a hash.Event, bs hash.Events) []bool {
+	if many, ok := p.dagIndex.(dagidx.ForklessCauseMany); ok {
+		return many.ForklessCauseMany(a, bs)
+	}
+	res := make([]bool, len(bs))
+	for i, b := range bs {
+		res[i] = p.dagIndex.ForklessCause(a, b)
+	}
+	return res
+}
+
+// parentsFrame returns the highest frame of event's parents
+func (p *Orderer) parentsFrame(e dag.Event) (idx.Frame, error) {
+	maxFrame := idx.Frame(0)
+	for _, id := range e.Parents() {
+		parent, err := p.input.GetEvent(id)
+		if err != nil {
+			return 0, err
+		}
+		if maxFrame < parent.Frame() {
+			maxFrame = parent.Frame()
+		}
+	}
+	return maxFrame, nil
+}
+
+// checkFrameJump returns FrameJumpError if frame exceeds the highest frame of parents by more than Config.MaxFrameJump.
+// The jump isn't limited relative to the self-parent, as a validator which was offline for many frames
+// legitimately jumps over them with its first event after it observes the recent events.
+func (p *Orderer) checkFrameJump(parentsFrame, frame idx.Frame) error {
+	if p.config.MaxFrameJump != 0 && frame > parentsFrame+p.config.MaxFrameJump {
+		return &FrameJumpError{
+			ParentsFrame: parentsFrame,
+			Frame:        frame,
+			MaxFrameJump: p.config.MaxFrameJump,
+		}
+	}
+	return nil
+}
+
 // calcFrameIdx checks root-conditions for new event and returns event's frame.
+// Returns FrameJumpError as soon as the frame exceeds the limit, without calculating the whole jump.
 // It is not safe for concurrent use.
-func (p *Orderer) calcFrameIdx(e dag.Event) (selfParentFrame, frame idx.Frame) {
+func (p *Orderer) calcFrameIdx(e dag.Event) (selfParentFrame, frame idx.Frame, err error) {
 	if e.SelfParent() == nil {
-		return 0, 1
+		return 0, 1, nil
+	}
+	selfParent, err := p.input.GetEvent(*e.SelfParent())
+	if err != nil {
+		return 0, 0, err
+	}
+	selfParentFrame = selfParent.Frame()
+	parentsFrame := selfParentFrame
+	if p.config.MaxFrameJump != 0 {
+		parentsFrame, err = p.parentsFrame(e)
+		if err != nil {
+			return 0, 0, err
+		}
 	}
-	selfParentFrame = p.input.GetEvent(*e.SelfParent()).Frame()
 	frame = selfParentFrame
 	// Find highest frame s.t. event e is forklessCausedByQuorumOn by frame-1 roots
 	for p.forklessCausedByQuorumOn(e, frame) {
 		frame++
+		if err := p.checkFrameJump(parentsFrame, frame); err != nil {
+			return selfParentFrame, frame, err
+		}
 	}
-	return selfParentFrame, frame
+	return selfParentFrame, frame, nil
 }
@@ -1,12 +1,58 @@
 package abft
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag"
 )
 
+var (
+	ErrEventNotFound = errors.New("event not found")
+)
+
 // EventSource is a callback for getting events from an external storage.
 type EventSource interface {
 	HasEvent(hash.Event) bool
 	GetEvent(hash.Event) dag.Event
 }
+
+// EventSourceV2 is an error-aware callback for getting events from an external storage.
+// It allows backends such as remote or lazily-loaded event stores to surface I/O errors.
+type EventSourceV2 interface {
+	// GetEvent returns the event, or an error wrapping ErrEventNotFound if event doesn't exist
+	GetEvent(hash.Event) (dag.Event, error)
+	// GetEvents returns the events in the same order as requested.
+	// Backends may use it to fetch events (e.g. parents of an event) in a single batch.
+	GetEvents(hash.Events) (dag.Events, error)
+}
+
+// WrapEventSource adapts EventSource to EventSourceV2.
+func WrapEventSource(input EventSource) EventSourceV2 {
+	return &eventSourceV1{input}
+}
+
+type eventSourceV1 struct {
+	input EventSource
+}
+
+func (s *eventSourceV1) GetEvent(id hash.Event) (dag.Event, error) {
+	e := s.input.GetEvent(id)
+	if e == nil {
+		return nil, fmt.Errorf("%w %s", ErrEventNotFound, id.String())
+	}
+	return e, nil
+}
+
+func (s *eventSourceV1) GetEvents(ids hash.Events) (dag.Events, error) {
+	ee := make(dag.Events, len(ids))
+	for i, id := range ids {
+		e, err := s.GetEvent(id)
+		if err != nil {
+			return nil, err
+		}
+		ee[i] = e
+	}
+	return ee, nil
+}
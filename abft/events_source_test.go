@@ -1,9 +1,11 @@
 package abft
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag"
@@ -43,3 +45,122 @@ func TestEventStore(t *testing.T) {
 
 	store.Close()
 }
+
+type failingEventSource struct {
+	EventSourceV2
+	fail hash.Event
+	// err is returned for the failed event, errTestIO by default
+	err error
+}
+
+var errTestIO = errors.New("test I/O error")
+
+func (s *failingEventSource) GetEvent(id hash.Event) (dag.Event, error) {
+	if id == s.fail {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, errTestIO
+	}
+	return s.EventSourceV2.GetEvent(id)
+}
+
+func TestEventSourceV2(t *testing.T) {
+	store := NewEventStore()
+	input := WrapEventSource(store)
+
+	t.Run("NotExisting", func(t *testing.T) {
+		require := require.New(t)
+
+		h := hash.FakeEvent()
+		_, err := input.GetEvent(h)
+		require.ErrorIs(err, ErrEventNotFound)
+		_, err = input.GetEvents(hash.Events{h})
+		require.ErrorIs(err, ErrEventNotFound)
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		require := require.New(t)
+
+		nodes := tdag.GenNodes(5)
+		tdag.ForEachRandEvent(nodes, 10, 4, nil, tdag.ForEachEvent{
+			Process: func(e dag.Event, name string) {
+				store.SetEvent(e)
+				e1, err := input.GetEvent(e.ID())
+				require.NoError(err)
+				require.Equal(e, e1)
+
+				parents, err := input.GetEvents(e.Parents())
+				require.NoError(err)
+				require.Len(parents, len(e.Parents()))
+				for i, p := range parents {
+					require.Equal(e.Parents()[i], p.ID())
+				}
+			},
+		})
+	})
+
+	t.Run("Orderer", func(t *testing.T) {
+		require := require.New(t)
+
+		nodes := tdag.GenNodes(2)
+		lch, _, store, _ := NewCoreLachesis(nodes, nil)
+		first := processTestEvent(t, lch, store, nodes[0], 1, hash.Events{})
+		lch.input = &failingEventSource{
+			EventSourceV2: lch.input,
+			fail:          first.ID(),
+		}
+
+		// I/O error is returned instead of a critical error
+		e := &tdag.TestEvent{}
+		e.SetSeq(2)
+		e.SetCreator(nodes[0])
+		e.SetParents(hash.Events{first.ID()})
+		e.SetLamport(2)
+		e.SetEpoch(lch.store.GetEpoch())
+		require.ErrorIs(lch.Build(e), errTestIO)
+	})
+
+	indexedLachesis := func(t *testing.T, sourceErr error) (processErr error, critErr error) {
+		nodes := tdag.GenNodes(2)
+		lch, _, store, _ := NewCoreLachesis(nodes, nil)
+		first := processTestEvent(t, lch, store, nodes[0], 1, hash.Events{})
+		other := processTestEvent(t, lch, store, nodes[1], 1, hash.Events{})
+		lch.input = &failingEventSource{
+			EventSourceV2: lch.input,
+			fail:          other.ID(),
+			err:           sourceErr,
+		}
+		lch.crit = func(err error) {
+			critErr = err
+		}
+
+		e := &tdag.TestEvent{}
+		e.SetSeq(2)
+		e.SetCreator(nodes[0])
+		e.SetParents(hash.Events{first.ID(), other.ID()})
+		e.SetLamport(3)
+		e.SetEpoch(lch.store.GetEpoch())
+		e.SetID([24]byte{2})
+		store.SetEvent(e)
+		return lch.Process(e), critErr
+	}
+
+	t.Run("IndexedLachesis missing event", func(t *testing.T) {
+		require := require.New(t)
+
+		// event which DAG indexer doesn't find is an error, not a critical error
+		processErr, critErr := indexedLachesis(t, ErrEventNotFound)
+		require.Error(processErr)
+		require.NoError(critErr)
+	})
+
+	t.Run("IndexedLachesis I/O error", func(t *testing.T) {
+		require := require.New(t)
+
+		// I/O error of DAG indexer isn't mistaken for a missing event
+		processErr, critErr := indexedLachesis(t, errTestIO)
+		require.Error(processErr)
+		require.ErrorIs(critErr, errTestIO)
+	})
+}
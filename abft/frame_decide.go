@@ -1,9 +1,11 @@
 package abft
 
 import (
+	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
 	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
 )
 
 // onFrameDecided moves LastDecidedFrameN to frame.
@@ -14,6 +16,7 @@ func (p *Orderer) onFrameDecided(frame idx.Frame, atropos, electing hash.Event)
 	if p.callback.ApplyAtropos != nil {
 		newValidators = p.callback.ApplyAtropos(frame, atropos, electing)
 	}
+	p.store.appendAtroposChain(p.store.GetEpoch(), frame, atropos, p.atroposCheaters(atropos))
 
 	lastDecidedState := *p.store.GetLastDecidedState()
 	if newValidators != nil {
@@ -31,6 +34,28 @@ func (p *Orderer) onFrameDecided(frame idx.Frame, atropos, electing hash.Event)
 	return newValidators != nil, nil
 }
 
+// atroposCheaters returns cheaters observed by the Atropos.
+// Returns nil if DAG index doesn't provide vector clock, because Orderer doesn't detect cheaters by itself.
+func (p *Orderer) atroposCheaters(atropos hash.Event) lachesis.Cheaters {
+	vecClock, ok := p.dagIndex.(dagidx.VectorClock)
+	if !ok {
+		return nil
+	}
+	return observedCheaters(vecClock.GetMergedHighestBefore(atropos), p.store.GetValidators())
+}
+
+// observedCheaters returns validators which are marked as forked in the vector clock.
+// Cheaters are ordered deterministically.
+func observedCheaters(vecClock dagidx.HighestBeforeSeq, validators *pos.Validators) lachesis.Cheaters {
+	cheaters := make(lachesis.Cheaters, 0, validators.Len())
+	for creatorIdx, creator := range validators.SortedIDs() {
+		if vecClock.Get(idx.Validator(creatorIdx)).IsForkDetected() {
+			cheaters = append(cheaters, creator)
+		}
+	}
+	return cheaters
+}
+
 func (p *Orderer) resetEpochStore(newEpoch idx.Epoch) error {
 	err := p.store.dropEpochDB()
 	if err != nil {
@@ -51,7 +76,7 @@ func (p *Orderer) sealEpoch(newValidators *pos.Validators) error {
 	// new PrevEpoch state
 	epochState := *p.store.GetEpochState()
 	epochState.Epoch++
-	epochState.Validators = newValidators
+	epochState.Validators = newValidators.WithQuorumRule(epochState.QuorumRule)
 	p.store.SetEpochState(&epochState)
 
 	return p.resetEpochStore(epochState.Epoch)
@@ -40,8 +40,13 @@ type DagIndexer interface {
 
 // NewIndexedLachesis creates IndexedLachesis instance.
 func NewIndexedLachesis(store *Store, input EventSource, dagIndexer DagIndexer, crit func(error), config Config) *IndexedLachesis {
+	return NewIndexedLachesisV2(store, WrapEventSource(input), dagIndexer, crit, config)
+}
+
+// NewIndexedLachesisV2 creates IndexedLachesis instance over error-aware events source.
+func NewIndexedLachesisV2(store *Store, input EventSourceV2, dagIndexer DagIndexer, crit func(error), config Config) *IndexedLachesis {
 	p := &IndexedLachesis{
-		Lachesis:      NewLachesis(store, input, dagIndexer, crit, config),
+		Lachesis:      NewLachesisV2(store, input, dagIndexer, crit, config),
 		dagIndexer:    dagIndexer,
 		uniqueDirtyID: uniqueID{new(big.Int)},
 	}
@@ -78,6 +83,9 @@ func (p *IndexedLachesis) Process(e dag.Event) (err error) {
 	if err != nil {
 		return err
 	}
+	if pruner, ok := p.dagIndexer.(dagidx.Pruner); ok {
+		pruner.Prune(p.store.GetLastDecidedFrame())
+	}
 	p.dagIndexer.Flush()
 	return nil
 }
@@ -90,7 +98,7 @@ func (p *IndexedLachesis) Bootstrap(callback lachesis.ConsensusCallbacks) error
 			if base.EpochDBLoaded != nil {
 				base.EpochDBLoaded(epoch)
 			}
-			p.dagIndexer.Reset(p.store.GetValidators(), flushable.Wrap(p.store.epochTable.VectorIndex), p.input.GetEvent)
+			p.dagIndexer.Reset(p.store.GetValidators(), flushable.Wrap(p.store.epochTable.VectorIndex), p.getEvent)
 		},
 	}
 	return p.Lachesis.BootstrapWithOrderer(callback, ordererCallbacks)
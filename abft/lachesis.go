@@ -28,8 +28,13 @@ type Lachesis struct {
 
 // NewLachesis creates Lachesis instance.
 func NewLachesis(store *Store, input EventSource, dagIndex DagIndex, crit func(error), config Config) *Lachesis {
+	return NewLachesisV2(store, WrapEventSource(input), dagIndex, crit, config)
+}
+
+// NewLachesisV2 creates Lachesis instance over error-aware events source.
+func NewLachesisV2(store *Store, input EventSourceV2, dagIndex DagIndex, crit func(error), config Config) *Lachesis {
 	p := &Lachesis{
-		Orderer:  NewOrderer(store, input, dagIndex, crit, config),
+		Orderer:  NewOrdererV2(store, input, dagIndex, crit, config),
 		dagIndex: dagIndex,
 	}
 
@@ -55,14 +60,8 @@ func (p *Lachesis) confirmEvents(frame idx.Frame, atropos hash.Event, onEventCon
 func (p *Lachesis) applyAtropos(decidedFrame idx.Frame, atropos, electing hash.Event) *pos.Validators {
 	atroposVecClock := p.dagIndex.GetMergedHighestBefore(atropos)
 
-	validators := p.store.GetValidators()
 	// cheaters are ordered deterministically
-	cheaters := make([]idx.ValidatorID, 0, validators.Len())
-	for creatorIdx, creator := range validators.SortedIDs() {
-		if atroposVecClock.Get(idx.Validator(creatorIdx)).IsForkDetected() {
-			cheaters = append(cheaters, creator)
-		}
-	}
+	cheaters := observedCheaters(atroposVecClock, p.store.GetValidators())
 
 	if p.callback.BeginBlock == nil {
 		return nil
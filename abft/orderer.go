@@ -1,9 +1,12 @@
 package abft
 
 import (
+	"errors"
+
 	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
 	"github.com/Fantom-foundation/lachesis-base/abft/election"
 	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
 	"github.com/Fantom-foundation/lachesis-base/inter/pos"
 )
@@ -24,7 +27,7 @@ type Orderer struct {
 	config Config
 	crit   func(error)
 	store  *Store
-	input  EventSource
+	input  EventSourceV2
 
 	election *election.Election
 	dagIndex OrdererDagIndex
@@ -36,6 +39,11 @@ type Orderer struct {
 // Unlike Lachesis, Orderer doesn't updates DAG indexes for events, and doesn't detect cheaters
 // It has only one purpose - reaching consensus on events order.
 func NewOrderer(store *Store, input EventSource, dagIndex OrdererDagIndex, crit func(error), config Config) *Orderer {
+	return NewOrdererV2(store, WrapEventSource(input), dagIndex, crit, config)
+}
+
+// NewOrdererV2 creates Orderer instance over error-aware events source.
+func NewOrdererV2(store *Store, input EventSourceV2, dagIndex OrdererDagIndex, crit func(error), config Config) *Orderer {
 	p := &Orderer{
 		config:   config,
 		store:    store,
@@ -46,3 +54,26 @@ func NewOrderer(store *Store, input EventSource, dagIndex OrdererDagIndex, crit
 
 	return p
 }
+
+// getEvent returns the event for callers which don't expect I/O errors, such as DAG indexers.
+// Missing event is returned as nil, so the caller reports it as not found.
+// Other errors are critical, as the caller can't tell them from a missing event.
+func (p *Orderer) getEvent(id hash.Event) dag.Event {
+	e, err := p.input.GetEvent(id)
+	if err != nil {
+		if !errors.Is(err, ErrEventNotFound) {
+			p.crit(err)
+		}
+		return nil
+	}
+	return e
+}
+
+// LeadingAtroposCandidate returns the most likely Atropos of the lowest not decided frame, according to the already processed roots.
+// The result isn't final unless it's marked as decided, so it may be used only for optimistic indications before ApplyAtropos is called.
+func (p *Orderer) LeadingAtroposCandidate() *election.Candidate {
+	if p.election == nil {
+		return nil
+	}
+	return p.election.LeadingCandidate()
+}
@@ -0,0 +1,166 @@
+package abft
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+func TestLachesis_QuorumRule(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(7)
+	rule, err := pos.NewQuorumRule(3, 4)
+	require.NoError(err)
+	validators := pos.EqualWeightValidators(nodes, 1).WithQuorumRule(rule)
+	// the rule requires 6 of 7 validators instead of 5
+	require.Equal(pos.Weight(6), validators.Quorum())
+
+	const lchCount = 2
+	lchs := make([]*CoreLachesis, 0, lchCount)
+	inputs := make([]*EventStore, 0, lchCount)
+	for i := 0; i < lchCount; i++ {
+		lch, _, input, _ := newCoreLachesisWithValidators(validators, vecfc.LiteConfig())
+		lchs = append(lchs, lch)
+		inputs = append(inputs, input)
+	}
+
+	eventCount := int(TestMaxEpochEvents)
+	const epochs = 3
+	var maxEpochBlocks = eventCount / 20
+	for _, _lch := range lchs {
+		lch := _lch // capture
+		lch.applyBlock = func(block *lachesis.Block) *pos.Validators {
+			if lch.store.GetLastDecidedFrame()+1 == idx.Frame(maxEpochBlocks) {
+				// validators of the next epoch are built without the rule
+				return mutateValidators(lch.store.GetValidators())
+			}
+			return nil
+		}
+	}
+
+	ordered := map[idx.Epoch]dag.Events{}
+	r := rand.New(rand.NewSource(0)) // nolint:gosec
+	for epoch := idx.Epoch(1); epoch <= idx.Epoch(epochs); epoch++ {
+		tdag.ForEachRandEvent(nodes, eventCount, 5, r, tdag.ForEachEvent{
+			Process: func(e dag.Event, name string) {
+				ordered[epoch] = append(ordered[epoch], e)
+				inputs[0].SetEvent(e)
+				require.NoError(lchs[0].Process(e))
+			},
+			Build: func(e dag.MutableEvent, name string) error {
+				if epoch != lchs[0].store.GetEpoch() {
+					return errors.New("epoch already sealed, skip")
+				}
+				e.SetEpoch(epoch)
+				return lchs[0].Build(e)
+			},
+		})
+		require.Equal(epoch+1, lchs[0].store.GetEpoch(), "epoch wasn't sealed")
+		require.Equal(idx.Frame(maxEpochBlocks), lchs[0].epochBlocks[epoch])
+		// the rule is kept for the next epoch
+		require.Equal(rule, lchs[0].store.GetEpochState().QuorumRule)
+		require.Equal(rule, lchs[0].store.GetValidators().QuorumRule())
+	}
+
+	for epoch := idx.Epoch(1); epoch <= idx.Epoch(epochs); epoch++ {
+		for _, e := range reorder(ordered[epoch]) {
+			inputs[1].SetEvent(e)
+			require.NoError(lchs[1].Process(e))
+			if lchs[1].store.GetEpoch() != epoch {
+				break
+			}
+		}
+		require.Equal(epoch+1, lchs[1].store.GetEpoch(), "epoch wasn't sealed")
+	}
+	compareResults(t, lchs)
+
+	// the rule is restored from DB
+	lchs[0].store.cache.EpochState = nil
+	require.Equal(rule, lchs[0].store.GetValidators().QuorumRule())
+
+	// the rule is kept on Reset
+	require.NoError(lchs[1].Reset(epochs+1, pos.EqualWeightValidators(nodes, 1)))
+	require.Equal(rule, lchs[1].store.GetValidators().QuorumRule())
+}
+
+func TestLachesis_QuorumRule_Liveness(t *testing.T) {
+	require := require.New(t)
+
+	// 2 of 7 validators are offline, so the quorum of 3/4 rule isn't reachable, unlike the default one
+	nodes := tdag.GenNodes(7)
+	online := nodes[:5]
+	rule, err := pos.NewQuorumRule(3, 4)
+	require.NoError(err)
+	for _, validators := range []*pos.Validators{pos.EqualWeightValidators(nodes, 1), pos.EqualWeightValidators(nodes, 1).WithQuorumRule(rule)} {
+		lch, _, input, _ := newCoreLachesisWithValidators(validators, vecfc.LiteConfig())
+		blocks := 0
+		lch.applyBlock = func(block *lachesis.Block) *pos.Validators {
+			blocks++
+			return nil
+		}
+		tdag.ForEachRandEvent(online, 200, 3, rand.New(rand.NewSource(0)), tdag.ForEachEvent{ // nolint:gosec
+			Process: func(e dag.Event, name string) {
+				input.SetEvent(e)
+				require.NoError(lch.Process(e))
+			},
+			Build: func(e dag.MutableEvent, name string) error {
+				e.SetEpoch(FirstEpoch)
+				return lch.Build(e)
+			},
+		})
+		if validators.QuorumRule() == pos.DefaultQuorumRule {
+			require.NotZero(blocks)
+		} else {
+			require.Zero(blocks)
+		}
+	}
+}
+
+func TestApplyGenesis_QuorumRule(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(4)
+	unsafe, err := pos.NewUnsafeQuorumRule(1, 2)
+	require.NoError(err)
+	_, err = pos.NewQuorumRule(1, 2)
+	require.Error(err)
+
+	newStore := func() *Store {
+		return NewStore(memorydb.New(), func(idx.Epoch) kvdb.Store { return memorydb.New() }, func(err error) { panic(err) }, LiteStoreConfig())
+	}
+	validators := pos.EqualWeightValidators(nodes, 1).WithQuorumRule(unsafe)
+	require.Error(newStore().ApplyGenesis(&Genesis{
+		Epoch:      FirstEpoch,
+		Validators: validators,
+	}))
+
+	store := newStore()
+	require.NoError(store.ApplyGenesis(&Genesis{
+		Epoch:             FirstEpoch,
+		Validators:        validators,
+		AllowUnsafeQuorum: true,
+	}))
+	require.Equal(unsafe, store.GetEpochState().QuorumRule)
+	require.Equal(pos.Weight(3), store.GetValidators().Quorum())
+
+	// the default rule isn't stored, so the encoding of the epoch state isn't changed
+	store = newStore()
+	require.NoError(store.ApplyGenesis(&Genesis{
+		Epoch:      FirstEpoch,
+		Validators: pos.EqualWeightValidators(nodes, 1),
+	}))
+	require.Equal(pos.QuorumRule{}, store.GetEpochState().QuorumRule)
+	require.Equal(pos.DefaultQuorumRule, store.GetValidators().QuorumRule())
+}
@@ -0,0 +1,169 @@
+package abft
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/utils/cachescale"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+// RebuildConfig is a config of the vector index rebuilding.
+type RebuildConfig struct {
+	Index vecfc.IndexConfig
+	// VerifySamples is a number of randomly sampled events whose vectors are verified after the rebuilding
+	VerifySamples int
+	// Progress is called after each indexed event with the number of indexed and total events, optional
+	Progress func(indexed, total int)
+}
+
+// DefaultRebuildConfig returns default config of the vector index rebuilding.
+func DefaultRebuildConfig() RebuildConfig {
+	return RebuildConfig{
+		Index:         vecfc.DefaultConfig(cachescale.Identity),
+		VerifySamples: 100,
+	}
+}
+
+// RebuildVectorIndex drops the vector index of the current epoch and rebuilds it from the epoch events.
+// ids must contain all the events of the current epoch, in any order.
+// Must be called before Bootstrap, as DAG indexer keeps a state in memory. The epoch DB is opened if it isn't opened yet.
+func (s *Store) RebuildVectorIndex(input EventSource, ids hash.Events, cfg RebuildConfig) error {
+	if err := s.loadEpochDB(); err != nil {
+		return err
+	}
+	return RebuildVectorIndex(s.epochTable.VectorIndex, s.GetValidators(), input, ids, cfg)
+}
+
+// RebuildVectorIndex drops vecfc.Index and vecengine tables in db and rebuilds them from the epoch events.
+// db is the vector index DB, i.e. the same DB which is passed to vecfc.Index.Reset.
+// ids must contain all the events of the epoch, in any order. Events are indexed in topological order.
+func RebuildVectorIndex(db kvdb.Store, validators *pos.Validators, input EventSource, ids hash.Events, cfg RebuildConfig) error {
+	events, err := WrapEventSource(input).GetEvents(ids)
+	if err != nil {
+		return err
+	}
+	sortTopologically(events)
+	eventsMap := make(map[hash.Event]dag.Event, len(events))
+	for _, e := range events {
+		if !validators.Exists(e.Creator()) {
+			return fmt.Errorf("event %s is created by %d, which isn't a validator", e.ID().String(), e.Creator())
+		}
+		eventsMap[e.ID()] = e
+	}
+
+	if err := dropAll(db); err != nil {
+		return err
+	}
+
+	var critErr error
+	crit := func(err error) {
+		if critErr == nil {
+			critErr = err
+		}
+	}
+	getEvent := func(id hash.Event) dag.Event {
+		return eventsMap[id]
+	}
+	vecDb := flushable.Wrap(db)
+	vecs := vecfc.NewIndex(crit, cfg.Index)
+	vecs.Reset(validators, vecDb, getEvent)
+	for i, e := range events {
+		for _, p := range e.Parents() {
+			if eventsMap[p] == nil {
+				return fmt.Errorf("parent %s of event %s: %w", p.String(), e.ID().String(), ErrEventNotFound)
+			}
+		}
+		if err := vecs.Add(e); err != nil {
+			return fmt.Errorf("failed to index event %s: %w", e.ID().String(), err)
+		}
+		if vecDb.NotFlushedSizeEst() >= kvdb.IdealBatchSize || i == len(events)-1 {
+			vecs.Flush()
+		}
+		if critErr != nil {
+			return critErr
+		}
+		if cfg.Progress != nil {
+			cfg.Progress(i+1, len(events))
+		}
+	}
+
+	// verify vectors read from DB rather than from caches
+	vecs = vecfc.NewIndex(crit, cfg.Index)
+	vecs.Reset(validators, flushable.Wrap(db), getEvent)
+	if err := verifyVectors(vecs, events, events, cfg.VerifySamples); err != nil {
+		return err
+	}
+	return critErr
+}
+
+// sortTopologically sorts events by Lamport timestamp, as parents have lower Lamport timestamps than their children
+func sortTopologically(events dag.Events) {
+	sort.Slice(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if a.Lamport() != b.Lamport() {
+			return a.Lamport() < b.Lamport()
+		}
+		return bytes.Compare(a.ID().Bytes(), b.ID().Bytes()) < 0
+	})
+}
+
+func dropAll(db kvdb.Store) error {
+	batch := db.NewBatch()
+	defer batch.Reset()
+
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+		if batch.ValueSize() >= kvdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if it.Error() != nil {
+		return it.Error()
+	}
+	return batch.Write()
+}
+
+// verifyVectors compares stored vectors of events randomly sampled from candidates against the vectors calculated from the DAG directly,
+// see vecfc.Index.VerifyEventDAG. events must contain all the events of the epoch.
+// Returns VectorInconsistency of the first wrong sampled event.
+func verifyVectors(vecs *vecfc.Index, events dag.Events, candidates dag.Events, samples int) error {
+	if len(candidates) == 0 || samples <= 0 {
+		return nil
+	}
+	children := make(map[hash.Event]hash.Events, len(events))
+	for _, e := range events {
+		for _, p := range e.Parents() {
+			children[p] = append(children[p], e.ID())
+		}
+	}
+	getChildren := func(id hash.Event) hash.Events {
+		return children[id]
+	}
+
+	r := rand.New(rand.NewSource(int64(len(candidates)))) // nolint:gosec
+	for _, i := range r.Perm(len(candidates))[:min(samples, len(candidates))] {
+		e := candidates[i]
+		if err := vecs.VerifyEventDAG(e, getChildren); err != nil {
+			return &VectorInconsistency{
+				Event: e.ID(),
+				Err:   err,
+			}
+		}
+	}
+	return nil
+}
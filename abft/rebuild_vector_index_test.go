@@ -0,0 +1,155 @@
+package abft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/utils/adapters"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+func TestRebuildVectorIndex(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	input := NewEventStore()
+	crit := func(err error) {
+		panic(err)
+	}
+
+	db := memorydb.New()
+	expected := vecfc.NewIndex(crit, vecfc.LiteConfig())
+	expected.Reset(validators, flushable.Wrap(db), input.GetEvent)
+	ids := hash.Events{}
+	tdag.ForEachRandFork(nodes, nodes[:2], 100, 4, 10, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			ids = append(ids, e.ID())
+			require.NoError(expected.Add(e))
+			expected.Flush()
+		},
+	})
+	// corrupt the index
+	it := db.NewIterator(nil, nil)
+	i := 0
+	for it.Next() {
+		if i%2 == 0 {
+			require.NoError(db.Delete(it.Key()))
+		} else {
+			require.NoError(db.Put(it.Key(), []byte{0xff}))
+		}
+		i++
+	}
+	it.Release()
+	require.NoError(db.Put([]byte("garbage"), []byte{1}))
+
+	cfg := DefaultRebuildConfig()
+	cfg.Index = vecfc.LiteConfig()
+	cfg.VerifySamples = len(ids)
+	progress := 0
+	cfg.Progress = func(indexed, total int) {
+		require.Equal(progress+1, indexed)
+		require.Equal(len(ids), total)
+		progress = indexed
+	}
+	// events order doesn't matter
+	reversed := make(hash.Events, len(ids))
+	for i, id := range ids {
+		reversed[len(ids)-1-i] = id
+	}
+	require.NoError(RebuildVectorIndex(db, validators, input, reversed, cfg))
+	require.Equal(len(ids), progress)
+
+	has, err := db.Has([]byte("garbage"))
+	require.NoError(err)
+	require.False(has)
+
+	rebuilt := vecfc.NewIndex(crit, vecfc.LiteConfig())
+	rebuilt.Reset(validators, flushable.Wrap(db), input.GetEvent)
+	// branch IDs of forks depend on the processing order, so only the forkless cause relation is the same
+	for _, a := range ids {
+		for _, b := range ids[:50] {
+			require.Equal(expected.ForklessCause(a, b), rebuilt.ForklessCause(a, b))
+		}
+	}
+
+	// missing events
+	cfg.Progress = nil
+	err = RebuildVectorIndex(db, validators, input, append(ids[:0:0], ids[1:]...), cfg)
+	require.True(errors.Is(err, ErrEventNotFound), err)
+	err = RebuildVectorIndex(db, validators, input, append(ids[:0:0], hash.FakeEvent()), cfg)
+	require.True(errors.Is(err, ErrEventNotFound), err)
+}
+
+func TestRebuildVectorIndex_Store(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	lch, prevStore, input, dagIndexer := NewCoreLachesis(nodes, nil)
+	ids := hash.Events{}
+	tdag.ForEachRandEvent(nodes, 50, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			ids = append(ids, e.ID())
+			require.NoError(lch.Process(e))
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetEpoch(FirstEpoch)
+			return lch.Build(e)
+		},
+	})
+	require.Equal(FirstEpoch, prevStore.GetEpoch())
+	expected := map[hash.Event]*vecfc.HighestBeforeSeq{}
+	for _, id := range ids {
+		expected[id] = dagIndexer.GetHighestBefore(id)
+	}
+
+	// restart with a corrupted index
+	store := NewMemStore()
+	copyDB(t, prevStore.mainDB, store.mainDB)
+	epochDB := memorydb.New()
+	copyDB(t, prevStore.epochDB, epochDB)
+	require.NoError(epochDB.Put(append([]byte("vS"), ids[0].Bytes()...), []byte{0xff}))
+	store.getEpochDB = func(epoch idx.Epoch) kvdb.Store {
+		return epochDB
+	}
+
+	// rebuild before Bootstrap
+	cfg := DefaultRebuildConfig()
+	cfg.Index = vecfc.LiteConfig()
+	require.NoError(store.RebuildVectorIndex(input, ids, cfg))
+
+	restoredIndexer := &adapters.VectorToDagIndexer{Index: vecfc.NewIndex(lch.crit, vecfc.LiteConfig())}
+	restored := NewIndexedLachesisV2(store, lch.input, restoredIndexer, lch.crit, lch.config)
+	require.NoError(restored.Bootstrap(lch.callback))
+	for _, id := range ids {
+		require.Equal(expected[id], restoredIndexer.GetHighestBefore(id))
+	}
+
+	// verification detects wrong vectors
+	require.NoError(store.epochTable.VectorIndex.Put(append([]byte("s"), ids[0].Bytes()...), *vecfc.NewLowestAfterSeq(5)))
+	rebuilt := vecfc.NewIndex(lch.crit, vecfc.LiteConfig())
+	rebuilt.Reset(store.GetValidators(), flushable.Wrap(store.epochTable.VectorIndex), input.GetEvent)
+	events, err := WrapEventSource(input).GetEvents(ids)
+	require.NoError(err)
+	require.Error(verifyVectors(rebuilt, events, events, len(events)))
+}
+
+func copyDB(t *testing.T, from, to kvdb.Store) {
+	it := from.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		require.NoError(t, to.Put(it.Key(), it.Value()))
+	}
+}
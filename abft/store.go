@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/rlp"
 
+	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
 	"github.com/Fantom-foundation/lachesis-base/kvdb"
 	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
@@ -22,11 +23,13 @@ type Store struct {
 	table  struct {
 		LastDecidedState kvdb.Store `table:"c"`
 		EpochState       kvdb.Store `table:"e"`
+		AtroposChain     kvdb.Store `table:"a"`
 	}
 
 	cache struct {
 		LastDecidedState *LastDecidedState
 		EpochState       *EpochState
+		AtroposChainHead *hash.Hash
 		FrameRoots       *simplewlru.Cache `cache:"-"` // store by pointer
 	}
 
@@ -123,6 +126,14 @@ func (s *Store) openEpochDB(n idx.Epoch) error {
 	return nil
 }
 
+// loadEpochDB opens DB of the current epoch, unless it's already opened
+func (s *Store) loadEpochDB() error {
+	if s.epochDB != nil {
+		return nil
+	}
+	return s.openEpochDB(s.GetEpoch())
+}
+
 /*
  * Utils:
  */
@@ -0,0 +1,65 @@
+package abft
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
+)
+
+const achKey = "h"
+
+// AtroposChainHash calculates the next value of the Atropos hash chain.
+// The chain commits to every decided frame, so that two nodes which have the same chain head
+// have decided the same Atropos and the same cheaters for every frame.
+func AtroposChainHash(prev hash.Hash, epoch idx.Epoch, frame idx.Frame, atropos hash.Event, cheaters lachesis.Cheaters) hash.Hash {
+	data := make([][]byte, 0, 4+len(cheaters))
+	data = append(data, prev.Bytes(), epoch.Bytes(), frame.Bytes(), atropos.Bytes())
+	for _, cheater := range cheaters {
+		data = append(data, cheater.Bytes())
+	}
+	return hash.Of(data...)
+}
+
+func atroposChainKey(epoch idx.Epoch, frame idx.Frame) []byte {
+	return append(epoch.Bytes(), frame.Bytes()...)
+}
+
+// appendAtroposChain moves the Atropos hash chain head to the decided frame.
+func (s *Store) appendAtroposChain(epoch idx.Epoch, frame idx.Frame, atropos hash.Event, cheaters lachesis.Cheaters) hash.Hash {
+	head := AtroposChainHash(s.GetAtroposChainHead(), epoch, frame, atropos, cheaters)
+
+	if err := s.table.AtroposChain.Put(atroposChainKey(epoch, frame), head.Bytes()); err != nil {
+		s.crit(err)
+	}
+	if err := s.table.AtroposChain.Put([]byte(achKey), head.Bytes()); err != nil {
+		s.crit(err)
+	}
+	s.cache.AtroposChainHead = &head
+	return head
+}
+
+// GetAtroposChainHead returns the Atropos hash chain value of the last decided frame.
+// Nodes which agree on the decided history have the same value for the same decided frame.
+func (s *Store) GetAtroposChainHead() hash.Hash {
+	if s.cache.AtroposChainHead != nil {
+		return *s.cache.AtroposChainHead
+	}
+
+	buf, err := s.table.AtroposChain.Get([]byte(achKey))
+	if err != nil {
+		s.crit(err)
+	}
+	head := hash.BytesToHash(buf)
+	s.cache.AtroposChainHead = &head
+	return head
+}
+
+// GetAtroposChainHash returns the Atropos hash chain value of the specified decided frame.
+// Returns zero hash if frame wasn't decided on this node.
+func (s *Store) GetAtroposChainHash(epoch idx.Epoch, frame idx.Frame) hash.Hash {
+	buf, err := s.table.AtroposChain.Get(atroposChainKey(epoch, frame))
+	if err != nil {
+		s.crit(err)
+	}
+	return hash.BytesToHash(buf)
+}
@@ -0,0 +1,108 @@
+package abft
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/lachesis"
+)
+
+func TestAtroposChainHash(t *testing.T) {
+	require := require.New(t)
+
+	atropos := hash.FakeEvent()
+	base := AtroposChainHash(hash.Zero, 1, 1, atropos, nil)
+	require.NotEqual(hash.Zero, base)
+	require.Equal(base, AtroposChainHash(hash.Zero, 1, 1, atropos, lachesis.Cheaters{}))
+
+	require.NotEqual(base, AtroposChainHash(hash.Hash(hash.FakeHash(1)), 1, 1, atropos, nil))
+	require.NotEqual(base, AtroposChainHash(hash.Zero, 2, 1, atropos, nil))
+	require.NotEqual(base, AtroposChainHash(hash.Zero, 1, 2, atropos, nil))
+	require.NotEqual(base, AtroposChainHash(hash.Zero, 1, 1, hash.FakeEvent(), nil))
+	require.NotEqual(base, AtroposChainHash(hash.Zero, 1, 1, atropos, lachesis.Cheaters{1}))
+}
+
+func TestAtroposChainMatches(t *testing.T) {
+	require := require.New(t)
+
+	const lchCount = 3
+	weights := []pos.Weight{1, 2, 3, 4, 5}
+	nodes := tdag.GenNodes(len(weights))
+
+	lchs := make([]*CoreLachesis, 0, lchCount)
+	inputs := make([]*EventStore, 0, lchCount)
+	for i := 0; i < lchCount; i++ {
+		lch, _, input, _ := NewCoreLachesis(nodes, weights)
+		lch.applyBlock = func(block *lachesis.Block) *pos.Validators {
+			if lch.store.GetLastDecidedFrame()+1 == 10 {
+				return lch.store.GetValidators()
+			}
+			return nil
+		}
+		lchs = append(lchs, lch)
+		inputs = append(inputs, input)
+	}
+	require.Equal(hash.Zero, lchs[0].store.GetAtroposChainHead())
+
+	// create events on lch0
+	const epochs = 2
+	ordered := map[idx.Epoch]dag.Events{}
+	r := rand.New(rand.NewSource(0)) // nolint:gosec
+	for epoch := idx.Epoch(1); epoch <= epochs; epoch++ {
+		tdag.ForEachRandFork(nodes, nodes[:1], 200, 4, 10, r, tdag.ForEachEvent{
+			Process: func(e dag.Event, name string) {
+				ordered[epoch] = append(ordered[epoch], e)
+				inputs[0].SetEvent(e)
+				require.NoError(lchs[0].Process(e))
+			},
+			Build: func(e dag.MutableEvent, name string) error {
+				if epoch != lchs[0].store.GetEpoch() {
+					return errors.New("epoch already sealed, skip")
+				}
+				e.SetEpoch(epoch)
+				return lchs[0].Build(e)
+			},
+		})
+		require.Equal(epoch+1, lchs[0].store.GetEpoch())
+	}
+
+	// process the same events in a different order on other instances
+	for epoch := idx.Epoch(1); epoch <= epochs; epoch++ {
+		for i := 1; i < lchCount; i++ {
+			for _, e := range reorder(ordered[epoch]) {
+				inputs[i].SetEvent(e)
+				require.NoError(lchs[i].Process(e))
+				if lchs[i].store.GetEpoch() != epoch {
+					break
+				}
+			}
+		}
+	}
+
+	head := lchs[0].store.GetAtroposChainHead()
+	require.NotEqual(hash.Zero, head)
+	for i := 1; i < lchCount; i++ {
+		require.Equal(head, lchs[i].store.GetAtroposChainHead())
+		for key, block := range lchs[0].blocks {
+			expected := lchs[0].store.GetAtroposChainHash(key.Epoch, key.Frame)
+			require.NotEqual(hash.Zero, expected, "block %v", key)
+			require.Equal(expected, lchs[i].store.GetAtroposChainHash(key.Epoch, key.Frame), "block %v", key)
+			require.Equal(block.Atropos, lchs[i].blocks[key].Atropos, "block %v", key)
+		}
+	}
+
+	// chain head is the value of the last decided frame
+	last := lchs[0].lastBlock
+	require.Equal(head, lchs[0].store.GetAtroposChainHash(last.Epoch, last.Frame))
+	// chain head survives restart of the store cache
+	lchs[0].store.cache.AtroposChainHead = nil
+	require.Equal(head, lchs[0].store.GetAtroposChainHead())
+}
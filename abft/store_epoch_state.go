@@ -35,6 +35,10 @@ func (s *Store) getEpochState(key []byte) *EpochState {
 	if !exists {
 		return nil
 	}
+	// the rule isn't a part of the validators encoding
+	if w.QuorumRule != (pos.QuorumRule{}) {
+		w.Validators = w.Validators.WithQuorumRule(w.QuorumRule)
+	}
 	return w
 }
 
@@ -49,6 +49,10 @@ type CoreLachesis struct {
 
 // NewCoreLachesis creates empty abft consensus with mem store and optional node weights w.o. some callbacks usually instantiated by Client
 func NewCoreLachesis(nodes []idx.ValidatorID, weights []pos.Weight, mods ...memorydb.Mod) (*CoreLachesis, *Store, *EventStore, *adapters.VectorToDagIndexer) {
+	return newCoreLachesis(nodes, weights, vecfc.LiteConfig(), mods...)
+}
+
+func newCoreLachesis(nodes []idx.ValidatorID, weights []pos.Weight, indexConfig vecfc.IndexConfig, mods ...memorydb.Mod) (*CoreLachesis, *Store, *EventStore, *adapters.VectorToDagIndexer) {
 	validators := make(pos.ValidatorsBuilder, len(nodes))
 	for i, v := range nodes {
 		if weights == nil {
@@ -57,7 +61,10 @@ func NewCoreLachesis(nodes []idx.ValidatorID, weights []pos.Weight, mods ...memo
 			validators[v] = weights[i]
 		}
 	}
+	return newCoreLachesisWithValidators(validators.Build(), indexConfig, mods...)
+}
 
+func newCoreLachesisWithValidators(validators *pos.Validators, indexConfig vecfc.IndexConfig, mods ...memorydb.Mod) (*CoreLachesis, *Store, *EventStore, *adapters.VectorToDagIndexer) {
 	openEDB := func(epoch idx.Epoch) kvdb.Store {
 		return memorydb.New()
 	}
@@ -67,7 +74,7 @@ func NewCoreLachesis(nodes []idx.ValidatorID, weights []pos.Weight, mods ...memo
 	store := NewStore(memorydb.New(), openEDB, crit, LiteStoreConfig())
 
 	err := store.ApplyGenesis(&Genesis{
-		Validators: validators.Build(),
+		Validators: validators,
 		Epoch:      FirstEpoch,
 	})
 	if err != nil {
@@ -77,7 +84,7 @@ func NewCoreLachesis(nodes []idx.ValidatorID, weights []pos.Weight, mods ...memo
 	input := NewEventStore()
 
 	config := LiteConfig()
-	dagIndexer := &adapters.VectorToDagIndexer{Index: vecfc.NewIndex(crit, vecfc.LiteConfig())}
+	dagIndexer := &adapters.VectorToDagIndexer{Index: vecfc.NewIndex(crit, indexConfig)}
 	lch := NewIndexedLachesis(store, input, dagIndexer, crit, config)
 
 	extended := &CoreLachesis{
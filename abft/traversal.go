@@ -1,8 +1,6 @@
 package abft
 
 import (
-	"errors"
-
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag"
 )
@@ -11,16 +9,18 @@ type eventFilterFn func(event dag.Event) bool
 
 // dfsSubgraph iterates all the events which are observed by head, and accepted by a filter.
 // filter MAY BE called twice for the same event.
+// Parents of an accepted event are fetched in a single batch.
 func (p *Orderer) dfsSubgraph(head hash.Event, filter eventFilterFn) error {
-	stack := make(hash.EventsStack, 0, 300)
-
-	for pwalk := &head; pwalk != nil; pwalk = stack.Pop() {
-		walk := *pwalk
+	headEvent, err := p.input.GetEvent(head)
+	if err != nil {
+		return err
+	}
+	stack := make(dag.Events, 0, 300)
+	stack = append(stack, headEvent)
 
-		event := p.input.GetEvent(walk)
-		if event == nil {
-			return errors.New("event not found " + walk.String())
-		}
+	for len(stack) != 0 {
+		event := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
 
 		// filter
 		if !filter(event) {
@@ -28,9 +28,11 @@ func (p *Orderer) dfsSubgraph(head hash.Event, filter eventFilterFn) error {
 		}
 
 		// memorize parents
-		for _, parent := range event.Parents() {
-			stack.Push(parent)
+		parents, err := p.input.GetEvents(event.Parents())
+		if err != nil {
+			return err
 		}
+		stack = append(stack, parents...)
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package abft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+func TestVectorPruning(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	expected, _, input, _ := NewCoreLachesis(nodes, nil)
+	cfg := vecfc.LiteConfig()
+	cfg.Engine.PruneFrames = 3
+	pruning, store, pruningInput, dagIndexer := newCoreLachesis(nodes, nil, cfg)
+
+	ids := hash.Events{}
+	tdag.ForEachRandEvent(nodes, 200, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			pruningInput.SetEvent(e)
+			ids = append(ids, e.ID())
+			require.NoError(expected.Process(e))
+			require.NoError(pruning.Process(e))
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetEpoch(FirstEpoch)
+			return expected.Build(e)
+		},
+	})
+
+	require.Equal(FirstEpoch, store.GetEpoch())
+	require.Greater(store.GetLastDecidedFrame(), cfg.Engine.PruneFrames+1)
+	require.Equal(expected.blocks, pruning.blocks)
+
+	pruned := 0
+	for _, id := range ids {
+		if dagIndexer.GetHighestBefore(id) == nil {
+			pruned++
+		}
+	}
+	require.NotZero(pruned)
+}
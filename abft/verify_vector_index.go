@@ -0,0 +1,133 @@
+package abft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/utils/cachescale"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+// VerifyConfig is a config of the vector index verification.
+type VerifyConfig struct {
+	// Index must be the config which the vector index was built with
+	Index vecfc.IndexConfig
+	// FromLamport and ToLamport limit the range of verified events, 0 means unlimited
+	FromLamport idx.Lamport
+	ToLamport   idx.Lamport
+	// Samples is a number of randomly sampled events in the range whose stored vectors are compared with the vectors calculated from the DAG
+	Samples int
+	// Progress is called after each verified event with the number of verified and total events in the range, optional
+	Progress func(verified, total int)
+}
+
+// DefaultVerifyConfig returns default config of the vector index verification.
+func DefaultVerifyConfig() VerifyConfig {
+	return VerifyConfig{
+		Index:   vecfc.DefaultConfig(cachescale.Identity),
+		Samples: 100,
+	}
+}
+
+// VectorInconsistency describes the first event whose stored vectors or branch ID are inconsistent.
+type VectorInconsistency struct {
+	Event hash.Event
+	Err   error
+}
+
+func (v *VectorInconsistency) Error() string {
+	return fmt.Sprintf("event %s: %v", v.Event.String(), v.Err)
+}
+
+func (v *VectorInconsistency) Unwrap() error {
+	return v.Err
+}
+
+// VerifyVectorIndex verifies the vector index of the current epoch, see VerifyVectorIndex.
+// Must be called before Bootstrap, as DAG indexer keeps a state in memory. The epoch DB is opened if it isn't opened yet.
+func (s *Store) VerifyVectorIndex(input EventSource, ids hash.Events, cfg VerifyConfig) (*VectorInconsistency, error) {
+	if err := s.loadEpochDB(); err != nil {
+		return nil, err
+	}
+	return VerifyVectorIndex(s.epochTable.VectorIndex, s.GetValidators(), input, ids, cfg)
+}
+
+// VerifyVectorIndex recalculates the vectors and branch IDs of the epoch events in the configured range from their parents and children,
+// and compares them with the vectors stored in db. db is the vector index DB, i.e. the same DB which is passed to vecfc.Index.Reset.
+// Then the stored vectors of the randomly sampled events in the range are compared with the vectors calculated from the DAG directly.
+// ids must contain all the events of the epoch, in any order.
+// Returns the first inconsistent event in topological order, or the first inconsistent sampled event, or nil if the index is consistent.
+// An inconsistent index may be repaired with RebuildVectorIndex.
+func VerifyVectorIndex(db kvdb.Store, validators *pos.Validators, input EventSource, ids hash.Events, cfg VerifyConfig) (*VectorInconsistency, error) {
+	events, err := WrapEventSource(input).GetEvents(ids)
+	if err != nil {
+		return nil, err
+	}
+	sortTopologically(events)
+	eventsMap := make(map[hash.Event]dag.Event, len(events))
+	children := make(map[hash.Event]hash.Events, len(events))
+	inRange := make(dag.Events, 0, len(events))
+	for _, e := range events {
+		if !validators.Exists(e.Creator()) {
+			return nil, fmt.Errorf("event %s is created by %d, which isn't a validator", e.ID().String(), e.Creator())
+		}
+		eventsMap[e.ID()] = e
+		for _, p := range e.Parents() {
+			children[p] = append(children[p], e.ID())
+		}
+		if e.Lamport() >= cfg.FromLamport && (cfg.ToLamport == 0 || e.Lamport() <= cfg.ToLamport) {
+			inRange = append(inRange, e)
+		}
+	}
+
+	// read errors of corrupted data are reported as inconsistencies
+	var critErr error
+	crit := func(err error) {
+		if critErr == nil {
+			critErr = err
+		}
+	}
+	vecs := vecfc.NewIndex(crit, cfg.Index)
+	vecs.Reset(validators, flushable.Wrap(db), func(id hash.Event) dag.Event {
+		return eventsMap[id]
+	})
+	for i, e := range inRange {
+		for _, p := range e.Parents() {
+			if eventsMap[p] == nil {
+				return nil, fmt.Errorf("parent %s of event %s: %w", p.String(), e.ID().String(), ErrEventNotFound)
+			}
+		}
+		err := vecs.VerifyEvent(e, children[e.ID()])
+		if critErr != nil {
+			err = critErr
+		}
+		if err != nil {
+			return &VectorInconsistency{
+				Event: e.ID(),
+				Err:   err,
+			}, nil
+		}
+		if cfg.Progress != nil {
+			cfg.Progress(i+1, len(inRange))
+		}
+	}
+
+	err = verifyVectors(vecs, events, inRange, cfg.Samples)
+	if critErr != nil {
+		err = critErr
+	}
+	if err != nil {
+		var inconsistency *VectorInconsistency
+		if errors.As(err, &inconsistency) {
+			return inconsistency, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,226 @@
+package abft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/utils/adapters"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+	"github.com/Fantom-foundation/lachesis-base/vecfc"
+)
+
+func TestVerifyVectorIndex(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	input := NewEventStore()
+	crit := func(err error) {
+		panic(err)
+	}
+
+	db := memorydb.New()
+	vecs := vecfc.NewIndex(crit, compactIndexConfig())
+	vecs.Reset(validators, flushable.Wrap(db), input.GetEvent)
+	ordered := dag.Events{}
+	ids := hash.Events{}
+	tdag.ForEachRandFork(nodes, nodes[:2], 100, 4, 10, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			ordered = append(ordered, e)
+			ids = append(ids, e.ID())
+			require.NoError(vecs.Add(e))
+			vecs.Flush()
+		},
+	})
+
+	cfg := DefaultVerifyConfig()
+	cfg.Index = compactIndexConfig()
+	progress := 0
+	cfg.Progress = func(verified, total int) {
+		require.Equal(progress+1, verified)
+		require.Equal(len(ids), total)
+		progress = verified
+	}
+	inconsistency, err := VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.Nil(inconsistency)
+	require.Equal(len(ids), progress)
+	cfg.Progress = nil
+
+	// corrupt HighestBefore of an event
+	corrupted := ordered[len(ordered)/2]
+	before := vecs.GetHighestBefore(corrupted.ID())
+
+	// stored vectors of the sampled events are compared with the vectors calculated from the DAG
+	honest := validators.GetIdx(nodes[len(nodes)-1])
+	wrong := vecfc.NewHighestBeforeSeq(0)
+	*wrong = append(*wrong, *before...)
+	wrong.Set(honest, vecfc.BranchSeq{Seq: before.Get(honest).Seq + 1, MinSeq: 1})
+	require.NoError(db.Put(append([]byte("H"), corrupted.ID().Bytes()...), wrong.EncodeCompact()))
+	stored := vecfc.NewIndex(crit, compactIndexConfig())
+	stored.Reset(validators, flushable.Wrap(db), input.GetEvent)
+	err = verifyVectors(stored, ordered, dag.Events{corrupted}, 1)
+	require.True(errors.Is(err, vecengine.ErrInconsistentIndex), err)
+	var sampled *VectorInconsistency
+	require.True(errors.As(err, &sampled))
+	require.Equal(corrupted.ID(), sampled.Event)
+	require.NoError(verifyVectors(stored, ordered, ordered[:len(ordered)/2], len(ordered)))
+
+	wrong = vecfc.NewHighestBeforeSeq(0)
+	*wrong = append(*wrong, *before...)
+	wrong.Set(0, vecfc.BranchSeq{Seq: before.Get(0).Seq + 1, MinSeq: 1})
+	require.NoError(db.Put(append([]byte("H"), corrupted.ID().Bytes()...), wrong.EncodeCompact()))
+
+	inconsistency, err = VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.NotNil(inconsistency)
+	require.Equal(corrupted.ID(), inconsistency.Event)
+	require.True(errors.Is(inconsistency, vecengine.ErrInconsistentIndex), inconsistency)
+
+	// events out of the range aren't verified
+	cfg.ToLamport = corrupted.Lamport() - 1
+	inconsistency, err = VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.Nil(inconsistency)
+	cfg.ToLamport = 0
+	cfg.FromLamport = corrupted.Lamport() + 1
+	inconsistency, err = VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.True(inconsistency == nil || inconsistency.Event != corrupted.ID())
+	cfg.FromLamport = 0
+
+	// malformed vectors are reported
+	require.NoError(db.Put(append([]byte("H"), corrupted.ID().Bytes()...), []byte{0xff}))
+	inconsistency, err = VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.NotNil(inconsistency)
+	require.Equal(corrupted.ID(), inconsistency.Event)
+
+	// repair
+	require.NoError(RebuildVectorIndex(db, validators, input, ids, DefaultRebuildConfig()))
+	inconsistency, err = VerifyVectorIndex(db, validators, input, ids, cfg)
+	require.NoError(err)
+	require.Nil(inconsistency)
+
+	// missing events
+	_, err = VerifyVectorIndex(db, validators, input, append(ids[:0:0], hash.FakeEvent()), cfg)
+	require.True(errors.Is(err, ErrEventNotFound), err)
+}
+
+func TestVerifyVectorIndex_Store(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	lch, prevStore, input, _ := NewCoreLachesis(nodes, nil)
+	ids := hash.Events{}
+	tdag.ForEachRandEvent(nodes, 50, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			ids = append(ids, e.ID())
+			require.NoError(lch.Process(e))
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetEpoch(FirstEpoch)
+			return lch.Build(e)
+		},
+	})
+
+	// restart
+	store := NewMemStore()
+	copyDB(t, prevStore.mainDB, store.mainDB)
+	epochDB := memorydb.New()
+	copyDB(t, prevStore.epochDB, epochDB)
+	store.getEpochDB = func(epoch idx.Epoch) kvdb.Store {
+		return epochDB
+	}
+
+	// verify before Bootstrap
+	cfg := DefaultVerifyConfig()
+	cfg.Index = vecfc.LiteConfig()
+	cfg.Samples = len(ids)
+	inconsistency, err := store.VerifyVectorIndex(input, ids, cfg)
+	require.NoError(err)
+	require.Nil(inconsistency)
+
+	restored := NewIndexedLachesisV2(store, lch.input, &adapters.VectorToDagIndexer{Index: vecfc.NewIndex(lch.crit, vecfc.LiteConfig())}, lch.crit, lch.config)
+	require.NoError(restored.Bootstrap(lch.callback))
+	require.Equal(lch.store.GetLastDecidedFrame(), store.GetLastDecidedFrame())
+}
+
+func TestVerifyVectorIndex_Bounded(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	indexCfg := vecfc.LiteConfig()
+	indexCfg.Engine.MaxFrameDistance = 2
+	indexCfg.Engine.PruneFrames = 40
+	lch, store, input, dagIndexer := newCoreLachesis(nodes, nil, indexCfg)
+	ids := hash.Events{}
+	// the events of the last validator aren't observed by others for a while,
+	// so LowestAfter vectors of these events aren't updated by the events with a too high frame
+	hidden := nodes[len(nodes)-1]
+	tdag.ForEachRandEvent(nodes, 200, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			input.SetEvent(e)
+			ids = append(ids, e.ID())
+			require.NoError(lch.Process(e))
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetEpoch(FirstEpoch)
+			if e.Creator() != hidden && len(ids) > 700 && len(ids) < 850 {
+				parents := hash.Events{}
+				lamport := idx.Lamport(0)
+				for _, p := range e.Parents() {
+					parent := input.GetEvent(p)
+					if parent.Creator() == hidden {
+						continue
+					}
+					parents.Add(p)
+					if lamport < parent.Lamport() {
+						lamport = parent.Lamport()
+					}
+				}
+				e.SetParents(parents)
+				e.SetLamport(lamport + 1)
+			}
+			return lch.Build(e)
+		},
+	})
+	pruned := 0
+	for _, id := range ids {
+		if dagIndexer.GetHighestBefore(id) == nil {
+			pruned++
+		}
+	}
+	require.NotZero(pruned)
+
+	cfg := DefaultVerifyConfig()
+	cfg.Index = indexCfg
+	cfg.Samples = len(ids)
+	inconsistency, err := VerifyVectorIndex(store.epochTable.VectorIndex, store.GetValidators(), input, ids, cfg)
+	require.NoError(err)
+	require.Nil(inconsistency)
+
+	// the vectors don't match the unbounded config
+	cfg.Index = vecfc.LiteConfig()
+	inconsistency, err = VerifyVectorIndex(store.epochTable.VectorIndex, store.GetValidators(), input, ids, cfg)
+	require.NoError(err)
+	require.NotNil(inconsistency)
+}
+
+func compactIndexConfig() vecfc.IndexConfig {
+	cfg := vecfc.LiteConfig()
+	cfg.CompactVectors = true
+	return cfg
+}
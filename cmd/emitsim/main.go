@@ -0,0 +1,247 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/Fantom-foundation/lachesis-base/abft/emissionsim"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+var (
+	ValidatorsFlag = cli.IntFlag{
+		Name:  "validators",
+		Usage: "Number of validators, ignored if --weights is set",
+		Value: 20,
+	}
+	WeightsFlag = cli.StringFlag{
+		Name:  "weights",
+		Usage: "Comma-separated weights of validators, e.g. 5,4,3,2,1",
+	}
+	StakeFlag = cli.StringFlag{
+		Name:  "stake",
+		Usage: "Stake distribution of validators if --weights isn't set: equal or mainnet",
+		Value: "mainnet",
+	}
+	LatencyFlag = cli.StringFlag{
+		Name:  "latency",
+		Usage: "Latency model: gaussian, mainnet or city",
+		Value: "gaussian",
+	}
+	LatencyMeanFlag = cli.Float64Flag{
+		Name:  "latency.mean",
+		Usage: "Mean latency of the gaussian model, in milliseconds",
+		Value: 100,
+	}
+	LatencyStdFlag = cli.Float64Flag{
+		Name:  "latency.std",
+		Usage: "Standard deviation of latency of the gaussian model, in milliseconds",
+		Value: 10,
+	}
+	FCParentsFlag = cli.IntSliceFlag{
+		Name:  "parents.fc",
+		Usage: "Max numbers of parents chosen by FCIndexer, including the self-parent. A simulation is run per each combination of parent counts",
+		Value: cli.NewIntSlice(12),
+	}
+	RandParentsFlag = cli.IntSliceFlag{
+		Name:  "parents.rand",
+		Usage: "Max numbers of parents chosen randomly. A simulation is run per each combination of parent counts",
+		Value: cli.NewIntSlice(0),
+	}
+	OfflineFlag = cli.BoolFlag{
+		Name:  "offline",
+		Usage: "Make the smallest validators offline, as long as the online validators have a quorum",
+	}
+	SeedFlag = cli.Int64Flag{
+		Name:  "seed",
+		Usage: "Seed of the simulation, the results are reproducible for the same seed and flags",
+	}
+	DurationFlag = cli.DurationFlag{
+		Name:  "duration",
+		Usage: "Simulated time",
+		Value: emissionsim.DefaultDuration,
+	}
+	FormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "Output format: json or csv",
+		Value: "json",
+	}
+	OutFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "Output file path, stdout by default",
+	}
+)
+
+// report is a result of a simulation with its parameters
+type report struct {
+	Latency     string `json:"latency"`
+	FCParents   int    `json:"fcParents"`
+	RandParents int    `json:"randParents"`
+	Seed        int64  `json:"seed"`
+	*emissionsim.Result
+}
+
+func main() {
+	app := &cli.App{
+		Name:        "Emission Simulator",
+		Description: "Simulates events emission over a network with latencies, and reports time to finality and DAG efficiency",
+		Copyright:   "(c) 2024 Fantom Foundation",
+		Flags: []cli.Flag{&ValidatorsFlag, &WeightsFlag, &StakeFlag, &LatencyFlag, &LatencyMeanFlag, &LatencyStdFlag,
+			&FCParentsFlag, &RandParentsFlag, &OfflineFlag, &SeedFlag, &DurationFlag, &FormatFlag, &OutFlag},
+		Action: run,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func parseWeights(ctx *cli.Context) ([]pos.Weight, error) {
+	if s := ctx.String(WeightsFlag.Name); s != "" {
+		var weights []pos.Weight
+		for _, w := range strings.Split(s, ",") {
+			v, err := strconv.ParseUint(strings.TrimSpace(w), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight %q: %w", w, err)
+			}
+			weights = append(weights, pos.Weight(v))
+		}
+		return weights, nil
+	}
+
+	num := ctx.Int(ValidatorsFlag.Name)
+	switch stake := ctx.String(StakeFlag.Name); stake {
+	case "equal":
+		weights := make([]pos.Weight, num)
+		for i := range weights {
+			weights[i] = 1
+		}
+		return weights, nil
+	case "mainnet":
+		return emissionsim.MainNetWeights(num, ctx.Int64(SeedFlag.Name)), nil
+	default:
+		return nil, fmt.Errorf("unknown stake distribution %q", stake)
+	}
+}
+
+func parseLatency(ctx *cli.Context, numValidators int) (emissionsim.Latency, error) {
+	switch latency := ctx.String(LatencyFlag.Name); latency {
+	case "gaussian":
+		return &emissionsim.GaussianLatency{
+			Mean: ctx.Float64(LatencyMeanFlag.Name),
+			Std:  ctx.Float64(LatencyStdFlag.Name),
+		}, nil
+	case "mainnet":
+		return &emissionsim.MainNetLatency{}, nil
+	case "city":
+		return emissionsim.NewCityLatency(numValidators, ctx.Int64(SeedFlag.Name)), nil
+	default:
+		return nil, fmt.Errorf("unknown latency model %q", latency)
+	}
+}
+
+func run(ctx *cli.Context) error {
+	weights, err := parseWeights(ctx)
+	if err != nil {
+		return err
+	}
+	latency, err := parseLatency(ctx, len(weights))
+	if err != nil {
+		return err
+	}
+	format := ctx.String(FormatFlag.Name)
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unknown output format %q", format)
+	}
+
+	var reports []report
+	for _, fcParents := range ctx.IntSlice(FCParentsFlag.Name) {
+		for _, randParents := range ctx.IntSlice(RandParentsFlag.Name) {
+			cfg := emissionsim.Config{
+				Weights:     weights,
+				FCParents:   fcParents,
+				RandParents: randParents,
+				Offline:     ctx.Bool(OfflineFlag.Name),
+				Latency:     latency,
+				Duration:    ctx.Duration(DurationFlag.Name),
+				Seed:        ctx.Int64(SeedFlag.Name),
+			}
+			res, err := emissionsim.Run(cfg)
+			if err != nil {
+				return err
+			}
+			reports = append(reports, report{
+				Latency:     ctx.String(LatencyFlag.Name),
+				FCParents:   fcParents,
+				RandParents: randParents,
+				Seed:        cfg.Seed,
+				Result:      res,
+			})
+		}
+	}
+
+	if path := ctx.String(OutFlag.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		if err := writeReports(f, format, reports); err != nil {
+			f.Close()
+			return err
+		}
+		// a failed close may leave the report truncated
+		return f.Close()
+	}
+	return writeReports(os.Stdout, format, reports)
+}
+
+func writeReports(out io.Writer, format string, reports []report) error {
+	if format == "csv" {
+		return writeCSV(out, reports)
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(reports)
+}
+
+func writeCSV(out io.Writer, reports []report) error {
+	w := csv.NewWriter(out)
+	err := w.Write([]string{"latency", "fcParents", "randParents", "seed", "validators", "onlineValidators", "durationMs",
+		"events", "maxFrame", "decidedFrames", "framesPerSecond", "eventsPerFrame", "eventRate", "ttfMeanMs", "ttfMedianMs", "ttfP95Ms"})
+	if err != nil {
+		return err
+	}
+	for _, r := range reports {
+		err := w.Write([]string{
+			r.Latency,
+			strconv.Itoa(r.FCParents),
+			strconv.Itoa(r.RandParents),
+			strconv.FormatInt(r.Seed, 10),
+			strconv.Itoa(r.Validators),
+			strconv.Itoa(r.OnlineValidators),
+			strconv.Itoa(r.DurationMs),
+			strconv.Itoa(r.Events),
+			strconv.FormatUint(uint64(r.MaxFrame), 10),
+			strconv.FormatUint(uint64(r.DecidedFrames), 10),
+			strconv.FormatFloat(r.FramesPerSecond, 'f', 4, 64),
+			strconv.FormatFloat(r.EventsPerFrame, 'f', 4, 64),
+			strconv.FormatFloat(r.EventRate, 'f', 4, 64),
+			strconv.FormatFloat(r.TTFMeanMs, 'f', 1, 64),
+			strconv.Itoa(r.TTFMedianMs),
+			strconv.Itoa(r.TTFP95Ms),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
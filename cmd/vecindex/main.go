@@ -0,0 +1,202 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/urfave/cli/v2"
+
+	"github.com/Fantom-foundation/lachesis-base/abft"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/pebble"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/table"
+)
+
+var (
+	EventsDbPathFlag = cli.StringFlag{
+		Name:     "events.db",
+		Usage:    "sqlite3 event db path",
+		Required: true,
+	}
+	EpochFlag = cli.UintFlag{
+		Name:     "epoch",
+		Usage:    "Epoch of the vector index",
+		Required: true,
+	}
+	VecDbPathFlag = cli.StringFlag{
+		Name:     "vec.db",
+		Usage:    "Pebble db path of the vector index",
+		Required: true,
+	}
+	VecTableFlag = cli.StringFlag{
+		Name:  "vec.table",
+		Usage: "Table prefix of the vector index within the db",
+		Value: "v",
+	}
+	VerifySamplesFlag = cli.IntFlag{
+		Name:  "verify.samples",
+		Usage: "Number of randomly sampled events whose vectors are verified against the DAG",
+		Value: abft.DefaultRebuildConfig().VerifySamples,
+	}
+	FromLamportFlag = cli.UintFlag{
+		Name:  "from.lamport",
+		Usage: "Lowest Lamport timestamp of the verified events",
+	}
+	ToLamportFlag = cli.UintFlag{
+		Name:  "to.lamport",
+		Usage: "Highest Lamport timestamp of the verified events, 0 means unlimited",
+	}
+	RepairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Rebuild the vector index if an inconsistency is found",
+	}
+)
+
+func main() {
+	app := &cli.App{
+		Name:        "Vector Index Tool",
+		Description: "Maintenance tool of the vector index of an epoch",
+		Copyright:   "(c) 2024 Fantom Foundation",
+		Commands: []*cli.Command{
+			{
+				Name:   "rebuild",
+				Usage:  "Drop and rebuild the vector index from the stored events",
+				Flags:  []cli.Flag{&EventsDbPathFlag, &EpochFlag, &VecDbPathFlag, &VecTableFlag, &VerifySamplesFlag},
+				Action: rebuild,
+			},
+			{
+				Name:   "fsck",
+				Usage:  "Recompute the vectors from the stored events and compare them with the vector index",
+				Flags:  []cli.Flag{&EventsDbPathFlag, &EpochFlag, &VecDbPathFlag, &VecTableFlag, &FromLamportFlag, &ToLamportFlag, &RepairFlag, &VerifySamplesFlag},
+				Action: fsck,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// openVecDB returns the vector index DB and the underlying DB to close
+func openVecDB(ctx *cli.Context) (kvdb.Store, kvdb.Store, error) {
+	db, err := pebble.New(ctx.String(VecDbPathFlag.Name), 64*1024*1024, 64, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if prefix := ctx.String(VecTableFlag.Name); prefix != "" {
+		return table.New(db, []byte(prefix)), db, nil
+	}
+	return db, db, nil
+}
+
+// epochEvents is the input of the vector index of an epoch
+type epochEvents struct {
+	epoch      idx.Epoch
+	validators *pos.Validators
+	events     *abft.EventStore
+	ids        hash.Events
+}
+
+func loadEpochEvents(ctx *cli.Context) (*epochEvents, error) {
+	conn, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", ctx.String(EventsDbPathFlag.Name)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.Ping(); err != nil {
+		return nil, err
+	}
+
+	epoch := idx.Epoch(ctx.Uint(EpochFlag.Name))
+	validators, events, ids, err := abft.LoadEpochEvents(conn, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if validators.Len() == 0 {
+		return nil, fmt.Errorf("no validators of epoch %d in event db", epoch)
+	}
+	return &epochEvents{
+		epoch:      epoch,
+		validators: validators,
+		events:     events,
+		ids:        ids,
+	}, nil
+}
+
+// progressLogger returns a progress callback which logs the progress periodically
+func progressLogger(action string) func(done, total int) {
+	start := time.Now()
+	lastLog := start
+	return func(done, total int) {
+		if time.Since(lastLog) >= 8*time.Second || done == total {
+			fmt.Printf("%s %d/%d events, elapsed %v\n", action, done, total, time.Since(start).Round(time.Millisecond))
+			lastLog = time.Now()
+		}
+	}
+}
+
+func rebuild(ctx *cli.Context) error {
+	input, err := loadEpochEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, closer, err := openVecDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	return rebuildVectorIndex(ctx, db, input)
+}
+
+func rebuildVectorIndex(ctx *cli.Context, db kvdb.Store, input *epochEvents) error {
+	cfg := abft.DefaultRebuildConfig()
+	cfg.VerifySamples = ctx.Int(VerifySamplesFlag.Name)
+	cfg.Progress = progressLogger("indexed")
+	if err := abft.RebuildVectorIndex(db, input.validators, input.events, input.ids, cfg); err != nil {
+		return err
+	}
+	fmt.Printf("vector index of epoch %d is rebuilt and verified\n", input.epoch)
+	return nil
+}
+
+func fsck(ctx *cli.Context) error {
+	input, err := loadEpochEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	db, closer, err := openVecDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	cfg := abft.DefaultVerifyConfig()
+	cfg.FromLamport = idx.Lamport(ctx.Uint(FromLamportFlag.Name))
+	cfg.ToLamport = idx.Lamport(ctx.Uint(ToLamportFlag.Name))
+	cfg.Samples = ctx.Int(VerifySamplesFlag.Name)
+	cfg.Progress = progressLogger("verified")
+	inconsistency, err := abft.VerifyVectorIndex(db, input.validators, input.events, input.ids, cfg)
+	if err != nil {
+		return err
+	}
+	if inconsistency == nil {
+		fmt.Printf("vector index of epoch %d is consistent\n", input.epoch)
+		return nil
+	}
+	fmt.Printf("vector index of epoch %d is inconsistent: %v\n", input.epoch, inconsistency)
+	if !ctx.Bool(RepairFlag.Name) {
+		return fmt.Errorf("inconsistent vector index, run with --%s to rebuild it", RepairFlag.Name)
+	}
+	return rebuildVectorIndex(ctx, db, input)
+}
@@ -0,0 +1,86 @@
+package ancestor
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// CheatersIndexer tracks the validators which are known to create forks.
+// A validator is a cheater if a fork is detected in the merged vector clock of any of the processed events.
+type CheatersIndexer struct {
+	dagi       DagIndexQ
+	validators *pos.Validators
+
+	cheaters []bool
+}
+
+func NewCheatersIndexer(validators *pos.Validators, dagi DagIndexQ) *CheatersIndexer {
+	return &CheatersIndexer{
+		dagi:       dagi,
+		validators: validators,
+		cheaters:   make([]bool, validators.Len()),
+	}
+}
+
+// ProcessEvent updates the known cheaters, the event must be already indexed by the vector clock
+func (h *CheatersIndexer) ProcessEvent(e dag.Event) {
+	vecClock := h.dagi.GetMergedHighestBefore(e.ID())
+	for validatorIdx := idx.Validator(0); validatorIdx < h.validators.Len(); validatorIdx++ {
+		if vecClock.Get(validatorIdx).IsForkDetected() {
+			h.cheaters[validatorIdx] = true
+		}
+	}
+}
+
+// IsCheater returns true if the validator is known to create forks
+func (h *CheatersIndexer) IsCheater(id idx.ValidatorID) bool {
+	if !h.validators.Exists(id) {
+		return false
+	}
+	return h.cheaters[h.validators.GetIdx(id)]
+}
+
+// Cheaters returns the known cheaters, in the validators order
+func (h *CheatersIndexer) Cheaters() []idx.ValidatorID {
+	cheaters := make([]idx.ValidatorID, 0)
+	for i, id := range h.validators.SortedIDs() {
+		if h.cheaters[i] {
+			cheaters = append(cheaters, id)
+		}
+	}
+	return cheaters
+}
+
+// SearchStrategy returns a strategy which deprioritizes the options created by the known cheaters:
+// the options are chosen by the base strategy among the honest ones, and among all of them only if there's no honest option
+func (h *CheatersIndexer) SearchStrategy(base SearchStrategy, getCreator func(hash.Event) idx.ValidatorID) SearchStrategy {
+	return &cheatersStrategy{
+		base: base,
+		isCheater: func(id hash.Event) bool {
+			return h.IsCheater(getCreator(id))
+		},
+	}
+}
+
+type cheatersStrategy struct {
+	base      SearchStrategy
+	isCheater func(hash.Event) bool
+}
+
+// Choose chooses the hash from the specified options
+func (st *cheatersStrategy) Choose(existing hash.Events, options hash.Events) int {
+	honest := make(hash.Events, 0, len(options))
+	honestIdxs := make([]int, 0, len(options))
+	for i, opt := range options {
+		if !st.isCheater(opt) {
+			honest = append(honest, opt)
+			honestIdxs = append(honestIdxs, i)
+		}
+	}
+	if len(honest) == 0 {
+		return st.base.Choose(existing, options)
+	}
+	return honestIdxs[st.base.Choose(existing, honest)]
+}
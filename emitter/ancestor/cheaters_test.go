@@ -0,0 +1,70 @@
+package ancestor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+func TestCheatersIndexer(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(4)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	vc := testVectorClock{}
+	newEvent := func(creator idx.ValidatorID, rID byte, forks ...idx.ValidatorID) dag.Event {
+		e := &tdag.TestEvent{}
+		e.SetCreator(creator)
+		e.SetID([24]byte{rID})
+		hb := make(testHighestBefore, validators.Len())
+		for _, fork := range forks {
+			hb[validators.GetIdx(fork)].fork = true
+		}
+		vc[e.ID()] = hb
+		return e
+	}
+	h := NewCheatersIndexer(validators, vc)
+
+	events := dag.Events{
+		newEvent(nodes[0], 1),
+		newEvent(nodes[1], 2),
+		newEvent(nodes[2], 3),
+		newEvent(nodes[3], 4),
+	}
+	for _, e := range events {
+		h.ProcessEvent(e)
+	}
+	require.Empty(h.Cheaters())
+
+	h.ProcessEvent(newEvent(nodes[0], 5, nodes[3], nodes[1]))
+	require.ElementsMatch([]idx.ValidatorID{nodes[1], nodes[3]}, h.Cheaters())
+	require.True(h.IsCheater(nodes[1]))
+	require.False(h.IsCheater(nodes[2]))
+	require.False(h.IsCheater(100))
+
+	// cheaters are chosen only if there's no other option
+	creators := map[hash.Event]idx.ValidatorID{}
+	for _, e := range events {
+		creators[e.ID()] = e.Creator()
+	}
+	preferCheaters := NewMetricStrategy(func(ids hash.Events) Metric {
+		creator := creators[ids[len(ids)-1]]
+		if h.IsCheater(creator) {
+			return 1 + Metric(validators.GetIdx(creator))
+		}
+		return 1
+	})
+	st := h.SearchStrategy(preferCheaters, func(id hash.Event) idx.ValidatorID {
+		return creators[id]
+	})
+	options := hash.Events{events[1].ID(), events[3].ID(), events[2].ID()}
+	require.Equal(2, st.Choose(nil, options))
+	require.NotEqual(2, preferCheaters.Choose(nil, options))
+	require.Equal(preferCheaters.Choose(nil, options[:2]), st.Choose(nil, options[:2]))
+}
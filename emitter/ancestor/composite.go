@@ -0,0 +1,72 @@
+package ancestor
+
+import (
+	"bytes"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+)
+
+// WeightedMetric is a metric and its weight in CompositeStrategy
+type WeightedMetric struct {
+	Fn     func(hash.Events) Metric
+	Weight float64
+}
+
+// CompositeStrategy chooses the option with the highest weighted sum of metrics.
+// Each metric is normalized into [0, 1] across the options, so metrics of different scales are comparable.
+// Ties are broken by a pseudo-random order, which is deterministic for the same existing parents.
+type CompositeStrategy struct {
+	metrics []WeightedMetric
+}
+
+// NewCompositeStrategy creates CompositeStrategy of the metrics
+func NewCompositeStrategy(metrics ...WeightedMetric) *CompositeStrategy {
+	return &CompositeStrategy{metrics}
+}
+
+// Choose chooses the hash from the specified options
+func (st *CompositeStrategy) Choose(existing hash.Events, options hash.Events) int {
+	scores := make([]float64, len(options))
+	values := make([]Metric, len(options))
+	for _, m := range st.metrics {
+		min, max := Metric(0), Metric(0)
+		for i, opt := range options {
+			values[i] = m.Fn(append(existing.Copy(), opt))
+			if i == 0 || values[i] < min {
+				min = values[i]
+			}
+			if i == 0 || values[i] > max {
+				max = values[i]
+			}
+		}
+		if min == max {
+			// metric doesn't distinguish the options
+			continue
+		}
+		for i := range options {
+			scores[i] += m.Weight * float64(values[i]-min) / float64(max-min)
+		}
+	}
+
+	var seed hash.Event
+	if len(existing) != 0 {
+		seed = existing[0]
+	}
+	best := 0
+	bestTie := tieBreaker(seed, options[0])
+	for i := 1; i < len(options); i++ {
+		if scores[i] < scores[best] {
+			continue
+		}
+		tie := tieBreaker(seed, options[i])
+		if scores[i] > scores[best] || bytes.Compare(tie.Bytes(), bestTie.Bytes()) < 0 {
+			best, bestTie = i, tie
+		}
+	}
+	return best
+}
+
+// tieBreaker returns a pseudo-random key of the option, which is deterministic for the seed
+func tieBreaker(seed hash.Event, option hash.Event) hash.Hash {
+	return hash.Of(seed.Bytes(), option.Bytes())
+}
@@ -0,0 +1,83 @@
+package ancestor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+)
+
+func TestCompositeStrategy(t *testing.T) {
+	require := require.New(t)
+
+	options := hash.FakeEvents(5)
+	metricOf := func(values map[hash.Event]Metric) func(hash.Events) Metric {
+		return func(ids hash.Events) Metric {
+			return values[ids[len(ids)-1]]
+		}
+	}
+	// large scale metric, prefers options[0]
+	large := metricOf(map[hash.Event]Metric{
+		options[0]: 1000000,
+		options[1]: 900000,
+	})
+	// small scale metric, prefers options[1]
+	small := metricOf(map[hash.Event]Metric{
+		options[0]: 1,
+		options[1]: 3,
+		options[2]: 2,
+	})
+	existing := hash.Events{hash.FakeEvent()}
+
+	st := NewCompositeStrategy(WeightedMetric{large, 1})
+	require.Equal(options[0], options[st.Choose(existing, options)])
+
+	// metrics are normalized, so the scale doesn't matter
+	st = NewCompositeStrategy(WeightedMetric{large, 1}, WeightedMetric{small, 1})
+	require.Equal(options[1], options[st.Choose(existing, options)])
+	st = NewCompositeStrategy(WeightedMetric{large, 10}, WeightedMetric{small, 1})
+	require.Equal(options[0], options[st.Choose(existing, options)])
+
+	// ties are broken deterministically, regardless of the options order
+	st = NewCompositeStrategy(WeightedMetric{metricOf(nil), 1})
+	chosen := options[st.Choose(existing, options)]
+	reversed := hash.Events{options[4], options[3], options[2], options[1], options[0]}
+	require.Equal(chosen, reversed[st.Choose(existing, reversed)])
+	chosenBy := map[hash.Event]bool{}
+	for i := 0; i < 20; i++ {
+		chosenBy[options[st.Choose(hash.FakeEvents(1), options)]] = true
+	}
+	require.Greater(len(chosenBy), 1)
+}
+
+func TestChooseParentsWithSizeLimit(t *testing.T) {
+	require := require.New(t)
+
+	existing := hash.FakeEvents(1)
+	options := hash.FakeEvents(4)
+	sizes := map[hash.Event]int{
+		existing[0]: 10,
+		options[0]:  50,
+		options[1]:  30,
+		options[2]:  20,
+		options[3]:  100,
+	}
+	getSize := func(id hash.Event) int {
+		return sizes[id]
+	}
+	// prefers the largest option
+	strategy := NewMetricStrategy(func(ids hash.Events) Metric {
+		return Metric(sizes[ids[len(ids)-1]])
+	})
+	strategies := []SearchStrategy{strategy, strategy, strategy}
+
+	require.Equal(hash.Events{existing[0], options[3], options[0], options[1]},
+		ChooseParentsWithSizeLimit(existing, options, strategies, getSize, 1000))
+	require.Equal(hash.Events{existing[0], options[0], options[1]},
+		ChooseParentsWithSizeLimit(existing, options, strategies, getSize, 99))
+	require.Equal(hash.Events{existing[0], options[0], options[2]},
+		ChooseParentsWithSizeLimit(existing, options, strategies, getSize, 80))
+	require.Equal(hash.Events{existing[0]},
+		ChooseParentsWithSizeLimit(existing, options, strategies, getSize, 29))
+}
@@ -2,14 +2,12 @@ package ancestor
 
 import (
 	"math"
-	"sort"
 
 	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/inter/dag"
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
 	"github.com/Fantom-foundation/lachesis-base/inter/pos"
-	"github.com/Fantom-foundation/lachesis-base/utils/wmedian"
 )
 
 type DagIndexQ interface {
@@ -24,22 +22,27 @@ type QuorumIndexer struct {
 	globalMatrix     Matrix
 	selfParentSeqs   []idx.Event
 	globalMedianSeqs []idx.Event
-	dirty            bool
+	medians          []*seqMedian
 	searchStrategy   SearchStrategy
 
 	diffMetricFn DiffMetricFn
 }
 
 func NewQuorumIndexer(validators *pos.Validators, dagi DagIndexQ, diffMetricFn DiffMetricFn) *QuorumIndexer {
-	return &QuorumIndexer{
+	h := &QuorumIndexer{
 		globalMatrix:     NewMatrix(validators.Len(), validators.Len()),
 		globalMedianSeqs: make([]idx.Event, validators.Len()),
 		selfParentSeqs:   make([]idx.Event, validators.Len()),
+		medians:          make([]*seqMedian, validators.Len()),
 		dagi:             dagi,
 		validators:       validators,
 		diffMetricFn:     diffMetricFn,
-		dirty:            true,
 	}
+	for validatorIdx := idx.Validator(0); validatorIdx < validators.Len(); validatorIdx++ {
+		h.medians[validatorIdx] = newSeqMedian(h.globalMatrix.Row(validatorIdx), validators.SortedWeights(), validators.Quorum())
+	}
+	h.searchStrategy = NewMetricStrategy(h.GetMetricOf)
+	return h
 }
 
 type Matrix struct {
@@ -74,54 +77,21 @@ func seqOf(seq dagidx.Seq) idx.Event {
 	return seq.Seq()
 }
 
-type weightedSeq struct {
-	seq    idx.Event
-	weight pos.Weight
-}
-
-func (ws weightedSeq) Weight() pos.Weight {
-	return ws.weight
-}
-
 func (h *QuorumIndexer) ProcessEvent(event dag.Event, selfEvent bool) {
 	vecClock := h.dagi.GetMergedHighestBefore(event.ID())
 	creatorIdx := h.validators.GetIdx(event.Creator())
-	// update global matrix
+	// update global matrix and median seqs, only the creator's column is changed
 	for validatorIdx := idx.Validator(0); validatorIdx < h.validators.Len(); validatorIdx++ {
 		seq := seqOf(vecClock.Get(validatorIdx))
-		h.globalMatrix.Row(validatorIdx)[creatorIdx] = seq
+		h.medians[validatorIdx].Update(creatorIdx, seq)
+		h.globalMedianSeqs[validatorIdx] = h.medians[validatorIdx].Median()
 		if selfEvent {
 			h.selfParentSeqs[validatorIdx] = seq
 		}
 	}
-	h.dirty = true
-}
-
-func (h *QuorumIndexer) recacheState() {
-	// update median seqs
-	for validatorIdx := idx.Validator(0); validatorIdx < h.validators.Len(); validatorIdx++ {
-		pairs := make([]wmedian.WeightedValue, h.validators.Len())
-		for i := range pairs {
-			pairs[i] = weightedSeq{
-				seq:    h.globalMatrix.Row(validatorIdx)[i],
-				weight: h.validators.GetWeightByIdx(idx.Validator(i)),
-			}
-		}
-		sort.Slice(pairs, func(i, j int) bool {
-			a, b := pairs[i].(weightedSeq), pairs[j].(weightedSeq)
-			return a.seq > b.seq
-		})
-		median := wmedian.Of(pairs, h.validators.Quorum())
-		h.globalMedianSeqs[validatorIdx] = median.(weightedSeq).seq
-	}
-	h.searchStrategy = NewMetricStrategy(h.GetMetricOf)
-	h.dirty = false
 }
 
 func (h *QuorumIndexer) GetMetricOf(parents hash.Events) Metric {
-	if h.dirty {
-		h.recacheState()
-	}
 	vecClock := make([]dagidx.HighestBeforeSeq, len(parents))
 	for i, parent := range parents {
 		vecClock[i] = h.dagi.GetMergedHighestBefore(parent)
@@ -144,16 +114,10 @@ func (h *QuorumIndexer) GetMetricOf(parents hash.Events) Metric {
 }
 
 func (h *QuorumIndexer) SearchStrategy() SearchStrategy {
-	if h.dirty {
-		h.recacheState()
-	}
 	return h.searchStrategy
 }
 
 func (h *QuorumIndexer) GetGlobalMedianSeqs() []idx.Event {
-	if h.dirty {
-		h.recacheState()
-	}
 	return h.globalMedianSeqs
 }
 
@@ -0,0 +1,167 @@
+package ancestor
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/abft/dagidx"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/utils/wmedian"
+)
+
+type testSeq struct {
+	seq  idx.Event
+	fork bool
+}
+
+func (s testSeq) Seq() idx.Event {
+	return s.seq
+}
+
+func (s testSeq) IsForkDetected() bool {
+	return s.fork
+}
+
+type testHighestBefore []testSeq
+
+func (hb testHighestBefore) Size() int {
+	return len(hb)
+}
+
+func (hb testHighestBefore) Get(i idx.Validator) dagidx.Seq {
+	return hb[i]
+}
+
+type testVectorClock map[hash.Event]testHighestBefore
+
+func (vc testVectorClock) GetMergedHighestBefore(id hash.Event) dagidx.HighestBeforeSeq {
+	return vc[id]
+}
+
+type weightedSeq struct {
+	seq    idx.Event
+	weight pos.Weight
+}
+
+func (ws weightedSeq) Weight() pos.Weight {
+	return ws.weight
+}
+
+// naiveMedianSeqs calculates median seqs by sorting every row of the matrix
+func naiveMedianSeqs(validators *pos.Validators, matrix Matrix) []idx.Event {
+	medians := make([]idx.Event, validators.Len())
+	for validatorIdx := idx.Validator(0); validatorIdx < validators.Len(); validatorIdx++ {
+		pairs := make([]wmedian.WeightedValue, validators.Len())
+		for i := range pairs {
+			pairs[i] = weightedSeq{
+				seq:    matrix.Row(validatorIdx)[i],
+				weight: validators.GetWeightByIdx(idx.Validator(i)),
+			}
+		}
+		sort.Slice(pairs, func(i, j int) bool {
+			a, b := pairs[i].(weightedSeq), pairs[j].(weightedSeq)
+			return a.seq > b.seq
+		})
+		median := wmedian.Of(pairs, validators.Quorum())
+		medians[validatorIdx] = median.(weightedSeq).seq
+	}
+	return medians
+}
+
+// genQuorumEvents generates events with random vector clocks, views of validators may go back and detect forks
+func genQuorumEvents(r *rand.Rand, validators *pos.Validators, num int) (dag.Events, testVectorClock) {
+	ids := validators.SortedIDs()
+	vc := testVectorClock{}
+	events := make(dag.Events, num)
+	latest := make([]idx.Event, validators.Len())
+	for i := range events {
+		creatorIdx := idx.Validator(r.Intn(len(ids)))
+		latest[creatorIdx]++
+		e := &tdag.TestEvent{}
+		e.SetCreator(ids[creatorIdx])
+		e.SetSeq(latest[creatorIdx])
+		e.SetID([24]byte{byte(i), byte(i >> 8), byte(i >> 16)})
+		hb := make(testHighestBefore, len(ids))
+		for v := range hb {
+			lag := idx.Event(r.Intn(4))
+			if lag < latest[v] {
+				hb[v].seq = latest[v] - lag
+			}
+			hb[v].fork = r.Intn(100) == 0
+		}
+		vc[e.ID()] = hb
+		events[i] = e
+	}
+	return events, vc
+}
+
+func TestQuorumIndexer_Medians(t *testing.T) {
+	for _, weights := range [][]pos.Weight{
+		{1},
+		{1, 1, 1, 1},
+		{5, 4, 3, 2, 1},
+		{100, 1, 1, 1, 1, 1},
+		{1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2},
+	} {
+		t.Run(fmt.Sprint(weights), func(t *testing.T) {
+			require := require.New(t)
+			r := rand.New(rand.NewSource(int64(len(weights))))
+			validators := pos.ArrayToValidators(tdag.GenNodes(len(weights)), weights)
+			events, vc := genQuorumEvents(r, validators, 1000)
+
+			h := NewQuorumIndexer(validators, vc, func(median, current, update idx.Event, validatorIdx idx.Validator) Metric {
+				return 0
+			})
+			require.Equal(naiveMedianSeqs(validators, h.GetGlobalMatrix()), h.GetGlobalMedianSeqs())
+			for _, e := range events {
+				h.ProcessEvent(e, r.Intn(2) == 0)
+				require.Equal(naiveMedianSeqs(validators, h.GetGlobalMatrix()), h.GetGlobalMedianSeqs())
+			}
+		})
+	}
+}
+
+func BenchmarkQuorumIndexer_ProcessEvent(b *testing.B) {
+	for _, n := range []int{10, 100, 300} {
+		r := rand.New(rand.NewSource(0))
+		weights := make([]pos.Weight, n)
+		for i := range weights {
+			weights[i] = pos.Weight(1 + r.Intn(100))
+		}
+		validators := pos.ArrayToValidators(tdag.GenNodes(n), weights)
+		events, vc := genQuorumEvents(r, validators, 1000)
+		diffMetricFn := func(median, current, update idx.Event, validatorIdx idx.Validator) Metric {
+			return 0
+		}
+
+		b.Run(fmt.Sprintf("incremental/n=%d", n), func(b *testing.B) {
+			h := NewQuorumIndexer(validators, vc, diffMetricFn)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				h.ProcessEvent(events[i%len(events)], false)
+				_ = h.GetGlobalMedianSeqs()
+			}
+		})
+		b.Run(fmt.Sprintf("naive/n=%d", n), func(b *testing.B) {
+			matrix := NewMatrix(validators.Len(), validators.Len())
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				e := events[i%len(events)]
+				hb := vc[e.ID()]
+				creatorIdx := validators.GetIdx(e.Creator())
+				for validatorIdx := idx.Validator(0); validatorIdx < validators.Len(); validatorIdx++ {
+					matrix.Row(validatorIdx)[creatorIdx] = seqOf(hb.Get(validatorIdx))
+				}
+				_ = naiveMedianSeqs(validators, matrix)
+			}
+		})
+	}
+}
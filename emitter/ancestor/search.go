@@ -30,3 +30,37 @@ func ChooseParents(existingParents hash.Events, options hash.Events, strategies
 
 	return parents
 }
+
+// ChooseParentsWithSizeLimit is the same as ChooseParents, but the total size of parents (including the existing ones) doesn't exceed maxSize.
+// On each step, the options which don't fit into the remaining size are skipped.
+func ChooseParentsWithSizeLimit(existingParents hash.Events, options hash.Events, strategies []SearchStrategy, getSize func(hash.Event) int, maxSize int) hash.Events {
+	optionsSet := options.Set()
+	parents := make(hash.Events, 0, len(strategies)+len(existingParents))
+	parents = append(parents, existingParents...)
+	size := 0
+	for _, p := range existingParents {
+		optionsSet.Erase(p)
+		size += getSize(p)
+	}
+
+	for i := 0; i < len(strategies) && len(optionsSet) > 0; i++ {
+		curOptions := make(hash.Events, 0, len(optionsSet))
+		for opt := range optionsSet {
+			if size+getSize(opt) > maxSize {
+				// it won't fit on the next steps either
+				optionsSet.Erase(opt)
+				continue
+			}
+			curOptions = append(curOptions, opt)
+		}
+		if len(curOptions) == 0 {
+			break
+		}
+		best := strategies[i].Choose(parents, curOptions)
+		parents = append(parents, curOptions[best])
+		optionsSet.Erase(curOptions[best])
+		size += getSize(curOptions[best])
+	}
+
+	return parents
+}
@@ -0,0 +1,102 @@
+package ancestor
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+// seqMedian maintains the weighted median of a row of seqs, i.e. the highest seq which is reached by a quorum.
+// The row is kept in the order of seqs, so an update of a single seq costs O(distance it moves in the order)
+// instead of sorting the whole row.
+type seqMedian struct {
+	seqs    []idx.Event
+	weights []pos.Weight
+	quorum  pos.Weight
+
+	// order contains indexes of seqs, sorted by seq in descending order
+	order []idx.Validator
+	// positions is the inverse of order
+	positions []int
+	// median is the position in order of the median
+	median int
+	// prefixWeight is the total weight of order[:median+1]
+	prefixWeight pos.Weight
+}
+
+func newSeqMedian(seqs []idx.Event, weights []pos.Weight, quorum pos.Weight) *seqMedian {
+	m := &seqMedian{
+		seqs:      seqs,
+		weights:   weights,
+		quorum:    quorum,
+		order:     make([]idx.Validator, len(seqs)),
+		positions: make([]int, len(seqs)),
+		median:    -1,
+	}
+	for i := range m.order {
+		m.order[i] = idx.Validator(i)
+	}
+	// insertion sort, seqs are typically zero
+	for i := range m.order {
+		for j := i; j > 0 && m.seqs[m.order[j-1]] < m.seqs[m.order[j]]; j-- {
+			m.order[j-1], m.order[j] = m.order[j], m.order[j-1]
+		}
+	}
+	for p, i := range m.order {
+		m.positions[i] = p
+	}
+	m.adjust()
+	return m
+}
+
+// Update sets the seq of i-th index
+func (m *seqMedian) Update(i idx.Validator, seq idx.Event) {
+	prev := m.seqs[i]
+	if prev == seq {
+		return
+	}
+	m.seqs[i] = seq
+
+	// move i to its new position, shifting the indexes in between
+	from := m.positions[i]
+	to := from
+	if seq > prev {
+		for ; to > 0 && m.seqs[m.order[to-1]] < seq; to-- {
+			m.order[to] = m.order[to-1]
+			m.positions[m.order[to]] = to
+		}
+	} else {
+		for ; to < len(m.order)-1 && m.seqs[m.order[to+1]] > seq; to++ {
+			m.order[to] = m.order[to+1]
+			m.positions[m.order[to]] = to
+		}
+	}
+	m.order[to] = i
+	m.positions[i] = to
+
+	// update the weight of the prefix up to the median
+	if from > m.median && to <= m.median {
+		// the index at the median position is pushed out of the prefix
+		m.prefixWeight += m.weights[i] - m.weights[m.order[m.median+1]]
+	} else if from <= m.median && to > m.median {
+		// the next index after the median position is pulled into the prefix
+		m.prefixWeight += m.weights[m.order[m.median]] - m.weights[i]
+	}
+	m.adjust()
+}
+
+// adjust moves the median to the shortest prefix which has a quorum
+func (m *seqMedian) adjust() {
+	for m.median > 0 && m.prefixWeight-m.weights[m.order[m.median]] >= m.quorum {
+		m.prefixWeight -= m.weights[m.order[m.median]]
+		m.median--
+	}
+	for m.prefixWeight < m.quorum && m.median < len(m.order)-1 {
+		m.median++
+		m.prefixWeight += m.weights[m.order[m.median]]
+	}
+}
+
+// Median returns the highest seq which is reached by a quorum
+func (m *seqMedian) Median() idx.Event {
+	return m.seqs[m.order[m.median]]
+}
@@ -0,0 +1,56 @@
+package emitter
+
+import (
+	"time"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// Config of Emitter
+type Config struct {
+	// MinEmitInterval is the min time between self-events
+	MinEmitInterval time.Duration
+	// MaxEmitInterval is the max time between self-events, an event is emitted after it even if DAG doesn't progress
+	MaxEmitInterval time.Duration
+	// MaxParents is the max number of parents of an event, including the self-parent
+	MaxParents int
+	// MaxParentsSize is the max total size of parents, including the self-parent. Zero means no limit
+	MaxParentsSize int
+	// DoublesignProtection is the threshold of doublesign.SyncedToEmit
+	DoublesignProtection time.Duration
+	// MaxProcessAttempts is the max number of attempts to process a signed self-event, see Emitter.Emit.
+	// Values below 1 mean a single attempt
+	MaxProcessAttempts int
+	// ExcludeCheaters excludes heads of the validators which are known to create forks from the parents.
+	// Otherwise, such heads are only deprioritized, i.e. chosen only if there's no other option.
+	// It has effect only if the DAG index is ancestor.DagIndexQ. Disabled by default
+	ExcludeCheaters bool
+	// MaxParentFrameLag excludes the heads whose frame is lower than the highest frame of the heads by more than MaxParentFrameLag
+	// from the parents, the self-parent is always chosen. It must not exceed vecengine.Config.PruneFrames of the DAG index
+	// if pruning is enabled, as events which refer to such heads are rejected. Zero means no limit
+	MaxParentFrameLag idx.Frame
+}
+
+// DefaultConfig returns default emitter config
+func DefaultConfig() Config {
+	return Config{
+		MinEmitInterval:      200 * time.Millisecond,
+		MaxEmitInterval:      10 * time.Minute,
+		MaxParents:           10,
+		DoublesignProtection: 27 * time.Minute,
+		MaxProcessAttempts:   3,
+		ExcludeCheaters:      false,
+	}
+}
+
+// LiteConfig returns emitter config for tests
+func LiteConfig() Config {
+	return Config{
+		MinEmitInterval:      10 * time.Millisecond,
+		MaxEmitInterval:      time.Second,
+		MaxParents:           5,
+		DoublesignProtection: 0,
+		MaxProcessAttempts:   3,
+		ExcludeCheaters:      false,
+	}
+}
@@ -0,0 +1,159 @@
+package doublesign
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// InterchangeVersion is the version of the slashing-protection interchange format
+const InterchangeVersion = "1"
+
+type (
+	// Interchange is a format to migrate slashing-protection data between machines
+	Interchange struct {
+		Metadata InterchangeMetadata    `json:"metadata"`
+		Data     []InterchangeValidator `json:"data"`
+	}
+
+	// InterchangeMetadata describes the interchange data
+	InterchangeMetadata struct {
+		Version string `json:"interchangeFormatVersion"`
+	}
+
+	// InterchangeValidator contains signed self-events of a validator
+	InterchangeValidator struct {
+		Validator idx.ValidatorID    `json:"validator"`
+		Epochs    []InterchangeEpoch `json:"epochs"`
+	}
+
+	// InterchangeEpoch is the highest signed self-event in the epoch
+	InterchangeEpoch struct {
+		Epoch   idx.Epoch   `json:"epoch"`
+		Seq     idx.Event   `json:"seq"`
+		Lamport idx.Lamport `json:"lamport"`
+		// ID is omitted if unknown
+		ID *hash.Hash `json:"id,omitempty"`
+	}
+)
+
+// Export writes all the protection data in the interchange format
+func (p *Protection) Export(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	byValidator := map[idx.ValidatorID]*InterchangeValidator{}
+	it := p.db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		validator, epoch, err := parseProtectionKey(it.Key())
+		if err != nil {
+			return err
+		}
+		signed := &SignedEvent{}
+		if err := rlp.DecodeBytes(it.Value(), signed); err != nil {
+			return err
+		}
+		v := byValidator[validator]
+		if v == nil {
+			v = &InterchangeValidator{
+				Validator: validator,
+			}
+			byValidator[validator] = v
+		}
+		rec := InterchangeEpoch{
+			Epoch:   epoch,
+			Seq:     signed.Seq,
+			Lamport: signed.Lamport,
+		}
+		if !signed.ID.IsZero() {
+			id := hash.Hash(signed.ID)
+			rec.ID = &id
+		}
+		v.Epochs = append(v.Epochs, rec)
+	}
+	if it.Error() != nil {
+		return it.Error()
+	}
+
+	data := Interchange{
+		Metadata: InterchangeMetadata{
+			Version: InterchangeVersion,
+		},
+		Data: make([]InterchangeValidator, 0, len(byValidator)),
+	}
+	for _, v := range byValidator {
+		// keys are sorted by validator and epoch
+		data.Data = append(data.Data, *v)
+	}
+	sort.Slice(data.Data, func(i, j int) bool {
+		return data.Data[i].Validator < data.Data[j].Validator
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// Import merges the protection data in the interchange format into the DB.
+// The merged records are the highest of the existing and imported ones by both seq and lamport,
+// so no event which conflicts with either of them may be signed.
+func (p *Protection) Import(r io.Reader) error {
+	var data Interchange
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return err
+	}
+	if data.Metadata.Version != InterchangeVersion {
+		return fmt.Errorf("unsupported interchange format version %q", data.Metadata.Version)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, v := range data.Data {
+		for _, rec := range v.Epochs {
+			imported := &SignedEvent{
+				Seq:     rec.Seq,
+				Lamport: rec.Lamport,
+			}
+			if rec.ID != nil {
+				imported.ID = hash.Event(*rec.ID)
+			}
+			existing, err := p.get(v.Validator, rec.Epoch)
+			if err != nil {
+				return err
+			}
+			if err := p.set(v.Validator, rec.Epoch, mergeSigned(existing, imported)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeSigned returns a record which covers both of the records.
+// The ID is kept only if the merged record is one of the signed events, and no other event with the same seq was signed.
+func mergeSigned(a, b *SignedEvent) *SignedEvent {
+	if a == nil {
+		return b
+	}
+	merged := *a
+	if b.Seq > merged.Seq {
+		merged.Seq = b.Seq
+	}
+	if b.Lamport > merged.Lamport {
+		merged.Lamport = b.Lamport
+	}
+	merged.ID = hash.ZeroEvent
+	switch {
+	case merged.Seq == a.Seq && merged.Lamport == a.Lamport && (b.Seq != a.Seq || b.ID == a.ID):
+		merged.ID = a.ID
+	case merged.Seq == b.Seq && merged.Lamport == b.Lamport && b.Seq != a.Seq:
+		merged.ID = b.ID
+	}
+	return &merged
+}
@@ -0,0 +1,125 @@
+package doublesign
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/Fantom-foundation/lachesis-base/common/bigendian"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+)
+
+// ErrDoubleSign is returned if an event conflicts with an already signed self-event
+var ErrDoubleSign = errors.New("event conflicts with an already signed self-event")
+
+// SignedEvent is the highest self-event signed by a validator in an epoch
+type SignedEvent struct {
+	Seq     idx.Event
+	Lamport idx.Lamport
+	// ID is zero if unknown, e.g. if records with different IDs were merged on import
+	ID hash.Event
+}
+
+// Protection is a slashing-protection database of self-events.
+// It persists the highest signed self-event per validator and epoch, and refuses to sign events which conflict with it,
+// i.e. events which aren't higher by both seq and lamport, except for the same event.
+// Protection is safe for concurrent use.
+type Protection struct {
+	db kvdb.Store
+	mu sync.Mutex
+}
+
+// NewProtection creates Protection over the DB, which must be dedicated to the protection data
+func NewProtection(db kvdb.Store) *Protection {
+	return &Protection{
+		db: db,
+	}
+}
+
+func protectionKey(validator idx.ValidatorID, epoch idx.Epoch) []byte {
+	return append(validator.Bytes(), epoch.Bytes()...)
+}
+
+func parseProtectionKey(key []byte) (idx.ValidatorID, idx.Epoch, error) {
+	if len(key) != 8 {
+		return 0, 0, fmt.Errorf("wrong protection key length %d", len(key))
+	}
+	return idx.ValidatorID(bigendian.BytesToUint32(key[:4])), idx.Epoch(bigendian.BytesToUint32(key[4:])), nil
+}
+
+// Get returns the highest signed self-event of the validator in the epoch, or nil if there's none
+func (p *Protection) Get(validator idx.ValidatorID, epoch idx.Epoch) (*SignedEvent, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.get(validator, epoch)
+}
+
+func (p *Protection) get(validator idx.ValidatorID, epoch idx.Epoch) (*SignedEvent, error) {
+	b, err := p.db.Get(protectionKey(validator, epoch))
+	if err != nil || b == nil {
+		return nil, err
+	}
+	signed := &SignedEvent{}
+	if err := rlp.DecodeBytes(b, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func (p *Protection) set(validator idx.ValidatorID, epoch idx.Epoch, signed *SignedEvent) error {
+	b, err := rlp.EncodeToBytes(signed)
+	if err != nil {
+		return err
+	}
+	return p.db.Put(protectionKey(validator, epoch), b)
+}
+
+// Check returns ErrDoubleSign if the event conflicts with the already signed self-events.
+// It should be called before signing, the event ID may be not calculated yet.
+func (p *Protection) Check(e dag.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err := p.check(e)
+	return err
+}
+
+func (p *Protection) check(e dag.Event) (*SignedEvent, error) {
+	signed, err := p.get(e.Creator(), e.Epoch())
+	if err != nil || signed == nil {
+		return signed, err
+	}
+	if e.Seq() > signed.Seq && e.Lamport() > signed.Lamport {
+		return signed, nil
+	}
+	if e.Seq() == signed.Seq && e.Lamport() == signed.Lamport && e.ID() == signed.ID && !signed.ID.IsZero() {
+		// the same event
+		return signed, nil
+	}
+	return signed, fmt.Errorf("%w: validator=%d epoch=%d seq=%d lamport=%d, signed seq=%d lamport=%d",
+		ErrDoubleSign, e.Creator(), e.Epoch(), e.Seq(), e.Lamport(), signed.Seq, signed.Lamport)
+}
+
+// Record checks the signed event, and persists it as the highest signed self-event.
+// It must be called before the event is published.
+func (p *Protection) Record(e dag.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	signed, err := p.check(e)
+	if err != nil {
+		return err
+	}
+	if signed != nil && signed.Seq == e.Seq() {
+		// already recorded
+		return nil
+	}
+	return p.set(e.Creator(), e.Epoch(), &SignedEvent{
+		Seq:     e.Seq(),
+		Lamport: e.Lamport(),
+		ID:      e.ID(),
+	})
+}
@@ -0,0 +1,107 @@
+package doublesign
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+func fakeEvent(validator idx.ValidatorID, epoch idx.Epoch, seq idx.Event, lamport idx.Lamport, rID byte) dag.Event {
+	e := &dag.MutableBaseEvent{}
+	e.SetCreator(validator)
+	e.SetEpoch(epoch)
+	e.SetSeq(seq)
+	e.SetLamport(lamport)
+	e.SetID([24]byte{rID})
+	return &e.BaseEvent
+}
+
+func TestProtection(t *testing.T) {
+	require := require.New(t)
+	p := NewProtection(memorydb.New())
+
+	// nothing is signed yet
+	require.NoError(p.Check(fakeEvent(1, 1, 5, 5, 0)))
+	require.NoError(p.Record(fakeEvent(1, 1, 5, 5, 1)))
+
+	// the same event may be recorded again
+	require.NoError(p.Record(fakeEvent(1, 1, 5, 5, 1)))
+	// conflicting events
+	for _, e := range []dag.Event{
+		fakeEvent(1, 1, 5, 5, 2),
+		fakeEvent(1, 1, 5, 5, 0),
+		fakeEvent(1, 1, 4, 6, 0),
+		fakeEvent(1, 1, 6, 5, 0),
+		fakeEvent(1, 1, 6, 4, 0),
+	} {
+		require.True(errors.Is(p.Check(e), ErrDoubleSign))
+		require.True(errors.Is(p.Record(e), ErrDoubleSign))
+	}
+	// other validators and epochs aren't affected
+	require.NoError(p.Check(fakeEvent(2, 1, 1, 1, 0)))
+	require.NoError(p.Check(fakeEvent(1, 2, 1, 1, 0)))
+
+	require.NoError(p.Record(fakeEvent(1, 1, 6, 6, 3)))
+	signed, err := p.Get(1, 1)
+	require.NoError(err)
+	require.Equal(&SignedEvent{Seq: 6, Lamport: 6, ID: fakeEvent(1, 1, 6, 6, 3).ID()}, signed)
+	signed, err = p.Get(1, 2)
+	require.NoError(err)
+	require.Nil(signed)
+}
+
+func TestProtection_Interchange(t *testing.T) {
+	require := require.New(t)
+
+	src := NewProtection(memorydb.New())
+	require.NoError(src.Record(fakeEvent(1, 1, 5, 5, 1)))
+	require.NoError(src.Record(fakeEvent(1, 2, 3, 3, 1)))
+	require.NoError(src.Record(fakeEvent(2, 1, 7, 7, 1)))
+	require.NoError(src.Record(fakeEvent(3, 1, 2, 9, 1)))
+
+	dst := NewProtection(memorydb.New())
+	require.NoError(dst.Record(fakeEvent(1, 1, 6, 4, 2)))
+	require.NoError(dst.Record(fakeEvent(2, 1, 7, 7, 2)))
+	require.NoError(dst.Record(fakeEvent(3, 1, 4, 4, 2)))
+
+	buf := &bytes.Buffer{}
+	require.NoError(src.Export(buf))
+	exported := buf.String()
+	require.NoError(dst.Import(buf))
+
+	for _, c := range []struct {
+		validator idx.ValidatorID
+		epoch     idx.Epoch
+		exp       *SignedEvent
+	}{
+		// merged, neither of the events is known
+		{1, 1, &SignedEvent{Seq: 6, Lamport: 5}},
+		{1, 2, &SignedEvent{Seq: 3, Lamport: 3, ID: fakeEvent(1, 2, 3, 3, 1).ID()}},
+		// different events with the same seq
+		{2, 1, &SignedEvent{Seq: 7, Lamport: 7}},
+		{3, 1, &SignedEvent{Seq: 4, Lamport: 9}},
+	} {
+		signed, err := dst.Get(c.validator, c.epoch)
+		require.NoError(err)
+		require.Equal(c.exp, signed, c)
+	}
+	require.True(errors.Is(dst.Check(fakeEvent(2, 1, 7, 7, 1)), ErrDoubleSign))
+	require.True(errors.Is(dst.Check(fakeEvent(3, 1, 5, 9, 0)), ErrDoubleSign))
+	require.NoError(dst.Check(fakeEvent(3, 1, 5, 10, 0)))
+	require.NoError(dst.Check(fakeEvent(1, 2, 3, 3, 1)))
+
+	// export is deterministic, and import into an empty DB restores the data
+	restored := NewProtection(memorydb.New())
+	require.NoError(restored.Import(bytes.NewBufferString(exported)))
+	buf.Reset()
+	require.NoError(restored.Export(buf))
+	require.Equal(exported, buf.String())
+
+	require.Error(restored.Import(bytes.NewBufferString(`{"metadata":{"interchangeFormatVersion":"0"},"data":[]}`)))
+}
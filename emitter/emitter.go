@@ -0,0 +1,337 @@
+package emitter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Fantom-foundation/lachesis-base/emitter/ancestor"
+	"github.com/Fantom-foundation/lachesis-base/emitter/doublesign"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+)
+
+var (
+	// ErrNotValidator is returned if the emitter's validator isn't in the current validators group
+	ErrNotValidator = errors.New("not a validator in the current epoch")
+	// ErrNotReset is returned if Emitter is used before Reset
+	ErrNotReset = errors.New("emitter isn't reset for an epoch")
+)
+
+// HaltedError is returned by Emit if a signed self-event has failed to be processed Config.MaxProcessAttempts times.
+// No more self-events are emitted until the next epoch, as a new event with the same seq would be a double-sign.
+type HaltedError struct {
+	Event hash.Event
+	Err   error
+}
+
+func (e *HaltedError) Error() string {
+	return fmt.Sprintf("emission is halted until the next epoch, self-event %s failed to be processed: %v", e.Event.String(), e.Err)
+}
+
+func (e *HaltedError) Unwrap() error {
+	return e.Err
+}
+
+// World is an external world which the emitter depends on
+type World struct {
+	// NewEvent returns an empty app-specific event
+	NewEvent func() dag.MutableEvent
+	// Build fills consensus-related fields of the event, i.e. Frame. Typically, it's abft.Orderer.Build
+	Build func(e dag.MutableEvent) error
+	// BuildPayload fills app-specific payload of the event. Optional
+	BuildPayload func(e dag.MutableEvent) error
+	// Sign signs the event and sets its ID
+	Sign func(e dag.MutableEvent) error
+	// Process connects the emitted event into DAG and broadcasts it
+	Process func(e dag.Event) error
+
+	// SyncStatus returns the node status for doublesign.SyncedToEmit. Optional, the protection is disabled if nil
+	SyncStatus func() doublesign.SyncStatus
+	// Protection refuses to sign events which conflict with the already signed self-events. Optional
+	Protection *doublesign.Protection
+	// ParentStrategies returns strategies to choose parents, one strategy per each parent except of the self-parent.
+	// Optional, FCIndexer's strategy is used by default. Heads of the known cheaters are deprioritized by every strategy
+	ParentStrategies func(maxParents int) []ancestor.SearchStrategy
+}
+
+// Emitter creates self-events: it tracks DAG heads, decides when to emit, chooses parents and fills the event fields.
+// Emitter isn't safe for concurrent use.
+type Emitter struct {
+	cfg   Config
+	world World
+	me    idx.ValidatorID
+	dagi  ancestor.DagIndex
+
+	epoch      idx.Epoch
+	validators *pos.Validators
+	fc         *ancestor.FCIndexer
+	cheaters   *ancestor.CheatersIndexer
+
+	heads       map[hash.Event]dag.Event
+	selfParent  dag.Event
+	lastEmitted time.Time
+	// pending is the signed self-event which failed to be processed, it's resubmitted instead of creating a new one
+	pending  dag.Event
+	attempts int
+	halted   *HaltedError
+}
+
+// New creates Emitter instance for the validator.
+// Reset must be called before emitting.
+func New(cfg Config, world World, me idx.ValidatorID, dagi ancestor.DagIndex) *Emitter {
+	return &Emitter{
+		cfg:   cfg,
+		world: world,
+		me:    me,
+		dagi:  dagi,
+		heads: make(map[hash.Event]dag.Event),
+	}
+}
+
+// Reset prepares the emitter for a new epoch, erasing the tracked DAG state
+func (em *Emitter) Reset(epoch idx.Epoch, validators *pos.Validators) {
+	if epoch != em.epoch {
+		em.pending = nil
+		em.attempts = 0
+		em.halted = nil
+	}
+	em.epoch = epoch
+	em.validators = validators
+	em.fc = ancestor.NewFCIndexer(validators, em.dagi, em.me)
+	em.cheaters = nil
+	if vecClock, ok := em.dagi.(ancestor.DagIndexQ); ok {
+		em.cheaters = ancestor.NewCheatersIndexer(validators, vecClock)
+	}
+	em.heads = make(map[hash.Event]dag.Event)
+	em.selfParent = nil
+}
+
+// FCIndexer returns the FCIndexer of the current epoch
+func (em *Emitter) FCIndexer() *ancestor.FCIndexer {
+	return em.fc
+}
+
+// CheatersIndexer returns the CheatersIndexer of the current epoch, or nil if the DAG index isn't ancestor.DagIndexQ
+func (em *Emitter) CheatersIndexer() *ancestor.CheatersIndexer {
+	return em.cheaters
+}
+
+// Heads returns the current DAG heads
+func (em *Emitter) Heads() dag.Events {
+	heads := make(dag.Events, 0, len(em.heads))
+	for _, head := range em.heads {
+		heads = append(heads, head)
+	}
+	return heads
+}
+
+// ProcessEvent updates the emitter state with a new event.
+// It must be called for every event connected into DAG (after the DAG index is updated),
+// except of the events emitted by the Emitter, which are processed automatically.
+func (em *Emitter) ProcessEvent(e dag.Event) {
+	if em.validators == nil || e.Epoch() != em.epoch {
+		return
+	}
+	for _, p := range e.Parents() {
+		delete(em.heads, p)
+	}
+	em.heads[e.ID()] = e
+	em.fc.ProcessEvent(e)
+	if em.cheaters != nil {
+		em.cheaters.ProcessEvent(e)
+	}
+	// self-events may be created by a previous run of the node
+	if e.Creator() == em.me && (em.selfParent == nil || e.Seq() > em.selfParent.Seq()) {
+		em.selfParent = e
+	}
+	if em.pending != nil && e.Creator() == em.me && e.Seq() >= em.pending.Seq() {
+		em.pending = nil
+		em.attempts = 0
+	}
+}
+
+// ShouldEmit returns true if it's time to emit a new event.
+// An event is emitted not earlier than MinEmitInterval after the previous one, and when
+// the validators which exceeded the knowledge of the previous self-event have a quorum.
+// After MaxEmitInterval, an event is emitted regardless of the DAG progress.
+func (em *Emitter) ShouldEmit(now time.Time) bool {
+	if em.validators == nil || !em.validators.Exists(em.me) {
+		return false
+	}
+	passed := now.Sub(em.lastEmitted)
+	if passed < em.cfg.MinEmitInterval {
+		return false
+	}
+	if passed >= em.cfg.MaxEmitInterval || em.selfParent == nil {
+		return true
+	}
+	return em.fc.ValidatorsPastMe() >= em.validators.Quorum()
+}
+
+// Tick emits an event if ShouldEmit permits.
+// Returns nil event if it isn't time to emit. Errors of doublesign.SyncedToEmit mean that the emission is postponed.
+func (em *Emitter) Tick(now time.Time) (dag.Event, error) {
+	if !em.ShouldEmit(now) {
+		return nil, nil
+	}
+	return em.Emit(now)
+}
+
+// Emit creates, signs and processes a new self-event, regardless of the emission timing.
+// Errors of doublesign.SyncedToEmit mean that the emission is postponed, doublesign.ErrDoubleSign means that
+// the emitter is behind the self-events signed before, e.g. by another machine.
+// If the event fails to be processed, the same signed event is resubmitted by the next calls, up to Config.MaxProcessAttempts
+// attempts in total. After that, HaltedError is returned until the emitter is reset for the next epoch, so the operator
+// must wait for the next epoch if the error isn't transient.
+func (em *Emitter) Emit(now time.Time) (dag.Event, error) {
+	if em.validators == nil {
+		return nil, ErrNotReset
+	}
+	if !em.validators.Exists(em.me) {
+		return nil, ErrNotValidator
+	}
+	if em.world.SyncStatus != nil {
+		if _, err := doublesign.SyncedToEmit(em.world.SyncStatus(), em.cfg.DoublesignProtection); err != nil {
+			return nil, err
+		}
+	}
+
+	if em.halted != nil {
+		return nil, em.halted
+	}
+
+	e := em.pending
+	if e == nil {
+		created, err := em.createEvent()
+		if err != nil {
+			return nil, err
+		}
+		e = created
+	}
+	if err := em.world.Process(e); err != nil {
+		// the event is already recorded by the protection, so a new event with the same seq would be refused
+		em.pending = e
+		em.attempts++
+		if em.attempts >= em.cfg.MaxProcessAttempts {
+			em.pending = nil
+			em.halted = &HaltedError{Event: e.ID(), Err: err}
+			return nil, em.halted
+		}
+		return nil, err
+	}
+	em.pending = nil
+	em.attempts = 0
+	em.lastEmitted = now
+	em.ProcessEvent(e)
+	return e, nil
+}
+
+// createEvent fills the fields of a new self-event
+func (em *Emitter) createEvent() (dag.MutableEvent, error) {
+	e := em.world.NewEvent()
+	e.SetEpoch(em.epoch)
+	e.SetCreator(em.me)
+
+	existing := hash.Events{}
+	if em.selfParent != nil {
+		existing = append(existing, em.selfParent.ID())
+		e.SetSeq(em.selfParent.Seq() + 1)
+	} else {
+		e.SetSeq(1)
+	}
+	minFrame := idx.Frame(0)
+	if em.cfg.MaxParentFrameLag != 0 {
+		for _, head := range em.heads {
+			if head.Frame() > minFrame+em.cfg.MaxParentFrameLag {
+				minFrame = head.Frame() - em.cfg.MaxParentFrameLag
+			}
+		}
+	}
+	options := make(hash.Events, 0, len(em.heads))
+	for id, head := range em.heads {
+		if head.Creator() == em.me || head.Frame() < minFrame {
+			continue
+		}
+		if !(em.cfg.ExcludeCheaters && em.cheaters != nil && em.cheaters.IsCheater(head.Creator())) {
+			options = append(options, id)
+		}
+	}
+	var parents hash.Events
+	if em.cfg.MaxParentsSize != 0 {
+		getSize := func(id hash.Event) int {
+			return em.getParent(id).Size()
+		}
+		parents = ancestor.ChooseParentsWithSizeLimit(existing, options, em.parentStrategies(), getSize, em.cfg.MaxParentsSize)
+	} else {
+		parents = ancestor.ChooseParents(existing, options, em.parentStrategies())
+	}
+	e.SetParents(parents)
+
+	lamport := idx.Lamport(0)
+	for _, p := range parents {
+		if parent := em.getParent(p); lamport < parent.Lamport() {
+			lamport = parent.Lamport()
+		}
+	}
+	e.SetLamport(lamport + 1)
+
+	if err := em.world.Build(e); err != nil {
+		return nil, err
+	}
+	if em.world.BuildPayload != nil {
+		if err := em.world.BuildPayload(e); err != nil {
+			return nil, err
+		}
+	}
+	if em.world.Protection != nil {
+		if err := em.world.Protection.Check(e); err != nil {
+			return nil, err
+		}
+	}
+	if err := em.world.Sign(e); err != nil {
+		return nil, err
+	}
+	if em.world.Protection != nil {
+		if err := em.world.Protection.Record(e); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// getParent returns a head or the self-parent
+func (em *Emitter) getParent(id hash.Event) dag.Event {
+	if parent := em.heads[id]; parent != nil {
+		return parent
+	}
+	return em.selfParent
+}
+
+func (em *Emitter) parentStrategies() []ancestor.SearchStrategy {
+	if em.cfg.MaxParents <= 1 {
+		return nil
+	}
+	var strategies []ancestor.SearchStrategy
+	if em.world.ParentStrategies != nil {
+		strategies = em.world.ParentStrategies(em.cfg.MaxParents)
+	} else {
+		strategies = make([]ancestor.SearchStrategy, em.cfg.MaxParents-1)
+		for i := range strategies {
+			strategies[i] = em.fc.SearchStrategy()
+		}
+	}
+	if em.cheaters == nil {
+		return strategies
+	}
+	// heads of the known cheaters are chosen only if there's no other option
+	getCreator := func(id hash.Event) idx.ValidatorID {
+		return em.getParent(id).Creator()
+	}
+	for i, st := range strategies {
+		strategies[i] = em.cheaters.SearchStrategy(st, getCreator)
+	}
+	return strategies
+}
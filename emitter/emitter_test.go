@@ -0,0 +1,591 @@
+package emitter
+
+import (
+	"crypto/sha256"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/abft"
+	"github.com/Fantom-foundation/lachesis-base/emitter/ancestor"
+	"github.com/Fantom-foundation/lachesis-base/emitter/doublesign"
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+type testNode struct {
+	emitter *Emitter
+	lch     *abft.CoreLachesis
+	store   *abft.Store
+	input   *abft.EventStore
+}
+
+// testNetwork connects emitters of the validators, events are delivered instantly
+type testNetwork struct {
+	nodes   map[idx.ValidatorID]*testNode
+	emitted dag.Events
+}
+
+func newTestNetwork(validators *pos.Validators, cfg Config, mod func(*World)) *testNetwork {
+	net := &testNetwork{
+		nodes: make(map[idx.ValidatorID]*testNode),
+	}
+	for _, me := range validators.IDs() {
+		lch, store, input, dagIndexer := abft.NewCoreLachesis(validators.SortedIDs(), validators.SortedWeights())
+		node := &testNode{
+			lch:   lch,
+			store: store,
+			input: input,
+		}
+		world := World{
+			NewEvent: func() dag.MutableEvent {
+				return &tdag.TestEvent{}
+			},
+			Build: lch.Build,
+			Sign: func(e dag.MutableEvent) error {
+				var id [24]byte
+				h := sha256.Sum256(e.(*tdag.TestEvent).Bytes())
+				copy(id[:], h[:24])
+				e.SetID(id)
+				return nil
+			},
+			Process: func(e dag.Event) error {
+				input.SetEvent(e)
+				if err := lch.Process(e); err != nil {
+					return err
+				}
+				net.emitted = append(net.emitted, e)
+				return nil
+			},
+		}
+		if mod != nil {
+			mod(&world)
+		}
+		node.emitter = New(cfg, world, me, dagIndexer)
+		node.emitter.Reset(store.GetEpoch(), store.GetValidators())
+		net.nodes[me] = node
+	}
+	return net
+}
+
+// broadcast delivers the emitted events to other nodes
+func (net *testNetwork) broadcast(t *testing.T) {
+	for len(net.emitted) != 0 {
+		e := net.emitted[0]
+		net.emitted = net.emitted[1:]
+		for id, node := range net.nodes {
+			if id == e.Creator() {
+				continue
+			}
+			node.input.SetEvent(e)
+			require.NoError(t, node.lch.Process(e))
+			node.emitter.ProcessEvent(e)
+		}
+	}
+}
+
+func TestEmitter(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	validators := pos.ArrayToValidators(nodes, []pos.Weight{5, 4, 3, 2, 1})
+	cfg := LiteConfig()
+	net := newTestNetwork(validators, cfg, nil)
+
+	start := time.Unix(1000, 0)
+	emittedBy := map[idx.ValidatorID]dag.Events{}
+	for step := 0; step < 500; step++ {
+		now := start.Add(time.Duration(step) * time.Millisecond)
+		for _, id := range nodes {
+			e, err := net.nodes[id].emitter.Tick(now)
+			require.NoError(err)
+			if e != nil {
+				emittedBy[id] = append(emittedBy[id], e)
+				// MinEmitInterval isn't passed yet
+				e, err = net.nodes[id].emitter.Tick(now)
+				require.NoError(err)
+				require.Nil(e)
+			}
+			net.broadcast(t)
+		}
+	}
+
+	for _, id := range nodes {
+		events := emittedBy[id]
+		require.NotEmpty(events)
+		// no forks
+		for i, e := range events {
+			require.Equal(idx.Event(i+1), e.Seq())
+			require.LessOrEqual(len(e.Parents()), cfg.MaxParents)
+			if i == 0 {
+				require.Nil(e.SelfParent())
+			} else {
+				require.Equal(events[i-1].ID(), *e.SelfParent())
+				require.Greater(e.Lamport(), events[i-1].Lamport())
+			}
+		}
+		// consensus progresses
+		require.Greater(net.nodes[id].store.GetLastDecidedFrame(), idx.Frame(5))
+		require.Equal(net.nodes[nodes[0]].store.GetLastDecidedState(), net.nodes[id].store.GetLastDecidedState())
+	}
+}
+
+func TestEmitter_Timing(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(3)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	net := newTestNetwork(validators, cfg, nil)
+	em := net.nodes[nodes[0]].emitter
+
+	start := time.Unix(1000, 0)
+	// the first event is emitted at once
+	require.True(em.ShouldEmit(start))
+	_, err := em.Emit(start)
+	require.NoError(err)
+	net.broadcast(t)
+
+	// DAG doesn't progress, so the next event is emitted only after MaxEmitInterval
+	require.False(em.ShouldEmit(start.Add(cfg.MinEmitInterval)))
+	require.False(em.ShouldEmit(start.Add(cfg.MaxEmitInterval - 1)))
+	require.True(em.ShouldEmit(start.Add(cfg.MaxEmitInterval)))
+
+	// other validators have observed the event
+	for _, id := range nodes[1:] {
+		_, err := net.nodes[id].emitter.Emit(start)
+		require.NoError(err)
+		net.broadcast(t)
+	}
+	require.False(em.ShouldEmit(start.Add(cfg.MinEmitInterval - 1)))
+	require.True(em.ShouldEmit(start.Add(cfg.MinEmitInterval)))
+
+	// not a validator
+	em.Reset(2, pos.EqualWeightValidators(nodes[1:], 1))
+	require.False(em.ShouldEmit(start.Add(cfg.MaxEmitInterval)))
+	_, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.Equal(ErrNotValidator, err)
+}
+
+func TestEmitter_SyncedToEmit(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(3)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	cfg.DoublesignProtection = time.Minute
+	start := time.Unix(1000, 0)
+	status := doublesign.SyncStatus{}
+	net := newTestNetwork(validators, cfg, func(world *World) {
+		world.SyncStatus = func() doublesign.SyncStatus {
+			return status
+		}
+	})
+	em := net.nodes[nodes[0]].emitter
+
+	_, err := em.Tick(start)
+	require.Equal(doublesign.ErrNoConnections, err)
+
+	status = doublesign.SyncStatus{
+		PeersNum:      1,
+		Now:           start,
+		Startup:       start.Add(-time.Hour),
+		LastConnected: start.Add(-time.Hour),
+		P2PSynced:     start.Add(-time.Second),
+	}
+	_, err = em.Tick(start)
+	require.Equal(doublesign.ErrJustP2PSynced, err)
+
+	status.P2PSynced = start.Add(-time.Hour)
+	e, err := em.Tick(start)
+	require.NoError(err)
+	require.NotNil(e)
+	require.Equal(hash.Events{}, e.Parents())
+}
+
+func TestEmitter_ParentsSizeLimit(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	cfg.MaxParentsSize = 400
+	net := newTestNetwork(validators, cfg, func(world *World) {
+		world.ParentStrategies = func(maxParents int) []ancestor.SearchStrategy {
+			strategies := make([]ancestor.SearchStrategy, maxParents-1)
+			for i := range strategies {
+				strategies[i] = ancestor.NewCompositeStrategy(ancestor.WeightedMetric{
+					Fn: func(ids hash.Events) ancestor.Metric {
+						return ancestor.Metric(len(ids))
+					},
+					Weight: 1,
+				})
+			}
+			return strategies
+		}
+	})
+
+	start := time.Unix(1000, 0)
+	parentsNum := map[int]int{}
+	for step := 0; step < 200; step++ {
+		now := start.Add(time.Duration(step) * time.Millisecond)
+		for _, id := range nodes {
+			e, err := net.nodes[id].emitter.Tick(now)
+			require.NoError(err)
+			net.broadcast(t)
+			if e == nil {
+				continue
+			}
+			size := 0
+			for _, p := range e.Parents() {
+				size += net.nodes[id].input.GetEvent(p).Size()
+			}
+			require.LessOrEqual(size, cfg.MaxParentsSize)
+			parentsNum[len(e.Parents())]++
+		}
+	}
+	require.NotZero(parentsNum[2])
+	require.Zero(parentsNum[cfg.MaxParents])
+	require.Greater(net.nodes[nodes[0]].store.GetLastDecidedFrame(), idx.Frame(2))
+}
+
+func TestEmitter_Protection(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(3)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	protection := doublesign.NewProtection(memorydb.New())
+	net := newTestNetwork(validators, cfg, func(world *World) {
+		world.Protection = protection
+	})
+	em := net.nodes[nodes[0]].emitter
+
+	start := time.Unix(1000, 0)
+	e, err := em.Emit(start)
+	require.NoError(err)
+	net.broadcast(t)
+	signed, err := protection.Get(nodes[0], e.Epoch())
+	require.NoError(err)
+	require.Equal(&doublesign.SignedEvent{Seq: e.Seq(), Lamport: e.Lamport(), ID: e.ID()}, signed)
+
+	// the emitter has lost its self-events, e.g. the validator is migrated to a machine without the DAG
+	em.Reset(e.Epoch(), validators)
+	_, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.True(errors.Is(err, doublesign.ErrDoubleSign))
+
+	// the emission continues after the self-event is observed
+	em.ProcessEvent(e)
+	e, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.NoError(err)
+	require.Equal(idx.Event(2), e.Seq())
+}
+
+func TestEmitter_ProcessFailure(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(3)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	errProcess := errors.New("process failed")
+	failures := 0
+	net := newTestNetwork(validators, cfg, func(world *World) {
+		world.Protection = doublesign.NewProtection(memorydb.New())
+		process := world.Process
+		world.Process = func(e dag.Event) error {
+			if failures != 0 {
+				failures--
+				return errProcess
+			}
+			return process(e)
+		}
+	})
+	em := net.nodes[nodes[0]].emitter
+
+	start := time.Unix(1000, 0)
+	failures = 1
+	_, err := em.Emit(start)
+	require.True(errors.Is(err, errProcess))
+	// new heads would change the parents of a new event
+	_, err = net.nodes[nodes[1]].emitter.Emit(start)
+	require.NoError(err)
+	net.broadcast(t)
+
+	// the same signed event is resubmitted
+	e, err := em.Emit(start)
+	require.NoError(err)
+	require.Equal(idx.Event(1), e.Seq())
+	net.broadcast(t)
+
+	e, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.NoError(err)
+	require.Equal(idx.Event(2), e.Seq())
+}
+
+func TestEmitter_ProcessFailure_Halted(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(3)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	errProcess := errors.New("process failed")
+	calls := 0
+	net := newTestNetwork(validators, cfg, func(world *World) {
+		world.Protection = doublesign.NewProtection(memorydb.New())
+		world.Process = func(e dag.Event) error {
+			calls++
+			return errProcess
+		}
+	})
+	em := net.nodes[nodes[0]].emitter
+
+	start := time.Unix(1000, 0)
+	for i := 1; i < cfg.MaxProcessAttempts; i++ {
+		_, err := em.Emit(start)
+		require.Equal(errProcess, err)
+	}
+	_, err := em.Emit(start)
+	halted := &HaltedError{}
+	require.True(errors.As(err, &halted))
+	require.True(errors.Is(err, errProcess))
+	require.Equal(cfg.MaxProcessAttempts, calls)
+
+	// the event isn't resubmitted anymore
+	_, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.Equal(halted, err)
+	require.Equal(cfg.MaxProcessAttempts, calls)
+
+	em.Reset(em.epoch, validators)
+	_, err = em.Emit(start.Add(cfg.MaxEmitInterval))
+	require.Equal(halted, err)
+
+	// emission is resumed in the next epoch
+	em.Reset(em.epoch+1, validators)
+	require.Nil(em.halted)
+	require.Nil(em.pending)
+}
+
+func TestEmitter_ExcludeCheaters(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(4)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cfg := LiteConfig()
+	cfg.ExcludeCheaters = true
+	net := newTestNetwork(validators, cfg, nil)
+	cheater := nodes[3]
+
+	start := time.Unix(1000, 0)
+	step := 0
+	tick := func() dag.Events {
+		now := start.Add(time.Duration(step) * time.Millisecond)
+		step++
+		emitted := dag.Events{}
+		for _, id := range nodes {
+			e, err := net.nodes[id].emitter.Tick(now)
+			require.NoError(err)
+			if e != nil {
+				emitted = append(emitted, e)
+			}
+			net.broadcast(t)
+		}
+		return emitted
+	}
+	for i := 0; i < 50; i++ {
+		tick()
+	}
+
+	// the cheater forgets its self-events and creates a fork
+	em := net.nodes[cheater].emitter
+	em.Reset(em.epoch, validators)
+	fork, err := em.Emit(start.Add(time.Duration(step) * time.Millisecond))
+	require.NoError(err)
+	require.Equal(idx.Event(1), fork.Seq())
+	net.broadcast(t)
+
+	decidedBefore := net.nodes[nodes[0]].store.GetLastDecidedFrame()
+	linked := 0
+	for i := 0; i < 100; i++ {
+		detected := map[idx.ValidatorID]bool{}
+		for _, id := range nodes {
+			detected[id] = net.nodes[id].emitter.CheatersIndexer().IsCheater(cheater)
+		}
+		for _, e := range tick() {
+			if e.Creator() == cheater {
+				continue
+			}
+			for _, p := range e.Parents()[1:] {
+				if net.nodes[e.Creator()].input.GetEvent(p).Creator() == cheater {
+					// the honest validators don't link to the cheater's events after the fork is detected
+					require.False(detected[e.Creator()])
+					linked++
+				}
+			}
+		}
+	}
+	require.Less(linked, 10)
+	for _, id := range nodes {
+		require.Equal([]idx.ValidatorID{cheater}, net.nodes[id].emitter.CheatersIndexer().Cheaters())
+	}
+	// consensus progresses without the cheater
+	require.Greater(net.nodes[nodes[0]].store.GetLastDecidedFrame(), decidedBefore)
+}
+
+func TestEmitter_DeprioritizeCheaters(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(4)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	cheater := nodes[3]
+	cfg := LiteConfig()
+	// a single parent besides the self-parent, so that the cheater's heads compete with the honest ones
+	cfg.MaxParents = 2
+	var net *testNetwork
+	// the parent strategies prefer the cheater's events
+	net = newTestNetwork(validators, cfg, func(world *World) {
+		world.ParentStrategies = func(maxParents int) []ancestor.SearchStrategy {
+			strategies := make([]ancestor.SearchStrategy, maxParents-1)
+			for i := range strategies {
+				strategies[i] = ancestor.NewMetricStrategy(func(ids hash.Events) ancestor.Metric {
+					for _, node := range net.nodes {
+						if e := node.input.GetEvent(ids[len(ids)-1]); e != nil && e.Creator() == cheater {
+							return 2
+						}
+					}
+					return 1
+				})
+			}
+			return strategies
+		}
+	})
+
+	start := time.Unix(1000, 0)
+	now := start
+	tick := func() {
+		now = now.Add(time.Millisecond)
+		for _, id := range nodes {
+			_, err := net.nodes[id].emitter.Tick(now)
+			require.NoError(err)
+			net.broadcast(t)
+		}
+	}
+	for i := 0; i < 50; i++ {
+		tick()
+	}
+
+	// the cheater forgets its self-events and creates a fork
+	em := net.nodes[cheater].emitter
+	em.Reset(em.epoch, validators)
+	_, err := em.Emit(now)
+	require.NoError(err)
+	net.broadcast(t)
+	me := net.nodes[nodes[0]]
+	for i := 0; i < 100 && !me.emitter.CheatersIndexer().IsCheater(cheater); i++ {
+		tick()
+	}
+	require.True(me.emitter.CheatersIndexer().IsCheater(cheater))
+
+	// both the cheater's event and an honest event are the heads of the validator
+	cheaterEvent, err := em.Emit(now)
+	require.NoError(err)
+	honestEvent, err := net.nodes[nodes[1]].emitter.Emit(now)
+	require.NoError(err)
+	for _, e := range net.emitted {
+		me.input.SetEvent(e)
+		require.NoError(me.lch.Process(e))
+		me.emitter.ProcessEvent(e)
+	}
+	net.emitted = nil
+	require.Contains(me.emitter.Heads(), cheaterEvent)
+	require.Contains(me.emitter.Heads(), honestEvent)
+
+	e, err := me.emitter.Emit(now)
+	require.NoError(err)
+	require.Len(e.Parents(), 2)
+	require.NotEqual(cheaterEvent.ID(), e.Parents()[1])
+}
+
+func TestEmitter_MaxParentFrameLag(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	offline := nodes[4]
+	cfg := LiteConfig()
+	cfg.MaxParentFrameLag = 3
+	net := newTestNetwork(validators, cfg, nil)
+
+	start := time.Unix(1000, 0)
+	step := 0
+	tick := func(online []idx.ValidatorID) dag.Events {
+		now := start.Add(time.Duration(step) * time.Millisecond)
+		step++
+		emitted := dag.Events{}
+		for _, id := range online {
+			e, err := net.nodes[id].emitter.Tick(now)
+			require.NoError(err)
+			if e != nil {
+				emitted = append(emitted, e)
+			}
+			net.broadcast(t)
+		}
+		return emitted
+	}
+	checkParents := func(e dag.Event) (refersOffline bool) {
+		input := net.nodes[e.Creator()].input
+		maxFrame := idx.Frame(0)
+		for _, p := range e.Parents() {
+			if f := input.GetEvent(p).Frame(); maxFrame < f {
+				maxFrame = f
+			}
+		}
+		for _, p := range e.Parents() {
+			parent := input.GetEvent(p)
+			if !e.IsSelfParent(p) {
+				require.GreaterOrEqual(parent.Frame()+cfg.MaxParentFrameLag, maxFrame)
+			}
+			if parent.Creator() == offline && !e.IsSelfParent(p) {
+				refersOffline = true
+			}
+		}
+		return refersOffline
+	}
+
+	for i := 0; i < 20; i++ {
+		tick(nodes)
+	}
+	// an event of the offline validator is delivered late, so it's a head with a too old frame
+	late, err := net.nodes[offline].emitter.Emit(start.Add(time.Duration(step) * time.Millisecond))
+	require.NoError(err)
+	net.emitted = nil
+	for i := 0; i < 300; i++ {
+		for _, e := range tick(nodes[:4]) {
+			checkParents(e)
+		}
+	}
+	net.emitted = dag.Events{late}
+	net.broadcast(t)
+	require.Less(late.Frame()+cfg.MaxParentFrameLag, net.nodes[nodes[0]].store.GetLastDecidedFrame())
+	for i := 0; i < 100; i++ {
+		for _, e := range tick(nodes[:4]) {
+			require.False(checkParents(e))
+		}
+	}
+
+	// the validator is back, its new events are referred again
+	linked := 0
+	for i := 0; i < 100; i++ {
+		for _, e := range tick(nodes) {
+			if checkParents(e) {
+				linked++
+			}
+		}
+	}
+	require.NotZero(linked)
+}
@@ -0,0 +1,76 @@
+package pos
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// QuorumRule is a rule where the quorum is more than Numerator/Denominator of the total weight.
+// The zero value is DefaultQuorumRule.
+// Rules with a fraction lower than 2/3 are intended for research and test networks only,
+// BFT guarantees of the consensus hold only if quorum is greater than 2/3 of the total weight.
+type QuorumRule struct {
+	Numerator   uint64 `json:"numerator"`
+	Denominator uint64 `json:"denominator"`
+}
+
+// DefaultQuorumRule is the rule where the quorum is more than 2/3 of the total weight.
+var DefaultQuorumRule = QuorumRule{Numerator: 2, Denominator: 3}
+
+// NewQuorumRule creates a rule where the quorum is more than numerator/denominator of the total weight.
+// Fractions lower than 2/3 are rejected, see NewUnsafeQuorumRule.
+func NewQuorumRule(numerator, denominator uint64) (QuorumRule, error) {
+	q := QuorumRule{
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+	return q, q.Validate(false)
+}
+
+// NewUnsafeQuorumRule creates a rule where the quorum is more than numerator/denominator of the total weight,
+// fractions lower than 2/3 are allowed. Such rules break BFT guarantees, so they're intended for research only.
+func NewUnsafeQuorumRule(numerator, denominator uint64) (QuorumRule, error) {
+	q := QuorumRule{
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+	return q, q.Validate(true)
+}
+
+// Validate checks that the fraction is lower than 1, and isn't lower than 2/3 unless allowUnsafe is set.
+func (q QuorumRule) Validate(allowUnsafe bool) error {
+	if q == (QuorumRule{}) {
+		return nil
+	}
+	if q.Denominator == 0 || q.Numerator >= q.Denominator {
+		return fmt.Errorf("invalid quorum fraction %d/%d", q.Numerator, q.Denominator)
+	}
+	if !allowUnsafe && !q.atLeastTwoThirds() {
+		return fmt.Errorf("quorum fraction %d/%d is lower than 2/3", q.Numerator, q.Denominator)
+	}
+	return nil
+}
+
+// Quorum returns the minimum weight which is more than the fraction of the total weight.
+func (q QuorumRule) Quorum(totalWeight Weight) Weight {
+	if q == (QuorumRule{}) {
+		q = DefaultQuorumRule
+	}
+	// the product doesn't fit into 64 bits for large fractions
+	hi, lo := bits.Mul64(uint64(totalWeight), q.Numerator)
+	quo, _ := bits.Div64(hi, lo, q.Denominator)
+	return Weight(quo) + 1
+}
+
+func (q QuorumRule) atLeastTwoThirds() bool {
+	hi1, lo1 := bits.Mul64(q.Numerator, 3)
+	hi2, lo2 := bits.Mul64(q.Denominator, 2)
+	return hi1 > hi2 || hi1 == hi2 && lo1 >= lo2
+}
+
+func (q QuorumRule) String() string {
+	if q == (QuorumRule{}) {
+		q = DefaultQuorumRule
+	}
+	return fmt.Sprintf("%d/%d+1", q.Numerator, q.Denominator)
+}
@@ -23,6 +23,7 @@ type (
 	// Read-only.
 	Validators struct {
 		values map[idx.ValidatorID]Weight
+		rule   QuorumRule
 		cache  cache
 	}
 
@@ -175,7 +176,27 @@ func (vv *Validators) sortedArray() validators {
 
 // Copy constructs a copy.
 func (vv *Validators) Copy() *Validators {
-	return newValidators(vv.values)
+	cp := newValidators(vv.values)
+	cp.rule = vv.rule
+	return cp
+}
+
+// WithQuorumRule constructs a copy which uses the specified quorum rule.
+// The rule isn't a part of the RLP encoding and isn't carried by Builder, because it's a consensus parameter
+// of the network rather than of the validators set. abft stores it next to the validators of an epoch and
+// applies it to the validators of every next epoch, see abft.EpochState.
+func (vv *Validators) WithQuorumRule(rule QuorumRule) *Validators {
+	cp := vv.Copy()
+	cp.rule = rule
+	return cp
+}
+
+// QuorumRule returns the rule which is used to calculate quorum.
+func (vv *Validators) QuorumRule() QuorumRule {
+	if vv.rule == (QuorumRule{}) {
+		return DefaultQuorumRule
+	}
+	return vv.rule
 }
 
 // Builder returns a mutable copy of content
@@ -185,7 +206,7 @@ func (vv *Validators) Builder() ValidatorsBuilder {
 
 // Quorum limit of validators.
 func (vv *Validators) Quorum() Weight {
-	return vv.TotalWeight()*2/3 + 1
+	return vv.QuorumRule().Quorum(vv.TotalWeight())
 }
 
 // TotalWeight of validators.
@@ -7,6 +7,7 @@ import (
 	"unsafe"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Fantom-foundation/lachesis-base/inter/idx"
@@ -196,3 +197,54 @@ func TestValidators_Big(t *testing.T) {
 	assert.Equal(t, Weight(0x9c37f), v.Get(4999))
 	assert.Equal(t, Weight(0x9c3ff), v.Get(5000))
 }
+
+func TestValidators_QuorumRule(t *testing.T) {
+	v := ArrayToValidators([]idx.ValidatorID{1, 2, 3, 4}, []Weight{1, 2, 3, 4})
+
+	assert.Equal(t, DefaultQuorumRule, v.QuorumRule())
+	assert.Equal(t, Weight(7), v.Quorum())
+
+	threeQuarters, err := NewQuorumRule(3, 4)
+	assert.NoError(t, err)
+	vRule := v.WithQuorumRule(threeQuarters)
+	assert.Equal(t, Weight(8), vRule.Quorum())
+	assert.Equal(t, Weight(8), vRule.Copy().Quorum())
+	// original set isn't affected
+	assert.Equal(t, Weight(7), v.Quorum())
+
+	counter := vRule.NewCounter()
+	counter.Count(4)
+	counter.Count(3)
+	assert.False(t, counter.HasQuorum())
+	counter.Count(1)
+	assert.True(t, counter.HasQuorum())
+
+	// the rule is a consensus parameter, it isn't encoded with the validators
+	b, err := rlp.EncodeToBytes(vRule)
+	assert.NoError(t, err)
+	expected, err := rlp.EncodeToBytes(v)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b)
+
+	// fractions lower than 2/3 are allowed only explicitly
+	_, err = NewQuorumRule(1, 2)
+	assert.Error(t, err)
+	half, err := NewUnsafeQuorumRule(1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, Weight(6), v.WithQuorumRule(half).Quorum())
+	_, err = NewQuorumRule(2, 3)
+	assert.NoError(t, err)
+
+	_, err = NewUnsafeQuorumRule(1, 0)
+	assert.Error(t, err)
+	_, err = NewUnsafeQuorumRule(3, 3)
+	assert.Error(t, err)
+
+	// the zero value is the default rule
+	assert.Equal(t, DefaultQuorumRule.Quorum(10), QuorumRule{}.Quorum(10))
+	assert.NoError(t, QuorumRule{}.Validate(false))
+	// large fractions don't overflow
+	large, err := NewQuorumRule(math.MaxUint64-1, math.MaxUint64)
+	assert.NoError(t, err)
+	assert.Equal(t, Weight(10), large.Quorum(10))
+}
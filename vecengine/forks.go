@@ -0,0 +1,121 @@
+package vecengine
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// BranchInfo describes a global branch of a validator
+type BranchInfo struct {
+	ID idx.Validator
+	// FirstEvent is the first indexed event of the branch, zero if unknown (e.g. the index was built by an older version)
+	FirstEvent hash.Event
+	// LastSeq is the highest seq of the branch events
+	LastSeq idx.Event
+}
+
+// ForkInfo describes the forks of a validator
+type ForkInfo struct {
+	Validator idx.ValidatorID
+	Branches  []BranchInfo
+	// Observers are the events which observe the fork first, i.e. none of their parents observe the fork
+	Observers hash.Events
+	// VisibleAtFrame is the lowest frame of the observers, or 0 if the fork isn't observed yet
+	VisibleAtFrame idx.Frame
+}
+
+// Forks returns fork information about all the validators which have more than one branch
+func (vi *Engine) Forks() []ForkInfo {
+	vi.InitBranchesInfo()
+	if !vi.AtLeastOneFork() {
+		return nil
+	}
+	forks := make([]ForkInfo, 0)
+	for creatorIdx := idx.Validator(0); creatorIdx < vi.validators.Len(); creatorIdx++ {
+		if len(vi.bi.BranchIDByCreators[creatorIdx]) > 1 {
+			forks = append(forks, vi.forkInfo(creatorIdx))
+		}
+	}
+	return forks
+}
+
+// GetForkInfo returns fork information about the validator, or nil if the validator has only one branch
+func (vi *Engine) GetForkInfo(validator idx.ValidatorID) *ForkInfo {
+	creatorIdx, ok := vi.validatorIdxs[validator]
+	if !ok {
+		return nil
+	}
+	vi.InitBranchesInfo()
+	if len(vi.bi.BranchIDByCreators[creatorIdx]) <= 1 {
+		return nil
+	}
+	info := vi.forkInfo(creatorIdx)
+	return &info
+}
+
+func (vi *Engine) forkInfo(creatorIdx idx.Validator) ForkInfo {
+	info := ForkInfo{
+		Validator: vi.validators.GetID(creatorIdx),
+		Observers: vi.getForkObservers(creatorIdx),
+	}
+	for _, branchID := range vi.bi.BranchIDByCreators[creatorIdx] {
+		info.Branches = append(info.Branches, BranchInfo{
+			ID:         branchID,
+			FirstEvent: vi.getBranchFirstEvent(branchID),
+			LastSeq:    vi.bi.BranchIDLastSeq[branchID],
+		})
+	}
+	for _, id := range info.Observers {
+		e := vi.getEvent(id)
+		if e == nil {
+			continue
+		}
+		if info.VisibleAtFrame == 0 || e.Frame() < info.VisibleAtFrame {
+			info.VisibleAtFrame = e.Frame()
+		}
+	}
+	return info
+}
+
+// addForkObservers stores the event as an observer of the forks which aren't observed by its parents
+func (vi *Engine) addForkObservers(e dag.Event, before HighestBeforeI, parentsVecs []HighestBeforeI) {
+nextCreator:
+	for n := idx.Validator(0); n < vi.validators.Len(); n++ {
+		if len(vi.bi.BranchIDByCreators[n]) <= 1 || !before.IsForkDetected(n) {
+			continue
+		}
+		for _, pVec := range parentsVecs {
+			if pVec.IsForkDetected(n) {
+				continue nextCreator
+			}
+		}
+		observers := append(vi.getForkObservers(n), e.ID())
+		vi.setRlp(vi.table.ForkObservers, n.Bytes(), observers)
+	}
+}
+
+func (vi *Engine) getForkObservers(creatorIdx idx.Validator) hash.Events {
+	observers, _ := vi.getRlp(vi.table.ForkObservers, creatorIdx.Bytes(), &hash.Events{}).(*hash.Events)
+	if observers == nil {
+		return hash.Events{}
+	}
+	return *observers
+}
+
+func (vi *Engine) setBranchFirstEvent(branchID idx.Validator, id hash.Event) {
+	if err := vi.table.BranchFirstEvent.Put(branchID.Bytes(), id.Bytes()); err != nil {
+		vi.crit(err)
+	}
+}
+
+func (vi *Engine) getBranchFirstEvent(branchID idx.Validator) hash.Event {
+	b, err := vi.table.BranchFirstEvent.Get(branchID.Bytes())
+	if err != nil {
+		vi.crit(err)
+	}
+	if b == nil {
+		return hash.ZeroEvent
+	}
+	return hash.BytesToEvent(b)
+}
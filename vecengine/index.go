@@ -17,9 +17,39 @@ type Callbacks struct {
 	GetLowestAfter   func(hash.Event) LowestAfterI
 	SetHighestBefore func(hash.Event, HighestBeforeI)
 	SetLowestAfter   func(hash.Event, LowestAfterI)
-	NewHighestBefore func(idx.Validator) HighestBeforeI
-	NewLowestAfter   func(idx.Validator) LowestAfterI
-	OnDropNotFlushed func()
+	// SetLowestAfterBatch stores multiple LowestAfter vectors at once, optional
+	SetLowestAfterBatch func(hash.Events, []LowestAfterI)
+	NewHighestBefore    func(idx.Validator) HighestBeforeI
+	NewLowestAfter      func(idx.Validator) LowestAfterI
+	OnDropNotFlushed    func()
+	// OnFlush is called after the not flushed data is written into DB, optional
+	OnFlush func()
+	// OnReset is called when the engine is reset to another DB, optional
+	OnReset func()
+	// DeleteVectors deletes HighestBefore and LowestAfter vectors of a pruned event, required if Config.PruneFrames is set
+	DeleteVectors func(hash.Event)
+}
+
+// Config is the Engine config
+type Config struct {
+	// MaxFrameDistance bounds propagation of LowestAfter vectors to the ancestors whose frame is lower
+	// than the self-parent frame of a new event by no more than MaxFrameDistance. 0 means unbounded.
+	// ForklessCause(a, b) isn't affected unless frame of a exceeds its self-parent frame by MaxFrameDistance or more,
+	// but the results may differ for such events, so all the nodes must use the same value.
+	MaxFrameDistance idx.Frame
+	// Parallelism is a number of goroutines which traverse parent subtrees of a new event, 0 or 1 means sequential traversal.
+	// Callbacks are never called concurrently, but getEvent must be safe for concurrent use if Parallelism > 1.
+	Parallelism int
+	// PruneFrames enables pruning of the events whose frame is lower than the last decided frame by more than PruneFrames,
+	// see Engine.Prune. 0 disables pruning. Only the events indexed with a non-zero value may be pruned.
+	// Events which refer to a parent whose frame is lower than the highest parent frame by more than PruneFrames are rejected,
+	// so all the nodes must use the same value.
+	PruneFrames idx.Frame
+}
+
+// DefaultConfig returns default Engine config
+func DefaultConfig() Config {
+	return Config{}
 }
 
 type Engine struct {
@@ -32,19 +62,30 @@ type Engine struct {
 	getEvent func(hash.Event) dag.Event
 
 	callback Callbacks
+	cfg      Config
 
 	vecDb kvdb.FlushableKVStore
 	table struct {
-		EventBranch  kvdb.Store `table:"b"`
-		BranchesInfo kvdb.Store `table:"B"`
+		EventBranch      kvdb.Store `table:"b"`
+		BranchesInfo     kvdb.Store `table:"B"`
+		BranchFirstEvent kvdb.Store `table:"f"`
+		ForkObservers    kvdb.Store `table:"o"`
+		FrameEvents      kvdb.Store `table:"F"`
+		BranchLastFrame  kvdb.Store `table:"l"`
 	}
 }
 
 // NewIndex creates Engine instance.
 func NewIndex(crit func(error), callbacks Callbacks) *Engine {
+	return NewIndexWithConfig(crit, DefaultConfig(), callbacks)
+}
+
+// NewIndexWithConfig creates Engine instance with a non-default config.
+func NewIndexWithConfig(crit func(error), cfg Config, callbacks Callbacks) *Engine {
 	vi := &Engine{
 		crit:     crit,
 		callback: callbacks,
+		cfg:      cfg,
 	}
 
 	return vi
@@ -60,6 +101,9 @@ func (vi *Engine) Reset(validators *pos.Validators, db kvdb.FlushableKVStore, ge
 	vi.DropNotFlushed()
 
 	table.MigrateTables(&vi.table, vi.vecDb)
+	if vi.callback.OnReset != nil {
+		vi.callback.OnReset()
+	}
 }
 
 // Add calculates vector clocks for the event and saves into DB.
@@ -77,6 +121,9 @@ func (vi *Engine) Flush() {
 	if err := vi.vecDb.Flush(); err != nil {
 		vi.crit(err)
 	}
+	if vi.callback.OnFlush != nil {
+		vi.callback.OnFlush()
+	}
 }
 
 // DropNotFlushed not connected clocks. Call it if event has failed.
@@ -90,9 +137,8 @@ func (vi *Engine) DropNotFlushed() {
 	}
 }
 
-func (vi *Engine) setForkDetected(before HighestBeforeI, branchID idx.Validator) {
-	creatorIdx := vi.bi.BranchIDCreatorIdxs[branchID]
-	for _, branchID := range vi.bi.BranchIDByCreators[creatorIdx] {
+func setForkDetected(before HighestBeforeI, branches []idx.Validator) {
+	for _, branchID := range branches {
 		before.SetForkDetected(branchID)
 	}
 }
@@ -111,6 +157,7 @@ func (vi *Engine) fillGlobalBranchID(e dag.Event, meIdx idx.Validator) (idx.Vali
 		if vi.bi.BranchIDLastSeq[meIdx] == 0 {
 			// OK, not a new fork
 			vi.bi.BranchIDLastSeq[meIdx] = e.Seq()
+			vi.setBranchFirstEvent(meIdx, e.ID())
 			return meIdx, nil
 		}
 	} else {
@@ -132,6 +179,7 @@ func (vi *Engine) fillGlobalBranchID(e dag.Event, meIdx idx.Validator) (idx.Vali
 	vi.bi.BranchIDCreatorIdxs = append(vi.bi.BranchIDCreatorIdxs, meIdx)
 	newBranchID := idx.Validator(len(vi.bi.BranchIDLastSeq) - 1)
 	vi.bi.BranchIDByCreators[meIdx] = append(vi.bi.BranchIDByCreators[meIdx], newBranchID)
+	vi.setBranchFirstEvent(newBranchID, e.ID())
 	return newBranchID, nil
 }
 
@@ -143,22 +191,33 @@ func (vi *Engine) fillEventVectors(e dag.Event) (allVecs, error) {
 		after:  vi.callback.NewLowestAfter(idx.Validator(len(vi.bi.BranchIDCreatorIdxs))),
 	}
 
-	meBranchID, err := vi.fillGlobalBranchID(e, meIdx)
+	// the threshold doesn't depend on the pruned data, so the pruning doesn't affect events validity
+	minParentFrame, err := vi.minParentFrame(e)
 	if err != nil {
 		return myVecs, err
 	}
+	if err := vi.checkParentFrames(e, minParentFrame); err != nil {
+		return myVecs, err
+	}
 
 	// pre-load parents into RAM for quick access
 	parentsVecs := make([]HighestBeforeI, len(e.Parents()))
-	parentsBranchIDs := make([]idx.Validator, len(e.Parents()))
 	for i, p := range e.Parents() {
-		parentsBranchIDs[i] = vi.GetEventBranchID(p)
 		parentsVecs[i] = vi.callback.GetHighestBefore(p)
 		if parentsVecs[i] == nil {
+			if vi.IsPruned(p) {
+				// only a fork may refer to a pruned event, see Engine.Prune
+				return myVecs, fmt.Errorf("%w, parent=%s", ErrPrunedParent, p.String())
+			}
 			return myVecs, fmt.Errorf("processed out of order, parent not found (inconsistent DB), parent=%s", p.String())
 		}
 	}
 
+	meBranchID, err := vi.fillGlobalBranchID(e, meIdx)
+	if err != nil {
+		return myVecs, err
+	}
+
 	// observed by himself
 	myVecs.after.InitWithEvent(meBranchID, e)
 	myVecs.before.InitWithEvent(meBranchID, e)
@@ -169,73 +228,77 @@ func (vi *Engine) fillEventVectors(e dag.Event) (allVecs, error) {
 	}
 	// Detect forks, which were not observed by parents
 	if vi.AtLeastOneFork() {
-		for n := idx.Validator(0); n < vi.validators.Len(); n++ {
-			if len(vi.bi.BranchIDByCreators[n]) <= 1 {
-				continue
-			}
-			for _, branchID := range vi.bi.BranchIDByCreators[n] {
-				if myVecs.before.IsForkDetected(branchID) {
-					// if one branch observes a fork, mark all the branches as observing the fork
-					vi.setForkDetected(myVecs.before, n)
-					break
-				}
-			}
-		}
-
-	nextCreator:
-		for n := idx.Validator(0); n < vi.validators.Len(); n++ {
-			if myVecs.before.IsForkDetected(n) {
-				continue
-			}
-			for _, branchID1 := range vi.bi.BranchIDByCreators[n] {
-				for _, branchID2 := range vi.bi.BranchIDByCreators[n] {
-					a := branchID1
-					b := branchID2
-					if a == b {
-						continue
-					}
-
-					if myVecs.before.IsEmpty(a) || myVecs.before.IsEmpty(b) {
-						continue
-					}
-					if myVecs.before.MinSeq(a) <= myVecs.before.Seq(b) && myVecs.before.MinSeq(b) <= myVecs.before.Seq(a) {
-						vi.setForkDetected(myVecs.before, n)
-						continue nextCreator
-					}
-				}
-			}
-		}
+		detectForks(myVecs.before, vi.validators.Len(), vi.bi.BranchIDByCreators)
+		vi.addForkObservers(e, myVecs.before, parentsVecs)
 	}
 
-	// graph traversal starting from e, but excluding e
-	onWalk := func(walk hash.Event) (godeeper bool) {
-		wLowestAfterSeq := vi.callback.GetLowestAfter(walk)
-
-		// update LowestAfter vector of the old event, because newly-connected event observes it
-		if wLowestAfterSeq.Visit(meBranchID, e) {
-			vi.callback.SetLowestAfter(walk, wLowestAfterSeq)
-			return true
-		}
-		return false
-	}
-	err = vi.DfsSubgraph(e, onWalk)
+	// update LowestAfter vectors of the old events, because newly-connected event observes them
+	// missing event is returned as an error, the not flushed changes must be dropped by the caller
+	err = vi.propagateLowestAfter(e, meBranchID, minParentFrame)
 	if err != nil {
-		vi.crit(err)
+		return myVecs, err
 	}
 
 	// store calculated vectors
 	vi.callback.SetHighestBefore(e.ID(), myVecs.before)
 	vi.callback.SetLowestAfter(e.ID(), myVecs.after)
 	vi.SetEventBranchID(e.ID(), meBranchID)
+	if vi.cfg.PruneFrames != 0 {
+		vi.addFrameEvent(e, meBranchID)
+	}
 
 	return myVecs, nil
 }
 
+// detectForks marks the forks which are observed by the vector, but not marked yet.
+// branchesByCreators is validator idx -> list of branch IDs.
+func detectForks(before HighestBeforeI, validatorsNum idx.Validator, branchesByCreators [][]idx.Validator) {
+	for n := idx.Validator(0); n < validatorsNum; n++ {
+		if len(branchesByCreators[n]) <= 1 {
+			continue
+		}
+		for _, branchID := range branchesByCreators[n] {
+			if before.IsForkDetected(branchID) {
+				// if one branch observes a fork, mark all the branches as observing the fork
+				setForkDetected(before, branchesByCreators[n])
+				break
+			}
+		}
+	}
+
+nextCreator:
+	for n := idx.Validator(0); n < validatorsNum; n++ {
+		if before.IsForkDetected(n) {
+			continue
+		}
+		for _, branchID1 := range branchesByCreators[n] {
+			for _, branchID2 := range branchesByCreators[n] {
+				a := branchID1
+				b := branchID2
+				if a == b {
+					continue
+				}
+
+				if before.IsEmpty(a) || before.IsEmpty(b) {
+					continue
+				}
+				if before.MinSeq(a) <= before.Seq(b) && before.MinSeq(b) <= before.Seq(a) {
+					setForkDetected(before, branchesByCreators[n])
+					continue nextCreator
+				}
+			}
+		}
+	}
+}
+
 func (vi *Engine) GetMergedHighestBefore(id hash.Event) HighestBeforeI {
 	vi.InitBranchesInfo()
 
 	if vi.AtLeastOneFork() {
 		scatteredBefore := vi.callback.GetHighestBefore(id)
+		if scatteredBefore == nil {
+			return nil
+		}
 
 		mergedBefore := vi.callback.NewHighestBefore(vi.validators.Len())
 
@@ -0,0 +1,191 @@
+package vecengine
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// lowestAfterWalker updates LowestAfter vectors of the events observed by a new event
+type lowestAfterWalker struct {
+	vi       *Engine
+	e        dag.Event
+	branchID idx.Validator
+	minFrame idx.Frame
+
+	mu      sync.Mutex // protects the fields below and the callbacks
+	visited hash.EventsSet
+	ids     hash.Events
+	vecs    []LowestAfterI
+}
+
+// propagateLowestAfter updates LowestAfter vectors of the events which are observed by e, excluding e.
+// The traversal stops at the events already observed by the branch, and at the events with a too low frame if MaxFrameDistance
+// or PruneFrames is set. minParentFrame is the lowest frame of the events which may be referred by e, see Engine.Prune.
+// Updated vectors are stored at once after the traversal.
+func (vi *Engine) propagateLowestAfter(e dag.Event, branchID idx.Validator, minParentFrame idx.Frame) error {
+	minFrame, err := vi.minPropagationFrame(e)
+	if err != nil {
+		return err
+	}
+	if minFrame < minParentFrame {
+		minFrame = minParentFrame
+	}
+	w := &lowestAfterWalker{
+		vi:       vi,
+		e:        e,
+		branchID: branchID,
+		minFrame: minFrame,
+		visited:  hash.EventsSet{},
+	}
+
+	if vi.cfg.Parallelism > 1 && len(e.Parents()) > 1 {
+		err = w.walkParallel(e.Parents(), vi.cfg.Parallelism)
+	} else {
+		err = w.walk(e.Parents())
+	}
+	if err != nil {
+		return err
+	}
+
+	if vi.callback.SetLowestAfterBatch != nil {
+		vi.callback.SetLowestAfterBatch(w.ids, w.vecs)
+	} else {
+		for i, id := range w.ids {
+			vi.callback.SetLowestAfter(id, w.vecs[i])
+		}
+	}
+	return nil
+}
+
+// MinPropagationFrame returns the lowest frame of events whose LowestAfter vectors are updated by e,
+// which is determined by MaxFrameDistance and PruneFrames. The parents of e must be known.
+func (vi *Engine) MinPropagationFrame(e dag.Event) (idx.Frame, error) {
+	minFrame, err := vi.minPropagationFrame(e)
+	if err != nil {
+		return 0, err
+	}
+	minParentFrame, err := vi.minParentFrame(e)
+	if err != nil {
+		return 0, err
+	}
+	if minFrame < minParentFrame {
+		minFrame = minParentFrame
+	}
+	return minFrame, nil
+}
+
+// minPropagationFrame returns the lowest frame of events whose LowestAfter vectors are updated by e due to MaxFrameDistance
+func (vi *Engine) minPropagationFrame(e dag.Event) (idx.Frame, error) {
+	if vi.cfg.MaxFrameDistance == 0 || e.SelfParent() == nil {
+		return 0, nil
+	}
+	selfParent := vi.getEvent(*e.SelfParent())
+	if selfParent == nil {
+		return 0, errors.New("event not found " + e.SelfParent().String())
+	}
+	if selfParent.Frame() <= vi.cfg.MaxFrameDistance {
+		return 0, nil
+	}
+	return selfParent.Frame() - vi.cfg.MaxFrameDistance, nil
+}
+
+func (w *lowestAfterWalker) walk(heads hash.Events) error {
+	stack := make(hash.EventsStack, 0, w.vi.validators.Len()*5)
+	stack.PushAll(heads)
+
+	for next := stack.Pop(); next != nil; next = stack.Pop() {
+		event, err := w.visit(*next)
+		if err != nil {
+			return err
+		}
+		if event != nil {
+			stack.PushAll(event.Parents())
+		}
+	}
+	return nil
+}
+
+// walkParallel traverses subtrees of the heads concurrently, subtrees may overlap
+func (w *lowestAfterWalker) walkParallel(heads hash.Events, threads int) error {
+	if threads > len(heads) {
+		threads = len(heads)
+	}
+	queue := make(chan hash.Event, len(heads))
+	for _, h := range heads {
+		queue <- h
+	}
+	close(queue)
+
+	errs := make(chan error, threads)
+	wg := sync.WaitGroup{}
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for h := range queue {
+				if err := w.walk(hash.Events{h}); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
+// visit updates LowestAfter vector of the event, returns the event if its parents have to be visited
+func (w *lowestAfterWalker) visit(id hash.Event) (dag.Event, error) {
+	w.mu.Lock()
+	if w.visited.Contains(id) {
+		w.mu.Unlock()
+		return nil, nil
+	}
+	w.visited.Add(id)
+	w.mu.Unlock()
+
+	var event dag.Event
+	if w.minFrame != 0 {
+		event = w.vi.getEvent(id)
+		if event == nil {
+			return nil, errors.New("event not found " + id.String())
+		}
+		if event.Frame() < w.minFrame {
+			return nil, nil
+		}
+	}
+
+	w.mu.Lock()
+	lowestAfter := w.vi.callback.GetLowestAfter(id)
+	if lowestAfter == nil {
+		w.mu.Unlock()
+		if w.vi.IsPruned(id) {
+			// only a fork may observe a pruned event above the threshold, see Engine.Prune
+			return nil, fmt.Errorf("%w, event=%s", ErrPrunedParent, id.String())
+		}
+		return nil, errors.New("LowestAfter not found " + id.String())
+	}
+	updated := lowestAfter.Visit(w.branchID, w.e)
+	if updated {
+		w.ids = append(w.ids, id)
+		w.vecs = append(w.vecs, lowestAfter)
+	}
+	w.mu.Unlock()
+	if !updated {
+		return nil, nil
+	}
+
+	if event == nil {
+		event = w.vi.getEvent(id)
+		if event == nil {
+			return nil, errors.New("event not found " + id.String())
+		}
+	}
+	return event, nil
+}
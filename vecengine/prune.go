@@ -0,0 +1,167 @@
+package vecengine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// ErrPrunedParent is returned by Add if a parent of the event is too old to be referred if Config.PruneFrames is set
+var ErrPrunedParent = errors.New("parent is pruned")
+
+// addFrameEvent adds the event into the frame index, which is used for pruning
+func (vi *Engine) addFrameEvent(e dag.Event, branchID idx.Validator) {
+	key := append(e.Frame().Bytes(), e.ID().Bytes()...)
+	if err := vi.table.FrameEvents.Put(key, e.Seq().Bytes()); err != nil {
+		vi.crit(err)
+	}
+	// a new event is always the last event of its branch
+	if err := vi.table.BranchLastFrame.Put(branchID.Bytes(), e.Frame().Bytes()); err != nil {
+		vi.crit(err)
+	}
+}
+
+// minParentFrame returns the lowest frame of the events which may be referred by e if Config.PruneFrames is set.
+// It depends only on the parents of e, so all the nodes accept the same events regardless of their pruned data.
+func (vi *Engine) minParentFrame(e dag.Event) (idx.Frame, error) {
+	if vi.cfg.PruneFrames == 0 {
+		return 0, nil
+	}
+	maxFrame := idx.Frame(0)
+	for _, p := range e.Parents() {
+		parent := vi.getEvent(p)
+		if parent == nil {
+			return 0, errors.New("event not found " + p.String())
+		}
+		if maxFrame < parent.Frame() {
+			maxFrame = parent.Frame()
+		}
+	}
+	if maxFrame <= vi.cfg.PruneFrames {
+		return 0, nil
+	}
+	return maxFrame - vi.cfg.PruneFrames, nil
+}
+
+// checkParentFrames returns ErrPrunedParent if a parent of e, other than the self-parent, has a frame lower than minFrame.
+// The self-parent is exempt, as the last event of a validator is never pruned.
+func (vi *Engine) checkParentFrames(e dag.Event, minFrame idx.Frame) error {
+	if minFrame == 0 {
+		return nil
+	}
+	for _, p := range e.Parents() {
+		if e.IsSelfParent(p) {
+			continue
+		}
+		if vi.getEvent(p).Frame() < minFrame {
+			return fmt.Errorf("%w, parent=%s", ErrPrunedParent, p.String())
+		}
+	}
+	return nil
+}
+
+// Prune deletes the vectors and branch IDs of the old events, which may not be referred by new events anymore.
+// Events whose frame is lower than lastDecidedFrame by more than Config.PruneFrames are pruned,
+// unless the last event of a branch has a frame lower than lastDecidedFrame, in which case the threshold is lowered accordingly.
+// The last event of each branch isn't pruned, as it may be a self-parent of a new event.
+//
+// Add rejects a new event with ErrPrunedParent if one of its other parents has a frame lower than the highest parent frame
+// by more than PruneFrames, and doesn't propagate LowestAfter vectors to such events. As the self-parent of an honest event
+// is the last event of a branch, such event never refers to a pruned event otherwise, so pruning doesn't affect events validity.
+// Only a fork whose parents are all below the pruned frames may be rejected due to the pruned data.
+// Nothing is pruned until each validator has an event, as a first event has no self-parent.
+// Call Flush to write the changes.
+func (vi *Engine) Prune(lastDecidedFrame idx.Frame) {
+	if vi.cfg.PruneFrames == 0 {
+		return
+	}
+	vi.InitBranchesInfo()
+	minFrame := lastDecidedFrame
+	for branchID, lastSeq := range vi.bi.BranchIDLastSeq {
+		if lastSeq == 0 {
+			return
+		}
+		b, err := vi.table.BranchLastFrame.Get(idx.Validator(branchID).Bytes())
+		if err != nil {
+			vi.crit(err)
+		}
+		if b == nil {
+			// indexed without pruning
+			return
+		}
+		if frame := idx.BytesToFrame(b); minFrame > frame {
+			minFrame = frame
+		}
+	}
+	if minFrame <= vi.cfg.PruneFrames {
+		return
+	}
+	below := minFrame - vi.cfg.PruneFrames
+	if below <= vi.getPrunedFrame() {
+		return
+	}
+
+	// collect the keys first, not to modify DB during iteration
+	keys := make([][]byte, 0)
+	it := vi.table.FrameEvents.NewIterator(nil, nil)
+	for it.Next() {
+		if idx.BytesToFrame(it.Key()[:4]) >= below {
+			break
+		}
+		id := hash.BytesToEvent(it.Key()[4:])
+		seq := idx.BytesToEvent(it.Value())
+		branch := vi.getBytes(vi.table.EventBranch, id)
+		if branch != nil && vi.bi.BranchIDLastSeq[idx.BytesToValidator(branch)] == seq {
+			// may be a self-parent of a new event
+			continue
+		}
+		keys = append(keys, common.CopyBytes(it.Key()))
+	}
+	if it.Error() != nil {
+		vi.crit(it.Error())
+	}
+	it.Release()
+
+	for _, key := range keys {
+		id := hash.BytesToEvent(key[4:])
+		vi.callback.DeleteVectors(id)
+		if err := vi.table.EventBranch.Delete(id.Bytes()); err != nil {
+			vi.crit(err)
+		}
+		if err := vi.table.FrameEvents.Delete(key); err != nil {
+			vi.crit(err)
+		}
+	}
+	vi.setPrunedFrame(below)
+}
+
+// IsPruned returns true if the event might be pruned, i.e. its vectors may be missing
+func (vi *Engine) IsPruned(id hash.Event) bool {
+	if vi.cfg.PruneFrames == 0 {
+		return false
+	}
+	e := vi.getEvent(id)
+	return e != nil && e.Frame() < vi.getPrunedFrame()
+}
+
+func (vi *Engine) setPrunedFrame(frame idx.Frame) {
+	if err := vi.table.BranchesInfo.Put([]byte("p"), frame.Bytes()); err != nil {
+		vi.crit(err)
+	}
+}
+
+func (vi *Engine) getPrunedFrame() idx.Frame {
+	b, err := vi.table.BranchesInfo.Get([]byte("p"))
+	if err != nil {
+		vi.crit(err)
+	}
+	if b == nil {
+		return 0
+	}
+	return idx.BytesToFrame(b)
+}
@@ -9,6 +9,8 @@ import (
 // TestSizeLimit is used as the limit in unit-test of packages that use vecflushable
 const TestSizeLimit = 100000
 
+// backedMap is a map which unloads its data into the backup store when its size exceeds the limit.
+// Deleted keys are kept in the map with nil values until they are unloaded.
 type backedMap struct {
 	cache      map[string][]byte
 	backup     kvdb.Store
@@ -27,8 +29,8 @@ func newBackedMap(backup kvdb.Store, maxMemSize, batchSize int) *backedMap {
 }
 
 func (w *backedMap) has(key []byte) (bool, error) {
-	if _, ok := w.cache[string(key)]; ok {
-		return true, nil
+	if val, ok := w.cache[string(key)]; ok {
+		return val != nil, nil
 	}
 	val, err := w.backup.Get(key)
 	if err != nil {
@@ -50,12 +52,11 @@ func (w *backedMap) close() error {
 }
 
 func (w *backedMap) add(key string, val []byte) {
-	lenBefore := len(w.cache)
-	w.cache[key] = val
-	// TODO it works correctly only if new key/value have the same size (which is practically true currently)
-	if len(w.cache) > lenBefore {
-		w.memSize += mapMemEst(len(key), len(val))
+	if old, ok := w.cache[key]; ok {
+		w.memSize -= mapMemEst(len(key), len(old))
 	}
+	w.cache[key] = val
+	w.memSize += mapMemEst(len(key), len(val))
 }
 
 // mayUnload evicts and flushes one batch of data
@@ -75,18 +76,18 @@ func (w *backedMap) unload(toUnload int) error {
 	defer batch.Reset()
 
 	for key, val := range w.cache {
-		err := batch.Put([]byte(key), val)
+		var err error
+		if val == nil {
+			err = batch.Delete([]byte(key))
+		} else {
+			err = batch.Put([]byte(key), val)
+		}
 		if err != nil {
 			return err
 		}
 
 		delete(w.cache, key)
-		rmS := mapMemEst(len(key), len(val))
-		if rmS <= w.memSize {
-			w.memSize -= rmS
-		} else {
-			w.memSize = 0
-		}
+		w.memSize -= mapMemEst(len(key), len(val))
 
 		if batch.ValueSize() >= toUnload {
 			break
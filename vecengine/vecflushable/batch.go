@@ -0,0 +1,65 @@
+package vecflushable
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+)
+
+// NewBatch creates new batch. The batch is written into the not flushed pairs.
+func (w *VecFlushable) NewBatch() kvdb.Batch {
+	return &cacheBatch{db: w}
+}
+
+// cacheBatch is a batch structure.
+type cacheBatch struct {
+	db     *VecFlushable
+	writes []kv
+	size   int
+}
+
+// Put adds "add key-value pair" operation into batch.
+func (b *cacheBatch) Put(key, value []byte) error {
+	b.writes = append(b.writes, kv{common.CopyBytes(key), common.CopyBytes(value)})
+	b.size += len(value) + len(key)
+	return nil
+}
+
+// Delete adds "remove key" operation into batch.
+func (b *cacheBatch) Delete(key []byte) error {
+	b.writes = append(b.writes, kv{common.CopyBytes(key), nil})
+	b.size += len(key)
+	return nil
+}
+
+// Write writes batch into db. Not atomic.
+func (b *cacheBatch) Write() error {
+	return b.Replay(b.db)
+}
+
+// ValueSize returns key-values sizes sum.
+func (b *cacheBatch) ValueSize() int {
+	return b.size
+}
+
+// Reset cleans whole batch.
+func (b *cacheBatch) Reset() {
+	b.writes = b.writes[:0]
+	b.size = 0
+}
+
+// Replay replays the batch contents.
+func (b *cacheBatch) Replay(w kvdb.Writer) error {
+	for _, kv := range b.writes {
+		if kv.v == nil {
+			if err := w.Delete(kv.k); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.Put(kv.k, kv.v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package vecflushable
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/devnulldb"
+)
+
+var devnull = devnulldb.New()
+
+type kv struct {
+	k, v []byte
+}
+
+// collectPairs copies the pairs of src with the prefix and not lower than prefix+start into dst
+func collectPairs(dst, src map[string][]byte, prefix, start []byte) {
+	from := string(append(common.CopyBytes(prefix), start...))
+	for key, val := range src {
+		if key >= from && bytes.HasPrefix([]byte(key), prefix) {
+			dst[key] = val
+		}
+	}
+}
+
+// sortedPairs returns the pairs sorted by key
+func sortedPairs(m map[string][]byte) []kv {
+	pairs := make([]kv, 0, len(m))
+	for key, val := range m {
+		pairs = append(pairs, kv{[]byte(key), val})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return bytes.Compare(pairs[i].k, pairs[j].k) < 0
+	})
+	return pairs
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset
+// of database content with a particular key prefix, starting at a particular
+// initial key (or after, if it does not exist).
+// The in-memory pairs are copied on the iterator creation, so the iterator isn't affected by the later writes into VecFlushable.
+func (w *VecFlushable) NewIterator(prefix []byte, start []byte) kvdb.Iterator {
+	if w.modified == nil {
+		return &errIterator{
+			Iterator: devnull.NewIterator(nil, nil),
+			err:      errClosed,
+		}
+	}
+	mem := make(map[string][]byte)
+	// not flushed pairs have a priority
+	collectPairs(mem, w.underlying.cache, prefix, start)
+	collectPairs(mem, w.modified, prefix, start)
+	return newIterator(sortedPairs(mem), w.underlying.backup.NewIterator(prefix, start))
+}
+
+// iterator merges sorted in-memory pairs with a parent iterator, the in-memory pairs have a priority.
+type iterator struct {
+	mem []kv
+
+	parentIt kvdb.Iterator
+	parentOk bool
+
+	key, val []byte
+}
+
+func newIterator(mem []kv, parentIt kvdb.Iterator) *iterator {
+	it := &iterator{
+		mem:      mem,
+		parentIt: parentIt,
+	}
+	it.parentOk = parentIt.Next()
+	return it
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the iterator is exhausted.
+func (it *iterator) Next() bool {
+	for it.Error() == nil && (len(it.mem) != 0 || it.parentOk) {
+		if len(it.mem) != 0 && (!it.parentOk || bytes.Compare(it.mem[0].k, it.parentIt.Key()) <= 0) {
+			if it.parentOk && bytes.Equal(it.mem[0].k, it.parentIt.Key()) {
+				// parent's value is overridden
+				it.parentOk = it.parentIt.Next()
+			}
+			pair := it.mem[0]
+			it.mem = it.mem[1:]
+			if pair.v == nil {
+				// deleted key
+				continue
+			}
+			it.key, it.val = pair.k, pair.v
+			return true
+		}
+
+		it.key = common.CopyBytes(it.parentIt.Key()) // leveldb's iterator may use the same memory
+		it.val = common.CopyBytes(it.parentIt.Value())
+		it.parentOk = it.parentIt.Next()
+		return true
+	}
+	it.key, it.val = nil, nil
+	return false
+}
+
+// Error returns any accumulated error of the parent iterator.
+func (it *iterator) Error() error {
+	return it.parentIt.Error()
+}
+
+// Key returns the key of the current key/value pair, or nil if done.
+func (it *iterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the value of the current key/value pair, or nil if done.
+func (it *iterator) Value() []byte {
+	return it.val
+}
+
+// Release releases associated resources. Release should always succeed and can
+// be called multiple times without causing error.
+func (it *iterator) Release() {
+	it.parentIt.Release()
+	it.mem = nil
+	it.key, it.val = nil, nil
+}
+
+type errIterator struct {
+	kvdb.Iterator
+	err error
+}
+
+func (it *errIterator) Error() error {
+	return it.err
+}
+
+// Snapshot is a DB snapshot.
+type Snapshot struct {
+	mem        map[string][]byte
+	parentSnap kvdb.Snapshot
+}
+
+// GetSnapshot returns a latest snapshot of the DB, including the not flushed pairs.
+// The in-memory pairs are copied, so it may be expensive if the memory limit is high.
+// The snapshot must be released after use, by calling Release method.
+func (w *VecFlushable) GetSnapshot() (kvdb.Snapshot, error) {
+	if w.modified == nil {
+		return nil, errClosed
+	}
+	parentSnap, err := w.underlying.backup.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	mem := make(map[string][]byte, len(w.underlying.cache)+len(w.modified))
+	collectPairs(mem, w.underlying.cache, nil, nil)
+	collectPairs(mem, w.modified, nil, nil)
+	return &Snapshot{
+		mem:        mem,
+		parentSnap: parentSnap,
+	}, nil
+}
+
+// Has checks if key is in the snapshot.
+func (s *Snapshot) Has(key []byte) (bool, error) {
+	if s.mem == nil {
+		return false, errClosed
+	}
+	if val, ok := s.mem[string(key)]; ok {
+		return val != nil, nil
+	}
+	return s.parentSnap.Has(key)
+}
+
+// Get returns the value by key.
+func (s *Snapshot) Get(key []byte) ([]byte, error) {
+	if s.mem == nil {
+		return nil, errClosed
+	}
+	if val, ok := s.mem[string(key)]; ok {
+		return common.CopyBytes(val), nil
+	}
+	return s.parentSnap.Get(key)
+}
+
+// NewIterator creates an iterator over the snapshot, see VecFlushable.NewIterator.
+func (s *Snapshot) NewIterator(prefix []byte, start []byte) kvdb.Iterator {
+	if s.mem == nil {
+		return &errIterator{
+			Iterator: devnull.NewIterator(nil, nil),
+			err:      errClosed,
+		}
+	}
+	mem := make(map[string][]byte)
+	collectPairs(mem, s.mem, prefix, start)
+	return newIterator(sortedPairs(mem), s.parentSnap.NewIterator(prefix, start))
+}
+
+// Release releases the snapshot.
+func (s *Snapshot) Release() {
+	if s.mem != nil {
+		s.parentSnap.Release()
+		s.mem = nil
+	}
+}
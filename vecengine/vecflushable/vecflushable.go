@@ -9,8 +9,7 @@ import (
 )
 
 var (
-	errClosed         = errors.New("vecflushable - database closed")
-	errNotImplemented = errors.New("vecflushable - not implemented")
+	errClosed = errors.New("vecflushable - database closed")
 )
 
 // mapConst is an approximation of the number of extra bytes used by native go
@@ -21,9 +20,12 @@ func mapMemEst(keyS, valueS int) int {
 	return mapConst + keyS + valueS
 }
 
-// VecFlushable is a fast, append only, Flushable intended for the vecengine.
-// It does not implement all of the Flushable interface, just what is needed by
-// the vecengine.
+// VecFlushable is a fast Flushable intended for the vecengine and other append-heavy tables.
+// Unlike flushable.Flushable, it keeps the pairs in native go maps, which makes writes and reads faster,
+// but iterators and snapshots have to sort the in-memory pairs.
+// Not flushed pairs are kept in the modified map, flushed pairs are kept in the backed map until they are unloaded into the parent DB.
+// Deleted keys have nil values in both maps.
+// It isn't safe for concurrent use.
 type VecFlushable struct {
 	modified   map[string][]byte
 	underlying backedMap
@@ -53,9 +55,8 @@ func (w *VecFlushable) Has(key []byte) (bool, error) {
 	if w.modified == nil {
 		return false, errClosed
 	}
-	_, ok := w.modified[string(key)]
-	if ok {
-		return true, nil
+	if val, ok := w.modified[string(key)]; ok {
+		return val != nil, nil
 	}
 	return w.underlying.has(key)
 }
@@ -74,11 +75,34 @@ func (w *VecFlushable) Put(key []byte, value []byte) error {
 	if value == nil || key == nil {
 		return errors.New("vecflushable: key or value is nil")
 	}
-	w.modified[string(key)] = common.CopyBytes(value)
-	w.memSize += mapMemEst(len(key), len(value))
+	if w.modified == nil {
+		return errClosed
+	}
+	w.set(string(key), common.CopyBytes(value))
+	return nil
+}
+
+// Delete removes key-value pair by key. In parent DB, key won't be deleted until .Flush() is called and the pair is unloaded.
+func (w *VecFlushable) Delete(key []byte) error {
+	if key == nil {
+		return errors.New("vecflushable: key is nil")
+	}
+	if w.modified == nil {
+		return errClosed
+	}
+	w.set(string(key), nil)
 	return nil
 }
 
+// set stores the not flushed value, nil value is a deletion
+func (w *VecFlushable) set(key string, value []byte) {
+	if old, ok := w.modified[key]; ok {
+		w.memSize -= mapMemEst(len(key), len(old))
+	}
+	w.modified[key] = value
+	w.memSize += mapMemEst(len(key), len(value))
+}
+
 func (w *VecFlushable) NotFlushedPairs() int {
 	return len(w.modified)
 }
@@ -119,36 +143,25 @@ func (w *VecFlushable) Close() error {
 	return w.underlying.close()
 }
 
+// Drop whole parent database.
 func (w *VecFlushable) Drop() {
-	panic(errNotImplemented)
+	if w.modified != nil {
+		panic("close db first")
+	}
+	w.underlying.backup.Drop()
 }
 
 func (w *VecFlushable) AncientDatadir() (string, error) {
-	panic(errNotImplemented)
-}
-
-/* Some methods are not implemented and panic when called */
-
-func (w *VecFlushable) Delete(key []byte) error {
-	panic(errNotImplemented)
-}
-
-func (w *VecFlushable) GetSnapshot() (kvdb.Snapshot, error) {
-	panic(errNotImplemented)
-}
-
-func (w *VecFlushable) NewIterator(prefix []byte, start []byte) kvdb.Iterator {
-	panic(errNotImplemented)
+	return w.underlying.backup.AncientDatadir()
 }
 
+// Stat returns a particular internal stat of the parent database.
 func (w *VecFlushable) Stat() (string, error) {
-	panic(errNotImplemented)
+	return w.underlying.backup.Stat()
 }
 
+// Compact flattens the parent data store for the given key range.
+// The pairs which aren't unloaded into the parent DB yet aren't affected.
 func (w *VecFlushable) Compact(start []byte, limit []byte) error {
-	panic(errNotImplemented)
-}
-
-func (w *VecFlushable) NewBatch() kvdb.Batch {
-	panic(errNotImplemented)
+	return w.underlying.backup.Compact(start, limit)
 }
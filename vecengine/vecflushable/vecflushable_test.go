@@ -3,18 +3,21 @@ package vecflushable
 import (
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"reflect"
 	"runtime"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 
 	"github.com/Fantom-foundation/lachesis-base/common/bigendian"
 	"github.com/Fantom-foundation/lachesis-base/kvdb"
 	"github.com/Fantom-foundation/lachesis-base/kvdb/devnulldb"
 	"github.com/Fantom-foundation/lachesis-base/kvdb/leveldb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
 )
 
 // TestVecflushableNoBackup tests normal operation of vecflushable, before and after
@@ -166,6 +169,49 @@ func TestVecflushableUpdateValue(t *testing.T) {
 	assert.Equal(t, 356, vecflushable.underlying.memSize)
 }
 
+// TestVecflushableMixedPutDelete tests that the size estimation is consistent when
+// the same keys are deleted and put again with values of different sizes.
+func TestVecflushableMixedPutDelete(t *testing.T) {
+	backupDB, _ := tempLevelDB()
+	vecflushable := Wrap(backupDB, 100000)
+
+	keys := [][]byte{
+		bigendian.Uint64ToBytes(uint64(0)),
+		bigendian.Uint64ToBytes(uint64(1)),
+	}
+	smallVal := make([]byte, 8)
+	bigVal := make([]byte, 70)
+
+	for i := 0; i < 3; i++ {
+		for _, key := range keys {
+			require.NoError(t, vecflushable.Put(key, smallVal))
+			require.NoError(t, vecflushable.Delete(key))
+			require.NoError(t, vecflushable.Put(key, bigVal))
+		}
+		assert.Equal(t, len(keys)*mapMemEst(8, 70), vecflushable.NotFlushedSizeEst())
+		require.NoError(t, vecflushable.Flush())
+		assert.Equal(t, len(keys)*mapMemEst(8, 70), vecflushable.underlying.memSize)
+
+		for _, key := range keys {
+			require.NoError(t, vecflushable.Delete(key))
+		}
+		assert.Equal(t, len(keys)*mapMemEst(8, 0), vecflushable.NotFlushedSizeEst())
+		require.NoError(t, vecflushable.Flush())
+		assert.Equal(t, len(keys)*mapMemEst(8, 0), vecflushable.underlying.memSize)
+	}
+
+	// unload everything into the backup store
+	for len(vecflushable.underlying.cache) != 0 {
+		require.NoError(t, vecflushable.underlying.unload(kvdb.IdealBatchSize))
+	}
+	assert.Equal(t, 0, vecflushable.underlying.memSize)
+	for _, key := range keys {
+		has, err := vecflushable.Has(key)
+		require.NoError(t, err)
+		assert.False(t, has)
+	}
+}
+
 func TestSizeBenchmark(t *testing.T) {
 	return // remove to benchmark
 	for _, numItems := range []int{10, 100, 1000, 10000, 100000, 1000000, 10000000} {
@@ -227,3 +273,115 @@ func tempLevelDB() (kvdb.Store, error) {
 	ldb, _ := disk.OpenDB("0")
 	return ldb, nil
 }
+
+// TestVecflushableStore compares VecFlushable with a reference DB under random writes, deletions, flushes and unloads.
+func TestVecflushableStore(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(0)) // nolint:gosec
+
+	expected := memorydb.New()
+	vecflushable := wrap(memorydb.New(), 2000, 300)
+
+	randKey := func() []byte {
+		return []byte{byte(r.Intn(4)), byte(r.Intn(16))}
+	}
+	assertEqualIteration := func(expected, got kvdb.IteratedReader, prefix, start []byte) {
+		expectedIt := expected.NewIterator(prefix, start)
+		defer expectedIt.Release()
+		gotIt := got.NewIterator(prefix, start)
+		defer gotIt.Release()
+		for expectedIt.Next() {
+			require.True(gotIt.Next())
+			require.Equal(expectedIt.Key(), gotIt.Key())
+			require.Equal(expectedIt.Value(), gotIt.Value())
+		}
+		require.False(gotIt.Next())
+		require.NoError(gotIt.Error())
+	}
+	assertEqual := func(expected, got kvdb.IteratedReader) {
+		for i := 0; i < 4; i++ {
+			for j := 0; j < 16; j++ {
+				key := []byte{byte(i), byte(j)}
+				expectedVal, err := expected.Get(key)
+				require.NoError(err)
+				val, err := got.Get(key)
+				require.NoError(err)
+				require.Equal(expectedVal, val)
+				has, err := got.Has(key)
+				require.NoError(err)
+				require.Equal(expectedVal != nil, has)
+			}
+		}
+		assertEqualIteration(expected, got, nil, nil)
+		assertEqualIteration(expected, got, []byte{1}, nil)
+		assertEqualIteration(expected, got, []byte{2}, []byte{8})
+		assertEqualIteration(expected, got, nil, []byte{1, 3})
+	}
+
+	var snapshot, expectedSnapshot kvdb.Snapshot
+	for i := 0; i < 1000; i++ {
+		switch op := r.Intn(10); {
+		case op < 5:
+			key, val := randKey(), bigendian.Uint64ToBytes(uint64(i))
+			require.NoError(expected.Put(key, val))
+			require.NoError(vecflushable.Put(key, val))
+		case op < 8:
+			key := randKey()
+			require.NoError(expected.Delete(key))
+			require.NoError(vecflushable.Delete(key))
+		case op < 9:
+			batch := vecflushable.NewBatch()
+			for j := 0; j < 5; j++ {
+				key := randKey()
+				if r.Intn(2) == 0 {
+					require.NoError(expected.Delete(key))
+					require.NoError(batch.Delete(key))
+				} else {
+					require.NoError(expected.Put(key, []byte{byte(j)}))
+					require.NoError(batch.Put(key, []byte{byte(j)}))
+				}
+			}
+			require.NoError(batch.Write())
+		default:
+			require.NoError(vecflushable.Flush())
+		}
+		if i%100 == 0 {
+			assertEqual(expected, vecflushable)
+			if snapshot != nil {
+				// snapshot isn't affected by the later writes
+				assertEqual(expectedSnapshot, snapshot)
+				snapshot.Release()
+				expectedSnapshot.Release()
+			}
+			var err error
+			snapshot, err = vecflushable.GetSnapshot()
+			require.NoError(err)
+			expectedSnapshot, err = expected.GetSnapshot()
+			require.NoError(err)
+		}
+	}
+	assertEqual(expected, vecflushable)
+	// some pairs were unloaded
+	backupIt := vecflushable.underlying.backup.NewIterator(nil, nil)
+	require.True(backupIt.Next())
+	backupIt.Release()
+
+	// deletions are unloaded into the backup DB
+	require.NoError(vecflushable.Flush())
+	require.NoError(vecflushable.underlying.unload(1 << 30))
+	require.Zero(len(vecflushable.underlying.cache))
+	assertEqual(expected, vecflushable.underlying.backup)
+	assertEqual(expected, vecflushable)
+
+	// not flushed pairs are dropped
+	require.NoError(vecflushable.Delete([]byte{0, 0}))
+	require.NoError(vecflushable.Put([]byte{0, 1}, []byte{1}))
+	vecflushable.DropNotFlushed()
+	assertEqual(expected, vecflushable)
+
+	require.NoError(vecflushable.Close())
+	it := vecflushable.NewIterator(nil, nil)
+	require.False(it.Next())
+	require.Equal(errClosed, it.Error())
+	require.Equal(errClosed, vecflushable.Put([]byte{0}, []byte{0}))
+}
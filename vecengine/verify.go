@@ -0,0 +1,90 @@
+package vecengine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// ErrInconsistentIndex is returned if the stored data of an indexed event doesn't match the recalculated one
+var ErrInconsistentIndex = errors.New("inconsistent vector index")
+
+// VerifyEventBranchID checks that the stored branch ID of an indexed event is consistent with its creator,
+// its self-parent and the branches info, and returns the branch ID.
+func (vi *Engine) VerifyEventBranchID(e dag.Event) (idx.Validator, error) {
+	vi.InitBranchesInfo()
+
+	b := vi.getBytes(vi.table.EventBranch, e.ID())
+	if b == nil {
+		return 0, fmt.Errorf("%w: branch ID isn't found", ErrInconsistentIndex)
+	}
+	branchID := idx.BytesToValidator(b)
+	if int(branchID) >= len(vi.bi.BranchIDCreatorIdxs) || len(vi.bi.BranchIDCreatorIdxs) != len(vi.bi.BranchIDLastSeq) {
+		return 0, fmt.Errorf("%w: unknown branch ID %d", ErrInconsistentIndex, branchID)
+	}
+	if vi.bi.BranchIDCreatorIdxs[branchID] != vi.validatorIdxs[e.Creator()] {
+		return 0, fmt.Errorf("%w: branch ID %d belongs to another validator", ErrInconsistentIndex, branchID)
+	}
+	if e.Seq() > vi.bi.BranchIDLastSeq[branchID] {
+		return 0, fmt.Errorf("%w: seq %d is higher than last seq %d of branch ID %d", ErrInconsistentIndex, e.Seq(), vi.bi.BranchIDLastSeq[branchID], branchID)
+	}
+
+	if e.SelfParent() != nil {
+		sp := vi.getBytes(vi.table.EventBranch, *e.SelfParent())
+		if sp == nil {
+			if vi.IsPruned(*e.SelfParent()) {
+				// the branch can't be verified against the pruned self-parent
+				return branchID, nil
+			}
+			return 0, fmt.Errorf("%w: branch ID of self-parent %s isn't found", ErrInconsistentIndex, e.SelfParent().String())
+		}
+		if idx.BytesToValidator(sp) == branchID {
+			return branchID, nil
+		}
+	}
+	// event starts a branch
+	first := vi.getBranchFirstEvent(branchID)
+	if first == hash.ZeroEvent {
+		// first events aren't recorded by older versions
+		return branchID, nil
+	}
+	if first != e.ID() {
+		return 0, fmt.Errorf("%w: branch ID %d is started by another event %s", ErrInconsistentIndex, branchID, first.String())
+	}
+	return branchID, nil
+}
+
+// CalcHighestBefore recalculates HighestBefore vector of an indexed event from the stored vectors of its parents.
+// Only the branches with IDs lower than size are taken into account,
+// i.e. size must be the number of branches at the moment when the event was indexed, which is the size of its stored vector.
+func (vi *Engine) CalcHighestBefore(e dag.Event, branchID idx.Validator, size idx.Validator) (HighestBeforeI, error) {
+	vi.InitBranchesInfo()
+
+	if size < vi.validators.Len() || int(size) > len(vi.bi.BranchIDCreatorIdxs) || branchID >= size {
+		return nil, fmt.Errorf("%w: wrong number of branches %d", ErrInconsistentIndex, size)
+	}
+	// branches which were known at the moment when the event was indexed
+	branchesByCreators := make([][]idx.Validator, len(vi.bi.BranchIDByCreators))
+	for creatorIdx, branches := range vi.bi.BranchIDByCreators {
+		for _, b := range branches {
+			if b < size {
+				branchesByCreators[creatorIdx] = append(branchesByCreators[creatorIdx], b)
+			}
+		}
+	}
+
+	before := vi.callback.NewHighestBefore(size)
+	before.InitWithEvent(branchID, e)
+	for _, p := range e.Parents() {
+		pVec := vi.callback.GetHighestBefore(p)
+		if pVec == nil {
+			return nil, fmt.Errorf("%w: HighestBefore of parent %s isn't found", ErrInconsistentIndex, p.String())
+		}
+		before.CollectFrom(pVec, size)
+	}
+	detectForks(before, vi.validators.Len(), branchesByCreators)
+	return before, nil
+}
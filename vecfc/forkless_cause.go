@@ -38,6 +38,30 @@ func (vi *Index) ForklessCause(aID, bID hash.Event) bool {
 	return res
 }
 
+// ForklessCauseMany returns ForklessCause(a, b) for each of bs.
+// HighestBefore vector of A is loaded only once for all the candidates.
+func (vi *Index) ForklessCauseMany(aID hash.Event, bs hash.Events) []bool {
+	res := make([]bool, len(bs))
+	var a *HighestBeforeSeq
+	for i, bID := range bs {
+		if cached, ok := vi.cache.ForklessCause.Get(kv{aID, bID}); ok {
+			res[i] = cached.(bool)
+			continue
+		}
+		if a == nil {
+			vi.Engine.InitBranchesInfo()
+			a = vi.GetHighestBefore(aID)
+			if a == nil {
+				vi.crit(fmt.Errorf("Event A=%s not found", aID.String()))
+				return res
+			}
+		}
+		res[i] = vi.forklessCauseWith(a, bID)
+		vi.cache.ForklessCause.Add(kv{aID, bID}, res[i], 1)
+	}
+	return res
+}
+
 func (vi *Index) forklessCause(aID, bID hash.Event) bool {
 	// Get events by hash
 	a := vi.GetHighestBefore(aID)
@@ -45,7 +69,10 @@ func (vi *Index) forklessCause(aID, bID hash.Event) bool {
 		vi.crit(fmt.Errorf("Event A=%s not found", aID.String()))
 		return false
 	}
+	return vi.forklessCauseWith(a, bID)
+}
 
+func (vi *Index) forklessCauseWith(a *HighestBeforeSeq, bID hash.Event) bool {
 	// check A doesn't observe any forks from B
 	if vi.Engine.AtLeastOneFork() {
 		bBranchID := vi.Engine.GetEventBranchID(bID)
@@ -101,6 +128,8 @@ func (vi *Index) ForklessCauseProgress(aID, bID hash.Event, candidateParents, ch
 	}
 	chosenParentsFCProgress := vi.validators.NewCounter() // initialise the counter for chosen parents only
 
+	vi.Engine.InitBranchesInfo()
+
 	// Get events by hash
 	aHB := vi.GetHighestBefore(aID)
 	if aHB == nil {
@@ -851,3 +851,42 @@ func codegen4ForklessCausedStability() {
 	fmt.Printf("}\n")
 }
 */
+
+func TestForklessCauseMany(t *testing.T) {
+	assertar := assert.New(t)
+
+	nodes := tdag.GenNodes(8)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	processed := make(map[hash.Event]dag.Event)
+	getEvent := func(id hash.Event) dag.Event {
+		return processed[id]
+	}
+
+	single := NewIndex(tCrit, LiteConfig())
+	single.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+	batched := NewIndex(tCrit, LiteConfig())
+	batched.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+
+	ordered := make(hash.Events, 0)
+	tdag.ForEachRandFork(nodes, nodes[:2], 30, 4, 5, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			processed[e.ID()] = e
+			ordered = append(ordered, e.ID())
+			assertar.NoError(single.Add(e))
+			single.Flush()
+			assertar.NoError(batched.Add(e))
+			batched.Flush()
+		},
+	})
+
+	for i, a := range ordered {
+		// some of the pairs are cached
+		if i%2 == 0 {
+			batched.ForklessCause(a, ordered[i/2])
+		}
+		res := batched.ForklessCauseMany(a, ordered)
+		for j, b := range ordered {
+			assertar.Equal(single.ForklessCause(a, b), res[j], "a=%s b=%s", a, b)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package vecfc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+func TestForkInfo(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(8)
+	cheaters := nodes[:3]
+	validators := pos.EqualWeightValidators(nodes, 1)
+	events := make(map[hash.Event]dag.Event)
+
+	vi := NewIndex(tCrit, LiteConfig())
+	vi.Reset(validators, flushable.Wrap(memorydb.New()), func(id hash.Event) dag.Event {
+		return events[id]
+	})
+	require.Nil(vi.Forks())
+
+	ordered := make(dag.Events, 0)
+	tdag.ForEachRandFork(nodes, cheaters, 50, 3, 5, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			events[e.ID()] = e
+			ordered = append(ordered, e)
+			require.NoError(vi.Add(e))
+			vi.Flush()
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetFrame(idx.Frame(e.Lamport()/5 + 1))
+			return nil
+		},
+	})
+
+	forks := vi.Forks()
+	require.NotEmpty(forks)
+	forked := map[idx.ValidatorID]bool{}
+	observed := 0
+	for _, info := range forks {
+		observed += len(info.Observers)
+		forked[info.Validator] = true
+		require.Contains(cheaters, info.Validator)
+		require.Equal(info, *vi.GetForkInfo(info.Validator))
+		require.Greater(len(info.Branches), 1)
+
+		// first events of branches
+		for _, branch := range info.Branches {
+			first := events[branch.FirstEvent]
+			require.NotNil(first)
+			require.Equal(info.Validator, first.Creator())
+			require.Equal(branch.ID, vi.GetEventBranchID(first.ID()))
+			for _, e := range ordered {
+				if vi.GetEventBranchID(e.ID()) == branch.ID {
+					require.LessOrEqual(first.Seq(), e.Seq())
+					require.LessOrEqual(e.Seq(), branch.LastSeq)
+				}
+			}
+		}
+
+		// observers of the fork
+		creatorIdx := validators.GetIdx(info.Validator)
+		observers := hash.NewEventsSet(info.Observers...)
+		minFrame := idx.Frame(0)
+		for _, e := range ordered {
+			if !vi.GetHighestBefore(e.ID()).Get(creatorIdx).IsForkDetected() {
+				require.False(observers.Contains(e.ID()))
+				continue
+			}
+			parentObserves := false
+			for _, p := range e.Parents() {
+				parentObserves = parentObserves || vi.GetHighestBefore(p).Get(creatorIdx).IsForkDetected()
+			}
+			require.Equal(!parentObserves, observers.Contains(e.ID()))
+			if !parentObserves && (minFrame == 0 || e.Frame() < minFrame) {
+				minFrame = e.Frame()
+			}
+		}
+		require.Equal(minFrame, info.VisibleAtFrame)
+	}
+
+	require.NotZero(observed)
+
+	for _, v := range nodes {
+		if !forked[v] {
+			require.Nil(vi.GetForkInfo(v))
+		}
+	}
+	require.Nil(vi.GetForkInfo(idx.ValidatorID(1000)))
+}
@@ -22,6 +22,10 @@ type IndexCacheConfig struct {
 // IndexConfig - Engine config (cache sizes)
 type IndexConfig struct {
 	Caches IndexCacheConfig
+	// CompactVectors enables compact encoding of the stored vectors, disabled by default.
+	// Vectors stored in the other encoding are migrated on Reset.
+	CompactVectors bool
+	Engine         vecengine.Config
 }
 
 // Index is a data to detect forkless-cause condition, calculate median timestamp, detect forks.
@@ -35,10 +39,10 @@ type Index struct {
 	getEvent func(hash.Event) dag.Event
 
 	vecDb kvdb.Store
-	table struct {
-		HighestBeforeSeq kvdb.Store `table:"S"`
-		LowestAfterSeq   kvdb.Store `table:"s"`
-	}
+	table vectorTables
+
+	// callback is the storage of the vectors, which is shared with the engine
+	callback vecengine.Callbacks
 
 	cache struct {
 		HighestBeforeSeq *simplewlru.Cache
@@ -57,6 +61,7 @@ func DefaultConfig(scale cachescale.Func) IndexConfig {
 			HighestBeforeSeqSize: scale.U(160 * 1024),
 			LowestAfterSeqSize:   scale.U(160 * 1024),
 		},
+		Engine: vecengine.DefaultConfig(),
 	}
 }
 
@@ -71,7 +76,8 @@ func NewIndex(crit func(error), config IndexConfig) *Index {
 		cfg:  config,
 		crit: crit,
 	}
-	vi.Engine = vecengine.NewIndex(crit, vi.GetEngineCallbacks())
+	vi.callback = vi.GetEngineCallbacks()
+	vi.Engine = vecengine.NewIndexWithConfig(crit, config.Engine, vi.callback)
 	vi.initCaches()
 
 	return vi
@@ -83,6 +89,7 @@ func NewIndexWithEngine(crit func(error), config IndexConfig, engine *vecengine.
 		cfg:    config,
 		crit:   crit,
 	}
+	vi.callback = vi.GetEngineCallbacks()
 	vi.initCaches()
 
 	return vi
@@ -99,6 +106,9 @@ func (vi *Index) Reset(validators *pos.Validators, db kvdb.FlushableKVStore, get
 	vi.Engine.Reset(validators, db, getEvent)
 	vi.vecDb = db
 	table.MigrateTables(&vi.table, vi.vecDb)
+	if err := vi.table.migrate(vi.cfg.CompactVectors); err != nil {
+		vi.crit(err)
+	}
 	vi.getEvent = getEvent
 	vi.validators = validators
 	vi.validatorIdxs = validators.Idxs()
@@ -106,19 +116,34 @@ func (vi *Index) Reset(validators *pos.Validators, db kvdb.FlushableKVStore, get
 	vi.onDropNotFlushed()
 }
 
+// GetEngineCallbacks returns the callbacks which store the vectors in DB
 func (vi *Index) GetEngineCallbacks() vecengine.Callbacks {
 	return vecengine.Callbacks{
 		GetHighestBefore: func(event hash.Event) vecengine.HighestBeforeI {
-			return vi.GetHighestBefore(event)
+			// return untyped nil if not found
+			if b := vi.getHighestBefore(event); b != nil {
+				return b
+			}
+			return nil
 		},
 		GetLowestAfter: func(event hash.Event) vecengine.LowestAfterI {
-			return vi.GetLowestAfter(event)
+			if b := vi.getLowestAfter(event); b != nil {
+				return b
+			}
+			return nil
 		},
 		SetHighestBefore: func(event hash.Event, b vecengine.HighestBeforeI) {
-			vi.SetHighestBefore(event, b.(*HighestBeforeSeq))
+			vi.setHighestBefore(event, b.(*HighestBeforeSeq))
 		},
 		SetLowestAfter: func(event hash.Event, b vecengine.LowestAfterI) {
-			vi.SetLowestAfter(event, b.(*LowestAfterSeq))
+			vi.setLowestAfter(event, b.(*LowestAfterSeq))
+		},
+		SetLowestAfterBatch: func(events hash.Events, bb []vecengine.LowestAfterI) {
+			seqs := make([]*LowestAfterSeq, len(bb))
+			for i, b := range bb {
+				seqs[i] = b.(*LowestAfterSeq)
+			}
+			vi.setLowestAfterBatch(events, seqs)
 		},
 		NewHighestBefore: func(size idx.Validator) vecengine.HighestBeforeI {
 			return NewHighestBeforeSeq(size)
@@ -127,6 +152,7 @@ func (vi *Index) GetEngineCallbacks() vecengine.Callbacks {
 			return NewLowestAfterSeq(size)
 		},
 		OnDropNotFlushed: vi.onDropNotFlushed,
+		DeleteVectors:    vi.deleteVectors,
 	}
 }
 
@@ -135,7 +161,33 @@ func (vi *Index) onDropNotFlushed() {
 	vi.cache.LowestAfterSeq.Purge()
 }
 
+// GetHighestBefore reads the vector from the storage, returns nil if the event isn't found or is pruned
+func (vi *Index) GetHighestBefore(id hash.Event) *HighestBeforeSeq {
+	// callbacks return untyped nil if not found
+	b, _ := vi.callback.GetHighestBefore(id).(*HighestBeforeSeq)
+	return b
+}
+
+// GetLowestAfter reads the vector from the storage, returns nil if the event isn't found or is pruned
+func (vi *Index) GetLowestAfter(id hash.Event) *LowestAfterSeq {
+	b, _ := vi.callback.GetLowestAfter(id).(*LowestAfterSeq)
+	return b
+}
+
+// SetHighestBefore stores the vector into the storage
+func (vi *Index) SetHighestBefore(id hash.Event, seq *HighestBeforeSeq) {
+	vi.callback.SetHighestBefore(id, seq)
+}
+
+// SetLowestAfter stores the vector into the storage
+func (vi *Index) SetLowestAfter(id hash.Event, seq *LowestAfterSeq) {
+	vi.callback.SetLowestAfter(id, seq)
+}
+
 // GetMergedHighestBefore returns HighestBefore vector clock without branches, where branches are merged into one
+// Returns nil if the event isn't found or is pruned.
 func (vi *Index) GetMergedHighestBefore(id hash.Event) *HighestBeforeSeq {
-	return vi.Engine.GetMergedHighestBefore(id).(*HighestBeforeSeq)
+	// engine returns untyped nil if not found
+	before, _ := vi.Engine.GetMergedHighestBefore(id).(*HighestBeforeSeq)
+	return before
 }
@@ -0,0 +1,256 @@
+package vecfc
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+)
+
+// genFramedForks generates events with forks, event frames are derived from Lamport timestamps
+func genFramedForks(nodes []idx.ValidatorID, eventCount int) dag.Events {
+	ordered := make(dag.Events, 0)
+	tdag.ForEachRandFork(nodes, nodes[:2], eventCount, 4, 10, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			ordered = append(ordered, e)
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			e.SetFrame(idx.Frame(e.Lamport()/3 + 1))
+			return nil
+		},
+	})
+	return ordered
+}
+
+func newEngineIndex(cfg vecengine.Config, validators *pos.Validators, ordered dag.Events) *Index {
+	events := make(map[hash.Event]dag.Event)
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+	for _, e := range ordered {
+		events[e.ID()] = e
+	}
+
+	indexCfg := LiteConfig()
+	indexCfg.Engine = cfg
+	vi := NewIndex(tCrit, indexCfg)
+	vi.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+	return vi
+}
+
+func indexEvents(cfg vecengine.Config, validators *pos.Validators, ordered dag.Events) *Index {
+	vi := newEngineIndex(cfg, validators, ordered)
+	for _, e := range ordered {
+		if err := vi.Add(e); err != nil {
+			panic(err)
+		}
+		vi.Flush()
+	}
+	return vi
+}
+
+// noPropagation is a LowestAfter vector which is never updated, it disables LowestAfter propagation of the engine
+type noPropagation struct{}
+
+func (noPropagation) InitWithEvent(idx.Validator, dag.Event) {}
+
+func (noPropagation) Visit(idx.Validator, dag.Event) bool {
+	return false
+}
+
+// indexEventsReference indexes the events with the reference LowestAfter propagation,
+// which visits the observed events by DfsSubgraph and stores every updated vector separately
+func indexEventsReference(validators *pos.Validators, ordered dag.Events) *Index {
+	events := make(map[hash.Event]dag.Event)
+	for _, e := range ordered {
+		events[e.ID()] = e
+	}
+	vi := NewIndex(tCrit, LiteConfig())
+	callbacks := vi.GetEngineCallbacks()
+	callbacks.GetLowestAfter = func(hash.Event) vecengine.LowestAfterI {
+		return noPropagation{}
+	}
+	vi.Engine = vecengine.NewIndex(tCrit, callbacks)
+	vi.Reset(validators, flushable.Wrap(memorydb.New()), func(id hash.Event) dag.Event {
+		return events[id]
+	})
+
+	for _, e := range ordered {
+		if err := vi.Add(e); err != nil {
+			panic(err)
+		}
+		branchID := vi.GetEventBranchID(e.ID())
+		err := vi.DfsSubgraph(e, func(walk hash.Event) (godeeper bool) {
+			lowestAfter := vi.GetLowestAfter(walk)
+			if lowestAfter.Visit(branchID, e) {
+				vi.SetLowestAfter(walk, lowestAfter)
+				return true
+			}
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+		vi.Flush()
+	}
+	return vi
+}
+
+func TestLowestAfterPropagation_Reference(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 50)
+
+	reference := indexEventsReference(validators, ordered)
+	vi := indexEvents(vecengine.DefaultConfig(), validators, ordered)
+	for _, e := range ordered {
+		require.Equal(reference.GetHighestBefore(e.ID()), vi.GetHighestBefore(e.ID()))
+		require.Equal(reference.GetLowestAfter(e.ID()), vi.GetLowestAfter(e.ID()))
+	}
+}
+
+func TestLowestAfterPropagation_Parallel(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 50)
+
+	sequential := indexEvents(vecengine.DefaultConfig(), validators, ordered)
+	parallel := indexEvents(vecengine.Config{Parallelism: 4}, validators, ordered)
+	for _, e := range ordered {
+		require.Equal(sequential.GetHighestBefore(e.ID()), parallel.GetHighestBefore(e.ID()))
+		require.Equal(sequential.GetLowestAfter(e.ID()), parallel.GetLowestAfter(e.ID()))
+	}
+}
+
+func TestLowestAfterPropagation_MaxFrameDistance(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 50)
+	events := make(map[hash.Event]dag.Event)
+	for _, e := range ordered {
+		events[e.ID()] = e
+	}
+
+	const maxFrameDistance = 2
+	unbounded := indexEvents(vecengine.DefaultConfig(), validators, ordered)
+	bounded := indexEvents(vecengine.Config{MaxFrameDistance: maxFrameDistance}, validators, ordered)
+
+	pruned := 0
+	for _, e := range ordered {
+		require.Equal(unbounded.GetHighestBefore(e.ID()), bounded.GetHighestBefore(e.ID()))
+		if !bytes.Equal(*unbounded.GetLowestAfter(e.ID()), *bounded.GetLowestAfter(e.ID())) {
+			pruned++
+		}
+	}
+	require.NotZero(pruned)
+
+	checked := 0
+	for _, a := range ordered {
+		selfParentFrame := idx.Frame(0)
+		if a.SelfParent() != nil {
+			selfParentFrame = events[*a.SelfParent()].Frame()
+		}
+		if a.Frame() >= selfParentFrame+maxFrameDistance {
+			continue
+		}
+		for _, b := range ordered {
+			if b.Frame()+1 < selfParentFrame {
+				continue
+			}
+			require.Equal(unbounded.ForklessCause(a.ID(), b.ID()), bounded.ForklessCause(a.ID(), b.ID()), "a=%s b=%s", a.ID(), b.ID())
+			checked++
+		}
+	}
+	require.NotZero(checked)
+}
+
+func TestLowestAfterPropagation_MaxFrameDistanceThreshold(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 50)
+	events := make(map[hash.Event]dag.Event)
+	for _, e := range ordered {
+		events[e.ID()] = e
+	}
+
+	const maxFrameDistance = 2
+	unbounded := newEngineIndex(vecengine.DefaultConfig(), validators, ordered)
+	bounded := newEngineIndex(vecengine.Config{MaxFrameDistance: maxFrameDistance}, validators, ordered)
+
+	atThreshold := 0
+	belowThreshold := 0
+	for i, e := range ordered {
+		prevUnbounded := make([]LowestAfterSeq, i)
+		prevBounded := make([]LowestAfterSeq, i)
+		for j, b := range ordered[:i] {
+			// copy, as the cached vectors are updated in place
+			prevUnbounded[j] = append(LowestAfterSeq{}, *unbounded.GetLowestAfter(b.ID())...)
+			prevBounded[j] = append(LowestAfterSeq{}, *bounded.GetLowestAfter(b.ID())...)
+		}
+		require.NoError(unbounded.Add(e))
+		unbounded.Flush()
+		require.NoError(bounded.Add(e))
+		bounded.Flush()
+
+		if e.SelfParent() == nil || events[*e.SelfParent()].Frame() <= maxFrameDistance {
+			continue
+		}
+		minFrame := events[*e.SelfParent()].Frame() - maxFrameDistance
+		for j, b := range ordered[:i] {
+			updated := !bytes.Equal(prevUnbounded[j], *unbounded.GetLowestAfter(b.ID()))
+			boundedUpdated := !bytes.Equal(prevBounded[j], *bounded.GetLowestAfter(b.ID()))
+			// the events at the threshold are updated, the events below it are skipped
+			require.Equal(updated && b.Frame() >= minFrame, boundedUpdated, "e=%s b=%s", e.ID(), b.ID())
+			if updated && b.Frame() == minFrame {
+				atThreshold++
+			}
+			if updated && b.Frame()+1 == minFrame {
+				belowThreshold++
+			}
+		}
+	}
+	require.NotZero(atThreshold)
+	require.NotZero(belowThreshold)
+}
+
+func BenchmarkIndex_Add_LowestAfterPropagation(b *testing.B) {
+	nodes := tdag.GenNodes(30)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 30)
+
+	b.Run("Reference", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			indexEventsReference(validators, ordered)
+		}
+	})
+	for _, cfg := range []vecengine.Config{
+		vecengine.DefaultConfig(),
+		{MaxFrameDistance: 3},
+		{Parallelism: 4},
+		{MaxFrameDistance: 3, Parallelism: 4},
+	} {
+		b.Run(fmt.Sprintf("MaxFrameDistance=%d,Parallelism=%d", cfg.MaxFrameDistance, cfg.Parallelism), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				indexEvents(cfg, validators, ordered)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package vecfc
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/utils/wmedian"
+)
+
+type medianTimeIndex struct {
+	weight      pos.Weight
+	claimedTime int64
+}
+
+func (m medianTimeIndex) Weight() pos.Weight {
+	return m.weight
+}
+
+// MedianTime calculates the stake-weighted median of creation times of the highest events observed by the event, per validator.
+// Validators which are observed as cheaters don't influence the median time.
+// genesisTime is used for validators which aren't observed by the event.
+// getTime returns the creation time of an event.
+func (vi *Index) MedianTime(id hash.Event, genesisTime int64, getTime func(hash.Event) int64) int64 {
+	vi.InitBranchesInfo()
+	before := vi.GetMergedHighestBefore(id)
+	if before == nil {
+		vi.crit(fmt.Errorf("event=%s not found", id.String()))
+		return genesisTime
+	}
+	highestEvents := vi.highestObservedEvents(id, before)
+
+	honestTotalWeight := pos.Weight(0) // isn't equal to validators.TotalWeight(), because doesn't count cheaters
+	highests := make([]wmedian.WeightedValue, 0, vi.validators.Len())
+	for creatorIdx := idx.Validator(0); creatorIdx < vi.validators.Len(); creatorIdx++ {
+		highest := medianTimeIndex{
+			weight:      vi.validators.GetWeightByIdx(creatorIdx),
+			claimedTime: genesisTime,
+		}
+		seq := before.Get(creatorIdx)
+		if seq.IsForkDetected() {
+			// cheaters don't influence medianTime
+			highest.weight = 0
+		} else if seq.Seq != 0 {
+			highest.claimedTime = getTime(highestEvents[creatorIdx])
+		}
+		highests = append(highests, highest)
+		honestTotalWeight += highest.weight
+	}
+	// it's technically possible honestTotalWeight == 0 (all validators are cheaters)
+
+	sort.SliceStable(highests, func(i, j int) bool {
+		return highests[i].(medianTimeIndex).claimedTime < highests[j].(medianTimeIndex).claimedTime
+	})
+	median := wmedian.Of(highests, honestTotalWeight/2)
+	return median.(medianTimeIndex).claimedTime
+}
+
+// highestObservedEvents finds the highest observed events of honest validators, according to the merged HighestBefore vector.
+// Traversal goes only through the events which observe some of the not yet found events.
+func (vi *Index) highestObservedEvents(id hash.Event, before *HighestBeforeSeq) []hash.Event {
+	highests := make([]hash.Event, vi.validators.Len())
+	targets := make(map[idx.Validator]idx.Event, vi.validators.Len())
+	for creatorIdx := idx.Validator(0); creatorIdx < vi.validators.Len(); creatorIdx++ {
+		if seq := before.Get(creatorIdx); !seq.IsForkDetected() && seq.Seq != 0 {
+			targets[creatorIdx] = seq.Seq
+		}
+	}
+
+	visited := hash.NewEventsSet(id)
+	stack := hash.EventsStack{id}
+	for next := stack.Pop(); next != nil && len(targets) != 0; next = stack.Pop() {
+		e := vi.getEvent(*next)
+		if e == nil {
+			vi.crit(fmt.Errorf("event=%s not found", next.String()))
+			return highests
+		}
+		creatorIdx := vi.validatorIdxs[e.Creator()]
+		if seq, ok := targets[creatorIdx]; ok && seq == e.Seq() {
+			highests[creatorIdx] = e.ID()
+			delete(targets, creatorIdx)
+		}
+		for _, p := range e.Parents() {
+			if visited.Contains(p) {
+				continue
+			}
+			pBefore := vi.GetMergedHighestBefore(p)
+			if pBefore == nil {
+				// pruned events are too old to be the highest observed
+				continue
+			}
+			for targetIdx, seq := range targets {
+				if pBefore.Get(targetIdx).Seq == seq {
+					visited.Add(p)
+					stack.Push(p)
+					break
+				}
+			}
+		}
+	}
+	return highests
+}
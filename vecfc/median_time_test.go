@@ -0,0 +1,111 @@
+package vecfc
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+func TestMedianTime(t *testing.T) {
+	const genesisTime = int64(1000)
+	nodes := tdag.GenNodes(8)
+	weights := []pos.Weight{5, 1, 1, 2, 3, 1, 4, 1}
+	builder := pos.NewBuilder()
+	for i, v := range nodes {
+		builder.Set(v, weights[i])
+	}
+	validators := builder.Build()
+
+	for _, cheaters := range [][]idx.ValidatorID{nil, nodes[:2]} {
+		require := require.New(t)
+		r := rand.New(rand.NewSource(int64(len(cheaters)))) // nolint:gosec
+
+		events := make(map[hash.Event]dag.Event)
+		times := make(map[hash.Event]int64)
+		getTime := func(id hash.Event) int64 {
+			return times[id]
+		}
+		vi := NewIndex(tCrit, LiteConfig())
+		vi.Reset(validators, flushable.Wrap(memorydb.New()), func(id hash.Event) dag.Event {
+			return events[id]
+		})
+
+		ordered := make(dag.Events, 0)
+		tdag.ForEachRandFork(nodes, cheaters, 30, 3, 5, r, tdag.ForEachEvent{
+			Process: func(e dag.Event, name string) {
+				events[e.ID()] = e
+				times[e.ID()] = genesisTime + int64(e.Lamport())*100 + r.Int63n(100)
+				ordered = append(ordered, e)
+				require.NoError(vi.Add(e))
+				vi.Flush()
+			},
+		})
+
+		for _, e := range ordered {
+			require.Equal(naiveMedianTime(vi, events, e, genesisTime, getTime), vi.MedianTime(e.ID(), genesisTime, getTime), e.ID())
+		}
+		// unobserved validators have the genesis time
+		first := ordered[0]
+		require.Equal(genesisTime, vi.MedianTime(first.ID(), genesisTime, getTime))
+	}
+}
+
+// naiveMedianTime calculates median time by a full traversal of the event subgraph
+func naiveMedianTime(vi *Index, events map[hash.Event]dag.Event, head dag.Event, genesisTime int64, getTime func(hash.Event) int64) int64 {
+	highest := make(map[idx.ValidatorID]dag.Event)
+	visited := hash.NewEventsSet(head.ID())
+	stack := dag.Events{head}
+	for len(stack) != 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if h := highest[e.Creator()]; h == nil || h.Seq() < e.Seq() {
+			highest[e.Creator()] = e
+		}
+		for _, p := range e.Parents() {
+			if !visited.Contains(p) {
+				visited.Add(p)
+				stack = append(stack, events[p])
+			}
+		}
+	}
+
+	before := vi.GetMergedHighestBefore(head.ID())
+	type weightedTime struct {
+		weight pos.Weight
+		time   int64
+	}
+	times := make([]weightedTime, 0)
+	total := pos.Weight(0)
+	for i, v := range vi.validators.SortedIDs() {
+		if before.Get(idx.Validator(i)).IsForkDetected() {
+			continue
+		}
+		t := genesisTime
+		if h := highest[v]; h != nil {
+			t = getTime(h.ID())
+		}
+		times = append(times, weightedTime{vi.validators.Get(v), t})
+		total += vi.validators.Get(v)
+	}
+	sort.SliceStable(times, func(i, j int) bool {
+		return times[i].time < times[j].time
+	})
+	acc := pos.Weight(0)
+	for _, t := range times {
+		acc += t.weight
+		if acc >= total/2 {
+			return t.time
+		}
+	}
+	return genesisTime
+}
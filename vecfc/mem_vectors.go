@@ -0,0 +1,141 @@
+package vecfc
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+)
+
+// memVectors keeps the vectors in memory instead of DB tables.
+// Vectors of not flushed events are kept separately, to be able to drop them.
+type memVectors struct {
+	highestBefore map[hash.Event]*HighestBeforeSeq
+	lowestAfter   map[hash.Event]*LowestAfterSeq
+
+	dirtyHighestBefore map[hash.Event]*HighestBeforeSeq
+	dirtyLowestAfter   map[hash.Event]*LowestAfterSeq
+	dirtyDeleted       hash.EventsSet
+}
+
+// NewMemIndex creates Index instance which keeps the vectors in memory, for simulations and short-lived validators.
+// Only branches info is written into the DB passed to Reset, so it may be a memory DB.
+// The vectors are erased on Reset, i.e. their lifetime is an epoch.
+// Cache sizes of the vectors in the config are ignored.
+func NewMemIndex(crit func(error), config IndexConfig) *Index {
+	vi := &Index{
+		cfg:      config,
+		crit:     crit,
+		callback: newMemVectors().callbacks(),
+	}
+	vi.Engine = vecengine.NewIndexWithConfig(crit, config.Engine, vi.callback)
+	vi.initCaches()
+
+	return vi
+}
+
+func newMemVectors() *memVectors {
+	m := &memVectors{}
+	m.reset()
+	return m
+}
+
+func (m *memVectors) reset() {
+	m.highestBefore = make(map[hash.Event]*HighestBeforeSeq)
+	m.lowestAfter = make(map[hash.Event]*LowestAfterSeq)
+	m.dropNotFlushed()
+}
+
+func (m *memVectors) dropNotFlushed() {
+	m.dirtyHighestBefore = make(map[hash.Event]*HighestBeforeSeq)
+	m.dirtyLowestAfter = make(map[hash.Event]*LowestAfterSeq)
+	m.dirtyDeleted = hash.EventsSet{}
+}
+
+func (m *memVectors) flush() {
+	for id := range m.dirtyDeleted {
+		delete(m.highestBefore, id)
+		delete(m.lowestAfter, id)
+	}
+	for id, seq := range m.dirtyHighestBefore {
+		m.highestBefore[id] = seq
+	}
+	for id, seq := range m.dirtyLowestAfter {
+		m.lowestAfter[id] = seq
+	}
+	m.dropNotFlushed()
+}
+
+func (m *memVectors) getHighestBefore(id hash.Event) *HighestBeforeSeq {
+	if seq, ok := m.dirtyHighestBefore[id]; ok {
+		return seq
+	}
+	if m.dirtyDeleted.Contains(id) {
+		return nil
+	}
+	return m.highestBefore[id]
+}
+
+// getLowestAfter returns a copy of a flushed vector, because LowestAfter vectors are modified in place before storing
+func (m *memVectors) getLowestAfter(id hash.Event) *LowestAfterSeq {
+	if seq, ok := m.dirtyLowestAfter[id]; ok {
+		return seq
+	}
+	if m.dirtyDeleted.Contains(id) {
+		return nil
+	}
+	seq, ok := m.lowestAfter[id]
+	if !ok {
+		return nil
+	}
+	cp := make(LowestAfterSeq, len(*seq))
+	copy(cp, *seq)
+	return &cp
+}
+
+func (m *memVectors) setHighestBefore(id hash.Event, seq *HighestBeforeSeq) {
+	m.dirtyHighestBefore[id] = seq
+}
+
+func (m *memVectors) setLowestAfter(id hash.Event, seq *LowestAfterSeq) {
+	m.dirtyLowestAfter[id] = seq
+}
+
+func (m *memVectors) delete(id hash.Event) {
+	delete(m.dirtyHighestBefore, id)
+	delete(m.dirtyLowestAfter, id)
+	m.dirtyDeleted.Add(id)
+}
+
+func (m *memVectors) callbacks() vecengine.Callbacks {
+	return vecengine.Callbacks{
+		GetHighestBefore: func(event hash.Event) vecengine.HighestBeforeI {
+			// return untyped nil if not found
+			if b := m.getHighestBefore(event); b != nil {
+				return b
+			}
+			return nil
+		},
+		GetLowestAfter: func(event hash.Event) vecengine.LowestAfterI {
+			if b := m.getLowestAfter(event); b != nil {
+				return b
+			}
+			return nil
+		},
+		SetHighestBefore: func(event hash.Event, b vecengine.HighestBeforeI) {
+			m.setHighestBefore(event, b.(*HighestBeforeSeq))
+		},
+		SetLowestAfter: func(event hash.Event, b vecengine.LowestAfterI) {
+			m.setLowestAfter(event, b.(*LowestAfterSeq))
+		},
+		NewHighestBefore: func(size idx.Validator) vecengine.HighestBeforeI {
+			return NewHighestBeforeSeq(size)
+		},
+		NewLowestAfter: func(size idx.Validator) vecengine.LowestAfterI {
+			return NewLowestAfterSeq(size)
+		},
+		OnDropNotFlushed: m.dropNotFlushed,
+		OnFlush:          m.flush,
+		OnReset:          m.reset,
+		DeleteVectors:    m.delete,
+	}
+}
@@ -0,0 +1,105 @@
+package vecfc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+func TestMemIndex(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(8)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	events := make(map[hash.Event]dag.Event)
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+
+	vi := NewIndex(tCrit, LiteConfig())
+	vi.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+	mem := NewMemIndex(tCrit, LiteConfig())
+	mem.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+
+	ordered := make(dag.Events, 0)
+	tdag.ForEachRandFork(nodes, nodes[:2], 30, 4, 5, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			events[e.ID()] = e
+			ordered = append(ordered, e)
+			require.NoError(vi.Add(e))
+			vi.Flush()
+			require.NoError(mem.Add(e))
+			mem.Flush()
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			// not flushed vectors are dropped
+			e.SetID([24]byte{1})
+			require.NoError(mem.Add(e))
+			mem.DropNotFlushed()
+			require.Nil(mem.GetHighestBefore(e.ID()))
+			require.Nil(mem.GetLowestAfter(e.ID()))
+			return nil
+		},
+	})
+
+	for _, a := range ordered {
+		require.Equal(vi.GetHighestBefore(a.ID()), mem.GetHighestBefore(a.ID()))
+		require.Equal(vi.GetLowestAfter(a.ID()), mem.GetLowestAfter(a.ID()))
+		require.Equal(vi.GetMergedHighestBefore(a.ID()), mem.GetMergedHighestBefore(a.ID()))
+		for _, b := range ordered[:30] {
+			require.Equal(vi.ForklessCause(a.ID(), b.ID()), mem.ForklessCause(a.ID(), b.ID()))
+		}
+		if a.SelfParent() != nil {
+			candidates := hash.Events{ordered[0].ID(), ordered[1].ID()}
+			expectedChosen, expectedCandidates := vi.ForklessCauseProgress(*a.SelfParent(), ordered[2].ID(), candidates, a.Parents()[1:])
+			chosen, candidatesProgress := mem.ForklessCauseProgress(*a.SelfParent(), ordered[2].ID(), candidates, a.Parents()[1:])
+			require.Equal(expectedChosen.Sum(), chosen.Sum())
+			for i := range candidates {
+				require.Equal(expectedCandidates[i].Sum(), candidatesProgress[i].Sum())
+			}
+		}
+	}
+
+	// vectors are erased on Reset
+	mem.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+	require.Nil(mem.GetHighestBefore(ordered[0].ID()))
+	require.Nil(mem.GetLowestAfter(ordered[0].ID()))
+}
+
+func BenchmarkMemIndex_Add(b *testing.B) {
+	nodes := tdag.GenNodes(30)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := make(dag.Events, 0)
+	events := make(map[hash.Event]dag.Event)
+	tdag.ForEachRandEvent(nodes, 30, 10, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			events[e.ID()] = e
+			ordered = append(ordered, e)
+		},
+	})
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+
+	for name, newIndex := range map[string]func(func(error), IndexConfig) *Index{"MemIndex": NewMemIndex, "Index": NewIndex} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				vi := newIndex(tCrit, LiteConfig())
+				vi.Reset(validators, flushable.Wrap(memorydb.New()), getEvent)
+				for _, e := range ordered {
+					if err := vi.Add(e); err != nil {
+						b.Fatal(err)
+					}
+					vi.Flush()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package vecfc
+
+import (
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/table"
+)
+
+// vectorTables are the tables of the vectors in both encodings
+type vectorTables struct {
+	HighestBeforeSeq kvdb.Store `table:"S"`
+	LowestAfterSeq   kvdb.Store `table:"s"`
+
+	HighestBeforeCompact kvdb.Store `table:"H"`
+	LowestAfterCompact   kvdb.Store `table:"L"`
+}
+
+// MigrateToCompactVectors re-encodes the vectors stored in the fixed-size binary form into the compact form.
+// db is the vector index DB, i.e. the same DB which is passed to Index.Reset.
+// Index.Reset migrates the vectors into the configured encoding by itself, so the function is required only
+// to migrate a DB offline. It must not be called concurrently with the Index.
+func MigrateToCompactVectors(db kvdb.Store) error {
+	var t vectorTables
+	table.MigrateTables(&t, db)
+	return t.migrate(true)
+}
+
+// migrate moves the vectors stored in the other encoding into the tables of the configured encoding
+func (t *vectorTables) migrate(compact bool) error {
+	if compact {
+		err := migrateVectors(t.HighestBeforeSeq, t.HighestBeforeCompact, func(b []byte) ([]byte, error) {
+			return HighestBeforeSeq(b).EncodeCompact(), nil
+		})
+		if err != nil {
+			return err
+		}
+		return migrateVectors(t.LowestAfterSeq, t.LowestAfterCompact, func(b []byte) ([]byte, error) {
+			return LowestAfterSeq(b).EncodeCompact(), nil
+		})
+	}
+	err := migrateVectors(t.HighestBeforeCompact, t.HighestBeforeSeq, func(b []byte) ([]byte, error) {
+		return DecodeHighestBeforeSeq(b)
+	})
+	if err != nil {
+		return err
+	}
+	return migrateVectors(t.LowestAfterCompact, t.LowestAfterSeq, func(b []byte) ([]byte, error) {
+		return DecodeLowestAfterSeq(b)
+	})
+}
+
+func migrateVectors(from, to kvdb.Store, encode func([]byte) ([]byte, error)) error {
+	toBatch := to.NewBatch()
+	defer toBatch.Reset()
+	fromBatch := from.NewBatch()
+	defer fromBatch.Reset()
+
+	for {
+		// the iterator is released before the batches are written, the migrated keys are deleted,
+		// so every iteration starts from the beginning of the table
+		it := from.NewIterator(nil, nil)
+		migrated := 0
+		for toBatch.ValueSize()+fromBatch.ValueSize() < kvdb.IdealBatchSize && it.Next() {
+			// vectors which are already stored in the new form have a priority, as they're written after the old ones
+			has, err := to.Has(it.Key())
+			if err != nil {
+				it.Release()
+				return err
+			}
+			if !has {
+				enc, err := encode(it.Value())
+				if err != nil {
+					it.Release()
+					return err
+				}
+				if err := toBatch.Put(it.Key(), enc); err != nil {
+					it.Release()
+					return err
+				}
+			}
+			if err := fromBatch.Delete(it.Key()); err != nil {
+				it.Release()
+				return err
+			}
+			migrated++
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return err
+		}
+		if migrated == 0 {
+			return nil
+		}
+
+		// write the new form first, so an interrupted migration doesn't lose the vectors
+		if err := toBatch.Write(); err != nil {
+			return err
+		}
+		toBatch.Reset()
+		if err := fromBatch.Write(); err != nil {
+			return err
+		}
+		fromBatch.Reset()
+	}
+}
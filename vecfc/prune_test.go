@@ -0,0 +1,169 @@
+package vecfc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+	"github.com/Fantom-foundation/lachesis-base/vecengine/vecflushable"
+)
+
+func TestIndex_Prune(t *testing.T) {
+	t.Run("Index", func(t *testing.T) {
+		testIndexPrune(t, NewIndex, flushable.Wrap(memorydb.New()))
+	})
+	t.Run("MemIndex", func(t *testing.T) {
+		testIndexPrune(t, NewMemIndex, flushable.Wrap(memorydb.New()))
+	})
+	t.Run("VecFlushable", func(t *testing.T) {
+		testIndexPrune(t, NewIndex, vecflushable.Wrap(memorydb.New(), vecflushable.TestSizeLimit))
+	})
+}
+
+func testIndexPrune(t *testing.T, newIndex func(func(error), IndexConfig) *Index, db kvdb.FlushableKVStore) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(5)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := make(dag.Events, 0)
+	events := make(map[hash.Event]dag.Event)
+	// the validators are split into two groups, which don't observe each other's events in the middle,
+	// so the new events observe many old events after the groups are joined
+	const eventsNum = 40
+	const pruneFrames = 3
+	inGroup := func(a, b idx.ValidatorID) bool {
+		return (a <= nodes[1]) == (b <= nodes[1])
+	}
+	tdag.ForEachRandEvent(nodes, eventsNum, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			ordered = append(ordered, e)
+			events[e.ID()] = e
+		},
+		Build: func(e dag.MutableEvent, name string) error {
+			partitioned := len(ordered) >= len(nodes)*eventsNum/4 && len(ordered) < len(nodes)*eventsNum*3/4
+			maxFrame := idx.Frame(0)
+			for _, p := range e.Parents() {
+				if maxFrame < events[p].Frame() {
+					maxFrame = events[p].Frame()
+				}
+			}
+			parents := hash.Events{}
+			lamport := idx.Lamport(0)
+			for _, p := range e.Parents() {
+				if !e.IsSelfParent(p) {
+					// the parents which are too old to be referred aren't chosen, the same way emitter does
+					if events[p].Frame()+pruneFrames < maxFrame || partitioned && !inGroup(e.Creator(), events[p].Creator()) {
+						continue
+					}
+				}
+				parents.Add(p)
+				if lamport < events[p].Lamport() {
+					lamport = events[p].Lamport()
+				}
+			}
+			e.SetParents(parents)
+			e.SetLamport(lamport + 1)
+			e.SetFrame(idx.Frame(e.Lamport()/3 + 1))
+			return nil
+		},
+	})
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+
+	cfg := LiteConfig()
+	cfg.Engine.PruneFrames = pruneFrames
+	// the same events are indexed without pruning
+	full := newEngineIndex(cfg.Engine, validators, ordered)
+	vi := newIndex(tCrit, cfg)
+	vi.Reset(validators, db, getEvent)
+
+	lastEvents := map[idx.ValidatorID]hash.Event{}
+	lastDecidedFrame := idx.Frame(0)
+	for _, e := range ordered {
+		require.NoError(full.Add(e))
+		full.Flush()
+		require.NoError(vi.Add(e))
+		vi.Flush()
+		lastEvents[e.Creator()] = e.ID()
+		// the decided frame lags behind the processed events
+		if e.Frame() > lastDecidedFrame+2 {
+			lastDecidedFrame = e.Frame() - 2
+		}
+		vi.Prune(lastDecidedFrame)
+		vi.Flush()
+	}
+	below := lastDecidedFrame - cfg.Engine.PruneFrames
+	require.Greater(below, idx.Frame(1))
+
+	pruned := hash.Events{}
+	prunedSet := hash.EventsSet{}
+	for _, e := range ordered {
+		if vi.GetHighestBefore(e.ID()) == nil {
+			require.Nil(vi.GetLowestAfter(e.ID()))
+			require.Less(e.Frame(), below)
+			require.NotEqual(lastEvents[e.Creator()], e.ID())
+			pruned.Add(e.ID())
+			prunedSet.Add(e.ID())
+		} else {
+			require.NotNil(vi.GetLowestAfter(e.ID()))
+		}
+	}
+	require.NotEmpty(pruned)
+
+	// vectors of the not pruned events are the same as without pruning
+	for _, a := range ordered {
+		if prunedSet.Contains(a.ID()) {
+			continue
+		}
+		require.Equal(full.GetHighestBefore(a.ID()), vi.GetHighestBefore(a.ID()))
+		require.Equal(full.GetLowestAfter(a.ID()), vi.GetLowestAfter(a.ID()))
+		for _, b := range ordered {
+			if !prunedSet.Contains(b.ID()) {
+				require.Equal(full.ForklessCause(a.ID(), b.ID()), vi.ForklessCause(a.ID(), b.ID()))
+			}
+		}
+	}
+
+	// pruned and unknown events are reported as not found
+	for _, id := range pruned {
+		require.Nil(vi.GetMergedHighestBefore(id))
+	}
+	require.Nil(vi.GetMergedHighestBefore(hash.FakeEvent()))
+	// median time traversal skips the pruned parents
+	for _, id := range lastEvents {
+		vi.MedianTime(id, 0, func(hash.Event) int64 { return 1 })
+	}
+
+	// a new event which refers to a too old event is rejected regardless of pruning
+	selfParent := events[lastEvents[nodes[0]]]
+	e := &tdag.TestEvent{}
+	e.SetCreator(nodes[0])
+	e.SetSeq(selfParent.Seq() + 1)
+	e.SetParents(hash.Events{selfParent.ID(), pruned[0]})
+	e.SetFrame(selfParent.Frame())
+	e.SetID([24]byte{1})
+	events[e.ID()] = e
+	require.True(errors.Is(vi.Add(e), vecengine.ErrPrunedParent))
+	vi.DropNotFlushed()
+	require.True(errors.Is(full.Add(e), vecengine.ErrPrunedParent))
+	full.DropNotFlushed()
+	delete(events, e.ID())
+
+	// pruning is idempotent
+	vi.Prune(lastDecidedFrame)
+	vi.Flush()
+	for _, id := range pruned {
+		require.Nil(vi.GetHighestBefore(id))
+	}
+}
@@ -0,0 +1,92 @@
+package vecfc
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+// Sees returns true if event A observes event B, i.e. B is A or an ancestor of A.
+// Unlike ForklessCause, the result is exact and doesn't depend on validators' weights.
+// If A observes the creator of B as a cheater, the fork-independent LowestAfter vector of B is used instead,
+// so the result may be inexact only in this case if LowestAfter propagation is bounded by MaxFrameDistance.
+func (vi *Index) Sees(aID, bID hash.Event) bool {
+	return vi.SeesMany(aID, hash.Events{bID})[0]
+}
+
+// SeesMany returns Sees(a, b) for each of bs.
+func (vi *Index) SeesMany(aID hash.Event, bs hash.Events) []bool {
+	vi.Engine.InitBranchesInfo()
+	res := make([]bool, len(bs))
+
+	a := vi.GetHighestBefore(aID)
+	if a == nil {
+		vi.crit(fmt.Errorf("Event A=%s not found", aID.String()))
+		return res
+	}
+	for i, bID := range bs {
+		b := vi.getEvent(bID)
+		if b == nil {
+			vi.crit(fmt.Errorf("Event B=%s not found", bID.String()))
+			continue
+		}
+		res[i] = vi.sees(aID, a, b, hash.EventsSet{})
+	}
+	return res
+}
+
+func (vi *Index) sees(aID hash.Event, a *HighestBeforeSeq, b dag.Event, visited hash.EventsSet) bool {
+	bID := b.ID()
+	if aID == bID {
+		return true
+	}
+
+	// branch of B is a chain, so A observes B if A observes an event of the branch with the same or a higher seq
+	bBranchID := vi.Engine.GetEventBranchID(bID)
+	if seq := a.Get(bBranchID); !seq.IsForkDetected() {
+		return seq.Seq >= b.Seq()
+	}
+
+	// creator of B is observed as cheater by A, so A observes B if an event observed by A observes B
+	bLowestAfter := vi.GetLowestAfter(bID)
+	if bLowestAfter == nil {
+		vi.crit(fmt.Errorf("Event B=%s not found", bID.String()))
+		return false
+	}
+	for branchID := idx.Validator(0); branchID < bLowestAfter.Size(); branchID++ {
+		after := bLowestAfter.Get(branchID)
+		seq := a.Get(branchID)
+		if after != 0 && !seq.IsForkDetected() && after <= seq.Seq {
+			return true
+		}
+	}
+	aBranchID := vi.Engine.GetEventBranchID(aID)
+	if !a.Get(aBranchID).IsForkDetected() {
+		// A itself would be counted above
+		return false
+	}
+
+	// A observes its own creator as a cheater, so neither A nor its self-ancestors are reflected in the vectors
+	ae := vi.getEvent(aID)
+	if ae == nil {
+		vi.crit(fmt.Errorf("Event A=%s not found", aID.String()))
+		return false
+	}
+	for _, p := range ae.Parents() {
+		if visited.Contains(p) {
+			continue
+		}
+		visited.Add(p)
+		pVec := vi.GetHighestBefore(p)
+		if pVec == nil {
+			vi.crit(fmt.Errorf("Event=%s not found", p.String()))
+			return false
+		}
+		if vi.sees(p, pVec, b, visited) {
+			return true
+		}
+	}
+	return false
+}
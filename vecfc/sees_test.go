@@ -0,0 +1,70 @@
+package vecfc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+)
+
+func TestSees(t *testing.T) {
+	nodes := tdag.GenNodes(6)
+	validators := pos.EqualWeightValidators(nodes, 1)
+
+	for name, cheatersCount := range map[string]int{"no forks": 0, "forks": 2, "all cheaters": len(nodes)} {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			events := make(map[hash.Event]dag.Event)
+			vi := NewIndex(tCrit, LiteConfig())
+			vi.Reset(validators, flushable.Wrap(memorydb.New()), func(id hash.Event) dag.Event {
+				return events[id]
+			})
+			ordered := make(dag.Events, 0)
+			tdag.ForEachRandFork(nodes, nodes[:cheatersCount], 20, 3, 5, nil, tdag.ForEachEvent{
+				Process: func(e dag.Event, name string) {
+					events[e.ID()] = e
+					ordered = append(ordered, e)
+					require.NoError(vi.Add(e))
+					vi.Flush()
+				},
+			})
+
+			ids := make(hash.Events, len(ordered))
+			for i, e := range ordered {
+				ids[i] = e.ID()
+			}
+			for _, a := range ordered {
+				ancestors := naiveAncestors(events, a)
+				res := vi.SeesMany(a.ID(), ids)
+				for i, b := range ordered {
+					require.Equal(ancestors.Contains(b.ID()), res[i], "a=%s b=%s", a.ID(), b.ID())
+					require.Equal(res[i], vi.Sees(a.ID(), b.ID()))
+				}
+			}
+		})
+	}
+}
+
+// naiveAncestors returns the event and all its ancestors
+func naiveAncestors(events map[hash.Event]dag.Event, head dag.Event) hash.EventsSet {
+	visited := hash.NewEventsSet(head.ID())
+	stack := dag.Events{head}
+	for len(stack) != 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range e.Parents() {
+			if !visited.Contains(p) {
+				visited.Add(p)
+				stack = append(stack, events[p])
+			}
+		}
+	}
+	return visited
+}
@@ -1,6 +1,8 @@
 package vecfc
 
 import (
+	"fmt"
+
 	"github.com/Fantom-foundation/lachesis-base/hash"
 	"github.com/Fantom-foundation/lachesis-base/kvdb"
 )
@@ -22,13 +24,20 @@ func (vi *Index) setBytes(table kvdb.Store, id hash.Event, b []byte) {
 	}
 }
 
-// GetLowestAfter reads the vector from DB
-func (vi *Index) GetLowestAfter(id hash.Event) *LowestAfterSeq {
+func (vi *Index) deleteBytes(table kvdb.Store, id hash.Event) {
+	err := table.Delete(id.Bytes())
+	if err != nil {
+		vi.crit(err)
+	}
+}
+
+// getLowestAfter reads the vector from DB
+func (vi *Index) getLowestAfter(id hash.Event) *LowestAfterSeq {
 	if bVal, okGet := vi.cache.LowestAfterSeq.Get(id); okGet {
 		return bVal.(*LowestAfterSeq)
 	}
 
-	b := LowestAfterSeq(vi.getBytes(vi.table.LowestAfterSeq, id))
+	b := vi.readLowestAfter(id)
 	if b == nil {
 		return nil
 	}
@@ -36,13 +45,13 @@ func (vi *Index) GetLowestAfter(id hash.Event) *LowestAfterSeq {
 	return &b
 }
 
-// GetHighestBefore reads the vector from DB
-func (vi *Index) GetHighestBefore(id hash.Event) *HighestBeforeSeq {
+// getHighestBefore reads the vector from DB
+func (vi *Index) getHighestBefore(id hash.Event) *HighestBeforeSeq {
 	if bVal, okGet := vi.cache.HighestBeforeSeq.Get(id); okGet {
 		return bVal.(*HighestBeforeSeq)
 	}
 
-	b := HighestBeforeSeq(vi.getBytes(vi.table.HighestBeforeSeq, id))
+	b := vi.readHighestBefore(id)
 	if b == nil {
 		return nil
 	}
@@ -50,16 +59,93 @@ func (vi *Index) GetHighestBefore(id hash.Event) *HighestBeforeSeq {
 	return &b
 }
 
-// SetLowestAfter stores the vector into DB
-func (vi *Index) SetLowestAfter(id hash.Event, seq *LowestAfterSeq) {
-	vi.setBytes(vi.table.LowestAfterSeq, id, *seq)
+// setLowestAfter stores the vector into DB
+func (vi *Index) setLowestAfter(id hash.Event, seq *LowestAfterSeq) {
+	if vi.cfg.CompactVectors {
+		vi.setBytes(vi.table.LowestAfterCompact, id, seq.EncodeCompact())
+	} else {
+		vi.setBytes(vi.table.LowestAfterSeq, id, *seq)
+	}
 
 	vi.cache.LowestAfterSeq.Add(id, seq, uint(len(*seq)))
 }
 
-// SetHighestBefore stores the vectors into DB
-func (vi *Index) SetHighestBefore(id hash.Event, seq *HighestBeforeSeq) {
-	vi.setBytes(vi.table.HighestBeforeSeq, id, *seq)
+// setLowestAfterBatch stores the vectors into DB using batches
+func (vi *Index) setLowestAfterBatch(ids hash.Events, seqs []*LowestAfterSeq) {
+	table := vi.table.LowestAfterSeq
+	if vi.cfg.CompactVectors {
+		table = vi.table.LowestAfterCompact
+	}
+	batch := table.NewBatch()
+	for i, id := range ids {
+		b := []byte(*seqs[i])
+		if vi.cfg.CompactVectors {
+			b = seqs[i].EncodeCompact()
+		}
+		if err := batch.Put(id.Bytes(), b); err != nil {
+			vi.crit(err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		vi.crit(err)
+	}
+	for i, id := range ids {
+		vi.cache.LowestAfterSeq.Add(id, seqs[i], uint(len(*seqs[i])))
+	}
+}
+
+// setHighestBefore stores the vectors into DB
+func (vi *Index) setHighestBefore(id hash.Event, seq *HighestBeforeSeq) {
+	if vi.cfg.CompactVectors {
+		vi.setBytes(vi.table.HighestBeforeCompact, id, seq.EncodeCompact())
+	} else {
+		vi.setBytes(vi.table.HighestBeforeSeq, id, *seq)
+	}
 
 	vi.cache.HighestBeforeSeq.Add(id, seq, uint(len(*seq)))
 }
+
+// readLowestAfter reads the vector in the configured encoding, vectors in the other encoding are migrated on Reset
+func (vi *Index) readLowestAfter(id hash.Event) LowestAfterSeq {
+	if !vi.cfg.CompactVectors {
+		return vi.getBytes(vi.table.LowestAfterSeq, id)
+	}
+	enc := vi.getBytes(vi.table.LowestAfterCompact, id)
+	if enc == nil {
+		return nil
+	}
+	b, err := DecodeLowestAfterSeq(enc)
+	if err != nil {
+		vi.crit(fmt.Errorf("LowestAfter of event=%s: %w", id.String(), err))
+	}
+	return b
+}
+
+// readHighestBefore reads the vector in the configured encoding, vectors in the other encoding are migrated on Reset
+func (vi *Index) readHighestBefore(id hash.Event) HighestBeforeSeq {
+	if !vi.cfg.CompactVectors {
+		return vi.getBytes(vi.table.HighestBeforeSeq, id)
+	}
+	enc := vi.getBytes(vi.table.HighestBeforeCompact, id)
+	if enc == nil {
+		return nil
+	}
+	b, err := DecodeHighestBeforeSeq(enc)
+	if err != nil {
+		vi.crit(fmt.Errorf("HighestBefore of event=%s: %w", id.String(), err))
+	}
+	return b
+}
+
+// deleteVectors deletes the vectors of a pruned event from DB
+func (vi *Index) deleteVectors(id hash.Event) {
+	vi.cache.HighestBeforeSeq.Remove(id)
+	vi.cache.LowestAfterSeq.Remove(id)
+	if vi.cfg.CompactVectors {
+		vi.deleteBytes(vi.table.HighestBeforeCompact, id)
+		vi.deleteBytes(vi.table.LowestAfterCompact, id)
+	} else {
+		vi.deleteBytes(vi.table.HighestBeforeSeq, id)
+		vi.deleteBytes(vi.table.LowestAfterSeq, id)
+	}
+}
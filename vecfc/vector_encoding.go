@@ -0,0 +1,136 @@
+package vecfc
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+)
+
+/*
+ * Compact encoding of the vectors.
+ * A vector is encoded as its size, a bitmap of non-empty positions, and varints of the non-empty positions only.
+ * Sequence numbers are much lower than 2^32 in practice, and a large part of LowestAfter vectors is empty,
+ * so the encoding is a few times smaller than the fixed-size binary form for networks with many validators.
+ * Vectors aren't encoded relative to the self-parent's vector, so each vector is decoded by a single read,
+ * and the vectors stay decodable after their self-parents are pruned.
+ */
+
+var errMalformedVector = errors.New("malformed compact vector")
+
+func bitmapSize(size int) int {
+	return (size + 7) / 8
+}
+
+// appendCompactHeader appends size and an empty bitmap, returns the bitmap offset
+func appendCompactHeader(buf []byte, size int) ([]byte, int) {
+	buf = binary.AppendUvarint(buf, uint64(size))
+	bitmapPos := len(buf)
+	buf = append(buf, make([]byte, bitmapSize(size))...)
+	return buf, bitmapPos
+}
+
+func readCompactHeader(b []byte) (size int, bitmap []byte, rest []byte, err error) {
+	size64, n := binary.Uvarint(b)
+	if n <= 0 || size64 > uint64(len(b))*8 {
+		return 0, nil, nil, errMalformedVector
+	}
+	size = int(size64)
+	b = b[n:]
+	if len(b) < bitmapSize(size) {
+		return 0, nil, nil, errMalformedVector
+	}
+	return size, b[:bitmapSize(size)], b[bitmapSize(size):], nil
+}
+
+func readUvarint32(b []byte) (uint32, []byte, error) {
+	v, n := binary.Uvarint(b)
+	if n <= 0 || v > 0xffffffff {
+		return 0, nil, errMalformedVector
+	}
+	return uint32(v), b[n:], nil
+}
+
+// EncodeCompact encodes the vector into a compact form
+func (b LowestAfterSeq) EncodeCompact() []byte {
+	size := int(b.Size())
+	buf, bitmapPos := appendCompactHeader(make([]byte, 0, 1+bitmapSize(size)+size*3), size)
+	for i := 0; i < size; i++ {
+		seq := b.Get(idx.Validator(i))
+		if seq == 0 {
+			continue
+		}
+		buf[bitmapPos+i/8] |= 1 << (i % 8)
+		buf = binary.AppendUvarint(buf, uint64(seq))
+	}
+	return buf
+}
+
+// DecodeLowestAfterSeq decodes the vector from the compact form
+func DecodeLowestAfterSeq(enc []byte) (LowestAfterSeq, error) {
+	size, bitmap, rest, err := readCompactHeader(enc)
+	if err != nil {
+		return nil, err
+	}
+	b := make(LowestAfterSeq, size*4)
+	for i := 0; i < size; i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		var seq uint32
+		seq, rest, err = readUvarint32(rest)
+		if err != nil {
+			return nil, err
+		}
+		binary.LittleEndian.PutUint32(b[i*4:(i+1)*4], seq)
+	}
+	if len(rest) != 0 {
+		return nil, errMalformedVector
+	}
+	return b, nil
+}
+
+// EncodeCompact encodes the vector into a compact form
+func (b HighestBeforeSeq) EncodeCompact() []byte {
+	size := b.Size()
+	buf, bitmapPos := appendCompactHeader(make([]byte, 0, 1+bitmapSize(size)+size*4), size)
+	for i := 0; i < size; i++ {
+		seq := b.Get(idx.Validator(i))
+		if seq.Seq == 0 && seq.MinSeq == 0 {
+			continue
+		}
+		buf[bitmapPos+i/8] |= 1 << (i % 8)
+		buf = binary.AppendUvarint(buf, uint64(seq.Seq))
+		buf = binary.AppendUvarint(buf, uint64(seq.MinSeq))
+	}
+	return buf
+}
+
+// DecodeHighestBeforeSeq decodes the vector from the compact form
+func DecodeHighestBeforeSeq(enc []byte) (HighestBeforeSeq, error) {
+	size, bitmap, rest, err := readCompactHeader(enc)
+	if err != nil {
+		return nil, err
+	}
+	b := make(HighestBeforeSeq, size*8)
+	for i := 0; i < size; i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		var seq, minSeq uint32
+		seq, rest, err = readUvarint32(rest)
+		if err != nil {
+			return nil, err
+		}
+		minSeq, rest, err = readUvarint32(rest)
+		if err != nil {
+			return nil, err
+		}
+		binary.LittleEndian.PutUint32(b[i*8:i*8+4], seq)
+		binary.LittleEndian.PutUint32(b[i*8+4:i*8+8], minSeq)
+	}
+	if len(rest) != 0 {
+		return nil, errMalformedVector
+	}
+	return b, nil
+}
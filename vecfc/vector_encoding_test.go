@@ -0,0 +1,176 @@
+package vecfc
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/flushable"
+	"github.com/Fantom-foundation/lachesis-base/kvdb/memorydb"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+)
+
+func TestCompactVectorsEncoding(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(0)) // nolint:gosec
+
+	for _, size := range []idx.Validator{0, 1, 7, 8, 9, 100, 333} {
+		la := NewLowestAfterSeq(size)
+		hb := NewHighestBeforeSeq(size)
+		for i := idx.Validator(0); i < size; i++ {
+			if r.Intn(3) == 0 {
+				continue
+			}
+			la.Set(i, idx.Event(r.Uint32()>>r.Intn(32)))
+			hb.Set(i, BranchSeq{Seq: idx.Event(r.Uint32() >> r.Intn(32)), MinSeq: idx.Event(r.Intn(10))})
+		}
+		if size > 0 {
+			hb.SetForkDetected(size - 1)
+		}
+
+		gotLa, err := DecodeLowestAfterSeq(la.EncodeCompact())
+		require.NoError(err)
+		require.Equal(*la, gotLa)
+
+		gotHb, err := DecodeHighestBeforeSeq(hb.EncodeCompact())
+		require.NoError(err)
+		require.Equal(*hb, gotHb)
+	}
+
+	// malformed data
+	hb := NewHighestBeforeSeq(10)
+	hb.Set(5, BranchSeq{Seq: 100, MinSeq: 1})
+	enc := hb.EncodeCompact()
+	_, err := DecodeHighestBeforeSeq(enc[:len(enc)-1])
+	require.Error(err)
+	_, err = DecodeHighestBeforeSeq(append(enc, 0))
+	require.Error(err)
+	_, err = DecodeLowestAfterSeq([]byte{0xff})
+	require.Error(err)
+}
+
+func TestMigrateToCompactVectors(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(20)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	events := make(map[hash.Event]dag.Event)
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+
+	legacyCfg := LiteConfig()
+	legacyCfg.CompactVectors = false
+	compactCfg := LiteConfig()
+	compactCfg.CompactVectors = true
+
+	db := memorydb.New()
+	legacy := NewIndex(tCrit, legacyCfg)
+	legacy.Reset(validators, flushable.Wrap(db), getEvent)
+	ordered := make(dag.Events, 0)
+	tdag.ForEachRandEvent(nodes, 20, 5, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			events[e.ID()] = e
+			ordered = append(ordered, e)
+			require.NoError(legacy.Add(e))
+			legacy.Flush()
+		},
+	})
+	legacySize := dbSize(db)
+
+	// the vectors are copied, as the legacy index can't read them after the DB is migrated
+	highestBefore := make(map[hash.Event]*HighestBeforeSeq)
+	lowestAfter := make(map[hash.Event]*LowestAfterSeq)
+	forklessCause := make(map[[2]hash.Event]bool)
+	for _, a := range ordered {
+		highestBefore[a.ID()] = legacy.GetHighestBefore(a.ID())
+		lowestAfter[a.ID()] = legacy.GetLowestAfter(a.ID())
+		for _, b := range ordered[:20] {
+			forklessCause[[2]hash.Event{a.ID(), b.ID()}] = legacy.ForklessCause(a.ID(), b.ID())
+		}
+	}
+	check := func(vi *Index) {
+		for _, a := range ordered {
+			require.Equal(highestBefore[a.ID()], vi.GetHighestBefore(a.ID()))
+			require.Equal(lowestAfter[a.ID()], vi.GetLowestAfter(a.ID()))
+			for _, b := range ordered[:20] {
+				require.Equal(forklessCause[[2]hash.Event{a.ID(), b.ID()}], vi.ForklessCause(a.ID(), b.ID()))
+			}
+		}
+	}
+
+	require.NoError(MigrateToCompactVectors(db))
+	require.Less(dbSize(db), legacySize)
+	compact := NewIndex(tCrit, compactCfg)
+	compact.Reset(validators, flushable.Wrap(db), getEvent)
+	check(compact)
+
+	// the vectors are migrated into the configured encoding on Reset, in both directions
+	back := NewIndex(tCrit, legacyCfg)
+	backDb := flushable.Wrap(db)
+	back.Reset(validators, backDb, getEvent)
+	require.NoError(backDb.Flush())
+	require.Equal(legacySize, dbSize(db))
+	check(back)
+
+	compactDb := flushable.Wrap(db)
+	compact = NewIndex(tCrit, compactCfg)
+	compact.Reset(validators, compactDb, getEvent)
+	require.NoError(compactDb.Flush())
+	require.Less(dbSize(db), legacySize)
+	check(compact)
+}
+
+func TestCompactVectors_Toggle(t *testing.T) {
+	require := require.New(t)
+
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := make(dag.Events, 0)
+	events := make(map[hash.Event]dag.Event)
+	tdag.ForEachRandEvent(nodes, 30, 3, nil, tdag.ForEachEvent{
+		Process: func(e dag.Event, name string) {
+			ordered = append(ordered, e)
+			events[e.ID()] = e
+		},
+	})
+	getEvent := func(id hash.Event) dag.Event {
+		return events[id]
+	}
+	expected := indexEvents(vecengine.DefaultConfig(), validators, ordered)
+
+	// the option is switched between the writes, so LowestAfter of the same events is updated in both encodings
+	db := flushable.Wrap(memorydb.New())
+	parts := []dag.Events{ordered[:len(ordered)/3], ordered[len(ordered)/3 : 2*len(ordered)/3], ordered[2*len(ordered)/3:]}
+	var vi *Index
+	for i, compact := range []bool{false, true, false} {
+		cfg := LiteConfig()
+		cfg.CompactVectors = compact
+		vi = NewIndex(tCrit, cfg)
+		vi.Reset(validators, db, getEvent)
+		for _, e := range parts[i] {
+			require.NoError(vi.Add(e))
+			vi.Flush()
+		}
+	}
+	for _, e := range ordered {
+		require.Equal(expected.GetHighestBefore(e.ID()), vi.GetHighestBefore(e.ID()))
+		require.Equal(expected.GetLowestAfter(e.ID()), vi.GetLowestAfter(e.ID()))
+	}
+}
+
+func dbSize(db *memorydb.Database) int {
+	size := 0
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		size += len(it.Key()) + len(it.Value())
+	}
+	return size
+}
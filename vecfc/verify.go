@@ -0,0 +1,179 @@
+package vecfc
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag"
+	"github.com/Fantom-foundation/lachesis-base/inter/idx"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+)
+
+// VerifyEvent recalculates the branch ID and the vectors of an indexed event, and compares them with the stored ones.
+// HighestBefore is recalculated from the stored vectors of the parents, and LowestAfter from the stored vectors of the children,
+// so children must contain all the indexed children of the event.
+// Pruned events are skipped, as well as the vectors which depend on the pruned parents or children.
+// Returns an error wrapping vecengine.ErrInconsistentIndex if the data is inconsistent.
+func (vi *Index) VerifyEvent(e dag.Event, children hash.Events) error {
+	if vi.isPrunedEvent(e.ID()) {
+		return nil
+	}
+	branchID, err := vi.Engine.VerifyEventBranchID(e)
+	if err != nil {
+		return err
+	}
+
+	storedBefore := vi.GetHighestBefore(e.ID())
+	if storedBefore == nil {
+		return fmt.Errorf("%w: HighestBefore isn't found", vecengine.ErrInconsistentIndex)
+	}
+	prunedParent := false
+	for _, p := range e.Parents() {
+		prunedParent = prunedParent || vi.isPrunedEvent(p)
+	}
+	if !prunedParent {
+		calcBefore, err := vi.Engine.CalcHighestBefore(e, branchID, idx.Validator(storedBefore.Size()))
+		if err != nil {
+			return err
+		}
+		for n := idx.Validator(0); int(n) < storedBefore.Size(); n++ {
+			got, expected := storedBefore.Get(n), calcBefore.(*HighestBeforeSeq).Get(n)
+			if got != expected {
+				return fmt.Errorf("%w: HighestBefore of branch %d is %v, expected %v", vecengine.ErrInconsistentIndex, n, got, expected)
+			}
+		}
+	}
+
+	storedAfter := vi.GetLowestAfter(e.ID())
+	if storedAfter == nil {
+		return fmt.Errorf("%w: LowestAfter isn't found", vecengine.ErrInconsistentIndex)
+	}
+	// the lowest event of a branch which observes the event is either the event itself or observes one of the children
+	calcAfter := NewLowestAfterSeq(0)
+	calcAfter.InitWithEvent(branchID, e)
+	for _, c := range children {
+		if vi.isPrunedEvent(c) {
+			// the lowest event may be observed only via the pruned child
+			return nil
+		}
+		childAfter := vi.GetLowestAfter(c)
+		if childAfter == nil {
+			return fmt.Errorf("%w: LowestAfter of child %s isn't found", vecengine.ErrInconsistentIndex, c.String())
+		}
+		for n := idx.Validator(0); n < childAfter.Size(); n++ {
+			seq := childAfter.Get(n)
+			if seq != 0 && (calcAfter.Get(n) == 0 || seq < calcAfter.Get(n)) {
+				calcAfter.Set(n, seq)
+			}
+		}
+	}
+	// LowestAfter isn't propagated to the events with a too low frame if MaxFrameDistance or PruneFrames is set,
+	// so a child may be observed by a lower event of the branch than the event is
+	bounded := vi.cfg.Engine.MaxFrameDistance != 0 || vi.cfg.Engine.PruneFrames != 0
+	for n := idx.Validator(0); n < calcAfter.Size() || n < storedAfter.Size(); n++ {
+		got, expected := storedAfter.Get(n), calcAfter.Get(n)
+		if got != expected && !(bounded && n != branchID && (got == 0 || got > expected)) {
+			return fmt.Errorf("%w: LowestAfter of branch %d is %d, expected %d", vecengine.ErrInconsistentIndex, n, got, expected)
+		}
+	}
+	return nil
+}
+
+// VerifyEventDAG compares the stored vectors of an indexed event with the vectors calculated from the DAG directly.
+// children must return all the indexed children of an event. Pruned events are skipped.
+// Only positions of validators which have no forks are verified, because branch IDs depend on processing order.
+// LowestAfter is expected to be propagated only from the events whose vecengine.Engine.MinPropagationFrame isn't above the event's frame.
+// Returns an error wrapping vecengine.ErrInconsistentIndex if the data is inconsistent.
+func (vi *Index) VerifyEventDAG(e dag.Event, children func(hash.Event) hash.Events) error {
+	vi.InitBranchesInfo()
+
+	hb := vi.GetMergedHighestBefore(e.ID())
+	la := vi.GetLowestAfter(e.ID())
+	if hb == nil || la == nil {
+		if vi.isPrunedEvent(e.ID()) {
+			return nil
+		}
+		return fmt.Errorf("%w: vectors aren't found", vecengine.ErrInconsistentIndex)
+	}
+
+	highestBefore := make([]idx.Event, vi.validators.Len())
+	err := walkDAG(e.ID(), func(id hash.Event) (hash.Events, error) {
+		p := vi.getEvent(id)
+		if p == nil {
+			return nil, fmt.Errorf("event %s isn't found", id.String())
+		}
+		creatorIdx := vi.validatorIdxs[p.Creator()]
+		if highestBefore[creatorIdx] < p.Seq() {
+			highestBefore[creatorIdx] = p.Seq()
+		}
+		return p.Parents(), nil
+	})
+	if err != nil {
+		return err
+	}
+	lowestAfter := make([]idx.Event, vi.validators.Len())
+	err = walkDAG(e.ID(), func(id hash.Event) (hash.Events, error) {
+		c := vi.getEvent(id)
+		if c == nil {
+			return nil, fmt.Errorf("event %s isn't found", id.String())
+		}
+		if id != e.ID() {
+			minFrame, err := vi.Engine.MinPropagationFrame(c)
+			if err != nil {
+				return nil, err
+			}
+			if e.Frame() < minFrame {
+				// the descendants of c may still observe the event
+				return children(id), nil
+			}
+		}
+		creatorIdx := vi.validatorIdxs[c.Creator()]
+		if lowestAfter[creatorIdx] == 0 || c.Seq() < lowestAfter[creatorIdx] {
+			lowestAfter[creatorIdx] = c.Seq()
+		}
+		return children(id), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	bi := vi.BranchesInfo()
+	for n := idx.Validator(0); n < vi.validators.Len(); n++ {
+		if len(bi.BranchIDByCreators[n]) > 1 {
+			continue
+		}
+		if got := hb.Get(n).Seq; got != highestBefore[n] {
+			return fmt.Errorf("%w: HighestBefore for validator %d is %d, expected %d", vecengine.ErrInconsistentIndex, vi.validators.GetID(n), got, highestBefore[n])
+		}
+		if got := la.Get(n); got != lowestAfter[n] {
+			return fmt.Errorf("%w: LowestAfter for validator %d is %d, expected %d", vecengine.ErrInconsistentIndex, vi.validators.GetID(n), got, lowestAfter[n])
+		}
+	}
+	return nil
+}
+
+// isPrunedEvent returns true if the vectors of the event are missing because it's pruned
+func (vi *Index) isPrunedEvent(id hash.Event) bool {
+	return vi.Engine.IsPruned(id) && vi.GetHighestBefore(id) == nil
+}
+
+// walkDAG visits each event reachable from the start event once, including the start event
+func walkDAG(start hash.Event, next func(hash.Event) (hash.Events, error)) error {
+	visited := hash.NewEventsSet(start)
+	stack := hash.Events{start}
+	for len(stack) != 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		nexts, err := next(id)
+		if err != nil {
+			return err
+		}
+		for _, n := range nexts {
+			if _, ok := visited[n]; !ok {
+				visited[n] = struct{}{}
+				stack = append(stack, n)
+			}
+		}
+	}
+	return nil
+}
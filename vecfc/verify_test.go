@@ -0,0 +1,65 @@
+package vecfc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Fantom-foundation/lachesis-base/hash"
+	"github.com/Fantom-foundation/lachesis-base/inter/dag/tdag"
+	"github.com/Fantom-foundation/lachesis-base/inter/pos"
+	"github.com/Fantom-foundation/lachesis-base/vecengine"
+)
+
+func TestIndex_VerifyEvent(t *testing.T) {
+	nodes := tdag.GenNodes(10)
+	validators := pos.EqualWeightValidators(nodes, 1)
+	ordered := genFramedForks(nodes, 50)
+	children := make(map[hash.Event]hash.Events)
+	for _, e := range ordered {
+		for _, p := range e.Parents() {
+			children[p] = append(children[p], e.ID())
+		}
+	}
+
+	for name, cfg := range map[string]vecengine.Config{
+		"unbounded":          vecengine.DefaultConfig(),
+		"max frame distance": {MaxFrameDistance: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			vi := indexEvents(cfg, validators, ordered)
+			for _, e := range ordered {
+				require.NoError(vi.VerifyEvent(e, children[e.ID()]), e.ID().String())
+			}
+
+			// wrong HighestBefore
+			e := ordered[len(ordered)/2]
+			before := vi.GetHighestBefore(e.ID()).Get(0)
+			before.Seq++
+			vi.GetHighestBefore(e.ID()).Set(0, before)
+			err := vi.VerifyEvent(e, children[e.ID()])
+			require.True(errors.Is(err, vecengine.ErrInconsistentIndex), err)
+			before.Seq--
+			vi.GetHighestBefore(e.ID()).Set(0, before)
+
+			// wrong LowestAfter
+			after := vi.GetLowestAfter(e.ID())
+			branchID := vi.GetEventBranchID(e.ID())
+			after.Set(branchID, after.Get(branchID)+1)
+			err = vi.VerifyEvent(e, children[e.ID()])
+			require.True(errors.Is(err, vecengine.ErrInconsistentIndex), err)
+			after.Set(branchID, after.Get(branchID)-1)
+
+			// wrong branch ID
+			vi.SetEventBranchID(e.ID(), branchID+1)
+			err = vi.VerifyEvent(e, children[e.ID()])
+			require.True(errors.Is(err, vecengine.ErrInconsistentIndex), err)
+			vi.SetEventBranchID(e.ID(), branchID)
+
+			require.NoError(vi.VerifyEvent(e, children[e.ID()]))
+		})
+	}
+}